@@ -74,7 +74,11 @@ func (c *RemoteIndex) PutObject(ctx context.Context, host, index,
 	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
-	_, err = c.do(c.timeoutUnit*60, req, body, nil, successCode)
+	setIfMatchHeader(ctx, req)
+	code, err := c.do(c.timeoutUnit*60, req, body, nil, successCode)
+	if code == http.StatusPreconditionFailed {
+		return objects.NewErrObjectChanged("%v", err)
+	}
 	return err
 }
 
@@ -323,12 +327,17 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	}
 
 	clusterapi.IndicesPayloads.MergeDoc.SetContentTypeHeaderReq(req)
+	setIfMatchHeader(ctx, req)
 	res, err := c.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "send http request")
 	}
 
 	defer res.Body.Close()
+	if res.StatusCode == http.StatusPreconditionFailed {
+		body, _ := io.ReadAll(res.Body)
+		return objects.NewErrObjectChanged("%s", body)
+	}
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
 		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
@@ -715,6 +724,93 @@ func (c *RemoteIndex) UpdateShardStatus(ctx context.Context, hostName, indexName
 	return c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) TrashEntries(ctx context.Context,
+	hostName, indexName, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	var query string
+	if id != "" {
+		query = url.Values{"id": []string{id.String()}}.Encode()
+	}
+	path := fmt.Sprintf("/indices/%s/shards/%s/trash", indexName, shardName)
+	url := url.URL{Scheme: "http", Host: hostName, Path: path, RawQuery: query}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+	var entries [][]byte
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.TrashEntries.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		entries, err = clusterapi.IndicesPayloads.TrashEntries.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return entries, c.retry(ctx, 9, try)
+}
+
+func (c *RemoteIndex) ObjectHistory(ctx context.Context,
+	hostName, indexName, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	query := url.Values{"id": []string{id.String()}}.Encode()
+	path := fmt.Sprintf("/indices/%s/shards/%s/history", indexName, shardName)
+	url := url.URL{Scheme: "http", Host: hostName, Path: path, RawQuery: query}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+	var versions [][]byte
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.ObjectHistory.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		versions, err = clusterapi.IndicesPayloads.ObjectHistory.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return versions, c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) PutFile(ctx context.Context, hostName, indexName,
 	shardName, fileName string, payload io.ReadSeekCloser,
 ) error {
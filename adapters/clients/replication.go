@@ -174,6 +174,7 @@ func (c *replicationClient) PutObject(ctx context.Context, host, index,
 	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
+	setIfMatchHeader(ctx, req)
 	err = c.do(c.timeoutUnit*90, req, body, &resp, 9)
 	return resp, err
 }
@@ -226,6 +227,7 @@ func (c *replicationClient) MergeObject(ctx context.Context, host, index, shard,
 	}
 
 	clusterapi.IndicesPayloads.MergeDoc.SetContentTypeHeaderReq(req)
+	setIfMatchHeader(ctx, req)
 	err = c.do(c.timeoutUnit*90, req, body, &resp, 9)
 	return resp, err
 }
@@ -402,6 +404,14 @@ func backOff(d time.Duration) time.Duration {
 	return time.Duration(float64(d.Nanoseconds()*2) * (0.5 + rand.Float64()))
 }
 
+// setIfMatchHeader passes the precondition of a conditional update on to the
+// node owning the object, which checks it when it writes the object
+func setIfMatchHeader(ctx context.Context, req *http.Request) {
+	if m, ok := objects.IfMatchFromContext(ctx); ok {
+		req.Header.Set("If-Match", m.String())
+	}
+}
+
 func shouldRetry(code int) bool {
 	return code == http.StatusInternalServerError ||
 		code == http.StatusTooManyRequests ||
@@ -20,6 +20,13 @@ const (
 
 const GroupBy = "Specify which properties to group by"
 
+const (
+	GroupByOffset    = "Number of groups to skip, used to page through the groups together with limit"
+	GroupBySort      = "Specify the order of the groups, by default the largest groups come first"
+	GroupBySortBy    = "Sort the groups by their number of objects (count) or by the value they are grouped by (groupedBy)"
+	GroupBySortOrder = "Specify the sort order, either ascending (asc) which is default or descending (desc)"
+)
+
 const (
 	AggregatePropertyObject = "An object containing Aggregation information about this property"
 )
@@ -0,0 +1,21 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package descriptions
+
+// Local
+const (
+	Subscribe           = "Subscribe to changes of Objects on a local Weaviate"
+	SubscribeObj        = "An object used to Subscribe to changes of Objects on a local Weaviate"
+	SubscribeEvent      = "The kind of change: 'create', 'update' or 'delete' of an Object, or 'class_add', 'class_delete' or 'property_add' of the schema. Deleted Objects only contain their id"
+	SubscribeSchemaHash = "The hash of the schema after a schema change"
+	SubscribeProperty   = "The name of the property added by a 'property_add' change"
+)
@@ -121,6 +121,11 @@ func classField(class *models.Class, description string,
 				Description: descriptions.GroupBy,
 				Type:        graphql.NewList(graphql.String),
 			},
+			"offset": &graphql.ArgumentConfig{
+				Description: descriptions.GroupByOffset,
+				Type:        graphql.Int,
+			},
+			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
 			"objectLimit": &graphql.ArgumentConfig{
@@ -148,6 +153,10 @@ func classField(class *models.Class, description string,
 func classPropertyFields(class *models.Class) (graphql.Fields, error) {
 	fields := graphql.Fields{}
 	for _, property := range class.Properties {
+		if schema.PropertyTombstoned(property) {
+			continue
+		}
+
 		propertyType, err := schema.GetPropertyDataType(class, property.Name)
 		if err != nil {
 			return nil, fmt.Errorf("%s.%s: %s", class.Class, property.Name, err)
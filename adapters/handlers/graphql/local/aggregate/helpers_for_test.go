@@ -18,6 +18,7 @@ import (
 	testhelper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -31,7 +32,11 @@ type mockResolver struct {
 }
 
 func newMockResolver(cfg config.Config) *mockResolver {
-	field, err := Build(&testhelper.CarSchema, cfg, nil)
+	return newMockResolverWithSchema(&testhelper.CarSchema, cfg)
+}
+
+func newMockResolverWithSchema(sch *schema.Schema, cfg config.Config) *mockResolver {
+	field, err := Build(sch, cfg, nil)
 	if err != nil {
 		panic(fmt.Sprintf("could not build graphql test schema: %s", err))
 	}
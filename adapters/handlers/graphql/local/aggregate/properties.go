@@ -120,6 +120,12 @@ func datePropertyFields(class *models.Class,
 			Type:        graphql.String,
 			Resolve:     makeResolveDateFieldAggregator("median"),
 		},
+		"mean": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMean", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateMean,
+			Type:        graphql.String,
+			Resolve:     makeResolveDateFieldAggregator("mean"),
+		},
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/requestsettings"
 )
 
 // GroupedByFieldName is a special graphQL field that appears alongside the
@@ -90,6 +91,20 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		return nil, fmt.Errorf("could not extract limit: %w", err)
 	}
 
+	offset, err := extractOffset(p.Args)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract offset: %w", err)
+	}
+
+	groupSort, err := extractGroupSort(p.Args)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract sort: %w", err)
+	}
+
+	if (offset != nil || groupSort != nil) && groupBy == nil {
+		return nil, fmt.Errorf("offset and sort can only be used with groupBy")
+	}
+
 	objectLimit, err := extractObjectLimit(p.Args)
 	if objectLimit != nil && *objectLimit <= 0 {
 		return nil, fmt.Errorf("objectLimit must be a positive integer")
@@ -141,7 +156,12 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		hybridParams = p
 	}
 
+	// the tenant of the request only applies to the classes which have
+	// multi-tenancy enabled, see the Get resolver
 	var tenant string
+	if schema.MultiTenancyEnabled(class) {
+		tenant = requestsettings.FromContext(p.Context).Tenant
+	}
 	if tk, ok := p.Args["tenant"]; ok {
 		tenant = tk.(string)
 	}
@@ -153,6 +173,8 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		GroupBy:          groupBy,
 		IncludeMetaCount: includeMeta,
 		Limit:            limit,
+		Offset:           offset,
+		GroupSort:        groupSort,
 		ObjectLimit:      objectLimit,
 		NearVector:       nearVectorParams,
 		NearObject:       nearObjectParams,
@@ -288,6 +310,23 @@ func extractLimit(args map[string]interface{}) (*int, error) {
 	return &limitInt, nil
 }
 
+func extractOffset(args map[string]interface{}) (*int, error) {
+	offset, ok := args["offset"]
+	if !ok {
+		return nil, nil
+	}
+
+	offsetInt, ok := offset.(int)
+	if !ok {
+		return nil, fmt.Errorf("offset must be an int, instead got: %#v", offset)
+	}
+	if offsetInt < 0 {
+		return nil, fmt.Errorf("offset must not be negative")
+	}
+
+	return &offsetInt, nil
+}
+
 func extractObjectLimit(args map[string]interface{}) (*int, error) {
 	objectLimit, ok := args["objectLimit"]
 	if !ok {
@@ -12,14 +12,18 @@
 package aggregate
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/requestsettings"
 )
 
 type testCase struct {
@@ -35,6 +39,8 @@ type testCase struct {
 	expectedNearHybrid       *searchparams.HybridSearch
 	expectedIncludeMetaCount bool
 	expectedLimit            *int
+	expectedOffset           *int
+	expectedGroupSort        *aggregation.GroupSort
 	expectedObjectLimit      *int
 }
 
@@ -212,6 +218,36 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name: "paging and sorting the groups",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"], limit:10, offset:20,
+				sort:{by:groupedBy, order:desc}) { groupedBy { value } } } }`,
+			expectedProps: []aggregation.ParamProperty{},
+			resolverReturn: []aggregation.Group{
+				{GroupedBy: &aggregation.GroupedBy{Value: "best-manufacturer"}},
+			},
+			expectedGroupBy:   groupCarByMadeByManufacturerName(),
+			expectedLimit:     ptInt(10),
+			expectedOffset:    ptInt(20),
+			expectedGroupSort: &aggregation.GroupSort{By: aggregation.GroupSortByValue, Order: "desc"},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"groupedBy": map[string]interface{}{"value": "best-manufacturer"},
+					},
+				},
+			}},
+		},
+		testCase{
+			name:                     "sorting the groups with the default order",
+			query:                    `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"], sort:{by:count}) { meta { count } } } }`,
+			expectedProps:            []aggregation.ParamProperty{},
+			resolverReturn:           []aggregation.Group{{Count: 3}},
+			expectedGroupBy:          groupCarByMadeByManufacturerName(),
+			expectedGroupSort:        &aggregation.GroupSort{By: aggregation.GroupSortByCount, Order: "asc"},
+			expectedIncludeMetaCount: true,
+		},
 		testCase{
 			name: "with props formerly contained only in Meta",
 			query: `{ Aggregate { Car {
@@ -982,6 +1018,48 @@ func Test_Resolve(t *testing.T) {
 	tests.AssertExtraction(t, "Car")
 }
 
+func Test_ResolveGroupPagingErrors(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(config.Config{})
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car(offset:10) { horsepower { mean } } } }`,
+		"offset and sort can only be used with groupBy")
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"], offset:-1) { horsepower { mean } } } }`,
+		"could not extract offset: offset must not be negative")
+}
+
+func Test_ResolveRequestTenant(t *testing.T) {
+	t.Parallel()
+
+	// only Car has multi-tenancy enabled, the tenant of the request must not
+	// be applied to Bicycle
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{
+			Class:              "Car",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			Properties:         []*models.Property{{Name: "horsepower", DataType: []string{"int"}}},
+		},
+		{
+			Class:      "Bicycle",
+			Properties: []*models.Property{{Name: "gears", DataType: []string{"int"}}},
+		},
+	}}}
+	resolver := newMockResolverWithSchema(&sch, config.Config{})
+	resolver.Context = requestsettings.WithSettings(context.Background(),
+		requestsettings.Settings{Tenant: "tenant1"})
+
+	resolver.On("Aggregate", mock.MatchedBy(func(p *aggregation.Params) bool {
+		return p.ClassName == "Car" && p.Tenant == "tenant1"
+	})).Return([]aggregation.Group{}, nil).Once()
+	resolver.On("Aggregate", mock.MatchedBy(func(p *aggregation.Params) bool {
+		return p.ClassName == "Bicycle" && p.Tenant == ""
+	})).Return([]aggregation.Group{}, nil).Once()
+
+	resolver.AssertResolve(t, `{ Aggregate { Car { meta { count } } Bicycle { meta { count } } } }`)
+}
+
 func (tests testCases) AssertExtraction(t *testing.T, className string) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -996,6 +1074,8 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				NearVector:       testCase.expectedNearVectorFilter,
 				IncludeMetaCount: testCase.expectedIncludeMetaCount,
 				Limit:            testCase.expectedLimit,
+				Offset:           testCase.expectedOffset,
+				GroupSort:        testCase.expectedGroupSort,
 				ObjectLimit:      testCase.expectedObjectLimit,
 				Hybrid:           testCase.expectedNearHybrid,
 			}
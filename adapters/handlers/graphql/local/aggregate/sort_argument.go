@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+func sortArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GroupBySort,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSortInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"by": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupBySortBy,
+						Type: graphql.NewEnum(graphql.EnumConfig{
+							Name: fmt.Sprintf("%sSortInpObjByEnum", prefix),
+							Values: graphql.EnumValueConfigMap{
+								aggregation.GroupSortByCount: &graphql.EnumValueConfig{},
+								aggregation.GroupSortByValue: &graphql.EnumValueConfig{},
+							},
+						}),
+					},
+					"order": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupBySortOrder,
+						Type: graphql.NewEnum(graphql.EnumConfig{
+							Name: fmt.Sprintf("%sSortInpObjTypeEnum", prefix),
+							Values: graphql.EnumValueConfigMap{
+								"asc":  &graphql.EnumValueConfig{},
+								"desc": &graphql.EnumValueConfig{},
+							},
+						}),
+					},
+				},
+				Description: descriptions.GroupBySort,
+			},
+		),
+	}
+}
+
+func extractGroupSort(args map[string]interface{}) (*aggregation.GroupSort, error) {
+	sort, ok := args["sort"]
+	if !ok {
+		return nil, nil
+	}
+
+	sortMap, ok := sort.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sort must be an object, instead got: %#v", sort)
+	}
+
+	groupSort := &aggregation.GroupSort{By: aggregation.GroupSortByCount, Order: "asc"}
+	if by, ok := sortMap["by"].(string); ok {
+		groupSort.By = by
+	}
+	if order, ok := sortMap["order"].(string); ok {
+		groupSort.Order = order
+	}
+	if err := groupSort.Validate(); err != nil {
+		return nil, err
+	}
+
+	return groupSort, nil
+}
@@ -110,6 +110,10 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
 			classProperties := graphql.Fields{}
 			for _, property := range class.Properties {
+				if schema.PropertyTombstoned(property) {
+					continue
+				}
+
 				propertyType, err := b.schema.FindPropertyDataType(property.DataType)
 				if err != nil {
 					if errors.Is(err, schema.ErrRefToNonexistentClass) {
@@ -139,6 +143,11 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 					classProperties[property.Name] = b.referenceField(propertyType, property,
 						class.Class)
 				}
+
+				if schema.PropertyDeprecated(property) {
+					classProperties[property.Name].DeprecationReason = fmt.Sprintf(
+						"property %q is deprecated and can no longer be written", property.Name)
+				}
 			}
 
 			b.additionalFields(classProperties, class)
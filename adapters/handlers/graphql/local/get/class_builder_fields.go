@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	moduleadditional "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	"github.com/weaviate/weaviate/usecases/requestsettings"
 
 	"github.com/tailor-inc/graphql"
 	"github.com/tailor-inc/graphql/language/ast"
@@ -234,7 +235,7 @@ func buildGetClassField(classObject *graphql.Object,
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
 		},
-		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
+		Resolve: newResolver(modulesProvider).makeResolveGetClass(class),
 	}
 
 	field.Args["bm25"] = bm25Argument(class.Class)
@@ -317,17 +318,18 @@ func newResolver(modulesProvider ModulesProvider) *resolver {
 	return &resolver{modulesProvider}
 }
 
-func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn {
+func (r *resolver) makeResolveGetClass(class *models.Class) graphql.FieldResolveFn {
 	return func(p graphql.ResolveParams) (interface{}, error) {
-		result, err := r.resolveGet(p, className)
+		result, err := r.resolveGet(p, class)
 		if err != nil {
-			return result, enterrors.NewErrGraphQLUser(err, "Get", className)
+			return result, enterrors.NewErrGraphQLUser(err, "Get", class.Class)
 		}
 		return result, nil
 	}
 }
 
-func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interface{}, error) {
+func (r *resolver) resolveGet(p graphql.ResolveParams, class *models.Class) (interface{}, error) {
+	className := class.Class
 	source, ok := p.Source.(map[string]interface{})
 	if !ok {
 		return nil, fmt.Errorf("expected graphql root to be a map, but was %T", p.Source)
@@ -431,11 +433,18 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		targetVectorCombination = targetCombination
 	}
 
+	// arguments take precedence over the settings of the request
+	settings := requestsettings.FromContext(p.Context)
+
 	var replProps *additional.ReplicationProperties
 	if cl, ok := p.Args["consistencyLevel"]; ok {
 		replProps = &additional.ReplicationProperties{
 			ConsistencyLevel: cl.(string),
 		}
+	} else if settings.ConsistencyLevel != "" {
+		replProps = &additional.ReplicationProperties{
+			ConsistencyLevel: settings.ConsistencyLevel,
+		}
 	}
 
 	group := extractGroup(p.Args)
@@ -447,7 +456,13 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		groupByParams = &p
 	}
 
+	// the tenant of the request only applies to the classes which have
+	// multi-tenancy enabled, so that it can be set for queries which span
+	// other classes as well
 	var tenant string
+	if schema.MultiTenancyEnabled(class) {
+		tenant = settings.Tenant
+	}
 	if tk, ok := p.Args["tenant"]; ok {
 		tenant = tk.(string)
 	}
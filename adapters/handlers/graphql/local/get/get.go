@@ -36,8 +36,17 @@ type ModulesProvider interface {
 func Build(schema *schema.Schema, logger logrus.FieldLogger,
 	modulesProvider ModulesProvider,
 ) (*graphql.Field, error) {
+	getField, _, err := BuildWithSubscribe(schema, logger, modulesProvider)
+	return getField, err
+}
+
+// BuildWithSubscribe builds the Local.Get part of the graphql tree and the
+// Subscribe field of the subscription root, which share their class objects
+func BuildWithSubscribe(schema *schema.Schema, logger logrus.FieldLogger,
+	modulesProvider ModulesProvider,
+) (*graphql.Field, *graphql.Field, error) {
 	if len(schema.Objects.Classes) == 0 {
-		return nil, utils.ErrEmptySchema
+		return nil, nil, utils.ErrEmptySchema
 	}
 
 	cb := newClassBuilder(schema, logger, modulesProvider)
@@ -47,7 +56,7 @@ func Build(schema *schema.Schema, logger logrus.FieldLogger,
 	if len(schema.Objects.Classes) > 0 {
 		objects, err = cb.objects()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -59,5 +68,5 @@ func Build(schema *schema.Schema, logger logrus.FieldLogger,
 			// Does nothing; pass through the filters
 			return p.Source, nil
 		},
-	}, nil
+	}, cb.subscribeField(), nil
 }
@@ -14,6 +14,7 @@
 package get
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -31,6 +32,8 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	helper "github.com/weaviate/weaviate/test/helper"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/requestsettings"
 )
 
 func TestSimpleFieldParamsOK(t *testing.T) {
@@ -47,6 +50,31 @@ func TestSimpleFieldParamsOK(t *testing.T) {
 	resolver.AssertResolve(t, "{ Get { SomeAction { intField } } }")
 }
 
+func TestRequestSettings(t *testing.T) {
+	t.Parallel()
+	// only SomeAction has multi-tenancy enabled, the tenant of the request
+	// must not be applied to SomeThing
+	sch := test_helper.CreateSimpleSchema(config.VectorizerModuleText2VecContextionary)
+	sch.FindClassByName("SomeAction").MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+	resolver := newMockResolverWithSchema(&sch)
+	resolver.Context = requestsettings.WithSettings(context.Background(),
+		requestsettings.Settings{ConsistencyLevel: "QUORUM", Tenant: "tenant1"})
+
+	resolver.On("GetClass", dto.GetParams{
+		ClassName:             "SomeAction",
+		Properties:            []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		ReplicationProperties: &additional.ReplicationProperties{ConsistencyLevel: "QUORUM"},
+		Tenant:                "tenant1",
+	}).Return(test_helper.EmptyList(), nil).Once()
+	resolver.On("GetClass", dto.GetParams{
+		ClassName:             "SomeThing",
+		Properties:            []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		ReplicationProperties: &additional.ReplicationProperties{ConsistencyLevel: "QUORUM"},
+	}).Return(test_helper.EmptyList(), nil).Once()
+
+	resolver.AssertResolve(t, "{ Get { SomeAction { intField } SomeThing { intField } } }")
+}
+
 func TestExtractIntField(t *testing.T) {
 	t.Parallel()
 
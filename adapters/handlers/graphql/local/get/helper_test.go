@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -632,9 +633,13 @@ func newMockResolver() *mockResolver {
 }
 
 func newMockResolverWithVectorizer(vectorizer string) *mockResolver {
-	logger, _ := test.NewNullLogger()
 	simpleSchema := test_helper.CreateSimpleSchema(vectorizer)
-	field, err := Build(&simpleSchema, logger, getFakeModulesProvider())
+	return newMockResolverWithSchema(&simpleSchema)
+}
+
+func newMockResolverWithSchema(sch *schema.Schema) *mockResolver {
+	logger, _ := test.NewNullLogger()
+	field, err := Build(sch, logger, getFakeModulesProvider())
 	if err != nil {
 		panic(fmt.Sprintf("could not build graphql test schema: %s", err))
 	}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 // Resolver is a local abstraction of the required UC resolvers
@@ -23,6 +24,13 @@ type Resolver interface {
 	GetClass(ctx context.Context, principal *models.Principal, info dto.GetParams) ([]interface{}, error)
 }
 
+// Subscriber is a local abstraction of the object changes streamed to
+// Subscribe. It is implemented by the same UC as the Resolver.
+type Subscriber interface {
+	Subscribe(ctx context.Context, principal *models.Principal,
+		classes []string, tenant string) (<-chan objects.Event, error)
+}
+
 // RequestsLog is a local abstraction on the RequestsLog that needs to be
 // provided to the graphQL API in order to log Local.Get queries.
 type RequestsLog interface {
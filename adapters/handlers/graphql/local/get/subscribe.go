@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// subscribeField builds the Subscribe field of the subscription root. Each
+// event resolves the class field of the changed object, using the same class
+// objects as Get.
+func (b *classBuilder) subscribeField() *graphql.Field {
+	fields := graphql.Fields{
+		"event": &graphql.Field{
+			Description: descriptions.SubscribeEvent,
+			Type:        graphql.String,
+		},
+		"schemaHash": &graphql.Field{
+			Description: descriptions.SubscribeSchemaHash,
+			Type:        graphql.String,
+		},
+		"property": &graphql.Field{
+			Description: descriptions.SubscribeProperty,
+			Type:        graphql.String,
+		},
+	}
+	for className, classObject := range b.knownClasses {
+		fields[className] = &graphql.Field{
+			Description: classObject.Description(),
+			Type:        classObject,
+		}
+	}
+
+	return &graphql.Field{
+		Name:        "Subscribe",
+		Description: descriptions.Subscribe,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name:        "SubscribeObjectsObj",
+			Fields:      fields,
+			Description: descriptions.SubscribeObj,
+		}),
+		Args: graphql.FieldConfigArgument{
+			"tenant": tenantArgument(),
+		},
+		Subscribe: b.resolveSubscribe,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			// the source is the event built by resolveSubscribe
+			return p.Source, nil
+		},
+	}
+}
+
+func (b *classBuilder) resolveSubscribe(p graphql.ResolveParams) (interface{}, error) {
+	source, ok := p.Source.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected graphql root to be a map, but was %T", p.Source)
+	}
+
+	subscriber, ok := source["Resolver"].(Subscriber)
+	if !ok {
+		return nil, fmt.Errorf("expected source map to have a usable Subscriber, but got %#v", source["Resolver"])
+	}
+
+	tenant, _ := p.Args["tenant"].(string)
+	events, err := subscriber.Subscribe(p.Context, principalFromContext(p.Context),
+		subscribedClasses(p.Info.FieldASTs), tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan interface{})
+	enterrors.GoWrapper(func() {
+		defer close(out)
+		for ev := range events {
+			select {
+			case out <- eventToSource(ev):
+			case <-p.Context.Done():
+				return
+			}
+		}
+	}, b.logger)
+	return out, nil
+}
+
+// subscribedClasses returns the classes selected in the Subscribe field
+func subscribedClasses(fields []*ast.Field) []string {
+	var classes []string
+	for _, field := range fields {
+		if field.SelectionSet == nil {
+			continue
+		}
+		for _, selection := range field.SelectionSet.Selections {
+			if f, ok := selection.(*ast.Field); ok && !isSubscribeMetaField(f.Name.Value) {
+				classes = append(classes, f.Name.Value)
+			}
+		}
+	}
+	return classes
+}
+
+func isSubscribeMetaField(name string) bool {
+	switch name {
+	case "event", "schemaHash", "property", "__typename":
+		return true
+	default:
+		return false
+	}
+}
+
+func eventToSource(ev objects.Event) map[string]interface{} {
+	if ev.IsSchemaEvent() {
+		// schema events have no object, the class field resolves to null
+		source := map[string]interface{}{
+			"event":      ev.Type,
+			"schemaHash": ev.SchemaHash,
+		}
+		if ev.Property != nil {
+			source["property"] = ev.Property.Name
+		}
+		return source
+	}
+
+	additional := map[string]interface{}{
+		"id": ev.ID,
+	}
+	object := map[string]interface{}{
+		"_additional": additional,
+	}
+
+	if ev.Object != nil {
+		additional["creationTimeUnix"] = ev.Object.CreationTimeUnix
+		additional["lastUpdateTimeUnix"] = ev.Object.LastUpdateTimeUnix
+		additional["vector"] = ev.Object.Vector
+		if props, ok := ev.Object.Properties.(map[string]interface{}); ok {
+			for name, value := range props {
+				object[name] = value
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"event":  ev.Type,
+		ev.Class: object,
+	}
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tailor-inc/graphql"
+	test_helper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeSubscriber struct {
+	classes []string
+	tenant  string
+	events  chan objects.Event
+}
+
+func (f *fakeSubscriber) Subscribe(ctx context.Context, principal *models.Principal,
+	classes []string, tenant string,
+) (<-chan objects.Event, error) {
+	f.classes = classes
+	f.tenant = tenant
+	return f.events, nil
+}
+
+func TestSubscribe(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	simpleSchema := test_helper.CreateSimpleSchema(config.VectorizerModuleText2VecContextionary)
+	getField, subscribeField, err := BuildWithSubscribe(&simpleSchema, logger, getFakeModulesProvider())
+	require.Nil(t, err)
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: graphql.Fields{"Get": getField},
+		}),
+		Subscription: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Subscription",
+			Fields: graphql.Fields{"Subscribe": subscribeField},
+		}),
+	})
+	require.Nil(t, err)
+
+	subscriber := &fakeSubscriber{events: make(chan objects.Event, 3)}
+	subscriber.events <- objects.Event{
+		Type:  objects.EventCreate,
+		Class: "SomeThing",
+		ID:    "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf",
+		Object: &models.Object{
+			Class:      "SomeThing",
+			ID:         "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf",
+			Properties: map[string]interface{}{"intField": 7},
+		},
+	}
+	subscriber.events <- objects.Event{
+		Type:  objects.EventDelete,
+		Class: "SomeThing",
+		ID:    "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf",
+	}
+	subscriber.events <- objects.Event{
+		Type:       objects.EventPropertyAdd,
+		Class:      "SomeThing",
+		Property:   &models.Property{Name: "title"},
+		SchemaHash: "abc",
+	}
+	close(subscriber.events)
+
+	results := graphql.Subscribe(graphql.Params{
+		Schema:        schema,
+		RootObject:    map[string]interface{}{"Resolver": subscriber},
+		RequestString: `subscription { Subscribe(tenant: "t1") { event schemaHash property SomeThing { intField _additional { id } } } }`,
+		Context:       context.Background(),
+	})
+
+	var data []interface{}
+	for result := range results {
+		require.Empty(t, result.Errors)
+		data = append(data, result.Data)
+	}
+
+	assert.Equal(t, []string{"SomeThing"}, subscriber.classes)
+	assert.Equal(t, "t1", subscriber.tenant)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"Subscribe": map[string]interface{}{
+			"event":      "create",
+			"schemaHash": nil,
+			"property":   nil,
+			"SomeThing": map[string]interface{}{
+				"intField":    7,
+				"_additional": map[string]interface{}{"id": strfmt.UUID("e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf")},
+			},
+		}},
+		map[string]interface{}{"Subscribe": map[string]interface{}{
+			"event":      "delete",
+			"schemaHash": nil,
+			"property":   nil,
+			"SomeThing": map[string]interface{}{
+				"intField":    nil,
+				"_additional": map[string]interface{}{"id": strfmt.UUID("e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf")},
+			},
+		}},
+		map[string]interface{}{"Subscribe": map[string]interface{}{
+			"event":      "property_add",
+			"schemaHash": "abc",
+			"property":   "title",
+			"SomeThing":  nil,
+		}},
+	}, data)
+}
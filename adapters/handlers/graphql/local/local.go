@@ -26,14 +26,27 @@ import (
 func Build(dbSchema *schema.Schema, logger logrus.FieldLogger,
 	config config.Config, modulesProvider *modules.Provider,
 ) (graphql.Fields, error) {
-	getField, err := get.Build(dbSchema, logger, modulesProvider)
+	localFields, _, err := BuildWithSubscriptions(dbSchema, logger, config, modulesProvider)
+	return localFields, err
+}
+
+// BuildWithSubscriptions builds the local queries and subscriptions from the
+// database schema.
+func BuildWithSubscriptions(dbSchema *schema.Schema, logger logrus.FieldLogger,
+	config config.Config, modulesProvider *modules.Provider,
+) (graphql.Fields, graphql.Fields, error) {
+	getField, subscribeField, err := get.BuildWithSubscribe(dbSchema, logger, modulesProvider)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	aggregateField, err := aggregate.Build(dbSchema, config, modulesProvider)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	subscriptionFields := graphql.Fields{
+		"Subscribe": subscribeField,
 	}
 
 	if modulesProvider.HasMultipleVectorizers() {
@@ -42,7 +55,7 @@ func Build(dbSchema *schema.Schema, logger logrus.FieldLogger,
 			"Aggregate": aggregateField,
 		}
 
-		return localFields, nil
+		return localFields, subscriptionFields, nil
 	}
 
 	exploreField := explore.Build(dbSchema.Objects, modulesProvider)
@@ -53,5 +66,5 @@ func Build(dbSchema *schema.Schema, logger logrus.FieldLogger,
 		"Explore":   exploreField,
 	}
 
-	return localFields, nil
+	return localFields, subscriptionFields, nil
 }
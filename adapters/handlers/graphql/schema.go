@@ -19,12 +19,16 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/gqlerrors"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/get"
 	"github.com/weaviate/weaviate/entities/schema"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 type Traverser interface {
@@ -39,6 +43,9 @@ type RequestsLogger interface {
 type GraphQL interface {
 	// Resolve the GraphQL query in 'query'.
 	Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result
+	// Subscribe to the GraphQL subscription in 'query'. The returned channel
+	// is closed once the context is done or the subscription failed.
+	Subscribe(context context.Context, query string, operationName string, variables map[string]interface{}) chan *graphql.Result
 }
 
 type graphQL struct {
@@ -69,7 +76,44 @@ func Build(schema *schema.Schema, traverser Traverser,
 
 // Resolve at query time
 func (g *graphQL) Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+	context, span := tracing.Start(context, "graphql.Resolve", attribute.String("operation", operationName))
+	defer span.End()
+
+	if g.config.GraphQLStrictMode {
+		if err := validateStrict(query); err != nil {
+			return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+		}
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema: g.schema,
+		RootObject: map[string]interface{}{
+			"Resolver": g.traverser,
+			"Config":   g.config,
+		},
+		RequestString:  query,
+		OperationName:  operationName,
+		VariableValues: variables,
+		Context:        context,
+	})
+	if result.HasErrors() {
+		span.SetStatus(codes.Error, result.Errors[0].Message)
+	}
+	return result
+}
+
+// Subscribe at query time
+func (g *graphQL) Subscribe(context context.Context, query string, operationName string, variables map[string]interface{}) chan *graphql.Result {
+	if g.config.GraphQLStrictMode {
+		if err := validateStrict(query); err != nil {
+			results := make(chan *graphql.Result, 1)
+			results <- &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+			close(results)
+			return results
+		}
+	}
+
+	return graphql.Subscribe(graphql.Params{
 		Schema: g.schema,
 		RootObject: map[string]interface{}{
 			"Resolver": g.traverser,
@@ -85,7 +129,7 @@ func (g *graphQL) Resolve(context context.Context, query string, operationName s
 func buildGraphqlSchema(dbSchema *schema.Schema, logger logrus.FieldLogger,
 	config config.Config, modulesProvider *modules.Provider,
 ) (graphql.Schema, error) {
-	localSchema, err := local.Build(dbSchema, logger, config, modulesProvider)
+	localSchema, subscriptions, err := local.BuildWithSubscriptions(dbSchema, logger, config, modulesProvider)
 	if err != nil {
 		return graphql.Schema{}, err
 	}
@@ -110,6 +154,11 @@ func buildGraphqlSchema(dbSchema *schema.Schema, logger logrus.FieldLogger,
 
 		result, err = graphql.NewSchema(graphql.SchemaConfig{
 			Query: graphql.NewObject(schemaObject),
+			Subscription: graphql.NewObject(graphql.ObjectConfig{
+				Name:        "WeaviateSubscription",
+				Description: "Location of the root subscription",
+				Fields:      subscriptions,
+			}),
 		})
 	}()
 
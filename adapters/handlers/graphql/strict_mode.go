@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/tailor-inc/graphql/language/parser"
+)
+
+// strictArguments are arguments which typically carry end-user input. In
+// strict mode they must be bound through query variables instead of being
+// interpolated into the query string.
+var strictArguments = map[string]struct{}{
+	"query":    {},
+	"concepts": {},
+}
+
+// validateStrict rejects queries which contain user values as inline
+// literals rather than variables. This catches clients which build queries
+// through string interpolation, which is prone to injection. Any
+// "value<Type>" field of a filter as well as the arguments listed in
+// strictArguments must be variables.
+func validateStrict(query string) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		// leave reporting syntax errors to the regular execution
+		return nil
+	}
+
+	var violations []string
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.OperationDefinition:
+			violations = append(violations, strictViolationsInSelections(def.SelectionSet)...)
+		case *ast.FragmentDefinition:
+			violations = append(violations, strictViolationsInSelections(def.SelectionSet)...)
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("strict mode: the following values must be passed as variables "+
+			"instead of inline literals: %s", strings.Join(violations, ", "))
+	}
+
+	return nil
+}
+
+func strictViolationsInSelections(set *ast.SelectionSet) []string {
+	if set == nil {
+		return nil
+	}
+
+	var violations []string
+	for _, sel := range set.Selections {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			for _, arg := range sel.Arguments {
+				name := arg.Name.Value
+				if _, ok := strictArguments[name]; ok && isLiteral(arg.Value) {
+					violations = append(violations, fmt.Sprintf("%s.%s", sel.Name.Value, name))
+					continue
+				}
+				violations = append(violations, strictViolationsInValue(sel.Name.Value+"."+name, arg.Value)...)
+			}
+			violations = append(violations, strictViolationsInSelections(sel.SelectionSet)...)
+		case *ast.InlineFragment:
+			violations = append(violations, strictViolationsInSelections(sel.SelectionSet)...)
+		}
+	}
+
+	return violations
+}
+
+func strictViolationsInValue(path string, value ast.Value) []string {
+	var violations []string
+
+	switch value := value.(type) {
+	case *ast.ObjectValue:
+		for _, field := range value.Fields {
+			name := field.Name.Value
+			fieldPath := path + "." + name
+			_, strict := strictArguments[name]
+			if (strict || isFilterValueField(name)) && isLiteral(field.Value) {
+				violations = append(violations, fieldPath)
+				continue
+			}
+			violations = append(violations, strictViolationsInValue(fieldPath, field.Value)...)
+		}
+	case *ast.ListValue:
+		for _, v := range value.Values {
+			violations = append(violations, strictViolationsInValue(path, v)...)
+		}
+	}
+
+	return violations
+}
+
+func isFilterValueField(name string) bool {
+	return strings.HasPrefix(name, "value") && len(name) > len("value")
+}
+
+// isLiteral returns true for any value which is not (entirely) a variable
+func isLiteral(value ast.Value) bool {
+	switch value := value.(type) {
+	case *ast.Variable:
+		return false
+	case *ast.ListValue:
+		for _, v := range value.Values {
+			if isLiteral(v) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
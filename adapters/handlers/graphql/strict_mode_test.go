@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStrict(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		violations []string
+	}{
+		{
+			name:  "no arguments",
+			query: `{ Get { Article { title } } }`,
+		},
+		{
+			name: "filter values as variables",
+			query: `query($title: TextGetObjectsArticlePath, $where: GetObjectsArticleWhereInpObj) {
+				Get { Article(where: {operator: And, operands: [
+					{path: ["title"], operator: Equal, valueText: $title},
+					$where
+				]}, limit: 10) { title } }
+			}`,
+		},
+		{
+			name:       "inline filter value",
+			query:      `{ Get { Article(where: {path: ["title"], operator: Equal, valueText: "foo"}) { title } } }`,
+			violations: []string{"Article.where.valueText"},
+		},
+		{
+			name: "nested operands and fragments",
+			query: `{ Get { ...articles } }
+				fragment articles on GetObjectsObj {
+					Article(where: {operator: Or, operands: [
+						{path: ["wordCount"], operator: GreaterThan, valueInt: 10},
+						{path: ["title"], operator: Equal, valueText: $title}
+					]}) { title }
+				}`,
+			violations: []string{"Article.where.operands.valueInt"},
+		},
+		{
+			name:       "inline search input",
+			query:      `{ Get { Article(bm25: {query: "foo"}, nearText: {concepts: ["bar", $baz]}) { title } } }`,
+			violations: []string{"Article.bm25.query", "Article.nearText.concepts"},
+		},
+		{
+			name:  "search input as variables",
+			query: `query($q: String, $c: [String]) { Get { Article(bm25: {query: $q}, nearText: {concepts: $c}) { title } } }`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateStrict(test.query)
+			if len(test.violations) == 0 {
+				assert.Nil(t, err)
+				return
+			}
+
+			if assert.NotNil(t, err) {
+				for _, v := range test.violations {
+					assert.Contains(t, err.Error(), v)
+				}
+			}
+		})
+	}
+}
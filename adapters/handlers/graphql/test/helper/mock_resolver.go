@@ -33,6 +33,8 @@ type MockResolver struct {
 	RootField     *graphql.Field
 	RootFieldName string
 	RootObject    map[string]interface{}
+	// Context is passed to the resolvers, context.Background() if nil
+	Context context.Context
 }
 
 var schemaBuildLock sync.Mutex
@@ -56,11 +58,15 @@ func (mr *MockResolver) Resolve(query string) *graphql.Result {
 		panic(err)
 	}
 
+	ctx := mr.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	result := graphql.Do(graphql.Params{
 		Schema:        schema,
 		RequestString: query,
 		RootObject:    mr.RootObject,
-		Context:       context.Background(),
+		Context:       ctx,
 	})
 
 	return result
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/networkacl"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// makeNetworkACLInterceptors apply the data rules of the network ACL to all
+// gRPC calls, the same rules which apply to the data endpoints of the REST
+// API. Health checks are always allowed, like the REST probes.
+func makeNetworkACLInterceptors(acl func() *networkacl.ACL, logger logrus.FieldLogger,
+) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	check := func(ctx context.Context, method string) error {
+		rules := acl().Data()
+		if rules.Empty() || method == grpc_health_v1.Health_Check_FullMethodName ||
+			method == grpc_health_v1.Health_Watch_FullMethodName {
+			return nil
+		}
+
+		var host string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			host = p.Addr.String()
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			}
+		}
+		if rules.Allows(net.ParseIP(host)) {
+			return nil
+		}
+
+		logger.WithFields(logrus.Fields{
+			"action":    "network_acl_denied",
+			"audit":     true,
+			"remote_ip": host,
+			"group":     networkacl.GroupData,
+			"method":    method,
+		}).Warn("request denied by network ACL")
+		return status.Error(codes.PermissionDenied, "access from this address is not allowed")
+	}
+
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	return unary, stream
+}
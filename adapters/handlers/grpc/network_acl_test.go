@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/networkacl"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestNetworkACLInterceptor(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	acl := networkacl.New(config.NetworkACL{
+		Admin: config.NetworkACLRules{Allow: "10.0.0.0/8"},
+		Data:  config.NetworkACLRules{Deny: "203.0.113.0/24"},
+	})
+	unary, _ := makeNetworkACLInterceptors(func() *networkacl.ACL { return acl }, logger)
+	call := func(remoteAddr, method string) error {
+		ctx := context.Background()
+		if remoteAddr != "" {
+			addr, err := net.ResolveTCPAddr("tcp", remoteAddr)
+			require.NoError(t, err)
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+		}
+		_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+		return err
+	}
+
+	assert.NoError(t, call("192.168.1.1:1234", "/weaviate.v1.Weaviate/Search"), "admin rules don't apply")
+	assert.NoError(t, call("203.0.113.5:1234", grpc_health_v1.Health_Check_FullMethodName))
+
+	err := call("203.0.113.5:1234", "/weaviate.v1.Weaviate/BatchObjects")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, "network_acl_denied", hook.LastEntry().Data["action"])
+	assert.Equal(t, "203.0.113.5", hook.LastEntry().Data["remote_ip"])
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("", "/weaviate.v1.Weaviate/Search")),
+		"calls without a peer address are denied")
+}
@@ -25,6 +25,7 @@ import (
 	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/networkacl"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip" // Install the gzip compressor
@@ -35,7 +36,7 @@ import (
 	v1 "github.com/weaviate/weaviate/adapters/handlers/grpc/v1"
 )
 
-func CreateGRPCServer(state *state.State) *GRPCServer {
+func CreateGRPCServer(state *state.State, acl func() *networkacl.ACL) *GRPCServer {
 	o := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(state.ServerConfig.Config.GRPC.MaxMsgSize),
 		grpc.MaxSendMsgSize(state.ServerConfig.Config.GRPC.MaxMsgSize),
@@ -52,7 +53,9 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		o = append(o, grpc.Creds(c))
 	}
 
-	var interceptors []grpc.UnaryServerInterceptor
+	aclUnary, aclStream := makeNetworkACLInterceptors(acl, state.Logger)
+	interceptors := []grpc.UnaryServerInterceptor{aclUnary}
+	o = append(o, grpc.ChainStreamInterceptor(aclStream))
 
 	// If sentry is enabled add automatic spans on gRPC requests
 	if state.ServerConfig.Config.Sentry.Enabled {
@@ -65,9 +68,7 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		interceptors = append(interceptors, makeMetricsInterceptor(state.Logger, state.Metrics))
 	}
 
-	if len(interceptors) > 0 {
-		o = append(o, grpc.ChainUnaryInterceptor(interceptors...))
-	}
+	o = append(o, grpc.ChainUnaryInterceptor(interceptors...))
 
 	s := grpc.NewServer(o...)
 	weaviateV0 := v0.NewService()
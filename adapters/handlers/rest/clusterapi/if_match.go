@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// contextWithIfMatch returns the context of a write request with the
+// precondition the coordinating node passed on in the If-Match header
+func contextWithIfMatch(r *http.Request) context.Context {
+	header, ok := r.Header["If-Match"]
+	if !ok {
+		return r.Context()
+	}
+	m := objects.ParseIfMatch(strings.Join(header, ","))
+	return objects.ContextWithIfMatch(r.Context(), m)
+}
@@ -61,6 +61,8 @@ type indices struct {
 	regexpReferences          *regexp.Regexp
 	regexpShardsQueueSize     *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
+	regexpShardTrash          *regexp.Regexp
+	regexpShardHistory        *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
 	regexpShardReinit         *regexp.Regexp
@@ -98,6 +100,10 @@ const (
 		`\/shards\/(` + sh + `)\/queuesize`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
+	urlPatternShardTrash = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/trash`
+	urlPatternShardHistory = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/history`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/files/(.*)`
 	urlPatternShard = `\/indices\/(` + cl + `)` +
@@ -140,6 +146,10 @@ type shards interface {
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string, schemaVersion uint64) error
+	TrashEntries(ctx context.Context, indexName, shardName string,
+		id strfmt.UUID) ([][]byte, error)
+	ObjectHistory(ctx context.Context, indexName, shardName string,
+		id strfmt.UUID) ([][]byte, error)
 
 	// Replication-specific
 	OverwriteObjects(ctx context.Context, indexName, shardName string,
@@ -177,6 +187,8 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled bool, lo
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsQueueSize:     regexp.MustCompile(urlPatternShardsQueueSize),
 		regexpShardsStatus:        regexp.MustCompile(urlPatternShardsStatus),
+		regexpShardTrash:          regexp.MustCompile(urlPatternShardTrash),
+		regexpShardHistory:        regexp.MustCompile(urlPatternShardHistory),
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
 		regexpShard:               regexp.MustCompile(urlPatternShard),
 		regexpShardReinit:         regexp.MustCompile(urlPatternShardReinit),
@@ -311,6 +323,20 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
+		case i.regexpShardTrash.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getTrashEntries().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case i.regexpShardHistory.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getObjectHistory().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 
 		case i.regexpShardFiles.MatchString(path):
 			if r.Method == http.MethodPost {
@@ -393,7 +419,11 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	if err := i.shards.PutObject(r.Context(), index, shard, obj, schemaVersion); err != nil {
+	if err := i.shards.PutObject(contextWithIfMatch(r), index, shard, obj, schemaVersion); err != nil {
+		if errors.As(err, &objects.ErrObjectChanged{}) {
+			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -625,7 +655,11 @@ func (i *indices) mergeObject() http.Handler {
 			return
 		}
 
-		if err = i.shards.MergeObject(r.Context(), index, shard, mergeDoc, schemaVersion); err != nil {
+		if err = i.shards.MergeObject(contextWithIfMatch(r), index, shard, mergeDoc, schemaVersion); err != nil {
+			if errors.As(err, &objects.ErrObjectChanged{}) {
+				http.Error(w, err.Error(), http.StatusPreconditionFailed)
+				return
+			}
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -1235,6 +1269,74 @@ func (i *indices) getGetShardStatus() http.Handler {
 	})
 }
 
+func (i *indices) getTrashEntries() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardTrash.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		id := strfmt.UUID(r.URL.Query().Get("id"))
+
+		defer r.Body.Close()
+
+		entries, err := i.shards.TrashEntries(r.Context(), index, shard, id)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		entriesBytes, err := IndicesPayloads.TrashEntries.Marshal(entries)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.TrashEntries.SetContentTypeHeader(w)
+		w.Write(entriesBytes)
+	})
+}
+
+func (i *indices) getObjectHistory() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardHistory.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		id := strfmt.UUID(r.URL.Query().Get("id"))
+
+		defer r.Body.Close()
+
+		versions, err := i.shards.ObjectHistory(r.Context(), index, shard, id)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		versionsBytes, err := IndicesPayloads.ObjectHistory.Marshal(versions)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ObjectHistory.SetContentTypeHeader(w)
+		w.Write(versionsBytes)
+	})
+}
+
 func (i *indices) postUpdateShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
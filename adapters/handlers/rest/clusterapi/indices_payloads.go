@@ -61,6 +61,8 @@ type indicesPayloads struct {
 	GetShardStatusResults     getShardStatusResultsPayload
 	UpdateShardStatusParams   updateShardStatusParamsPayload
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
+	TrashEntries              trashEntriesPayload
+	ObjectHistory             objectHistoryPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
 }
@@ -929,3 +931,53 @@ func (p shardFilesPayload) CheckContentTypeHeaderReq(r *http.Request) (string, b
 	ct := r.Header.Get("content-type")
 	return ct, ct == p.MIME()
 }
+
+type trashEntriesPayload struct{}
+
+func (p trashEntriesPayload) Unmarshal(in []byte) ([][]byte, error) {
+	var out [][]byte
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p trashEntriesPayload) Marshal(in [][]byte) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p trashEntriesPayload) MIME() string {
+	return "application/vnd.weaviate.trashentries+json"
+}
+
+func (p trashEntriesPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p trashEntriesPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type objectHistoryPayload struct{}
+
+func (p objectHistoryPayload) Unmarshal(in []byte) ([][]byte, error) {
+	var out [][]byte
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p objectHistoryPayload) Marshal(in [][]byte) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p objectHistoryPayload) MIME() string {
+	return "application/vnd.weaviate.objecthistory+json"
+}
+
+func (p objectHistoryPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p objectHistoryPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/tenantactivity"
+	"github.com/weaviate/weaviate/adapters/repos/blobs"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
@@ -58,6 +59,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/replication"
+	esync "github.com/weaviate/weaviate/entities/sync"
 	modstgazure "github.com/weaviate/weaviate/modules/backup-azure"
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
 	modstggcs "github.com/weaviate/weaviate/modules/backup-gcs"
@@ -87,10 +89,12 @@ import (
 	modcentroid "github.com/weaviate/weaviate/modules/ref2vec-centroid"
 	modrerankercohere "github.com/weaviate/weaviate/modules/reranker-cohere"
 	modrerankerdummy "github.com/weaviate/weaviate/modules/reranker-dummy"
+	modrerankerhuggingface "github.com/weaviate/weaviate/modules/reranker-huggingface"
 	modrerankerjinaai "github.com/weaviate/weaviate/modules/reranker-jinaai"
 	modrerankertransformers "github.com/weaviate/weaviate/modules/reranker-transformers"
 	modrerankervoyageai "github.com/weaviate/weaviate/modules/reranker-voyageai"
 	modsum "github.com/weaviate/weaviate/modules/sum-transformers"
+	modtextchunker "github.com/weaviate/weaviate/modules/text-chunker"
 	modspellcheck "github.com/weaviate/weaviate/modules/text-spellcheck"
 	modtext2vecaws "github.com/weaviate/weaviate/modules/text2vec-aws"
 	modt2vbigram "github.com/weaviate/weaviate/modules/text2vec-bigram"
@@ -114,6 +118,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/logging"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -124,6 +129,7 @@ import (
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/telemetry"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"github.com/weaviate/weaviate/usecases/traverser"
 
 	"github.com/getsentry/sentry-go"
@@ -312,10 +318,39 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Metrics = promMetrics
 	}
 
+	if budgetCfg := appState.ServerConfig.Config.CacheBudget; budgetCfg.Enabled() {
+		var observer memwatch.BudgetObserver
+		if appState.Metrics != nil {
+			observer = appState.Metrics
+		}
+		budget := memwatch.NewBudget(appState.MemWatch.Limit, budgetCfg.Percentage,
+			budgetCfg.Weights, observer)
+		appState.MemWatch.SetCacheBudget(budget)
+		budget.Start(10*time.Second, appState.Logger)
+	}
+
+	var lockObserver esync.HoldObserver
+	if appState.Metrics != nil {
+		lockObserver = appState.Metrics
+	}
+	appState.LockMonitor = esync.NewLockMonitor(lockObserver)
+	if threshold := appState.ServerConfig.Config.LockWatchdogThreshold; threshold > 0 {
+		enterrors.GoWrapper(func() {
+			appState.LockMonitor.Watch(context.Background(), threshold,
+				min(threshold, 10*time.Second), appState.Logger)
+		}, appState.Logger)
+	}
+
 	// TODO: configure http transport for efficient intra-cluster comm
 	remoteIndexClient := clients.NewRemoteIndex(appState.ClusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
+	masterKey, previousMasterKey, err := appState.ServerConfig.Config.DataEncryption.Keys()
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid data encryption keys")
+	}
 	repo, err := db.New(appState.Logger, db.Config{
 		ServerVersion:                  config.ServerVersion,
 		GitHash:                        build.Revision,
@@ -325,10 +360,12 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		MemtablesMinActiveSeconds:      appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
 		MemtablesMaxActiveSeconds:      appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
 		SegmentsCleanupIntervalSeconds: appState.ServerConfig.Config.Persistence.LSMSegmentsCleanupIntervalSeconds,
+		SegmentsScrubIntervalSeconds:   appState.ServerConfig.Config.Persistence.LSMSegmentsScrubIntervalSeconds,
 		SeparateObjectsCompactions:     appState.ServerConfig.Config.Persistence.LSMSeparateObjectsCompactions,
 		MaxSegmentSize:                 appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
 		HNSWMaxLogSize:                 appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
 		HNSWWaitForCachePrefill:        appState.ServerConfig.Config.HNSWStartupWaitForVectorCache,
+		WarmUp:                         appState.ServerConfig.Config.WarmUp,
 		HNSWFlatSearchConcurrency:      appState.ServerConfig.Config.HNSWFlatSearchConcurrency,
 		VisitedListPoolMaxSize:         appState.ServerConfig.Config.HNSWVisitedListPoolMaxSize,
 		RootPath:                       appState.ServerConfig.Config.Persistence.DataPath,
@@ -341,13 +378,22 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		AvoidMMap:                      appState.ServerConfig.Config.AvoidMmap,
 		DisableLazyLoadShards:          appState.ServerConfig.Config.DisableLazyLoadShards,
 		ForceFullReplicasSearch:        appState.ServerConfig.Config.ForceFullReplicasSearch,
+		QueryEarlyTerminationCertainty: appState.ServerConfig.Config.QueryEarlyTerminationCertainty,
+		SoftDeleteRetention:            appState.ServerConfig.Config.SoftDeleteRetention,
+		ObjectHistoryVersions:          appState.ServerConfig.Config.ObjectHistoryVersions,
+		LockMonitor:                    appState.LockMonitor,
+		EncryptionMasterKey:            masterKey,
+		EncryptionPreviousMasterKey:    previousMasterKey,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
 		// longer start up if the required minimum is now higher than 1. We want
 		// the required minimum to only apply to newly created classes - not block
 		// loading existing ones.
-		Replication: replication.GlobalConfig{MinimumFactor: 1},
+		Replication: replication.GlobalConfig{
+			MinimumFactor: 1,
+			ReadRouting:   appState.ServerConfig.Config.Replication.ReadRouting,
+		},
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics, appState.MemWatch) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -356,6 +402,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	}
 
 	appState.DB = repo
+	appState.ObjectEvents = objects.NewEvents()
+	repo.SetObjectEvents(appState.ObjectEvents)
 	if appState.ServerConfig.Config.Monitoring.Enabled {
 		appState.TenantActivity.SetSource(appState.DB)
 	}
@@ -502,6 +550,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		FQDNResolverTLD:        appState.ServerConfig.Config.Raft.FQDNResolverTLD,
 		SentryEnabled:          appState.ServerConfig.Config.Sentry.Enabled,
 		ClassTenantDataEvents:  classTenantDataEvents,
+		Roles:                  appState.Roles,
+		APIKeys:                appState.APIKey,
 	}
 	for _, name := range appState.ServerConfig.Config.Raft.Join[:rConfig.BootstrapExpect] {
 		if strings.Contains(name, rConfig.NodeID) {
@@ -512,6 +562,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	appState.ClusterService = rCluster.New(rConfig)
 	migrator.SetCluster(appState.ClusterService.Raft)
+	appState.Roles.SetCluster(appState.ClusterService.Raft)
+	appState.APIKey.SetCluster(appState.ClusterService.Raft)
 
 	executor := schema.NewExecutor(migrator,
 		appState.ClusterService.SchemaReader(),
@@ -540,8 +592,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
 	appState.RemoteReplicaIncoming = replica.NewRemoteReplicaIncoming(repo, appState.ClusterService.SchemaReader())
 
+	sealer, err := backupSealer(appState.ServerConfig.Config.Backup)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid backup encryption key")
+	}
 	backupManager := backup.NewHandler(appState.Logger, appState.Authorizer,
-		schemaManager, repo, appState.Modules)
+		schemaManager, repo, appState.Modules, sealer)
 	appState.BackupManager = backupManager
 
 	enterrors.GoWrapper(func() { clusterapi.Serve(appState) }, appState.Logger)
@@ -554,9 +612,11 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
 		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+	appState.Traverser.ObjectEvents = appState.ObjectEvents
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState)
 	executor.RegisterSchemaUpdateCallback(updateSchemaCallback)
+	executor.RegisterSchemaUpdateCallback(appState.ObjectEvents.SchemaChanges())
 
 	// while we accept an overall longer startup, e.g. due to a recovery, we
 	// still want to limit the module startup context, as that's mostly service
@@ -584,9 +644,21 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	// this sleep was used to block GraphQL and give time to RAFT to start.
 	time.Sleep(2 * time.Second)
 
+	if appState.ServerConfig.Config.SchemaTemplatesPath != "" {
+		enterrors.GoWrapper(func() { syncSchemaTemplates(appState) }, appState.Logger)
+	}
+
 	batchManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Metrics)
+	batchManager.Events = appState.ObjectEvents
+	err = batchManager.PersistBatchJobs(filepath.Join(
+		appState.ServerConfig.Config.Persistence.DataPath, "batch_jobs"))
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not load batch jobs")
+	}
 	appState.BatchManager = batchManager
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
@@ -639,6 +711,21 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	return appState
 }
 
+// syncSchemaTemplates adds properties which were added to the schema
+// templates since the last start, once the schema is ready
+func syncSchemaTemplates(appState *state.State) {
+	for !appState.ClusterService.Ready() {
+		time.Sleep(time.Second)
+	}
+
+	if err := appState.SchemaManager.SyncTemplates(context.Background()); err != nil {
+		appState.Logger.
+			WithField("action", "schema_templates_sync").
+			WithError(err).
+			Error("could not sync schema templates")
+	}
+}
+
 func parseNode2Port(appState *state.State) (m map[string]int, err error) {
 	m = make(map[string]int, len(appState.ServerConfig.Config.Raft.Join))
 	for _, raftNamePort := range appState.ServerConfig.Config.Raft.Join {
@@ -680,6 +767,15 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.ServeError = openapierrors.ServeError
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	// streamed batches are decoded line by line by their handler
+	api.RegisterConsumer("application/x-ndjson", runtime.ByteStreamConsumer())
+	// streamed GraphQL batches are written by their handler, errors before
+	// streaming starts are plain json
+	api.RegisterProducer("application/x-ndjson", runtime.JSONProducer())
+	// multipart forms are parsed when the parameters are bound, files are
+	// read by the handler
+	api.RegisterConsumer("multipart/form-data", runtime.DiscardConsumer)
+	api.RegisterProducer(runtime.DefaultMime, runtime.ByteStreamProducer())
 
 	api.OidcAuth = composer.New(
 		appState.ServerConfig.Config.Authentication,
@@ -693,12 +789,21 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Authorizer,
 		appState.Logger, appState.Modules)
 
-	setupAuthZHandlers(api, appState.Metrics, appState.Authorizer, appState.Logger)
+	setupAuthZHandlers(api, appState.Metrics, appState.Authorizer, appState.Roles, appState.APIKey, appState.Logger)
 	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
 	objectsManager := objects.NewManager(appState.Locks,
 		appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch)
+	objectsManager.Events = appState.ObjectEvents
+	blobStore, err := blobs.NewLocal(appState.ServerConfig.Config.BlobStorage.Path)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not create blob storage")
+	}
+	objectsManager.Blobs = blobStore
+	appState.BatchManager.Blobs = blobStore
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
@@ -711,9 +816,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
 
-	grpcServer := createGrpcServer(appState)
-	setupMiddlewares := makeSetupMiddlewares(appState)
-	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState, api.Context())
+	runtimeMiddlewares := newRuntimeMiddlewares(appState.ServerConfig.Config.RuntimeSettings())
+	grpcServer := createGrpcServer(appState, runtimeMiddlewares)
+	setupMiddlewares := makeSetupMiddlewares(appState, runtimeMiddlewares, api.OidcAuth)
+	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState, runtimeMiddlewares, api.Context(), api.OidcAuth)
+	reloadCtx, stopReload := context.WithCancel(context.Background())
+	startConfigReload(reloadCtx, appState, runtimeMiddlewares, connectorOptionGroup)
+	addGraphQLSubscriptions := makeAddGraphQLSubscriptions(appState,
+		appState.ServerConfig.Config.DisableGraphQL, api.OidcAuth,
+		appState.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
+		appState.Authorizer, setupMiddlewares, appState.Logger)
 
 	telemeter := telemetry.New(appState.DB, appState.SchemaManager, appState.Logger)
 	if telemetryEnabled(appState) {
@@ -725,6 +837,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			}
 		}, appState.Logger)
 	}
+	usageReporter := telemetry.NewUsageReporter(appState.ServerConfig.Config.UsageReporting,
+		appState.DB, appState.Modules, appState.Logger)
+	usageReporter.Start()
+	setupUsageReportingDebugHandlers(usageReporter, appState.Logger)
 	enterrors.GoWrapper(
 		func() {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
@@ -732,6 +848,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			backupScheduler.CleanupUnfinishedBackups(ctx)
 		}, appState.Logger)
 	api.ServerShutdown = func() {
+		stopReload()
+
 		if telemetryEnabled(appState) {
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			defer cancel()
@@ -744,6 +862,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			}
 		}
 
+		usageCtx, usageCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer usageCancel()
+		if err := usageReporter.Stop(usageCtx); err != nil {
+			appState.Logger.WithField("action", "stop_usage_reporting").
+				Errorf("failed to stop usage reporting: %s", err.Error())
+		}
+
 		// stop reindexing on server shutdown
 		appState.ReindexCtxCancel()
 
@@ -754,6 +879,17 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			sentry.Flush(2 * time.Second)
 		}
 
+		for _, sink := range appState.LogSinks {
+			sink.Close()
+		}
+
+		tracingCtx, tracingCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer tracingCancel()
+		if err := appState.StopTracing(tracingCtx); err != nil {
+			appState.Logger.WithField("action", "stop_tracing").WithError(err).
+				Error("failed to flush traces")
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
@@ -764,7 +900,17 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	startGrpcServer(grpcServer, appState)
 
-	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
+	return setupGlobalMiddleware(addGraphQLSubscriptions(api.Serve(setupMiddlewares)))
+}
+
+// backupSealer returns the sealer protecting backups or nil if no
+// encryption key is configured
+func backupSealer(cfg config.Backup) (*backup.Sealer, error) {
+	key, err := cfg.Key()
+	if err != nil || key == nil {
+		return nil, err
+	}
+	return backup.NewSealer(key)
 }
 
 func startBackupScheduler(appState *state.State) *backup.Scheduler {
@@ -775,7 +921,9 @@ func startBackupScheduler(appState *state.State) *backup.Scheduler {
 		membership{appState.Cluster, appState.ClusterService},
 		appState.SchemaManager,
 		appState.Logger)
-	return backupScheduler
+	// the key was validated when the backup handler was created
+	sealer, _ := backupSealer(appState.ServerConfig.Config.Backup)
+	return backupScheduler.WithSealer(sealer)
 }
 
 // TODO: Split up and don't write into global variables. Instead return an appState
@@ -796,6 +944,24 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 		logger.WithField("action", "startup").WithError(err).Error("could not load config")
 		logger.Exit(1)
 	}
+
+	// the log level may also be set in the config file
+	logger.SetLevel(parseLogLevel(serverConfig.Config.LogLevel))
+
+	logSinks, err := logging.AttachSinks(logger, serverConfig.Config.Logging)
+	if err != nil {
+		logger.WithField("action", "startup").WithError(err).Error("could not attach log sinks")
+		logger.Exit(1)
+	}
+	appState.LogSinks = logSinks
+
+	stopTracing, err := tracing.Init(ctx, serverConfig.Config.Tracing)
+	if err != nil {
+		logger.WithField("action", "startup").WithError(err).Error("could not initialize tracing")
+		logger.Exit(1)
+	}
+	appState.StopTracing = stopTracing
+
 	dataPath := serverConfig.Config.Persistence.DataPath
 	if err := os.MkdirAll(dataPath, 0o777); err != nil {
 		logger.WithField("action", "startup").
@@ -874,24 +1040,7 @@ func logger() *logrus.Logger {
 	if os.Getenv("LOG_FORMAT") != "text" {
 		logger.SetFormatter(NewWeaviateJSONFormatter())
 	}
-	switch os.Getenv("LOG_LEVEL") {
-	case "panic":
-		logger.SetLevel(logrus.PanicLevel)
-	case "fatal":
-		logger.SetLevel(logrus.FatalLevel)
-	case "error":
-		logger.SetLevel(logrus.ErrorLevel)
-	case "warn":
-		logger.SetLevel(logrus.WarnLevel)
-	case "warning":
-		logger.SetLevel(logrus.WarnLevel)
-	case "debug":
-		logger.SetLevel(logrus.DebugLevel)
-	case "trace":
-		logger.SetLevel(logrus.TraceLevel)
-	default:
-		logger.SetLevel(logrus.InfoLevel)
-	}
+	logger.SetLevel(parseLogLevel(os.Getenv("LOG_LEVEL")))
 
 	return logger
 }
@@ -946,6 +1095,7 @@ func registerModules(appState *state.State) error {
 		modrerankercohere.Name,
 		modrerankervoyageai.Name,
 		modrerankerjinaai.Name,
+		modrerankerhuggingface.Name,
 	}
 
 	defaultModules := append(defaultVectorizers, defaultGenerative...)
@@ -1039,6 +1189,22 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modrerankerhuggingface.Name]; ok {
+		appState.Modules.Register(modrerankerhuggingface.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modrerankerhuggingface.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modtextchunker.Name]; ok {
+		appState.Modules.Register(modtextchunker.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtextchunker.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules[modqna.Name]; ok {
 		appState.Modules.Register(modqna.New())
 		appState.Logger.
@@ -1373,6 +1539,8 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	appState.Modules.SetBudgets(appState.ServerConfig.Config.ModuleBudgets)
+
 	appState.Logger.
 		WithField("action", "startup").
 		Debug("completed registering modules")
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/traverser"
@@ -42,6 +43,8 @@ var configureServer func(*http.Server, string, string)
 
 func makeUpdateSchemaCall(appState *state.State) func(schema.Schema) {
 	return func(updatedSchema schema.Schema) {
+		appState.SetSchemaHash(schema.Hash(updatedSchema.Objects))
+
 		if appState.ServerConfig.Config.DisableGraphQL {
 			return
 		}
@@ -106,8 +109,18 @@ func configureAnonymousAccess(appState *state.State) *anonymous.Client {
 	return anonymous.New(appState.ServerConfig.Config)
 }
 
+// configureAuthorizer also sets up the role manager. It's set up even if rbac
+// is disabled, as roles are replicated to every node through RAFT. Principals
+// limited to a set of collections by their API key are only allowed on those
+// collections, regardless of the authorizer.
 func configureAuthorizer(appState *state.State) authorization.Authorizer {
-	return authorization.New(appState.ServerConfig.Config)
+	cfg := appState.ServerConfig.Config
+	appState.Roles = rbac.New(cfg.Authorization.Rbac)
+	if !cfg.Authorization.Rbac.Enabled {
+		return authorization.Scoped(authorization.New(cfg))
+	}
+
+	return authorization.Scoped(appState.Roles)
 }
 
 func timeTillDeadline(ctx context.Context) string {
@@ -1145,7 +1145,214 @@ func init() {
         ]
       }
     },
+    "/batch/jobs": {
+      "post": {
+        "description": "Create new objects in bulk in the background. \u003cbr/\u003e\u003cbr/\u003eThe objects are validated and imported just like on ` + "`" + `POST /batch/objects` + "`" + `, but the request returns as soon as the job is started. The status of the job and the result of every object can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + ` until a while after the job finished. \u003cbr/\u003e\u003cbr/\u003eJobs are kept on the node which started them, so their status can only be queried on that node. Jobs which are still running when the node restarts fail. At most 16 jobs can run on a node at the same time, further jobs are rejected with status 429.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects as a batch in the background.",
+        "operationId": "batch.jobs.create",
+        "parameters": [
+          {
+            "description": "The objects to be imported.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Object"
+              }
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Job started, use its id to query its status.",
+            "schema": {
+              "$ref": "#/definitions/BatchJob"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/batch/jobs/{id}": {
+      "get": {
+        "description": "Get the status of a batch job, and the result of every object it processed so far.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Get the status of a batch job.",
+        "operationId": "batch.jobs.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the job.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the job, returned as body.",
+            "schema": {
+              "$ref": "#/definitions/BatchJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Job does not exist, or finished too long ago."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
     "/batch/objects": {
+      "put": {
+        "description": "Replace existing objects in bulk. \u003cbr/\u003e\u003cbr/\u003eEvery object needs its id. Meta-data and schema values are validated just like on ` + "`" + `POST /batch/objects` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eObjects which don't exist are not created, but fail individually.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Replaces existing Objects as a batch.",
+        "operationId": "batch.objects.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
       "post": {
         "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
         "tags": [
@@ -1300,6 +1507,75 @@ func init() {
         ]
       }
     },
+    "/batch/objects/stream": {
+      "post": {
+        "description": "Create new objects in bulk from a stream of newline-delimited JSON (NDJSON), one object per line. \\u003cbr/\\u003e\\u003cbr/\\u003eObjects are imported in sub-batches as they arrive, so the request body is never held in memory as a whole. Meta-data and schema values are validated. \\u003cbr/\\u003e\\u003cbr/\\u003eThe response summarizes the import and lists the objects which failed, identified by their line. Lines which cannot be parsed fail individually and don't stop the import.",
+        "consumes": [
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects from a stream of newline-delimited JSON.",
+        "operationId": "batch.objects.stream",
+        "parameters": [
+          {
+            "description": "Newline-delimited JSON, one object per line",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request body was consumed, see response body to get the objects which could not be imported.",
+            "schema": {
+              "$ref": "#/definitions/BatchStreamResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
     "/batch/references": {
       "post": {
         "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
@@ -1574,7 +1850,11 @@ func init() {
     },
     "/graphql/batch": {
       "post": {
-        "description": "Perform a batched GraphQL query",
+        "description": "Perform a batched GraphQL query. \\u003cbr/\\u003e\\u003cbr/\\u003eWith an Accept header of application/x-ndjson the responses are streamed as newline-delimited JSON instead, one GraphQLResponse per query with an additional index field holding the position of the query in the batch. Each response is written as soon as its query finishes, so the responses arrive out of order.",
+        "produces": [
+          "application/json",
+          "application/x-ndjson"
+        ],
         "tags": [
           "graphql"
         ],
@@ -1630,15 +1910,123 @@ func init() {
         ]
       }
     },
-    "/meta": {
-      "get": {
-        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+    "/keys/{id}/revoke": {
+      "post": {
+        "description": "Adds the current token of the API key to the revocation list. Requests using a revoked token are rejected. Rotate the key to issue a new token.",
         "tags": [
-          "meta"
+          "authz"
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
-        "responses": {
+        "summary": "Revoke an API key",
+        "operationId": "revokeKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "API key ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Key revoked successfully",
+            "schema": {
+              "$ref": "#/definitions/ApiKey"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no key found"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.revoke.key"
+        ]
+      }
+    },
+    "/keys/{id}/rotate": {
+      "post": {
+        "description": "Issues a new token for the API key and revokes the current one. The user the key belongs to, and therefore its roles, stay unchanged. The new token is only returned in this response.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Rotate an API key",
+        "operationId": "rotateKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "API key ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Key rotated successfully, the response contains the new token",
+            "schema": {
+              "$ref": "#/definitions/ApiKey"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no key found"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.rotate.key"
+        ]
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
@@ -1668,6 +2056,42 @@ func init() {
         ]
       }
     },
+    "/modules": {
+      "get": {
+        "description": "Returns the enabled modules, their capabilities, the module config defaults they set and the health of their upstream inference services. Can be used to verify a deployment before relying on module-specific query arguments.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "List the enabled modules.",
+        "operationId": "modules.list",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ModulesListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
     "/nodes": {
       "get": {
         "description": "Returns node information for the entire database.",
@@ -1920,27 +2344,35 @@ func init() {
         ]
       }
     },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
+    "/objects/.deleted": {
+      "get": {
+        "description": "Lists the soft deleted objects of a collection which can still be restored. \u003cbr/\u003e\u003cbr/\u003eDeleted objects are only kept in the trash if soft delete is enabled by setting ` + "`" + `SOFT_DELETE_RETENTION` + "`" + `, and are purged once the retention expires. The time of deletion is returned as additional property ` + "`" + `deletionTimeUnix` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eThe trash is kept on the nodes of a shard and is not replicated, the shards of all nodes are listed from the replica owning the shard.",
         "tags": [
           "objects"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "List soft deleted objects of a class.",
+        "operationId": "objects.trash.list",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "description": "The collection to list the trash of.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1952,7 +2384,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1967,18 +2399,18 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query"
         ]
       }
     },
-    "/objects/{className}/{id}": {
+    "/objects/by/{className}/{propertyName}/{value}": {
       "get": {
-        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
+        "description": "Get the data object of a collection whose property is equal to the given value, e.g. an external ID stored on the object. \u003cbr/\u003e\u003cbr/\u003eThe lookup uses the inverted index of the property, just like a Get query with an Equal where filter, so the property needs to be indexed. Text properties need ` + "`" + `field` + "`" + ` tokenization, so that their value is matched as a whole. Properties are not unique, a value matching more than one object is rejected.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Get an object based on its collection and the value of one of its properties.",
+        "operationId": "objects.class.lookup",
         "parameters": [
           {
             "type": "string",
@@ -1988,20 +2420,20 @@ func init() {
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "description": "Name of the property to look up the object by.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "type": "string",
+            "description": "Value of the property, parsed according to the data type of the property. Slashes in the value need to be URL-encoded as %2F.",
+            "name": "value",
+            "in": "path",
+            "required": true
           },
           {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -2014,12 +2446,6 @@ func init() {
               "$ref": "#/definitions/Object"
             }
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -2050,48 +2476,44 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.query"
         ]
-      },
-      "put": {
-        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
+      }
+    },
+    "/objects/multipart": {
+      "post": {
+        "description": "Create a new object and upload files to its blob properties in a single multipart request. \u003cbr/\u003e\u003cbr/\u003eThe object is sent as JSON in the form field ` + "`" + `object` + "`" + `. Every other form field is a file, which is stored in the blob storage of the server. The name of the field is the name of the blob property the file is attached to. \u003cbr/\u003e\u003cbr/\u003eThe blob properties of the created object hold references to the stored files, which can be downloaded with GET /objects/{className}/{id}/blobs/{propertyName}.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "objects"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Create a new object with files attached to its blob properties.",
+        "operationId": "objects.create.multipart",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
+            "description": "The object to create as JSON, without the contents of the uploaded files.",
+            "name": "object",
+            "in": "formData",
             "required": true
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Object created.",
             "schema": {
               "$ref": "#/definitions/Object"
             }
           },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -2101,9 +2523,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -2120,47 +2539,31 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
-      },
-      "delete": {
-        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
+      }
+    },
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2171,11 +2574,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2187,23 +2587,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
-      },
-      "head": {
-        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
+      }
+    },
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2211,21 +2612,42 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
           },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
+          {
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Entity tag of the object. Pass it as If-Match to updates to only apply them if the object is unchanged."
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2237,7 +2659,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
             "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
@@ -2252,23 +2674,22 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "put": {
+        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2282,25 +2703,28 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the update if the object matches one of the entity tags, as returned in the ETag header when getting the object, or if it exists for *. Otherwise 412 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
+          "200": {
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -2315,8 +2739,14 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The object does not match the If-Match header, it was updated since.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2333,20 +2763,17 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace **all** references in cross-reference property of an object.",
+      },
+      "delete": {
+        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2359,21 +2786,6 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -2382,8 +2794,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
+          "204": {
+            "description": "Successfully deleted."
           },
           "400": {
             "description": "Malformed request.",
@@ -2401,10 +2813,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2416,19 +2828,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "post": {
-        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
+      "head": {
+        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
         "parameters": [
           {
             "type": "string",
@@ -2440,26 +2852,11 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object",
             "name": "id",
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -2468,14 +2865,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully added the reference."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2487,10 +2878,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Object doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2502,19 +2893,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
         "parameters": [
           {
             "type": "string",
@@ -2526,39 +2917,35 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object to update.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "type": "string",
+            "description": "Only apply the update if the object matches one of the entity tags, as returned in the ETag header when getting the object, or if it exists for *. Otherwise 412 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
+            "description": "Successfully applied. No content provided."
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "The patch-JSON is malformed.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2573,13 +2960,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object does not match the If-Match header, it was updated since.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2598,16 +2988,25 @@ func init() {
         ]
       }
     },
-    "/objects/{id}": {
+    "/objects/{className}/{id}/blobs/{propertyName}": {
       "get": {
-        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
+        "description": "Download the file which was uploaded to a blob property of an object with POST /objects/multipart.",
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Download a file attached to a blob property of an object.",
+        "operationId": "objects.class.blob.get",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2617,20 +3016,24 @@ func init() {
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "type": "string",
+            "description": "Name of the blob property.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The contents of the file.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "file"
             }
           },
           "401": {
@@ -2643,7 +3046,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The object does not exist or no file was uploaded to the property."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2657,16 +3066,23 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.query"
         ]
-      },
-      "put": {
-        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/objects/{className}/{id}/history": {
+      "get": {
+        "description": "Lists the prior versions of an object, latest first. \u003cbr/\u003e\u003cbr/\u003eVersions are only kept if object history is enabled by setting ` + "`" + `OBJECT_HISTORY_VERSIONS` + "`" + `, which is the number of versions kept per object. They are dropped when the object is deleted.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "List the prior versions of an object based on its class and UUID.",
+        "operationId": "objects.class.history",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2676,22 +3092,17 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ObjectsListResponse"
             }
           },
           "401": {
@@ -2703,11 +3114,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2722,18 +3130,26 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      },
-      "delete": {
-        "description": "Deletes an object from the database based on its UUID.",
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace **all** references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2742,6 +3158,21 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -2750,8 +3181,14 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2763,7 +3200,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2772,68 +3215,27 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "head": {
-        "description": "Checks if an object exists in the system based on its UUID.",
+      "post": {
+        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
         "tags": [
           "objects"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "description": "The class name as defined in the schema",
+            "name": "className",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Object exists."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
           },
-          "404": {
-            "description": "Object doesn't exist."
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.objects.check"
-        ]
-      },
-      "patch": {
-        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
-        "parameters": [
           {
             "type": "string",
             "format": "uuid",
@@ -2843,23 +3245,36 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
+          "200": {
+            "description": "Successfully added the reference."
           },
           "400": {
-            "description": "The patch-JSON is malformed."
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2871,10 +3286,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2891,18 +3306,22 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references in cross-reference property of an object.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2923,16 +3342,25 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/SingleRef"
             }
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2943,6 +3371,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -2961,38 +3395,33 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
+      }
+    },
+    "/objects/{className}/{id}/restore": {
       "post": {
-        "description": "Add a cross-reference.",
+        "description": "Restores a soft deleted object from the trash as it was when it was deleted. \u003cbr/\u003e\u003cbr/\u003eCreating an object with the same UUID also removes it from the trash, so an object can only be restored while no object with its UUID exists.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "summary": "Restore a soft deleted object based on its class and UUID.",
+        "operationId": "objects.class.restore",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -3000,7 +3429,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successfully restored.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3011,8 +3443,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The object is not in the trash."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3029,14 +3464,16 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
         "deprecated": true,
         "parameters": [
           {
@@ -3048,80 +3485,21 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found.",
+          "200": {
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "400": {
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      }
-    },
-    "/schema": {
-      "get": {
-        "description": "Fetch an array of all collection definitions from the schema.",
-        "tags": [
-          "schema"
-        ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successfully dumped the database schema.",
-            "schema": {
-              "$ref": "#/definitions/Schema"
-            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3132,6 +3510,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3139,32 +3520,46 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
+      "put": {
+        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
         "parameters": [
           {
-            "name": "objectClass",
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -3176,8 +3571,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3189,39 +3587,39 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}": {
-      "get": {
+      },
+      "delete": {
+        "description": "Deletes an object from the database based on its UUID.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the Class, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3233,7 +3631,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist"
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3242,39 +3640,33 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Add a property to an existing collection.",
+      "head": {
+        "description": "Checks if an object exists in the system based on its UUID.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3286,16 +3678,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Object doesn't exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3304,34 +3687,47 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.objects.check"
         ]
       },
-      "delete": {
-        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
+      "patch": {
+        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Removed the Object class from the schema."
+          "204": {
+            "description": "Successfully applied. No content provided."
           },
           "400": {
-            "description": "Could not delete the Object class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "The patch-JSON is malformed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3342,6 +3738,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3349,23 +3754,36 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references in cross-reference property of an object.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
             "required": true
           },
           {
@@ -3373,16 +3791,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/MultipleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3394,7 +3812,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3406,38 +3824,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}/shards": {
-      "get": {
-        "description": "Get the status of every shard in the cluster.",
+      },
+      "post": {
+        "description": "Add a cross-reference.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "query"
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3448,8 +3879,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3461,29 +3892,33 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update a shard status.",
-        "operationId": "schema.objects.shards.update",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "shardName",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
@@ -3492,16 +3927,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Shard status was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3513,13 +3948,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
+            "description": "Successful query result but no resource was found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3531,26 +3960,22 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/schema": {
       "get": {
-        "description": "get all tenants from a specific class",
+        "description": "Fetch an array of all collection definitions from the schema.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the list of tenants.",
-        "operationId": "tenants.get",
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "boolean",
             "default": true,
@@ -3561,11 +3986,14 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Successfully dumped the database schema.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
+              "$ref": "#/definitions/Schema"
+            },
+            "headers": {
+              "X-Weaviate-Schema-Hash": {
+                "type": "string",
+                "description": "Hash of the current schema. Pass it as If-Match to schema changes to only apply them if the schema is unchanged."
               }
             }
           },
@@ -3578,54 +4006,45 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       },
-      "put": {
-        "description": "Update tenant of a specific class",
+      "post": {
+        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a tenant.",
-        "operationId": "tenants.update",
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
+          },
+          {
+            "type": "string",
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Updated tenants of the specified class",
+            "description": "Added the new Object class to the schema.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3637,8 +4056,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A class with the same name already exists, or the schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid Object class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3649,42 +4074,41 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/import": {
       "post": {
-        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
+        "description": "Add all classes of a schema, as returned by ` + "`" + `GET /v1/schema` + "`" + `, e.g. to migrate a schema between instances. All classes are validated, including cross-references between them, before any class is added. If adding a class fails, the classes added before are removed again, so that either all or none of the classes are added.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new tenant",
-        "operationId": "tenants.create",
+        "summary": "Import a previously dumped schema.",
+        "operationId": "schema.import",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "schema",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Schema"
             }
+          },
+          {
+            "type": "string",
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added new tenants to the specified class",
+            "description": "Added all classes of the schema.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Schema"
             }
           },
           "401": {
@@ -3696,8 +4120,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A class with the same name as one of the imported classes already exists, or the schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid schema. None of the classes have been added.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3708,36 +4138,36 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/validate": {
+      "post": {
+        "description": "Compare a proposed schema, in the format returned by ` + "`" + `GET /v1/schema` + "`" + `, with the current schema. The response lists the added, removed and changed classes and properties, as well as all validation errors, e.g. invalid module configuration or changes to immutable settings. Nothing is applied. Removed classes are only reported, they are never validation errors, since classes have to be deleted explicitly.",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.delete",
+        "summary": "Validate a proposed schema without applying it.",
+        "operationId": "schema.validate",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "tenants",
+            "name": "schema",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/Schema"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "The differences between the current and the proposed schema. Check ` + "`" + `valid` + "`" + ` and ` + "`" + `errors` + "`" + ` to see whether the proposed schema could be applied.",
+            "schema": {
+              "$ref": "#/definitions/SchemaDiff"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3749,7 +4179,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "The proposed schema could not be read, e.g. because it contains a class without a name.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3760,17 +4190,19 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "/schema/{className}/tenants/{tenantName}": {
+    "/schema/{className}": {
       "get": {
-        "description": "get a specific tenant for the given class",
         "tags": [
           "schema"
         ],
-        "summary": "Get a specific tenant",
-        "operationId": "tenants.get.one",
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
@@ -3778,25 +4210,78 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the Class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Add a property to an existing collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
           {
             "type": "string",
-            "name": "tenantName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
             "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "load the tenant given the specified class",
+            "description": "Class was updated successfully",
             "schema": {
-              "$ref": "#/definitions/TenantResponse"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3809,10 +4294,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Tenant not found"
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Invalid tenant or class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3823,15 +4317,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       },
-      "head": {
-        "description": "Check if a tenant exists for a specific class",
+      "delete": {
+        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Check whether a tenant exists",
-        "operationId": "tenant.exists",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
@@ -3841,21 +4338,83 @@ func init() {
           },
           {
             "type": "string",
-            "name": "tenantName",
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
             "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "The tenant exists in the specified class"
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3866,11 +4425,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "The tenant not found"
+          "409": {
+            "description": "A property with the same name already exists, or the schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3881,1359 +4443,1395 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "(Response only) Additional meta information about a single object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "BackupConfig": {
-      "description": "Backup custom configuration",
-      "type": "object",
-      "properties": {
-        "Bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "CPUPercentage": {
-          "description": "Desired CPU core utilization ranging from 1%-80%",
-          "type": "integer",
-          "default": 50,
-          "maximum": 80,
-          "minimum": 1,
-          "x-nullable": false
-        },
-        "ChunkSize": {
-          "description": "Aimed chunk size, with a minimum of 2MB, default of 128MB, and a maximum of 512MB. The actual chunk size may vary.",
-          "type": "integer",
-          "default": 128,
-          "maximum": 512,
-          "minimum": 2,
-          "x-nullable": false
-        },
-        "CompressionLevel": {
-          "description": "compression level used by compression algorithm",
-          "type": "string",
-          "default": "DefaultCompression",
-          "enum": [
-            "DefaultCompression",
-            "BestSpeed",
-            "BestCompression"
-          ],
-          "x-nullable": false
-        },
-        "Endpoint": {
-          "description": "name of the endpoint, e.g. s3.amazonaws.com",
-          "type": "string"
         },
-        "Path": {
-          "description": "Path or key within the bucket",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object",
-          "$ref": "#/definitions/BackupConfig"
-        },
-        "exclude": {
-          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "id": {
-          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "include": {
-          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/properties/{propertyName}/lifecycle": {
+      "put": {
+        "description": "Deprecate, tombstone or reactivate a property. \u003cbr/\u003e\u003cbr/\u003eWriting a deprecated or tombstoned property is rejected, tombstoned properties are additionally hidden from GraphQL. The data of tombstoned properties is kept until it is removed with ` + "`" + `POST /schema/{className}/properties/{propertyName}/purge` + "`" + `.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Change the lifecycle of a property.",
+        "operationId": "schema.objects.properties.lifecycle.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PropertyLifecycle"
+            }
           }
-        }
-      }
-    },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Changed the lifecycle of the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or property not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The property can't be moved to the given lifecycle.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "Path within bucket of backup",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
-      }
-    },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupListResponse": {
-      "description": "The definition of a backup create response body",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classes": {
-            "description": "The list of classes for which the existed backup process",
-            "type": "array",
-            "items": {
-              "type": "string"
+    "/schema/{className}/properties/{propertyName}/purge": {
+      "post": {
+        "description": "Removes the values of a tombstoned property from all objects of the class in the background. The property itself stays in the schema as tombstoned. \u003cbr/\u003e\u003cbr/\u003eThe request returns as soon as the job is started, its status can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + `.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Purge the data of a tombstoned property.",
+        "operationId": "schema.objects.properties.purge",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Job started, use its id to query its status.",
+            "schema": {
+              "$ref": "#/definitions/BatchJob"
             }
           },
-          "id": {
-            "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "path": {
-            "description": "destination path of backup files proper to selected backend",
-            "type": "string"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "status": {
-            "description": "status of backup process",
-            "type": "string",
-            "enum": [
-              "STARTED",
-              "TRANSFERRING",
-              "TRANSFERRED",
-              "SUCCESS",
-              "FAILED",
-              "CANCELED"
-            ]
+          "404": {
+            "description": "Class or property not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The property is not tombstoned.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object",
-          "$ref": "#/definitions/RestoreConfig"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/properties/{propertyName}/rename": {
+      "post": {
+        "description": "Adds a property with the new name and the same settings, deprecates the old property and starts a job in the background which moves the values of all objects from the old to the new property. Once all objects are migrated, the old property is tombstoned. Objects are not vectorized again. \u003cbr/\u003e\u003cbr/\u003eThe request returns as soon as the job is started, its status can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + `. Until the job is done, some objects only have a value for the old property.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rename a property without reimporting its data.",
+        "operationId": "schema.objects.properties.rename",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RenameRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
-        },
-        "node_mapping": {
-          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        ],
+        "responses": {
+          "202": {
+            "description": "Job started, use its id to query its status.",
+            "schema": {
+              "$ref": "#/definitions/BatchJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or property not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid new name, the new name is already in use or the property is not active.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/rename": {
+      "post": {
+        "description": "Makes the shards of the class read-only, adds a class with the new name and the same settings and tenants, then starts a job in the background which copies all objects including their vectors to the new class. Once all objects are copied, the old class is kept read-only and has to be deleted after switching to the new class. Until then the objects of the class are stored twice, set ` + "`" + `deleteOldClass` + "`" + ` to delete the old class as soon as the copy is complete. References of the class to itself are renamed as well, classes which are referenced by other classes can't be renamed. \u003cbr/\u003e\u003cbr/\u003eThe request returns as soon as the job is started, its status can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + `. If any object can't be copied, the job fails, both classes are kept and the old class is writable again.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rename a class without reimporting its data.",
+        "operationId": "schema.objects.rename",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RenameRequest"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Job started, use its id to query its status.",
+            "schema": {
+              "$ref": "#/definitions/BatchJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid new name, the new name is already in use, the class is referenced by another class or it has inactive tenants.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
-          "type": "string"
+    "/schema/{className}/shards": {
+      "get": {
+        "description": "Get the status of every shard in the cluster.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       }
     },
-    "BatchDelete": {
-      "type": "object",
-      "properties": {
-        "deletionTimeUnixMilli": {
-          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true
-        },
-        "dryRun": {
-          "description": "If true, the call will show which objects would be matched using the specified filter without deleting any objects. \u003cbr/\u003e\u003cbr/\u003eDepending on the configured verbosity, you will either receive a count of affected objects, or a list of IDs.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a shard status.",
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "deletionTimeUnixMilli": {
-          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true
-        },
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the list of tenants.",
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
               "type": "array",
               "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
+                "$ref": "#/definitions/Tenant"
               }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
-        },
-        "tenant": {
-          "description": "Name of the reference tenant.",
-          "type": "string"
-        },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
-      }
-    },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "FAILED"
-                  ]
-                }
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a tenant.",
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
               }
             }
           }
-        }
-      ]
-    },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
-      "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
-          "type": "number",
-          "format": "int",
-          "x-nullable": true,
-          "x-omitempty": true
-        },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
-      }
-    },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
-          "type": "string"
-        },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
-          },
-          "word": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting.",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
               }
             }
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
-      }
-    },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
-      "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
+      },
+      "post": {
+        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new tenant",
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
               "type": "array",
               "items": {
-                "format": "string"
+                "$ref": "#/definitions/Tenant"
               }
             }
           }
-        },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
               }
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
-      }
-    },
-    "Class": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class (a.k.a. 'collection') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the collection for metadata purposes.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules in a collection context.",
-          "type": "object"
-        },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
-        },
-        "properties": {
-          "description": "Define properties of the collection.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           }
-        },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorConfig": {
-          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/VectorConfig"
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
+        }
+      }
+    },
+    "/schema/{className}/tenants/{tenantName}": {
+      "get": {
+        "description": "get a specific tenant for the given class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a specific tenant",
+        "operationId": "tenants.get.one",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "load the tenant given the specified class",
+            "schema": {
+              "$ref": "#/definitions/TenantResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Tenant not found"
+          },
+          "422": {
+            "description": "Invalid tenant or class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "head": {
+        "description": "Check if a tenant exists for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Check whether a tenant exists",
+        "operationId": "tenant.exists",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tenant exists in the specified class"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The tenant not found"
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "(Response only) Additional meta information about a single object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "ApiKey": {
+      "description": "An API key configured for this instance.",
+      "type": "object",
+      "required": [
+        "id",
+        "user"
+      ],
+      "properties": {
+        "id": {
+          "description": "stable identifier of the key, derived from the configured key",
+          "type": "string"
         },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+        "key": {
+          "description": "the newly issued token, only returned once when the key is rotated",
           "type": "string"
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+        "revoked": {
+          "description": "whether the current token of the key has been revoked",
+          "type": "boolean"
+        },
+        "user": {
+          "description": "the user the key authenticates as",
           "type": "string"
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
+    "AsyncReplicationStatus": {
+      "description": "The outcome of the most recent comparisons of a shard with its other replicas. Only present if async replication is enabled.",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "description"
-          ]
+        "lagSeconds": {
+          "description": "Seconds the shard has been out of sync with its other replicas as of the last comparison. Zero if it was in sync.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
+        "lastInSync": {
+          "description": "Unix time in milliseconds of the last comparison which found the shard in sync with all other replicas. Zero if that has not happened yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCountry"
-          ]
-        },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
-        },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
-        },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
-        },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
-        },
-        "status": {
-          "description": "status of this classification",
-          "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
+        "lastSync": {
+          "description": "Unix time in milliseconds of the last completed comparison with the other replicas.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
+        "objectsOutOfSync": {
+          "description": "The number of objects which diverged from other replicas and were propagated by the last comparison.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+        "b": {
+          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
+          "type": "number",
+          "format": "float"
         },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
+        "k1": {
+          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupConfig": {
+      "description": "Backup custom configuration",
+      "type": "object",
+      "properties": {
+        "Bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
+        "CPUPercentage": {
+          "description": "Desired CPU core utilization ranging from 1%-80%",
           "type": "integer",
-          "example": 7
+          "default": 50,
+          "maximum": 80,
+          "minimum": 1,
+          "x-nullable": false
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
+        "ChunkSize": {
+          "description": "Aimed chunk size, with a minimum of 2MB, default of 128MB, and a maximum of 512MB. The actual chunk size may vary.",
           "type": "integer",
-          "example": 140
+          "default": 128,
+          "maximum": 512,
+          "minimum": 2,
+          "x-nullable": false
         },
-        "started": {
-          "description": "time when this classification was started",
+        "CompressionLevel": {
+          "description": "compression level used by compression algorithm",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "default": "DefaultCompression",
+          "enum": [
+            "DefaultCompression",
+            "BestSpeed",
+            "BestCompression"
+          ],
+          "x-nullable": false
+        },
+        "Endpoint": {
+          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+          "type": "string"
+        },
+        "IncrementalBaseBackupID": {
+          "description": "ID of a successful backup on the same backend, bucket and path to take an incremental backup against. Only files which changed since are stored, unchanged ones are referenced from the base backup, which therefore must be kept.",
+          "type": "string"
+        },
+        "Path": {
+          "description": "Path or key within the bucket",
+          "type": "string"
         }
       }
     },
-    "ClusterStatisticsResponse": {
-      "description": "The cluster statistics of all of the Weaviate nodes",
-      "type": "object",
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
       "properties": {
-        "statistics": {
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object",
+          "$ref": "#/definitions/BackupConfig"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Statistics"
+            "type": "string"
           }
         },
-        "synchronized": {
-          "type": "boolean",
-          "x-omitempty": false
+        "id": {
+          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "Deprecation": {
-      "type": "object",
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
           "type": "string"
         },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
+        "error": {
+          "description": "error message if creation failed",
           "type": "string"
         },
-        "msg": {
-          "description": "What this deprecation is about",
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
           "type": "string"
         },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+        "path": {
+          "description": "Path within bucket of backup",
           "type": "string"
         },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
+        "status": {
+          "description": "phase of backup creation process",
           "type": "string",
-          "x-nullable": true
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
         },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
         },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
           "type": "string"
         },
         "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
-            }
+    "BackupListResponse": {
+      "description": "The definition of a backup create response body",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classes": {
+            "description": "The list of classes for which the existed backup process",
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          },
+          "id": {
+            "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "type": "string"
+          },
+          "path": {
+            "description": "destination path of backup files proper to selected backend",
+            "type": "string"
+          },
+          "status": {
+            "description": "status of backup process",
+            "type": "string",
+            "enum": [
+              "STARTED",
+              "TRANSFERRING",
+              "TRANSFERRED",
+              "SUCCESS",
+              "FAILED",
+              "CANCELED"
+            ]
           }
         }
       }
     },
-    "GeoCoordinates": {
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
       "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
+        "allow_unsigned": {
+          "description": "Restores the backup even if it is not signed although an encryption key is configured, e.g. a backup taken before the key was configured. Signatures which are present are still checked.",
+          "type": "boolean"
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
-            }
+        "class_mapping": {
+          "description": "Allows restoring classes under different names. Keys are class names in the backup, values are the new names. Useful when cloning data into an environment which already holds the original classes.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
         },
-        "message": {
-          "type": "string"
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object",
+          "$ref": "#/definitions/RestoreConfig"
         },
-        "path": {
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
-      }
-    },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
+        "node_mapping": {
+          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
           "type": "object",
           "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
+            "type": "string"
           }
         },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
-          },
-          "x-omitempty": true
+        "tenants": {
+          "description": "Allows restoring only some tenants of multi-tenant classes. Keys are class names in the backup, values are the tenants to restore.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate (default: 60).",
-      "type": "object",
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
       "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
         },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "indexNullState": {
-          "description": "Index each object with the null state (default: 'false').",
-          "type": "boolean"
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
         },
-        "indexPropertyLength": {
-          "description": "Index length of properties (default: 'false').",
-          "type": "boolean"
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps (default: 'false').",
-          "type": "boolean"
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
-      "type": "object",
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
-        "href": {
-          "description": "target of the link",
+        "error": {
+          "description": "error message if restoration failed",
           "type": "string"
         },
-        "name": {
-          "description": "human readable name of the resource group",
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
           "type": "string"
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
           "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
         }
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
+    "BatchDelete": {
       "type": "object",
       "properties": {
-        "grpcMaxMessageSize": {
-          "description": "Max message size for GRPC connection in bytes.",
-          "type": "integer"
-        },
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
+        "deletionTimeUnixMilli": {
+          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
         },
-        "modules": {
-          "description": "Module-specific meta information.",
-          "type": "object"
+        "dryRun": {
+          "description": "If true, the call will show which objects would be matched using the specified filter without deleting any objects. \u003cbr/\u003e\u003cbr/\u003eDepending on the configured verbosity, you will either receive a count of affected objects, or a list of IDs.",
+          "type": "boolean",
+          "default": false
         },
-        "version": {
-          "description": "The Weaviate server version.",
-          "type": "string"
-        }
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
       }
     },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
       "properties": {
-        "autoTenantActivation": {
-          "description": "Existing tenants should (not) be turned HOT implicitly when they are accessed and in another activity status (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "deletionTimeUnixMilli": {
+          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
         },
-        "autoTenantCreation": {
-          "description": "Nonexistent tenants should (not) be created implicitly (default: false).",
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
           "type": "boolean",
-          "x-omitempty": false
+          "default": false
         },
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "NestedProperty": {
-      "type": "object",
+    "BatchJob": {
+      "description": "A batch of objects which is imported in the background.",
       "properties": {
-        "dataType": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "endTimeUnix": {
+          "description": "When the job finished, in milliseconds since epoch UTC. Not set while the job is running.",
+          "type": "integer",
+          "format": "int64"
         },
-        "description": {
+        "error": {
+          "description": "Error message if the job as a whole failed. Errors of single objects are part of their result.",
           "type": "string"
         },
-        "indexFilterable": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexRangeFilters": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "type": "boolean",
-          "x-nullable": true
+        "failed": {
+          "description": "How many of the processed objects could not be imported.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "name": {
+        "id": {
+          "description": "The ID of the job, used to query its status.",
           "type": "string"
         },
-        "nestedProperties": {
-          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+        "objects": {
+          "description": "The result of every processed object, in the order they were sent. Only the id of an object is returned with its result. Objects which were not processed yet are omitted.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NestedProperty"
-          },
-          "x-omitempty": true
+            "$ref": "#/definitions/ObjectsGetResponse"
+          }
         },
-        "tokenization": {
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field",
-            "trigram",
-            "gse",
-            "kagome_kr",
-            "kagome_ja"
-          ]
-        }
-      }
-    },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
-      "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
+        "percentage": {
+          "description": "Share of the processed objects, from 0 to 100.",
+          "type": "number",
+          "format": "float",
           "x-omitempty": false
         },
-        "compressed": {
-          "description": "The status of vector compression/quantization.",
-          "format": "boolean",
+        "processed": {
+          "description": "How many objects were processed so far.",
+          "type": "integer",
+          "format": "int64",
           "x-omitempty": false
         },
-        "loaded": {
-          "description": "The load status of the shard.",
-          "type": "boolean",
-          "x-omitempty": false
+        "startTimeUnix": {
+          "description": "When the job was started, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
-        "name": {
-          "description": "The name of the shard.",
+        "status": {
+          "description": "Phase of the job.",
           "type": "string",
-          "x-omitempty": false
+          "enum": [
+            "STARTED",
+            "SUCCESS",
+            "FAILED"
+          ]
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
+        "total": {
+          "description": "How many objects were sent.",
+          "type": "integer",
           "format": "int64",
           "x-omitempty": false
         },
-        "vectorIndexingStatus": {
-          "description": "The status of the vector indexing process.",
-          "format": "string",
+        "vectorsRecomputed": {
+          "description": "How many vectors were computed by vectorizer modules. Every target vector of an object counts separately.",
+          "type": "integer",
+          "format": "int64",
           "x-omitempty": false
         },
-        "vectorQueueLength": {
-          "description": "The length of the vector indexing queue.",
-          "type": "number",
+        "vectorsSkipped": {
+          "description": "How many vectors of existing objects were kept without calling the vectorizer, because none of the vectorized properties changed.",
+          "type": "integer",
           "format": "int64",
           "x-omitempty": false
         }
       }
     },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
+    "BatchReference": {
       "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
         },
-        "shardCount": {
-          "description": "The count of Weaviate's shards. To see this value, set ` + "`" + `output` + "`" + ` to ` + "`" + `verbose` + "`" + `.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
+        "tenant": {
+          "description": "Name of the reference tenant.",
+          "type": "string"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
         }
       }
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
-      "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
-        },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
-          "type": "string"
-        },
-        "name": {
-          "description": "The name of the node.",
-          "type": "string"
-        },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeShardStatus"
-          }
-        },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE",
-            "TIMEOUT"
-          ]
-        },
-        "version": {
-          "description": "The version of Weaviate.",
-          "type": "string"
-        }
-      }
-    },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
-      "type": "object",
-      "properties": {
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeStatus"
-          }
-        }
-      }
-    },
-    "Object": {
-      "type": "object",
-      "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "(Response only) Timestamp of creation of this object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "(Response only) Timestamp of the last object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
-          "type": "string"
-        },
-        "vector": {
-          "description": "This field returns vectors associated with the Object. C11yVector, Vector or Vectors values are possible.",
-          "$ref": "#/definitions/C11yVector"
-        },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        },
-        "vectors": {
-          "description": "This field returns vectors associated with the Object.",
-          "$ref": "#/definitions/Vectors"
-        }
-      }
-    },
-    "ObjectsGetResponse": {
+    "BatchReferenceResponse": {
       "type": "object",
       "allOf": [
         {
-          "$ref": "#/definitions/Object"
-        },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
-          }
+          "$ref": "#/definitions/BatchReference"
         },
         {
           "properties": {
             "result": {
-              "description": "Results for this specific Object.",
+              "description": "Results for this specific reference.",
               "format": "object",
               "properties": {
                 "errors": {
@@ -5253,1235 +5851,3627 @@ func init() {
         }
       ]
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
       "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
-          }
-        },
-        "objects": {
-          "description": "The actual list of Objects.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Object"
-          }
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
+          "type": "number",
+          "format": "int",
+          "x-nullable": true,
+          "x-omitempty": true
         },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
         }
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "BatchStreamError": {
+      "description": "An object of a streamed batch import which could not be imported.",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
         },
-        "op": {
-          "description": "The operation to be performed.",
+        "id": {
+          "description": "ID of the object, if it could be read.",
           "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+          "format": "uuid"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "line": {
+          "description": "Line of the object in the stream, starting at 1.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "BatchStreamResponse": {
+      "description": "Summary of a streamed batch import.",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "errors": {
+          "description": "The objects which could not be imported.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchStreamError"
+          }
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "failed": {
+          "description": "How many objects could not be imported.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "objects": {
+          "description": "How many objects were read from the stream.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
           "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
+          "example": "foobarium"
         },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
           "type": "string"
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/PeerUpdate"
-      }
-    },
-    "Permission": {
-      "description": "permissions attached to a role.",
-      "type": "object",
-      "required": [
-        "action"
-      ],
-      "properties": {
-        "action": {
-          "description": "allowed actions in weaviate.",
-          "type": "string",
-          "enum": [
-            "manage_backups",
-            "read_cluster",
-            "manage_data",
-            "create_data",
-            "read_data",
-            "update_data",
-            "delete_data",
-            "read_nodes",
-            "manage_roles",
-            "read_roles",
-            "manage_collections",
-            "create_collections",
-            "read_collections",
-            "update_collections",
-            "delete_collections"
-          ]
-        },
-        "backups": {
-          "description": "resources applicable for backup actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            }
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
           }
-        },
-        "collections": {
-          "description": "resources applicable for collection and/or tenant actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            },
-            "tenant": {
-              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting. On writes, the vector can also be sent as a base64-encoded string (standard encoding with padding) of its values as little-endian float32, which is about a quarter of the size. NaN and infinite values are rejected.",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
             }
           }
-        },
-        "data": {
-          "description": "resources applicable for data actions",
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
           "type": "object",
           "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
             },
-            "object": {
-              "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
             },
-            "tenant": {
-              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            }
-          }
-        },
-        "nodes": {
-          "description": "resources applicable for cluster actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
+            "concatenatedWord": {
+              "type": "string"
             },
-            "verbosity": {
-              "description": "whether to allow (verbose) returning shards and stats data in the response",
-              "type": "string",
-              "default": "minimal",
-              "enum": [
-                "verbose",
-                "minimal"
-              ]
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
             }
           }
         },
-        "roles": {
-          "description": "resources applicable for role actions",
-          "type": "object",
-          "properties": {
-            "role": {
-              "description": "string or regex. if a specific role name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
             }
           }
         }
       }
     },
-    "PhoneNumber": {
+    "Class": {
+      "type": "object",
       "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+        "class": {
+          "description": "Name of the class (a.k.a. 'collection') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
           "type": "string"
         },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+        "description": {
+          "description": "Description of the collection for metadata purposes.",
           "type": "string"
         },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
+        "encryptionConfig": {
+          "$ref": "#/definitions/EncryptionConfig"
         },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
         },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
+        "moduleConfig": {
+          "description": "Configuration specific to modules in a collection context.",
+          "type": "object"
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
-        }
-      }
-    },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "properties": {
+          "description": "Define properties of the collection.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Property"
           }
         },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
-        }
-      }
-    },
-    "Property": {
-      "type": "object",
-      "properties": {
-        "dataType": {
-          "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "templates": {
+          "description": "Names of the schema templates whose properties are included in the collection. Template properties are added when the collection is created and whenever the template gains new properties.",
           "type": "array",
           "items": {
             "type": "string"
-          }
+          },
+          "x-omitempty": true
         },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "indexFilterable": {
-          "description": "Whether to include this property in the filterable, Roaring Bitmap index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters. \u003cbr/\u003e\u003cbr/\u003eNote: Unrelated to vectorization behavior.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexInverted": {
-          "description": "(Deprecated). Whether to include this property in the inverted index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters, ` + "`" + `bm25` + "`" + ` or ` + "`" + `hybrid` + "`" + ` search. \u003cbr/\u003e\u003cbr/\u003eUnrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexRangeFilters": {
-          "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
+        "vectorConfig": {
+          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
         },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
         },
-        "name": {
-          "description": "The name of the property (required). Multiple words should be concatenated in camelCase, e.g. ` + "`" + `nameOfAuthor` + "`" + `.",
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
           "type": "string"
         },
-        "nestedProperties": {
-          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NestedProperty"
+            "type": "string"
           },
-          "x-omitempty": true
+          "example": [
+            "description"
+          ]
         },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+        "class": {
+          "description": "class (name) which is used in this classification",
           "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field",
-            "trigram",
-            "gse",
-            "kagome_kr",
-            "kagome_ja"
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
           ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
         }
       }
     },
-    "PropertySchema": {
-      "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
-      "type": "object"
-    },
-    "RaftStatistics": {
-      "description": "The definition of Raft statistics.",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
       "properties": {
-        "appliedIndex": {
-          "type": "string"
-        },
-        "commitIndex": {
-          "type": "string"
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         },
-        "fsmPending": {
-          "type": "string"
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
         },
-        "lastContact": {
-          "type": "string"
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
         },
-        "lastLogIndex": {
-          "type": "string"
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
         },
-        "lastLogTerm": {
-          "type": "string"
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "ClusterStatisticsResponse": {
+      "description": "The cluster statistics of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "statistics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Statistics"
+          }
         },
-        "lastSnapshotIndex": {
+        "synchronized": {
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
           "type": "string"
         },
-        "lastSnapshotTerm": {
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
           "type": "string"
         },
-        "latestConfiguration": {
-          "description": "Weaviate Raft nodes.",
-          "type": "object"
-        },
-        "latestConfigurationIndex": {
-          "type": "string"
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "numPeers": {
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
           "type": "string"
         },
-        "protocolVersion": {
+        "msg": {
+          "description": "What this deprecation is about",
           "type": "string"
         },
-        "protocolVersionMax": {
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
           "type": "string"
         },
-        "protocolVersionMin": {
-          "type": "string"
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
+          "type": "string",
+          "x-nullable": true
         },
-        "snapshotVersionMax": {
-          "type": "string"
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         },
-        "snapshotVersionMin": {
-          "type": "string"
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
         },
-        "state": {
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
           "type": "string"
         },
-        "term": {
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
           "type": "string"
         }
       }
     },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
+    "EncryptionConfig": {
+      "description": "Configure the encryption of the objects of a collection at rest",
       "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
-        },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
+        "enabled": {
+          "description": "Encrypt the objects of the collection at rest (default: false). Every shard gets its own data encryption keys, which are wrapped by the master key configured with DATA_ENCRYPTION_MASTER_KEY. Immutable.",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
+        "keyVersion": {
+          "description": "Version of the data encryption keys (default: 0). Increase it to rotate the keys of all shards, objects are then re-encrypted in the background. It can not be decreased.",
+          "type": "integer",
           "format": "int64"
-        },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "code": {
+                "description": "Machine-readable code of the error, only set for errors of a known kind, e.g. CLASS_EXISTS.",
+                "type": "string"
+              },
+              "message": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
           "type": "number",
-          "format": "float32",
+          "format": "float",
           "x-nullable": true
         },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
           "type": "number",
-          "format": "float32",
+          "format": "float",
           "x-nullable": true
-        },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
         }
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
-      "type": "object",
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
       "properties": {
-        "asyncEnabled": {
-          "description": "Enable asynchronous replication (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
         },
-        "deletionStrategy": {
-          "description": "Conflict resolution strategy for deleted objects.",
-          "type": "string",
-          "enum": [
-            "NoAutomatedResolution",
-            "DeleteOnConflict",
-            "TimeBasedResolution"
-          ],
-          "x-omitempty": true
+        "message": {
+          "type": "string"
         },
-        "factor": {
-          "description": "Number of times a class is replicated (default: 1).",
-          "type": "integer"
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "RestoreConfig": {
-      "description": "Backup custom configuration",
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
       "type": "object",
       "properties": {
-        "Bucket": {
-          "description": "Name of the bucket, container, volume, etc",
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
           "type": "string"
         },
-        "CPUPercentage": {
-          "description": "Desired CPU core utilization ranging from 1%-80%",
-          "type": "integer",
-          "default": 50,
-          "maximum": 80,
-          "minimum": 1,
-          "x-nullable": false
-        },
-        "Endpoint": {
-          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+        "query": {
+          "description": "Query based on GraphQL syntax.",
           "type": "string"
         },
-        "Path": {
-          "description": "Path within the bucket",
-          "type": "string"
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
         }
       }
     },
-    "Role": {
-      "type": "object",
-      "required": [
-        "name",
-        "permissions"
-      ],
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
       "properties": {
-        "name": {
-          "description": "role name",
-          "type": "string"
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         },
-        "permissions": {
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "description": "list of permissions (level, action, resource)",
-            "type": "object",
-            "$ref": "#/definitions/Permission"
-          }
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
         }
       }
     },
-    "RolesListResponse": {
-      "description": "list of roles",
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/Role"
+        "$ref": "#/definitions/GraphQLResponse"
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
       "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
-          }
-        },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
         },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
-        }
-      }
-    },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "description": "Contains the sync check error if one occurred",
-          "type": "string",
-          "x-omitempty": true
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
         },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
-          "type": "boolean",
-          "x-omitempty": false
+        "indexNullState": {
+          "description": "Index each object with the null state (default: 'false').",
+          "type": "boolean"
         },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
-          "type": "string"
+        "indexPropertyLength": {
+          "description": "Index length of properties (default: 'false').",
+          "type": "boolean"
         },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
-          "type": "boolean",
-          "x-omitempty": false
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps (default: 'false').",
+          "type": "boolean"
         },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
         }
       }
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+    "JsonObject": {
+      "description": "JSON object value.",
       "type": "object"
     },
-    "ShardStatus": {
-      "description": "The status of a single shard",
+    "Link": {
+      "type": "object",
       "properties": {
-        "status": {
-          "description": "Status of the shard",
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
           "type": "string"
-        }
-      }
-    },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
-      "properties": {
-        "name": {
-          "description": "Name of the shard",
+        },
+        "href": {
+          "description": "target of the link",
           "type": "string"
         },
-        "status": {
-          "description": "Status of the shard",
+        "name": {
+          "description": "human readable name of the resource group",
           "type": "string"
         },
-        "vectorQueueSize": {
-          "description": "Size of the vector queue of the shard",
-          "type": "integer",
-          "x-omitempty": false
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
         }
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
-      }
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
       "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
-        },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
+        "grpcMaxMessageSize": {
+          "description": "Max message size for GRPC connection in bytes.",
+          "type": "integer"
         },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+        "hostname": {
+          "description": "The url of the host.",
           "type": "string",
-          "format": "uri"
-        },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
-        }
-      }
-    },
-    "Statistics": {
-      "description": "The definition of node statistics.",
-      "properties": {
-        "bootstrapped": {
-          "type": "boolean"
-        },
-        "candidates": {
-          "type": "object"
-        },
-        "dbLoaded": {
-          "type": "boolean"
-        },
-        "initialLastAppliedIndex": {
-          "type": "number",
-          "format": "uint64"
-        },
-        "isVoter": {
-          "type": "boolean"
-        },
-        "lastAppliedIndex": {
-          "type": "number"
-        },
-        "leaderAddress": {
-          "type": "object"
+          "format": "url"
         },
-        "leaderId": {
+        "modules": {
+          "description": "Module-specific meta information.",
           "type": "object"
         },
-        "name": {
-          "description": "The name of the node.",
+        "version": {
+          "description": "The Weaviate server version.",
           "type": "string"
-        },
-        "open": {
-          "type": "boolean"
-        },
-        "raft": {
-          "description": "Weaviate Raft statistics.",
-          "type": "object",
-          "$ref": "#/definitions/RaftStatistics"
-        },
-        "ready": {
-          "type": "boolean"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE",
-            "TIMEOUT"
-          ]
         }
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
+    "ModuleInfo": {
+      "description": "An enabled module, what it can be used for and the health of its upstream service.",
       "type": "object",
       "properties": {
-        "additions": {
-          "description": "Stopwords to be considered additionally (default: []). Can be any array of custom strings.",
+        "altNames": {
+          "description": "Alternative names the module can be referred to by.",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "preset": {
-          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
+        "capabilities": {
+          "description": "What the module can be used for, any of ` + "`" + `vectorizer` + "`" + `, ` + "`" + `reranker` + "`" + `, ` + "`" + `generative` + "`" + `, ` + "`" + `qna` + "`" + `, ` + "`" + `ner` + "`" + `, ` + "`" + `summarizer` + "`" + `, ` + "`" + `chunker` + "`" + `, ` + "`" + `backup` + "`" + ` and ` + "`" + `offload` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "config": {
+          "description": "Defaults the module sets in the module config of classes which use it.",
+          "type": "object"
+        },
+        "error": {
+          "description": "Why the upstream service is unhealthy.",
           "type": "string"
         },
-        "removals": {
-          "description": "Stopwords to be removed from consideration (default: []). Can be any array of custom strings.",
+        "graphQLArguments": {
+          "description": "Module-specific GraphQL arguments the module provides, e.g. ` + "`" + `nearText` + "`" + ` or ` + "`" + `ask` + "`" + `.",
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
-      "type": "object",
-      "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `ACTIVE` + "`" + `) and required for updating tenant. For creation, allowed values are ` + "`" + `ACTIVE` + "`" + ` - tenant is fully active and ` + "`" + `INACTIVE` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally. For updating, ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + ` and also ` + "`" + `OFFLOADED` + "`" + ` - as INACTIVE, but files are stored on cloud storage. The following values are read-only and are set by the server for internal use: ` + "`" + `OFFLOADING` + "`" + ` - tenant is transitioning from ACTIVE/INACTIVE to OFFLOADED, ` + "`" + `ONLOADING` + "`" + ` - tenant is transitioning from OFFLOADED to ACTIVE/INACTIVE. We still accept deprecated names ` + "`" + `HOT` + "`" + ` (now ` + "`" + `ACTIVE` + "`" + `), ` + "`" + `COLD` + "`" + ` (now ` + "`" + `INACTIVE` + "`" + `), ` + "`" + `FROZEN` + "`" + ` (now ` + "`" + `OFFLOADED` + "`" + `), ` + "`" + `FREEZING` + "`" + ` (now ` + "`" + `OFFLOADING` + "`" + `), ` + "`" + `UNFREEZING` + "`" + ` (now ` + "`" + `ONLOADING` + "`" + `).",
+        },
+        "name": {
+          "description": "Name of the module, e.g. ` + "`" + `text2vec-openai` + "`" + `.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Health of the upstream service. ` + "`" + `UNKNOWN` + "`" + ` for modules which don't depend on an inference service of this deployment, e.g. because they call a third-party API.",
           "type": "string",
           "enum": [
-            "ACTIVE",
-            "INACTIVE",
-            "OFFLOADED",
-            "OFFLOADING",
-            "ONLOADING",
-            "HOT",
-            "COLD",
-            "FROZEN",
-            "FREEZING",
-            "UNFREEZING"
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNKNOWN"
           ]
         },
-        "name": {
-          "description": "The name of the tenant (required).",
+        "type": {
+          "description": "Type of the module, e.g. ` + "`" + `Text2Vec` + "`" + ` or ` + "`" + `Text2TextGenerative` + "`" + `.",
           "type": "string"
         }
       }
     },
-    "TenantResponse": {
-      "description": "attributes representing a single tenant response within weaviate",
+    "ModulesListResponse": {
+      "description": "The enabled modules.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Tenant"
-        },
-        {
-          "properties": {
-            "belongsToNodes": {
-              "description": "The list of nodes that owns that tenant data.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            },
-            "dataVersion": {
-              "description": "Experimental. The data version of the tenant is a monotonically increasing number starting from 0 which is incremented each time a tenant's data is offloaded to cloud storage.",
-              "type": "integer",
-              "default": 0,
-              "x-nullable": true,
-              "example": 3
-            }
+      "properties": {
+        "modules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ModuleInfo"
           }
         }
-      ]
-    },
-    "Vector": {
-      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
       }
     },
-    "VectorConfig": {
-      "type": "object",
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
       "properties": {
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
+        "autoTenantActivation": {
+          "description": "Existing tenants should (not) be turned HOT implicitly when they are accessed and in another activity status (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
+        "autoTenantCreation": {
+          "description": "Nonexistent tenants should (not) be created implicitly (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "vectorizer": {
-          "description": "Configuration of a specific vectorizer used by this vector",
-          "type": "object"
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
         }
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "Vectors": {
-      "description": "A map of named vectors for multi-vector representations.",
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/Vector"
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
       }
     },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
+    "NestedProperty": {
       "type": "object",
       "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+        "dataType": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/WhereFilter"
+            "type": "string"
           }
         },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull",
-            "ContainsAny",
-            "ContainsAll"
-          ],
-          "example": "GreaterThanEqual"
+        "description": {
+          "type": "string"
         },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
+        "indexFilterable": {
+          "type": "boolean",
+          "x-nullable": true
         },
-        "valueBoolean": {
-          "description": "value as boolean",
+        "indexRangeFilters": {
           "type": "boolean",
-          "x-nullable": true,
-          "example": false
+          "x-nullable": true
         },
-        "valueBooleanArray": {
-          "description": "value as boolean",
+        "indexSearchable": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
           "type": "array",
           "items": {
-            "type": "boolean"
+            "$ref": "#/definitions/NestedProperty"
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            true,
-            false
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_kr",
+            "kagome_ja"
           ]
+        }
+      }
+    },
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
+      "properties": {
+        "asyncReplicationStatus": {
+          "$ref": "#/definitions/AsyncReplicationStatus"
         },
-        "valueDate": {
-          "description": "value as date (as string)",
+        "class": {
+          "description": "The name of shard's class.",
           "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
+          "x-omitempty": false
         },
-        "valueDateArray": {
-          "description": "value as date (as string)",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "TODO"
+        "compressed": {
+          "description": "The status of vector compression/quantization.",
+          "format": "boolean",
+          "x-omitempty": false
         },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
+        "loaded": {
+          "description": "The load status of the shard.",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
+          "type": "number",
           "format": "int64",
-          "x-nullable": true,
-          "example": 2000
+          "x-omitempty": false
         },
-        "valueIntArray": {
-          "description": "value as integer",
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int64"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "[100, 200]"
+        "vectorIndexingStatus": {
+          "description": "The status of the vector indexing process.",
+          "format": "string",
+          "x-omitempty": false
         },
-        "valueNumber": {
-          "description": "value as number/float",
+        "vectorQueueLength": {
+          "description": "The length of the vector indexing queue.",
           "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
+      "properties": {
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "valueNumberArray": {
-          "description": "value as number/float",
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float64"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            3.14
-          ]
+        "shardCount": {
+          "description": "The count of Weaviate's shards. To see this value, set ` + "`" + `output` + "`" + ` to ` + "`" + `verbose` + "`" + `.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
+      "properties": {
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
         },
-        "valueString": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
+          "type": "string"
         },
-        "valueStringArray": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
+            "$ref": "#/definitions/NodeShardStatus"
+          }
         },
-        "valueText": {
-          "description": "value as text",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
         },
-        "valueTextArray": {
-          "description": "value as text",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE",
+            "TIMEOUT"
           ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
         }
       }
     },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
       "type": "object",
       "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
-            }
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
           }
-        },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
         }
       }
-    }
-  },
-  "parameters": {
-    "CommonAfterParameterQuery": {
-      "type": "string",
-      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
-      "name": "after",
-      "in": "query"
     },
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-      "name": "class",
-      "in": "query"
-    },
-    "CommonConsistencyLevelParameterQuery": {
-      "type": "string",
-      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-      "name": "consistency_level",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
+    "Object": {
+      "type": "object",
+      "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
+        },
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "(Response only) Timestamp of creation of this object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "expiresAtUnix": {
+          "description": "Timestamp in milliseconds since epoch UTC after which the object is deleted automatically. Objects without it never expire.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "(Response only) Timestamp of the last object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "tenant": {
+          "description": "Name of the Objects tenant.",
+          "type": "string"
+        },
+        "vector": {
+          "description": "This field returns vectors associated with the Object. C11yVector, Vector or Vectors values are possible.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "description": "This field returns vectors associated with the Object.",
+          "$ref": "#/definitions/Vectors"
+        }
+      }
     },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
-      "name": "limit",
-      "in": "query"
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
     },
-    "CommonNodeNameParameterQuery": {
-      "type": "string",
-      "description": "The target node which should fulfill the request",
-      "name": "node_name",
-      "in": "query"
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
+      "type": "object",
+      "properties": {
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
+        },
+        "nextCursor": {
+          "description": "Cursor to pass as ` + "`" + `after` + "`" + ` to retrieve the next page. Only set when the request was paged with ` + "`" + `after` + "`" + `.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The actual list of Objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
     },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
-      "name": "offset",
-      "in": "query"
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
     },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
-      "name": "order",
-      "in": "query"
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
     },
-    "CommonOutputVerbosityParameterQuery": {
-      "type": "string",
-      "default": "minimal",
-      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-      "name": "output",
-      "in": "query"
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
+        }
+      }
     },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
-      "name": "sort",
-      "in": "query"
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
     },
-    "CommonTenantParameterQuery": {
-      "type": "string",
-      "description": "Specifies the tenant in a request targeting a multi-tenant class",
-      "name": "tenant",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
+    "Permission": {
+      "description": "permissions attached to a role.",
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "description": "allowed actions in weaviate.",
+          "type": "string",
+          "enum": [
+            "manage_backups",
+            "read_cluster",
+            "manage_data",
+            "create_data",
+            "read_data",
+            "update_data",
+            "delete_data",
+            "read_nodes",
+            "manage_roles",
+            "read_roles",
+            "manage_collections",
+            "create_collections",
+            "read_collections",
+            "update_collections",
+            "delete_collections"
+          ]
+        },
+        "backups": {
+          "description": "resources applicable for backup actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
+        },
+        "collections": {
+          "description": "resources applicable for collection and/or tenant actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "tenant": {
+              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
+        },
+        "data": {
+          "description": "resources applicable for data actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "object": {
+              "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "tenant": {
+              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
+        },
+        "nodes": {
+          "description": "resources applicable for cluster actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "verbosity": {
+              "description": "whether to allow (verbose) returning shards and stats data in the response",
+              "type": "string",
+              "default": "minimal",
+              "enum": [
+                "verbose",
+                "minimal"
+              ]
+            }
+          }
+        },
+        "roles": {
+          "description": "resources applicable for role actions",
+          "type": "object",
+          "properties": {
+            "role": {
+              "description": "string or regex. if a specific role name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
+        }
+      }
     },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
+        },
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
     },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/weaviate/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "# Introduction\n Weaviate is an open source, AI-native vector database that helps developers create intuitive and reliable AI-powered applications. \n ### Base Path \nThe base path for the Weaviate server is structured as ` + "`" + `[YOUR-WEAVIATE-HOST]:[PORT]/v1` + "`" + `. As an example, if you wish to access the ` + "`" + `schema` + "`" + ` endpoint on a local instance, you would navigate to ` + "`" + `http://localhost:8080/v1/schema` + "`" + `. Ensure you replace ` + "`" + `[YOUR-WEAVIATE-HOST]` + "`" + ` and ` + "`" + `[PORT]` + "`" + ` with your actual server host and port number respectively. \n ### Questions? \nIf you have any comments or questions, please feel free to reach out to us at the community forum [https://forum.weaviate.io/](https://forum.weaviate.io/). \n### Issues? \nIf you find a bug or want to file a feature request, please open an issue on our GitHub repository for [Weaviate](https://github.com/weaviate/weaviate). \n### Want more documentation? \nFor a quickstart, code examples, concepts and more, please visit our [documentation page](https://weaviate.io/developers/weaviate).",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/weaviate",
-      "email": "hello@weaviate.io"
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "collections": {
+          "description": "The collections the principal is limited to, e.g. by the scope of its API key. Empty if it is not limited.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
+        }
+      }
     },
-    "version": "1.28.0-dev"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Get links to other endpoints to help discover the REST API",
-        "summary": "List available endpoints",
-        "operationId": "weaviate.root",
+    "Property": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Whether to include this property in the filterable, Roaring Bitmap index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters. \u003cbr/\u003e\u003cbr/\u003eNote: Unrelated to vectorization behavior.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "(Deprecated). Whether to include this property in the inverted index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters, ` + "`" + `bm25` + "`" + ` or ` + "`" + `hybrid` + "`" + ` search. \u003cbr/\u003e\u003cbr/\u003eUnrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexRangeFilters": {
+          "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "lifecycle": {
+          "description": "Lifecycle of the property, used to remove it safely in steps. ` + "`" + `deprecated` + "`" + ` properties can still be read, but writing them is rejected. ` + "`" + `tombstoned` + "`" + ` properties are additionally hidden from GraphQL, their data is kept until it is purged. Defaults to ` + "`" + `active` + "`" + `.",
+          "type": "string",
+          "enum": [
+            "active",
+            "deprecated",
+            "tombstoned"
+          ]
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "The name of the property (required). Multiple words should be concatenated in camelCase, e.g. ` + "`" + `nameOfAuthor` + "`" + `.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_kr",
+            "kagome_ja"
+          ]
+        }
+      }
+    },
+    "PropertyLifecycle": {
+      "description": "The lifecycle a property is moved to.",
+      "type": "object",
+      "required": [
+        "lifecycle"
+      ],
+      "properties": {
+        "lifecycle": {
+          "description": "Properties move one step at a time, from ` + "`" + `active` + "`" + ` to ` + "`" + `deprecated` + "`" + ` to ` + "`" + `tombstoned` + "`" + `, or back.",
+          "type": "string",
+          "enum": [
+            "active",
+            "deprecated",
+            "tombstoned"
+          ]
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
+      "type": "object"
+    },
+    "RaftStatistics": {
+      "description": "The definition of Raft statistics.",
+      "properties": {
+        "appliedIndex": {
+          "type": "string"
+        },
+        "commitIndex": {
+          "type": "string"
+        },
+        "fsmPending": {
+          "type": "string"
+        },
+        "lastContact": {
+          "type": "string"
+        },
+        "lastLogIndex": {
+          "type": "string"
+        },
+        "lastLogTerm": {
+          "type": "string"
+        },
+        "lastSnapshotIndex": {
+          "type": "string"
+        },
+        "lastSnapshotTerm": {
+          "type": "string"
+        },
+        "latestConfiguration": {
+          "description": "Weaviate Raft nodes.",
+          "type": "object"
+        },
+        "latestConfigurationIndex": {
+          "type": "string"
+        },
+        "numPeers": {
+          "type": "string"
+        },
+        "protocolVersion": {
+          "type": "string"
+        },
+        "protocolVersionMax": {
+          "type": "string"
+        },
+        "protocolVersionMin": {
+          "type": "string"
+        },
+        "snapshotVersionMax": {
+          "type": "string"
+        },
+        "snapshotVersionMin": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "term": {
+          "type": "string"
+        }
+      }
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "RenameRequest": {
+      "description": "The new name of a class or property.",
+      "type": "object",
+      "properties": {
+        "deleteOldClass": {
+          "description": "Only used when renaming a class: delete the old class once all objects are copied. By default the old class is kept read-only, so that clients can be switched to the new name.",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "The new name.",
+          "type": "string"
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "asyncEnabled": {
+          "description": "Enable asynchronous replication (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "conflictResolution": {
+          "description": "Conflict resolution strategy for objects which differ between replicas (default: LastWriteWins).",
+          "type": "string",
+          "enum": [
+            "LastWriteWins",
+            "PropertyMerge"
+          ],
+          "x-omitempty": true
+        },
+        "deletionStrategy": {
+          "description": "Conflict resolution strategy for deleted objects.",
+          "type": "string",
+          "enum": [
+            "NoAutomatedResolution",
+            "DeleteOnConflict",
+            "TimeBasedResolution"
+          ],
+          "x-omitempty": true
+        },
+        "factor": {
+          "description": "Number of times a class is replicated (default: 1).",
+          "type": "integer"
+        }
+      }
+    },
+    "RestoreConfig": {
+      "description": "Backup custom configuration",
+      "type": "object",
+      "properties": {
+        "Bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "CPUPercentage": {
+          "description": "Desired CPU core utilization ranging from 1%-80%",
+          "type": "integer",
+          "default": 50,
+          "maximum": 80,
+          "minimum": 1,
+          "x-nullable": false
+        },
+        "Endpoint": {
+          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+          "type": "string"
+        },
+        "Path": {
+          "description": "Path within the bucket",
+          "type": "string"
+        }
+      }
+    },
+    "Role": {
+      "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
+      "properties": {
+        "name": {
+          "description": "role name",
+          "type": "string"
+        },
+        "permissions": {
+          "type": "array",
+          "items": {
+            "description": "list of permissions (level, action, resource)",
+            "type": "object",
+            "$ref": "#/definitions/Permission"
+          }
+        }
+      }
+    },
+    "RolesListResponse": {
+      "description": "list of roles",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Role"
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaClassDiff": {
+      "description": "Differences between a class of the current schema and the same class of a proposed schema.",
+      "type": "object",
+      "properties": {
+        "addedProperties": {
+          "description": "Properties which only exist in the proposed class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "changedProperties": {
+          "description": "Properties which exist in both classes, but differ.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "changedSettings": {
+          "description": "Top-level class settings which differ, e.g. ` + "`" + `invertedIndexConfig` + "`" + ` or ` + "`" + `vectorIndexConfig` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "removedProperties": {
+          "description": "Properties which only exist in the current class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Contains the sync check error if one occurred",
+          "type": "string",
+          "x-omitempty": true
+        },
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+          "type": "string"
+        },
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
+          "type": "number",
+          "format": "int"
+        }
+      }
+    },
+    "SchemaDiff": {
+      "description": "Result of validating a proposed schema against the current schema without applying it.",
+      "type": "object",
+      "properties": {
+        "addedClasses": {
+          "description": "Classes which only exist in the proposed schema.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "changedClasses": {
+          "description": "Classes which exist in both schemas, but differ.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaClassDiff"
+          }
+        },
+        "errors": {
+          "description": "Reasons why the proposed schema could not be applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaValidationError"
+          }
+        },
+        "removedClasses": {
+          "description": "Classes which only exist in the current schema.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "valid": {
+          "description": "True if the proposed schema could be applied, i.e. there are no errors.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "SchemaValidationError": {
+      "description": "A single reason why a proposed schema could not be applied.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class the error refers to.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Description of the error.",
+          "type": "string"
+        },
+        "property": {
+          "description": "Name of the property the error refers to, empty for class-level errors.",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
+      "properties": {
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        },
+        "vectorQueueSize": {
+          "description": "Size of the vector queue of the shard",
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "Statistics": {
+      "description": "The definition of node statistics.",
+      "properties": {
+        "bootstrapped": {
+          "type": "boolean"
+        },
+        "candidates": {
+          "type": "object"
+        },
+        "dbLoaded": {
+          "type": "boolean"
+        },
+        "initialLastAppliedIndex": {
+          "type": "number",
+          "format": "uint64"
+        },
+        "isVoter": {
+          "type": "boolean"
+        },
+        "lastAppliedIndex": {
+          "type": "number"
+        },
+        "leaderAddress": {
+          "type": "object"
+        },
+        "leaderId": {
+          "type": "object"
+        },
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "open": {
+          "type": "boolean"
+        },
+        "raft": {
+          "description": "Weaviate Raft statistics.",
+          "type": "object",
+          "$ref": "#/definitions/RaftStatistics"
+        },
+        "ready": {
+          "type": "boolean"
+        },
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE",
+            "TIMEOUT"
+          ]
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "Stopwords to be considered additionally (default: []). Can be any array of custom strings.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "preset": {
+          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
+          "type": "string"
+        },
+        "removals": {
+          "description": "Stopwords to be removed from consideration (default: []). Can be any array of custom strings.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `ACTIVE` + "`" + `) and required for updating tenant. For creation, allowed values are ` + "`" + `ACTIVE` + "`" + ` - tenant is fully active and ` + "`" + `INACTIVE` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally. For updating, ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + ` and also ` + "`" + `OFFLOADED` + "`" + ` - as INACTIVE, but files are stored on cloud storage. The following values are read-only and are set by the server for internal use: ` + "`" + `OFFLOADING` + "`" + ` - tenant is transitioning from ACTIVE/INACTIVE to OFFLOADED, ` + "`" + `ONLOADING` + "`" + ` - tenant is transitioning from OFFLOADED to ACTIVE/INACTIVE. We still accept deprecated names ` + "`" + `HOT` + "`" + ` (now ` + "`" + `ACTIVE` + "`" + `), ` + "`" + `COLD` + "`" + ` (now ` + "`" + `INACTIVE` + "`" + `), ` + "`" + `FROZEN` + "`" + ` (now ` + "`" + `OFFLOADED` + "`" + `), ` + "`" + `FREEZING` + "`" + ` (now ` + "`" + `OFFLOADING` + "`" + `), ` + "`" + `UNFREEZING` + "`" + ` (now ` + "`" + `ONLOADING` + "`" + `).",
+          "type": "string",
+          "enum": [
+            "ACTIVE",
+            "INACTIVE",
+            "OFFLOADED",
+            "OFFLOADING",
+            "ONLOADING",
+            "HOT",
+            "COLD",
+            "FROZEN",
+            "FREEZING",
+            "UNFREEZING"
+          ]
+        },
+        "name": {
+          "description": "The name of the tenant (required).",
+          "type": "string"
+        }
+      }
+    },
+    "TenantResponse": {
+      "description": "attributes representing a single tenant response within weaviate",
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Tenant"
+        },
+        {
+          "properties": {
+            "belongsToNodes": {
+              "description": "The list of nodes that owns that tenant data.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "dataVersion": {
+              "description": "Experimental. The data version of the tenant is a monotonically increasing number starting from 0 which is incremented each time a tenant's data is offloaded to cloud storage.",
+              "type": "integer",
+              "default": 0,
+              "x-nullable": true,
+              "example": 3
+            }
+          }
+        }
+      ]
+    },
+    "Vector": {
+      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting. On writes, the vector can also be sent as a base64-encoded string (standard encoding with padding) of its values as little-endian float32, which is about a quarter of the size. NaN and infinite values are rejected.",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "Vectors": {
+      "description": "A map of named vectors for multi-vector representations.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/Vector"
+      }
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueBooleanArray": {
+          "description": "value as boolean",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueDateArray": {
+          "description": "value as date (as string)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueIntArray": {
+          "description": "value as integer",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "[100, 200]"
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueNumberArray": {
+          "description": "value as number/float",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
+        },
+        "valueString": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueStringArray": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
+        "valueText": {
+          "description": "value as text",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eWithout ` + "`" + `class` + "`" + `, pass the ` + "`" + `nextCursor` + "`" + ` of the previous page, or the nil UUID to start, to page through all collections. Typically used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyLevelParameterQuery": {
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonNodeNameParameterQuery": {
+      "type": "string",
+      "description": "The target node which should fulfill the request",
+      "name": "node_name",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonOutputVerbosityParameterQuery": {
+      "type": "string",
+      "default": "minimal",
+      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+      "name": "output",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
+      "name": "sort",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/weaviate/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "# Introduction\n Weaviate is an open source, AI-native vector database that helps developers create intuitive and reliable AI-powered applications. \n ### Base Path \nThe base path for the Weaviate server is structured as ` + "`" + `[YOUR-WEAVIATE-HOST]:[PORT]/v1` + "`" + `. As an example, if you wish to access the ` + "`" + `schema` + "`" + ` endpoint on a local instance, you would navigate to ` + "`" + `http://localhost:8080/v1/schema` + "`" + `. Ensure you replace ` + "`" + `[YOUR-WEAVIATE-HOST]` + "`" + ` and ` + "`" + `[PORT]` + "`" + ` with your actual server host and port number respectively. \n ### Questions? \nIf you have any comments or questions, please feel free to reach out to us at the community forum [https://forum.weaviate.io/](https://forum.weaviate.io/). \n### Issues? \nIf you find a bug or want to file a feature request, please open an issue on our GitHub repository for [Weaviate](https://github.com/weaviate/weaviate). \n### Want more documentation? \nFor a quickstart, code examples, concepts and more, please visit our [documentation page](https://weaviate.io/developers/weaviate).",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/weaviate",
+      "email": "hello@weaviate.io"
+    },
+    "version": "1.28.0-dev"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Get links to other endpoints to help discover the REST API",
+        "summary": "List available endpoints",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "summary": "Get application liveness.",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "summary": "Get application readiness.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/authz/roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get all roles",
+        "operationId": "getRoles",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.roles"
+        ]
+      },
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "create new role",
+        "operationId": "createRole",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Role created successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Role already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.create.role"
+        ]
+      }
+    },
+    "/authz/roles/add-permissions": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Add permission to a role as an upsert. If the role doesn't exist then it will be created.",
+        "operationId": "addPermissions",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name",
+                "permissions"
+              ],
+              "properties": {
+                "name": {
+                  "description": "role name",
+                  "type": "string"
+                },
+                "permissions": {
+                  "description": "permissions to be added to the role",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Permission"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Permissions added successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.add.role.permissions"
+        ]
+      }
+    },
+    "/authz/roles/remove-permissions": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Remove permissions from a role. If this results in an empty role, the role will be deleted.",
+        "operationId": "removePermissions",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name",
+                "permissions"
+              ],
+              "properties": {
+                "name": {
+                  "description": "role name",
+                  "type": "string"
+                },
+                "permissions": {
+                  "description": "permissions to remove from the role",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Permission"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Permissions removed successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.remove.role.permissions"
+        ]
+      }
+    },
+    "/authz/roles/{id}": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get a role",
+        "operationId": "getRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.role"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Delete role",
+        "operationId": "deleteRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.delete.role"
+        ]
+      }
+    },
+    "/authz/roles/{id}/users": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "get users or a keys assigned to role",
+        "operationId": "getUsersForRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Users assigned to this role",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found for user"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.roles.users"
+        ]
+      }
+    },
+    "/authz/users/own-roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "get roles assigned to own user",
+        "operationId": "getRolesForOwnUser",
+        "responses": {
+          "200": {
+            "description": "Role assigned to own users",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.users.own-roles"
+        ]
+      }
+    },
+    "/authz/users/{id}/assign": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Assign a role to a user",
+        "operationId": "assignRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "properties": {
+                "roles": {
+                  "description": "the roles that assigned to user",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "role is not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.assign.role"
+        ]
+      }
+    },
+    "/authz/users/{id}/revoke": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Revoke a role from a user",
+        "operationId": "revokeRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "properties": {
+                "roles": {
+                  "description": "the roles that revoked from the key or user",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role revoked successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.revoke.role"
+        ]
+      }
+    },
+    "/authz/users/{id}/roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "get roles assigned to user",
+        "operationId": "getRolesForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned users",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found for user"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.users.roles"
+        ]
+      }
+    },
+    "/backups/{backend}": {
+      "get": {
+        "description": "[Coming soon] List all backups in progress not implemented yet.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "List backups in progress",
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Existed backups",
+            "schema": {
+              "$ref": "#/definitions/BackupListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Start a backup process",
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Get backup process status",
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "delete": {
+        "description": "Cancel created backup with specified ID",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Cancel backup",
+        "operationId": "backups.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup cancellation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Get restore process status",
+        "operationId": "backups.restore.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Start a restoration process",
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/batch/jobs": {
+      "post": {
+        "description": "Create new objects in bulk in the background. \u003cbr/\u003e\u003cbr/\u003eThe objects are validated and imported just like on ` + "`" + `POST /batch/objects` + "`" + `, but the request returns as soon as the job is started. The status of the job and the result of every object can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + ` until a while after the job finished. \u003cbr/\u003e\u003cbr/\u003eJobs are kept on the node which started them, so their status can only be queried on that node. Jobs which are still running when the node restarts fail. At most 16 jobs can run on a node at the same time, further jobs are rejected with status 429.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects as a batch in the background.",
+        "operationId": "batch.jobs.create",
+        "parameters": [
+          {
+            "description": "The objects to be imported.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Object"
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "Weaviate is alive and ready to serve content",
+          "202": {
+            "description": "Job started, use its id to query its status.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
+              "$ref": "#/definitions/BatchJob"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "/.well-known/live": {
+    "/batch/jobs/{id}": {
       "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "summary": "Get application liveness.",
-        "operationId": "weaviate.wellknown.liveness",
+        "description": "Get the status of a batch job, and the result of every object it processed so far.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Get the status of a batch job.",
+        "operationId": "batch.jobs.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the job.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Found the job, returned as body.",
+            "schema": {
+              "$ref": "#/definitions/BatchJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Job does not exist, or finished too long ago."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+    "/batch/objects": {
+      "put": {
+        "description": "Replace existing objects in bulk. \u003cbr/\u003e\u003cbr/\u003eEvery object needs its id. Meta-data and schema values are validated just like on ` + "`" + `POST /batch/objects` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eObjects which don't exist are not created, but fail individually.",
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "batch",
+          "objects"
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
-        "responses": {
-          "200": {
-            "description": "Successful response, inspect body",
+        "summary": "Replaces existing Objects as a batch.",
+        "operationId": "batch.objects.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
               "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
                 },
-                "scopes": {
-                  "description": "OAuth Scopes",
+                "objects": {
                   "type": "array",
                   "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
+                    "$ref": "#/definitions/Object"
+                  }
                 }
               }
             }
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6491,36 +9481,66 @@ func init() {
           }
         },
         "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "summary": "Get application readiness.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
-      }
-    },
-    "/authz/roles": {
-      "get": {
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
         ],
-        "summary": "Get all roles",
-        "operationId": "getRoles",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
             }
           },
           "400": {
@@ -6538,6 +9558,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6545,29 +9571,48 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.get.roles"
+          "weaviate.local.add"
         ]
       },
-      "post": {
+      "delete": {
+        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "create new role",
-        "operationId": "createRole",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Role"
+              "$ref": "#/definitions/BatchDelete"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Role created successfully"
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -6584,12 +9629,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "409": {
-            "description": "Role already exists",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -6603,48 +9642,49 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.create.role"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/authz/roles/add-permissions": {
+    "/batch/objects/stream": {
       "post": {
+        "description": "Create new objects in bulk from a stream of newline-delimited JSON (NDJSON), one object per line. \\u003cbr/\\u003e\\u003cbr/\\u003eObjects are imported in sub-batches as they arrive, so the request body is never held in memory as a whole. Meta-data and schema values are validated. \\u003cbr/\\u003e\\u003cbr/\\u003eThe response summarizes the import and lists the objects which failed, identified by their line. Lines which cannot be parsed fail individually and don't stop the import.",
+        "consumes": [
+          "application/x-ndjson"
+        ],
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "Add permission to a role as an upsert. If the role doesn't exist then it will be created.",
-        "operationId": "addPermissions",
+        "summary": "Creates new Objects from a stream of newline-delimited JSON.",
+        "operationId": "batch.objects.stream",
         "parameters": [
           {
+            "description": "Newline-delimited JSON, one object per line",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "name",
-                "permissions"
-              ],
-              "properties": {
-                "name": {
-                  "description": "role name",
-                  "type": "string"
-                },
-                "permissions": {
-                  "description": "permissions to be added to the role",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
+              "type": "string",
+              "format": "binary"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Permissions added successfully"
+            "description": "Request body was consumed, see response body to get the objects which could not be imported.",
+            "schema": {
+              "$ref": "#/definitions/BatchStreamResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -6674,48 +9714,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.add.role.permissions"
+          "weaviate.local.add"
         ]
       }
     },
-    "/authz/roles/remove-permissions": {
+    "/batch/references": {
       "post": {
+        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
         "tags": [
-          "authz"
+          "batch",
+          "references"
         ],
-        "summary": "Remove permissions from a role. If this results in an empty role, the role will be deleted.",
-        "operationId": "removePermissions",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "name",
-                "permissions"
-              ],
-              "properties": {
-                "name": {
-                  "description": "role name",
-                  "type": "string"
-                },
-                "permissions": {
-                  "description": "permissions to remove from the role",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
               }
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Permissions removed successfully"
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -6745,84 +9788,41 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.remove.role.permissions"
+          "weaviate.local.add"
         ]
       }
     },
-    "/authz/roles/{id}": {
-      "get": {
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "authz"
+          "classifications"
         ],
-        "summary": "Get a role",
-        "operationId": "getRole",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "role ID",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Role"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "no role found"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.authz.get.role"
-        ]
-      },
-      "delete": {
-        "tags": [
-          "authz"
-        ],
-        "summary": "Delete role",
-        "operationId": "deleteRole",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "role ID",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6844,40 +9844,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.delete.role"
+          "weaviate.classifications.post"
         ]
       }
     },
-    "/authz/roles/{id}/users": {
+    "/classifications/{id}": {
       "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "authz"
+          "classifications"
         ],
-        "summary": "get users or a keys assigned to role",
-        "operationId": "getUsersForRole",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "description": "role ID",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Users assigned to this role",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -6890,7 +9882,7 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found for user"
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6900,27 +9892,40 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles.users"
+          "weaviate.classifications.get"
         ]
       }
     },
-    "/authz/users/own-roles": {
+    "/cluster/statistics": {
       "get": {
+        "description": "Returns Raft cluster statistics of Weaviate DB.",
         "tags": [
-          "authz"
+          "cluster"
         ],
-        "summary": "get roles assigned to own user",
-        "operationId": "getRolesForOwnUser",
+        "summary": "See Raft cluster statistics",
+        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Role assigned to own users",
+            "description": "Cluster statistics successfully returned",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "$ref": "#/definitions/ClusterStatisticsResponse"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6929,50 +9934,34 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.users.own-roles"
+          "weaviate.cluster.statistics.get"
         ]
       }
     },
-    "/authz/users/{id}/assign": {
+    "/graphql": {
       "post": {
+        "description": "Get a response based on a GraphQL query",
         "tags": [
-          "authz"
+          "graphql"
         ],
-        "summary": "Assign a role to a user",
-        "operationId": "assignRole",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "user name",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "properties": {
-                "roles": {
-                  "description": "the roles that assigned to user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Role assigned successfully"
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -6984,8 +9973,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "role is not found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6994,51 +9986,44 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.assign.role"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/authz/users/{id}/revoke": {
+    "/graphql/batch": {
       "post": {
+        "description": "Perform a batched GraphQL query. \\u003cbr/\\u003e\\u003cbr/\\u003eWith an Accept header of application/x-ndjson the responses are streamed as newline-delimited JSON instead, one GraphQLResponse per query with an additional index field holding the position of the query in the batch. Each response is written as soon as its query finishes, so the responses arrive out of order.",
+        "produces": [
+          "application/json",
+          "application/x-ndjson"
+        ],
         "tags": [
-          "authz"
+          "graphql"
         ],
-        "summary": "Revoke a role from a user",
-        "operationId": "revokeRole",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "description": "user name",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "properties": {
-                "roles": {
-                  "description": "the roles that revoked from the key or user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Role revoked successfully"
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -7050,6 +10035,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7057,22 +10048,28 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.revoke.role"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/authz/users/{id}/roles": {
-      "get": {
+    "/keys/{id}/revoke": {
+      "post": {
+        "description": "Adds the current token of the API key to the revocation list. Requests using a revoked token are rejected. Rotate the key to issue a new token.",
         "tags": [
           "authz"
         ],
-        "summary": "get roles assigned to user",
-        "operationId": "getRolesForUser",
+        "summary": "Revoke an API key",
+        "operationId": "revokeKey",
         "parameters": [
           {
             "type": "string",
-            "description": "user name",
+            "description": "API key ID",
             "name": "id",
             "in": "path",
             "required": true
@@ -7080,13 +10077,13 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Role assigned users",
+            "description": "Key revoked successfully",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "$ref": "#/definitions/ApiKey"
             }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7101,7 +10098,7 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found for user"
+            "description": "no key found"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7111,32 +10108,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.authz.revoke.key"
         ]
       }
     },
-    "/backups/{backend}": {
-      "get": {
-        "description": "[Coming soon] List all backups in progress not implemented yet.",
+    "/keys/{id}/rotate": {
+      "post": {
+        "description": "Issues a new token for the API key and revokes the current one. The user the key belongs to, and therefore its roles, stay unchanged. The new token is only returned in this response.",
         "tags": [
-          "backups"
+          "authz"
         ],
-        "summary": "List backups in progress",
-        "operationId": "backups.list",
+        "summary": "Rotate an API key",
+        "operationId": "rotateKey",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "description": "API key ID",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Existed backups",
+            "description": "Key rotated successfully, the response contains the new token",
             "schema": {
-              "$ref": "#/definitions/BackupListResponse"
+              "$ref": "#/definitions/ApiKey"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -7148,11 +10151,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup list.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "no key found"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7162,38 +10162,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.authz.rotate.key"
         ]
-      },
-      "post": {
-        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "backups"
-        ],
-        "summary": "Start a backup process",
-        "operationId": "backups.create",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
-            }
-          }
+          "meta"
         ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/Meta"
             }
           },
           "401": {
@@ -7205,12 +10190,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup creation attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7218,52 +10197,26 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
-      }
-    },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
-        "tags": [
-          "backups"
-        ],
-        "summary": "Get backup process status",
-        "operationId": "backups.create.status",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
-          }
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/modules": {
+      "get": {
+        "description": "Returns the enabled modules, their capabilities, the module config defaults they set and the health of their upstream inference services. Can be used to verify a deployment before relying on module-specific query arguments.",
+        "tags": [
+          "meta"
         ],
+        "summary": "List the enabled modules.",
+        "operationId": "modules.list",
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/ModulesListResponse"
             }
           },
           "401": {
@@ -7275,18 +10228,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7295,47 +10236,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query.meta"
         ]
-      },
-      "delete": {
-        "description": "Cancel created backup with specified ID",
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns node information for the entire database.",
         "tags": [
-          "backups"
+          "nodes"
         ],
-        "summary": "Cancel backup",
-        "operationId": "backups.cancel",
+        "summary": "Node information for the database.",
+        "operationId": "nodes.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
+            "default": "minimal",
+            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+            "name": "output",
             "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Nodes status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesStatusResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7346,8 +10273,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid backup cancellation attempt.",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7360,51 +10293,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.nodes.status.get"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
+    "/nodes/{className}": {
       "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
+        "description": "Returns node information for the nodes relevant to the collection.",
         "tags": [
-          "backups"
+          "nodes"
         ],
-        "summary": "Get restore process status",
-        "operationId": "backups.restore.status",
+        "summary": "Node information for a collection.",
+        "operationId": "nodes.get.class",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
+            "default": "minimal",
+            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+            "name": "output",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -7422,6 +10342,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7430,45 +10356,82 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.nodes.status.get.class"
         ]
-      },
-      "post": {
-        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "backups"
+          "objects"
         ],
-        "summary": "Start a restoration process",
-        "operationId": "backups.restore",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
+            "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eWithout ` + "`" + `class` + "`" + `, pass the ` + "`" + `nextCursor` + "`" + ` of the previous page, or the nil UUID to start, to page through all collections. Typically used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
+            "name": "limit",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
-            }
+            "type": "string",
+            "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -7481,13 +10444,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7499,50 +10459,26 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
-      }
-    },
-    "/batch/objects": {
-      "post": {
-        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
-        "tags": [
-          "batch",
-          "objects"
-        ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Create a new object.",
+        "operationId": "objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
             }
           },
           {
@@ -7554,12 +10490,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Object created.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
+              "$ref": "#/definitions/Object"
             }
           },
           "400": {
@@ -7595,28 +10528,28 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.add"
         ]
-      },
-      "delete": {
-        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
+      }
+    },
+    "/objects/.deleted": {
+      "get": {
+        "description": "Lists the soft deleted objects of a collection which can still be restored. \u003cbr/\u003e\u003cbr/\u003eDeleted objects are only kept in the trash if soft delete is enabled by setting ` + "`" + `SOFT_DELETE_RETENTION` + "`" + `, and are purged once the retention expires. The time of deletion is returned as additional property ` + "`" + `deletionTimeUnix` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eThe trash is kept on the nodes of a shard and is not replicated, the shards of all nodes are listed from the replica owning the shard.",
         "tags": [
-          "batch",
           "objects"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "List soft deleted objects of a class.",
+        "operationId": "objects.trash.list",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
+            "type": "string",
+            "description": "The collection to list the trash of.",
+            "name": "class",
+            "in": "query",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
             "in": "query"
           },
           {
@@ -7628,15 +10561,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ObjectsListResponse"
             }
           },
           "401": {
@@ -7649,7 +10576,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7664,53 +10591,57 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
+    "/objects/by/{className}/{propertyName}/{value}": {
+      "get": {
+        "description": "Get the data object of a collection whose property is equal to the given value, e.g. an external ID stored on the object. \u003cbr/\u003e\u003cbr/\u003eThe lookup uses the inverted index of the property, just like a Get query with an Equal where filter, so the property needs to be indexed. Text properties need ` + "`" + `field` + "`" + ` tokenization, so that their value is matched as a whole. Properties are not unique, a value matching more than one object is rejected.",
         "tags": [
-          "batch",
-          "references"
+          "objects"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Get an object based on its collection and the value of one of its properties.",
+        "operationId": "objects.class.lookup",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Name of the property to look up the object by.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Value of the property, parsed according to the data type of the property. Slashes in the value need to be URL-encoded as %2F.",
+            "name": "value",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -7722,8 +10653,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7738,38 +10672,45 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query"
         ]
       }
     },
-    "/classifications/": {
+    "/objects/multipart": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Create a new object and upload files to its blob properties in a single multipart request. \u003cbr/\u003e\u003cbr/\u003eThe object is sent as JSON in the form field ` + "`" + `object` + "`" + `. Every other form field is a file, which is stored in the blob storage of the server. The name of the field is the name of the blob property the file is attached to. \u003cbr/\u003e\u003cbr/\u003eThe blob properties of the created object hold references to the stored files, which can be downloaded with GET /objects/{className}/{id}/blobs/{propertyName}.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Create a new object with files attached to its blob properties.",
+        "operationId": "objects.create.multipart",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "type": "string",
+            "description": "The object to create as JSON, without the contents of the uploaded files.",
+            "name": "object",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
+          "200": {
+            "description": "Object created.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Object"
             }
           },
           "400": {
-            "description": "Incorrect request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7783,6 +10724,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7790,34 +10737,34 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.add"
         ]
       }
     },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
           {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7828,8 +10775,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7838,77 +10788,78 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/cluster/statistics": {
+    "/objects/{className}/{id}": {
       "get": {
-        "description": "Returns Raft cluster statistics of Weaviate DB.",
+        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
         "tags": [
-          "cluster"
+          "objects"
         ],
-        "summary": "See Raft cluster statistics",
-        "operationId": "cluster.get.statistics",
-        "responses": {
-          "200": {
-            "description": "Cluster statistics successfully returned",
-            "schema": {
-              "$ref": "#/definitions/ClusterStatisticsResponse"
-            }
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
           },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The target node which should fulfill the request",
+            "name": "node_name",
+            "in": "query"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.cluster.statistics.get"
-        ]
-      }
-    },
-    "/graphql": {
-      "post": {
-        "description": "Get a response based on a GraphQL query",
-        "tags": [
-          "graphql"
-        ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
-        "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Entity tag of the object. Pass it as If-Match to updates to only apply them if the object is unchanged."
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -7920,8 +10871,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7936,37 +10890,57 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "put": {
+        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
         "tags": [
-          "graphql"
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the update if the object matches one of the entity tags, as returned in the ETag header when getting the object, or if it exists for *. Otherwise 412 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -7978,49 +10952,17 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "412": {
+            "description": "The object does not match the If-Match header, it was updated since.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
-        ]
-      }
-    },
-    "/meta": {
-      "get": {
-        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
-        "tags": [
-          "meta"
-        ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Meta"
-            }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8035,32 +10977,52 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns node information for the entire database.",
+      },
+      "delete": {
+        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
         "tags": [
-          "nodes"
+          "objects"
         ],
-        "summary": "Node information for the database.",
-        "operationId": "nodes.get",
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
           {
             "type": "string",
-            "default": "minimal",
-            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-            "name": "output",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -8073,13 +11035,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8091,40 +11050,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Returns node information for the nodes relevant to the collection.",
+      },
+      "head": {
+        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
         "tags": [
-          "nodes"
+          "objects"
         ],
-        "summary": "Node information for a collection.",
-        "operationId": "nodes.get.class",
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "default": "minimal",
-            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-            "name": "output",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
-            "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8136,13 +11106,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Object doesn't exist."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8154,81 +11121,62 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
         "parameters": [
           {
             "type": "string",
-            "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
-            "name": "after",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "default": 0,
-            "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
-            "name": "sort",
-            "in": "query"
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
-            "name": "order",
-            "in": "query"
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           {
             "type": "string",
-            "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-            "name": "class",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "description": "Only apply the update if the object matches one of the entity tags, as returned in the ETag header when getting the object, or if it exists for *. Otherwise 412 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
+          "204": {
+            "description": "Successfully applied. No content provided."
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "The patch-JSON is malformed.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8245,8 +11193,14 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The object does not match the If-Match header, it was updated since.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8261,43 +11215,62 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
-      },
-      "post": {
-        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
+      }
+    },
+    "/objects/{className}/{id}/blobs/{propertyName}": {
+      "get": {
+        "description": "Download the file which was uploaded to a blob property of an object with POST /objects/multipart.",
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
         "tags": [
           "objects"
         ],
-        "summary": "Create a new object.",
-        "operationId": "objects.create",
+        "summary": "Download a file attached to a blob property of an object.",
+        "operationId": "objects.class.blob.get",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the blob property.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The contents of the file.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "file"
             }
           },
           "401": {
@@ -8309,8 +11282,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The object does not exist or no file was uploaded to the property."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8325,31 +11301,52 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query"
         ]
       }
     },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
+    "/objects/{className}/{id}/history": {
+      "get": {
+        "description": "Lists the prior versions of an object, latest first. \u003cbr/\u003e\u003cbr/\u003eVersions are only kept if object history is enabled by setting ` + "`" + `OBJECT_HISTORY_VERSIONS` + "`" + `, which is the number of versions kept per object. They are dropped when the object is deleted.",
         "tags": [
           "objects"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "List the prior versions of an object based on its class and UUID.",
+        "operationId": "objects.class.history",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8361,7 +11358,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8376,21 +11373,22 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query"
         ]
       }
     },
-    "/objects/{className}/{id}": {
-      "get": {
-        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace **all** references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -8405,20 +11403,23 @@ func init() {
           },
           {
             "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
           },
           {
             "type": "string",
-            "description": "The target node which should fulfill the request",
-            "name": "node_name",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           },
           {
@@ -8430,10 +11431,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "description": "Successfully replaced all the references."
           },
           "400": {
             "description": "Malformed request.",
@@ -8451,10 +11449,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8469,19 +11467,20 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
+      "post": {
+        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
         "tags": [
           "objects"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -8489,17 +11488,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
@@ -8507,13 +11513,22 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Successfully added the reference."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -8526,10 +11541,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8548,15 +11563,16 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -8569,6 +11585,21 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
           {
             "type": "string",
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
@@ -8602,10 +11633,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8617,23 +11651,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "head": {
-        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
+      }
+    },
+    "/objects/{className}/{id}/restore": {
+      "post": {
+        "description": "Restores a soft deleted object from the trash as it was when it was deleted. \u003cbr/\u003e\u003cbr/\u003eCreating an object with the same UUID also removes it from the trash, so an object can only be restored while no object with its UUID exists.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "summary": "Restore a soft deleted object based on its class and UUID.",
+        "operationId": "objects.class.restore",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -8641,7 +11676,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -8660,8 +11695,11 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successfully restored.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8673,7 +11711,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "The object is not in the trash."
           },
           "422": {
             "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
@@ -8688,39 +11726,97 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
@@ -8733,13 +11829,10 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
+          "200": {
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -8755,7 +11848,7 @@ func init() {
             "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8772,24 +11865,16 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace **all** references in cross-reference property of an object.",
+      },
+      "delete": {
+        "description": "Deletes an object from the database based on its UUID.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -8798,21 +11883,6 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
           {
             "type": "string",
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
@@ -8827,14 +11897,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8846,13 +11910,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -8861,27 +11919,68 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "post": {
-        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
+      "head": {
+        "description": "Checks if an object exists in the system based on its UUID.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.objects.check"
+        ]
+      },
+      "patch": {
+        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
@@ -8891,18 +11990,11 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
@@ -8910,23 +12002,14 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully added the reference."
+          "204": {
+            "description": "Successfully applied. No content provided."
           },
           "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "The patch-JSON is malformed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8938,10 +12021,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8958,22 +12041,18 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -8994,15 +12073,9 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/MultipleRef"
             }
           },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
           {
             "type": "string",
             "description": "Specifies the tenant in a request targeting a multi-tenant class",
@@ -9011,14 +12084,8 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9029,12 +12096,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -9053,16 +12114,14 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}": {
-      "get": {
-        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
+      },
+      "post": {
+        "description": "Add a cross-reference.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
         "deprecated": true,
         "parameters": [
           {
@@ -9075,23 +12134,29 @@ func init() {
           },
           {
             "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9102,8 +12167,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -9115,16 +12183,16 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
         "deprecated": true,
         "parameters": [
           {
@@ -9135,27 +12203,31 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9167,10 +12239,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Successful query result but no resource was found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9187,40 +12256,37 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Deletes an object from the database based on its UUID.",
+      }
+    },
+    "/schema": {
+      "get": {
+        "description": "Fetch an array of all collection definitions from the schema.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            },
+            "headers": {
+              "X-Weaviate-Schema-Hash": {
+                "type": "string",
+                "description": "Hash of the current schema. Pass it as If-Match to schema changes to only apply them if the schema is unchanged."
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9231,9 +12297,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9241,33 +12304,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
       },
-      "head": {
-        "description": "Checks if an object exists in the system based on its UUID.",
+      "post": {
+        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
         "parameters": [
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9278,8 +12347,17 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
+          "409": {
+            "description": "A class with the same name already exists, or the schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -9288,50 +12366,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.objects.check"
+          "weaviate.local.add.meta"
         ]
-      },
-      "patch": {
-        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/import": {
+      "post": {
+        "description": "Add all classes of a schema, as returned by ` + "`" + `GET /v1/schema` + "`" + `, e.g. to migrate a schema between instances. All classes are validated, including cross-references between them, before any class is added. If adding a class fails, the classes added before are removed again, so that either all or none of the classes are added.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "summary": "Import a previously dumped schema.",
+        "operationId": "schema.import",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
+            "name": "schema",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Schema"
             }
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Added all classes of the schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9342,11 +12411,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "409": {
+            "description": "A class with the same name as one of the imported classes already exists, or the schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid schema. None of the classes have been added.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9358,56 +12430,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references in cross-reference property of an object.",
+    "/schema/validate": {
+      "post": {
+        "description": "Compare a proposed schema, in the format returned by ` + "`" + `GET /v1/schema` + "`" + `, with the current schema. The response lists the added, removed and changed classes and properties, as well as all validation errors, e.g. invalid module configuration or changes to immutable settings. Nothing is applied. Removed classes are only reported, they are never validation errors, since classes have to be deleted explicitly.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "summary": "Validate a proposed schema without applying it.",
+        "operationId": "schema.validate",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "schema",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/Schema"
             }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "The differences between the current and the proposed schema. Check ` + "`" + `valid` + "`" + ` and ` + "`" + `errors` + "`" + ` to see whether the proposed schema could be applied.",
+            "schema": {
+              "$ref": "#/definitions/SchemaDiff"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9419,7 +12470,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "The proposed schema could not be read, e.g. because it contains a class without a name.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9431,54 +12482,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
-      },
-      "post": {
-        "description": "Add a cross-reference.",
+      }
+    },
+    "/schema/{className}": {
+      "get": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Found the Class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9489,11 +12525,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -9502,54 +12535,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.get.meta"
         ]
       },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      "put": {
+        "description": "Add a property to an existing collection.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Class"
             }
           },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9561,7 +12585,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9573,35 +12609,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/schema": {
-      "get": {
-        "description": "Fetch an array of all collection definitions from the schema.",
+      },
+      "delete": {
+        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
             "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -9613,6 +12653,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "The schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9621,31 +12667,44 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      },
+      }
+    },
+    "/schema/{className}/properties": {
       "post": {
-        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
-            "name": "objectClass",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Property"
             }
+          },
+          {
+            "type": "string",
+            "description": "Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Added the property.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -9657,8 +12716,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A property with the same name already exists, or the schema changed since the given schema hash.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9671,17 +12736,18 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema/{className}": {
-      "get": {
+    "/schema/{className}/properties/{propertyName}/lifecycle": {
+      "put": {
+        "description": "Deprecate, tombstone or reactivate a property. \u003cbr/\u003e\u003cbr/\u003eWriting a deprecated or tombstoned property is rejected, tombstoned properties are additionally hidden from GraphQL. The data of tombstoned properties is kept until it is removed with ` + "`" + `POST /schema/{className}/properties/{propertyName}/purge` + "`" + `.",
         "tags": [
           "schema"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Change the lifecycle of a property.",
+        "operationId": "schema.objects.properties.lifecycle.update",
         "parameters": [
           {
             "type": "string",
@@ -9690,18 +12756,25 @@ func init() {
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PropertyLifecycle"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the Class, returned as body",
+            "description": "Changed the lifecycle of the property.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -9714,7 +12787,16 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist"
+            "description": "Class or property not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The property can't be moved to the given lifecycle.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -9724,16 +12806,18 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "put": {
-        "description": "Add a property to an existing collection.",
+      }
+    },
+    "/schema/{className}/properties/{propertyName}/purge": {
+      "post": {
+        "description": "Removes the values of a tombstoned property from all objects of the class in the background. The property itself stays in the schema as tombstoned. \u003cbr/\u003e\u003cbr/\u003eThe request returns as soon as the job is started, its status can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + `.",
         "tags": [
           "schema"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Purge the data of a tombstoned property.",
+        "operationId": "schema.objects.properties.purge",
         "parameters": [
           {
             "type": "string",
@@ -9742,19 +12826,23 @@ func init() {
             "required": true
           },
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Class was updated successfully",
+          "202": {
+            "description": "Job started, use its id to query its status.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/BatchJob"
             }
           },
           "401": {
@@ -9767,13 +12855,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
+            "description": "Class or property not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "The property is not tombstoned.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9786,32 +12874,51 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
+      }
+    },
+    "/schema/{className}/properties/{propertyName}/rename": {
+      "post": {
+        "description": "Adds a property with the new name and the same settings, deprecates the old property and starts a job in the background which moves the values of all objects from the old to the new property. Once all objects are migrated, the old property is tombstoned. Objects are not vectorized again. \u003cbr/\u003e\u003cbr/\u003eThe request returns as soon as the job is started, its status can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + `. Until the job is done, some objects only have a value for the old property.",
         "tags": [
           "schema"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Rename a property without reimporting its data.",
+        "operationId": "schema.objects.properties.rename",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RenameRequest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
+          "202": {
+            "description": "Job started, use its id to query its status.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BatchJob"
             }
           },
           "401": {
@@ -9823,6 +12930,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class or property not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid new name, the new name is already in use or the property is not active.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9835,13 +12954,14 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/properties": {
+    "/schema/{className}/rename": {
       "post": {
+        "description": "Makes the shards of the class read-only, adds a class with the new name and the same settings and tenants, then starts a job in the background which copies all objects including their vectors to the new class. Once all objects are copied, the old class is kept read-only and has to be deleted after switching to the new class. Until then the objects of the class are stored twice, set ` + "`" + `deleteOldClass` + "`" + ` to delete the old class as soon as the copy is complete. References of the class to itself are renamed as well, classes which are referenced by other classes can't be renamed. \u003cbr/\u003e\u003cbr/\u003eThe request returns as soon as the job is started, its status can be queried with ` + "`" + `GET /batch/jobs/{id}` + "`" + `. If any object can't be copied, the job fails, both classes are kept and the old class is writable again.",
         "tags": [
           "schema"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Rename a class without reimporting its data.",
+        "operationId": "schema.objects.rename",
         "parameters": [
           {
             "type": "string",
@@ -9854,15 +12974,21 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/RenameRequest"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the property.",
+          "202": {
+            "description": "Job started, use its id to query its status.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/BatchJob"
             }
           },
           "401": {
@@ -9874,8 +13000,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid property.",
+            "description": "Invalid new name, the new name is already in use, the class is referenced by another class or it has inactive tenants.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -10374,6 +13506,61 @@ func init() {
         "type": "object"
       }
     },
+    "ApiKey": {
+      "description": "An API key configured for this instance.",
+      "type": "object",
+      "required": [
+        "id",
+        "user"
+      ],
+      "properties": {
+        "id": {
+          "description": "stable identifier of the key, derived from the configured key",
+          "type": "string"
+        },
+        "key": {
+          "description": "the newly issued token, only returned once when the key is rotated",
+          "type": "string"
+        },
+        "revoked": {
+          "description": "whether the current token of the key has been revoked",
+          "type": "boolean"
+        },
+        "user": {
+          "description": "the user the key authenticates as",
+          "type": "string"
+        }
+      }
+    },
+    "AsyncReplicationStatus": {
+      "description": "The outcome of the most recent comparisons of a shard with its other replicas. Only present if async replication is enabled.",
+      "properties": {
+        "lagSeconds": {
+          "description": "Seconds the shard has been out of sync with its other replicas as of the last comparison. Zero if it was in sync.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastInSync": {
+          "description": "Unix time in milliseconds of the last comparison which found the shard in sync with all other replicas. Zero if that has not happened yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastSync": {
+          "description": "Unix time in milliseconds of the last completed comparison with the other replicas.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsOutOfSync": {
+          "description": "The number of objects which diverged from other replicas and were propagated by the last comparison.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -10429,6 +13616,10 @@ func init() {
           "description": "name of the endpoint, e.g. s3.amazonaws.com",
           "type": "string"
         },
+        "IncrementalBaseBackupID": {
+          "description": "ID of a successful backup on the same backend, bucket and path to take an incremental backup against. Only files which changed since are stored, unchanged ones are referenced from the base backup, which therefore must be kept.",
+          "type": "string"
+        },
         "Path": {
           "description": "Path or key within the bucket",
           "type": "string"
@@ -10584,6 +13775,17 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
+        "allow_unsigned": {
+          "description": "Restores the backup even if it is not signed although an encryption key is configured, e.g. a backup taken before the key was configured. Signatures which are present are still checked.",
+          "type": "boolean"
+        },
+        "class_mapping": {
+          "description": "Allows restoring classes under different names. Keys are class names in the backup, values are the new names. Useful when cloning data into an environment which already holds the original classes.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process",
           "type": "object",
@@ -10609,6 +13811,16 @@ func init() {
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "tenants": {
+          "description": "Allows restoring only some tenants of multi-tenant classes. Keys are class names in the backup, values are the tenants to restore.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
         }
       }
     },
@@ -10839,53 +14051,128 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
-        "limit": {
-          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-          "type": "number",
+        "limit": {
+          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "matches": {
+          "description": "How many objects were matched by the filter.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+          }
+        },
+        "successful": {
+          "description": "How many objects were successfully deleted in this round.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BatchDeleteResponseResultsObjectsItems0": {
+      "description": "Results for this specific Object.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "DRYRUN",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchJob": {
+      "description": "A batch of objects which is imported in the background.",
+      "properties": {
+        "endTimeUnix": {
+          "description": "When the job finished, in milliseconds since epoch UTC. Not set while the job is running.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "Error message if the job as a whole failed. Errors of single objects are part of their result.",
+          "type": "string"
+        },
+        "failed": {
+          "description": "How many of the processed objects could not be imported.",
+          "type": "integer",
           "format": "int64",
           "x-omitempty": false
         },
-        "matches": {
-          "description": "How many objects were matched by the filter.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+        "id": {
+          "description": "The ID of the job, used to query its status.",
+          "type": "string"
         },
         "objects": {
-          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+          "description": "The result of every processed object, in the order they were sent. Only the id of an object is returned with its result. Objects which were not processed yet are omitted.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+            "$ref": "#/definitions/ObjectsGetResponse"
           }
         },
-        "successful": {
-          "description": "How many objects were successfully deleted in this round.",
+        "percentage": {
+          "description": "Share of the processed objects, from 0 to 100.",
           "type": "number",
+          "format": "float",
+          "x-omitempty": false
+        },
+        "processed": {
+          "description": "How many objects were processed so far.",
+          "type": "integer",
           "format": "int64",
           "x-omitempty": false
-        }
-      }
-    },
-    "BatchDeleteResponseResultsObjectsItems0": {
-      "description": "Results for this specific Object.",
-      "format": "object",
-      "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
         },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
+        "startTimeUnix": {
+          "description": "When the job was started, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
         "status": {
+          "description": "Phase of the job.",
           "type": "string",
-          "default": "SUCCESS",
           "enum": [
+            "STARTED",
             "SUCCESS",
-            "DRYRUN",
             "FAILED"
           ]
+        },
+        "total": {
+          "description": "How many objects were sent.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorsRecomputed": {
+          "description": "How many vectors were computed by vectorizer modules. Every target vector of an object counts separately.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorsSkipped": {
+          "description": "How many vectors of existing objects were kept without calling the vectorizer, because none of the vectorized properties changed.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
@@ -10973,6 +14260,48 @@ func init() {
         }
       }
     },
+    "BatchStreamError": {
+      "description": "An object of a streamed batch import which could not be imported.",
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "description": "ID of the object, if it could be read.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "line": {
+          "description": "Line of the object in the stream, starting at 1.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BatchStreamResponse": {
+      "description": "Summary of a streamed batch import.",
+      "properties": {
+        "errors": {
+          "description": "The objects which could not be imported.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchStreamError"
+          }
+        },
+        "failed": {
+          "description": "How many objects could not be imported.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "How many objects were read from the stream.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "C11yExtension": {
       "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
@@ -11012,7 +14341,7 @@ func init() {
       }
     },
     "C11yVector": {
-      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting.",
+      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting. On writes, the vector can also be sent as a base64-encoded string (standard encoding with padding) of its values as little-endian float32, which is about a quarter of the size. NaN and infinite values are rejected.",
       "type": "array",
       "items": {
         "type": "number",
@@ -11164,6 +14493,9 @@ func init() {
           "description": "Description of the collection for metadata purposes.",
           "type": "string"
         },
+        "encryptionConfig": {
+          "$ref": "#/definitions/EncryptionConfig"
+        },
         "invertedIndexConfig": {
           "$ref": "#/definitions/InvertedIndexConfig"
         },
@@ -11188,6 +14520,14 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "templates": {
+          "description": "Names of the schema templates whose properties are included in the collection. Template properties are added when the collection is created and whenever the template gains new properties.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "vectorConfig": {
           "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
           "type": "object",
@@ -11420,6 +14760,21 @@ func init() {
         }
       }
     },
+    "EncryptionConfig": {
+      "description": "Configure the encryption of the objects of a collection at rest",
+      "properties": {
+        "enabled": {
+          "description": "Encrypt the objects of the collection at rest (default: false). Every shard gets its own data encryption keys, which are wrapped by the master key configured with DATA_ENCRYPTION_MASTER_KEY. Immutable.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "keyVersion": {
+          "description": "Version of the data encryption keys (default: 0). Increase it to rotate the keys of all shards, objects are then re-encrypted in the background. It can not be decreased.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "ErrorResponse": {
       "description": "An error response given by Weaviate end-points.",
       "type": "object",
@@ -11435,6 +14790,10 @@ func init() {
     "ErrorResponseErrorItems0": {
       "type": "object",
       "properties": {
+        "code": {
+          "description": "Machine-readable code of the error, only set for errors of a known kind, e.g. CLASS_EXISTS.",
+          "type": "string"
+        },
         "message": {
           "type": "string"
         }
@@ -11618,6 +14977,70 @@ func init() {
         }
       }
     },
+    "ModuleInfo": {
+      "description": "An enabled module, what it can be used for and the health of its upstream service.",
+      "type": "object",
+      "properties": {
+        "altNames": {
+          "description": "Alternative names the module can be referred to by.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "capabilities": {
+          "description": "What the module can be used for, any of ` + "`" + `vectorizer` + "`" + `, ` + "`" + `reranker` + "`" + `, ` + "`" + `generative` + "`" + `, ` + "`" + `qna` + "`" + `, ` + "`" + `ner` + "`" + `, ` + "`" + `summarizer` + "`" + `, ` + "`" + `chunker` + "`" + `, ` + "`" + `backup` + "`" + ` and ` + "`" + `offload` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "config": {
+          "description": "Defaults the module sets in the module config of classes which use it.",
+          "type": "object"
+        },
+        "error": {
+          "description": "Why the upstream service is unhealthy.",
+          "type": "string"
+        },
+        "graphQLArguments": {
+          "description": "Module-specific GraphQL arguments the module provides, e.g. ` + "`" + `nearText` + "`" + ` or ` + "`" + `ask` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the module, e.g. ` + "`" + `text2vec-openai` + "`" + `.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Health of the upstream service. ` + "`" + `UNKNOWN` + "`" + ` for modules which don't depend on an inference service of this deployment, e.g. because they call a third-party API.",
+          "type": "string",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNKNOWN"
+          ]
+        },
+        "type": {
+          "description": "Type of the module, e.g. ` + "`" + `Text2Vec` + "`" + ` or ` + "`" + `Text2TextGenerative` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "ModulesListResponse": {
+      "description": "The enabled modules.",
+      "type": "object",
+      "properties": {
+        "modules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ModuleInfo"
+          }
+        }
+      }
+    },
     "MultiTenancyConfig": {
       "description": "Configuration related to multi-tenancy within a class",
       "properties": {
@@ -11698,6 +15121,9 @@ func init() {
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
+        "asyncReplicationStatus": {
+          "$ref": "#/definitions/AsyncReplicationStatus"
+        },
         "class": {
           "description": "The name of shard's class.",
           "type": "string",
@@ -11826,6 +15252,11 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "expiresAtUnix": {
+          "description": "Timestamp in milliseconds since epoch UTC after which the object is deleted automatically. Objects without it never expire.",
+          "type": "integer",
+          "format": "int64"
+        },
         "id": {
           "description": "ID of the Object.",
           "type": "string",
@@ -11922,6 +15353,10 @@ func init() {
             "$ref": "#/definitions/Deprecation"
           }
         },
+        "nextCursor": {
+          "description": "Cursor to pass as ` + "`" + `after` + "`" + ` to retrieve the next page. Only set when the request was paged with ` + "`" + `after` + "`" + `.",
+          "type": "string"
+        },
         "objects": {
           "description": "The actual list of Objects.",
           "type": "array",
@@ -12263,6 +15698,13 @@ func init() {
     "Principal": {
       "type": "object",
       "properties": {
+        "collections": {
+          "description": "The collections the principal is limited to, e.g. by the scope of its API key. Empty if it is not limited.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "groups": {
           "type": "array",
           "items": {
@@ -12309,6 +15751,15 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "lifecycle": {
+          "description": "Lifecycle of the property, used to remove it safely in steps. ` + "`" + `deprecated` + "`" + ` properties can still be read, but writing them is rejected. ` + "`" + `tombstoned` + "`" + ` properties are additionally hidden from GraphQL, their data is kept until it is purged. Defaults to ` + "`" + `active` + "`" + `.",
+          "type": "string",
+          "enum": [
+            "active",
+            "deprecated",
+            "tombstoned"
+          ]
+        },
         "moduleConfig": {
           "description": "Configuration specific to modules this Weaviate instance has installed",
           "type": "object"
@@ -12341,6 +15792,24 @@ func init() {
         }
       }
     },
+    "PropertyLifecycle": {
+      "description": "The lifecycle a property is moved to.",
+      "type": "object",
+      "required": [
+        "lifecycle"
+      ],
+      "properties": {
+        "lifecycle": {
+          "description": "Properties move one step at a time, from ` + "`" + `active` + "`" + ` to ` + "`" + `deprecated` + "`" + ` to ` + "`" + `tombstoned` + "`" + `, or back.",
+          "type": "string",
+          "enum": [
+            "active",
+            "deprecated",
+            "tombstoned"
+          ]
+        }
+      }
+    },
     "PropertySchema": {
       "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
       "type": "object"
@@ -12463,6 +15932,20 @@ func init() {
         }
       }
     },
+    "RenameRequest": {
+      "description": "The new name of a class or property.",
+      "type": "object",
+      "properties": {
+        "deleteOldClass": {
+          "description": "Only used when renaming a class: delete the old class once all objects are copied. By default the old class is kept read-only, so that clients can be switched to the new name.",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "The new name.",
+          "type": "string"
+        }
+      }
+    },
     "ReplicationConfig": {
       "description": "Configure how replication is executed in a cluster",
       "type": "object",
@@ -12472,6 +15955,15 @@ func init() {
           "type": "boolean",
           "x-omitempty": false
         },
+        "conflictResolution": {
+          "description": "Conflict resolution strategy for objects which differ between replicas (default: LastWriteWins).",
+          "type": "string",
+          "enum": [
+            "LastWriteWins",
+            "PropertyMerge"
+          ],
+          "x-omitempty": true
+        },
         "deletionStrategy": {
           "description": "Conflict resolution strategy for deleted objects.",
           "type": "string",
@@ -12564,6 +16056,44 @@ func init() {
         }
       }
     },
+    "SchemaClassDiff": {
+      "description": "Differences between a class of the current schema and the same class of a proposed schema.",
+      "type": "object",
+      "properties": {
+        "addedProperties": {
+          "description": "Properties which only exist in the proposed class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "changedProperties": {
+          "description": "Properties which exist in both classes, but differ.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "changedSettings": {
+          "description": "Top-level class settings which differ, e.g. ` + "`" + `invertedIndexConfig` + "`" + ` or ` + "`" + `vectorIndexConfig` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "removedProperties": {
+          "description": "Properties which only exist in the current class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "SchemaClusterStatus": {
       "description": "Indicates the health of the schema in a cluster.",
       "type": "object",
@@ -12594,10 +16124,67 @@ func init() {
         }
       }
     },
+    "SchemaDiff": {
+      "description": "Result of validating a proposed schema against the current schema without applying it.",
+      "type": "object",
+      "properties": {
+        "addedClasses": {
+          "description": "Classes which only exist in the proposed schema.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "changedClasses": {
+          "description": "Classes which exist in both schemas, but differ.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaClassDiff"
+          }
+        },
+        "errors": {
+          "description": "Reasons why the proposed schema could not be applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaValidationError"
+          }
+        },
+        "removedClasses": {
+          "description": "Classes which only exist in the current schema.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "valid": {
+          "description": "True if the proposed schema could be applied, i.e. there are no errors.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "SchemaHistory": {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "SchemaValidationError": {
+      "description": "A single reason why a proposed schema could not be applied.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class the error refers to.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Description of the error.",
+          "type": "string"
+        },
+        "property": {
+          "description": "Name of the property the error refers to, empty for class-level errors.",
+          "type": "string"
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -12795,7 +16382,7 @@ func init() {
       ]
     },
     "Vector": {
-      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
+      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting. On writes, the vector can also be sent as a base64-encoded string (standard encoding with padding) of its values as little-endian float32, which is about a quarter of the size. NaN and infinite values are rejected.",
       "type": "array",
       "items": {
         "type": "number",
@@ -13022,7 +16609,7 @@ func init() {
   "parameters": {
     "CommonAfterParameterQuery": {
       "type": "string",
-      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
+      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eWithout ` + "`" + `class` + "`" + `, pass the ` + "`" + `nextCursor` + "`" + ` of the previous page, or the nil UUID to start, to page through all collections. Typically used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
       "name": "after",
       "in": "query"
     },
@@ -17,8 +17,8 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 )
 
-func createGrpcServer(state *state.State) *grpc.GRPCServer {
-	return grpc.CreateGRPCServer(state)
+func createGrpcServer(state *state.State, middlewares *runtimeMiddlewares) *grpc.GRPCServer {
+	return grpc.CreateGRPCServer(state, middlewares.currentNetworkACL)
 }
 
 func startGrpcServer(server *grpc.GRPCServer, state *state.State) {
@@ -12,23 +12,34 @@
 package rest
 
 import (
+	"errors"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
+var errRbacDisabled = errors.New("rbac is not enabled, set AUTHORIZATION_RBAC_ENABLED to manage roles")
+
 type authZHandlers struct {
 	authorizer authorization.Authorizer
+	roles      *rbac.Manager
+	keys       *apikey.Client
 	logger     logrus.FieldLogger
 	metrics    *monitoring.PrometheusMetrics
 }
 
-func setupAuthZHandlers(api *operations.WeaviateAPI, metrics *monitoring.PrometheusMetrics, authorizer authorization.Authorizer, logger logrus.FieldLogger) {
-	h := &authZHandlers{authorizer: authorizer, logger: logger, metrics: metrics}
+func setupAuthZHandlers(api *operations.WeaviateAPI, metrics *monitoring.PrometheusMetrics,
+	authorizer authorization.Authorizer, roles *rbac.Manager, keys *apikey.Client, logger logrus.FieldLogger,
+) {
+	h := &authZHandlers{authorizer: authorizer, roles: roles, keys: keys, logger: logger, metrics: metrics}
 
 	// rbac role handlers
 	api.AuthzCreateRoleHandler = authz.CreateRoleHandlerFunc(h.createRole)
@@ -39,48 +50,257 @@ func setupAuthZHandlers(api *operations.WeaviateAPI, metrics *monitoring.Prometh
 	api.AuthzRemovePermissionsHandler = authz.RemovePermissionsHandlerFunc(h.removePermission)
 
 	// rbac users handlers
+	api.AuthzGetRolesForOwnUserHandler = authz.GetRolesForOwnUserHandlerFunc(h.getRolesForOwnUser)
 	api.AuthzGetRolesForUserHandler = authz.GetRolesForUserHandlerFunc(h.getRolesForUser)
 	api.AuthzGetUsersForRoleHandler = authz.GetUsersForRoleHandlerFunc(h.getUsersForRole)
 	api.AuthzAssignRoleHandler = authz.AssignRoleHandlerFunc(h.assignRole)
 	api.AuthzRevokeRoleHandler = authz.RevokeRoleHandlerFunc(h.revokeRole)
+
+	// api key handlers
+	api.AuthzRotateKeyHandler = authz.RotateKeyHandlerFunc(h.rotateKey)
+	api.AuthzRevokeKeyHandler = authz.RevokeKeyHandlerFunc(h.revokeKey)
+}
+
+func (h *authZHandlers) rbacDisabled() bool {
+	return h.roles == nil || !h.roles.Enabled()
 }
 
 func (h *authZHandlers) createRole(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewCreateRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	name := ""
+	if params.Body != nil {
+		name = swag.StringValue(params.Body.Name)
+	}
+	if err := h.authorizer.Authorize(principal, authorization.CREATE, authorization.Roles(name)...); err != nil {
+		return authz.NewCreateRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if params.Body != nil {
+		if err := h.roles.AuthorizeGrant(principal, params.Body.Permissions); err != nil {
+			if errors.Is(err, rbac.ErrInvalidPermission) {
+				return authz.NewCreateRoleUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+			}
+			return authz.NewCreateRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	if err := h.roles.CreateRole(params.HTTPRequest.Context(), params.Body); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrRoleExists):
+			return authz.NewCreateRoleConflict().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrInvalidPermission):
+			return authz.NewCreateRoleUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewCreateRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return authz.NewCreateRoleCreated()
 }
 
 func (h *authZHandlers) addPermission(params authz.AddPermissionsParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewAddPermissionsBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	name := swag.StringValue(params.Body.Name)
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(name)...); err != nil {
+		return authz.NewAddPermissionsForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if err := h.roles.AuthorizeGrant(principal, params.Body.Permissions); err != nil {
+		if errors.Is(err, rbac.ErrInvalidPermission) {
+			return authz.NewAddPermissionsUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewAddPermissionsForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.roles.AddPermissions(params.HTTPRequest.Context(), name, params.Body.Permissions); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewAddPermissionsBadRequest().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrInvalidPermission):
+			return authz.NewAddPermissionsUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAddPermissionsInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return authz.NewAddPermissionsOK()
 }
 
 func (h *authZHandlers) removePermission(params authz.RemovePermissionsParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewRemovePermissionsBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	name := swag.StringValue(params.Body.Name)
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(name)...); err != nil {
+		return authz.NewRemovePermissionsForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.roles.RemovePermissions(params.HTTPRequest.Context(), name, params.Body.Permissions); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewRemovePermissionsBadRequest().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewRemovePermissionsInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return authz.NewRemovePermissionsOK()
 }
 
 func (h *authZHandlers) getRoles(params authz.GetRolesParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewGetRolesBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles()...); err != nil {
+		return authz.NewGetRolesForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return authz.NewGetRolesOK().WithPayload(h.roles.GetRoles())
 }
 
 func (h *authZHandlers) getRole(params authz.GetRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewGetRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles(params.ID)...); err != nil {
+		return authz.NewGetRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	roles := h.roles.GetRoles(params.ID)
+	if len(roles) == 0 {
+		return authz.NewGetRoleNotFound()
+	}
+	return authz.NewGetRoleOK().WithPayload(roles[0])
 }
 
 func (h *authZHandlers) deleteRole(params authz.DeleteRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewDeleteRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.DELETE, authorization.Roles(params.ID)...); err != nil {
+		return authz.NewDeleteRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.roles.DeleteRole(params.HTTPRequest.Context(), params.ID); err != nil {
+		return authz.NewDeleteRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewDeleteRoleNoContent()
 }
 
 func (h *authZHandlers) assignRole(params authz.AssignRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewAssignRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(params.Body.Roles...)...); err != nil {
+		return authz.NewAssignRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if err := h.roles.AuthorizeAssign(principal, params.Body.Roles...); err != nil {
+		return authz.NewAssignRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.roles.AssignRoles(params.HTTPRequest.Context(), params.ID, params.Body.Roles...); err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewAssignRoleNotFound()
+		default:
+			return authz.NewAssignRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewAssignRoleOK()
+}
+
+func (h *authZHandlers) getRolesForOwnUser(params authz.GetRolesForOwnUserParams, principal *models.Principal) middleware.Responder {
+	if h.rbacDisabled() {
+		return authz.NewGetRolesForOwnUserInternalServerError().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if principal == nil {
+		return authz.NewGetRolesForOwnUserUnauthorized()
+	}
+
+	return authz.NewGetRolesForOwnUserOK().WithPayload(h.roles.GetRolesForUser(principal.Username))
 }
 
 func (h *authZHandlers) getRolesForUser(params authz.GetRolesForUserParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewGetRolesForUserBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles()...); err != nil {
+		return authz.NewGetRolesForUserForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	return authz.NewGetRolesForUserOK().WithPayload(h.roles.GetRolesForUser(params.ID))
 }
 
 func (h *authZHandlers) getUsersForRole(params authz.GetUsersForRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewGetUsersForRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Roles(params.ID)...); err != nil {
+		return authz.NewGetUsersForRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	users, err := h.roles.GetUsersForRole(params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewGetUsersForRoleNotFound()
+		default:
+			return authz.NewGetUsersForRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewGetUsersForRoleOK().WithPayload(users)
 }
 
 func (h *authZHandlers) revokeRole(params authz.RevokeRoleParams, principal *models.Principal) middleware.Responder {
-	panic("not implemented")
+	if h.rbacDisabled() {
+		return authz.NewRevokeRoleBadRequest().WithPayload(errPayloadFromSingleErr(errRbacDisabled))
+	}
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Roles(params.Body.Roles...)...); err != nil {
+		return authz.NewRevokeRoleForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.roles.RevokeRoles(params.HTTPRequest.Context(), params.ID, params.Body.Roles...); err != nil {
+		return authz.NewRevokeRoleInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return authz.NewRevokeRoleOK()
+}
+
+func (h *authZHandlers) rotateKey(params authz.RotateKeyParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Keys(params.ID)...); err != nil {
+		return authz.NewRotateKeyForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	key, err := h.keys.Rotate(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, apikey.ErrNotEnabled):
+			return authz.NewRotateKeyBadRequest().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, apikey.ErrNotFound):
+			return authz.NewRotateKeyNotFound()
+		default:
+			return authz.NewRotateKeyInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewRotateKeyOK().WithPayload(key)
+}
+
+func (h *authZHandlers) revokeKey(params authz.RevokeKeyParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Keys(params.ID)...); err != nil {
+		return authz.NewRevokeKeyForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	key, err := h.keys.Revoke(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, apikey.ErrNotEnabled):
+			return authz.NewRevokeKeyBadRequest().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, apikey.ErrNotFound):
+			return authz.NewRevokeKeyNotFound()
+		default:
+			return authz.NewRevokeKeyInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	return authz.NewRevokeKeyOK().WithPayload(key)
 }
@@ -93,9 +93,11 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 ) middleware.Responder {
 	overrideBucket := ""
 	overridePath := ""
+	baseID := ""
 	if params.Body.Config != nil {
 		overrideBucket = params.Body.Config.Bucket
 		overridePath = params.Body.Config.Path
+		baseID = params.Body.Config.IncrementalBaseBackupID
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
 		ID:          params.Body.ID,
@@ -105,6 +107,7 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 		Include:     params.Body.Include,
 		Exclude:     params.Body.Exclude,
 		Compression: compressionFromBCfg(params.Body.Config),
+		BaseID:      baseID,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
@@ -177,14 +180,17 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 		path = params.Body.Config.Path
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
-		ID:          params.ID,
-		Backend:     params.Backend,
-		Include:     params.Body.Include,
-		Exclude:     params.Body.Exclude,
-		NodeMapping: params.Body.NodeMapping,
-		Compression: compressionFromRCfg(params.Body.Config),
-		Bucket:      bucket,
-		Path:        path,
+		ID:            params.ID,
+		Backend:       params.Backend,
+		Include:       params.Body.Include,
+		Exclude:       params.Body.Exclude,
+		NodeMapping:   params.Body.NodeMapping,
+		ClassMapping:  params.Body.ClassMapping,
+		Tenants:       params.Body.Tenants,
+		AllowUnsigned: params.Body.AllowUnsigned,
+		Compression:   compressionFromRCfg(params.Body.Config),
+		Bucket:        bucket,
+		Path:          path,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
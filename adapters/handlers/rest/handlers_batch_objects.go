@@ -12,18 +12,35 @@
 package rest
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/verbosity"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+const (
+	// streamBatchSize is how many objects of a stream are imported at once
+	streamBatchSize = 100
+	// maxStreamLineSize bounds the size of a single object of a stream
+	maxStreamLineSize = 64 * 1024 * 1024
 )
 
 type batchObjectHandlers struct {
@@ -34,7 +51,7 @@ type batchObjectHandlers struct {
 func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return batch.NewBatchObjectsCreateBadRequest().
@@ -61,9 +78,49 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 		}
 	}
 
+	if res, ok := batchBudgetExhausted(objs); ok {
+		h.metricRequestsTotal.logError("", objs[0].Err)
+		return res
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return withBatchDeprecationWarning(batch.NewBatchObjectsCreateOK().
+		WithPayload(h.objectsResponse(objs)), objs)
+}
+
+func (h *batchObjectHandlers) updateObjects(params batch.BatchObjectsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return batch.NewBatchObjectsUpdateBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	objs, err := h.manager.UpdateObjects(params.HTTPRequest.Context(), principal,
+		params.Body.Objects, params.Body.Fields, repl)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return batch.NewBatchObjectsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return batch.NewBatchObjectsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrMultiTenancy:
+			return batch.NewBatchObjectsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewBatchObjectsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
 	h.metricRequestsTotal.logOk("")
-	return batch.NewBatchObjectsCreateOK().
-		WithPayload(h.objectsResponse(objs))
+	return withBatchDeprecationWarning(batch.NewBatchObjectsUpdateOK().
+		WithPayload(h.objectsResponse(objs)), objs)
 }
 
 func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*models.ObjectsGetResponse {
@@ -89,10 +146,365 @@ func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*mod
 	return response
 }
 
+// withBatchDeprecationWarning adds a Warning header to res if any of the
+// objects failed because it wrote a deprecated property
+func withBatchDeprecationWarning(res middleware.Responder, objs objects.BatchObjects) middleware.Responder {
+	for _, obj := range objs {
+		if errors.As(obj.Err, &validation.ErrDeprecatedProperty{}) {
+			return withDeprecationWarning(res, obj.Err)
+		}
+	}
+	return res
+}
+
+// batchBudgetExhausted returns a responder if all objects failed because of
+// an exhausted module budget, so that clients can back off. Otherwise the
+// errors are reported per object as usual.
+func batchBudgetExhausted(objs objects.BatchObjects) (middleware.Responder, bool) {
+	if len(objs) == 0 {
+		return nil, false
+	}
+	for _, obj := range objs {
+		if !errors.As(obj.Err, &ratelimiter.ErrBudgetExhausted{}) {
+			return nil, false
+		}
+	}
+	return budgetExhausted(objs[0].Err)
+}
+
+func (h *batchObjectHandlers) createJob(params batch.BatchJobsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return batch.NewBatchJobsCreateBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	job, err := h.manager.AddObjectsAsync(params.HTTPRequest.Context(), principal,
+		params.Body, repl)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return batch.NewBatchJobsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return batch.NewBatchJobsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrTooManyBatchJobs:
+			return tooManyBatchJobs(err)
+		default:
+			return batch.NewBatchJobsCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return batch.NewBatchJobsCreateAccepted().
+		WithPayload(h.jobResponse(job))
+}
+
+func (h *batchObjectHandlers) getJob(params batch.BatchJobsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := h.manager.GetBatchJob(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return batch.NewBatchJobsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrNotFound:
+			return batch.NewBatchJobsGetNotFound()
+		default:
+			return batch.NewBatchJobsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return batch.NewBatchJobsGetOK().
+		WithPayload(h.jobResponse(job))
+}
+
+func (h *batchObjectHandlers) purgeProperty(params schema.SchemaObjectsPropertiesPurgeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return schema.NewSchemaObjectsPropertiesPurgeUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	job, err := h.manager.PurgeProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, repl)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return schema.NewSchemaObjectsPropertiesPurgeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrNotFound:
+			return schema.NewSchemaObjectsPropertiesPurgeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return schema.NewSchemaObjectsPropertiesPurgeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrTooManyBatchJobs:
+			return tooManyBatchJobs(err)
+		default:
+			return schema.NewSchemaObjectsPropertiesPurgeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesPurgeAccepted().
+		WithPayload(h.jobResponse(job))
+}
+
+func (h *batchObjectHandlers) renameProperty(params schema.SchemaObjectsPropertiesRenameParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return schema.NewSchemaObjectsPropertiesRenameUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	job, err := h.manager.RenameProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, params.Body.Name, repl)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return schema.NewSchemaObjectsPropertiesRenameForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrNotFound:
+			return schema.NewSchemaObjectsPropertiesRenameNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return schema.NewSchemaObjectsPropertiesRenameUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrTooManyBatchJobs:
+			return tooManyBatchJobs(err)
+		default:
+			return schema.NewSchemaObjectsPropertiesRenameInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesRenameAccepted().
+		WithPayload(h.jobResponse(job))
+}
+
+func (h *batchObjectHandlers) renameClass(params schema.SchemaObjectsRenameParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return schema.NewSchemaObjectsRenameUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	job, err := h.manager.RenameClass(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.Name, params.Body.DeleteOldClass, repl)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return schema.NewSchemaObjectsRenameForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrNotFound:
+			return schema.NewSchemaObjectsRenameNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return schema.NewSchemaObjectsRenameUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrTooManyBatchJobs:
+			return tooManyBatchJobs(err)
+		default:
+			return schema.NewSchemaObjectsRenameInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsRenameAccepted().
+		WithPayload(h.jobResponse(job))
+}
+
+// tooManyBatchJobs tells the client to retry starting a job later
+func tooManyBatchJobs(err error) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		rw.WriteHeader(http.StatusTooManyRequests)
+		if err := producer.Produce(rw, errPayloadFromSingleErr(err)); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	})
+}
+
+func (h *batchObjectHandlers) jobResponse(job *objects.BatchJob) *models.BatchJob {
+	res := &models.BatchJob{
+		ID:                job.ID,
+		Status:            job.Status,
+		Total:             int64(job.Total),
+		Processed:         int64(len(job.Objects)),
+		StartTimeUnix:     job.StartTimeUnix,
+		EndTimeUnix:       job.EndTimeUnix,
+		Objects:           h.objectsResponse(job.Objects),
+		VectorsRecomputed: job.VectorsRecomputed,
+		VectorsSkipped:    job.VectorsSkipped,
+	}
+	if job.Err != nil {
+		res.Error = job.Err.Error()
+	}
+	if job.Total > 0 {
+		res.Percentage = float32(res.Processed) / float32(job.Total) * 100
+	}
+	for _, obj := range job.Objects {
+		if obj.Err != nil {
+			res.Failed++
+		}
+	}
+	return res
+}
+
+func (h *batchObjectHandlers) streamObjects(params batch.BatchObjectsStreamParams,
+	principal *models.Principal,
+) middleware.Responder {
+	defer params.Body.Close()
+
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return batch.NewBatchObjectsStreamBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	res := &models.BatchStreamResponse{Errors: []*models.BatchStreamError{}}
+	onErr := func(line int64, id strfmt.UUID, err error) {
+		res.Errors = append(res.Errors, &models.BatchStreamError{
+			Line:  line,
+			ID:    id,
+			Error: errPayloadFromSingleErr(err),
+		})
+	}
+	res.Objects, err = readObjectStream(params.Body, streamBatchSize,
+		func(lines []int64, objs []*models.Object) error {
+			imported, err := h.manager.AddObjects(ctx, principal, objs, nil, repl)
+			if err != nil {
+				return err
+			}
+			for _, obj := range imported {
+				if obj.Err != nil {
+					onErr(lines[obj.OriginalIndex], obj.UUID, obj.Err)
+				}
+			}
+			return nil
+		}, onErr)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &errStream{}):
+			return batch.NewBatchObjectsStreamBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return batch.NewBatchObjectsStreamForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &objects.ErrInvalidUserInput{}),
+			errors.As(err, &objects.ErrMultiTenancy{}):
+			return batch.NewBatchObjectsStreamUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewBatchObjectsStreamInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	res.Failed = int64(len(res.Errors))
+
+	h.metricRequestsTotal.logOk("")
+	return batch.NewBatchObjectsStreamOK().WithPayload(res)
+}
+
+// errStream is returned if the stream itself cannot be read
+type errStream struct{ error }
+
+func (e errStream) Unwrap() error { return e.error }
+
+// readObjectStream reads newline-delimited JSON objects from r and passes
+// them to fn in batches of up to size objects, together with their lines.
+// Lines which don't hold a valid object are reported to onErr and skipped,
+// empty lines are ignored. It returns how many objects were read.
+func readObjectStream(r io.Reader, size int,
+	fn func(lines []int64, objs []*models.Object) error,
+	onErr func(line int64, id strfmt.UUID, err error),
+) (int64, error) {
+	var (
+		count int64
+		line  int64
+		lines = make([]int64, 0, size)
+		objs  = make([]*models.Object, 0, size)
+	)
+	flush := func() error {
+		if len(objs) == 0 {
+			return nil
+		}
+		err := fn(lines, objs)
+		lines, objs = lines[:0], make([]*models.Object, 0, size)
+		return err
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxStreamLineSize)
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		count++
+
+		obj := &models.Object{}
+		// numbers are kept as json.Number, just as for a regular batch
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(obj); err != nil {
+			onErr(line, "", fmt.Errorf("parse object: %w", err))
+			continue
+		}
+		if err := obj.Validate(strfmt.Default); err != nil {
+			onErr(line, obj.ID, err)
+			continue
+		}
+
+		lines, objs = append(lines, line), append(objs, obj)
+		if len(objs) == size {
+			if err := flush(); err != nil {
+				return count, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			err = fmt.Errorf("line %d exceeds %d bytes", line+1, maxStreamLineSize)
+		}
+		return count, errStream{fmt.Errorf("read stream: %w", err)}
+	}
+	return count, flush()
+}
+
 func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return batch.NewBatchReferencesCreateBadRequest().
@@ -153,14 +565,14 @@ func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences)
 func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return batch.NewBatchObjectsDeleteBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	tenant := getTenant(params.Tenant)
+	tenant := getTenant(params.HTTPRequest.Context(), params.Tenant)
 
 	res, err := h.manager.DeleteObjects(params.HTTPRequest.Context(), principal,
 		params.Body.Match, params.Body.DeletionTimeUnixMilli, params.Body.DryRun, params.Body.Output, repl, tenant)
@@ -248,6 +660,20 @@ func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.Batc
 		BatchReferencesCreateHandlerFunc(h.addReferences)
 	api.BatchBatchObjectsDeleteHandler = batch.
 		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
+	api.BatchBatchObjectsUpdateHandler = batch.
+		BatchObjectsUpdateHandlerFunc(h.updateObjects)
+	api.BatchBatchObjectsStreamHandler = batch.
+		BatchObjectsStreamHandlerFunc(h.streamObjects)
+	api.BatchBatchJobsCreateHandler = batch.
+		BatchJobsCreateHandlerFunc(h.createJob)
+	api.BatchBatchJobsGetHandler = batch.
+		BatchJobsGetHandlerFunc(h.getJob)
+	api.SchemaSchemaObjectsPropertiesPurgeHandler = schema.
+		SchemaObjectsPropertiesPurgeHandlerFunc(h.purgeProperty)
+	api.SchemaSchemaObjectsPropertiesRenameHandler = schema.
+		SchemaObjectsPropertiesRenameHandlerFunc(h.renameProperty)
+	api.SchemaSchemaObjectsRenameHandler = schema.
+		SchemaObjectsRenameHandlerFunc(h.renameClass)
 }
 
 type batchRequestsTotal struct {
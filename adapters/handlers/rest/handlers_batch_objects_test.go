@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestReadObjectStream(t *testing.T) {
+	type failure struct {
+		line int64
+		id   strfmt.UUID
+	}
+	var (
+		stream = strings.Join([]string{
+			`{"class":"A","properties":{"n":1}}`,
+			``,
+			`{"class":"A","id":"6c9fd2e8-1d0e-4b7d-9c38-4a6c4b1f3c3a"}`,
+			`{"class":"A",`,
+			`{"class":"A","id":"not-a-uuid"}`,
+			`  {"class":"B"}  `,
+			`{"class":"B"}`,
+		}, "\n")
+		lines    [][]int64
+		classes  []string
+		failures []failure
+	)
+	count, err := readObjectStream(strings.NewReader(stream), 2,
+		func(ls []int64, objs []*models.Object) error {
+			lines = append(lines, append([]int64{}, ls...))
+			for _, obj := range objs {
+				classes = append(classes, obj.Class)
+			}
+			return nil
+		},
+		func(line int64, id strfmt.UUID, err error) {
+			require.NotNil(t, err)
+			failures = append(failures, failure{line, id})
+		})
+	require.Nil(t, err)
+
+	assert.Equal(t, int64(6), count)
+	assert.Equal(t, [][]int64{{1, 3}, {6, 7}}, lines)
+	assert.Equal(t, []string{"A", "A", "B", "B"}, classes)
+	assert.Equal(t, []failure{{4, ""}, {5, "not-a-uuid"}}, failures)
+
+	t.Run("NumbersAreKept", func(t *testing.T) {
+		var got any
+		_, err := readObjectStream(strings.NewReader(`{"class":"A","properties":{"n":1}}`), 10,
+			func(_ []int64, objs []*models.Object) error {
+				got = objs[0].Properties.(map[string]any)["n"]
+				return nil
+			}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, json.Number("1"), got)
+	})
+
+	t.Run("ImportFails", func(t *testing.T) {
+		errImport := errors.New("import failed")
+		calls := 0
+		_, err := readObjectStream(strings.NewReader(stream), 2,
+			func([]int64, []*models.Object) error {
+				calls++
+				return errImport
+			}, func(int64, strfmt.UUID, error) {})
+		assert.ErrorIs(t, err, errImport)
+		assert.Equal(t, 1, calls, "the stream must not be read any further")
+	})
+}
+
+func TestBatchJobResponse(t *testing.T) {
+	h := &batchObjectHandlers{}
+	res := h.jobResponse(&objects.BatchJob{
+		ID:     "job",
+		Status: models.BatchJobStatusSTARTED,
+		Total:  4,
+		Objects: objects.BatchObjects{
+			{Object: &models.Object{Class: "A"}, UUID: "6c9fd2e8-1d0e-4b7d-9c38-4a6c4b1f3c3a"},
+			{Object: &models.Object{Class: "A"}, Err: errors.New("invalid")},
+		},
+	})
+
+	assert.Equal(t, "job", res.ID)
+	assert.Equal(t, int64(4), res.Total)
+	assert.Equal(t, int64(2), res.Processed)
+	assert.Equal(t, int64(1), res.Failed)
+	assert.Equal(t, float32(50), res.Percentage)
+	require.Len(t, res.Objects, 2)
+	assert.Equal(t, models.ObjectsGetResponseAO2ResultStatusSUCCESS, *res.Objects[0].Result.Status)
+	assert.Equal(t, strfmt.UUID("6c9fd2e8-1d0e-4b7d-9c38-4a6c4b1f3c3a"), res.Objects[0].ID)
+	assert.Equal(t, models.ObjectsGetResponseAO2ResultStatusFAILED, *res.Objects[1].Result.Status)
+	assert.Empty(t, res.Error)
+}
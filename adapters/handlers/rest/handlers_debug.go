@@ -14,14 +14,21 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/benchmark"
 	"github.com/weaviate/weaviate/entities/config"
 	"github.com/weaviate/weaviate/entities/schema"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/telemetry"
 )
 
 func setupDebugHandlers(appState *state.State) {
@@ -182,4 +189,297 @@ func setupDebugHandlers(appState *state.State) {
 		w.WriteHeader(http.StatusOK)
 		w.Write(jsonBytes)
 	}))
+
+	// lists conflicts between replicas of this node which were not resolved
+	// silently, e.g. because the conflict resolution strategies disagree
+	http.HandleFunc("/debug/replication/conflicts", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jsonBytes, err := json.Marshal(appState.DB.ReplicationConflicts())
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on replication conflicts")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+
+	// lists the locks which are currently held on this node, longest held
+	// first, to find out what a stalled request is waiting for
+	http.HandleFunc("/debug/locks", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jsonBytes, err := json.Marshal(appState.LockMonitor.Holders())
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on lock holders")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+
+	// runs a vector search benchmark on a temporary index. The index settings
+	// are taken from the given collection (or the defaults) and can be
+	// overridden through query params. This is only served on the debug port,
+	// which must not be exposed publicly.
+	http.HandleFunc("/debug/benchmark/vector", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		uc, err := benchmarkIndexConfig(appState, r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		cfg, err := benchmarkConfig(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		logger.WithField("config", cfg).Info("vector benchmark started")
+
+		res, err := benchmark.Run(r.Context(), cfg, uc,
+			appState.ServerConfig.Config.Persistence.DataPath, logger)
+		if err != nil {
+			logger.WithError(err).Error("vector benchmark failed")
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(res)
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on benchmark result")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		logger.WithField("recall", res.Recall).Info("vector benchmark finished")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+
+	// measures recall@k of the live vector indexes of a collection against a
+	// brute force search over the stored vectors
+	http.HandleFunc("/debug/recall/collection/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		colName := strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/debug/recall/collection/"))
+		if colName == "" || strings.Contains(colName, "/") {
+			http.Error(w, "invalid path", http.StatusNotFound)
+			return
+		}
+
+		idx := appState.DB.GetIndex(schema.ClassName(colName))
+		if idx == nil {
+			logger.WithField("collection", colName).Error("collection not found")
+			http.Error(w, "collection not found", http.StatusNotFound)
+			return
+		}
+
+		samples, k, seed, err := recallParams(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		targetVector := r.URL.Query().Get("vector")
+
+		logger.WithField("collection", colName).Info("recall measurement started")
+
+		report, err := idx.MeasureRecall(r.Context(), targetVector, samples, k, seed)
+		if err != nil {
+			logger.WithError(err).Error("recall measurement failed")
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(report)
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on recall report")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		logger.WithField("collection", colName).
+			WithField("recall", report.Recall).
+			Info("recall measurement finished")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+
+	// compares the objects of a collection to the entries of its keyword and
+	// vector indexes, repairing discrepancies if repair=true
+	http.HandleFunc("/debug/consistency/collection/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		colName := strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/debug/consistency/collection/"))
+		if colName == "" || strings.Contains(colName, "/") {
+			http.Error(w, "invalid path", http.StatusNotFound)
+			return
+		}
+
+		idx := appState.DB.GetIndex(schema.ClassName(colName))
+		if idx == nil {
+			logger.WithField("collection", colName).Error("collection not found")
+			http.Error(w, "collection not found", http.StatusNotFound)
+			return
+		}
+
+		repair := false
+		if v := r.URL.Query().Get("repair"); v != "" {
+			var err error
+			repair, err = strconv.ParseBool(v)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("parse repair: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		logger.WithField("collection", colName).
+			WithField("repair", repair).
+			Info("consistency check started")
+
+		report, err := idx.CheckConsistency(r.Context(), repair)
+		if err != nil {
+			logger.WithError(err).Error("consistency check failed")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(report)
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on consistency report")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		logger.WithField("collection", colName).
+			WithField("consistent", report.Consistent).
+			Info("consistency check finished")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+}
+
+func recallParams(query url.Values) (samples, k int, seed int64, err error) {
+	samples, k = 100, 10
+	for name, target := range map[string]*int{
+		"samples": &samples,
+		"k":       &k,
+	} {
+		if v := query.Get(name); v != "" {
+			asInt, err := strconv.Atoi(v)
+			if err != nil {
+				return 0, 0, 0, fmt.Errorf("parse %s: %w", name, err)
+			}
+			*target = asInt
+		}
+	}
+
+	if v := query.Get("seed"); v != "" {
+		seed, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("parse seed: %w", err)
+		}
+	}
+
+	return samples, k, seed, nil
+}
+
+func benchmarkConfig(query url.Values) (benchmark.Config, error) {
+	var cfg benchmark.Config
+	for name, target := range map[string]*int{
+		"vectors":    &cfg.Vectors,
+		"dimensions": &cfg.Dimensions,
+		"queries":    &cfg.Queries,
+		"k":          &cfg.K,
+	} {
+		if v := query.Get(name); v != "" {
+			asInt, err := strconv.Atoi(v)
+			if err != nil {
+				return cfg, fmt.Errorf("parse %s: %w", name, err)
+			}
+			*target = asInt
+		}
+	}
+
+	if v := query.Get("seed"); v != "" {
+		seed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("parse seed: %w", err)
+		}
+		cfg.Seed = seed
+	}
+
+	return cfg, nil
+}
+
+func benchmarkIndexConfig(appState *state.State, query url.Values) (hnswent.UserConfig, error) {
+	uc := hnswent.NewDefaultUserConfig()
+
+	if colName := query.Get("collection"); colName != "" {
+		class := appState.SchemaManager.ReadOnlyClass(colName)
+		if class == nil {
+			return uc, fmt.Errorf("collection %q not found", colName)
+		}
+
+		classUC, ok := class.VectorIndexConfig.(hnswent.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("collection %q does not use an hnsw index", colName)
+		}
+		uc = classUC
+	}
+
+	for name, target := range map[string]*int{
+		"ef":             &uc.EF,
+		"efConstruction": &uc.EFConstruction,
+		"maxConnections": &uc.MaxConnections,
+	} {
+		if v := query.Get(name); v != "" {
+			asInt, err := strconv.Atoi(v)
+			if err != nil {
+				return uc, fmt.Errorf("parse %s: %w", name, err)
+			}
+			*target = asInt
+		}
+	}
+
+	if v := query.Get("distance"); v != "" {
+		uc.Distance = v
+	}
+
+	return uc, nil
+}
+
+// setupUsageReportingDebugHandlers exposes the usage report exactly as it
+// would be sent, so operators can inspect it before opting in.
+func setupUsageReportingDebugHandlers(reporter *telemetry.UsageReporter, logger logrus.FieldLogger) {
+	logger = logger.WithField("handler", "debug")
+
+	http.HandleFunc("/debug/telemetry/preview", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, err := reporter.Preview(r.Context())
+		if err != nil {
+			logger.WithError(err).Error("failed to build usage report")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(report)
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on usage report")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
 }
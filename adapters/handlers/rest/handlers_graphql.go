@@ -15,10 +15,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
 	tailorincgraphql "github.com/tailor-inc/graphql"
@@ -34,6 +36,8 @@ import (
 	"github.com/weaviate/weaviate/usecases/schema"
 )
 
+const ndjsonMime = "application/x-ndjson"
+
 const error422 string = "The request is well-formed but was unable to be followed due to semantic errors."
 
 type gqlUnbatchedRequestResponse struct {
@@ -182,6 +186,15 @@ func setupGraphQLHandlers(
 			}, logger)
 		}
 
+		if middleware.NegotiateContentType(params.HTTPRequest,
+			[]string{runtime.JSONMime, ndjsonMime}, runtime.JSONMime) == ndjsonMime {
+			enterrors.GoWrapper(func() {
+				wg.Wait()
+				close(requestResults)
+			}, logger)
+			return streamGraphQLResponses(requestResults, logger)
+		}
+
 		wg.Wait()
 
 		close(requestResults)
@@ -197,6 +210,41 @@ func setupGraphQLHandlers(
 	})
 }
 
+// gqlStreamedResponse is a line of a streamed batch, the index is the
+// position of the query in the batch
+type gqlStreamedResponse struct {
+	Index int `json:"index"`
+	*models.GraphQLResponse
+}
+
+// streamGraphQLResponses writes each response of a batch as a line of
+// newline-delimited JSON as soon as it's ready. The results channel is
+// buffered for the whole batch, so queries never wait for the client.
+func streamGraphQLResponses(results <-chan gqlUnbatchedRequestResponse,
+	logger logrus.FieldLogger,
+) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", ndjsonMime)
+		rw.WriteHeader(http.StatusOK)
+
+		enc := json.NewEncoder(rw)
+		flusher := http.NewResponseController(rw)
+		for result := range results {
+			err := enc.Encode(gqlStreamedResponse{
+				Index:           result.RequestIndex,
+				GraphQLResponse: result.Response,
+			})
+			if err != nil {
+				// the client is gone, the remaining results are dropped
+				logger.WithField("action", "graphql_batch_stream").WithError(err).
+					Debug("stop streaming graphql batch")
+				return
+			}
+			flusher.Flush()
+		}
+	})
+}
+
 // Handle a single unbatched GraphQL request, return a tuple containing the index of the request in the batch and either the response or an error
 func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse, metricRequestsTotal *graphqlRequestsTotal) {
 	defer wg.Done()
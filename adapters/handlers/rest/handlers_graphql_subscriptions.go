@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"golang.org/x/net/websocket"
+)
+
+// graphQLSubscriptionsPath is the GraphQL endpoint, which serves
+// subscriptions to clients upgrading to a WebSocket
+const graphQLSubscriptionsPath = "/v1/graphql"
+
+// graphQLWSProtocol is the WebSocket sub-protocol spoken on subscriptions,
+// see https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const graphQLWSProtocol = "graphql-transport-ws"
+
+// message types of the graphql-transport-ws protocol
+const (
+	gqlWSConnectionInit = "connection_init"
+	gqlWSConnectionAck  = "connection_ack"
+	gqlWSPing           = "ping"
+	gqlWSPong           = "pong"
+	gqlWSSubscribe      = "subscribe"
+	gqlWSNext           = "next"
+	gqlWSError          = "error"
+	gqlWSComplete       = "complete"
+)
+
+// maxSubscriptionsPerConnection is the number of subscriptions a client may
+// have active on a single connection
+const maxSubscriptionsPerConnection = 16
+
+type gqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type gqlWSSubscribePayload struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// makeAddGraphQLSubscriptions serves GraphQL subscriptions on the GraphQL
+// endpoint to clients which upgrade to a WebSocket. All other requests are
+// passed on. Clients authenticate like on any other request, through the
+// Authorization header or the access_token query param.
+//
+// The router of the REST API does not accept the upgrade requests, so they
+// are passed through the middlewares of the REST handlers here instead,
+// which include the rate limiting.
+func makeAddGraphQLSubscriptions(gqlProvider graphQLProvider, disabled bool,
+	authenticate func(token string, scopes []string) (*models.Principal, error),
+	anonymousAccess bool, authorizer authorization.Authorizer,
+	middlewares func(http.Handler) http.Handler, logger logrus.FieldLogger,
+) func(http.Handler) http.Handler {
+	subscriptions := middlewares(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if disabled {
+			writeSubscriptionError(w, http.StatusUnprocessableEntity, "graphql api is disabled")
+			return
+		}
+
+		principal, err := authenticateSubscription(r, authenticate, anonymousAccess)
+		if err != nil {
+			writeSubscriptionError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		// same as for regular GraphQL requests, further authorization is
+		// done per subscribed class
+		if err := authorizer.Authorize(principal, authorization.READ, authorization.Collections()...); err != nil {
+			status := http.StatusUnprocessableEntity
+			if _, ok := err.(autherrs.Forbidden); ok {
+				status = http.StatusForbidden
+			}
+			writeSubscriptionError(w, status, err.Error())
+			return
+		}
+
+		s := websocket.Server{
+			Handshake: func(cfg *websocket.Config, r *http.Request) error {
+				for _, protocol := range cfg.Protocol {
+					if protocol == graphQLWSProtocol {
+						cfg.Protocol = []string{graphQLWSProtocol}
+						return nil
+					}
+				}
+				return websocket.ErrBadWebSocketProtocol
+			},
+			Handler: func(conn *websocket.Conn) {
+				ctx := context.WithValue(r.Context(), "principal", principal)
+				serveGraphQLSubscriptions(ctx, conn, gqlProvider, logger)
+			},
+		}
+		s.ServeHTTP(w, r)
+	}))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != graphQLSubscriptionsPath || !isWebSocketUpgrade(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			subscriptions.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+func authenticateSubscription(r *http.Request,
+	authenticate func(token string, scopes []string) (*models.Principal, error),
+	anonymousAccess bool,
+) (*models.Principal, error) {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		token = r.URL.Query().Get("access_token")
+	}
+	if token == "" {
+		if !anonymousAccess {
+			return nil, errAnonymousSubscription
+		}
+		return nil, nil
+	}
+	return authenticate(token, nil)
+}
+
+var errAnonymousSubscription = errors.New("anonymous access not enabled, please provide an auth scheme such as OIDC")
+
+func writeSubscriptionError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errPayloadFromSingleErr(errors.New(msg)))
+}
+
+// serveGraphQLSubscriptions speaks the graphql-transport-ws protocol on conn
+// until the client disconnects
+func serveGraphQLSubscriptions(ctx context.Context, conn *websocket.Conn,
+	gqlProvider graphQLProvider, logger logrus.FieldLogger,
+) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		sendLock sync.Mutex
+		subsLock sync.Mutex
+		subs     = map[string]context.CancelFunc{}
+	)
+	send := func(msg gqlWSMessage) {
+		sendLock.Lock()
+		defer sendLock.Unlock()
+		if err := websocket.JSON.Send(conn, msg); err != nil {
+			logger.WithField("action", "graphql_subscription").WithError(err).
+				Debug("could not send message")
+		}
+	}
+	payload := func(v interface{}) json.RawMessage {
+		b, _ := json.Marshal(v)
+		return b
+	}
+
+	for {
+		var msg gqlWSMessage
+		if err := websocket.JSON.Receive(conn, &msg); err != nil {
+			return
+		}
+
+		switch msg.Type {
+		case gqlWSConnectionInit:
+			send(gqlWSMessage{Type: gqlWSConnectionAck})
+		case gqlWSPing:
+			send(gqlWSMessage{Type: gqlWSPong})
+		case gqlWSComplete:
+			subsLock.Lock()
+			if stop, ok := subs[msg.ID]; ok {
+				stop()
+				delete(subs, msg.ID)
+			}
+			subsLock.Unlock()
+		case gqlWSSubscribe:
+			var p gqlWSSubscribePayload
+			if err := json.Unmarshal(msg.Payload, &p); err != nil || p.Query == "" {
+				send(gqlWSMessage{ID: msg.ID, Type: gqlWSError, Payload: payload(
+					[]map[string]string{{"message": "invalid subscribe payload"}})})
+				continue
+			}
+			gql := gqlProvider.GetGraphQL()
+			if gql == nil {
+				send(gqlWSMessage{ID: msg.ID, Type: gqlWSError, Payload: payload(
+					[]map[string]string{{"message": "no graphql provider present, " +
+						"this is most likely because no schema is present. Import a schema first!"}})})
+				continue
+			}
+
+			subCtx, stop := context.WithCancel(ctx)
+			subsLock.Lock()
+			if len(subs) >= maxSubscriptionsPerConnection {
+				subsLock.Unlock()
+				stop()
+				send(gqlWSMessage{ID: msg.ID, Type: gqlWSError, Payload: payload(
+					[]map[string]string{{"message": fmt.Sprintf(
+						"at most %d subscriptions may be active per connection", maxSubscriptionsPerConnection)}})})
+				continue
+			}
+			if _, ok := subs[msg.ID]; ok {
+				subsLock.Unlock()
+				stop()
+				send(gqlWSMessage{ID: msg.ID, Type: gqlWSError, Payload: payload(
+					[]map[string]string{{"message": "subscriber for " + msg.ID + " already exists"}})})
+				continue
+			}
+			subs[msg.ID] = stop
+			subsLock.Unlock()
+
+			id := msg.ID
+			results := gql.Subscribe(subCtx, p.Query, p.OperationName, p.Variables)
+			enterrors.GoWrapper(func() {
+				for result := range results {
+					send(gqlWSMessage{ID: id, Type: gqlWSNext, Payload: payload(result)})
+				}
+
+				subsLock.Lock()
+				_, active := subs[id]
+				delete(subs, id)
+				subsLock.Unlock()
+				// the client is only told about subscriptions ending on the server
+				if active && subCtx.Err() == nil {
+					send(gqlWSMessage{ID: id, Type: gqlWSComplete})
+				}
+				stop()
+			}, logger)
+		}
+	}
+}
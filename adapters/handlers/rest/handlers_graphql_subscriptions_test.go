@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tailorincgraphql "github.com/tailor-inc/graphql"
+	libgraphql "github.com/weaviate/weaviate/adapters/handlers/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"golang.org/x/net/websocket"
+)
+
+type fakeSubscriptionGraphQL struct {
+	query     string
+	principal interface{}
+	// block keeps subscriptions open until it is closed, if set
+	block chan struct{}
+}
+
+func (f *fakeSubscriptionGraphQL) GetGraphQL() libgraphql.GraphQL {
+	return f
+}
+
+func (f *fakeSubscriptionGraphQL) Resolve(ctx context.Context, query string,
+	operationName string, variables map[string]interface{},
+) *tailorincgraphql.Result {
+	return nil
+}
+
+func (f *fakeSubscriptionGraphQL) Subscribe(ctx context.Context, query string,
+	operationName string, variables map[string]interface{},
+) chan *tailorincgraphql.Result {
+	f.query = query
+	f.principal = ctx.Value("principal")
+	if f.block != nil {
+		results := make(chan *tailorincgraphql.Result)
+		block := f.block
+		go func() {
+			<-block
+			close(results)
+		}()
+		return results
+	}
+	results := make(chan *tailorincgraphql.Result, 1)
+	results <- &tailorincgraphql.Result{Data: map[string]interface{}{"Subscribe": map[string]interface{}{"event": "create"}}}
+	close(results)
+	return results
+}
+
+func TestGraphQLSubscriptions(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	gql := &fakeSubscriptionGraphQL{}
+	principal := &models.Principal{Username: "john"}
+	authenticate := func(token string, scopes []string) (*models.Principal, error) {
+		return principal, nil
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	var middlewareCalls atomic.Int32
+	middlewares := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			middlewareCalls.Add(1)
+			next.ServeHTTP(w, r)
+		})
+	}
+	server := httptest.NewServer(makeAddGraphQLSubscriptions(gql, false, authenticate,
+		false, mocks.NewMockAuthorizer(), middlewares, logger)(next))
+	defer server.Close()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + graphQLSubscriptionsPath
+
+	t.Run("regular requests are passed on", func(t *testing.T) {
+		res, err := http.Post(server.URL+graphQLSubscriptionsPath, "application/json", nil)
+		require.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusTeapot, res.StatusCode)
+		assert.Equal(t, int32(0), middlewareCalls.Load())
+	})
+
+	t.Run("anonymous access is rejected", func(t *testing.T) {
+		cfg, err := websocket.NewConfig(wsURL, server.URL)
+		require.Nil(t, err)
+		cfg.Protocol = []string{graphQLWSProtocol}
+		_, err = websocket.DialConfig(cfg)
+		require.NotNil(t, err)
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		cfg, err := websocket.NewConfig(wsURL+"?access_token=secret", server.URL)
+		require.Nil(t, err)
+		cfg.Protocol = []string{graphQLWSProtocol}
+		conn, err := websocket.DialConfig(cfg)
+		require.Nil(t, err)
+		defer conn.Close()
+
+		var msg gqlWSMessage
+		require.Nil(t, websocket.JSON.Send(conn, gqlWSMessage{Type: gqlWSConnectionInit}))
+		require.Nil(t, websocket.JSON.Receive(conn, &msg))
+		assert.Equal(t, gqlWSConnectionAck, msg.Type)
+
+		payload, _ := json.Marshal(gqlWSSubscribePayload{Query: "subscription { Subscribe { event } }"})
+		require.Nil(t, websocket.JSON.Send(conn, gqlWSMessage{ID: "1", Type: gqlWSSubscribe, Payload: payload}))
+
+		require.Nil(t, websocket.JSON.Receive(conn, &msg))
+		assert.Equal(t, gqlWSNext, msg.Type)
+		assert.Equal(t, "1", msg.ID)
+		assert.JSONEq(t, `{"data":{"Subscribe":{"event":"create"}}}`, string(msg.Payload))
+
+		require.Nil(t, websocket.JSON.Receive(conn, &msg))
+		assert.Equal(t, gqlWSComplete, msg.Type)
+		assert.Equal(t, "1", msg.ID)
+
+		assert.Equal(t, "subscription { Subscribe { event } }", gql.query)
+		assert.Equal(t, principal, gql.principal)
+		assert.Equal(t, int32(2), middlewareCalls.Load(), "upgrade requests pass the REST middlewares")
+	})
+
+	t.Run("subscriptions per connection are capped", func(t *testing.T) {
+		cfg, err := websocket.NewConfig(wsURL+"?access_token=secret", server.URL)
+		require.Nil(t, err)
+		cfg.Protocol = []string{graphQLWSProtocol}
+		conn, err := websocket.DialConfig(cfg)
+		require.Nil(t, err)
+		defer conn.Close()
+
+		gql.block = make(chan struct{})
+		defer close(gql.block)
+
+		payload, _ := json.Marshal(gqlWSSubscribePayload{Query: "subscription { Subscribe { event } }"})
+		for i := 0; i <= maxSubscriptionsPerConnection; i++ {
+			require.Nil(t, websocket.JSON.Send(conn, gqlWSMessage{
+				ID: strconv.Itoa(i), Type: gqlWSSubscribe, Payload: payload,
+			}))
+		}
+
+		var msg gqlWSMessage
+		require.Nil(t, websocket.JSON.Receive(conn, &msg))
+		assert.Equal(t, gqlWSError, msg.Type)
+		assert.Equal(t, strconv.Itoa(maxSubscriptionsPerConnection), msg.ID)
+	})
+}
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestStreamGraphQLResponses(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	results := make(chan gqlUnbatchedRequestResponse, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		streamGraphQLResponses(results, logger).WriteResponse(rw, runtime.JSONProducer())
+	}))
+	defer server.Close()
+
+	// the second query finishes first
+	results <- gqlUnbatchedRequestResponse{
+		RequestIndex: 1,
+		Response:     &models.GraphQLResponse{Data: map[string]models.JSONObject{"Get": "second"}},
+	}
+
+	res, err := http.Get(server.URL)
+	require.Nil(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+
+	type line struct {
+		Index int `json:"index"`
+		models.GraphQLResponse
+	}
+	lines := bufio.NewScanner(res.Body)
+
+	// the first line arrives before the batch is complete
+	require.True(t, lines.Scan())
+	var first line
+	require.Nil(t, json.Unmarshal(lines.Bytes(), &first))
+	assert.Equal(t, 1, first.Index)
+	assert.Equal(t, "second", first.Data["Get"])
+
+	results <- gqlUnbatchedRequestResponse{
+		RequestIndex: 0,
+		Response:     &models.GraphQLResponse{Errors: []*models.GraphQLError{{Message: "failed"}}},
+	}
+	close(results)
+
+	require.True(t, lines.Scan())
+	var second line
+	require.Nil(t, json.Unmarshal(lines.Bytes(), &second))
+	assert.Equal(t, 0, second.Index)
+	require.Len(t, second.Errors, 1)
+	assert.Equal(t, "failed", second.Errors[0].Message)
+
+	assert.False(t, lines.Scan())
+}
@@ -53,6 +53,16 @@ func setupMiscHandlers(api *operations.WeaviateAPI, serverConfig *config.Weaviat
 		return meta.NewMetaGetOK().WithPayload(res)
 	})
 
+	api.MetaModulesListHandler = meta.ModulesListHandlerFunc(func(params meta.ModulesListParams, principal *models.Principal) middleware.Responder {
+		modules := []*models.ModuleInfo{}
+		if modulesProvider != nil {
+			modules = modulesProvider.GetModulesInfo(params.HTTPRequest.Context())
+		}
+
+		metricRequestsTotal.logOk("")
+		return meta.NewModulesListOK().WithPayload(&models.ModulesListResponse{Modules: modules})
+	})
+
 	api.WellKnownGetWellKnownOpenidConfigurationHandler = well_known.GetWellKnownOpenidConfigurationHandlerFunc(
 		func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			if !serverConfig.Config.Authentication.OIDC.Enabled {
@@ -15,8 +15,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
@@ -27,9 +32,13 @@ import (
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/logging"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/requestsettings"
 )
 
 type objectHandlers struct {
@@ -44,6 +53,7 @@ type ModulesProvider interface {
 	RestApiAdditionalProperties(includeProp string, class *models.Class) map[string]interface{}
 	GetMeta() (map[string]interface{}, error)
 	HasMultipleVectorizers() bool
+	GetModulesInfo(ctx context.Context) []*models.ModuleInfo
 }
 
 type objectsManager interface {
@@ -73,12 +83,24 @@ type objectsManager interface {
 		*additional.ReplicationProperties, string) *uco.Error
 	GetObjectsClass(ctx context.Context, principal *models.Principal, id strfmt.UUID) (*models.Class, error)
 	GetObjectClassFromName(ctx context.Context, principal *models.Principal, className string) (*models.Class, error)
+	GetTrashedObjects(ctx context.Context, principal *models.Principal, class string,
+		includeVector bool, tenant string) ([]*models.Object, error)
+	RestoreObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string) (*models.Object, error)
+	GetObjectHistory(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
+		includeVector bool, tenant string) ([]*models.Object, error)
+	LookupObject(ctx context.Context, principal *models.Principal, class, property, value string,
+		additional additional.Properties, tenant string) (*models.Object, error)
+	AddObjectWithBlobs(ctx context.Context, principal *models.Principal, object *models.Object,
+		blobs map[string]io.Reader, repl *additional.ReplicationProperties) (*models.Object, error)
+	GetObjectBlob(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
+		property string, repl *additional.ReplicationProperties, tenant string) (io.ReadCloser, error)
 }
 
 func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return objects.NewObjectsCreateBadRequest().
@@ -91,14 +113,16 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
 		if errors.As(err, &uco.ErrInvalidUserInput{}) {
-			return objects.NewObjectsCreateUnprocessableEntity().
-				WithPayload(errPayloadFromSingleErr(err))
+			return withDeprecationWarning(objects.NewObjectsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err)), err)
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if res, ok := budgetExhausted(err); ok {
+			return res
 		} else {
 			return objects.NewObjectsCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -114,6 +138,81 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	return objects.NewObjectsCreateOK().WithPayload(object)
 }
 
+// addObjectWithBlobs creates the object of the multipart form. Every file of
+// the form is uploaded to the blob property with the name of its form field.
+func (h *objectHandlers) addObjectWithBlobs(params objects.ObjectsCreateMultipartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return objects.NewObjectsCreateMultipartBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	// numbers are kept as json.Number like in the body of objects.create
+	var object models.Object
+	if err := runtime.JSONConsumer().Consume(strings.NewReader(params.Object), &object); err != nil {
+		h.metricRequestsTotal.logUserError("")
+		return objects.NewObjectsCreateMultipartBadRequest().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("parse object: %w", err)))
+	}
+	if err := object.Validate(strfmt.Default); err != nil {
+		h.metricRequestsTotal.logUserError(object.Class)
+		return objects.NewObjectsCreateMultipartUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	blobs := map[string]io.Reader{}
+	if form := params.HTTPRequest.MultipartForm; form != nil {
+		for name, files := range form.File {
+			if len(files) != 1 {
+				h.metricRequestsTotal.logUserError(object.Class)
+				return objects.NewObjectsCreateMultipartBadRequest().
+					WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+						"expected a single file for property '%s', got %d", name, len(files))))
+			}
+			f, err := files[0].Open()
+			if err != nil {
+				h.metricRequestsTotal.logError(object.Class, err)
+				return objects.NewObjectsCreateMultipartInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			defer f.Close()
+			blobs[name] = f
+		}
+	}
+
+	res, err := h.manager.AddObjectWithBlobs(params.HTTPRequest.Context(),
+		principal, &object, blobs, repl)
+	if err != nil {
+		h.metricRequestsTotal.logError(object.Class, err)
+		if errors.As(err, &uco.ErrInvalidUserInput{}) {
+			return withDeprecationWarning(objects.NewObjectsCreateMultipartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err)), err)
+		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
+			return objects.NewObjectsCreateMultipartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &autherrs.Forbidden{}) {
+			return objects.NewObjectsCreateMultipartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if res, ok := budgetExhausted(err); ok {
+			return res
+		} else {
+			return objects.NewObjectsCreateMultipartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	propertiesMap, ok := res.Properties.(map[string]interface{})
+	if ok {
+		res.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
+	}
+
+	h.metricRequestsTotal.logOk(object.Class)
+	return objects.NewObjectsCreateMultipartOK().WithPayload(res)
+}
+
 func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -126,8 +225,8 @@ func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
 			return objects.NewObjectsValidateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrInvalidUserInput:
-			return objects.NewObjectsValidateUnprocessableEntity().
-				WithPayload(errPayloadFromSingleErr(err))
+			return withDeprecationWarning(objects.NewObjectsValidateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err)), err)
 		case uco.ErrMultiTenancy:
 			return objects.NewObjectsValidateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -175,14 +274,14 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 		}
 	}
 
-	replProps, err := getReplicationProperties(params.ConsistencyLevel, params.NodeName)
+	replProps, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, params.NodeName)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsClassGetBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	tenant := getTenant(params.Tenant)
+	tenant := getTenant(params.HTTPRequest.Context(), params.Tenant)
 
 	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal,
 		params.ClassName, params.ID, additional, replProps, tenant)
@@ -209,7 +308,9 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	}
 
 	h.metricRequestsTotal.logOk(getClassName(object))
-	return objects.NewObjectsClassGetOK().WithPayload(object)
+	return objects.NewObjectsClassGetOK().
+		WithETag(uco.ETag(object.LastUpdateTimeUnix)).
+		WithPayload(object)
 }
 
 func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
@@ -229,13 +330,16 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 
 	list, err := h.manager.GetObjects(params.HTTPRequest.Context(), principal,
 		params.Offset, params.Limit, params.Sort, params.Order, params.After, additional,
-		getTenant(params.Tenant))
+		getTenant(params.HTTPRequest.Context(), params.Tenant))
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		switch err.(type) {
 		case autherrs.Forbidden:
 			return objects.NewObjectsListForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput:
+			return objects.NewObjectsListBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrMultiTenancy:
 			return objects.NewObjectsListUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -252,12 +356,19 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 		}
 	}
 
+	var nextCursor string
+	if params.After != nil && len(list) > 0 {
+		last := list[len(list)-1]
+		nextCursor = uco.EncodeCursor(last.Class, last.ID)
+	}
+
 	h.metricRequestsTotal.logOk("")
 	return objects.NewObjectsListOK().
 		WithPayload(&models.ObjectsListResponse{
 			Objects:      list,
 			TotalResults: int64(len(list)),
 			Deprecations: deprecationsRes,
+			NextCursor:   nextCursor,
 		})
 }
 
@@ -308,12 +419,18 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		}
 	}
 
+	var nextCursor string
+	if params.After != nil && len(resultSet) > 0 {
+		nextCursor = resultSet[len(resultSet)-1].ID.String()
+	}
+
 	h.metricRequestsTotal.logOk(req.Class)
 	return objects.NewObjectsListOK().
 		WithPayload(&models.ObjectsListResponse{
 			Objects:      resultSet,
 			TotalResults: int64(len(resultSet)),
 			Deprecations: []*models.Deprecation{},
+			NextCursor:   nextCursor,
 		})
 }
 
@@ -321,14 +438,14 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	tenant := getTenant(params.Tenant)
+	tenant := getTenant(params.HTTPRequest.Context(), params.Tenant)
 
 	err = h.manager.DeleteObject(params.HTTPRequest.Context(),
 		principal, params.ClassName, params.ID, repl, tenant)
@@ -353,30 +470,241 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 	return objects.NewObjectsClassDeleteNoContent()
 }
 
+// getTrashedObjects lists the soft deleted objects of a class
+func (h *objectHandlers) getTrashedObjects(params objects.ObjectsTrashListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	additional, err := parseIncludeParam(params.Include, h.modulesProvider, false, nil)
+	if err != nil {
+		h.metricRequestsTotal.logUserError(params.Class)
+		return objects.NewObjectsTrashListUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	list, err := h.manager.GetTrashedObjects(params.HTTPRequest.Context(), principal,
+		params.Class, additional.Vector, getTenant(params.HTTPRequest.Context(), params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.Class, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return objects.NewObjectsTrashListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput, uco.ErrMultiTenancy:
+			return objects.NewObjectsTrashListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsTrashListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	for i, object := range list {
+		propertiesMap, ok := object.Properties.(map[string]interface{})
+		if ok {
+			list[i].Properties = h.extendPropertiesWithAPILinks(propertiesMap)
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.Class)
+	return objects.NewObjectsTrashListOK().
+		WithPayload(&models.ObjectsListResponse{
+			Objects:      list,
+			TotalResults: int64(len(list)),
+			Deprecations: []*models.Deprecation{},
+		})
+}
+
+// getObjectHistory lists the prior versions of an object
+func (h *objectHandlers) getObjectHistory(params objects.ObjectsClassHistoryParams,
+	principal *models.Principal,
+) middleware.Responder {
+	additional, err := parseIncludeParam(params.Include, h.modulesProvider, false, nil)
+	if err != nil {
+		h.metricRequestsTotal.logUserError(params.ClassName)
+		return objects.NewObjectsClassHistoryUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	list, err := h.manager.GetObjectHistory(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, additional.Vector, getTenant(params.HTTPRequest.Context(), params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return objects.NewObjectsClassHistoryForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput, uco.ErrMultiTenancy:
+			return objects.NewObjectsClassHistoryUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassHistoryInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	for i, object := range list {
+		propertiesMap, ok := object.Properties.(map[string]interface{})
+		if ok {
+			list[i].Properties = h.extendPropertiesWithAPILinks(propertiesMap)
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return objects.NewObjectsClassHistoryOK().
+		WithPayload(&models.ObjectsListResponse{
+			Objects:      list,
+			TotalResults: int64(len(list)),
+			Deprecations: []*models.Deprecation{},
+		})
+}
+
+// lookupObject gets the object of a given class by the value of one of its
+// properties
+func (h *objectHandlers) lookupObject(params objects.ObjectsClassLookupParams,
+	principal *models.Principal,
+) middleware.Responder {
+	additional, err := parseIncludeParam(params.Include, h.modulesProvider, false, nil)
+	if err != nil {
+		h.metricRequestsTotal.logUserError(params.ClassName)
+		return objects.NewObjectsClassLookupUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	object, err := h.manager.LookupObject(params.HTTPRequest.Context(), principal, params.ClassName,
+		params.PropertyName, params.Value, additional, getTenant(params.HTTPRequest.Context(), params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return objects.NewObjectsClassLookupForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return objects.NewObjectsClassLookupNotFound()
+		case uco.ErrInvalidUserInput, uco.ErrMultiTenancy:
+			return objects.NewObjectsClassLookupUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassLookupInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	propertiesMap, ok := object.Properties.(map[string]interface{})
+	if ok {
+		object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return objects.NewObjectsClassLookupOK().WithPayload(object)
+}
+
+// restoreObject restores a soft deleted object of a given class
+func (h *objectHandlers) restoreObject(params objects.ObjectsClassRestoreParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logUserError(params.ClassName)
+		return objects.NewObjectsClassRestoreUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	object, err := h.manager.RestoreObject(params.HTTPRequest.Context(),
+		principal, params.ClassName, params.ID, repl, getTenant(params.HTTPRequest.Context(), params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return objects.NewObjectsClassRestoreForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return objects.NewObjectsClassRestoreNotFound()
+		case uco.ErrInvalidUserInput, uco.ErrMultiTenancy:
+			return objects.NewObjectsClassRestoreUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassRestoreInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	propertiesMap, ok := object.Properties.(map[string]interface{})
+	if ok {
+		object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return objects.NewObjectsClassRestoreOK().WithPayload(object)
+}
+
+func (h *objectHandlers) getObjectBlob(params objects.ObjectsClassBlobGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logUserError(params.ClassName)
+		return objects.NewObjectsClassBlobGetUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	blob, err := h.manager.GetObjectBlob(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, params.PropertyName, repl, getTenant(params.HTTPRequest.Context(), params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return objects.NewObjectsClassBlobGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return objects.NewObjectsClassBlobGetNotFound()
+		case uco.ErrInvalidUserInput, uco.ErrMultiTenancy:
+			return objects.NewObjectsClassBlobGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsClassBlobGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	// the file is always streamed as it is, even if the client accepts json
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", runtime.DefaultMime)
+		objects.NewObjectsClassBlobGetOK().WithPayload(blob).
+			WriteResponse(rw, runtime.ByteStreamProducer())
+	})
+}
+
 func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	className := getClassName(params.Body)
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(),
+	ctx := withIfMatch(params.HTTPRequest, params.IfMatch)
+	object, err := h.manager.UpdateObject(ctx,
 		principal, params.ClassName, params.ID, params.Body, repl)
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
-		if errors.As(err, &uco.ErrInvalidUserInput{}) {
-			return objects.NewObjectsClassPutUnprocessableEntity().
+		if errors.As(err, &uco.ErrObjectChanged{}) {
+			return objects.NewObjectsClassPutPreconditionFailed().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrInvalidUserInput{}) {
+			return withDeprecationWarning(objects.NewObjectsClassPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err)), err)
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsClassPutForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if res, ok := budgetExhausted(err); ok {
+			return res
 		} else {
 			return objects.NewObjectsClassPutInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -395,14 +723,14 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 func (h *objectHandlers) headObject(params objects.ObjectsClassHeadParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	tenant := getTenant(params.Tenant)
+	tenant := getTenant(params.HTTPRequest.Context(), params.Tenant)
 
 	exists, objErr := h.manager.HeadObject(params.HTTPRequest.Context(),
 		principal, params.ClassName, params.ID, repl, tenant)
@@ -436,28 +764,35 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 	updates.ID = params.ID
 	updates.Class = params.ClassName
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(getClassName(updates), err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl)
+	ctx := withIfMatch(params.HTTPRequest, params.IfMatch)
+	objErr := h.manager.MergeObject(ctx, principal, updates, repl)
 	if objErr != nil {
 		h.metricRequestsTotal.logError(getClassName(updates), objErr)
 		switch {
 		case objErr.NotFound():
 			return objects.NewObjectsClassPatchNotFound()
+		case objErr.PreconditionFailed():
+			return objects.NewObjectsClassPatchPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		case objErr.Forbidden():
 			return objects.NewObjectsClassPatchForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
 		case objErr.BadRequest():
-			return objects.NewObjectsClassPatchUnprocessableEntity().
-				WithPayload(errPayloadFromSingleErr(objErr))
+			return withDeprecationWarning(objects.NewObjectsClassPatchUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(objErr)), objErr)
 		case objErr.UnprocessableEntity():
 			return objects.NewObjectsClassPatchUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(objErr))
+		case errors.As(objErr, &ratelimiter.ErrBudgetExhausted{}):
+			res, _ := budgetExhausted(objErr)
+			return res
 		default:
 			return objects.NewObjectsClassPatchInternalServerError().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -479,13 +814,13 @@ func (h *objectHandlers) addObjectReference(
 		Ref:      *params.Body,
 	}
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
-	tenant := getTenant(params.Tenant)
+	tenant := getTenant(params.HTTPRequest.Context(), params.Tenant)
 
 	objErr := h.manager.AddObjectReference(params.HTTPRequest.Context(), principal, &input, repl, tenant)
 	if objErr != nil {
@@ -522,14 +857,14 @@ func (h *objectHandlers) putObjectReferences(params objects.ObjectsClassReferenc
 		Refs:     params.Body,
 	}
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	tenant := getTenant(params.Tenant)
+	tenant := getTenant(params.HTTPRequest.Context(), params.Tenant)
 
 	objErr := h.manager.UpdateObjectReferences(params.HTTPRequest.Context(), principal, &input, repl, tenant)
 	if objErr != nil {
@@ -566,13 +901,13 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 		Reference: *params.Body,
 	}
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.HTTPRequest.Context(), params.ConsistencyLevel, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
-	tenant := getTenant(params.Tenant)
+	tenant := getTenant(params.HTTPRequest.Context(), params.Tenant)
 
 	objErr := h.manager.DeleteObjectReference(params.HTTPRequest.Context(), principal, &input, repl, tenant)
 	if objErr != nil {
@@ -626,6 +961,18 @@ func setupObjectHandlers(api *operations.WeaviateAPI,
 		ObjectsClassReferencesDeleteHandlerFunc(h.deleteObjectReference)
 	api.ObjectsObjectsClassReferencesPutHandler = objects.
 		ObjectsClassReferencesPutHandlerFunc(h.putObjectReferences)
+	api.ObjectsObjectsTrashListHandler = objects.
+		ObjectsTrashListHandlerFunc(h.getTrashedObjects)
+	api.ObjectsObjectsClassRestoreHandler = objects.
+		ObjectsClassRestoreHandlerFunc(h.restoreObject)
+	api.ObjectsObjectsClassHistoryHandler = objects.
+		ObjectsClassHistoryHandlerFunc(h.getObjectHistory)
+	api.ObjectsObjectsClassLookupHandler = objects.
+		ObjectsClassLookupHandlerFunc(h.lookupObject)
+	api.ObjectsObjectsCreateMultipartHandler = objects.
+		ObjectsCreateMultipartHandlerFunc(h.addObjectWithBlobs)
+	api.ObjectsObjectsClassBlobGetHandler = objects.
+		ObjectsClassBlobGetHandlerFunc(h.getObjectBlob)
 	// deprecated handlers
 	api.ObjectsObjectsGetHandler = objects.
 		ObjectsGetHandlerFunc(h.getObjectDeprecated)
@@ -648,7 +995,7 @@ func setupObjectHandlers(api *operations.WeaviateAPI,
 func (h *objectHandlers) getObjectDeprecated(params objects.ObjectsGetParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	h.logger.Warn("deprecated endpoint: ", "GET "+params.HTTPRequest.URL.Path)
+	logging.WithRequest(params.HTTPRequest.Context(), h.logger).Warn("deprecated endpoint: ", "GET "+params.HTTPRequest.URL.Path)
 	ps := objects.ObjectsClassGetParams{
 		HTTPRequest: params.HTTPRequest,
 		ID:          params.ID,
@@ -660,7 +1007,7 @@ func (h *objectHandlers) getObjectDeprecated(params objects.ObjectsGetParams,
 func (h *objectHandlers) headObjectDeprecated(params objects.ObjectsHeadParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	h.logger.Warn("deprecated endpoint: ", "HEAD "+params.HTTPRequest.URL.Path)
+	logging.WithRequest(params.HTTPRequest.Context(), h.logger).Warn("deprecated endpoint: ", "HEAD "+params.HTTPRequest.URL.Path)
 	r := objects.ObjectsClassHeadParams{
 		HTTPRequest: params.HTTPRequest,
 		ID:          params.ID,
@@ -870,8 +1217,14 @@ func getModuleParams(moduleParams map[string]interface{}) map[string]interface{}
 	return moduleParams
 }
 
-func getReplicationProperties(consistencyLvl, nodeName *string) (*additional.ReplicationProperties, error) {
+// getReplicationProperties falls back to the consistency level of the request
+// settings if neither a consistency level nor a node name is given
+func getReplicationProperties(ctx context.Context, consistencyLvl, nodeName *string,
+) (*additional.ReplicationProperties, error) {
 	if nodeName == nil && consistencyLvl == nil {
+		if cl := requestsettings.FromContext(ctx).ConsistencyLevel; cl != "" {
+			return &additional.ReplicationProperties{ConsistencyLevel: cl}, nil
+		}
 		return nil, nil
 	}
 
@@ -907,11 +1260,12 @@ func getConsistencyLevel(lvl *string) (string, error) {
 	return "", nil
 }
 
-func getTenant(maybeKey *string) string {
+// getTenant falls back to the tenant of the request settings
+func getTenant(ctx context.Context, maybeKey *string) string {
 	if maybeKey != nil {
 		return *maybeKey
 	}
-	return ""
+	return requestsettings.FromContext(ctx).Tenant
 }
 
 func getClassName(obj *models.Object) string {
@@ -921,6 +1275,58 @@ func getClassName(obj *models.Object) string {
 	return ""
 }
 
+// warningResponder adds a Warning header to a response
+type warningResponder struct {
+	middleware.Responder
+	warning string
+}
+
+func (r warningResponder) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	rw.Header().Add("Warning", fmt.Sprintf("299 - %q", r.warning))
+	r.Responder.WriteResponse(rw, producer)
+}
+
+// withDeprecationWarning adds a Warning header to res if err was caused by
+// writing a deprecated property, so that clients notice it without parsing
+// the error
+func withDeprecationWarning(res middleware.Responder, err error) middleware.Responder {
+	var deprecated validation.ErrDeprecatedProperty
+	if !errors.As(err, &deprecated) {
+		return res
+	}
+	return warningResponder{Responder: res, warning: deprecated.Error()}
+}
+
+// budgetExhaustedResponder tells the client that a module ran out of its
+// budget for calls to its inference API. If too many calls are queued it's
+// unclear when capacity frees up, which is reported as 503 instead of 429.
+type budgetExhaustedResponder struct {
+	err ratelimiter.ErrBudgetExhausted
+}
+
+func (r budgetExhaustedResponder) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	status := http.StatusTooManyRequests
+	if r.err.QueueFull {
+		status = http.StatusServiceUnavailable
+	}
+	retryAfter := max(1, int(math.Ceil(r.err.RetryAfter.Seconds())))
+	rw.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	rw.WriteHeader(status)
+	if err := producer.Produce(rw, errPayloadFromSingleErr(r.err)); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// budgetExhausted returns a responder for err if it was caused by an
+// exhausted module budget
+func budgetExhausted(err error) (middleware.Responder, bool) {
+	var exhausted ratelimiter.ErrBudgetExhausted
+	if !errors.As(err, &exhausted) {
+		return nil, false
+	}
+	return budgetExhaustedResponder{err: exhausted}, true
+}
+
 type errReplication struct {
 	err error
 }
@@ -944,3 +1350,13 @@ func newErrUnregonizedProperty(err error) errUnregonizedProperty {
 func (e errUnregonizedProperty) Error() string {
 	return fmt.Sprintf("%v", e.err)
 }
+
+// withIfMatch returns the context of a conditional update. The If-Match
+// header may be sent multiple times, so all of its values are parsed.
+func withIfMatch(r *http.Request, ifMatch *string) context.Context {
+	if ifMatch == nil {
+		return r.Context()
+	}
+	m := uco.ParseIfMatch(strings.Join(r.Header.Values("If-Match"), ","))
+	return uco.ContextWithIfMatch(r.Context(), m)
+}
@@ -12,11 +12,19 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	stderrors "errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
@@ -25,6 +33,8 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -659,6 +669,11 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		if _, ok := res.(*objects.ObjectsClassPatchInternalServerError); !ok {
 			t.Errorf("expected: %T got: %T", objects.ObjectsClassPatchInternalServerError{}, res)
 		}
+		fakeManager.patchObjectReturn = &uco.Error{Code: uco.StatusPreconditionFailed}
+		res = h.patchObject(req, nil)
+		if _, ok := res.(*objects.ObjectsClassPatchPreconditionFailed); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassPatchPreconditionFailed{}, res)
+		}
 
 		// test deprecated function
 		fakeManager.patchObjectReturn = nil
@@ -768,6 +783,7 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 				}
 				require.True(t, ok)
 				assert.Equal(t, test.expectedResult, parsed.Payload)
+				assert.Equal(t, uco.ETag(test.object.LastUpdateTimeUnix), parsed.ETag)
 			})
 		}
 	})
@@ -1066,6 +1082,13 @@ type fakeManager struct {
 	addRefErr          *uco.Error
 	putRefErr          *uco.Error
 	deleteRefErr       *uco.Error
+	trashedObjects     []*models.Object
+	restoreObjectErr   error
+	history            []*models.Object
+	historyErr         error
+	lookupErr          error
+	uploadedBlobs      map[string]string
+	blobErr            error
 }
 
 func (f *fakeManager) HeadObject(context.Context, *models.Principal,
@@ -1158,9 +1181,332 @@ func (f *fakeManager) DeleteObjectReference(context.Context, *models.Principal,
 	return f.deleteRefErr
 }
 
+func (f *fakeManager) GetTrashedObjects(context.Context, *models.Principal, string, bool, string,
+) ([]*models.Object, error) {
+	return f.trashedObjects, nil
+}
+
+func (f *fakeManager) GetObjectHistory(context.Context, *models.Principal, string, strfmt.UUID,
+	bool, string,
+) ([]*models.Object, error) {
+	if f.historyErr != nil {
+		return nil, f.historyErr
+	}
+	return f.history, nil
+}
+
+func (f *fakeManager) LookupObject(_ context.Context, _ *models.Principal, class, _, _ string,
+	_ additional.Properties, _ string,
+) (*models.Object, error) {
+	if f.lookupErr != nil {
+		return nil, f.lookupErr
+	}
+	return &models.Object{Class: class, Properties: map[string]interface{}{"externalId": "a1"}}, nil
+}
+
+func (f *fakeManager) RestoreObject(_ context.Context, _ *models.Principal, class string,
+	id strfmt.UUID, _ *additional.ReplicationProperties, _ string,
+) (*models.Object, error) {
+	if f.restoreObjectErr != nil {
+		return nil, f.restoreObjectErr
+	}
+	return &models.Object{Class: class, ID: id}, nil
+}
+
+func (f *fakeManager) AddObjectWithBlobs(_ context.Context, _ *models.Principal,
+	object *models.Object, blobs map[string]io.Reader, _ *additional.ReplicationProperties,
+) (*models.Object, error) {
+	f.uploadedBlobs = map[string]string{}
+	for name, r := range blobs {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		f.uploadedBlobs[name] = string(data)
+	}
+	return object, nil
+}
+
+func (f *fakeManager) GetObjectBlob(context.Context, *models.Principal, string, strfmt.UUID,
+	string, *additional.ReplicationProperties, string,
+) (io.ReadCloser, error) {
+	if f.blobErr != nil {
+		return nil, f.blobErr
+	}
+	return io.NopCloser(strings.NewReader("some pdf")), nil
+}
+
 type fakeMetricRequestsTotal struct{}
 
 func (f *fakeMetricRequestsTotal) logError(className string, err error)       {}
 func (f *fakeMetricRequestsTotal) logOk(className string)                     {}
 func (f *fakeMetricRequestsTotal) logUserError(className string)              {}
 func (f *fakeMetricRequestsTotal) logServerError(className string, err error) {}
+
+func TestTrashHandlers(t *testing.T) {
+	id := strfmt.UUID("85f78e29-5937-4390-a121-5379f262b4e5")
+
+	t.Run("list trash", func(t *testing.T) {
+		manager := &fakeManager{trashedObjects: []*models.Object{{Class: "Foo", ID: id}}}
+		h := &objectHandlers{manager: manager, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		res := h.getTrashedObjects(objects.ObjectsTrashListParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/objects/.deleted?class=Foo", nil),
+			Class:       "Foo",
+		}, nil)
+		parsed, ok := res.(*objects.ObjectsTrashListOK)
+		require.True(t, ok)
+		assert.Equal(t, int64(1), parsed.Payload.TotalResults)
+	})
+
+	t.Run("list trash with invalid include", func(t *testing.T) {
+		include := "foo"
+		h := &objectHandlers{manager: &fakeManager{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		res := h.getTrashedObjects(objects.ObjectsTrashListParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/objects/.deleted?class=Foo&include=foo", nil),
+			Class:       "Foo",
+			Include:     &include,
+		}, nil)
+		_, ok := res.(*objects.ObjectsTrashListUnprocessableEntity)
+		assert.True(t, ok)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			err      error
+			expected interface{}
+		}{
+			{name: "restored", expected: &objects.ObjectsClassRestoreOK{}},
+			{name: "not in trash", err: uco.NewErrNotFound("not in trash"), expected: &objects.ObjectsClassRestoreNotFound{}},
+			{name: "disabled", err: uco.NewErrInvalidUserInput("disabled"), expected: &objects.ObjectsClassRestoreUnprocessableEntity{}},
+			{name: "internal", err: uco.NewErrInternal("boom"), expected: &objects.ObjectsClassRestoreInternalServerError{}},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				h := &objectHandlers{
+					manager:             &fakeManager{restoreObjectErr: test.err},
+					metricRequestsTotal: &fakeMetricRequestsTotal{},
+				}
+				res := h.restoreObject(objects.ObjectsClassRestoreParams{
+					HTTPRequest: httptest.NewRequest("POST", "/v1/objects/Foo/"+id.String()+"/restore", nil),
+					ClassName:   "Foo",
+					ID:          id,
+				}, nil)
+				assert.IsType(t, test.expected, res)
+			})
+		}
+	})
+}
+
+func TestObjectHistoryHandler(t *testing.T) {
+	id := strfmt.UUID("85f78e29-5937-4390-a121-5379f262b4e5")
+
+	tests := []struct {
+		name     string
+		history  []*models.Object
+		err      error
+		expected interface{}
+	}{
+		{name: "listed", history: []*models.Object{{Class: "Foo", ID: id}}, expected: &objects.ObjectsClassHistoryOK{}},
+		{name: "disabled", err: uco.NewErrInvalidUserInput("disabled"), expected: &objects.ObjectsClassHistoryUnprocessableEntity{}},
+		{name: "internal", err: uco.NewErrInternal("boom"), expected: &objects.ObjectsClassHistoryInternalServerError{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &objectHandlers{
+				manager:             &fakeManager{history: test.history, historyErr: test.err},
+				metricRequestsTotal: &fakeMetricRequestsTotal{},
+			}
+			res := h.getObjectHistory(objects.ObjectsClassHistoryParams{
+				HTTPRequest: httptest.NewRequest("GET", "/v1/objects/Foo/"+id.String()+"/history", nil),
+				ClassName:   "Foo",
+				ID:          id,
+			}, nil)
+			require.IsType(t, test.expected, res)
+			if parsed, ok := res.(*objects.ObjectsClassHistoryOK); ok {
+				assert.Equal(t, int64(len(test.history)), parsed.Payload.TotalResults)
+			}
+		})
+	}
+}
+
+func TestLookupObjectHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected interface{}
+	}{
+		{name: "found", expected: &objects.ObjectsClassLookupOK{}},
+		{name: "not found", err: uco.NewErrNotFound("no object"), expected: &objects.ObjectsClassLookupNotFound{}},
+		{name: "not unique", err: uco.NewErrInvalidUserInput("more than one"), expected: &objects.ObjectsClassLookupUnprocessableEntity{}},
+		{name: "internal", err: uco.NewErrInternal("boom"), expected: &objects.ObjectsClassLookupInternalServerError{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &objectHandlers{
+				manager:             &fakeManager{lookupErr: test.err},
+				metricRequestsTotal: &fakeMetricRequestsTotal{},
+			}
+			res := h.lookupObject(objects.ObjectsClassLookupParams{
+				HTTPRequest:  httptest.NewRequest("GET", "/v1/objects/by/Foo/externalId/a1", nil),
+				ClassName:    "Foo",
+				PropertyName: "externalId",
+				Value:        "a1",
+			}, nil)
+			require.IsType(t, test.expected, res)
+			if parsed, ok := res.(*objects.ObjectsClassLookupOK); ok {
+				assert.Equal(t, "Foo", parsed.Payload.Class)
+			}
+		})
+	}
+}
+
+func TestBlobHandlers(t *testing.T) {
+	id := strfmt.UUID("85f78e29-5937-4390-a121-5379f262b4e5")
+
+	multipartRequest := func(t *testing.T, object string, files map[string]string) *http.Request {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		require.Nil(t, w.WriteField("object", object))
+		for name, content := range files {
+			part, err := w.CreateFormFile(name, name+".pdf")
+			require.Nil(t, err)
+			_, err = part.Write([]byte(content))
+			require.Nil(t, err)
+		}
+		require.Nil(t, w.Close())
+
+		req := httptest.NewRequest("POST", "/v1/objects/multipart", &body)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		require.Nil(t, req.ParseMultipartForm(1<<20))
+		return req
+	}
+
+	t.Run("upload", func(t *testing.T) {
+		manager := &fakeManager{}
+		h := &objectHandlers{manager: manager, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		object := `{"class":"Foo","properties":{"title":"report"}}`
+		res := h.addObjectWithBlobs(objects.ObjectsCreateMultipartParams{
+			HTTPRequest: multipartRequest(t, object, map[string]string{"file": "some pdf"}),
+			Object:      object,
+		}, nil)
+		parsed, ok := res.(*objects.ObjectsCreateMultipartOK)
+		require.True(t, ok)
+		assert.Equal(t, "Foo", parsed.Payload.Class)
+		assert.Equal(t, map[string]string{"file": "some pdf"}, manager.uploadedBlobs)
+	})
+
+	t.Run("upload with an invalid object", func(t *testing.T) {
+		h := &objectHandlers{manager: &fakeManager{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		res := h.addObjectWithBlobs(objects.ObjectsCreateMultipartParams{
+			HTTPRequest: multipartRequest(t, "{", nil),
+			Object:      "{",
+		}, nil)
+		assert.IsType(t, &objects.ObjectsCreateMultipartBadRequest{}, res)
+	})
+
+	t.Run("download", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			err      error
+			expected interface{}
+		}{
+			{name: "no file", err: uco.NewErrNotFound("no file"), expected: &objects.ObjectsClassBlobGetNotFound{}},
+			{name: "forbidden", err: errors.NewForbidden(&models.Principal{}, "get", "objects"), expected: &objects.ObjectsClassBlobGetForbidden{}},
+			{name: "internal", err: uco.NewErrInternal("boom"), expected: &objects.ObjectsClassBlobGetInternalServerError{}},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				h := &objectHandlers{
+					manager:             &fakeManager{blobErr: test.err},
+					metricRequestsTotal: &fakeMetricRequestsTotal{},
+				}
+				res := h.getObjectBlob(objects.ObjectsClassBlobGetParams{
+					HTTPRequest:  httptest.NewRequest("GET", "/v1/objects/Foo/"+id.String()+"/blobs/file", nil),
+					ClassName:    "Foo",
+					ID:           id,
+					PropertyName: "file",
+				}, nil)
+				assert.IsType(t, test.expected, res)
+			})
+		}
+
+		t.Run("file is streamed even if json is accepted", func(t *testing.T) {
+			h := &objectHandlers{manager: &fakeManager{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+			res := h.getObjectBlob(objects.ObjectsClassBlobGetParams{
+				HTTPRequest:  httptest.NewRequest("GET", "/v1/objects/Foo/"+id.String()+"/blobs/file", nil),
+				ClassName:    "Foo",
+				ID:           id,
+				PropertyName: "file",
+			}, nil)
+			rec := httptest.NewRecorder()
+			res.WriteResponse(rec, runtime.JSONProducer())
+			assert.Equal(t, 200, rec.Code)
+			assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+			assert.Equal(t, "some pdf", rec.Body.String())
+		})
+	})
+}
+
+func TestWithDeprecationWarning(t *testing.T) {
+	deprecated := validation.ErrDeprecatedProperty{
+		Class: "Article", Property: "summary", Lifecycle: models.PropertyLifecycleDeprecated,
+	}
+
+	t.Run("write to deprecated property", func(t *testing.T) {
+		err := fmt.Errorf("invalid object: %w", deprecated)
+		rec := httptest.NewRecorder()
+		withDeprecationWarning(objects.NewObjectsCreateUnprocessableEntity(), err).
+			WriteResponse(rec, runtime.JSONProducer())
+		assert.Equal(t, 422, rec.Code)
+		assert.Equal(t, `299 - "property 'summary' of class 'Article' is deprecated and can no longer be written"`,
+			rec.Header().Get("Warning"))
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		withDeprecationWarning(objects.NewObjectsCreateUnprocessableEntity(), stderrors.New("invalid")).
+			WriteResponse(rec, runtime.JSONProducer())
+		assert.Empty(t, rec.Header().Get("Warning"))
+	})
+}
+
+func TestBudgetExhausted(t *testing.T) {
+	t.Run("budget exhausted", func(t *testing.T) {
+		err := fmt.Errorf("update vector: %w",
+			ratelimiter.ErrBudgetExhausted{Name: "text2vec-openai", RetryAfter: 1500 * time.Millisecond})
+		res, ok := budgetExhausted(err)
+		require.True(t, ok)
+
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.JSONProducer())
+		assert.Equal(t, 429, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+		assert.Contains(t, rec.Body.String(), `budget of \"text2vec-openai\" exhausted: retry after 2s`)
+	})
+
+	t.Run("queue full", func(t *testing.T) {
+		res, ok := budgetExhausted(ratelimiter.ErrBudgetExhausted{Name: "text2vec-openai", QueueFull: true})
+		require.True(t, ok)
+
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.JSONProducer())
+		assert.Equal(t, 503, rec.Code)
+		assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		_, ok := budgetExhausted(stderrors.New("invalid"))
+		assert.False(t, ok)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		exhausted := ratelimiter.ErrBudgetExhausted{Name: "text2vec-openai", RetryAfter: time.Second}
+		_, ok := batchBudgetExhausted(uco.BatchObjects{{Err: exhausted}, {Err: exhausted}})
+		assert.True(t, ok)
+		_, ok = batchBudgetExhausted(uco.BatchObjects{{Err: exhausted}, {}})
+		assert.False(t, ok)
+		_, ok = batchBudgetExhausted(nil)
+		assert.False(t, ok)
+	})
+}
@@ -12,6 +12,8 @@
 package rest
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -19,12 +21,22 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/entities/models"
-	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	entschema "github.com/weaviate/weaviate/entities/schema"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
 
+// error codes of typed schema errors, see schemaErrPayload
+const (
+	schemaErrCodeClassExists          = "CLASS_EXISTS"
+	schemaErrCodePropertyExists       = "PROPERTY_EXISTS"
+	schemaErrCodePropertyTypeConflict = "PROPERTY_TYPE_CONFLICT"
+	schemaErrCodeReservedName         = "RESERVED_NAME"
+	schemaErrCodeSchemaChanged        = "SCHEMA_CHANGED"
+)
+
 type schemaHandlers struct {
 	manager             *schemaUC.Manager
 	metricRequestsTotal restApiRequestsTotal
@@ -33,16 +45,21 @@ type schemaHandlers struct {
 func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	_, _, err := s.manager.AddClass(params.HTTPRequest.Context(), principal, params.ObjectClass)
+	ctx := withExpectedSchemaHash(params.HTTPRequest.Context(), params.IfMatch)
+	_, _, err := s.manager.AddClass(ctx, principal, params.ObjectClass)
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ObjectClass.Class, err)
-		switch err.(type) {
-		case errors.Forbidden:
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
 			return schema.NewSchemaObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &schemaUC.ErrClassExists{}),
+			errors.As(err, &schemaUC.ErrSchemaChanged{}):
+			return schema.NewSchemaObjectsCreateConflict().
+				WithPayload(schemaErrPayload(err))
 		default:
 			return schema.NewSchemaObjectsCreateUnprocessableEntity().
-				WithPayload(errPayloadFromSingleErr(err))
+				WithPayload(schemaErrPayload(err))
 		}
 	}
 
@@ -53,21 +70,24 @@ func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	err := s.manager.UpdateClass(params.HTTPRequest.Context(), principal, params.ClassName,
-		params.ObjectClass)
+	ctx := withExpectedSchemaHash(params.HTTPRequest.Context(), params.IfMatch)
+	err := s.manager.UpdateClass(ctx, principal, params.ClassName, params.ObjectClass)
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		if err == schemaUC.ErrNotFound {
 			return schema.NewSchemaObjectsUpdateNotFound()
 		}
 
-		switch err.(type) {
-		case errors.Forbidden:
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
 			return schema.NewSchemaObjectsUpdateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &schemaUC.ErrSchemaChanged{}):
+			return schema.NewSchemaObjectsUpdateConflict().
+				WithPayload(schemaErrPayload(err))
 		default:
 			return schema.NewSchemaObjectsUpdateUnprocessableEntity().
-				WithPayload(errPayloadFromSingleErr(err))
+				WithPayload(schemaErrPayload(err))
 		}
 	}
 
@@ -82,7 +102,7 @@ func (s *schemaHandlers) getClass(params schema.SchemaObjectsGetParams,
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewSchemaObjectsGetForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -101,13 +121,17 @@ func (s *schemaHandlers) getClass(params schema.SchemaObjectsGetParams,
 }
 
 func (s *schemaHandlers) deleteClass(params schema.SchemaObjectsDeleteParams, principal *models.Principal) middleware.Responder {
-	err := s.manager.DeleteClass(params.HTTPRequest.Context(), principal, params.ClassName)
+	ctx := withExpectedSchemaHash(params.HTTPRequest.Context(), params.IfMatch)
+	err := s.manager.DeleteClass(ctx, principal, params.ClassName)
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
-		switch err.(type) {
-		case errors.Forbidden:
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
 			return schema.NewSchemaObjectsDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &schemaUC.ErrSchemaChanged{}):
+			return schema.NewSchemaObjectsDeleteConflict().
+				WithPayload(schemaErrPayload(err))
 		default:
 			return schema.NewSchemaObjectsDeleteBadRequest().WithPayload(errPayloadFromSingleErr(err))
 		}
@@ -120,16 +144,22 @@ func (s *schemaHandlers) deleteClass(params schema.SchemaObjectsDeleteParams, pr
 func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesAddParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	_, _, err := s.manager.AddClassProperty(params.HTTPRequest.Context(), principal, s.manager.ReadOnlyClass(params.ClassName), false, params.Body)
+	ctx := withExpectedSchemaHash(params.HTTPRequest.Context(), params.IfMatch)
+	_, _, err := s.manager.AddClassProperty(ctx, principal, s.manager.ReadOnlyClass(params.ClassName), false, params.Body)
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
-		switch err.(type) {
-		case errors.Forbidden:
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
 			return schema.NewSchemaObjectsPropertiesAddForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &schemaUC.ErrPropertyExists{}),
+			errors.As(err, &schemaUC.ErrPropertyTypeConflict{}),
+			errors.As(err, &schemaUC.ErrSchemaChanged{}):
+			return schema.NewSchemaObjectsPropertiesAddConflict().
+				WithPayload(schemaErrPayload(err))
 		default:
 			return schema.NewSchemaObjectsPropertiesAddUnprocessableEntity().
-				WithPayload(errPayloadFromSingleErr(err))
+				WithPayload(schemaErrPayload(err))
 		}
 	}
 
@@ -137,12 +167,36 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) updatePropertyLifecycle(params schema.SchemaObjectsPropertiesLifecycleUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	prop, _, err := s.manager.UpdatePropertyLifecycle(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, *params.Body.Lifecycle)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaObjectsPropertiesLifecycleUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsPropertiesLifecycleUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesLifecycleUpdateUnprocessableEntity().
+				WithPayload(schemaErrPayload(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesLifecycleUpdateOK().WithPayload(prop)
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetConsistentSchema(principal, *params.Consistency)
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewSchemaDumpForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -153,7 +207,55 @@ func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *mo
 	payload := dbSchema.Objects
 
 	s.metricRequestsTotal.logOk("")
-	return schema.NewSchemaDumpOK().WithPayload(payload)
+	// the hash is computed from the payload, as the schema might have changed
+	// since the hash was set by the middleware
+	return schema.NewSchemaDumpOK().WithPayload(payload).
+		WithXWeaviateSchemaHash(entschema.Hash(payload))
+}
+
+func (s *schemaHandlers) importSchema(params schema.SchemaImportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := withExpectedSchemaHash(params.HTTPRequest.Context(), params.IfMatch)
+	classes, _, err := s.manager.ImportSchema(ctx, principal, params.Schema)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaImportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &schemaUC.ErrClassExists{}),
+			errors.As(err, &schemaUC.ErrSchemaChanged{}):
+			return schema.NewSchemaImportConflict().
+				WithPayload(schemaErrPayload(err))
+		default:
+			return schema.NewSchemaImportUnprocessableEntity().
+				WithPayload(schemaErrPayload(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaImportOK().WithPayload(&models.Schema{Classes: classes})
+}
+
+func (s *schemaHandlers) validateSchema(params schema.SchemaValidateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	diff, err := s.manager.ValidateSchema(params.HTTPRequest.Context(), principal, params.Schema)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaValidateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaValidateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaValidateOK().WithPayload(diff)
 }
 
 func (s *schemaHandlers) getShardsStatus(params schema.SchemaObjectsShardsGetParams,
@@ -170,7 +272,7 @@ func (s *schemaHandlers) getShardsStatus(params schema.SchemaObjectsShardsGetPar
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewSchemaObjectsShardsGetForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -193,7 +295,7 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewSchemaObjectsShardsGetForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -216,7 +318,7 @@ func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewTenantsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -237,7 +339,7 @@ func (s *schemaHandlers) updateTenants(params schema.TenantsUpdateParams,
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewTenantsUpdateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -258,7 +360,7 @@ func (s *schemaHandlers) deleteTenants(params schema.TenantsDeleteParams,
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewTenantsDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -278,7 +380,7 @@ func (s *schemaHandlers) getTenants(params schema.TenantsGetParams,
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewTenantsGetForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -299,7 +401,7 @@ func (s *schemaHandlers) getTenant(
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewTenantsGetOneForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -329,7 +431,7 @@ func (s *schemaHandlers) tenantExists(params schema.TenantExistsParams, principa
 			return schema.NewTenantExistsNotFound()
 		}
 		switch err.(type) {
-		case errors.Forbidden:
+		case autherrs.Forbidden:
 			return schema.NewTenantExistsForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		default:
@@ -346,10 +448,16 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 
 	api.SchemaSchemaObjectsCreateHandler = schema.
 		SchemaObjectsCreateHandlerFunc(h.addClass)
+	api.SchemaSchemaImportHandler = schema.
+		SchemaImportHandlerFunc(h.importSchema)
+	api.SchemaSchemaValidateHandler = schema.
+		SchemaValidateHandlerFunc(h.validateSchema)
 	api.SchemaSchemaObjectsDeleteHandler = schema.
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesLifecycleUpdateHandler = schema.
+		SchemaObjectsPropertiesLifecycleUpdateHandlerFunc(h.updatePropertyLifecycle)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -386,9 +494,38 @@ func (e *schemaRequestsTotal) logError(className string, err error) {
 	switch err.(type) {
 	case uco.ErrMultiTenancy:
 		e.logUserError(className)
-	case errors.Forbidden:
+	case autherrs.Forbidden:
 		e.logUserError(className)
 	default:
 		e.logUserError(className)
 	}
 }
+
+// schemaErrPayload is like errPayloadFromSingleErr, but additionally sets the
+// error code if err is a typed schema error, so that clients don't need to
+// parse the message
+func schemaErrPayload(err error) *models.ErrorResponse {
+	payload := errPayloadFromSingleErr(err)
+	switch {
+	case errors.As(err, &schemaUC.ErrClassExists{}):
+		payload.Error[0].Code = schemaErrCodeClassExists
+	case errors.As(err, &schemaUC.ErrPropertyExists{}):
+		payload.Error[0].Code = schemaErrCodePropertyExists
+	case errors.As(err, &schemaUC.ErrPropertyTypeConflict{}):
+		payload.Error[0].Code = schemaErrCodePropertyTypeConflict
+	case errors.As(err, &schemaUC.ErrReservedName{}):
+		payload.Error[0].Code = schemaErrCodeReservedName
+	case errors.As(err, &schemaUC.ErrSchemaChanged{}):
+		payload.Error[0].Code = schemaErrCodeSchemaChanged
+	}
+	return payload
+}
+
+// withExpectedSchemaHash makes schema changes made with the returned context
+// fail if the schema hash passed as If-Match is outdated
+func withExpectedSchemaHash(ctx context.Context, ifMatch *string) context.Context {
+	if ifMatch == nil {
+		return ctx
+	}
+	return schemaUC.ContextWithExpectedHash(ctx, *ifMatch)
+}
@@ -0,0 +1,47 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func TestSchemaErrPayload(t *testing.T) {
+	tests := []struct {
+		err  error
+		code string
+	}{
+		{err: schemaUC.NewErrClassExists("class name A already exists"), code: "CLASS_EXISTS"},
+		{err: schemaUC.NewErrPropertyExists("property p in use"), code: "PROPERTY_EXISTS"},
+		{err: schemaUC.NewErrPropertyTypeConflict("property p is text"), code: "PROPERTY_TYPE_CONFLICT"},
+		{
+			err:  fmt.Errorf("parse class name: %w", schemaUC.NewErrReservedName("raft is reserved")),
+			code: "RESERVED_NAME",
+		},
+		{err: schemaUC.NewErrSchemaChanged("schema changed"), code: "SCHEMA_CHANGED"},
+		{err: errors.New("invalid class")},
+	}
+	for _, test := range tests {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			payload := schemaErrPayload(test.err)
+			require.Len(t, payload.Error, 1)
+			assert.Equal(t, test.err.Error(), payload.Error[0].Message)
+			assert.Equal(t, test.code, payload.Error[0].Code)
+		})
+	}
+}
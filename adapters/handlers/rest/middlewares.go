@@ -13,23 +13,35 @@ package rest
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"math"
+	"net"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sentryhttp "github.com/getsentry/sentry-go/http"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/raft"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/logging"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/requestsettings"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
@@ -37,15 +49,264 @@ import (
 //
 // we are setting the middlewares from within configureAPI, as we need access
 // to some resources which are not exposed
-func makeSetupMiddlewares(appState *state.State) func(http.Handler) http.Handler {
+func makeSetupMiddlewares(appState *state.State, runtime *runtimeMiddlewares,
+	authenticate composer.TokenFunc,
+) func(http.Handler) http.Handler {
 	return func(handler http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.URL.String() == "/v1/.well-known/openid-configuration" || r.URL.String() == "/v1" {
 				handler.ServeHTTP(w, r)
 				return
 			}
 			appState.AnonymousAccess.Middleware(handler).ServeHTTP(w, r)
 		})
+		return makeAddRateLimiting(runtime.rateLimiter, authenticate)(next)
+	}
+}
+
+const (
+	// QuotaRateHeader warns clients that few requests are left before they are
+	// rate limited
+	QuotaRateHeader = "X-Weaviate-Quota-Rate"
+	// QuotaDiskHeader warns clients that the disk usage is above the warning
+	// threshold, shards are set to read-only once it reaches the read-only
+	// threshold
+	QuotaDiskHeader = "X-Weaviate-Quota-Disk"
+	// quotaWarningRatio is the share of the rate limit burst below which
+	// clients are warned
+	quotaWarningRatio = 0.2
+)
+
+// makeAddRateLimiting limits requests with the current limiter, a nil
+// limiter means rate limiting is disabled
+func makeAddRateLimiting(limiter func() *ratelimiter.Keyed,
+	authenticate composer.TokenFunc,
+) func(http.Handler) http.Handler {
+	users := newTokenUsers(authenticate, tokenUsersTTL, maxTokenUsers)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			l := limiter()
+			if l == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			now := time.Now()
+			key := rateLimitKey(r, users, now)
+			ok, retryAfter := l.Allow(key, now)
+			if !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				json.NewEncoder(w).Encode(&models.ErrorResponse{
+					Error: []*models.ErrorResponseErrorItems0{{Message: "rate limit exceeded"}},
+				})
+				return
+			}
+
+			if remaining, burst, ok := l.Remaining(key, now); ok &&
+				float64(remaining) < quotaWarningRatio*float64(burst) {
+				w.Header().Set(QuotaRateHeader, fmt.Sprintf("remaining=%d; limit=%d", remaining, burst))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rateLimitKey identifies the client by the user its token authenticates.
+// Requests without a valid token are limited per remote host, otherwise a
+// client could get a new limit with every made up token it sends.
+func rateLimitKey(r *http.Request, users *tokenUsers, now time.Time) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if ok && token != "" {
+		if user := users.user(token, now); user != "" {
+			return "user:" + user
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "host:" + host
+}
+
+const (
+	// tokenUsersTTL is how long the user of a token is used for rate
+	// limiting before the token is verified again
+	tokenUsersTTL = time.Minute
+	// maxTokenUsers bounds the tokens which are remembered, further tokens
+	// are verified on every request until remembered ones expire
+	maxTokenUsers = 10_000
+)
+
+// tokenUsers remembers the users tokens authenticate. The rate limit runs
+// before the API authenticates the request, so without it every token would
+// be verified twice, which is expensive for OIDC tokens. Tokens are only
+// kept as hashes, tokens which don't authenticate are remembered as well, so
+// that made up tokens are not verified over and over. The user is only used
+// to pick the limit, the API still verifies every token.
+type tokenUsers struct {
+	authenticate composer.TokenFunc
+	ttl          time.Duration
+	maxUsers     int
+
+	sync.Mutex
+	users map[[sha256.Size]byte]tokenUser
+}
+
+type tokenUser struct {
+	name    string
+	expires time.Time
+}
+
+func newTokenUsers(authenticate composer.TokenFunc, ttl time.Duration, maxUsers int) *tokenUsers {
+	return &tokenUsers{
+		authenticate: authenticate,
+		ttl:          ttl,
+		maxUsers:     maxUsers,
+		users:        map[[sha256.Size]byte]tokenUser{},
+	}
+}
+
+// user returns the user the token authenticates, "" if it doesn't
+func (t *tokenUsers) user(token string, now time.Time) string {
+	if t.authenticate == nil {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	t.Lock()
+	cached, ok := t.users[sum]
+	t.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.name
+	}
+
+	var name string
+	if principal, err := t.authenticate(token, nil); err == nil && principal != nil {
+		name = principal.Username
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	if len(t.users) >= t.maxUsers {
+		for key, user := range t.users {
+			if !now.Before(user.expires) {
+				delete(t.users, key)
+			}
+		}
+	}
+	if len(t.users) < t.maxUsers {
+		t.users[sum] = tokenUser{name: name, expires: now.Add(t.ttl)}
+	}
+	return name
+}
+
+// makeAddSecurityHeaders sets the headers before calling the next handler, so
+// that handlers can still override them
+func makeAddSecurityHeaders(cfg config.SecurityHeaders) func(http.Handler) http.Handler {
+	hsts := ""
+	if cfg.HSTSMaxAgeSeconds > 0 {
+		hsts = fmt.Sprintf("max-age=%d", cfg.HSTSMaxAgeSeconds)
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		if cfg.Disabled {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+			if hsts != "" {
+				w.Header().Set("Strict-Transport-Security", hsts)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// makeAddDeprecationHeaders marks the responses of operations which are
+// deprecated in the swagger spec with a Deprecation header, and with a Sunset
+// header if the operation has an x-sunset date. Requests are counted per user
+// their token authenticates, so that clients which still use the operations
+// can be identified. Requests without a valid token are counted as anonymous,
+// so that made up tokens can't add series to the metric.
+func makeAddDeprecationHeaders(context *middleware.Context, requests *prometheus.CounterVec,
+	users *tokenUsers,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, routed, ok := context.RouteInfo(r)
+			if !ok || route.Operation == nil || !route.Operation.Deprecated {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("Deprecation", "true")
+			if sunset, ok := operationSunset(route.Operation.Extensions); ok {
+				w.Header().Set("Sunset", sunset.Format(http.TimeFormat))
+			}
+			if requests != nil {
+				requests.With(prometheus.Labels{
+					"operation": route.Operation.ID,
+					"user":      deprecatedRequestUser(r, users),
+				}).Inc()
+			}
+
+			// the matched route is kept, so that it isn't looked up again
+			next.ServeHTTP(w, routed)
+		})
+	}
+}
+
+// operationSunset parses the x-sunset extension, a date as YYYY-MM-DD
+func operationSunset(extensions map[string]interface{}) (time.Time, bool) {
+	value, ok := extensions["x-sunset"].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	sunset, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return sunset, true
+}
+
+// deprecatedRequestUser is the user the token of a request authenticates,
+// "anonymous" if there is none
+func deprecatedRequestUser(r *http.Request, users *tokenUsers) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if ok && token != "" {
+		if user := users.user(token, time.Now()); user != "" {
+			return user
+		}
+	}
+	return "anonymous"
+}
+
+func deprecatedRequestsTotal(metrics *monitoring.PrometheusMetrics) *prometheus.CounterVec {
+	if metrics == nil {
+		return nil
+	}
+	return metrics.DeprecatedRequestsTotal
+}
+
+// makeAddDiskQuotaWarning warns clients before writes fail because of the
+// disk usage
+func makeAddDiskQuotaWarning(diskUse func() (float64, bool), readOnlyPercentage uint64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if used, ok := diskUse(); ok {
+				value := fmt.Sprintf("used=%.2f", used)
+				if readOnlyPercentage > 0 {
+					value += fmt.Sprintf("; limit=%d", readOnlyPercentage)
+				}
+				w.Header().Set(QuotaDiskHeader, value)
+			}
+			next.ServeHTTP(w, r)
+		})
 	}
 }
 
@@ -109,26 +370,39 @@ func makeAddModuleHandlers(modules *modules.Provider) func(http.Handler) http.Ha
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics
 // Contains "x-api-key", "x-api-token" for legacy reasons, older interfaces might need these headers.
-func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Context) func(http.Handler) http.Handler {
+func makeSetupGlobalMiddleware(appState *state.State, runtime *runtimeMiddlewares, context *middleware.Context,
+	authenticate composer.TokenFunc,
+) func(http.Handler) http.Handler {
 	return func(handler http.Handler) http.Handler {
-		handleCORS := cors.New(cors.Options{
-			OptionsPassthrough: true,
-			AllowedMethods:     strings.Split(appState.ServerConfig.Config.CORS.AllowMethods, ","),
-			AllowedHeaders:     strings.Split(appState.ServerConfig.Config.CORS.AllowHeaders, ","),
-			AllowedOrigins:     strings.Split(appState.ServerConfig.Config.CORS.AllowOrigin, ","),
-		}).Handler
-		handler = handleCORS(handler)
-		handler = swagger_middleware.AddMiddleware([]byte(SwaggerJSON), handler)
+		handler = runtime.handleCORS(handler)
+		handler = swagger_middleware.AddMiddleware([]byte(SwaggerJSON),
+			appState.ServerConfig.Config.SecurityHeaders.DisableSwaggerUI, handler)
+		openAPI, err := runtimeOpenAPI(SwaggerJSON, capabilitiesFromState(appState))
+		if err != nil {
+			appState.Logger.WithField("action", "startup").WithError(err).
+				Warn("could not adjust the OpenAPI document, serving the static one")
+			openAPI = SwaggerJSON
+		}
+		handler = addOpenAPIDocument(openAPI, handler)
 		handler = makeAddLogging(appState.Logger)(handler)
 		if appState.ServerConfig.Config.Monitoring.Enabled {
 			handler = makeAddMonitoring(appState.Metrics)(handler)
 		}
-		handler = addPreflight(handler, appState.ServerConfig.Config.CORS)
+		handler = addPreflight(handler, runtime.corsConfig)
 		handler = addLiveAndReadyness(appState, handler)
 		handler = addHandleRoot(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
 		handler = addInjectHeadersIntoContext(handler)
+		handler = addRequestSettings(handler)
+		if appState.DB != nil {
+			handler = makeAddDiskQuotaWarning(appState.DB.DiskUseAboveWarning,
+				appState.ServerConfig.Config.ResourceUsage.DiskUse.ReadOnlyPercentage)(handler)
+		}
 		handler = makeCatchPanics(appState.Logger, newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
+		handler = addRequestID(handler)
+		handler = makeAddDeprecationHeaders(context, deprecatedRequestsTotal(appState.Metrics),
+			newTokenUsers(authenticate, tokenUsersTTL, maxTokenUsers))(handler)
+		handler = makeAddSchemaHash(appState)(handler)
 		if appState.ServerConfig.Config.Monitoring.Enabled {
 			handler = monitoring.InstrumentHTTP(
 				handler,
@@ -139,8 +413,21 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 				appState.ServerMetrics.ResponseBodySize,
 			)
 		}
+		if appState.ServerConfig.Config.Tracing.Enabled {
+			handler = otelhttp.NewHandler(handler, "rest",
+				otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+					// name spans by route pattern to keep their number bounded
+					if route, _, ok := context.RouteInfo(r); ok {
+						return r.Method + " " + route.PathPattern
+					}
+					return r.Method
+				}))
+		}
 		// Must be the last middleware as it might skip the next handler
 		handler = addClusterHandlerMiddleware(handler, appState)
+		// wraps the cluster handler, so that its endpoints are covered as well
+		handler = makeAddNetworkACL(runtime.currentNetworkACL, appState.Logger)(handler)
+		handler = makeAddSecurityHeaders(appState.ServerConfig.Config.SecurityHeaders)(handler)
 		if appState.ServerConfig.Config.Sentry.Enabled {
 			handler = addSentryHandler(handler)
 		}
@@ -156,7 +443,7 @@ func addSentryHandler(next http.Handler) http.Handler {
 func makeAddLogging(logger logrus.FieldLogger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			logger.
+			logging.WithRequest(r.Context(), logger).
 				WithField("action", "restapi_request").
 				WithField("method", r.Method).
 				WithField("url", r.URL).
@@ -182,14 +469,49 @@ func makeAddMonitoring(metrics *monitoring.PrometheusMetrics) func(http.Handler)
 				}).
 					Observe(float64(time.Since(before) / time.Millisecond))
 
-				metrics.BatchSizeBytes.WithLabelValues("rest").Observe(float64(r.ContentLength))
+				// streamed batches don't announce their size
+				if r.ContentLength >= 0 {
+					metrics.BatchSizeBytes.WithLabelValues("rest").Observe(float64(r.ContentLength))
+				}
 			}
 		})
 	}
 }
 
-func addPreflight(next http.Handler, cfg config.CORS) http.Handler {
+// addRequestID makes sure every request carries an id in its context, so
+// that everything logged with that context can be correlated. A client
+// supplied id is kept, otherwise a new one is generated. Either way the id
+// is echoed in the response.
+func addRequestID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(logging.RequestIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+		}
+		w.Header().Set(logging.RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(logging.WithRequestID(r.Context(), id)))
+	})
+}
+
+// SchemaHashHeader carries the hash of the current schema in every
+// response, so that clients can tell cheaply whether cached schema
+// information, such as a GraphQL introspection, is stale.
+const SchemaHashHeader = "X-Weaviate-Schema-Hash"
+
+func makeAddSchemaHash(appState *state.State) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if hash := appState.GetSchemaHash(); hash != "" {
+				w.Header().Set(SchemaHashHeader, hash)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func addPreflight(next http.Handler, corsConfig func() config.CORS) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg := corsConfig()
 		w.Header().Set("Access-Control-Allow-Origin", cfg.AllowOrigin)
 		w.Header().Set("Access-Control-Allow-Methods", cfg.AllowMethods)
 		w.Header().Set("Access-Control-Allow-Headers", cfg.AllowHeaders)
@@ -202,6 +524,30 @@ func addPreflight(next http.Handler, cfg config.CORS) http.Handler {
 	})
 }
 
+// addRequestSettings parses the request settings headers once and stores them
+// in the context. The timeout is applied to the context right away.
+func addRequestSettings(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		settings, err := requestsettings.FromHeaders(r.Header)
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(&models.ErrorResponse{
+				Error: []*models.ErrorResponseErrorItems0{{Message: err.Error()}},
+			})
+			return
+		}
+
+		ctx := requestsettings.WithSettings(r.Context(), settings)
+		if settings.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, settings.Timeout)
+			defer cancel()
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func addInjectHeadersIntoContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -242,6 +588,20 @@ func addLiveAndReadyness(state *state.State, next http.Handler) http.Handler {
 					code = http.StatusServiceUnavailable
 				}
 			}
+
+			// while warming up, the node is live but should not receive traffic
+			// yet. The progress is reported in the body.
+			if state.DB != nil {
+				if warmUp := state.DB.WarmUpStatus(); warmUp.Enabled {
+					if !warmUp.Done {
+						code = http.StatusServiceUnavailable
+					}
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(code)
+					json.NewEncoder(w).Encode(map[string]any{"warmUp": warmUp})
+					return
+				}
+			}
 			w.WriteHeader(code)
 			return
 		}
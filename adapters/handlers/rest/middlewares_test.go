@@ -0,0 +1,330 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	openapispec "github.com/go-openapi/spec"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/requestsettings"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	request := func(handler http.Handler, path string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.SetBasicAuth("key", "token")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		w := request(makeAddSecurityHeaders(config.SecurityHeaders{})(ok), "/v1/objects")
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		assert.Empty(t, w.Header().Get("Strict-Transport-Security"))
+	})
+
+	t.Run("hsts", func(t *testing.T) {
+		w := request(makeAddSecurityHeaders(config.SecurityHeaders{HSTSMaxAgeSeconds: 31536000})(ok), "/v1/objects")
+		assert.Equal(t, "max-age=31536000", w.Header().Get("Strict-Transport-Security"))
+
+		w = request(makeAddSecurityHeaders(config.SecurityHeaders{
+			HSTSMaxAgeSeconds: 600, HSTSIncludeSubdomains: true,
+		})(ok), "/v1/objects")
+		assert.Equal(t, "max-age=600; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		w := request(makeAddSecurityHeaders(config.SecurityHeaders{Disabled: true, HSTSMaxAgeSeconds: 600})(ok), "/v1/objects")
+		assert.Empty(t, w.Header().Get("X-Content-Type-Options"))
+		assert.Empty(t, w.Header().Get("Strict-Transport-Security"))
+	})
+
+	t.Run("swagger UI", func(t *testing.T) {
+		notFound := http.NotFoundHandler()
+
+		w := request(swagger_middleware.AddMiddleware([]byte(`{}`), false, notFound), "/v1/swagger")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+		assert.Equal(t, "frame-ancestors 'none'", w.Header().Get("Content-Security-Policy"))
+
+		w = request(swagger_middleware.AddMiddleware([]byte(`{}`), true, notFound), "/v1/swagger")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = request(swagger_middleware.AddMiddleware([]byte(`{}`), true, notFound), "/v1/swagger.json")
+		assert.Equal(t, http.StatusOK, w.Code, "the spec is still served")
+	})
+}
+
+func TestDeprecationHeaders(t *testing.T) {
+	spec, err := loads.Embedded(SwaggerJSON, FlatSwaggerJSON)
+	require.Nil(t, err)
+	api := operations.NewWeaviateAPI(spec)
+	api.Serve(nil) // builds the router
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "deprecated_requests_total"},
+		[]string{"operation", "user"})
+	authenticate := func(token string, scopes []string) (*models.Principal, error) {
+		if token == "valid" {
+			return &models.Principal{Username: "alice"}, nil
+		}
+		return nil, errors.New("invalid token")
+	}
+
+	var routed bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, routed = api.Context().RouteInfo(r)
+	})
+	handler := makeAddDeprecationHeaders(api.Context(), requests,
+		newTokenUsers(authenticate, time.Minute, 10))(next)
+	request := func(method, path, key string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, nil)
+		if key != "" {
+			r.Header.Set("Authorization", "Bearer "+key)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("deprecated operation", func(t *testing.T) {
+		w := request(http.MethodGet, "/v1/objects/34e9df15-0c3b-468d-ab99-f929662834c7", "valid")
+		assert.Equal(t, "true", w.Header().Get("Deprecation"))
+		assert.Empty(t, w.Header().Get("Sunset"))
+		assert.True(t, routed)
+
+		request(http.MethodGet, "/v1/objects/34e9df15-0c3b-468d-ab99-f929662834c7", "valid")
+		request(http.MethodDelete, "/v1/objects/34e9df15-0c3b-468d-ab99-f929662834c7", "")
+		request(http.MethodDelete, "/v1/objects/34e9df15-0c3b-468d-ab99-f929662834c7", "made-up")
+		assert.Equal(t, 2.0, testutil.ToFloat64(requests.WithLabelValues("objects.get", "alice")))
+		assert.Equal(t, 2.0, testutil.ToFloat64(requests.WithLabelValues("objects.delete", "anonymous")))
+	})
+
+	t.Run("other operations", func(t *testing.T) {
+		for _, path := range []string{"/v1/objects/Article/34e9df15-0c3b-468d-ab99-f929662834c7", "/v1/unknown"} {
+			w := request(http.MethodGet, path, "valid")
+			assert.Empty(t, w.Header().Get("Deprecation"), path)
+		}
+		assert.Equal(t, 2, testutil.CollectAndCount(requests))
+	})
+
+	t.Run("sunset", func(t *testing.T) {
+		sunset, ok := operationSunset(map[string]interface{}{"x-sunset": "2025-06-30"})
+		require.True(t, ok)
+		assert.Equal(t, "Mon, 30 Jun 2025 00:00:00 GMT", sunset.Format(http.TimeFormat))
+
+		_, ok = operationSunset(map[string]interface{}{"x-sunset": "soon"})
+		assert.False(t, ok)
+		_, ok = operationSunset(nil)
+		assert.False(t, ok)
+	})
+
+	t.Run("sunset dates of the spec are valid", func(t *testing.T) {
+		for path, item := range spec.Spec().Paths.Paths {
+			for _, op := range []*openapispec.Operation{
+				item.Get, item.Put, item.Post, item.Delete, item.Patch, item.Head, item.Options,
+			} {
+				if op == nil {
+					continue
+				}
+				if _, ok := op.Extensions["x-sunset"]; ok {
+					_, valid := operationSunset(op.Extensions)
+					assert.True(t, valid, "%s %s", path, op.ID)
+				}
+			}
+		}
+	})
+}
+
+func TestRequestSettings(t *testing.T) {
+	var (
+		settings requestsettings.Settings
+		deadline bool
+	)
+	handler := addRequestSettings(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		settings = requestsettings.FromContext(r.Context())
+		_, deadline = r.Context().Deadline()
+	}))
+	request := func(headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("parsed into the context", func(t *testing.T) {
+		w := request(map[string]string{
+			requestsettings.ConsistencyLevelHeader: "ALL",
+			requestsettings.TenantHeader:           "tenant1",
+			requestsettings.TimeoutHeader:          "10s",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, requestsettings.Settings{ConsistencyLevel: "ALL", Tenant: "tenant1", Timeout: 10 * time.Second}, settings)
+		assert.True(t, deadline)
+
+		request(nil)
+		assert.Equal(t, requestsettings.Settings{}, settings)
+		assert.False(t, deadline)
+	})
+
+	t.Run("invalid headers", func(t *testing.T) {
+		w := request(map[string]string{requestsettings.TimeoutHeader: "forever"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), requestsettings.TimeoutHeader)
+	})
+
+	t.Run("defaults of the REST handlers", func(t *testing.T) {
+		ctx := requestsettings.WithSettings(context.Background(),
+			requestsettings.Settings{ConsistencyLevel: "ONE", Tenant: "tenant1"})
+		quorum, node, tenant := "QUORUM", "node1", "tenant2"
+
+		repl, err := getReplicationProperties(ctx, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "ONE", repl.ConsistencyLevel)
+		repl, err = getReplicationProperties(ctx, &quorum, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "QUORUM", repl.ConsistencyLevel)
+		repl, err = getReplicationProperties(ctx, nil, &node)
+		require.Nil(t, err)
+		assert.Equal(t, additional.ReplicationProperties{NodeName: "node1"}, *repl)
+		repl, err = getReplicationProperties(context.Background(), nil, nil)
+		require.Nil(t, err)
+		assert.Nil(t, repl)
+
+		assert.Equal(t, "tenant1", getTenant(ctx, nil))
+		assert.Equal(t, "tenant2", getTenant(ctx, &tenant))
+		assert.Equal(t, "", getTenant(context.Background(), nil))
+	})
+}
+
+func TestQuotaWarnings(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	t.Run("rate limit", func(t *testing.T) {
+		limiter := ratelimiter.NewKeyed(1, 10, 0, 0)
+		handler := makeAddRateLimiting(func() *ratelimiter.Keyed { return limiter }, nil)(next)
+
+		var headers []string
+		for i := 0; i < 10; i++ {
+			r := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			require.Equal(t, http.StatusOK, w.Code)
+			headers = append(headers, w.Header().Get(QuotaRateHeader))
+		}
+		assert.Equal(t, "", headers[0])
+		assert.Equal(t, "", headers[7])
+		assert.Equal(t, "remaining=1; limit=10", headers[8])
+		assert.Equal(t, "remaining=0; limit=10", headers[9])
+	})
+
+	t.Run("disk use", func(t *testing.T) {
+		request := func(used float64, above bool, limit uint64) string {
+			diskUse := func() (float64, bool) { return used, above }
+			w := httptest.NewRecorder()
+			makeAddDiskQuotaWarning(diskUse, limit)(next).
+				ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/objects", nil))
+			return w.Header().Get(QuotaDiskHeader)
+		}
+
+		assert.Equal(t, "", request(50, false, 90))
+		assert.Equal(t, "used=85.50; limit=90", request(85.5, true, 90))
+		assert.Equal(t, "used=85.50", request(85.5, true, 0))
+	})
+}
+
+func TestRateLimitKey(t *testing.T) {
+	var calls int
+	authenticate := func(token string, scopes []string) (*models.Principal, error) {
+		calls++
+		if token == "valid" {
+			return &models.Principal{Username: "alice"}, nil
+		}
+		return nil, errors.New("invalid token")
+	}
+	request := func(remoteAddr, token string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+		r.RemoteAddr = remoteAddr
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		return r
+	}
+	now := time.Now()
+
+	users := newTokenUsers(authenticate, time.Minute, 10)
+	assert.Equal(t, "user:alice", rateLimitKey(request("10.0.0.1:1234", "valid"), users, now))
+	assert.Equal(t, "user:alice", rateLimitKey(request("10.0.0.2:1234", "valid"), users, now))
+	assert.Equal(t, "host:10.0.0.1", rateLimitKey(request("10.0.0.1:1234", ""), users, now))
+	assert.Equal(t, "host:10.0.0.1", rateLimitKey(request("10.0.0.1:1234", "made-up-1"), users, now))
+	assert.Equal(t, "host:10.0.0.1", rateLimitKey(request("10.0.0.1:5678", "made-up-2"), users, now))
+	assert.Equal(t, "host:10.0.0.1", rateLimitKey(request("10.0.0.1:1234", "valid"),
+		newTokenUsers(nil, time.Minute, 10), now))
+
+	t.Run("tokens are verified once until they expire", func(t *testing.T) {
+		calls = 0
+		users := newTokenUsers(authenticate, time.Minute, 10)
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, "user:alice", rateLimitKey(request("10.0.0.1:1234", "valid"), users, now))
+			assert.Equal(t, "host:10.0.0.1", rateLimitKey(request("10.0.0.1:1234", "made-up"), users, now))
+		}
+		assert.Equal(t, 2, calls)
+
+		assert.Equal(t, "user:alice", rateLimitKey(request("10.0.0.1:1234", "valid"), users, now.Add(time.Minute)))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("remembered tokens are bounded", func(t *testing.T) {
+		users := newTokenUsers(authenticate, time.Minute, 2)
+		for _, token := range []string{"made-up-1", "made-up-2", "made-up-3"} {
+			rateLimitKey(request("10.0.0.1:1234", token), users, now)
+		}
+		assert.Len(t, users.users, 2)
+
+		rateLimitKey(request("10.0.0.1:1234", "valid"), users, now.Add(time.Minute))
+		assert.Len(t, users.users, 1)
+	})
+
+	t.Run("made up tokens share the limit of the host", func(t *testing.T) {
+		limiter := ratelimiter.NewKeyed(1, 1, 0, 0)
+		handler := makeAddRateLimiting(func() *ratelimiter.Keyed { return limiter }, authenticate)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		serve := func(token string) int {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, request("10.0.0.1:1234", token))
+			return w.Code
+		}
+
+		assert.Equal(t, http.StatusOK, serve("made-up-1"))
+		assert.Equal(t, http.StatusTooManyRequests, serve("made-up-2"))
+		assert.Equal(t, http.StatusOK, serve("valid"))
+	})
+}
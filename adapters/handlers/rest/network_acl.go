@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/networkacl"
+)
+
+// makeAddNetworkACL rejects requests whose remote address isn't allowed by
+// the current rules. Only the address of the connection is checked, as
+// forwarding headers can be set by any client. Liveness and readiness probes
+// are always allowed. The gRPC API is covered by the interceptor of the gRPC
+// server, which uses the same rules.
+func makeAddNetworkACL(acl func() *networkacl.ACL, logger logrus.FieldLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			group, rules := acl().RESTGroup(r.URL.Path)
+			if rules.Empty() || r.URL.Path == "/v1/.well-known/live" || r.URL.Path == "/v1/.well-known/ready" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			host, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				host = r.RemoteAddr
+			}
+			if rules.Allows(net.ParseIP(host)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			logger.WithFields(logrus.Fields{
+				"action":    "network_acl_denied",
+				"audit":     true,
+				"remote_ip": host,
+				"group":     group,
+				"method":    r.Method,
+				"path":      r.URL.Path,
+			}).Warn("request denied by network ACL")
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(&models.ErrorResponse{
+				Error: []*models.ErrorResponseErrorItems0{{Message: "access from this address is not allowed"}},
+			})
+		})
+	}
+}
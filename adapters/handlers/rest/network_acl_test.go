@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestNetworkACL(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	m := newRuntimeMiddlewares(config.RuntimeSettings{})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	handler := makeAddNetworkACL(m.currentNetworkACL, logger)(ok)
+	request := func(remoteAddr, path string) int {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	t.Run("no rules", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request("203.0.113.5:1234", "/v1/schema"))
+		assert.Equal(t, http.StatusOK, request("203.0.113.5:1234", "/v1/objects"))
+	})
+
+	m.apply(config.RuntimeSettings{NetworkACL: config.NetworkACL{
+		Admin: config.NetworkACLRules{Allow: "10.0.0.0/8,2001:db8::/32", Deny: "10.0.66.0/24"},
+		Data:  config.NetworkACLRules{Deny: "203.0.113.0/24"},
+	}})
+
+	t.Run("admin endpoints", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request("10.1.2.3:1234", "/v1/schema/Article"))
+		assert.Equal(t, http.StatusOK, request("[2001:db8::1]:1234", "/v1/backups/s3"))
+		assert.Equal(t, http.StatusForbidden, request("192.168.1.1:1234", "/v1/cluster/statistics"))
+		assert.Equal(t, http.StatusForbidden, request("10.0.66.7:1234", "/v1/authz/roles"), "deny takes precedence")
+		assert.Equal(t, http.StatusForbidden, request("not an address", "/v1/nodes"))
+		assert.Equal(t, http.StatusForbidden, request("192.168.1.1:1234", "/v1/Schema/Article"))
+		assert.Equal(t, http.StatusForbidden, request("192.168.1.1:1234", "/v1//schema/"))
+		assert.Equal(t, http.StatusForbidden, request("192.168.1.1:1234", "/v1/objects/../backups"))
+	})
+
+	t.Run("data endpoints", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request("192.168.1.1:1234", "/v1/objects"))
+		assert.Equal(t, http.StatusOK, request("192.168.1.1:1234", "/v1/schemas-are-not-admin"))
+		assert.Equal(t, http.StatusForbidden, request("203.0.113.5:1234", "/v1/graphql"))
+	})
+
+	t.Run("probes are always allowed", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request("203.0.113.5:1234", "/v1/.well-known/live"))
+		assert.Equal(t, http.StatusOK, request("203.0.113.5:1234", "/v1/.well-known/ready"))
+	})
+
+	t.Run("denied attempts are audited", func(t *testing.T) {
+		hook.Reset()
+		request("203.0.113.5:1234", "/v1/objects")
+		require.Len(t, hook.AllEntries(), 1)
+		entry := hook.LastEntry()
+		assert.Equal(t, "network_acl_denied", entry.Data["action"])
+		assert.Equal(t, "203.0.113.5", entry.Data["remote_ip"])
+		assert.Equal(t, "data", entry.Data["group"])
+		assert.Equal(t, "/v1/objects", entry.Data["path"])
+	})
+
+	t.Run("rules are reloaded", func(t *testing.T) {
+		m.apply(config.RuntimeSettings{})
+		assert.Equal(t, http.StatusOK, request("203.0.113.5:1234", "/v1/graphql"))
+	})
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+)
+
+// capabilities are the parts of the configuration of the running server which
+// change the operations it offers
+type capabilities struct {
+	anonymousAccess bool
+	oidc            bool
+	apiKey          bool
+	rbac            bool
+	graphQL         bool
+	backups         bool
+	modules         []string
+}
+
+func capabilitiesFromState(appState *state.State) capabilities {
+	cfg := appState.ServerConfig.Config
+	c := capabilities{
+		anonymousAccess: cfg.Authentication.AnonymousAccess.Enabled,
+		oidc:            cfg.Authentication.OIDC.Enabled,
+		apiKey:          cfg.Authentication.APIKey.Enabled,
+		rbac:            cfg.Authorization.Rbac.Enabled,
+		graphQL:         !cfg.DisableGraphQL,
+	}
+	if appState.Modules != nil {
+		c.backups = len(appState.Modules.EnabledBackupBackends()) > 0
+		for _, mod := range appState.Modules.GetAll() {
+			c.modules = append(c.modules, mod.Name())
+		}
+		sort.Strings(c.modules)
+	}
+	return c
+}
+
+// runtimeOpenAPI adjusts the static swagger spec to the capabilities of the
+// server. Operations which can't be used are removed, the security
+// definitions list the enabled authentication schemes and the enabled modules
+// are listed in the info section.
+func runtimeOpenAPI(swaggerJSON []byte, c capabilities) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(swaggerJSON, &doc); err != nil {
+		return nil, fmt.Errorf("parse swagger spec: %w", err)
+	}
+
+	paths, _ := doc["paths"].(map[string]interface{})
+	removePaths := func(prefix string) {
+		for path := range paths {
+			if path == prefix || strings.HasPrefix(path, prefix+"/") {
+				delete(paths, path)
+			}
+		}
+	}
+	if !c.graphQL {
+		removePaths("/graphql")
+	}
+	if !c.backups {
+		removePaths("/backups")
+	}
+	if !c.rbac {
+		removePaths("/authz")
+	}
+	if !c.apiKey {
+		removePaths("/keys")
+	}
+	removeUnusedTags(doc, paths)
+
+	definitions := map[string]interface{}{}
+	security := []interface{}{}
+	if c.anonymousAccess {
+		security = append(security, map[string]interface{}{})
+	}
+	if c.oidc {
+		if current, ok := doc["securityDefinitions"].(map[string]interface{}); ok && current["oidc"] != nil {
+			definitions["oidc"] = current["oidc"]
+			security = append(security, map[string]interface{}{"oidc": []interface{}{}})
+		}
+	}
+	if c.apiKey {
+		definitions["apiKey"] = map[string]interface{}{
+			"type":        "apiKey",
+			"in":          "header",
+			"name":        "Authorization",
+			"description": "API key sent as bearer token: 'Bearer <key>'",
+		}
+		security = append(security, map[string]interface{}{"apiKey": []interface{}{}})
+	}
+	doc["securityDefinitions"] = definitions
+	doc["security"] = security
+
+	if info, ok := doc["info"].(map[string]interface{}); ok {
+		modules := c.modules
+		if modules == nil {
+			modules = []string{}
+		}
+		info["x-weaviate-modules"] = modules
+	}
+
+	return json.Marshal(doc)
+}
+
+// removeUnusedTags removes the tags which no remaining operation refers to
+func removeUnusedTags(doc map[string]interface{}, paths map[string]interface{}) {
+	used := map[string]bool{}
+	for _, item := range paths {
+		operations, _ := item.(map[string]interface{})
+		for _, op := range operations {
+			operation, _ := op.(map[string]interface{})
+			tags, _ := operation["tags"].([]interface{})
+			for _, tag := range tags {
+				if name, ok := tag.(string); ok {
+					used[name] = true
+				}
+			}
+		}
+	}
+
+	tags, _ := doc["tags"].([]interface{})
+	kept := make([]interface{}, 0, len(tags))
+	for _, tag := range tags {
+		t, _ := tag.(map[string]interface{})
+		if name, _ := t["name"].(string); used[name] {
+			kept = append(kept, tag)
+		}
+	}
+	doc["tags"] = kept
+}
+
+// addOpenAPIDocument serves the spec adjusted to the running server
+func addOpenAPIDocument(doc []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/openapi.json" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(doc)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeOpenAPI(t *testing.T) {
+	parse := func(t *testing.T, c capabilities) map[string]interface{} {
+		doc, err := runtimeOpenAPI(SwaggerJSON, c)
+		require.Nil(t, err)
+		_, err = loads.Analyzed(doc, "")
+		require.Nil(t, err, "the adjusted document is a valid spec")
+
+		var parsed map[string]interface{}
+		require.Nil(t, json.Unmarshal(doc, &parsed))
+		return parsed
+	}
+	hasPath := func(doc map[string]interface{}, path string) bool {
+		_, ok := doc["paths"].(map[string]interface{})[path]
+		return ok
+	}
+	tagNames := func(doc map[string]interface{}) []string {
+		var names []string
+		for _, tag := range doc["tags"].([]interface{}) {
+			names = append(names, tag.(map[string]interface{})["name"].(string))
+		}
+		return names
+	}
+
+	t.Run("everything enabled", func(t *testing.T) {
+		doc := parse(t, capabilities{
+			anonymousAccess: true, oidc: true, apiKey: true, rbac: true, graphQL: true, backups: true,
+			modules: []string{"backup-filesystem", "text2vec-contextionary"},
+		})
+		for _, path := range []string{"/graphql", "/backups/{backend}", "/authz/roles", "/keys/{id}/rotate", "/objects"} {
+			assert.True(t, hasPath(doc, path), path)
+		}
+		assert.Contains(t, tagNames(doc), "graphql")
+		assert.Len(t, doc["securityDefinitions"], 2)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{},
+			map[string]interface{}{"oidc": []interface{}{}},
+			map[string]interface{}{"apiKey": []interface{}{}},
+		}, doc["security"])
+		assert.Equal(t, []interface{}{"backup-filesystem", "text2vec-contextionary"},
+			doc["info"].(map[string]interface{})["x-weaviate-modules"])
+	})
+
+	t.Run("nothing enabled", func(t *testing.T) {
+		doc := parse(t, capabilities{})
+		for _, path := range []string{"/graphql", "/graphql/batch", "/backups/{backend}", "/authz/roles", "/keys/{id}/rotate"} {
+			assert.False(t, hasPath(doc, path), path)
+		}
+		assert.True(t, hasPath(doc, "/objects"))
+		assert.True(t, hasPath(doc, "/schema"))
+		assert.NotContains(t, tagNames(doc), "graphql")
+		assert.Contains(t, tagNames(doc), "objects")
+		assert.Empty(t, doc["securityDefinitions"])
+		assert.Empty(t, doc["security"])
+		assert.Equal(t, []interface{}{}, doc["info"].(map[string]interface{})["x-weaviate-modules"])
+	})
+
+	t.Run("served at /openapi.json", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+		handler := addOpenAPIDocument([]byte(`{"swagger":"2.0"}`), next)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Equal(t, `{"swagger":"2.0"}`, w.Body.String())
+
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/objects", nil))
+		assert.Equal(t, http.StatusTeapot, w.Code)
+	})
+}
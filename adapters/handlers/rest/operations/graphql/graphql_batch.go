@@ -47,7 +47,7 @@ func NewGraphqlBatch(ctx *middleware.Context, handler GraphqlBatchHandler) *Grap
 
 Get a response based on GraphQL.
 
-Perform a batched GraphQL query
+Perform a batched GraphQL query. <br/><br/>With an Accept header of application/x-ndjson the responses are streamed as newline-delimited JSON instead, one GraphQLResponse per query with an additional index field holding the position of the query in the batch. Each response is written as soon as its query finishes, so the responses arrive out of order.
 */
 type GraphqlBatch struct {
 	Context *middleware.Context
@@ -33,6 +33,10 @@ SchemaDumpOK Successfully dumped the database schema.
 swagger:response schemaDumpOK
 */
 type SchemaDumpOK struct {
+	/*Hash of the current schema. Pass it as If-Match to schema changes to only apply them if the schema is unchanged.
+
+	 */
+	XWeaviateSchemaHash string `json:"X-Weaviate-Schema-Hash"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewSchemaDumpOK() *SchemaDumpOK {
 	return &SchemaDumpOK{}
 }
 
+// WithXWeaviateSchemaHash adds the xWeaviateSchemaHash to the schema dump o k response
+func (o *SchemaDumpOK) WithXWeaviateSchemaHash(xWeaviateSchemaHash string) *SchemaDumpOK {
+	o.XWeaviateSchemaHash = xWeaviateSchemaHash
+	return o
+}
+
+// SetXWeaviateSchemaHash sets the xWeaviateSchemaHash to the schema dump o k response
+func (o *SchemaDumpOK) SetXWeaviateSchemaHash(xWeaviateSchemaHash string) {
+	o.XWeaviateSchemaHash = xWeaviateSchemaHash
+}
+
 // WithPayload adds the payload to the schema dump o k response
 func (o *SchemaDumpOK) WithPayload(payload *models.Schema) *SchemaDumpOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *SchemaDumpOK) SetPayload(payload *models.Schema) {
 // WriteResponse to the client
 func (o *SchemaDumpOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Weaviate-Schema-Hash
+
+	xWeaviateSchemaHash := o.XWeaviateSchemaHash
+	if xWeaviateSchemaHash != "" {
+		rw.Header().Set("X-Weaviate-Schema-Hash", xWeaviateSchemaHash)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
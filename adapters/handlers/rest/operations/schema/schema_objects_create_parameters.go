@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -45,6 +46,10 @@ type SchemaObjectsCreateParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: body
@@ -61,6 +66,10 @@ func (o *SchemaObjectsCreateParams) BindRequest(r *http.Request, route *middlewa
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Class
@@ -93,3 +102,20 @@ func (o *SchemaObjectsCreateParams) BindRequest(r *http.Request, route *middlewa
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *SchemaObjectsCreateParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
@@ -139,6 +139,51 @@ func (o *SchemaObjectsCreateForbidden) WriteResponse(rw http.ResponseWriter, pro
 	}
 }
 
+// SchemaObjectsCreateConflictCode is the HTTP code returned for type SchemaObjectsCreateConflict
+const SchemaObjectsCreateConflictCode int = 409
+
+/*
+SchemaObjectsCreateConflict A class with the same name already exists, or the schema changed since the given schema hash.
+
+swagger:response schemaObjectsCreateConflict
+*/
+type SchemaObjectsCreateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsCreateConflict creates SchemaObjectsCreateConflict with default headers values
+func NewSchemaObjectsCreateConflict() *SchemaObjectsCreateConflict {
+
+	return &SchemaObjectsCreateConflict{}
+}
+
+// WithPayload adds the payload to the schema objects create conflict response
+func (o *SchemaObjectsCreateConflict) WithPayload(payload *models.ErrorResponse) *SchemaObjectsCreateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects create conflict response
+func (o *SchemaObjectsCreateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsCreateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SchemaObjectsCreateUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsCreateUnprocessableEntity
 const SchemaObjectsCreateUnprocessableEntityCode int = 422
 
@@ -46,6 +46,10 @@ type SchemaObjectsDeleteParams struct {
 	  In: path
 	*/
 	ClassName string
+	/*Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	  In: header
+	*/
+	IfMatch *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -61,6 +65,9 @@ func (o *SchemaObjectsDeleteParams) BindRequest(r *http.Request, route *middlewa
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
 	}
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -80,3 +87,20 @@ func (o *SchemaObjectsDeleteParams) bindClassName(rawData []string, hasKey bool,
 
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *SchemaObjectsDeleteParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
@@ -164,6 +164,51 @@ func (o *SchemaObjectsDeleteForbidden) WriteResponse(rw http.ResponseWriter, pro
 	}
 }
 
+// SchemaObjectsDeleteConflictCode is the HTTP code returned for type SchemaObjectsDeleteConflict
+const SchemaObjectsDeleteConflictCode int = 409
+
+/*
+SchemaObjectsDeleteConflict The schema changed since the given schema hash.
+
+swagger:response schemaObjectsDeleteConflict
+*/
+type SchemaObjectsDeleteConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsDeleteConflict creates SchemaObjectsDeleteConflict with default headers values
+func NewSchemaObjectsDeleteConflict() *SchemaObjectsDeleteConflict {
+
+	return &SchemaObjectsDeleteConflict{}
+}
+
+// WithPayload adds the payload to the schema objects delete conflict response
+func (o *SchemaObjectsDeleteConflict) WithPayload(payload *models.ErrorResponse) *SchemaObjectsDeleteConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects delete conflict response
+func (o *SchemaObjectsDeleteConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsDeleteConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SchemaObjectsDeleteInternalServerErrorCode is the HTTP code returned for type SchemaObjectsDeleteInternalServerError
 const SchemaObjectsDeleteInternalServerErrorCode int = 500
 
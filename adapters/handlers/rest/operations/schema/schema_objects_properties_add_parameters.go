@@ -56,6 +56,10 @@ type SchemaObjectsPropertiesAddParams struct {
 	  In: path
 	*/
 	ClassName string
+	/*Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	  In: header
+	*/
+	IfMatch *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -99,6 +103,9 @@ func (o *SchemaObjectsPropertiesAddParams) BindRequest(r *http.Request, route *m
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
 	}
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -118,3 +125,20 @@ func (o *SchemaObjectsPropertiesAddParams) bindClassName(rawData []string, hasKe
 
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *SchemaObjectsPropertiesAddParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
@@ -139,6 +139,51 @@ func (o *SchemaObjectsPropertiesAddForbidden) WriteResponse(rw http.ResponseWrit
 	}
 }
 
+// SchemaObjectsPropertiesAddConflictCode is the HTTP code returned for type SchemaObjectsPropertiesAddConflict
+const SchemaObjectsPropertiesAddConflictCode int = 409
+
+/*
+SchemaObjectsPropertiesAddConflict A property with the same name already exists, or the schema changed since the given schema hash.
+
+swagger:response schemaObjectsPropertiesAddConflict
+*/
+type SchemaObjectsPropertiesAddConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesAddConflict creates SchemaObjectsPropertiesAddConflict with default headers values
+func NewSchemaObjectsPropertiesAddConflict() *SchemaObjectsPropertiesAddConflict {
+
+	return &SchemaObjectsPropertiesAddConflict{}
+}
+
+// WithPayload adds the payload to the schema objects properties add conflict response
+func (o *SchemaObjectsPropertiesAddConflict) WithPayload(payload *models.ErrorResponse) *SchemaObjectsPropertiesAddConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties add conflict response
+func (o *SchemaObjectsPropertiesAddConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesAddConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SchemaObjectsPropertiesAddUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsPropertiesAddUnprocessableEntity
 const SchemaObjectsPropertiesAddUnprocessableEntityCode int = 422
 
@@ -51,6 +51,10 @@ type SchemaObjectsUpdateParams struct {
 	  In: path
 	*/
 	ClassName string
+	/*Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: body
@@ -72,6 +76,10 @@ func (o *SchemaObjectsUpdateParams) BindRequest(r *http.Request, route *middlewa
 		res = append(res, err)
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Class
@@ -118,3 +126,20 @@ func (o *SchemaObjectsUpdateParams) bindClassName(rawData []string, hasKey bool,
 
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *SchemaObjectsUpdateParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
@@ -184,6 +184,51 @@ func (o *SchemaObjectsUpdateNotFound) WriteResponse(rw http.ResponseWriter, prod
 	}
 }
 
+// SchemaObjectsUpdateConflictCode is the HTTP code returned for type SchemaObjectsUpdateConflict
+const SchemaObjectsUpdateConflictCode int = 409
+
+/*
+SchemaObjectsUpdateConflict The schema changed since the given schema hash.
+
+swagger:response schemaObjectsUpdateConflict
+*/
+type SchemaObjectsUpdateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsUpdateConflict creates SchemaObjectsUpdateConflict with default headers values
+func NewSchemaObjectsUpdateConflict() *SchemaObjectsUpdateConflict {
+
+	return &SchemaObjectsUpdateConflict{}
+}
+
+// WithPayload adds the payload to the schema objects update conflict response
+func (o *SchemaObjectsUpdateConflict) WithPayload(payload *models.ErrorResponse) *SchemaObjectsUpdateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects update conflict response
+func (o *SchemaObjectsUpdateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsUpdateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SchemaObjectsUpdateUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsUpdateUnprocessableEntity
 const SchemaObjectsUpdateUnprocessableEntityCode int = 422
 
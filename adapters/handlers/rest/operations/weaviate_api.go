@@ -95,12 +95,24 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
+		BatchBatchJobsCreateHandler: batch.BatchJobsCreateHandlerFunc(func(params batch.BatchJobsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchJobsCreate has not yet been implemented")
+		}),
+		BatchBatchJobsGetHandler: batch.BatchJobsGetHandlerFunc(func(params batch.BatchJobsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchJobsGet has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
 		BatchBatchObjectsDeleteHandler: batch.BatchObjectsDeleteHandlerFunc(func(params batch.BatchObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsDelete has not yet been implemented")
 		}),
+		BatchBatchObjectsStreamHandler: batch.BatchObjectsStreamHandlerFunc(func(params batch.BatchObjectsStreamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsStream has not yet been implemented")
+		}),
+		BatchBatchObjectsUpdateHandler: batch.BatchObjectsUpdateHandlerFunc(func(params batch.BatchObjectsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsUpdate has not yet been implemented")
+		}),
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
@@ -143,12 +155,18 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
+		MetaModulesListHandler: meta.ModulesListHandlerFunc(func(params meta.ModulesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation meta.ModulesList has not yet been implemented")
+		}),
 		NodesNodesGetHandler: nodes.NodesGetHandlerFunc(func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGet has not yet been implemented")
 		}),
 		NodesNodesGetClassHandler: nodes.NodesGetClassHandlerFunc(func(params nodes.NodesGetClassParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGetClass has not yet been implemented")
 		}),
+		ObjectsObjectsClassBlobGetHandler: objects.ObjectsClassBlobGetHandlerFunc(func(params objects.ObjectsClassBlobGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassBlobGet has not yet been implemented")
+		}),
 		ObjectsObjectsClassDeleteHandler: objects.ObjectsClassDeleteHandlerFunc(func(params objects.ObjectsClassDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassDelete has not yet been implemented")
 		}),
@@ -158,6 +176,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsClassHeadHandler: objects.ObjectsClassHeadHandlerFunc(func(params objects.ObjectsClassHeadParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassHead has not yet been implemented")
 		}),
+		ObjectsObjectsClassHistoryHandler: objects.ObjectsClassHistoryHandlerFunc(func(params objects.ObjectsClassHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassHistory has not yet been implemented")
+		}),
+		ObjectsObjectsClassLookupHandler: objects.ObjectsClassLookupHandlerFunc(func(params objects.ObjectsClassLookupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassLookup has not yet been implemented")
+		}),
 		ObjectsObjectsClassPatchHandler: objects.ObjectsClassPatchHandlerFunc(func(params objects.ObjectsClassPatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassPatch has not yet been implemented")
 		}),
@@ -173,9 +197,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsClassReferencesPutHandler: objects.ObjectsClassReferencesPutHandlerFunc(func(params objects.ObjectsClassReferencesPutParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassReferencesPut has not yet been implemented")
 		}),
+		ObjectsObjectsClassRestoreHandler: objects.ObjectsClassRestoreHandlerFunc(func(params objects.ObjectsClassRestoreParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassRestore has not yet been implemented")
+		}),
 		ObjectsObjectsCreateHandler: objects.ObjectsCreateHandlerFunc(func(params objects.ObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsCreate has not yet been implemented")
 		}),
+		ObjectsObjectsCreateMultipartHandler: objects.ObjectsCreateMultipartHandlerFunc(func(params objects.ObjectsCreateMultipartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsCreateMultipart has not yet been implemented")
+		}),
 		ObjectsObjectsDeleteHandler: objects.ObjectsDeleteHandlerFunc(func(params objects.ObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsDelete has not yet been implemented")
 		}),
@@ -200,6 +230,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsReferencesUpdateHandler: objects.ObjectsReferencesUpdateHandlerFunc(func(params objects.ObjectsReferencesUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsReferencesUpdate has not yet been implemented")
 		}),
+		ObjectsObjectsTrashListHandler: objects.ObjectsTrashListHandlerFunc(func(params objects.ObjectsTrashListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsTrashList has not yet been implemented")
+		}),
 		ObjectsObjectsUpdateHandler: objects.ObjectsUpdateHandlerFunc(func(params objects.ObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsUpdate has not yet been implemented")
 		}),
@@ -209,12 +242,21 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		AuthzRemovePermissionsHandler: authz.RemovePermissionsHandlerFunc(func(params authz.RemovePermissionsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.RemovePermissions has not yet been implemented")
 		}),
+		AuthzRevokeKeyHandler: authz.RevokeKeyHandlerFunc(func(params authz.RevokeKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.RevokeKey has not yet been implemented")
+		}),
 		AuthzRevokeRoleHandler: authz.RevokeRoleHandlerFunc(func(params authz.RevokeRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.RevokeRole has not yet been implemented")
 		}),
+		AuthzRotateKeyHandler: authz.RotateKeyHandlerFunc(func(params authz.RotateKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.RotateKey has not yet been implemented")
+		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
+		SchemaSchemaImportHandler: schema.SchemaImportHandlerFunc(func(params schema.SchemaImportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaImport has not yet been implemented")
+		}),
 		SchemaSchemaObjectsCreateHandler: schema.SchemaObjectsCreateHandlerFunc(func(params schema.SchemaObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsCreate has not yet been implemented")
 		}),
@@ -227,6 +269,18 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesLifecycleUpdateHandler: schema.SchemaObjectsPropertiesLifecycleUpdateHandlerFunc(func(params schema.SchemaObjectsPropertiesLifecycleUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesLifecycleUpdate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPropertiesPurgeHandler: schema.SchemaObjectsPropertiesPurgeHandlerFunc(func(params schema.SchemaObjectsPropertiesPurgeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesPurge has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPropertiesRenameHandler: schema.SchemaObjectsPropertiesRenameHandlerFunc(func(params schema.SchemaObjectsPropertiesRenameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesRename has not yet been implemented")
+		}),
+		SchemaSchemaObjectsRenameHandler: schema.SchemaObjectsRenameHandlerFunc(func(params schema.SchemaObjectsRenameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRename has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
@@ -236,6 +290,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaValidateHandler: schema.SchemaValidateHandlerFunc(func(params schema.SchemaValidateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaValidate has not yet been implemented")
+		}),
 		SchemaTenantExistsHandler: schema.TenantExistsHandlerFunc(func(params schema.TenantExistsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantExists has not yet been implemented")
 		}),
@@ -348,10 +405,18 @@ type WeaviateAPI struct {
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
+	// BatchBatchJobsCreateHandler sets the operation handler for the batch jobs create operation
+	BatchBatchJobsCreateHandler batch.BatchJobsCreateHandler
+	// BatchBatchJobsGetHandler sets the operation handler for the batch jobs get operation
+	BatchBatchJobsGetHandler batch.BatchJobsGetHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
+	// BatchBatchObjectsStreamHandler sets the operation handler for the batch objects stream operation
+	BatchBatchObjectsStreamHandler batch.BatchObjectsStreamHandler
+	// BatchBatchObjectsUpdateHandler sets the operation handler for the batch objects update operation
+	BatchBatchObjectsUpdateHandler batch.BatchObjectsUpdateHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
@@ -380,16 +445,24 @@ type WeaviateAPI struct {
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
+	// MetaModulesListHandler sets the operation handler for the modules list operation
+	MetaModulesListHandler meta.ModulesListHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
 	NodesNodesGetClassHandler nodes.NodesGetClassHandler
+	// ObjectsObjectsClassBlobGetHandler sets the operation handler for the objects class blob get operation
+	ObjectsObjectsClassBlobGetHandler objects.ObjectsClassBlobGetHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
 	ObjectsObjectsClassDeleteHandler objects.ObjectsClassDeleteHandler
 	// ObjectsObjectsClassGetHandler sets the operation handler for the objects class get operation
 	ObjectsObjectsClassGetHandler objects.ObjectsClassGetHandler
 	// ObjectsObjectsClassHeadHandler sets the operation handler for the objects class head operation
 	ObjectsObjectsClassHeadHandler objects.ObjectsClassHeadHandler
+	// ObjectsObjectsClassHistoryHandler sets the operation handler for the objects class history operation
+	ObjectsObjectsClassHistoryHandler objects.ObjectsClassHistoryHandler
+	// ObjectsObjectsClassLookupHandler sets the operation handler for the objects class lookup operation
+	ObjectsObjectsClassLookupHandler objects.ObjectsClassLookupHandler
 	// ObjectsObjectsClassPatchHandler sets the operation handler for the objects class patch operation
 	ObjectsObjectsClassPatchHandler objects.ObjectsClassPatchHandler
 	// ObjectsObjectsClassPutHandler sets the operation handler for the objects class put operation
@@ -400,8 +473,12 @@ type WeaviateAPI struct {
 	ObjectsObjectsClassReferencesDeleteHandler objects.ObjectsClassReferencesDeleteHandler
 	// ObjectsObjectsClassReferencesPutHandler sets the operation handler for the objects class references put operation
 	ObjectsObjectsClassReferencesPutHandler objects.ObjectsClassReferencesPutHandler
+	// ObjectsObjectsClassRestoreHandler sets the operation handler for the objects class restore operation
+	ObjectsObjectsClassRestoreHandler objects.ObjectsClassRestoreHandler
 	// ObjectsObjectsCreateHandler sets the operation handler for the objects create operation
 	ObjectsObjectsCreateHandler objects.ObjectsCreateHandler
+	// ObjectsObjectsCreateMultipartHandler sets the operation handler for the objects create multipart operation
+	ObjectsObjectsCreateMultipartHandler objects.ObjectsCreateMultipartHandler
 	// ObjectsObjectsDeleteHandler sets the operation handler for the objects delete operation
 	ObjectsObjectsDeleteHandler objects.ObjectsDeleteHandler
 	// ObjectsObjectsGetHandler sets the operation handler for the objects get operation
@@ -418,16 +495,24 @@ type WeaviateAPI struct {
 	ObjectsObjectsReferencesDeleteHandler objects.ObjectsReferencesDeleteHandler
 	// ObjectsObjectsReferencesUpdateHandler sets the operation handler for the objects references update operation
 	ObjectsObjectsReferencesUpdateHandler objects.ObjectsReferencesUpdateHandler
+	// ObjectsObjectsTrashListHandler sets the operation handler for the objects trash list operation
+	ObjectsObjectsTrashListHandler objects.ObjectsTrashListHandler
 	// ObjectsObjectsUpdateHandler sets the operation handler for the objects update operation
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
 	// AuthzRemovePermissionsHandler sets the operation handler for the remove permissions operation
 	AuthzRemovePermissionsHandler authz.RemovePermissionsHandler
+	// AuthzRevokeKeyHandler sets the operation handler for the revoke key operation
+	AuthzRevokeKeyHandler authz.RevokeKeyHandler
 	// AuthzRevokeRoleHandler sets the operation handler for the revoke role operation
 	AuthzRevokeRoleHandler authz.RevokeRoleHandler
+	// AuthzRotateKeyHandler sets the operation handler for the rotate key operation
+	AuthzRotateKeyHandler authz.RotateKeyHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
+	// SchemaSchemaImportHandler sets the operation handler for the schema import operation
+	SchemaSchemaImportHandler schema.SchemaImportHandler
 	// SchemaSchemaObjectsCreateHandler sets the operation handler for the schema objects create operation
 	SchemaSchemaObjectsCreateHandler schema.SchemaObjectsCreateHandler
 	// SchemaSchemaObjectsDeleteHandler sets the operation handler for the schema objects delete operation
@@ -436,12 +521,22 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesLifecycleUpdateHandler sets the operation handler for the schema objects properties lifecycle update operation
+	SchemaSchemaObjectsPropertiesLifecycleUpdateHandler schema.SchemaObjectsPropertiesLifecycleUpdateHandler
+	// SchemaSchemaObjectsPropertiesPurgeHandler sets the operation handler for the schema objects properties purge operation
+	SchemaSchemaObjectsPropertiesPurgeHandler schema.SchemaObjectsPropertiesPurgeHandler
+	// SchemaSchemaObjectsPropertiesRenameHandler sets the operation handler for the schema objects properties rename operation
+	SchemaSchemaObjectsPropertiesRenameHandler schema.SchemaObjectsPropertiesRenameHandler
+	// SchemaSchemaObjectsRenameHandler sets the operation handler for the schema objects rename operation
+	SchemaSchemaObjectsRenameHandler schema.SchemaObjectsRenameHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaValidateHandler sets the operation handler for the schema validate operation
+	SchemaSchemaValidateHandler schema.SchemaValidateHandler
 	// SchemaTenantExistsHandler sets the operation handler for the tenant exists operation
 	SchemaTenantExistsHandler schema.TenantExistsHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
@@ -571,12 +666,24 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
+	if o.BatchBatchJobsCreateHandler == nil {
+		unregistered = append(unregistered, "batch.BatchJobsCreateHandler")
+	}
+	if o.BatchBatchJobsGetHandler == nil {
+		unregistered = append(unregistered, "batch.BatchJobsGetHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
 	if o.BatchBatchObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsDeleteHandler")
 	}
+	if o.BatchBatchObjectsStreamHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsStreamHandler")
+	}
+	if o.BatchBatchObjectsUpdateHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsUpdateHandler")
+	}
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
@@ -619,12 +726,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
+	if o.MetaModulesListHandler == nil {
+		unregistered = append(unregistered, "meta.ModulesListHandler")
+	}
 	if o.NodesNodesGetHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetHandler")
 	}
 	if o.NodesNodesGetClassHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetClassHandler")
 	}
+	if o.ObjectsObjectsClassBlobGetHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassBlobGetHandler")
+	}
 	if o.ObjectsObjectsClassDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassDeleteHandler")
 	}
@@ -634,6 +747,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsClassHeadHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassHeadHandler")
 	}
+	if o.ObjectsObjectsClassHistoryHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassHistoryHandler")
+	}
+	if o.ObjectsObjectsClassLookupHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassLookupHandler")
+	}
 	if o.ObjectsObjectsClassPatchHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassPatchHandler")
 	}
@@ -649,9 +768,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsClassReferencesPutHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassReferencesPutHandler")
 	}
+	if o.ObjectsObjectsClassRestoreHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassRestoreHandler")
+	}
 	if o.ObjectsObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsCreateHandler")
 	}
+	if o.ObjectsObjectsCreateMultipartHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsCreateMultipartHandler")
+	}
 	if o.ObjectsObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsDeleteHandler")
 	}
@@ -676,6 +801,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsReferencesUpdateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsReferencesUpdateHandler")
 	}
+	if o.ObjectsObjectsTrashListHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsTrashListHandler")
+	}
 	if o.ObjectsObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsUpdateHandler")
 	}
@@ -685,12 +813,21 @@ func (o *WeaviateAPI) Validate() error {
 	if o.AuthzRemovePermissionsHandler == nil {
 		unregistered = append(unregistered, "authz.RemovePermissionsHandler")
 	}
+	if o.AuthzRevokeKeyHandler == nil {
+		unregistered = append(unregistered, "authz.RevokeKeyHandler")
+	}
 	if o.AuthzRevokeRoleHandler == nil {
 		unregistered = append(unregistered, "authz.RevokeRoleHandler")
 	}
+	if o.AuthzRotateKeyHandler == nil {
+		unregistered = append(unregistered, "authz.RotateKeyHandler")
+	}
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
+	if o.SchemaSchemaImportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaImportHandler")
+	}
 	if o.SchemaSchemaObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsCreateHandler")
 	}
@@ -703,6 +840,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesLifecycleUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesLifecycleUpdateHandler")
+	}
+	if o.SchemaSchemaObjectsPropertiesPurgeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesPurgeHandler")
+	}
+	if o.SchemaSchemaObjectsPropertiesRenameHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesRenameHandler")
+	}
+	if o.SchemaSchemaObjectsRenameHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRenameHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
@@ -712,6 +861,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaValidateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaValidateHandler")
+	}
 	if o.SchemaTenantExistsHandler == nil {
 		unregistered = append(unregistered, "schema.TenantExistsHandler")
 	}
@@ -878,6 +1030,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/batch/jobs"] = batch.NewBatchJobsCreate(o.context, o.BatchBatchJobsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/batch/jobs/{id}"] = batch.NewBatchJobsGet(o.context, o.BatchBatchJobsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batch/objects"] = batch.NewBatchObjectsCreate(o.context, o.BatchBatchObjectsCreateHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -886,6 +1046,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/batch/objects/stream"] = batch.NewBatchObjectsStream(o.context, o.BatchBatchObjectsStreamHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/batch/objects"] = batch.NewBatchObjectsUpdate(o.context, o.BatchBatchObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batch/references"] = batch.NewBatchReferencesCreate(o.context, o.BatchBatchReferencesCreateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -942,11 +1110,19 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/modules"] = meta.NewModulesList(o.context, o.MetaModulesListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/nodes"] = nodes.NewNodesGet(o.context, o.NodesNodesGetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/nodes/{className}"] = nodes.NewNodesGetClass(o.context, o.NodesNodesGetClassHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/{className}/{id}/blobs/{propertyName}"] = objects.NewObjectsClassBlobGet(o.context, o.ObjectsObjectsClassBlobGetHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -967,6 +1143,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/objects/{className}/{id}"] = objects.NewObjectsClassPut(o.context, o.ObjectsObjectsClassPutHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/{className}/{id}/history"] = objects.NewObjectsClassHistory(o.context, o.ObjectsObjectsClassHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/by/{className}/{propertyName}/{value}"] = objects.NewObjectsClassLookup(o.context, o.ObjectsObjectsClassLookupHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -982,7 +1166,15 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/objects/{className}/{id}/restore"] = objects.NewObjectsClassRestore(o.context, o.ObjectsObjectsClassRestoreHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/objects"] = objects.NewObjectsCreate(o.context, o.ObjectsObjectsCreateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/objects/multipart"] = objects.NewObjectsCreateMultipart(o.context, o.ObjectsObjectsCreateMultipartHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1015,6 +1207,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/objects/{id}/references/{propertyName}"] = objects.NewObjectsReferencesUpdate(o.context, o.ObjectsObjectsReferencesUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/.deleted"] = objects.NewObjectsTrashList(o.context, o.ObjectsObjectsTrashListHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1030,7 +1226,15 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/keys/{id}/revoke"] = authz.NewRevokeKey(o.context, o.AuthzRevokeKeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/authz/users/{id}/revoke"] = authz.NewRevokeRole(o.context, o.AuthzRevokeRoleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/keys/{id}/rotate"] = authz.NewRotateKey(o.context, o.AuthzRotateKeyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1038,6 +1242,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/schema/import"] = schema.NewSchemaImport(o.context, o.SchemaSchemaImportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/schema"] = schema.NewSchemaObjectsCreate(o.context, o.SchemaSchemaObjectsCreateHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1051,6 +1259,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/properties/{propertyName}/lifecycle"] = schema.NewSchemaObjectsPropertiesLifecycleUpdate(o.context, o.SchemaSchemaObjectsPropertiesLifecycleUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/properties/{propertyName}/purge"] = schema.NewSchemaObjectsPropertiesPurge(o.context, o.SchemaSchemaObjectsPropertiesPurgeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/properties/{propertyName}/rename"] = schema.NewSchemaObjectsPropertiesRename(o.context, o.SchemaSchemaObjectsPropertiesRenameHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/rename"] = schema.NewSchemaObjectsRename(o.context, o.SchemaSchemaObjectsRenameHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1063,6 +1287,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/validate"] = schema.NewSchemaValidate(o.context, o.SchemaSchemaValidateHandler)
 	if o.handlers["HEAD"] == nil {
 		o.handlers["HEAD"] = make(map[string]http.Handler)
 	}
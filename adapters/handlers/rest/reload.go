@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-openapi/swag"
+	"github.com/rs/cors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/networkacl"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+// runtimeMiddlewares hold the state of the middlewares whose settings can be
+// changed by reloading the config. Requests always use the latest state, so
+// applying new settings doesn't affect open connections.
+type runtimeMiddlewares struct {
+	cors       atomic.Pointer[corsState]
+	rateLimit  atomic.Pointer[rateLimitState]
+	networkACL atomic.Pointer[networkacl.ACL]
+}
+
+type corsState struct {
+	cfg  config.CORS
+	cors *cors.Cors
+}
+
+type rateLimitState struct {
+	cfg     config.RateLimit
+	limiter *ratelimiter.Keyed
+}
+
+func newRuntimeMiddlewares(settings config.RuntimeSettings) *runtimeMiddlewares {
+	m := &runtimeMiddlewares{}
+	m.apply(settings)
+	return m
+}
+
+func (m *runtimeMiddlewares) apply(settings config.RuntimeSettings) {
+	if current := m.cors.Load(); current == nil || current.cfg != settings.CORS {
+		m.cors.Store(&corsState{
+			cfg: settings.CORS,
+			cors: cors.New(cors.Options{
+				OptionsPassthrough: true,
+				AllowedMethods:     strings.Split(settings.CORS.AllowMethods, ","),
+				AllowedHeaders:     strings.Split(settings.CORS.AllowHeaders, ","),
+				AllowedOrigins:     strings.Split(settings.CORS.AllowOrigin, ","),
+				// browser clients need to read it to invalidate their caches
+				ExposedHeaders: []string{SchemaHashHeader},
+			}),
+		})
+	}
+
+	// the limiter is only replaced if the limits changed, as replacing it
+	// resets the requests counted so far
+	if current := m.rateLimit.Load(); current == nil || current.cfg != settings.RateLimit {
+		state := &rateLimitState{cfg: settings.RateLimit}
+		if cfg := settings.RateLimit; cfg.Enabled() {
+			state.limiter = ratelimiter.NewKeyed(cfg.PerKey, cfg.PerKeyBurst, cfg.Global, cfg.GlobalBurst)
+		}
+		m.rateLimit.Store(state)
+	}
+
+	if current := m.networkACL.Load(); current == nil || current.Config() != settings.NetworkACL {
+		m.networkACL.Store(networkacl.New(settings.NetworkACL))
+	}
+}
+
+func (m *runtimeMiddlewares) handleCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.cors.Load().cors.ServeHTTP(w, r, next.ServeHTTP)
+	})
+}
+
+func (m *runtimeMiddlewares) corsConfig() config.CORS {
+	return m.cors.Load().cfg
+}
+
+func (m *runtimeMiddlewares) rateLimiter() *ratelimiter.Keyed {
+	return m.rateLimit.Load().limiter
+}
+
+func (m *runtimeMiddlewares) currentNetworkACL() *networkacl.ACL {
+	return m.networkACL.Load()
+}
+
+// startConfigReload applies the runtime settings of the reloaded config to
+// the server until ctx is done
+func startConfigReload(ctx context.Context, appState *state.State, middlewares *runtimeMiddlewares,
+	flags *swag.CommandLineOptionsGroup,
+) {
+	watcher := config.NewWatcher(flags, appState.ServerConfig.Config, appState.Logger)
+	watcher.OnChange(func(settings config.RuntimeSettings) {
+		appState.Logger.SetLevel(parseLogLevel(settings.LogLevel))
+		middlewares.apply(settings)
+		appState.Traverser.SetMaxConcurrentGetRequests(settings.MaximumConcurrentGetRequests)
+	})
+	watcher.Start(ctx)
+}
+
+// parseLogLevel falls back to the info level for unknown levels
+func parseLogLevel(level string) logrus.Level {
+	parsed, err := logrus.ParseLevel(level)
+	if err != nil {
+		return logrus.InfoLevel
+	}
+	return parsed
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestRuntimeMiddlewares(t *testing.T) {
+	settings := config.RuntimeSettings{
+		CORS: config.CORS{AllowOrigin: "https://a.example", AllowMethods: "GET", AllowHeaders: "Content-Type"},
+	}
+	m := newRuntimeMiddlewares(settings)
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	handler := makeAddRateLimiting(m.rateLimiter, nil)(addPreflight(m.handleCORS(ok), m.corsConfig))
+	request := func() *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+		r.Header.Set("Origin", "https://b.example")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := request()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://a.example", w.Header().Get("Access-Control-Allow-Origin"))
+
+	settings.CORS.AllowOrigin = "https://b.example"
+	settings.RateLimit = config.RateLimit{Global: 1}
+	m.apply(settings)
+
+	w = request()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://b.example", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, http.StatusTooManyRequests, request().Code)
+
+	// unchanged limits keep the limiter and the requests counted so far
+	limiter := m.rateLimiter()
+	settings.LogLevel = "debug"
+	m.apply(settings)
+	assert.Same(t, limiter, m.rateLimiter())
+
+	settings.RateLimit = config.RateLimit{}
+	m.apply(settings)
+	assert.Nil(t, m.rateLimiter())
+	assert.Equal(t, http.StatusOK, request().Code)
+}
+
+func TestParseLogLevel(t *testing.T) {
+	assert.Equal(t, logrus.DebugLevel, parseLogLevel("debug"))
+	assert.Equal(t, logrus.WarnLevel, parseLogLevel("warning"))
+	assert.Equal(t, logrus.InfoLevel, parseLogLevel(""))
+	assert.Equal(t, logrus.InfoLevel, parseLogLevel("unknown"))
+}
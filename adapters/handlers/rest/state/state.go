@@ -22,15 +22,18 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	rCluster "github.com/weaviate/weaviate/cluster"
+	esync "github.com/weaviate/weaviate/entities/sync"
 	"github.com/weaviate/weaviate/exp/metadata"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/locks"
+	"github.com/weaviate/weaviate/usecases/logging"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -50,11 +53,16 @@ type State struct {
 	AnonymousAccess       *anonymous.Client
 	APIKey                *apikey.Client
 	Authorizer            authorization.Authorizer
+	Roles                 *rbac.Manager
 	ServerConfig          *config.WeaviateConfig
 	Locks                 locks.ConnectorSchemaLock
 	Logger                *logrus.Logger
+	LogSinks              []logging.Sink
+	StopTracing           func(context.Context) error
 	gqlMutex              sync.Mutex
 	GraphQL               graphql.GraphQL
+	schemaHashMutex       sync.RWMutex
+	schemaHash            string
 	Modules               *modules.Provider
 	SchemaManager         *schema.Manager
 	Scaler                *scaler.Scaler
@@ -70,9 +78,11 @@ type State struct {
 	BackupManager      *backup.Handler
 	DB                 *db.DB
 	BatchManager       *objects.BatchManager
+	ObjectEvents       *objects.Events
 	ClusterHttpClient  *http.Client
 	ReindexCtxCancel   context.CancelFunc
 	MemWatch           *memwatch.Monitor
+	LockMonitor        *esync.LockMonitor
 
 	ClusterService *rCluster.Service
 	TenantActivity *tenantactivity.Handler
@@ -97,3 +107,17 @@ func (s *State) SetGraphQL(gql graphql.GraphQL) {
 	s.GraphQL = gql
 	s.gqlMutex.Unlock()
 }
+
+// GetSchemaHash returns the hash of the current schema, see
+// schema.Hash. It is empty until the schema was loaded.
+func (s *State) GetSchemaHash() string {
+	s.schemaHashMutex.RLock()
+	defer s.schemaHashMutex.RUnlock()
+	return s.schemaHash
+}
+
+func (s *State) SetSchemaHash(hash string) {
+	s.schemaHashMutex.Lock()
+	s.schemaHash = hash
+	s.schemaHashMutex.Unlock()
+}
@@ -26,12 +26,14 @@ type templateData struct {
 	APIToken string
 }
 
-func AddMiddleware(swaggerJSON []byte, next http.Handler) http.Handler {
+// AddMiddleware serves the swagger spec and, unless disableUI is set, the
+// swagger UI. Without the UI its path is passed on to the next handler.
+func AddMiddleware(swaggerJSON []byte, disableUI bool, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if strings.HasPrefix(r.URL.Path, "/v1/swagger.json") && r.Method == http.MethodGet {
 			w.Header().Set("Content-Type", "application/json")
 			w.Write(swaggerJSON)
-		} else if strings.HasPrefix(r.URL.Path, "/v1/swagger") && r.Method == http.MethodGet {
+		} else if strings.HasPrefix(r.URL.Path, "/v1/swagger") && r.Method == http.MethodGet && !disableUI {
 			renderSwagger(w, r)
 		} else {
 			next.ServeHTTP(w, r)
@@ -41,6 +43,10 @@ func AddMiddleware(swaggerJSON []byte, next http.Handler) http.Handler {
 
 // renderswagger renders the swagger GUI
 func renderSwagger(w http.ResponseWriter, r *http.Request) {
+	// the UI sends the credentials of the user, it must not be embedded by
+	// other sites
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
 	w.Header().Set("WWW-Authenticate", `Basic realm="Provide your key and token (as username as password respectively)"`)
 
 	user, password, authOk := r.BasicAuth()
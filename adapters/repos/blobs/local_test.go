@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package blobs
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewLocal(filepath.Join(dir, "blobs"))
+	require.Nil(t, err)
+
+	t.Run("put, get and delete", func(t *testing.T) {
+		key := "Image/tenant/id/picture/1"
+		require.Nil(t, store.Put(ctx, key, strings.NewReader("some image")))
+
+		r, err := store.Get(ctx, key)
+		require.Nil(t, err)
+		data, err := io.ReadAll(r)
+		require.Nil(t, err)
+		require.Nil(t, r.Close())
+		assert.Equal(t, "some image", string(data))
+
+		ok, err := store.Exists(ctx, key)
+		require.Nil(t, err)
+		assert.True(t, ok)
+
+		require.Nil(t, store.Delete(ctx, key))
+		ok, err = store.Exists(ctx, key)
+		require.Nil(t, err)
+		assert.False(t, ok)
+		_, err = store.Get(ctx, key)
+		assert.ErrorIs(t, err, objects.ErrBlobNotFound)
+		assert.Nil(t, store.Delete(ctx, key), "deleting a missing blob is a no-op")
+	})
+
+	t.Run("delete prefix", func(t *testing.T) {
+		require.Nil(t, store.Put(ctx, "Image/tenant/id/picture/1", strings.NewReader("a")))
+		require.Nil(t, store.Put(ctx, "Image/tenant/id/thumbnail/1", strings.NewReader("b")))
+		require.Nil(t, store.Put(ctx, "Image/tenant/other/picture/1", strings.NewReader("c")))
+
+		require.Nil(t, store.DeletePrefix(ctx, "Image/tenant/id/"))
+		for key, exists := range map[string]bool{
+			"Image/tenant/id/picture/1":    false,
+			"Image/tenant/id/thumbnail/1":  false,
+			"Image/tenant/other/picture/1": true,
+		} {
+			ok, err := store.Exists(ctx, key)
+			require.Nil(t, err)
+			assert.Equal(t, exists, ok, key)
+		}
+		assert.Nil(t, store.DeletePrefix(ctx, "Image/tenant/id/"), "deleting a missing prefix is a no-op")
+		require.Nil(t, store.Delete(ctx, "Image/tenant/other/picture/1"))
+	})
+
+	t.Run("keys outside of the directory are rejected", func(t *testing.T) {
+		for _, key := range []string{"", "../outside", "a/../../outside", "/abs", `a\..\b`} {
+			assert.NotNil(t, store.Put(ctx, key, strings.NewReader("x")), key)
+		}
+		_, err := os.Stat(filepath.Join(dir, "outside"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("cancelled uploads are not stored", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		key := "Image/tenant/id/picture/2"
+		assert.NotNil(t, store.Put(cancelled, key, strings.NewReader("some image")))
+		_, err := store.Get(ctx, key)
+		assert.ErrorIs(t, err, objects.ErrBlobNotFound)
+
+		entries, err := os.ReadDir(filepath.Join(dir, "blobs", "Image", "tenant", "id", "picture"))
+		require.Nil(t, err)
+		assert.Empty(t, entries, "temporary files are removed")
+	})
+}
@@ -14,6 +14,7 @@ package aggregator
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"sort"
 	"time"
 
@@ -42,11 +43,11 @@ func addDateAggregations(prop *aggregation.Property,
 		return
 	}
 
-	// when combining the results from different shards, we need the raw dates to recompute the mode and median.
+	// when combining the results from different shards, we need the raw dates to recompute the mode, median and mean.
 	// Therefore we add a reference later which needs to be cleared out before returning the results to a user
 	for _, aProp := range aggs {
 		switch aProp {
-		case aggregation.ModeAggregator, aggregation.MedianAggregator:
+		case aggregation.ModeAggregator, aggregation.MedianAggregator, aggregation.MeanAggregator:
 			prop.DateAggregations["_dateAggregator"] = agg
 		}
 	}
@@ -63,6 +64,8 @@ func addDateAggregations(prop *aggregation.Property,
 			prop.DateAggregations[aProp.String()] = agg.Count()
 		case aggregation.MedianAggregator:
 			prop.DateAggregations[aProp.String()] = agg.Median()
+		case aggregation.MeanAggregator:
+			prop.DateAggregations[aProp.String()] = agg.Mean()
 
 		default:
 			continue
@@ -171,6 +174,17 @@ func (a *dateAggregator) Count() int64 {
 	return int64(a.count)
 }
 
+// Mean is calculated with arbitrary precision, as the sum of the epoch
+// nanoseconds overflows an int64 quickly
+func (a *dateAggregator) Mean() string {
+	sum := new(big.Int)
+	for ts, count := range a.valueCounter {
+		sum.Add(sum, new(big.Int).Mul(big.NewInt(ts.epochNano), new(big.Int).SetUint64(count)))
+	}
+	mean := sum.Quo(sum, new(big.Int).SetUint64(a.count))
+	return time.Unix(0, mean.Int64()).UTC().Format(time.RFC3339Nano)
+}
+
 // Median does not require preparation if build from rows, but requires a call of
 // buildPairsFromCounts() if it was built using individual objects
 //
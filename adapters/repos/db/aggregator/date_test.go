@@ -29,24 +29,28 @@ func TestDateAggregator(t *testing.T) {
 		seconds        []string
 		expectedMedian string
 		expectedMode   string
+		expectedMean   string
 	}{
 		{
 			name:           "Single value",
 			seconds:        []string{"17"},
 			expectedMedian: "17",
 			expectedMode:   "17",
+			expectedMean:   "17.451235Z",
 		},
 		{
 			name:           "Even number of values",
 			seconds:        []string{"18", "18", "20", "25"},
 			expectedMedian: "19",
 			expectedMode:   "18",
+			expectedMean:   "20.701235Z",
 		},
 		{
 			name:           "Uneven number of values",
 			seconds:        []string{"18", "18", "19", "20", "25"},
 			expectedMedian: "19",
 			expectedMode:   "18",
+			expectedMean:   "20.451235Z",
 		},
 	}
 	names := []string{"AddTimestamp", "AddRow"}
@@ -69,6 +73,7 @@ func TestDateAggregator(t *testing.T) {
 				}
 				agg.buildPairsFromCounts() // needed to populate all required info
 				assert.Equal(t, DateYearMonthDayHourMinute+tt.expectedMedian+DateNanoSecondsTimeZone, agg.Median())
+				assert.Equal(t, DateYearMonthDayHourMinute+tt.expectedMean, agg.Mean())
 				if len(tt.expectedMode) > 0 { // if there is no value that appears more often than other values
 					assert.Equal(t, DateYearMonthDayHourMinute+tt.expectedMode+DateNanoSecondsTimeZone, agg.Mode())
 				}
@@ -76,3 +81,14 @@ func TestDateAggregator(t *testing.T) {
 		}
 	}
 }
+
+func TestDateAggregatorMeanOfDistantDates(t *testing.T) {
+	agg := newDateAggregator()
+	for _, date := range []string{"2200-01-01T00:00:00Z", "2200-01-01T00:00:10Z"} {
+		ts, err := time.Parse(time.RFC3339, date)
+		assert.Nil(t, err)
+		// the sum of the epoch nanoseconds doesn't fit into an int64
+		assert.Nil(t, agg.addRow(newTimestamp(ts.UnixNano()), 10))
+	}
+	assert.Equal(t, "2200-01-01T00:00:05Z", agg.Mean())
+}
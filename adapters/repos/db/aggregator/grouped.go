@@ -13,6 +13,8 @@ package aggregator
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/aggregation"
@@ -60,11 +62,19 @@ type group struct {
 	docIDs []uint64
 }
 
+// defaultGroupLimit is a reasonable default in case we get no limit
+const defaultGroupLimit = 100
+
 func (ga *groupedAggregator) identifyGroups(ctx context.Context) ([]group, error) {
-	limit := 100 // reasonable default in case we get none
+	limit := defaultGroupLimit
 	if ga.params.Limit != nil {
 		limit = *ga.params.Limit
 	}
+	// the offset is applied once the groups of all shards are combined, so each
+	// shard needs to provide the groups in front of the requested page as well
+	if ga.params.Offset != nil {
+		limit += *ga.params.Offset
+	}
 	return newGrouper(ga.Aggregator, limit).Do(ctx)
 }
 
@@ -81,3 +91,93 @@ func (ga *groupedAggregator) aggregateGroup(ctx context.Context,
 	out.Properties = props
 	return out, nil
 }
+
+// groupLess reports whether group a is ordered before group b. Without a sort
+// the largest groups come first.
+func groupLess(groupSort *aggregation.GroupSort) func(a, b aggregation.Group) bool {
+	if groupSort == nil {
+		return func(a, b aggregation.Group) bool {
+			return a.Count > b.Count
+		}
+	}
+
+	desc := groupSort.Order == "desc"
+	return func(a, b aggregation.Group) bool {
+		var cmp int
+		if groupSort.By == aggregation.GroupSortByCount {
+			cmp = a.Count - b.Count
+		} else {
+			cmp = compareGroupValues(a.GroupedBy, b.GroupedBy)
+		}
+		if desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	}
+}
+
+func compareGroupValues(a, b *aggregation.GroupedBy) int {
+	if a == nil || b == nil {
+		return 0
+	}
+
+	switch av := a.Value.(type) {
+	case float64:
+		if bv, ok := b.Value.(float64); ok {
+			switch {
+			case av < bv:
+				return -1
+			case av > bv:
+				return 1
+			default:
+				return 0
+			}
+		}
+	case bool:
+		if bv, ok := b.Value.(bool); ok {
+			switch {
+			case av == bv:
+				return 0
+			case !av:
+				return -1
+			default:
+				return 1
+			}
+		}
+	}
+
+	as, bs := fmt.Sprint(a.Value), fmt.Sprint(b.Value)
+	switch {
+	case as < bs:
+		return -1
+	case as > bs:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// PageGroups sorts the combined groups of all shards and returns the page
+// selected by the offset and limit of the params. Without a limit the page
+// holds at most defaultGroupLimit groups, no matter the offset.
+func PageGroups(groups []aggregation.Group, params aggregation.Params) []aggregation.Group {
+	less := groupLess(params.GroupSort)
+	sort.SliceStable(groups, func(i, j int) bool {
+		return less(groups[i], groups[j])
+	})
+
+	if params.Offset != nil {
+		if *params.Offset >= len(groups) {
+			return []aggregation.Group{}
+		}
+		groups = groups[*params.Offset:]
+	}
+	limit := defaultGroupLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < len(groups) {
+		groups = groups[:limit]
+	}
+	return groups
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+func TestPageGroups(t *testing.T) {
+	groups := func() []aggregation.Group {
+		return []aggregation.Group{
+			{Count: 2, GroupedBy: &aggregation.GroupedBy{Value: "b"}},
+			{Count: 7, GroupedBy: &aggregation.GroupedBy{Value: "d"}},
+			{Count: 1, GroupedBy: &aggregation.GroupedBy{Value: "a"}},
+			{Count: 5, GroupedBy: &aggregation.GroupedBy{Value: "c"}},
+		}
+	}
+	values := func(groups []aggregation.Group) []interface{} {
+		out := make([]interface{}, len(groups))
+		for i := range groups {
+			out[i] = groups[i].GroupedBy.Value
+		}
+		return out
+	}
+	ptInt := func(i int) *int { return &i }
+
+	tests := []struct {
+		name     string
+		params   aggregation.Params
+		expected []interface{}
+	}{
+		{
+			name:     "largest groups first by default",
+			params:   aggregation.Params{},
+			expected: []interface{}{"d", "c", "b", "a"},
+		},
+		{
+			name:     "limit and offset",
+			params:   aggregation.Params{Limit: ptInt(2), Offset: ptInt(1)},
+			expected: []interface{}{"c", "b"},
+		},
+		{
+			name:     "offset beyond the groups",
+			params:   aggregation.Params{Offset: ptInt(4)},
+			expected: []interface{}{},
+		},
+		{
+			name: "by count ascending",
+			params: aggregation.Params{
+				GroupSort: &aggregation.GroupSort{By: aggregation.GroupSortByCount, Order: "asc"},
+			},
+			expected: []interface{}{"a", "b", "c", "d"},
+		},
+		{
+			name: "by value descending",
+			params: aggregation.Params{
+				Limit:     ptInt(3),
+				GroupSort: &aggregation.GroupSort{By: aggregation.GroupSortByValue, Order: "desc"},
+			},
+			expected: []interface{}{"d", "c", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, values(PageGroups(groups(), test.params)))
+		})
+	}
+
+	t.Run("offset without limit", func(t *testing.T) {
+		many := make([]aggregation.Group, 2*defaultGroupLimit)
+		for i := range many {
+			many[i] = aggregation.Group{Count: len(many) - i, GroupedBy: &aggregation.GroupedBy{Value: i}}
+		}
+
+		page := PageGroups(many, aggregation.Params{Offset: ptInt(50)})
+		require.Len(t, page, defaultGroupLimit)
+		assert.Equal(t, 50, page[0].GroupedBy.Value)
+		assert.Equal(t, 50+defaultGroupLimit-1, page[len(page)-1].GroupedBy.Value)
+	})
+}
+
+func TestCompareGroupValues(t *testing.T) {
+	grouped := func(v interface{}) *aggregation.GroupedBy {
+		return &aggregation.GroupedBy{Value: v}
+	}
+
+	assert.Equal(t, -1, compareGroupValues(grouped(2.0), grouped(10.0)))
+	assert.Equal(t, 1, compareGroupValues(grouped("2"), grouped("10")))
+	assert.Equal(t, -1, compareGroupValues(grouped(false), grouped(true)))
+	assert.Equal(t, 0, compareGroupValues(grouped("a"), grouped("a")))
+}
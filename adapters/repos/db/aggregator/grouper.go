@@ -233,13 +233,15 @@ func (g *grouper) insertOrdered(elem group) {
 		return
 	}
 
+	less := groupLess(g.params.GroupSort)
 	added := false
 	for i, existing := range g.topGroups {
-		if existing.res.Count > elem.res.Count {
+		if less(existing.res, elem.res) {
 			continue
 		}
 
-		// we have found the first one that's smaller so we must insert before i
+		// we have found the first one that's not ordered before elem so we must
+		// insert before i
 		g.topGroups = append(
 			g.topGroups[:i], append(
 				[]group{elem},
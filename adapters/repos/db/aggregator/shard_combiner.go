@@ -135,7 +135,7 @@ func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
 		return
 	}
 
-	// add all values from the second map to the first one. This is needed to compute median and mode correctly
+	// add all values from the second map to the first one. This is needed to compute median, mean and mode correctly
 	for propType := range second {
 		switch propType {
 		case "_dateAggregator":
@@ -170,6 +170,9 @@ func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
 		case "median":
 			dateAggCombined := first["_dateAggregator"].(*dateAggregator)
 			first[propType] = dateAggCombined.Median()
+		case "mean":
+			dateAggCombined := first["_dateAggregator"].(*dateAggregator)
+			first[propType] = dateAggCombined.Mean()
 		case "minimum":
 			val, ok := first["minimum"]
 			if !ok {
@@ -32,6 +32,7 @@ type TestStructDates struct {
 	expectedMaximum string
 	expectedMode    string
 	expectedMinimum string
+	expectedMean    string
 }
 
 func TestShardCombinerMergeDates(t *testing.T) {
@@ -44,6 +45,7 @@ func TestShardCombinerMergeDates(t *testing.T) {
 			expectedMinimum: "10",
 			expectedMedian:  "26",
 			expectedMode:    "26",
+			expectedMean:    "29.451235Z",
 		},
 		{
 			name:            "Struct with single element",
@@ -53,6 +55,7 @@ func TestShardCombinerMergeDates(t *testing.T) {
 			expectedMinimum: "00",
 			expectedMedian:  "27",
 			expectedMode:    "45",
+			expectedMean:    "29.051235Z",
 		},
 	}
 	for _, tt := range tests {
@@ -75,6 +78,7 @@ func testDates(t *testing.T, dates1, dates2 []string, tt TestStructDates) {
 	assert.Equal(t, YearMonthDayHourMinute+tt.expectedMedian+NanoSecondsTimeZone, dateMap1["median"])
 	assert.Equal(t, int64(len(tt.dates1)+len(tt.dates2)), dateMap1["count"])
 	assert.Equal(t, YearMonthDayHourMinute+tt.expectedMode+NanoSecondsTimeZone, dateMap1["mode"])
+	assert.Equal(t, YearMonthDayHourMinute+tt.expectedMean, dateMap1["mean"])
 }
 
 func createDateAgg(dates []string) map[string]interface{} {
@@ -85,7 +89,7 @@ func createDateAgg(dates []string) map[string]interface{} {
 	agg.buildPairsFromCounts() // needed to populate all required info
 
 	prop := aggregation.Property{}
-	aggs := []aggregation.Aggregator{aggregation.MedianAggregator, aggregation.MinimumAggregator, aggregation.MaximumAggregator, aggregation.CountAggregator, aggregation.ModeAggregator}
+	aggs := []aggregation.Aggregator{aggregation.MedianAggregator, aggregation.MinimumAggregator, aggregation.MaximumAggregator, aggregation.CountAggregator, aggregation.ModeAggregator, aggregation.MeanAggregator}
 	addDateAggregations(&prop, aggs, agg)
 	return prop.DateAggregations
 }
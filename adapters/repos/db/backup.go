@@ -23,11 +23,15 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/schema"
+	esync "github.com/weaviate/weaviate/entities/sync"
 )
 
 type BackupState struct {
 	BackupID   string
 	InProgress bool
+	// WritesHeld is set while writes into the index are blocked on behalf of
+	// the backup, see HoldWrites
+	WritesHeld bool
 }
 
 // Backupable returns whether all given class can be backed up.
@@ -57,6 +61,33 @@ func (db *DB) ListBackupable() []string {
 	return cs
 }
 
+// HoldWrites blocks writes into all given classes, so that the classes of
+// this node's part of a backup are captured at the same point of its writes.
+// The hold of a class is lifted by BackupDescriptors as soon as the files of
+// that class are listed, or by ReleaseBackup if the backup is aborted before.
+func (db *DB) HoldWrites(ctx context.Context, bakID string, classes []string) (err error) {
+	held := make([]*Index, 0, len(classes))
+	defer func() {
+		if err != nil {
+			for _, idx := range held {
+				idx.ReleaseBackup(ctx, bakID)
+			}
+		}
+	}()
+
+	for _, c := range classes {
+		idx := db.GetIndex(schema.ClassName(c))
+		if idx == nil {
+			return fmt.Errorf("class %v doesn't exist", c)
+		}
+		if err := idx.holdWrites(ctx, bakID); err != nil {
+			return fmt.Errorf("hold writes of class %v: %w", c, err)
+		}
+		held = append(held, idx)
+	}
+	return nil
+}
+
 // BackupDescriptors returns a channel of class descriptors.
 // Class descriptor records everything needed to restore a class
 // If an error happens a descriptor with an error will be written to the channel just before closing it.
@@ -205,17 +236,23 @@ func (db *DB) ListClasses(ctx context.Context) []string {
 
 // descriptor record everything needed to restore a class
 func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.ClassDescriptor) (err error) {
-	if err := i.initBackup(backupID); err != nil {
-		return err
+	if st := i.lastBackup.Load(); st != nil && st.BackupID == backupID && st.WritesHeld {
+		// writes have been blocked since the backup was accepted, the hold is
+		// handed over and lifted once the metadata is collected
+		defer i.releaseHeldWrites(backupID)
+	} else {
+		if err := i.initBackup(backupID); err != nil {
+			return err
+		}
+		// prevent writing into the index during collection of metadata
+		i.shardTransferMutex.Lock()
+		defer i.shardTransferMutex.Unlock()
 	}
 	defer func() {
 		if err != nil {
 			enterrors.GoWrapper(func() { i.ReleaseBackup(ctx, backupID) }, i.logger)
 		}
 	}()
-	// prevent writing into the index during collection of metadata
-	i.shardTransferMutex.Lock()
-	defer i.shardTransferMutex.Unlock()
 
 	if err = i.ForEachShard(func(name string, s ShardLike) error {
 		if err = s.HaltForTransfer(ctx); err != nil {
@@ -246,6 +283,7 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 // or is already inactive.
 func (i *Index) ReleaseBackup(ctx context.Context, id string) error {
 	i.logger.WithField("backup_id", id).WithField("class", i.Config.ClassName).Info("release backup")
+	i.releaseHeldWrites(id)
 	i.resetBackupState()
 	if err := i.resumeMaintenanceCycles(ctx); err != nil {
 		return err
@@ -272,6 +310,32 @@ func (i *Index) initBackup(id string) error {
 	return nil
 }
 
+// holdWrites books the index for the backup and blocks all writes until
+// releaseHeldWrites is called
+func (i *Index) holdWrites(ctx context.Context, backupID string) error {
+	if err := i.initBackup(backupID); err != nil {
+		return err
+	}
+	if err := i.shardTransferMutex.LockWithContext(ctx); err != nil {
+		i.resetBackupState()
+		return fmt.Errorf("wait for ongoing writes: %w", err)
+	}
+	i.lastBackup.Store(&BackupState{BackupID: backupID, InProgress: true, WritesHeld: true})
+	return nil
+}
+
+// releaseHeldWrites lifts a hold placed by holdWrites. It is safe to call
+// more than once, only the first call unblocks the writes.
+func (i *Index) releaseHeldWrites(backupID string) {
+	st := i.lastBackup.Load()
+	if st == nil || st.BackupID != backupID || !st.WritesHeld {
+		return
+	}
+	if i.lastBackup.CompareAndSwap(st, &BackupState{BackupID: backupID, InProgress: true}) {
+		i.shardTransferMutex.Unlock()
+	}
+}
+
 func (i *Index) resetBackupState() {
 	i.lastBackup.Store(nil)
 }
@@ -317,12 +381,41 @@ type shardTransfer struct {
 	log            logrus.FieldLogger
 	retryDuration  time.Duration
 	notifyDuration time.Duration
+	// monitor is optional, holds are reported under the name of the class
+	monitor *esync.LockMonitor
+	key     string
+}
+
+const shardTransferLockName = "shard_transfer"
+
+func (m *shardTransfer) Lock() {
+	m.RWMutex.Lock()
+	m.monitor.Acquired(shardTransferLockName, m.key, true)
+}
+
+func (m *shardTransfer) Unlock() {
+	m.monitor.Released(shardTransferLockName, m.key, true)
+	m.RWMutex.Unlock()
+}
+
+func (m *shardTransfer) RLock() {
+	m.RWMutex.RLock()
+	m.monitor.Acquired(shardTransferLockName, m.key, false)
+}
+
+func (m *shardTransfer) RUnlock() {
+	m.monitor.Released(shardTransferLockName, m.key, false)
+	m.RWMutex.RUnlock()
 }
 
 // LockWithContext attempts to acquire a write lock while respecting the provided context.
 // It reports whether the lock acquisition was successful or if the context has been cancelled.
 func (m *shardTransfer) LockWithContext(ctx context.Context) error {
-	return m.lock(ctx, m.TryLock)
+	if err := m.lock(ctx, m.TryLock); err != nil {
+		return err
+	}
+	m.monitor.Acquired(shardTransferLockName, m.key, true)
+	return nil
 }
 
 func (m *shardTransfer) lock(ctx context.Context, tryLock func() bool) error {
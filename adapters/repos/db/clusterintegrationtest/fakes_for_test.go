@@ -22,6 +22,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -99,7 +100,7 @@ func (n *node) init(dirName string, shardStateRaw []byte,
 
 	backendProvider := newFakeBackupBackendProvider(localDir)
 	n.backupManager = ubak.NewHandler(
-		logger, &fakeAuthorizer{}, n.schemaManager, n.repo, backendProvider)
+		logger, &fakeAuthorizer{}, n.schemaManager, n.repo, backendProvider, nil)
 
 	backupClient := clients.NewClusterBackups(&http.Client{})
 	n.scheduler = ubak.NewScheduler(
@@ -197,6 +198,23 @@ func (f *fakeSchemaManager) RestoreClass(ctx context.Context, d *backup.ClassDes
 	return nil
 }
 
+func (f *fakeSchemaManager) ClassesVersion(classes []string) uint64 {
+	return 0
+}
+
+func (f *fakeSchemaManager) WaitForUpdate(ctx context.Context, version uint64) error {
+	return nil
+}
+
+func (f *fakeSchemaManager) ClassEqual(name string) string {
+	for _, class := range f.schema.Objects.Classes {
+		if strings.EqualFold(class.Class, name) {
+			return class.Class
+		}
+	}
+	return ""
+}
+
 func (f *fakeSchemaManager) Nodes() []string {
 	return []string{"NOT SET"}
 }
@@ -188,6 +188,74 @@ func (db *DB) Object(ctx context.Context, class string, id strfmt.UUID,
 	return db.enrichRefsForSingle(ctx, r, props, addl, tenant)
 }
 
+// TrashedObjects returns the soft deleted objects of a class which can still
+// be restored. The time of deletion is set as additional property
+// deletionTimeUnix.
+func (db *DB) TrashedObjects(ctx context.Context, class, tenant string,
+) ([]*search.Result, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, nil
+	}
+
+	objs, err := idx.trashedObjects(ctx, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("search trash of index %s: %w", idx.ID(), err)
+	}
+	out := make([]*search.Result, len(objs))
+	for i, obj := range objs {
+		out[i] = trashedSearchResult(obj, tenant)
+	}
+	return out, nil
+}
+
+// TrashedObject returns a soft deleted object or nil if it is not in the
+// trash
+func (db *DB) TrashedObject(ctx context.Context, class string, id strfmt.UUID,
+	tenant string,
+) (*search.Result, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, nil
+	}
+
+	obj, err := idx.trashedObjectByID(ctx, id, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("search trash of index %s: %w", idx.ID(), err)
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	return trashedSearchResult(obj, tenant), nil
+}
+
+// ObjectHistory returns the prior versions of an object, latest first. It's
+// empty if the object was not updated or object history is disabled.
+func (db *DB) ObjectHistory(ctx context.Context, class string, id strfmt.UUID,
+	tenant string,
+) ([]*search.Result, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, nil
+	}
+
+	objs, err := idx.objectHistory(ctx, id, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("search history of index %s: %w", idx.ID(), err)
+	}
+	out := make([]*search.Result, len(objs))
+	for i, obj := range objs {
+		out[i] = obj.SearchResult(additional.Properties{}, tenant)
+	}
+	return out, nil
+}
+
+func trashedSearchResult(obj *trashedObject, tenant string) *search.Result {
+	res := obj.object.SearchResult(additional.Properties{}, tenant)
+	res.AdditionalProperties["deletionTimeUnix"] = obj.deletionTime.UnixMilli()
+	return res
+}
+
 func (db *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 	props search.SelectProperties, additional additional.Properties, tenant string,
 ) (*search.Result, error) {
@@ -22,7 +22,17 @@ var (
 	ObjectsBucketLSM           = "objects"
 	VectorsCompressedBucketLSM = "vectors_compressed"
 	VectorsBucketLSM           = "vectors"
+	VectorsGraphBucketLSM      = "vectors_graph"
 	DimensionsBucketLSM        = "dimensions"
+	// TrashBucketLSM holds soft deleted objects until they are restored or
+	// their retention expires
+	TrashBucketLSM = "trash"
+	// HistoryBucketLSM holds prior versions of objects, keyed by the id of
+	// the object followed by their lastUpdateTimeUnix
+	HistoryBucketLSM = "history"
+	// ExpiryBucketLSM indexes the objects with an expiry time, keyed by their
+	// expiresAtUnix followed by their id
+	ExpiryBucketLSM = "expiry"
 )
 
 const (
@@ -164,6 +164,7 @@ type Index struct {
 	vectorIndexUserConfig     schemaConfig.VectorIndexConfig
 	vectorIndexUserConfigLock sync.Mutex
 	vectorIndexUserConfigs    map[string]schemaConfig.VectorIndexConfig
+	dimensions                dimensionRegistry
 	getSchema                 schemaUC.SchemaGetter
 	logger                    logrus.FieldLogger
 	remote                    *sharding.RemoteIndex
@@ -247,7 +248,8 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	}
 
 	repl := replica.NewReplicator(cfg.ClassName.String(),
-		sg, nodeResolver, string(cfg.DeletionStrategy), replicaClient, logger)
+		sg, nodeResolver, string(cfg.DeletionStrategy), replica.ReadRouting(cfg.ReadRouting),
+		cfg.ReplicationConflicts, replicaClient, logger)
 
 	if cfg.QueryNestedRefLimit == 0 {
 		cfg.QueryNestedRefLimit = config.DefaultQueryNestedCrossReferenceLimit
@@ -267,10 +269,10 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		remote:                 sharding.NewRemoteIndex(cfg.ClassName.String(), sg, nodeResolver, remoteClient),
 		metrics:                NewMetrics(logger, promMetrics, cfg.ClassName.String(), "n/a"),
 		centralJobQueue:        jobQueueCh,
-		shardTransferMutex:     shardTransfer{log: logger, retryDuration: mutexRetryDuration, notifyDuration: mutexNotifyDuration},
+		shardTransferMutex:     shardTransfer{log: logger, retryDuration: mutexRetryDuration, notifyDuration: mutexNotifyDuration, monitor: cfg.LockMonitor, key: cfg.ClassName.String()},
 		indexCheckpoints:       indexCheckpoints,
 		allocChecker:           allocChecker,
-		shardCreateLocks:       esync.NewKeyLocker(),
+		shardCreateLocks:       esync.NewKeyLocker().WithMonitor("shard_create", cfg.LockMonitor),
 	}
 	index.closingCtx, index.closingCancel = context.WithCancel(context.Background())
 
@@ -577,6 +579,19 @@ func (i *Index) updateAsyncReplication(ctx context.Context, enabled bool) error
 	return nil
 }
 
+func (i *Index) updateEncryptionConfig(ctx context.Context, updated *models.EncryptionConfig) error {
+	if updated == nil || !updated.Enabled {
+		return nil
+	}
+
+	return i.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		if err := shard.RotateEncryptionKey(ctx, updated.KeyVersion); err != nil {
+			return fmt.Errorf("rotate encryption key of shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
 type IndexConfig struct {
 	RootPath                       string
 	ClassName                      schema.ClassName
@@ -589,6 +604,9 @@ type IndexConfig struct {
 	MemtablesMinActiveSeconds      int
 	MemtablesMaxActiveSeconds      int
 	SegmentsCleanupIntervalSeconds int
+	SegmentsScrubIntervalSeconds   int
+	EncryptionMasterKey            []byte
+	EncryptionPreviousMasterKey    []byte
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
@@ -597,10 +615,23 @@ type IndexConfig struct {
 	VisitedListPoolMaxSize         int
 	ReplicationFactor              *atomic.Int64
 	DeletionStrategy               string
+	ReadRouting                    string
+	ReplicationConflicts           *replica.ConflictReport
 	AsyncReplicationEnabled        bool
 	AvoidMMap                      bool
 	DisableLazyLoadShards          bool
 	ForceFullReplicasSearch        bool
+	QueryEarlyTerminationCertainty float64
+	// SoftDeleteRetention enables soft deletes if set. Deleted objects are
+	// kept in the trash of their shard for this long and can be restored.
+	SoftDeleteRetention time.Duration
+	// ObjectHistoryVersions is the number of prior versions of an object its
+	// shard keeps, so that they can be listed. History is disabled if 0.
+	ObjectHistoryVersions int
+	// ObjectEvents are notified of objects deleted because they expired
+	ObjectEvents *objects.Events
+	// LockMonitor is optional and keeps track of the locks held on the index
+	LockMonitor *esync.LockMonitor
 
 	TrackVectorDimensions bool
 }
@@ -651,7 +682,7 @@ func (i *Index) determineObjectShardByStatus(ctx context.Context, id strfmt.UUID
 
 func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 	replProps *additional.ReplicationProperties, schemaVersion uint64,
-) error {
+) (err error) {
 	if err := i.validateMultiTenancy(object.Object.Tenant); err != nil {
 		return err
 	}
@@ -661,6 +692,16 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 			object.Class(), i.Config.ClassName)
 	}
 
+	if err := i.checkObjectDimensions(object.Vector, object.Vectors); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			i.recordObjectDimensions(object.Vector, object.Vectors)
+		}
+	}()
+	i.normalizeObjectVectors(object)
+
 	shardName, err := i.determineObjectShard(ctx, object.ID(), object.Object.Tenant)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
@@ -859,6 +900,11 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 			out[pos] = err
 			continue
 		}
+		if err := i.checkObjectDimensions(obj.Vector, obj.Vectors); err != nil {
+			out[pos] = err
+			continue
+		}
+		i.normalizeObjectVectors(obj)
 		shardName, err := i.determineObjectShardByStatus(ctx, obj.ID(), obj.Object.Tenant, tenantsStatus)
 		if err != nil {
 			out[pos] = err
@@ -919,6 +965,12 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 
 	wg.Wait()
 
+	for pos, err := range out {
+		if err == nil {
+			i.recordObjectDimensions(objects[pos].Vector, objects[pos].Vectors)
+		}
+	}
+
 	return out
 }
 
@@ -1563,6 +1615,10 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
+	if err := i.validateSearchDimensions(searchVectors, targetVectors); err != nil {
+		return nil, nil, err
+	}
+	searchVectors = i.normalizeSearchVectors(searchVectors, targetVectors)
 	shardNames, err := i.targetShardNames(ctx, tenant)
 	if err != nil || len(shardNames) == 0 {
 		return nil, nil, err
@@ -1596,10 +1652,39 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 
 	out := make([]*storobj.Object, 0, shardCap)
 	dists := make([]float32, 0, shardCap)
+	collect := func(objs []*storobj.Object, scores []float32) {
+		m.Lock()
+		out = append(out, objs...)
+		dists = append(dists, scores...)
+		m.Unlock()
+	}
+
+	// Plain kNN searches only need to keep the best results across all
+	// shards. This also allows to stop waiting for the remaining shards once
+	// enough results with a high certainty were found.
+	var acc *topKAccumulator
+	searchCtx := ctx
+	if limit > 0 && groupBy == nil && len(sort) == 0 && !i.Config.ForceFullReplicasSearch {
+		acc, searchCtx = newTopKAccumulator(ctx, limit, i.earlyTerminationDistance(targetVectors))
+		defer acc.cancel()
+		collect = acc.add
+	}
+	// errors of shards that were cancelled by early termination are ignored
+	shardErr := func(err error) error {
+		if acc != nil && acc.terminated() {
+			return nil
+		}
+		return err
+	}
+
 	for _, shardName := range shardNames {
 		shardName := shardName
 		eg.Go(func() error {
-			shard, release, err := i.GetShard(ctx, shardName)
+			if acc != nil && acc.terminated() {
+				return nil
+			}
+
+			shard, release, err := i.GetShard(searchCtx, shardName)
 			if err != nil {
 				return nil
 			}
@@ -1607,21 +1692,18 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 			if shard != nil {
 				defer release()
 
-				localCtx := helpers.InitSlowQueryDetails(ctx)
+				localCtx := helpers.InitSlowQueryDetails(searchCtx)
 				helpers.AnnotateSlowQueryLog(localCtx, "is_coordinator", true)
 				localShardResult, localShardScores, err := shard.ObjectVectorSearch(
 					localCtx, searchVectors, targetVectors, dist, limit, filters, sort, groupBy, additional, targetCombination, properties)
 				if err != nil {
-					return errors.Wrapf(err, "shard %s", shard.ID())
+					return shardErr(errors.Wrapf(err, "shard %s", shard.ID()))
 				}
 				// Append result to out
 				if i.replicationEnabled() {
 					storobj.AddOwnership(localShardResult, i.getSchema.NodeName(), shardName)
 				}
-				m.Lock()
-				out = append(out, localShardResult...)
-				dists = append(dists, localShardScores...)
-				m.Unlock()
+				collect(localShardResult, localShardScores)
 			}
 
 			// If we have no local shard or if we force the query to reach all replicas
@@ -1640,27 +1722,21 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 						if i.replicationEnabled() {
 							storobj.AddOwnership(remoteShardResult.Objects, remoteShardResult.Node, shardName)
 						}
-						m.Lock()
-						out = append(out, remoteShardResult.Objects...)
-						dists = append(dists, remoteShardResult.Scores...)
-						m.Unlock()
+						collect(remoteShardResult.Objects, remoteShardResult.Scores)
 					}
 				} else {
 					// Search only what is necessary
-					remoteResult, remoteDists, nodeName, err := i.remote.SearchShard(ctx,
+					remoteResult, remoteDists, nodeName, err := i.remote.SearchShard(searchCtx,
 						shardName, searchVectors, targetVectors, limit, filters,
 						nil, sort, nil, groupBy, additional, i.replicationEnabled(), targetCombination, properties)
 					if err != nil {
-						return errors.Wrapf(err, "remote shard %s", shardName)
+						return shardErr(errors.Wrapf(err, "remote shard %s", shardName))
 					}
 
 					if i.replicationEnabled() {
 						storobj.AddOwnership(remoteResult, nodeName, shardName)
 					}
-					m.Lock()
-					out = append(out, remoteResult...)
-					dists = append(dists, remoteDists...)
-					m.Unlock()
+					collect(remoteResult, remoteDists)
 				}
 			}
 
@@ -1672,6 +1748,13 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 		return nil, nil, err
 	}
 
+	if acc != nil {
+		if acc.terminated() {
+			helpers.AnnotateSlowQueryLog(ctx, "early_termination", true)
+		}
+		out, dists = acc.results()
+	}
+
 	// If we are force querying all replicas, we need to run deduplication on the result.
 	if i.Config.ForceFullReplicasSearch {
 		out, dists, err = searchResultDedup(out, dists)
@@ -1934,11 +2017,25 @@ func (i *Index) getOptInitLocalShard(ctx context.Context, shardName string, ensu
 
 func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 	replProps *additional.ReplicationProperties, tenant string, schemaVersion uint64,
-) error {
+) (err error) {
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return err
 	}
 
+	mergeVectors := make(map[string][]float32, len(merge.Vectors))
+	for target, vec := range merge.Vectors {
+		mergeVectors[target] = vec
+	}
+	if err := i.checkObjectDimensions(merge.Vector, mergeVectors); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			i.recordObjectDimensions(merge.Vector, mergeVectors)
+		}
+	}()
+	i.normalizeMergeVectors(&merge)
+
 	shardName, err := i.determineObjectShard(ctx, merge.ID, tenant)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
@@ -2032,7 +2129,11 @@ func (i *Index) aggregate(ctx context.Context,
 		results[j] = res
 	}
 
-	return aggregator.NewShardCombiner().Do(results), nil
+	combined := aggregator.NewShardCombiner().Do(results)
+	if params.GroupBy != nil {
+		combined.Groups = aggregator.PageGroups(combined.Groups, params)
+	}
+	return combined, nil
 }
 
 func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
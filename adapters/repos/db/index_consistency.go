@@ -0,0 +1,380 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// ConsistencyReport is the result of comparing the objects of a class to the
+// entries of its keyword and vector indexes
+type ConsistencyReport struct {
+	Class      string              `json:"class"`
+	Consistent bool                `json:"consistent"`
+	Repaired   bool                `json:"repaired"`
+	Shards     []*ShardConsistency `json:"shards"`
+}
+
+type ShardConsistency struct {
+	Name    string `json:"name"`
+	Objects int    `json:"objects"`
+	// KeywordIndex compares the objects to the inverted index of their ids,
+	// which every object is added to
+	KeywordIndex  IndexConsistency          `json:"keywordIndex"`
+	VectorIndexes []*VectorIndexConsistency `json:"vectorIndexes"`
+}
+
+// IndexConsistency counts the discrepancies between the objects of a shard
+// and the entries of one of its indexes
+type IndexConsistency struct {
+	Entries int `json:"entries"`
+	// Missing is the number of objects which are not in the index
+	Missing int `json:"missing"`
+	// Orphaned is the number of entries which do not belong to an object
+	Orphaned int `json:"orphaned"`
+}
+
+func (c IndexConsistency) consistent() bool {
+	return c.Missing == 0 && c.Orphaned == 0
+}
+
+type VectorIndexConsistency struct {
+	TargetVector string `json:"targetVector,omitempty"`
+	IndexConsistency
+	// Queued is the number of vectors waiting to be indexed asynchronously.
+	// They are reported as missing until they are indexed.
+	Queued int64 `json:"queued,omitempty"`
+}
+
+// CheckConsistency compares the objects of every loaded shard to the entries
+// of the inverted index of their ids and of their vector indexes. If repair
+// is set, missing entries are added and orphaned entries are removed.
+//
+// Objects written while the check runs may be reported as discrepancies, but
+// they are not repaired: every repair is checked against the current object.
+func (i *Index) CheckConsistency(ctx context.Context, repair bool) (*ConsistencyReport, error) {
+	report := &ConsistencyReport{
+		Class:      i.Config.ClassName.String(),
+		Consistent: true,
+		Repaired:   repair,
+	}
+
+	err := i.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		sc, err := checkShardConsistency(ctx, shard, repair)
+		if err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+		sc.Name = name
+
+		if !sc.KeywordIndex.consistent() {
+			report.Consistent = false
+		}
+		for _, vc := range sc.VectorIndexes {
+			if !vc.consistent() {
+				report.Consistent = false
+			}
+		}
+		report.Shards = append(report.Shards, sc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+type consistencyVectorIndex struct {
+	report  *VectorIndexConsistency
+	index   VectorIndex
+	queue   *IndexQueue
+	docIDs  helpers.AllowList
+	missing []*storobj.Object
+	orphans []uint64
+}
+
+// checkShardConsistency compares the objects to the entries of the indexes.
+// Writes update the indexes after the object, outside of the lock of its id,
+// so the check runs against a moving target. Before anything is repaired, the
+// object is read again under the lock of its id: entries are only added for
+// objects which still exist and only removed if no object owns them anymore.
+func checkShardConsistency(ctx context.Context, shard ShardLike, repair bool,
+) (*ShardConsistency, error) {
+	sc := &ShardConsistency{}
+
+	objects := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if objects == nil {
+		return nil, fmt.Errorf("objects bucket not found")
+	}
+	ids := shard.Store().Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropID))
+	if ids == nil {
+		return nil, fmt.Errorf("id bucket not found")
+	}
+	if ids.Strategy() != lsmkv.StrategySetCollection {
+		return nil, fmt.Errorf("unexpected strategy %s of id bucket", ids.Strategy())
+	}
+
+	var vectorIndexes []*consistencyVectorIndex
+	addVectorIndex := func(targetVector string, index VectorIndex, queue *IndexQueue) {
+		if index == nil || queue == nil {
+			return
+		}
+		vc := &VectorIndexConsistency{TargetVector: targetVector}
+		if asyncEnabled() {
+			vc.Queued = queue.Size()
+		}
+		sc.VectorIndexes = append(sc.VectorIndexes, vc)
+		vectorIndexes = append(vectorIndexes, &consistencyVectorIndex{
+			report: vc,
+			index:  index,
+			queue:  queue,
+			docIDs: helpers.NewAllowList(),
+		})
+	}
+	if shard.hasTargetVectors() {
+		queues := shard.Queues()
+		for targetVector, index := range shard.VectorIndexes() {
+			addVectorIndex(targetVector, index, queues[targetVector])
+		}
+	} else {
+		addVectorIndex("", shard.VectorIndex(), shard.Queue())
+	}
+
+	// objects missing from an index are collected and repaired once the
+	// cursor is closed, as they are read again from the objects bucket
+	docIDs := helpers.NewAllowList()
+	var idMissing []*storobj.Object
+	err := objects.IterateObjects(ctx, func(obj *storobj.Object) error {
+		sc.Objects++
+		docIDs.Insert(obj.DocID)
+
+		key, err := obj.ID().MarshalText()
+		if err != nil {
+			return fmt.Errorf("marshal id: %w", err)
+		}
+		values, err := ids.SetList(key)
+		if err != nil {
+			return fmt.Errorf("read id index: %w", err)
+		}
+		if !containsValue(values, docIDToBytes(obj.DocID)) {
+			sc.KeywordIndex.Missing++
+			idMissing = append(idMissing, obj)
+		}
+
+		for _, vi := range vectorIndexes {
+			if len(consistencyVector(vi, obj)) == 0 {
+				continue
+			}
+			vi.docIDs.Insert(obj.DocID)
+			if vi.index.ContainsNode(obj.DocID) {
+				continue
+			}
+			vi.report.Missing++
+			vi.missing = append(vi.missing, obj)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate objects: %w", err)
+	}
+
+	// the entries are collected first, as buckets must not be written to
+	// while a cursor is open on them. They are copied, as the cursor reuses
+	// them.
+	type idEntry struct{ key, docID []byte }
+	var idOrphans []idEntry
+	c := ids.SetCursor()
+	for k, values := c.First(); k != nil; k, values = c.Next() {
+		for _, v := range values {
+			sc.KeywordIndex.Entries++
+			if len(v) == 8 && docIDs.Contains(binary.LittleEndian.Uint64(v)) {
+				continue
+			}
+			idOrphans = append(idOrphans, idEntry{
+				key:   append([]byte{}, k...),
+				docID: append([]byte{}, v...),
+			})
+		}
+	}
+	c.Close()
+	sc.KeywordIndex.Orphaned = len(idOrphans)
+
+	for _, vi := range vectorIndexes {
+		vi.index.Iterate(func(id uint64) bool {
+			vi.report.Entries++
+			if !vi.docIDs.Contains(id) {
+				vi.orphans = append(vi.orphans, id)
+			}
+			return ctx.Err() == nil
+		})
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		vi.report.Orphaned = len(vi.orphans)
+	}
+
+	if !repair {
+		return sc, nil
+	}
+
+	for _, obj := range idMissing {
+		err := withCurrentObject(shard, objects, obj, func() error {
+			key, err := obj.ID().MarshalText()
+			if err != nil {
+				return fmt.Errorf("marshal id: %w", err)
+			}
+			return ids.SetAdd(key, [][]byte{docIDToBytes(obj.DocID)})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("add to id index: %w", err)
+		}
+	}
+	for _, orphan := range idOrphans {
+		if err := deleteIDOrphan(shard, objects, ids, orphan.key, orphan.docID); err != nil {
+			return nil, fmt.Errorf("delete from id index: %w", err)
+		}
+	}
+
+	for _, vi := range vectorIndexes {
+		for _, obj := range vi.missing {
+			err := withCurrentObject(shard, objects, obj, func() error {
+				return addToVectorIndex(ctx, vi, obj.DocID, consistencyVector(vi, obj))
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// an object is written before its vector is added and deleted
+		// before its vector is removed, so a vector whose doc id does not
+		// belong to an object now can't belong to one later
+		orphans := vi.orphans[:0]
+		for _, docID := range vi.orphans {
+			obj, err := objects.GetBySecondary(0, docIDToBytes(docID))
+			if err != nil {
+				return nil, fmt.Errorf("get object by doc id %d: %w", docID, err)
+			}
+			if obj == nil {
+				orphans = append(orphans, docID)
+			}
+		}
+		if err := vi.queue.Delete(orphans...); err != nil {
+			return nil, fmt.Errorf("delete from vector index %q: %w", vi.report.TargetVector, err)
+		}
+		if !asyncEnabled() {
+			if err := vi.index.Flush(); err != nil {
+				return nil, fmt.Errorf("flush vector index %q: %w", vi.report.TargetVector, err)
+			}
+		}
+	}
+	return sc, nil
+}
+
+func consistencyVector(vi *consistencyVectorIndex, obj *storobj.Object) []float32 {
+	if vi.report.TargetVector != "" {
+		return obj.Vectors[vi.report.TargetVector]
+	}
+	return obj.Vector
+}
+
+// withCurrentObject calls fn with the lock of the id of the object held, if
+// the object still exists with the same doc id. Otherwise it has been
+// updated or deleted since it was read, and the write took care of the
+// indexes.
+func withCurrentObject(shard ShardLike, objects *lsmkv.Bucket, obj *storobj.Object,
+	fn func() error,
+) error {
+	idBytes, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	lock := shard.objectLock(idBytes)
+	lock.Lock()
+	defer lock.Unlock()
+
+	current, err := fetchObject(objects, idBytes)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.DocID != obj.DocID {
+		return nil
+	}
+	return fn()
+}
+
+// deleteIDOrphan deletes the entry of the id index, unless an object has been
+// written with that id and doc id since the entries were collected
+func deleteIDOrphan(shard ShardLike, objects, ids *lsmkv.Bucket, key, docID []byte) error {
+	id, err := uuid.ParseBytes(key)
+	if err != nil {
+		// not an id of an object, so it can't belong to one
+		return ids.SetDeleteSingle(key, docID)
+	}
+	idBytes, err := id.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	lock := shard.objectLock(idBytes)
+	lock.Lock()
+	defer lock.Unlock()
+
+	current, err := fetchObject(objects, idBytes)
+	if err != nil {
+		return err
+	}
+	if current != nil && len(docID) == 8 && current.DocID == binary.LittleEndian.Uint64(docID) {
+		return nil
+	}
+	return ids.SetDeleteSingle(key, docID)
+}
+
+func docIDToBytes(docID uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, docID)
+	return b
+}
+
+// addToVectorIndex adds a missing vector the way imports do, either to the
+// queue of async indexing or directly to the index
+func addToVectorIndex(ctx context.Context, vi *consistencyVectorIndex, docID uint64,
+	vector []float32,
+) error {
+	var err error
+	if asyncEnabled() {
+		err = vi.queue.Push(ctx, vectorDescriptor{id: docID, vector: vector})
+	} else {
+		err = vi.index.Add(ctx, docID, vector)
+	}
+	if err != nil {
+		return fmt.Errorf("add doc id %d to vector index %q: %w", docID, vi.report.TargetVector, err)
+	}
+	return nil
+}
+
+func containsValue(values [][]byte, value []byte) bool {
+	for _, v := range values {
+		if bytes.Equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
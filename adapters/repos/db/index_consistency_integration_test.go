@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestIndex_CheckConsistency(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "ConsistencyClass"}
+
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false)
+	defer idx.drop()
+
+	objects := createRandomObjects(getRandomSeed(), class.Class, 50, 16)
+	errs := shd.PutObjectBatch(ctx, objects)
+	for _, err := range errs {
+		require.Nil(t, err)
+	}
+
+	t.Run("consistent after import", func(t *testing.T) {
+		report, err := idx.CheckConsistency(ctx, false)
+		require.Nil(t, err)
+
+		assert.Equal(t, class.Class, report.Class)
+		assert.True(t, report.Consistent)
+		require.Len(t, report.Shards, 1)
+		sc := report.Shards[0]
+		assert.Equal(t, 50, sc.Objects)
+		assert.Equal(t, IndexConsistency{Entries: 50}, sc.KeywordIndex)
+		require.Len(t, sc.VectorIndexes, 1)
+		assert.Equal(t, IndexConsistency{Entries: 50}, sc.VectorIndexes[0].IndexConsistency)
+	})
+
+	// simulate partial write failures
+	ids := shd.Store().Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropID))
+	obj, err := shd.ObjectByID(ctx, objects[0].ID(), nil, additional.Properties{})
+	require.Nil(t, err)
+	key, err := obj.ID().MarshalText()
+	require.Nil(t, err)
+	docIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docIDBytes, obj.DocID)
+	require.Nil(t, ids.SetDeleteSingle(key, docIDBytes))
+
+	orphanDocID := uint64(10000)
+	orphanBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(orphanBytes, orphanDocID)
+	require.Nil(t, ids.SetAdd([]byte("orphan"), [][]byte{orphanBytes}))
+	require.Nil(t, shd.VectorIndex().Add(ctx, orphanDocID, objects[1].Vector))
+
+	// an object which was written without being indexed
+	partial := createRandomObjects(getRandomSeed(), class.Class, 1, 16)[0]
+	partial.DocID = 20000
+	data, err := partial.MarshalBinary()
+	require.Nil(t, err)
+	idBytes, err := uuid.MustParse(partial.ID().String()).MarshalBinary()
+	require.Nil(t, err)
+	require.Nil(t, shd.(*LazyLoadShard).shard.upsertObjectDataLSM(shd.Store().Bucket(helpers.ObjectsBucketLSM),
+		idBytes, data, partial.DocID))
+
+	t.Run("reports discrepancies", func(t *testing.T) {
+		report, err := idx.CheckConsistency(ctx, false)
+		require.Nil(t, err)
+
+		assert.False(t, report.Consistent)
+		sc := report.Shards[0]
+		assert.Equal(t, 51, sc.Objects)
+		assert.Equal(t, IndexConsistency{Entries: 50, Missing: 2, Orphaned: 1}, sc.KeywordIndex)
+		assert.Equal(t, IndexConsistency{Entries: 51, Missing: 1, Orphaned: 1}, sc.VectorIndexes[0].IndexConsistency)
+	})
+
+	t.Run("repairs discrepancies", func(t *testing.T) {
+		report, err := idx.CheckConsistency(ctx, true)
+		require.Nil(t, err)
+		assert.True(t, report.Repaired)
+		assert.False(t, report.Consistent)
+
+		report, err = idx.CheckConsistency(ctx, false)
+		require.Nil(t, err)
+		assert.True(t, report.Consistent)
+		sc := report.Shards[0]
+		assert.Equal(t, IndexConsistency{Entries: 51}, sc.KeywordIndex)
+		assert.Equal(t, IndexConsistency{Entries: 51}, sc.VectorIndexes[0].IndexConsistency)
+		assert.False(t, shd.VectorIndex().ContainsNode(orphanDocID))
+		assert.True(t, shd.VectorIndex().ContainsNode(partial.DocID))
+	})
+
+	t.Run("does not repair objects written in between", func(t *testing.T) {
+		objectsBucket := shd.Store().Bucket(helpers.ObjectsBucketLSM)
+		written := createRandomObjects(getRandomSeed(), class.Class, 1, 16)[0]
+		require.Nil(t, shd.PutObject(ctx, written))
+		written, err := shd.ObjectByID(ctx, written.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		key, err := written.ID().MarshalText()
+		require.Nil(t, err)
+
+		// collected as orphan before the object was written
+		require.Nil(t, deleteIDOrphan(shd, objectsBucket, ids, key, docIDToBytes(written.DocID)))
+		values, err := ids.SetList(key)
+		require.Nil(t, err)
+		assert.True(t, containsValue(values, docIDToBytes(written.DocID)))
+
+		// collected as missing before the object was updated
+		stale := *written
+		stale.DocID = written.DocID + 1000
+		called := false
+		require.Nil(t, withCurrentObject(shd, objectsBucket, &stale, func() error {
+			called = true
+			return nil
+		}))
+		assert.False(t, called)
+	})
+}
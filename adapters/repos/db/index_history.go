@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// like the trash, the history is kept by every replica for the writes it
+// applied. It's read from the local shard if this node has one and from the
+// node owning the shard otherwise.
+
+// objectHistory returns the prior versions of an object, latest first
+func (i *Index) objectHistory(ctx context.Context, id strfmt.UUID, tenant string,
+) ([]*storobj.Object, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	shardName, err := i.determineObjectShard(ctx, id, tenant)
+	if err != nil {
+		switch err.(type) {
+		case objects.ErrMultiTenancy:
+			return nil, objects.NewErrMultiTenancy(fmt.Errorf("determine shard: %w", err))
+		default:
+			return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
+		}
+	}
+
+	var versions [][]byte
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	if shard != nil {
+		defer release()
+		versions, err = localObjectHistory(ctx, shard, id)
+	} else {
+		versions, err = i.remote.ObjectHistory(ctx, shardName, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", shardName, err)
+	}
+
+	out := make([]*storobj.Object, len(versions))
+	for j, version := range versions {
+		if out[j], err = storobj.FromBinary(version); err != nil {
+			return nil, fmt.Errorf("unmarshal version of object %s: %w", id, err)
+		}
+	}
+	return out, nil
+}
+
+func (i *Index) IncomingObjectHistory(ctx context.Context, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return localObjectHistory(ctx, shard, id)
+}
+
+func localObjectHistory(ctx context.Context, shard ShardLike, id strfmt.UUID) ([][]byte, error) {
+	idBytes, err := parseBytesUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return objectHistory(ctx, shard, idBytes)
+}
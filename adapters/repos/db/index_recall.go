@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/benchmark"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// RecallReport is the result of comparing the vector index of a class to an
+// exact brute force search
+type RecallReport struct {
+	Class        string         `json:"class"`
+	TargetVector string         `json:"targetVector,omitempty"`
+	K            int            `json:"k"`
+	Samples      int            `json:"samples"`
+	Recall       float64        `json:"recall"`
+	Shards       []*ShardRecall `json:"shards"`
+}
+
+type ShardRecall struct {
+	Name    string  `json:"name"`
+	Vectors int     `json:"vectors"`
+	Samples int     `json:"samples"`
+	Recall  float64 `json:"recall"`
+}
+
+// MeasureRecall samples stored vectors of every loaded shard, queries the
+// vector index with them and compares the results to a brute force search
+// over all vectors of that shard. Each shard's vectors are held in memory
+// while it is measured, so this is meant for diagnostics only.
+func (i *Index) MeasureRecall(ctx context.Context, targetVector string,
+	samples, k int, seed int64,
+) (*RecallReport, error) {
+	if samples <= 0 || k <= 0 {
+		return nil, fmt.Errorf("samples and k must be greater than 0")
+	}
+
+	report := &RecallReport{
+		Class:        i.Config.ClassName.String(),
+		TargetVector: targetVector,
+		K:            k,
+	}
+	rnd := rand.New(rand.NewSource(seed))
+
+	var relevant, retrieved int
+	err := i.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		var vidx VectorIndex
+		if targetVector == "" {
+			vidx = shard.VectorIndex()
+		} else {
+			vidx = shard.VectorIndexes()[targetVector]
+		}
+		if vidx == nil {
+			return fmt.Errorf("shard %s: vector index %q not found", name, targetVector)
+		}
+
+		sr, rel, ret, err := measureShardRecall(ctx, shard, vidx, targetVector, samples, k, rnd)
+		if err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+		sr.Name = name
+
+		report.Shards = append(report.Shards, sr)
+		report.Samples += sr.Samples
+		relevant += rel
+		retrieved += ret
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if retrieved > 0 {
+		report.Recall = float64(relevant) / float64(retrieved)
+	}
+	return report, nil
+}
+
+func measureShardRecall(ctx context.Context, shard ShardLike, vidx VectorIndex,
+	targetVector string, samples, k int, rnd *rand.Rand,
+) (*ShardRecall, int, int, error) {
+	distProv := vidx.DistancerProvider()
+
+	var docIDs []uint64
+	var vectors [][]float32
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	err := bucket.IterateObjects(ctx, func(obj *storobj.Object) error {
+		vec := obj.Vector
+		if targetVector != "" {
+			vec = obj.Vectors[targetVector]
+		}
+		if len(vec) == 0 {
+			return nil
+		}
+		if distProv.Type() == "cosine-dot" {
+			vec = distancer.Normalize(vec)
+		}
+		docIDs = append(docIDs, obj.DocID)
+		vectors = append(vectors, vec)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("iterate objects: %w", err)
+	}
+
+	sr := &ShardRecall{Vectors: len(vectors)}
+	if len(vectors) == 0 {
+		return sr, 0, 0, nil
+	}
+
+	shardK := min(k, len(vectors))
+	sr.Samples = min(samples, len(vectors))
+
+	var relevant int
+	for _, pos := range rnd.Perm(len(vectors))[:sr.Samples] {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, 0, err
+		}
+
+		query := vectors[pos]
+		results, _, err := vidx.SearchByVector(ctx, query, shardK, nil)
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("vector search: %w", err)
+		}
+
+		truthPos, err := benchmark.BruteForce(vectors, query, shardK, distProv)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		truth := make([]uint64, len(truthPos))
+		for j, p := range truthPos {
+			truth[j] = docIDs[p]
+		}
+
+		relevant += benchmark.Matches(truth, results)
+	}
+
+	retrieved := sr.Samples * shardK
+	sr.Recall = float64(relevant) / float64(retrieved)
+
+	// indexes with autoEf tune ef based on the measured recall
+	if observer, ok := vidx.(interface{ ObserveRecall(recall float64) }); ok {
+		observer.ObserveRecall(sr.Recall)
+	}
+	return sr, relevant, retrieved, nil
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestIndex_MeasureRecall(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "RecallClass"}
+
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false)
+	defer idx.drop()
+
+	objects := createRandomObjects(getRandomSeed(), class.Class, 200, 16)
+	errs := shd.PutObjectBatch(ctx, objects)
+	for _, err := range errs {
+		require.Nil(t, err)
+	}
+
+	t.Run("reports recall per shard", func(t *testing.T) {
+		report, err := idx.MeasureRecall(ctx, "", 20, 5, 7)
+		require.Nil(t, err)
+
+		assert.Equal(t, class.Class, report.Class)
+		assert.Equal(t, 5, report.K)
+		assert.Equal(t, 20, report.Samples)
+		require.Len(t, report.Shards, 1)
+		assert.Equal(t, 200, report.Shards[0].Vectors)
+		// with default settings the index is near-exact on such a small set
+		assert.Greater(t, report.Recall, 0.9)
+		assert.Equal(t, report.Recall, report.Shards[0].Recall)
+	})
+
+	t.Run("samples are capped at the number of vectors", func(t *testing.T) {
+		report, err := idx.MeasureRecall(ctx, "", 1000, 5, 7)
+		require.Nil(t, err)
+		assert.Equal(t, 200, report.Samples)
+	})
+
+	t.Run("unknown target vector", func(t *testing.T) {
+		_, err := idx.MeasureRecall(ctx, "missing", 20, 5, 7)
+		assert.ErrorContains(t, err, "vector index \"missing\" not found")
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, err := idx.MeasureRecall(ctx, "", 0, 5, 7)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// topKAccumulator collects the results of a vector search across shards and
+// only keeps the best limit results. If an early termination distance is set,
+// the search is cancelled as soon as limit results within that distance were
+// found, even if some shards have not answered yet.
+type topKAccumulator struct {
+	sync.Mutex
+	limit     int
+	maxDist   float32
+	queue     *priorityqueue.Queue[*storobj.Object]
+	cancel    context.CancelFunc
+	satisfied bool
+}
+
+// newTopKAccumulator returns the accumulator and a context which is
+// cancelled on early termination. A negative maxDist disables early
+// termination.
+func newTopKAccumulator(ctx context.Context, limit int, maxDist float32,
+) (*topKAccumulator, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &topKAccumulator{
+		limit:   limit,
+		maxDist: maxDist,
+		queue:   priorityqueue.NewMax[*storobj.Object](limit + 1),
+		cancel:  cancel,
+	}, ctx
+}
+
+func (a *topKAccumulator) add(objs []*storobj.Object, dists []float32) {
+	a.Lock()
+	defer a.Unlock()
+
+	for i, obj := range objs {
+		if a.queue.Len() == a.limit && dists[i] >= a.queue.Top().Dist {
+			continue
+		}
+		a.queue.InsertWithValue(obj.DocID, dists[i], obj)
+		if a.queue.Len() > a.limit {
+			a.queue.Pop()
+		}
+	}
+
+	if a.maxDist >= 0 && !a.satisfied && a.queue.Len() == a.limit &&
+		a.queue.Top().Dist <= a.maxDist {
+		a.satisfied = true
+		a.cancel()
+	}
+}
+
+// terminated reports whether the search was cancelled because enough results
+// were found. Shards that fail after that are not an error.
+func (a *topKAccumulator) terminated() bool {
+	a.Lock()
+	defer a.Unlock()
+
+	return a.satisfied
+}
+
+// results returns the accumulated results sorted by ascending distance
+func (a *topKAccumulator) results() ([]*storobj.Object, []float32) {
+	a.Lock()
+	defer a.Unlock()
+
+	objs := make([]*storobj.Object, a.queue.Len())
+	dists := make([]float32, a.queue.Len())
+	for i := len(objs) - 1; i >= 0; i-- {
+		item := a.queue.Pop()
+		objs[i], dists[i] = item.Value, item.Dist
+	}
+	return objs, dists
+}
+
+// earlyTerminationDistance translates the configured certainty into a
+// distance for the searched target vector. Certainty is only defined for
+// cosine distances and not for combined multi-target distances, so -1
+// (disabled) is returned in all other cases.
+func (i *Index) earlyTerminationDistance(targetVectors []string) float32 {
+	certainty := i.Config.QueryEarlyTerminationCertainty
+	if certainty <= 0 || len(targetVectors) > 1 {
+		return -1
+	}
+
+	target := ""
+	if len(targetVectors) == 1 {
+		target = targetVectors[0]
+	}
+	cfg := i.vectorIndexConfigFor(target)
+	if cfg == nil || cfg.DistanceName() != common.DistanceCosine {
+		return -1
+	}
+
+	return float32(2 * (1 - certainty))
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func topKTestObjects(ids ...uint64) []*storobj.Object {
+	objs := make([]*storobj.Object, len(ids))
+	for i, id := range ids {
+		objs[i] = &storobj.Object{DocID: id}
+	}
+	return objs
+}
+
+func TestTopKAccumulator(t *testing.T) {
+	t.Run("keeps the best results across shards", func(t *testing.T) {
+		acc, ctx := newTopKAccumulator(context.Background(), 3, -1)
+		acc.add(topKTestObjects(1, 2, 3), []float32{0.5, 0.1, 0.9})
+		acc.add(topKTestObjects(4, 5), []float32{0.3, 0.95})
+		acc.add(nil, nil)
+
+		objs, dists := acc.results()
+		require.Len(t, objs, 3)
+		assert.Equal(t, []float32{0.1, 0.3, 0.5}, dists)
+		assert.Equal(t, []uint64{2, 4, 1}, []uint64{objs[0].DocID, objs[1].DocID, objs[2].DocID})
+		assert.False(t, acc.terminated())
+		assert.Nil(t, ctx.Err())
+	})
+
+	t.Run("fewer results than the limit", func(t *testing.T) {
+		acc, _ := newTopKAccumulator(context.Background(), 10, -1)
+		acc.add(topKTestObjects(1, 2), []float32{0.5, 0.1})
+
+		objs, dists := acc.results()
+		require.Len(t, objs, 2)
+		assert.Equal(t, []float32{0.1, 0.5}, dists)
+	})
+
+	t.Run("terminates once enough results are close enough", func(t *testing.T) {
+		acc, ctx := newTopKAccumulator(context.Background(), 2, 0.2)
+		acc.add(topKTestObjects(1, 2), []float32{0.1, 0.3})
+		assert.False(t, acc.terminated())
+		assert.Nil(t, ctx.Err())
+
+		acc.add(topKTestObjects(3), []float32{0.15})
+		assert.True(t, acc.terminated())
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+
+		_, dists := acc.results()
+		assert.Equal(t, []float32{0.1, 0.15}, dists)
+	})
+}
+
+func TestIndex_EarlyTerminationDistance(t *testing.T) {
+	l2 := hnsw.NewDefaultUserConfig()
+	l2.Distance = "l2-squared"
+
+	idx := &Index{
+		Config:                IndexConfig{QueryEarlyTerminationCertainty: 0.9},
+		vectorIndexUserConfig: hnsw.NewDefaultUserConfig(),
+		vectorIndexUserConfigs: map[string]schemaConfig.VectorIndexConfig{
+			"cosine": hnsw.NewDefaultUserConfig(),
+			"l2":     l2,
+		},
+	}
+
+	assert.InDelta(t, 0.2, idx.earlyTerminationDistance(nil), 1e-6)
+	assert.InDelta(t, 0.2, idx.earlyTerminationDistance([]string{"cosine"}), 1e-6)
+	assert.Equal(t, float32(-1), idx.earlyTerminationDistance([]string{"l2"}))
+	assert.Equal(t, float32(-1), idx.earlyTerminationDistance([]string{"cosine", "cosine"}))
+
+	idx.Config.QueryEarlyTerminationCertainty = 0
+	assert.Equal(t, float32(-1), idx.earlyTerminationDistance(nil))
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// the trash is not replicated to other nodes. Shards of other nodes are read
+// through the remote index, from the node owning the shard. With
+// replication, every replica keeps its own trash and only the trash of the
+// owning replica is considered.
+
+func (i *Index) trashedObjects(ctx context.Context, tenant string) ([]*trashedObject, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	shardNames := i.getSchema.CopyShardingState(i.Config.ClassName.String()).AllPhysicalShards()
+	var out []*trashedObject
+	for _, shardName := range shardNames {
+		if tenant != "" && shardName != tenant {
+			continue
+		}
+		objs, err := i.shardTrash(ctx, shardName, "")
+		if err != nil {
+			return nil, fmt.Errorf("shard %s: %w", shardName, err)
+		}
+		out = append(out, objs...)
+	}
+	return out, nil
+}
+
+func (i *Index) trashedObjectByID(ctx context.Context, id strfmt.UUID, tenant string,
+) (*trashedObject, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	shardName, err := i.determineObjectShard(ctx, id, tenant)
+	if err != nil {
+		switch err.(type) {
+		case objects.ErrMultiTenancy:
+			return nil, objects.NewErrMultiTenancy(fmt.Errorf("determine shard: %w", err))
+		default:
+			return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
+		}
+	}
+
+	objs, err := i.shardTrash(ctx, shardName, id)
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", shardName, err)
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// shardTrash returns the trash of a local or remote shard, or only the entry
+// of the given object if id is set
+func (i *Index) shardTrash(ctx context.Context, shardName string, id strfmt.UUID,
+) ([]*trashedObject, error) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	if shard != nil {
+		defer release()
+		return localShardTrash(ctx, shard, id, i.Config.SoftDeleteRetention)
+	}
+
+	entries, err := i.remote.TrashEntries(ctx, shardName, id)
+	if err != nil {
+		return nil, err
+	}
+	expiry := time.Now().Add(-i.Config.SoftDeleteRetention)
+	out := make([]*trashedObject, 0, len(entries))
+	for _, entry := range entries {
+		obj, err := parseTrashEntry(entry, expiry)
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+func (i *Index) IncomingTrashEntries(ctx context.Context, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	objs, err := localShardTrash(ctx, shard, id, i.Config.SoftDeleteRetention)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]byte, len(objs))
+	for j, obj := range objs {
+		if out[j], err = obj.entry(); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func localShardTrash(ctx context.Context, shard ShardLike, id strfmt.UUID, retention time.Duration,
+) ([]*trashedObject, error) {
+	if id == "" {
+		return trashedObjects(ctx, shard, retention)
+	}
+
+	idBytes, err := parseBytesUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := trashedObjectByID(shard, idBytes, retention)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+	return []*trashedObject{obj}, nil
+}
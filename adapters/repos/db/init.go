@@ -91,6 +91,9 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMinActiveSeconds:      db.config.MemtablesMinActiveSeconds,
 				MemtablesMaxActiveSeconds:      db.config.MemtablesMaxActiveSeconds,
 				SegmentsCleanupIntervalSeconds: db.config.SegmentsCleanupIntervalSeconds,
+				SegmentsScrubIntervalSeconds:   db.config.SegmentsScrubIntervalSeconds,
+				EncryptionMasterKey:            db.config.EncryptionMasterKey,
+				EncryptionPreviousMasterKey:    db.config.EncryptionPreviousMasterKey,
 				SeparateObjectsCompactions:     db.config.SeparateObjectsCompactions,
 				MaxSegmentSize:                 db.config.MaxSegmentSize,
 				HNSWMaxLogSize:                 db.config.HNSWMaxLogSize,
@@ -101,9 +104,16 @@ func (db *DB) init(ctx context.Context) error {
 				AvoidMMap:                      db.config.AvoidMMap,
 				DisableLazyLoadShards:          db.config.DisableLazyLoadShards,
 				ForceFullReplicasSearch:        db.config.ForceFullReplicasSearch,
+				QueryEarlyTerminationCertainty: db.config.QueryEarlyTerminationCertainty,
+				SoftDeleteRetention:            db.config.SoftDeleteRetention,
+				ObjectHistoryVersions:          db.config.ObjectHistoryVersions,
 				ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 				AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
 				DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
+				ReadRouting:                    db.config.Replication.ReadRouting,
+				ReplicationConflicts:           db.replicationConflicts,
+				ObjectEvents:                   db.objectEvents,
+				LockMonitor:                    db.config.LockMonitor,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				convertToVectorIndexConfig(class.VectorIndexConfig),
@@ -134,6 +134,13 @@ type Bucket struct {
 	// redundant obsolete data, that was deleted or updated in newer segments
 	// (currently supported only in buckets of REPLACE strategy)
 	segmentsCleanupInterval time.Duration
+
+	// optional segments scrub interval. If set, every segment's checksum is
+	// verified once within the interval and corrupted segments are quarantined
+	segmentsScrubInterval time.Duration
+
+	// optional cipher to encrypt values at rest, see WithValueCipher
+	valueCipher ValueCipher
 }
 
 func NewBucketCreator() *Bucket { return &Bucket{} }
@@ -179,6 +186,10 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 		}
 	}
 
+	if b.valueCipher != nil && b.strategy != StrategyReplace {
+		return nil, fmt.Errorf("value cipher is only supported by strategy %q", StrategyReplace)
+	}
+
 	if b.memtableResizer != nil {
 		b.memtableThreshold = uint64(b.memtableResizer.Initial())
 	}
@@ -196,6 +207,7 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			calcCountNetAdditions: b.calcCountNetAdditions,
 			maxSegmentSize:        b.maxSegmentSize,
 			cleanupInterval:       b.segmentsCleanupInterval,
+			scrubInterval:         b.segmentsScrubInterval,
 		}, b.allocChecker)
 	if err != nil {
 		return nil, fmt.Errorf("init disk segments: %w", err)
@@ -358,7 +370,11 @@ func (b *Bucket) Get(key []byte) ([]byte, error) {
 	}
 	defer b.flushLock.RUnlock()
 
-	return b.get(key)
+	v, err := b.get(key)
+	if err != nil {
+		return nil, err
+	}
+	return b.decryptValue(v)
 }
 
 func (b *Bucket) get(key []byte) ([]byte, error) {
@@ -415,6 +431,14 @@ func (b *Bucket) GetErrDeleted(key []byte) ([]byte, error) {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
+	v, err := b.getErrDeleted(key)
+	if err != nil {
+		return nil, err
+	}
+	return b.decryptValue(v)
+}
+
+func (b *Bucket) getErrDeleted(key []byte) ([]byte, error) {
 	v, err := b.active.get(key)
 	if err == nil {
 		// item found and no error, return and stop searching, since the strategy
@@ -494,6 +518,18 @@ func (b *Bucket) GetBySecondaryIntoMemory(pos int, key []byte, buffer []byte) ([
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
+	v, buffer, err := b.getBySecondaryIntoMemory(pos, key, buffer)
+	if err != nil {
+		return nil, nil, err
+	}
+	v, err = b.decryptValue(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, buffer, nil
+}
+
+func (b *Bucket) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) ([]byte, []byte, error) {
 	v, err := b.active.getBySecondary(pos, key)
 	if err == nil {
 		// item found and no error, return and stop searching, since the strategy
@@ -601,6 +637,11 @@ func (b *Bucket) SetList(key []byte) ([][]byte, error) {
 // Put is limited to ReplaceStrategy, use [Bucket.SetAdd] for Set or
 // [Bucket.MapSet] and [Bucket.MapSetMulti].
 func (b *Bucket) Put(key, value []byte, opts ...SecondaryKeyOption) error {
+	value, err := b.encryptValue(value)
+	if err != nil {
+		return err
+	}
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
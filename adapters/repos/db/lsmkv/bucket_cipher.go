@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import "fmt"
+
+// ValueCipher encrypts the values of a bucket before they are written to the
+// memtable, so that neither the WAL nor the segments contain them in plain.
+type ValueCipher interface {
+	Encrypt(plain []byte) ([]byte, error)
+	// Decrypt appends the plaintext to dst, it must not modify sealed
+	Decrypt(dst, sealed []byte) ([]byte, error)
+}
+
+func (b *Bucket) encryptValue(plain []byte) ([]byte, error) {
+	if b.valueCipher == nil {
+		return plain, nil
+	}
+	sealed, err := b.valueCipher.Encrypt(plain)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt value: %w", err)
+	}
+	return sealed, nil
+}
+
+// decryptValue always returns a copy when a cipher is set, sealed may point
+// into a memtable or mapped segment
+func (b *Bucket) decryptValue(sealed []byte) ([]byte, error) {
+	if b.valueCipher == nil || sealed == nil {
+		return sealed, nil
+	}
+	plain, err := b.valueCipher.Decrypt(nil, sealed)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt value: %w", err)
+	}
+	return plain, nil
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// xorCipher is not secure, it only makes sure that values are not stored in
+// plain and are transformed on every read
+type xorCipher struct{}
+
+func (xorCipher) Encrypt(plain []byte) ([]byte, error) {
+	out := make([]byte, 0, len(plain)+1)
+	out = append(out, 'x')
+	for _, b := range plain {
+		out = append(out, b^0x5a)
+	}
+	return out, nil
+}
+
+func (xorCipher) Decrypt(dst, sealed []byte) ([]byte, error) {
+	if len(sealed) == 0 || sealed[0] != 'x' {
+		return nil, errors.New("not encrypted")
+	}
+	for _, b := range sealed[1:] {
+		dst = append(dst, b^0x5a)
+	}
+	return dst, nil
+}
+
+func TestBucketValueCipher(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+	plain := []byte("secret-value")
+
+	newBucket := func(t *testing.T, opts ...BucketOption) *Bucket {
+		opts = append([]BucketOption{WithStrategy(StrategyReplace), WithSecondaryIndices(1)}, opts...)
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.Nil(t, err)
+		return b
+	}
+
+	t.Run("only the replace strategy is supported", func(t *testing.T) {
+		_, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyMapCollection), WithValueCipher(xorCipher{}))
+		assert.NotNil(t, err)
+	})
+
+	b := newBucket(t, WithValueCipher(xorCipher{}))
+	require.Nil(t, b.Put([]byte("flushed"), plain, WithSecondaryKey(0, []byte("s-flushed"))))
+	require.Nil(t, b.FlushMemtable())
+	require.Nil(t, b.Put([]byte("memtable"), plain, WithSecondaryKey(0, []byte("s-memtable"))))
+
+	t.Run("values are decrypted on read", func(t *testing.T) {
+		for _, key := range []string{"flushed", "memtable"} {
+			v, err := b.Get([]byte(key))
+			require.Nil(t, err)
+			assert.Equal(t, plain, v)
+
+			v, err = b.GetErrDeleted([]byte(key))
+			require.Nil(t, err)
+			assert.Equal(t, plain, v)
+
+			v, err = b.GetBySecondary(0, []byte("s-"+key))
+			require.Nil(t, err)
+			assert.Equal(t, plain, v)
+		}
+
+		v, err := b.Get([]byte("missing"))
+		require.Nil(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("cursor decrypts values", func(t *testing.T) {
+		c := b.Cursor()
+		defer c.Close()
+
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, plain, v)
+			count++
+		}
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("values are not stored in plain", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			assert.False(t, bytes.Contains(data, plain), "%s contains the plain value", path)
+			return nil
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("reading without the cipher returns the encrypted values", func(t *testing.T) {
+		b := newBucket(t)
+		defer b.Shutdown(ctx)
+
+		v, err := b.Get([]byte("flushed"))
+		require.Nil(t, err)
+		assert.NotEqual(t, plain, v)
+	})
+}
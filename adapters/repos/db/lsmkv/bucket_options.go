@@ -159,6 +159,23 @@ func WithSegmentsCleanupInterval(interval time.Duration) BucketOption {
 	}
 }
 
+func WithSegmentsScrubInterval(interval time.Duration) BucketOption {
+	return func(b *Bucket) error {
+		b.segmentsScrubInterval = interval
+		return nil
+	}
+}
+
+// WithValueCipher encrypts all values of a bucket of the replace strategy.
+// Keys and secondary keys are stored in plain, as segments are sorted and
+// searched by them.
+func WithValueCipher(cipher ValueCipher) BucketOption {
+	return func(b *Bucket) error {
+		b.valueCipher = cipher
+		return nil
+	}
+}
+
 /*
 Background for this option:
 
@@ -23,6 +23,7 @@ type CursorReplace struct {
 	state        []cursorStateReplace
 	unlock       func()
 	serveCache   cursorStateReplace
+	valueCipher  ValueCipher
 
 	reusableIDList []int
 }
@@ -63,6 +64,7 @@ func (b *Bucket) Cursor() *CursorReplace {
 		// cursor are in order from oldest to newest, with the memtable cursor
 		// being at the very top
 		innerCursors: innerCursors,
+		valueCipher:  b.valueCipher,
 		unlock: func() {
 			unlockSegmentGroup()
 			b.flushLock.RUnlock()
@@ -94,6 +96,7 @@ func (b *Bucket) CursorWithSecondaryIndex(pos int) *CursorReplace {
 		// cursor are in order from oldest to newest, with the memtable cursor
 		// being at the very top
 		innerCursors: innerCursors,
+		valueCipher:  b.valueCipher,
 		unlock: func() {
 			unlockSegmentGroup()
 			b.flushLock.RUnlock()
@@ -204,8 +207,18 @@ func (c *CursorReplace) copyStateIntoServeCache(pos int) {
 	}
 
 	copy(c.serveCache.key, resMut.key)
-	copy(c.serveCache.value, resMut.value)
 	c.serveCache.err = resMut.err
+
+	if c.valueCipher == nil || resMut.err != nil || resMut.value == nil {
+		copy(c.serveCache.value, resMut.value)
+		return
+	}
+
+	plain, err := c.valueCipher.Decrypt(c.serveCache.value[:0], resMut.value)
+	if err != nil {
+		panic(errors.Wrap(err, "unexpected error in decrypt (cursor type 'replace')"))
+	}
+	c.serveCache.value = plain
 }
 
 func (c *CursorReplace) Seek(key []byte) ([]byte, []byte) {
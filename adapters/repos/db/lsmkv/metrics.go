@@ -43,6 +43,8 @@ type Metrics struct {
 	memtableDurations            prometheus.ObserverVec
 	memtableSize                 *prometheus.GaugeVec
 	DimensionSum                 *prometheus.GaugeVec
+	segmentsScrubbed             *prometheus.CounterVec
+	segmentsCorrupted            *prometheus.CounterVec
 
 	groupClasses        bool
 	criticalBucketsOnly bool
@@ -146,6 +148,14 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		segmentsScrubbed: promMetrics.LSMSegmentsScrubbed.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		segmentsCorrupted: promMetrics.LSMSegmentsCorrupted.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
 	}
 }
 
@@ -182,6 +192,22 @@ func (m *Metrics) MemtableOpObserver(path, strategy, op string) NsObserver {
 	}
 }
 
+func (m *Metrics) SegmentScrubbed(strategy string) {
+	if m == nil {
+		return
+	}
+
+	m.segmentsScrubbed.With(prometheus.Labels{"strategy": strategy}).Inc()
+}
+
+func (m *Metrics) SegmentCorrupted(strategy string) {
+	if m == nil {
+		return
+	}
+
+	m.segmentsCorrupted.With(prometheus.Labels{"strategy": strategy}).Inc()
+}
+
 func (m *Metrics) MemtableSizeSetter(path, strategy string) Setter {
 	if m == nil || m.groupClasses {
 		// this metric would set absolute values, that's not possible in
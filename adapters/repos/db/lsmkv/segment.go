@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/edsrzf/mmap-go"
 	"github.com/pkg/errors"
@@ -57,6 +58,11 @@ type segment struct {
 
 	invertedHeader *segmentindex.HeaderInverted
 	invertedData   *segmentInvertedData
+
+	// set by the scrubber of the segment group, quarantined segments are no
+	// longer compacted or cleaned up
+	quarantined  bool
+	lastScrubbed time.Time
 }
 
 type diskIndex interface {
@@ -187,6 +193,17 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		}
 	}
 
+	seg.quarantined, err = fileExists(seg.quarantinePath())
+	if err != nil {
+		return nil, err
+	}
+	if seg.quarantined {
+		logger.WithField("action", "lsm_init_disk_segment").
+			WithField("path", path).
+			Warn("segment was found to be corrupted and is quarantined, " +
+				"it is excluded from compaction until it is restored")
+	}
+
 	return seg, nil
 }
 
@@ -225,6 +242,10 @@ func (s *segment) dropImmediately() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.checksumPath()); err != nil {
+		return fmt.Errorf("drop checksum file: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -254,6 +275,10 @@ func (s *segment) dropMarked() error {
 		return fmt.Errorf("drop previously marked count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.checksumPath() + DeleteMarkerSuffix); err != nil {
+		return fmt.Errorf("drop previously marked checksum file: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -294,6 +319,13 @@ func (s *segment) markForDeletion() error {
 		}
 	}
 
+	// checksums are only written while scrubbing is enabled
+	if err := markDeleted(s.checksumPath()); err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("mark checksum file deleted: %w", err)
+		}
+	}
+
 	// for the segment itself, we're not accepting a NotExists error. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// segments are read in chunks of this size when computing their checksum, so
+// that a long running scrub can be aborted in between
+const checksumChunkSize = 4 * 1024 * 1024
+
+// checksumPath is the path of the file holding the crc32 of the entire
+// segment file. Checksums are only written while segment scrubbing is
+// enabled, see SegmentGroup.scrubOnce.
+func (s *segment) checksumPath() string {
+	return segmentChecksumPath(s.path)
+}
+
+func segmentChecksumPath(segmentPath string) string {
+	extless := strings.TrimSuffix(segmentPath, filepath.Ext(segmentPath))
+	return fmt.Sprintf("%s.checksum", extless)
+}
+
+// quarantinePath is the path of the marker file of a segment which the
+// scrubber found to be corrupted
+func (s *segment) quarantinePath() string {
+	extless := strings.TrimSuffix(s.path, filepath.Ext(s.path))
+	return fmt.Sprintf("%s.quarantined", extless)
+}
+
+// precomputeChecksum stores the checksum of a compacted or cleaned .tmp
+// segment. Just like other pre-computed files, it is suffixed with .tmp until
+// the segment replaces the old ones.
+func (s *segment) precomputeChecksum(tmpSegmentPath string) (string, error) {
+	sum, _, err := segmentFileChecksum(tmpSegmentPath, nil)
+	if err != nil {
+		return "", err
+	}
+
+	path := fmt.Sprintf("%s.tmp", s.checksumPath())
+	if err := storeSegmentChecksum(path, sum); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// segmentFileChecksum reads the segment file from disk rather than using its
+// mapped contents, so that an unreadable file results in an error rather than
+// a SIGBUS. It reports whether it was aborted, in which case the checksum is
+// meaningless.
+func segmentFileChecksum(path string, shouldAbort func() bool) (uint32, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, false, fmt.Errorf("open segment: %w", err)
+	}
+	defer f.Close()
+
+	hash := crc32.NewIEEE()
+	buf := make([]byte, checksumChunkSize)
+	for {
+		if shouldAbort != nil && shouldAbort() {
+			return 0, true, nil
+		}
+
+		n, err := f.Read(buf)
+		hash.Write(buf[:n])
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, false, fmt.Errorf("read segment: %w", err)
+		}
+	}
+
+	return hash.Sum32(), false, nil
+}
+
+func storeSegmentChecksum(path string, sum uint32) error {
+	data := make([]byte, 4)
+	binary.LittleEndian.PutUint32(data, sum)
+	return writeWithChecksum(data, path)
+}
+
+// loadSegmentChecksum returns ErrInvalidChecksum if the checksum file itself
+// is corrupted
+func loadSegmentChecksum(path string) (uint32, error) {
+	data, err := loadWithChecksum(path, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint32(data), nil
+}
@@ -76,6 +76,9 @@ type SegmentGroup struct {
 	cleanupInterval    time.Duration
 	lastCleanupCall    time.Time
 	lastCompactionCall time.Time
+
+	scrubInterval time.Duration
+	lastScrubCall time.Time
 }
 
 type sgConfig struct {
@@ -90,6 +93,7 @@ type sgConfig struct {
 	forceCompaction       bool
 	maxSegmentSize        int64
 	cleanupInterval       time.Duration
+	scrubInterval         time.Duration
 }
 
 func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
@@ -117,6 +121,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		compactLeftOverSegments: cfg.forceCompaction,
 		maxSegmentSize:          cfg.maxSegmentSize,
 		cleanupInterval:         cfg.cleanupInterval,
+		scrubInterval:           cfg.scrubInterval,
 		allocChecker:            allocChecker,
 		lastCompactionCall:      now,
 		lastCleanupCall:         now,
@@ -232,6 +237,10 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 			return nil, fmt.Errorf("rename compacted segment file %q as %q: %w", entry.Name(), rightSegmentFilename, err)
 		}
 
+		if err := sg.storeNewSegmentChecksum(rightSegmentPath); err != nil {
+			return nil, fmt.Errorf("checksum segment %s: %w", rightSegmentFilename, err)
+		}
+
 		segment, err := newSegment(rightSegmentPath, logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
 			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
@@ -345,6 +354,10 @@ func (sg *SegmentGroup) makeExistsOnLower(nextSegmentIndex int) existsOnLowerSeg
 }
 
 func (sg *SegmentGroup) add(path string) error {
+	if err := sg.storeNewSegmentChecksum(path); err != nil {
+		return fmt.Errorf("checksum segment %s: %w", path, err)
+	}
+
 	sg.maintenanceLock.Lock()
 	defer sg.maintenanceLock.Unlock()
 
@@ -656,6 +669,16 @@ func (sg *SegmentGroup) compactOrCleanup(shouldAbort cyclemanager.ShouldAbortCal
 		}
 		return cleaned
 	}
+	scrub := func() bool {
+		scrubbed, err := sg.scrubOnce(shouldAbort)
+		if err != nil {
+			sg.logger.WithField("action", "lsm_segment_scrub").
+				WithField("path", sg.dir).
+				WithError(err).
+				Errorf("segment scrub failed")
+		}
+		return scrubbed
+	}
 
 	// alternatively run compaction or cleanup first
 	// if 1st one called succeeds, 2nd one is skipped, otherwise 2nd one is called as well
@@ -664,12 +687,14 @@ func (sg *SegmentGroup) compactOrCleanup(shouldAbort cyclemanager.ShouldAbortCal
 	// was not called for over [forceCleanupInterval], force at least one execution
 	// in between compactions.
 	// (ignore if compaction was not called within that time either)
+	//
+	// scrubbing only runs if there was nothing to compact or clean up
 	forceCleanupInterval := time.Hour * 12
 
 	if time.Since(sg.lastCleanupCall) > forceCleanupInterval && sg.lastCleanupCall.Before(sg.lastCompactionCall) {
-		return cleanup() || compact()
+		return cleanup() || compact() || scrub()
 	}
-	return compact() || cleanup()
+	return compact() || cleanup() || scrub()
 }
 
 func (sg *SegmentGroup) Len() int {
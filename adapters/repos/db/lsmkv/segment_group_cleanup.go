@@ -461,6 +461,11 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 	}
 
 	oldSegment := c.sg.segmentAtPos(candidateIdx)
+	if oldSegment.quarantined {
+		// a quarantined segment is not rewritten, mark it as cleaned so that
+		// the next cleanup moves on to other segments
+		return false, onCompleted(oldSegment.size)
+	}
 	segmentId := segmentID(oldSegment.path)
 	tmpSegmentPath := filepath.Join(c.sg.dir, "segment-"+segmentId+".db.tmp")
 	scratchSpacePath := oldSegment.path + "cleanup.scratch.d"
@@ -574,7 +579,7 @@ func (sg *SegmentGroup) replaceSegment(segmentIdx int, tmpSegmentPath string,
 	countNetAdditions := oldSegment.countNetAdditions
 
 	precomputedFiles, err := preComputeSegmentMeta(tmpSegmentPath, countNetAdditions,
-		sg.logger, sg.useBloomFilter, sg.calcCountNetAdditions)
+		sg.logger, sg.useBloomFilter, sg.calcCountNetAdditions, sg.scrubEnabled())
 	if err != nil {
 		return nil, fmt.Errorf("precompute segment meta: %w", err)
 	}
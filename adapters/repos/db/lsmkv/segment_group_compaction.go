@@ -90,7 +90,7 @@ func (sg *SegmentGroup) findCompactionCandidates() (pair []int, level uint16) {
 		left, right := sg.segments[leftId], sg.segments[leftId+1]
 
 		if left.level == right.level {
-			if sg.compactionFitsSizeLimit(left, right) {
+			if sg.compactionFitsSizeLimit(left, right) && !isQuarantined(left, right) {
 				// max size not exceeded
 				matchingPairFound = true
 				matchingLeftId = leftId
@@ -108,7 +108,8 @@ func (sg *SegmentGroup) findCompactionCandidates() (pair []int, level uint16) {
 			}
 			if sg.compactLeftOverSegments && !leftoverPairFound {
 				// eftover segments enabled, none leftover pair found yet
-				if sg.compactionFitsSizeLimit(left, right) && isSimilarSegmentSizes(left.size, right.size) {
+				if sg.compactionFitsSizeLimit(left, right) && !isQuarantined(left, right) &&
+					isSimilarSegmentSizes(left.size, right.size) {
 					// max size not exceeded, segment sizes similar despite different levels
 					leftoverPairFound = true
 					leftoverLeftId = leftId
@@ -127,6 +128,12 @@ func (sg *SegmentGroup) findCompactionCandidates() (pair []int, level uint16) {
 	return nil, 0
 }
 
+// quarantined segments are kept as they are, as compacting them would spread
+// their corruption to the compacted segment
+func isQuarantined(left, right *segment) bool {
+	return left.quarantined || right.quarantined
+}
+
 func isSimilarSegmentSizes(leftSize, rightSize int64) bool {
 	MiB := int64(1024 * 1024)
 	GiB := 1024 * MiB
@@ -352,7 +359,7 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	// WIP: we could add a random suffix to the tmp file to avoid conflicts
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
 		updatedCountNetAdditions, sg.logger,
-		sg.useBloomFilter, sg.calcCountNetAdditions)
+		sg.useBloomFilter, sg.calcCountNetAdditions, sg.scrubEnabled())
 	if err != nil {
 		return fmt.Errorf("precompute segment meta: %w", err)
 	}
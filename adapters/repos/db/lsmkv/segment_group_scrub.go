@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func (sg *SegmentGroup) scrubEnabled() bool {
+	return sg.scrubInterval > 0
+}
+
+// storeNewSegmentChecksum stores the checksum of a segment file which was
+// just written. With scrubbing disabled, it instead removes the checksum of
+// a previous, incomplete segment of the same name, so that it can not be
+// mistaken for corruption once scrubbing is enabled.
+func (sg *SegmentGroup) storeNewSegmentChecksum(path string) error {
+	if !sg.scrubEnabled() {
+		return os.RemoveAll(segmentChecksumPath(path))
+	}
+
+	sum, _, err := segmentFileChecksum(path, nil)
+	if err != nil {
+		return err
+	}
+	return storeSegmentChecksum(segmentChecksumPath(path), sum)
+}
+
+// scrubOnce verifies the checksum of the segment which was verified the
+// longest time ago, so that every segment is verified once per scrub
+// interval. Scrubs are spread evenly over the interval rather than verifying
+// all segments at once.
+//
+// It runs as part of the compaction cycle, so the segment can't be compacted
+// or cleaned up while it is read.
+func (sg *SegmentGroup) scrubOnce(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	if !sg.scrubEnabled() || sg.isReadyOnly() {
+		return false, nil
+	}
+
+	seg := sg.findScrubCandidate()
+	if seg == nil {
+		return false, nil
+	}
+
+	sum, aborted, err := segmentFileChecksum(seg.path, shouldAbort)
+	if err != nil {
+		return false, fmt.Errorf("checksum segment %q: %w", seg.path, err)
+	}
+	if aborted {
+		return false, nil
+	}
+	sg.lastScrubCall = time.Now()
+	seg.lastScrubbed = sg.lastScrubCall
+
+	expected, err := loadSegmentChecksum(seg.checksumPath())
+	if errors.Is(err, os.ErrNotExist) {
+		// the segment was written while scrubbing was disabled, from now on it
+		// is verified against its current state
+		if err := storeSegmentChecksum(seg.checksumPath(), sum); err != nil {
+			return false, fmt.Errorf("store checksum of segment %q: %w", seg.path, err)
+		}
+		return true, nil
+	}
+	if err != nil && !errors.Is(err, ErrInvalidChecksum) {
+		return false, fmt.Errorf("load checksum of segment %q: %w", seg.path, err)
+	}
+
+	sg.metrics.SegmentScrubbed(sg.strategy)
+	if err != nil {
+		// the checksum file is only ever written once, so it rotted on the same
+		// disk as the segment
+		return true, sg.quarantine(seg, "checksum file is corrupted")
+	}
+	if sum != expected {
+		return true, sg.quarantine(seg,
+			fmt.Sprintf("checksum mismatch: expected %08x, got %08x", expected, sum))
+	}
+	return true, nil
+}
+
+func (sg *SegmentGroup) findScrubCandidate() *segment {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	if len(sg.segments) == 0 ||
+		time.Since(sg.lastScrubCall) < sg.scrubInterval/time.Duration(len(sg.segments)) {
+		return nil
+	}
+
+	var candidate *segment
+	for _, seg := range sg.segments {
+		if seg.quarantined {
+			continue
+		}
+		if candidate == nil || seg.lastScrubbed.Before(candidate.lastScrubbed) {
+			candidate = seg
+		}
+	}
+
+	if candidate == nil || time.Since(candidate.lastScrubbed) < sg.scrubInterval {
+		return nil
+	}
+	return candidate
+}
+
+// quarantine excludes a corrupted segment from compaction and cleanup, so
+// that the corruption does not spread to the segments they write. The
+// segment keeps serving reads, as dropping it would silently lose its data.
+// The shard should be restored from a replica or a backup.
+func (sg *SegmentGroup) quarantine(seg *segment, reason string) error {
+	seg.quarantined = true
+	sg.metrics.SegmentCorrupted(sg.strategy)
+
+	sg.logger.WithFields(logrus.Fields{
+		"action": "lsm_segment_scrub",
+		"path":   seg.path,
+		"reason": reason,
+	}).Error("segment is corrupted and was quarantined, restore the shard " +
+		"from a replica or backup")
+
+	// the marker keeps the segment quarantined across restarts
+	f, err := os.Create(seg.quarantinePath())
+	if err != nil {
+		return fmt.Errorf("mark segment %q quarantined: %w", seg.path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestSegmentGroupScrub(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	noAbort := func() bool { return false }
+
+	newBucket := func(t *testing.T, dir string, opts ...BucketOption) *Bucket {
+		opts = append([]BucketOption{WithStrategy(StrategyReplace)}, opts...)
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.Nil(t, err)
+		return b
+	}
+	flushSegments := func(t *testing.T, b *Bucket, count int) {
+		for i := 0; i < count; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+			require.Nil(t, b.FlushMemtable())
+		}
+	}
+	// makes all segments due for their next scrub
+	resetScrubs := func(sg *SegmentGroup) {
+		sg.lastScrubCall = time.Time{}
+		for _, seg := range sg.segments {
+			seg.lastScrubbed = time.Time{}
+		}
+	}
+
+	t.Run("no checksums are written with scrubbing disabled", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newBucket(t, dir)
+		defer b.Shutdown(ctx)
+		flushSegments(t, b, 1)
+
+		files, err := os.ReadDir(dir)
+		require.Nil(t, err)
+		_, ok := findFileWithExt(files, ".checksum")
+		assert.False(t, ok)
+
+		scrubbed, err := b.disk.scrubOnce(noAbort)
+		require.Nil(t, err)
+		assert.False(t, scrubbed)
+	})
+
+	t.Run("intact segments are verified once per interval", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newBucket(t, dir, WithSegmentsScrubInterval(time.Hour))
+		defer b.Shutdown(ctx)
+		flushSegments(t, b, 2)
+
+		for _, seg := range b.disk.segments {
+			ok, err := fileExists(seg.checksumPath())
+			require.Nil(t, err)
+			assert.True(t, ok)
+		}
+
+		scrubbed, err := b.disk.scrubOnce(noAbort)
+		require.Nil(t, err)
+		assert.True(t, scrubbed)
+
+		// the second segment is only due after half of the interval
+		scrubbed, err = b.disk.scrubOnce(noAbort)
+		require.Nil(t, err)
+		assert.False(t, scrubbed)
+
+		b.disk.lastScrubCall = time.Time{}
+		scrubbed, err = b.disk.scrubOnce(noAbort)
+		require.Nil(t, err)
+		assert.True(t, scrubbed)
+
+		b.disk.lastScrubCall = time.Time{}
+		scrubbed, err = b.disk.scrubOnce(noAbort)
+		require.Nil(t, err)
+		assert.False(t, scrubbed, "all segments were verified within the interval")
+
+		for _, seg := range b.disk.segments {
+			assert.False(t, seg.quarantined)
+		}
+	})
+
+	t.Run("segments without checksum get one on their first scrub", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newBucket(t, dir)
+		flushSegments(t, b, 1)
+		require.Nil(t, b.Shutdown(ctx))
+
+		b = newBucket(t, dir, WithSegmentsScrubInterval(time.Hour))
+		defer b.Shutdown(ctx)
+		seg := b.disk.segments[0]
+
+		scrubbed, err := b.disk.scrubOnce(noAbort)
+		require.Nil(t, err)
+		assert.True(t, scrubbed)
+		_, err = loadSegmentChecksum(seg.checksumPath())
+		require.Nil(t, err)
+		assert.False(t, seg.quarantined)
+	})
+
+	t.Run("aborted scrub", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newBucket(t, dir, WithSegmentsScrubInterval(time.Hour))
+		defer b.Shutdown(ctx)
+		flushSegments(t, b, 1)
+
+		scrubbed, err := b.disk.scrubOnce(func() bool { return true })
+		require.Nil(t, err)
+		assert.False(t, scrubbed)
+		assert.True(t, b.disk.segments[0].lastScrubbed.IsZero())
+	})
+
+	t.Run("corrupted segments are quarantined", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newBucket(t, dir, WithSegmentsScrubInterval(time.Hour))
+		flushSegments(t, b, 2)
+
+		corrupted := b.disk.segments[1]
+		f, err := os.OpenFile(corrupted.path, os.O_RDWR, 0o666)
+		require.Nil(t, err)
+		_, err = f.WriteAt([]byte{0xff}, segmentindex.HeaderSize)
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		for i := 0; i < 2; i++ {
+			b.disk.lastScrubCall = time.Time{}
+			scrubbed, err := b.disk.scrubOnce(noAbort)
+			require.Nil(t, err)
+			require.True(t, scrubbed)
+		}
+
+		assert.False(t, b.disk.segments[0].quarantined)
+		assert.True(t, corrupted.quarantined)
+		ok, err := fileExists(corrupted.quarantinePath())
+		require.Nil(t, err)
+		assert.True(t, ok)
+
+		pair, _ := b.disk.findCompactionCandidates()
+		assert.Nil(t, pair, "quarantined segments must not be compacted")
+
+		// quarantined segments are not scrubbed again
+		resetScrubs(b.disk)
+		scrubbed, err := b.disk.scrubOnce(noAbort)
+		require.Nil(t, err)
+		assert.True(t, scrubbed)
+		assert.True(t, corrupted.lastScrubbed.IsZero())
+
+		require.Nil(t, b.Shutdown(ctx))
+
+		t.Run("quarantine is kept across restarts", func(t *testing.T) {
+			b := newBucket(t, dir, WithSegmentsScrubInterval(time.Hour))
+			defer b.Shutdown(ctx)
+
+			require.Len(t, b.disk.segments, 2)
+			assert.False(t, b.disk.segments[0].quarantined)
+			assert.True(t, b.disk.segments[1].quarantined)
+		})
+	})
+
+	t.Run("compacted segments get a checksum", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newBucket(t, dir, WithSegmentsScrubInterval(time.Hour))
+		defer b.Shutdown(ctx)
+		flushSegments(t, b, 2)
+
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+		require.Len(t, b.disk.segments, 1)
+
+		seg := b.disk.segments[0]
+		expected, err := loadSegmentChecksum(seg.checksumPath())
+		require.Nil(t, err)
+		actual, _, err := segmentFileChecksum(seg.path, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expected, actual)
+
+		files, err := os.ReadDir(dir)
+		require.Nil(t, err)
+		_, ok := findFileWithExt(files, ".tmp")
+		assert.False(t, ok)
+		_, ok = findFileWithExt(files, DeleteMarkerSuffix)
+		assert.False(t, ok)
+	})
+}
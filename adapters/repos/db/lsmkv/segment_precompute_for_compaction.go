@@ -29,6 +29,7 @@ import (
 // segments that might have a similar name.
 func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	logger logrus.FieldLogger, useBloomFilter bool, calcCountNetAdditions bool,
+	writeChecksum bool,
 ) ([]string, error) {
 	out := []string{path}
 
@@ -136,6 +137,13 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		}
 		out = append(out, files...)
 	}
+	if writeChecksum {
+		file, err := seg.precomputeChecksum(path)
+		if err != nil {
+			return nil, fmt.Errorf("precompute checksum: %w", err)
+		}
+		out = append(out, file)
+	}
 
 	return out, nil
 }
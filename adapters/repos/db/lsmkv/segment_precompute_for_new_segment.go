@@ -14,6 +14,12 @@ package lsmkv
 import "fmt"
 
 func (sg *SegmentGroup) initAndPrecomputeNewSegment(path string) (*segment, error) {
+	// the segment file is complete at this point, so its checksum can be
+	// computed before any locks are held
+	if err := sg.storeNewSegmentChecksum(path); err != nil {
+		return nil, fmt.Errorf("checksum new segment %s: %w", path, err)
+	}
+
 	// During this entire operation we need to make sure that no compaction
 	// happens, otherwise we get a race between the existsOnLower func and
 	// the meta count init.
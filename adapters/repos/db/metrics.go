@@ -16,6 +16,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
@@ -32,6 +33,9 @@ type Metrics struct {
 	filteredVectorVector  prometheus.Observer
 	filteredVectorObjects prometheus.Observer
 	filteredVectorSort    prometheus.Observer
+	vectorCacheStats      *prometheus.GaugeVec
+	asyncReplOutOfSync    prometheus.Gauge
+	asyncReplLastInSync   prometheus.Gauge
 	grouped               bool
 	baseMetrics           *monitoring.PrometheusMetrics
 }
@@ -106,6 +110,20 @@ func NewMetrics(
 		"operation":  "sort",
 	})
 
+	m.vectorCacheStats = prom.VectorIndexCacheStats.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
+	m.asyncReplOutOfSync = prom.AsyncReplicationObjectsOutOfSync.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+	m.asyncReplLastInSync = prom.AsyncReplicationLastInSync.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	return m
 }
 
@@ -118,6 +136,20 @@ func (m *Metrics) DeleteShardLabels(class, shard string) {
 	m.baseMetrics.DeleteShard(class, shard)
 }
 
+// AsyncReplicationIteration records the outcome of a hashbeat iteration.
+// A shared value makes no sense for these, so nothing is recorded if
+// metrics are grouped.
+func (m *Metrics) AsyncReplicationIteration(objectsOutOfSync int, lastInSync time.Time) {
+	if !m.monitoring || m.grouped {
+		return
+	}
+
+	m.asyncReplOutOfSync.Set(float64(objectsOutOfSync))
+	if !lastInSync.IsZero() {
+		m.asyncReplLastInSync.Set(float64(lastInSync.Unix()))
+	}
+}
+
 func (m *Metrics) BatchObject(start time.Time, size int) {
 	took := time.Since(start)
 	m.logger.WithField("action", "batch_objects").
@@ -326,3 +358,16 @@ func (m *Metrics) FilteredVectorSort(dur time.Duration) {
 
 	m.filteredVectorSort.Observe(float64(dur) / float64(time.Millisecond))
 }
+
+// VectorCacheStats publishes the access statistics of a vector cache. They are
+// cumulative per shard, so they are not published when metrics are grouped.
+func (m *Metrics) VectorCacheStats(targetVector string, stats cache.Stats) {
+	if !m.monitoring || m.grouped {
+		return
+	}
+
+	m.vectorCacheStats.WithLabelValues(targetVector, "hits").Set(float64(stats.Hits))
+	m.vectorCacheStats.WithLabelValues(targetVector, "misses").Set(float64(stats.Misses))
+	m.vectorCacheStats.WithLabelValues(targetVector, "rejected").Set(float64(stats.Rejected))
+	m.vectorCacheStats.WithLabelValues(targetVector, "hit_rate").Set(stats.HitRate())
+}
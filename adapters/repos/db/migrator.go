@@ -20,6 +20,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
@@ -65,7 +66,7 @@ func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
 	return &Migrator{
 		db:         db,
 		logger:     logger,
-		classLocks: esync.NewKeyLocker(),
+		classLocks: esync.NewKeyLocker().WithMonitor("class", db.config.LockMonitor),
 	}
 }
 
@@ -116,6 +117,9 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMinActiveSeconds:      m.db.config.MemtablesMinActiveSeconds,
 			MemtablesMaxActiveSeconds:      m.db.config.MemtablesMaxActiveSeconds,
 			SegmentsCleanupIntervalSeconds: m.db.config.SegmentsCleanupIntervalSeconds,
+			SegmentsScrubIntervalSeconds:   m.db.config.SegmentsScrubIntervalSeconds,
+			EncryptionMasterKey:            m.db.config.EncryptionMasterKey,
+			EncryptionPreviousMasterKey:    m.db.config.EncryptionPreviousMasterKey,
 			SeparateObjectsCompactions:     m.db.config.SeparateObjectsCompactions,
 			MaxSegmentSize:                 m.db.config.MaxSegmentSize,
 			HNSWMaxLogSize:                 m.db.config.HNSWMaxLogSize,
@@ -126,9 +130,16 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			AvoidMMap:                      m.db.config.AvoidMMap,
 			DisableLazyLoadShards:          m.db.config.DisableLazyLoadShards,
 			ForceFullReplicasSearch:        m.db.config.ForceFullReplicasSearch,
+			QueryEarlyTerminationCertainty: m.db.config.QueryEarlyTerminationCertainty,
+			SoftDeleteRetention:            m.db.config.SoftDeleteRetention,
+			ObjectHistoryVersions:          m.db.config.ObjectHistoryVersions,
 			ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 			AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
 			DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
+			ReadRouting:                    m.db.config.Replication.ReadRouting,
+			ReplicationConflicts:           m.db.replicationConflicts,
+			ObjectEvents:                   m.db.objectEvents,
+			LockMonitor:                    m.db.config.LockMonitor,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
@@ -611,6 +622,8 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDISKANN:
+		return diskann.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("Invalid index type: %s", old.IndexType())
 }
@@ -674,6 +687,25 @@ func (m *Migrator) UpdateReplicationConfig(ctx context.Context, className string
 	return nil
 }
 
+// UpdateEncryptionConfig rotates the data keys of all loaded shards. Shards
+// which are not loaded rotate their keys once they are loaded.
+func (m *Migrator) UpdateEncryptionConfig(ctx context.Context, className string, cfg *models.EncryptionConfig) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update encryption config of non-existing index for %s", className)
+	}
+
+	if err := idx.updateEncryptionConfig(ctx, cfg); err != nil {
+		return fmt.Errorf("update encryption config for class %q: %w", className, err)
+	}
+	return nil
+}
+
 func (m *Migrator) RecalculateVectorDimensions(ctx context.Context) error {
 	count := 0
 	m.logger.
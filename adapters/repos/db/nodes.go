@@ -206,13 +206,14 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 		}
 
 		shardStatus := &models.NodeShardStatus{
-			Name:                 name,
-			Class:                shard.Index().Config.ClassName.String(),
-			ObjectCount:          objectCount,
-			VectorIndexingStatus: shard.GetStatus().String(),
-			VectorQueueLength:    queueLen,
-			Compressed:           compressed,
-			Loaded:               true,
+			Name:                   name,
+			Class:                  shard.Index().Config.ClassName.String(),
+			ObjectCount:            objectCount,
+			VectorIndexingStatus:   shard.GetStatus().String(),
+			VectorQueueLength:      queueLen,
+			Compressed:             compressed,
+			Loaded:                 true,
+			AsyncReplicationStatus: shard.asyncReplicationStatus(),
 		}
 		*status = append(*status, shardStatus)
 		shardCount++
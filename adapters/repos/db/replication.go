@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -34,6 +35,15 @@ import (
 	"github.com/weaviate/weaviate/usecases/replica/hashtree"
 )
 
+// replicationConflictReportSize is the number of conflicts kept for review
+const replicationConflictReportSize = 1000
+
+// ReplicationConflicts returns the most recent conflicts between replicas
+// which were not resolved silently, oldest first
+func (db *DB) ReplicationConflicts() []replica.Conflict {
+	return db.replicationConflicts.List()
+}
+
 type Replicator interface {
 	ReplicateObject(ctx context.Context, shardName, requestID string,
 		object *storobj.Object) replica.SimpleResponse
@@ -336,6 +346,43 @@ func (s *Shard) filePutter(ctx context.Context,
 	return f, nil
 }
 
+// conflictResolution returns the conflict resolution strategy of the class
+func (idx *Index) conflictResolution() string {
+	class := idx.getSchema.ReadOnlyClass(idx.Config.ClassName.String())
+	if class == nil || class.ReplicationConfig == nil {
+		return ""
+	}
+	return class.ReplicationConfig.ConflictResolution
+}
+
+// mergeStaleProperties keeps properties of the stored object which the
+// incoming, more recent, version lacks. The merged object is marked as
+// updated after the incoming one, so that it wins against the versions
+// on other replicas and eventually replaces them.
+func (idx *Index) mergeStaleProperties(shard string, incoming *models.Object,
+	stored *storobj.Object,
+) *models.Object {
+	latest, _ := incoming.Properties.(map[string]interface{})
+	stale, _ := stored.Object.Properties.(map[string]interface{})
+	merged, taken := replica.MergeProperties(latest, stale)
+	if len(taken) == 0 {
+		return incoming
+	}
+
+	obj := *incoming
+	obj.Properties = merged
+	obj.LastUpdateTimeUnix = incoming.LastUpdateTimeUnix + 1
+
+	idx.Config.ReplicationConflicts.Record(replica.Conflict{
+		Class:  idx.Config.ClassName.String(),
+		Shard:  shard,
+		ID:     incoming.ID,
+		Kind:   replica.ConflictPropertyMerge,
+		Detail: fmt.Sprintf("kept properties %v of the version updated at %d", taken, stored.LastUpdateTimeUnix()),
+	})
+	return &obj
+}
+
 // OverwriteObjects if their state didn't change in the meantime
 // It returns nil if all object have been successfully overwritten
 // and otherwise a list of failed operations.
@@ -416,6 +463,10 @@ func (idx *Index) OverwriteObjects(ctx context.Context,
 			continue
 		}
 
+		if localObj != nil && idx.conflictResolution() == models.ReplicationConfigConflictResolutionPropertyMerge {
+			incomingObj = idx.mergeStaleProperties(shard, incomingObj, localObj)
+		}
+
 		// the stored object is not the most recent version. in
 		// this case, we overwrite it with the more recent one.
 		err = s.PutObject(ctx, storobj.FromObject(incomingObj, u.Vector, u.Vectors))
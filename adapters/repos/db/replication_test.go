@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestMergeStaleProperties(t *testing.T) {
+	idx := &Index{Config: IndexConfig{
+		ClassName:            "C",
+		ReplicationConflicts: replica.NewConflictReport(10),
+	}}
+	stored := storobj.FromObject(&models.Object{
+		ID:                 "8b4e4b7a-b4a4-4bd4-9c3f-9d1c8e3a2d10",
+		Class:              "C",
+		LastUpdateTimeUnix: 10,
+		Properties:         map[string]interface{}{"title": "old", "tags": []string{"a"}},
+	}, nil, nil)
+
+	t.Run("same properties", func(t *testing.T) {
+		incoming := &models.Object{
+			ID:                 "8b4e4b7a-b4a4-4bd4-9c3f-9d1c8e3a2d10",
+			LastUpdateTimeUnix: 20,
+			Properties:         map[string]interface{}{"title": "new", "tags": []string{"b"}},
+		}
+		assert.Same(t, incoming, idx.mergeStaleProperties("S1", incoming, stored))
+		assert.Empty(t, idx.Config.ReplicationConflicts.List())
+	})
+
+	t.Run("missing properties", func(t *testing.T) {
+		incoming := &models.Object{
+			ID:                 "8b4e4b7a-b4a4-4bd4-9c3f-9d1c8e3a2d10",
+			LastUpdateTimeUnix: 20,
+			Properties:         map[string]interface{}{"title": "new"},
+		}
+		merged := idx.mergeStaleProperties("S1", incoming, stored)
+		assert.Equal(t, map[string]interface{}{"title": "new", "tags": []string{"a"}}, merged.Properties)
+		assert.Equal(t, int64(21), merged.LastUpdateTimeUnix, "merged version must win")
+		assert.Len(t, incoming.Properties, 1, "incoming object must not be modified")
+
+		conflicts := idx.Config.ReplicationConflicts.List()
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, replica.ConflictPropertyMerge, conflicts[0].Kind)
+		assert.Equal(t, "S1", conflicts[0].Shard)
+	})
+}
@@ -29,9 +29,11 @@ import (
 	"github.com/weaviate/weaviate/cluster/utils"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
+	esync "github.com/weaviate/weaviate/entities/sync"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -52,6 +54,14 @@ type DB struct {
 	startupComplete   atomic.Bool
 	resourceScanState *resourceScanState
 	memMonitor        *memwatch.Monitor
+	warmUp            warmUpState
+
+	// replicationConflicts collects conflicts between replicas which were not
+	// resolved silently
+	replicationConflicts *replica.ConflictReport
+
+	// objectEvents are passed on to the indexes, see SetObjectEvents
+	objectEvents *objects.Events
 
 	// indexLock is an RWMutex which allows concurrent access to various indexes,
 	// but only one modification at a time. R/W can be a bit confusing here,
@@ -108,6 +118,12 @@ func (db *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
 	db.schemaGetter = sg
 }
 
+// SetObjectEvents sets the events which objects deleted because they expired
+// are published to. It must be called before the indexes are loaded.
+func (db *DB) SetObjectEvents(events *objects.Events) {
+	db.objectEvents = events
+}
+
 func (db *DB) WaitForStartup(ctx context.Context) error {
 	err := db.init(ctx)
 	if err != nil {
@@ -117,6 +133,10 @@ func (db *DB) WaitForStartup(ctx context.Context) error {
 	db.startupComplete.Store(true)
 	db.scanResourceUsage()
 
+	if db.config.WarmUp.Enabled {
+		db.startWarmUp()
+	}
+
 	return nil
 }
 
@@ -144,6 +164,7 @@ func New(logger logrus.FieldLogger, config Config,
 		maxNumberGoroutines:     int(math.Round(config.MaxImportGoroutinesFactor * float64(runtime.GOMAXPROCS(0)))),
 		resourceScanState:       newResourceScanState(),
 		memMonitor:              memMonitor,
+		replicationConflicts:    replica.NewConflictReport(replicationConflictReportSize),
 	}
 
 	if db.maxNumberGoroutines == 0 {
@@ -186,6 +207,7 @@ type Config struct {
 	MemtablesMinActiveSeconds      int
 	MemtablesMaxActiveSeconds      int
 	SegmentsCleanupIntervalSeconds int
+	SegmentsScrubIntervalSeconds   int
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
@@ -198,7 +220,14 @@ type Config struct {
 	AvoidMMap                      bool
 	DisableLazyLoadShards          bool
 	ForceFullReplicasSearch        bool
+	QueryEarlyTerminationCertainty float64
+	SoftDeleteRetention            time.Duration
+	ObjectHistoryVersions          int
+	EncryptionMasterKey            []byte
+	EncryptionPreviousMasterKey    []byte
 	Replication                    replication.GlobalConfig
+	WarmUp                         config.WarmUp
+	LockMonitor                    *esync.LockMonitor
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
@@ -284,6 +313,7 @@ func (db *DB) DeleteIndex(className schema.ClassName) error {
 
 func (db *DB) Shutdown(ctx context.Context) error {
 	db.shutdown <- struct{}{}
+	db.stopWarmUp()
 
 	if !asyncEnabled() {
 		// shut down the workers that add objects to
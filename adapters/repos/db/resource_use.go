@@ -13,6 +13,8 @@ package db
 
 import (
 	"fmt"
+	"math"
+	"sync/atomic"
 	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -69,6 +71,9 @@ type resourceScanState struct {
 	diskWarning *interval.BackoffTimer
 	memWarning  *interval.BackoffTimer
 	isReadOnly  bool
+	// bits of the disk usage in percent if it is above the warning
+	// threshold, 0 otherwise
+	diskUseAboveWarning atomic.Uint64
 }
 
 func newResourceScanState() *resourceScanState {
@@ -85,10 +90,20 @@ func (db *DB) resourceUseWarn(mon *memwatch.Monitor, du diskUse, updateMappings
 	db.memUseWarn(mon)
 }
 
+// DiskUseAboveWarning returns the disk usage in percent if it is above the
+// warning threshold, so that clients can be warned before the shards are set
+// to read-only
+func (db *DB) DiskUseAboveWarning() (float64, bool) {
+	bits := db.resourceScanState.diskUseAboveWarning.Load()
+	return math.Float64frombits(bits), bits != 0
+}
+
 func (db *DB) diskUseWarn(du diskUse) {
 	diskWarnPercent := db.config.ResourceUsage.DiskUse.WarningPercentage
+	db.resourceScanState.diskUseAboveWarning.Store(0)
 	if diskWarnPercent > 0 {
 		if pu := du.percentUsed(); pu > float64(diskWarnPercent) {
+			db.resourceScanState.diskUseAboveWarning.Store(math.Float64bits(pu))
 			if db.resourceScanState.diskWarning.IntervalElapsed() {
 				db.logger.WithField("action", "read_disk_use").
 					WithField("path", db.config.RootPath).
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestDiskUseAboveWarning(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	db := &DB{
+		logger:            logger,
+		resourceScanState: newResourceScanState(),
+		config: Config{ResourceUsage: config.ResourceUsage{
+			DiskUse: config.DiskUse{WarningPercentage: 80},
+		}},
+	}
+
+	db.diskUseWarn(diskUse{total: 100, free: 30})
+	_, ok := db.DiskUseAboveWarning()
+	assert.False(t, ok)
+
+	db.diskUseWarn(diskUse{total: 100, free: 15})
+	used, ok := db.DiskUseAboveWarning()
+	assert.True(t, ok)
+	assert.Equal(t, 85.0, used)
+
+	db.diskUseWarn(diskUse{total: 100, free: 50})
+	_, ok = db.DiskUseAboveWarning()
+	assert.False(t, ok, "cleared once the usage drops")
+}
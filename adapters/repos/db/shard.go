@@ -50,6 +50,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/encryption"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -87,6 +88,8 @@ type ShardLike interface {
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
 	UpdateAsyncReplication(ctx context.Context, enabled bool) error
+	RotateEncryptionKey(ctx context.Context, version int64) error
+	asyncReplicationStatus() *models.AsyncReplicationStatus
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
 	DeleteObjectBatch(ctx context.Context, ids []strfmt.UUID, deletionTime time.Time, dryRun bool) objects.BatchSimpleObjects // Delete many objects by id
 	DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error                                           // Delete object by id
@@ -151,8 +154,9 @@ type ShardLike interface {
 	setFallbackToSearchable(fallback bool)
 	addJobToQueue(job job)
 	uuidFromDocID(docID uint64) (strfmt.UUID, error)
+	objectLock(idBytes []byte) *sync.Mutex
 	batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error
-	putObjectLSM(object *storobj.Object, idBytes []byte) (objectInsertStatus, error)
+	putObjectLSM(ctx context.Context, object *storobj.Object, idBytes []byte) (objectInsertStatus, error)
 	mayUpsertObjectHashTree(object *storobj.Object, idBytes []byte, status objectInsertStatus) error
 	mutableMergeObjectLSM(merge objects.MergeDocument, idBytes []byte) (mutableMergeResult, error)
 	batchExtendInvertedIndexItemsLSMNoFrequency(b *lsmkv.Bucket, item inverted.MergeItem) error
@@ -205,6 +209,9 @@ type Shard struct {
 	lastComparedHosts    []string
 	lastComparedHostsMux sync.RWMutex
 
+	asyncReplStatus    asyncReplicationStatus
+	asyncReplStatusMux sync.RWMutex
+
 	status              ShardStatus
 	statusLock          sync.Mutex
 	propertyIndicesLock sync.RWMutex
@@ -232,6 +239,18 @@ type Shard struct {
 	cycleCallbacks *shardCycleCallbacks
 	bitmapFactory  *roaringset.BitmapFactory
 
+	// time of the last purge of expired objects from the trash, only
+	// accessed by the trash reaper
+	lastTrashReap time.Time
+
+	// time of the last deletion of expired objects, only accessed by the
+	// object expiry
+	lastObjectExpiry time.Time
+
+	// data keys of a shard of an encrypted class, nil otherwise
+	keyring      *encryption.Keyring
+	reencryption reencryptionProgress
+
 	activityTracker atomic.Int32
 
 	// indicates whether shard is shut down or dropped (or ongoing)
@@ -278,6 +297,11 @@ func (s *Shard) uuidToIdLockPoolId(idBytes []byte) uint8 {
 	return idBytes[15] % IdLockPoolSize
 }
 
+// objectLock returns the lock writes of the object with the given id hold
+func (s *Shard) objectLock(idBytes []byte) *sync.Mutex {
+	return &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+}
+
 func (s *Shard) initHashTree(ctx context.Context) error {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 
@@ -625,6 +649,11 @@ func (s *Shard) segmentCleanupConfig() lsmkv.BucketOption {
 		time.Duration(s.index.Config.SegmentsCleanupIntervalSeconds) * time.Second)
 }
 
+func (s *Shard) segmentScrubConfig() lsmkv.BucketOption {
+	return lsmkv.WithSegmentsScrubInterval(
+		time.Duration(s.index.Config.SegmentsScrubIntervalSeconds) * time.Second)
+}
+
 func (s *Shard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error {
 	if err := s.isReadOnly(); err != nil {
 		return err
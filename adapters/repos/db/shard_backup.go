@@ -69,6 +69,14 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 		return err
 	}
 
+	keyringFile, err := s.keyringBackupFile()
+	if err != nil {
+		return fmt.Errorf("keyring: %w", err)
+	}
+	if keyringFile != "" {
+		ret.Files = append(ret.Files, keyringFile)
+	}
+
 	if s.hasTargetVectors() {
 		for targetVector, vectorIndex := range s.vectorIndexes {
 			files, err := vectorIndex.ListFiles(ctx, s.index.Config.RootPath)
@@ -0,0 +1,247 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/encryption"
+)
+
+const (
+	keyringFileName = "encryption.keyring"
+
+	// number of values re-encrypted per compaction cycle
+	reencryptBatchSize = 100
+)
+
+// encryptedBuckets hold entire objects. The inverted and vector indexes are
+// not encrypted, so the indexed property values and vectors are still stored
+// in plain.
+var encryptedBuckets = []string{
+	helpers.ObjectsBucketLSM, helpers.TrashBucketLSM, helpers.HistoryBucketLSM,
+}
+
+func encryptionEnabled(class *models.Class) bool {
+	return class.EncryptionConfig != nil && class.EncryptionConfig.Enabled
+}
+
+func (s *Shard) keyringPath() string {
+	return path.Join(s.path(), keyringFileName)
+}
+
+// initKeyring opens the keyring of a shard of an encrypted class. Every shard
+// has its own data keys, so that tenants never share a key.
+func (s *Shard) initKeyring(class *models.Class) error {
+	if !encryptionEnabled(class) {
+		return nil
+	}
+
+	// lazily loaded shards keep the class of the time the index was loaded,
+	// the key may have been rotated since
+	version := class.EncryptionConfig.KeyVersion
+	if s.index.getSchema != nil {
+		current := s.index.getSchema.ReadOnlyClass(class.Class)
+		if current != nil && current.EncryptionConfig != nil && current.EncryptionConfig.KeyVersion > version {
+			version = current.EncryptionConfig.KeyVersion
+		}
+	}
+
+	keyring, err := encryption.OpenKeyring(s.keyringPath(),
+		s.index.Config.EncryptionMasterKey, s.index.Config.EncryptionPreviousMasterKey,
+		uint32(version))
+	if err != nil {
+		return fmt.Errorf("open keyring: %w", err)
+	}
+	s.keyring = keyring
+
+	id := strings.Join([]string{"shard", s.index.ID(), s.name, "reencrypt"}, "/")
+	s.cycleCallbacks.compactionCallbacks.Register(id, s.reencryptValues)
+	return nil
+}
+
+// valueCipherOptions are only set on encryptedBuckets
+func (s *Shard) valueCipherOptions() []lsmkv.BucketOption {
+	if s.keyring == nil {
+		return nil
+	}
+	return []lsmkv.BucketOption{lsmkv.WithValueCipher(s.keyring)}
+}
+
+// RotateEncryptionKey creates a new data key for the shard. Values encrypted
+// with the previous keys are re-encrypted in the background, see
+// reencryptValues.
+func (s *Shard) RotateEncryptionKey(ctx context.Context, version int64) error {
+	if s.keyring == nil {
+		return nil
+	}
+	return s.keyring.Rotate(uint32(version))
+}
+
+// reencryptValues re-encrypts a batch of values with the current key on every
+// call while older keys exist. Once all values of the encryptedBuckets were
+// re-encrypted, the older keys are retired. The progress is not persisted,
+// after a restart the values are re-encrypted from the start.
+func (s *Shard) reencryptValues(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	if s.keyring == nil || s.keyring.Versions() < 2 || s.isReadOnly() != nil {
+		return false
+	}
+
+	current := s.keyring.Current()
+	if s.reencryption.version != current {
+		// a pass with an older key is obsolete after a rotation
+		s.reencryption = reencryptionProgress{version: current}
+	}
+
+	for s.reencryption.bucket < len(encryptedBuckets) {
+		name := encryptedBuckets[s.reencryption.bucket]
+		bucket := s.store.Bucket(name)
+		if bucket != nil {
+			done, err := s.reencryptBatch(name, bucket, shouldAbort)
+			if err != nil {
+				s.encryptionLogger().WithField("bucket", name).WithError(err).
+					Error("failed to re-encrypt values")
+				return true
+			}
+			if !done {
+				return true
+			}
+		}
+		s.reencryption.bucket++
+		s.reencryption.position = nil
+	}
+
+	// the re-encrypted values are only in the memtables so far. They have to
+	// be on disk before the old keys are dropped, otherwise a crash would
+	// leave only the values sealed with the dropped keys behind.
+	if err := s.flushEncryptedBuckets(); err != nil {
+		s.encryptionLogger().WithError(err).Error("failed to flush re-encrypted values")
+		return true
+	}
+	if err := s.keyring.Retire(); err != nil {
+		s.encryptionLogger().WithError(err).Error("failed to retire old data keys")
+		return true
+	}
+	s.reencryption = reencryptionProgress{}
+	s.encryptionLogger().WithField("key_version", current).
+		Info("re-encrypted all values, old data keys were retired")
+	return true
+}
+
+func (s *Shard) flushEncryptedBuckets() error {
+	for _, name := range encryptedBuckets {
+		bucket := s.store.Bucket(name)
+		if bucket == nil {
+			continue
+		}
+		if err := bucket.WriteWAL(); err != nil {
+			return fmt.Errorf("write wal of bucket %q: %w", name, err)
+		}
+		if err := bucket.FlushAndSwitch(); err != nil {
+			return fmt.Errorf("flush bucket %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// reencryptBatch reports whether the end of the bucket was reached
+func (s *Shard) reencryptBatch(name string, bucket *lsmkv.Bucket,
+	shouldAbort cyclemanager.ShouldAbortCallback,
+) (bool, error) {
+	// the keys are collected first, as buckets must not be written to while
+	// a cursor is open on them. They are copied, as the cursor reuses them.
+	keys := make([][]byte, 0, reencryptBatchSize)
+	c := bucket.Cursor()
+	k, _ := c.First()
+	if s.reencryption.position != nil {
+		k, _ = c.Seek(s.reencryption.position)
+		if bytes.Equal(k, s.reencryption.position) {
+			k, _ = c.Next()
+		}
+	}
+	for ; k != nil && len(keys) < reencryptBatchSize; k, _ = c.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+	c.Close()
+
+	for _, key := range keys {
+		if shouldAbort() {
+			return false, nil
+		}
+		if err := s.reencryptValue(name, bucket, key); err != nil {
+			return false, err
+		}
+		s.reencryption.position = key
+	}
+	return len(keys) < reencryptBatchSize, nil
+}
+
+// reencryptValue holds the lock of the id, so that the object can't be
+// changed or deleted in between reading and writing it
+func (s *Shard) reencryptValue(name string, bucket *lsmkv.Bucket, key []byte) error {
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(key)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	v, err := bucket.Get(key)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return nil
+	}
+
+	if name != helpers.ObjectsBucketLSM {
+		return bucket.Put(key, v)
+	}
+
+	// the secondary keys have to be written as well
+	docID, _, err := storobj.DocIDAndTimeFromBinary(v)
+	if err != nil {
+		return fmt.Errorf("get doc id from object binary: %w", err)
+	}
+	return s.upsertObjectDataLSM(bucket, key, v, docID)
+}
+
+// keyringBackupFile is the path of the keyring relative to the root path.
+// It is empty if the shard is not encrypted.
+func (s *Shard) keyringBackupFile() (string, error) {
+	if s.keyring == nil {
+		return "", nil
+	}
+	return filepath.Rel(s.index.Config.RootPath, s.keyring.Path())
+}
+
+func (s *Shard) encryptionLogger() logrus.FieldLogger {
+	return s.index.logger.WithFields(logrus.Fields{
+		"action": "reencrypt_values",
+		"shard":  s.name,
+	})
+}
+
+// reencryptionProgress is only accessed by reencryptValues
+type reencryptionProgress struct {
+	version  uint32
+	bucket   int
+	position []byte
+}
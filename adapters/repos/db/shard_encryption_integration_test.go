@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_Encryption(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{
+		Class:            "EncryptedClass",
+		EncryptionConfig: &models.EncryptionConfig{Enabled: true},
+	}
+	masterKey := bytes.Repeat([]byte{7}, 32)
+
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false,
+		func(i *Index) { i.Config.EncryptionMasterKey = masterKey })
+	defer idx.drop()
+
+	// more objects than a single re-encryption batch
+	objs := createRandomObjects(getRandomSeed(), class.Class, reencryptBatchSize+10, 16)
+	for _, obj := range objs {
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+	shard := shd.(*LazyLoadShard).shard
+
+	noAbort := func() bool { return false }
+	reencrypt := func(t *testing.T) {
+		for i := 0; i < 100 && shard.keyring.Versions() > 1; i++ {
+			shard.reencryptValues(noAbort)
+		}
+		require.Equal(t, 1, shard.keyring.Versions())
+	}
+	assertReadable := func(t *testing.T, objs ...*storobj.Object) {
+		for _, obj := range objs {
+			res, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, obj.ID(), res.ID())
+		}
+	}
+
+	t.Run("objects are not stored in plain", func(t *testing.T) {
+		require.Nil(t, shard.store.Bucket(helpers.ObjectsBucketLSM).FlushMemtable())
+		assertReadable(t, objs...)
+
+		vector := make([]byte, 0, 4*len(objs[0].Vector))
+		for _, f := range objs[0].Vector {
+			vector = binary.LittleEndian.AppendUint32(vector, math.Float32bits(f))
+		}
+		dir := path.Join(shard.pathLSM(), helpers.ObjectsBucketLSM)
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			assert.False(t, bytes.Contains(data, vector), "%s contains a vector in plain", path)
+			return nil
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("keyring is part of backups", func(t *testing.T) {
+		file, err := shard.keyringBackupFile()
+		require.Nil(t, err)
+		assert.False(t, filepath.IsAbs(file))
+		assert.True(t, strings.HasSuffix(file, filepath.Join(shard.name, keyringFileName)))
+	})
+
+	deleted := objs[len(objs)-1]
+	require.Nil(t, shd.DeleteObject(ctx, deleted.ID(), time.Time{}))
+
+	t.Run("rotated keys are retired once all objects were re-encrypted", func(t *testing.T) {
+		require.Nil(t, shd.RotateEncryptionKey(ctx, 1))
+		assert.Equal(t, uint32(1), shard.keyring.Current())
+		assert.Equal(t, 2, shard.keyring.Versions())
+
+		// objects encrypted with both keys can be read
+		require.Nil(t, shd.PutObject(ctx, objs[0]))
+		assertReadable(t, objs[:len(objs)-1]...)
+
+		reencrypt(t)
+		assertReadable(t, objs[:len(objs)-1]...)
+
+		found, err := shd.Exists(ctx, deleted.ID())
+		require.Nil(t, err)
+		assert.False(t, found, "re-encryption must not restore deleted objects")
+	})
+
+	t.Run("rotation to an older version is ignored", func(t *testing.T) {
+		require.Nil(t, shd.RotateEncryptionKey(ctx, 0))
+		assert.Equal(t, uint32(1), shard.keyring.Current())
+		assert.Equal(t, 1, shard.keyring.Versions())
+	})
+}
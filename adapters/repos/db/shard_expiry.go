@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Keys of the expiry bucket are the expiresAtUnix of an object in big endian
+// followed by its id, so that the objects which are due are the first keys
+// of the bucket. Values are empty. The entry of an object is replaced
+// whenever its expiry time changes, while holding the lock of the object.
+
+// expired objects are deleted at most this often, the compaction cycle the
+// expiry runs on is much shorter
+const objectExpiryInterval = time.Minute
+
+// the file marking that the expiry bucket indexes all objects of the shard.
+// Shards created before the bucket existed are indexed once when loaded.
+const expiryIndexedMarker = "expiry.indexed"
+
+// initObjectExpiry loads the expiry bucket and registers the deletion of
+// expired objects. It runs on the compaction cycle, which is paused while
+// the shard is transferred for a backup.
+func (s *Shard) initObjectExpiry(ctx context.Context) error {
+	_, err := os.Stat(filepath.Join(s.path(), expiryIndexedMarker))
+	indexed := err == nil
+
+	opts := []lsmkv.BucketOption{
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+	}
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.ExpiryBucketLSM, opts...); err != nil {
+		return fmt.Errorf("create expiry bucket: %w", err)
+	}
+	if !indexed {
+		if err := s.indexExpiringObjects(ctx); err != nil {
+			return fmt.Errorf("index expiring objects: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(s.path(), expiryIndexedMarker), nil, 0o666); err != nil {
+			return fmt.Errorf("mark expiring objects indexed: %w", err)
+		}
+	}
+
+	id := strings.Join([]string{"shard", s.index.ID(), s.name, "object_expiry"}, "/")
+	s.cycleCallbacks.compactionCallbacks.Register(id, s.expireObjects)
+	return nil
+}
+
+// indexExpiringObjects adds all objects with an expiry time to the expiry
+// bucket. Adding an object twice is harmless, so it simply starts over if
+// it was interrupted.
+func (s *Shard) indexExpiringObjects(ctx context.Context) error {
+	expiry := s.store.Bucket(helpers.ExpiryBucketLSM)
+	c := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		expiresAt, err := storobj.ExpiresAtFromBinary(v)
+		if err != nil {
+			return fmt.Errorf("read expiry time of object: %w", err)
+		}
+		if expiresAt == 0 {
+			continue
+		}
+		if err := expiry.Put(expiryKey(expiresAt, k), []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateExpiry replaces the entry of an object in the expiry bucket if its
+// expiry time changes. It must be called while holding the lock of the
+// object.
+func (s *Shard) updateExpiry(idBytes []byte, prevObj, obj *storobj.Object) error {
+	var prev, next int64
+	if prevObj != nil {
+		prev = prevObj.ExpiresAtUnix()
+	}
+	if obj != nil {
+		next = obj.ExpiresAtUnix()
+	}
+	if prev == next {
+		return nil
+	}
+
+	bucket := s.store.Bucket(helpers.ExpiryBucketLSM)
+	if prev != 0 {
+		if err := bucket.Delete(expiryKey(prev, idBytes)); err != nil {
+			return fmt.Errorf("delete expiry time: %w", err)
+		}
+	}
+	if next != 0 {
+		if err := bucket.Put(expiryKey(next, idBytes), []byte{}); err != nil {
+			return fmt.Errorf("put expiry time: %w", err)
+		}
+	}
+	return nil
+}
+
+// expireObjects deletes all objects whose expiry time passed. Only the due
+// entries of the expiry bucket are read.
+func (s *Shard) expireObjects(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	if time.Since(s.lastObjectExpiry) < objectExpiryInterval {
+		return false
+	}
+	if s.isReadOnly() != nil {
+		return false
+	}
+	s.lastObjectExpiry = time.Now()
+
+	// the keys are collected first, as buckets must not be written to while a
+	// cursor is open on them
+	var due [][]byte
+	now := time.Now().UnixMilli()
+	c := s.store.Bucket(helpers.ExpiryBucketLSM).Cursor()
+	for k, _ := c.First(); k != nil && expiresAtOfKey(k) <= now; k, _ = c.Next() {
+		if shouldAbort() {
+			break
+		}
+		due = append(due, append([]byte{}, k...))
+	}
+	c.Close()
+
+	deleted := false
+	for _, key := range due {
+		ok, err := s.deleteIfExpired(key)
+		if err != nil {
+			s.expiryLogger().WithField("key", key).WithError(err).
+				Error("failed to delete expired object")
+			return deleted
+		}
+		deleted = deleted || ok
+	}
+	return deleted
+}
+
+// deleteIfExpired deletes the object of a due entry of the expiry bucket.
+// It holds the lock of the object while checking the expiry time and
+// deleting the object, so that an update which extends the expiry in the
+// meantime is not lost.
+func (s *Shard) deleteIfExpired(key []byte) (bool, error) {
+	idBytes := key[8:]
+	id, err := uuid.FromBytes(idBytes)
+	if err != nil {
+		return false, fmt.Errorf("parse id of expired object: %w", err)
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	lock := s.objectLock(idBytes)
+	lock.Lock()
+	existing, err := bucket.Get(idBytes)
+	if err != nil {
+		lock.Unlock()
+		return false, fmt.Errorf("look up object: %w", err)
+	}
+	var expiresAt int64
+	if existing != nil {
+		if expiresAt, err = storobj.ExpiresAtFromBinary(existing); err != nil {
+			lock.Unlock()
+			return false, fmt.Errorf("read expiry time: %w", err)
+		}
+	}
+	if expiresAt != expiresAtOfKey(key) {
+		// the object was deleted or its expiry time changed, without the
+		// entry being dropped
+		err := s.store.Bucket(helpers.ExpiryBucketLSM).Delete(key)
+		lock.Unlock()
+		return false, err
+	}
+
+	docID, updateTime, err := storobj.DocIDAndTimeFromBinary(existing)
+	if err != nil {
+		lock.Unlock()
+		return false, fmt.Errorf("get doc id from object binary: %w", err)
+	}
+	err = s.deleteObjectDataLocked(bucket, idBytes, existing, time.Now())
+	lock.Unlock()
+	if err != nil {
+		return false, err
+	}
+	if err := s.cleanupDeletedObject(existing, idBytes, docID, updateTime); err != nil {
+		return false, err
+	}
+
+	var tenant string
+	if s.index.partitioningEnabled {
+		tenant = s.name
+	}
+	s.index.Config.ObjectEvents.Publish(objects.Event{
+		Type:   objects.EventExpire,
+		Class:  s.index.Config.ClassName.String(),
+		Tenant: tenant,
+		ID:     strfmt.UUID(id.String()),
+	})
+	return true, nil
+}
+
+func expiryKey(expiresAt int64, idBytes []byte) []byte {
+	key := make([]byte, 8+len(idBytes))
+	binary.BigEndian.PutUint64(key, uint64(expiresAt))
+	copy(key[8:], idBytes)
+	return key
+}
+
+func expiresAtOfKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key))
+}
+
+func (s *Shard) expiryLogger() logrus.FieldLogger {
+	return s.index.logger.WithFields(logrus.Fields{
+		"action": "expire_objects",
+		"shard":  s.name,
+	})
+}
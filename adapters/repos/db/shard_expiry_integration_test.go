@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShard_ObjectExpiry(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "ExpiryClass"}
+	events := objects.NewEvents()
+
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false,
+		func(i *Index) { i.Config.ObjectEvents = events })
+	defer idx.drop()
+
+	sub, cancel := events.Subscribe(10)
+	defer cancel()
+
+	noAbort := func() bool { return false }
+	expire := func() bool {
+		shard := shd.(*LazyLoadShard).shard
+		shard.lastObjectExpiry = time.Time{}
+		return shard.expireObjects(noAbort)
+	}
+	exists := func(obj *storobj.Object) bool {
+		found, err := shd.Exists(ctx, obj.ID())
+		require.Nil(t, err)
+		return found
+	}
+
+	objs := createRandomObjects(getRandomSeed(), class.Class, 3, 16)
+	require.Nil(t, shd.PutObject(ctx, objs[0]))
+
+	t.Run("objects without expiry are kept", func(t *testing.T) {
+		assert.False(t, expire())
+		assert.True(t, exists(objs[0]))
+	})
+
+	objs[1].Object.ExpiresAtUnix = time.Now().Add(time.Hour).UnixMilli()
+	objs[2].Object.ExpiresAtUnix = time.Now().Add(50 * time.Millisecond).UnixMilli()
+	require.Nil(t, shd.PutObject(ctx, objs[1]))
+	require.Nil(t, shd.PutObject(ctx, objs[2]))
+
+	t.Run("expiry time is stored", func(t *testing.T) {
+		obj, err := shd.ObjectByID(ctx, objs[1].ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, objs[1].Object.ExpiresAtUnix, obj.ExpiresAtUnix())
+	})
+
+	t.Run("objects are kept until they expire", func(t *testing.T) {
+		assert.False(t, expire())
+		assert.True(t, exists(objs[2]))
+	})
+
+	t.Run("expired objects are deleted", func(t *testing.T) {
+		time.Sleep(60 * time.Millisecond)
+
+		assert.True(t, expire())
+		assert.True(t, exists(objs[0]))
+		assert.True(t, exists(objs[1]))
+		assert.False(t, exists(objs[2]))
+
+		select {
+		case ev := <-sub:
+			assert.Equal(t, objects.EventExpire, ev.Type)
+			assert.Equal(t, class.Class, ev.Class)
+			assert.Equal(t, objs[2].ID(), ev.ID)
+		default:
+			t.Fatal("no event published for the expired object")
+		}
+	})
+
+	t.Run("extending the expiry time keeps the object", func(t *testing.T) {
+		objs[1].Object.ExpiresAtUnix = time.Now().Add(50 * time.Millisecond).UnixMilli()
+		require.Nil(t, shd.PutObject(ctx, objs[1]))
+		objs[1].Object.ExpiresAtUnix = time.Now().Add(time.Hour).UnixMilli()
+		require.Nil(t, shd.PutObject(ctx, objs[1]))
+		time.Sleep(60 * time.Millisecond)
+
+		assert.False(t, expire())
+		assert.True(t, exists(objs[1]))
+	})
+
+	t.Run("only due entries are read", func(t *testing.T) {
+		shard := shd.(*LazyLoadShard).shard
+		c := shard.store.Bucket(helpers.ExpiryBucketLSM).Cursor()
+		defer c.Close()
+		k, _ := c.First()
+		require.NotNil(t, k)
+		assert.Equal(t, objs[1].Object.ExpiresAtUnix, expiresAtOfKey(k))
+		k, _ = c.Next()
+		assert.Nil(t, k, "the entries of deleted and updated objects are dropped")
+	})
+
+	t.Run("objects of shards created before the expiry bucket are indexed", func(t *testing.T) {
+		shard := shd.(*LazyLoadShard).shard
+		expiry := shard.store.Bucket(helpers.ExpiryBucketLSM)
+		require.Nil(t, expiry.Delete(expiryKey(objs[1].Object.ExpiresAtUnix, mustIDBytes(t, objs[1]))))
+
+		require.Nil(t, shard.indexExpiringObjects(ctx))
+		v, err := expiry.Get(expiryKey(objs[1].Object.ExpiresAtUnix, mustIDBytes(t, objs[1])))
+		require.Nil(t, err)
+		assert.NotNil(t, v)
+	})
+
+	t.Run("expiry runs at most once per interval", func(t *testing.T) {
+		shard := shd.(*LazyLoadShard).shard
+		shard.lastObjectExpiry = time.Now()
+		assert.False(t, shard.expireObjects(noAbort))
+	})
+}
+
+func mustIDBytes(t *testing.T, obj *storobj.Object) []byte {
+	idBytes, err := parseBytesUUID(obj.ID())
+	require.Nil(t, err)
+	return idBytes
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/interval"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -39,7 +40,11 @@ func (s *Shard) initHashBeater() {
 			WithField("shard_name", s.name).
 			Info("hashbeater started...")
 
+		s.resetAsyncReplicationStatus(time.Now())
+
 		defer func() {
+			s.resetAsyncReplicationStatus(time.Time{})
+
 			s.index.logger.
 				WithField("action", "async_replication").
 				WithField("class_name", s.class.Class).
@@ -129,6 +134,8 @@ func (s *Shard) initHashBeater() {
 				if propagationErr == nil {
 					logEntry.Info("hashbeat iteration successfully completed")
 
+					s.recordHashBeat(time.Now(), objectsPropagated)
+
 					backoffTimer.Reset()
 
 					if objectsPropagated > 0 {
@@ -216,6 +223,68 @@ func (s *Shard) waitUntilObjectPropagationRequired(ctx context.Context) error {
 	return nil
 }
 
+// asyncReplicationStatus keeps the outcome of the most recent hashbeat
+// iterations. started is zero while no hashbeater is running.
+type asyncReplicationStatus struct {
+	started          time.Time
+	lastSync         time.Time
+	lastInSync       time.Time
+	objectsOutOfSync int
+}
+
+func (s *Shard) resetAsyncReplicationStatus(started time.Time) {
+	s.asyncReplStatusMux.Lock()
+	defer s.asyncReplStatusMux.Unlock()
+
+	s.asyncReplStatus = asyncReplicationStatus{started: started}
+}
+
+// recordHashBeat updates the status after a successful iteration. The shard
+// counts as in sync once an iteration finds nothing left to propagate.
+func (s *Shard) recordHashBeat(now time.Time, objectsPropagated int) {
+	s.asyncReplStatusMux.Lock()
+	s.asyncReplStatus.lastSync = now
+	s.asyncReplStatus.objectsOutOfSync = objectsPropagated
+	if objectsPropagated == 0 {
+		s.asyncReplStatus.lastInSync = now
+	}
+	lastInSync := s.asyncReplStatus.lastInSync
+	s.asyncReplStatusMux.Unlock()
+
+	s.metrics.AsyncReplicationIteration(objectsPropagated, lastInSync)
+}
+
+// asyncReplicationStatus returns nil if no hashbeater is running.
+func (s *Shard) asyncReplicationStatus() *models.AsyncReplicationStatus {
+	s.asyncReplStatusMux.RLock()
+	defer s.asyncReplStatusMux.RUnlock()
+
+	st := s.asyncReplStatus
+	if st.started.IsZero() {
+		return nil
+	}
+
+	status := &models.AsyncReplicationStatus{
+		ObjectsOutOfSync: int64(st.objectsOutOfSync),
+	}
+	if !st.lastSync.IsZero() {
+		status.LastSync = st.lastSync.UnixMilli()
+		if !st.lastInSync.Equal(st.lastSync) {
+			// out of sync since the shard was last found in sync, or since
+			// the hashbeater started if that never happened
+			since := st.lastInSync
+			if since.IsZero() {
+				since = st.started
+			}
+			status.LagSeconds = int64(st.lastSync.Sub(since).Seconds())
+		}
+	}
+	if !st.lastInSync.IsZero() {
+		status.LastInSync = st.lastInSync.UnixMilli()
+	}
+	return status
+}
+
 type hashBeatStats struct {
 	diffCalculationTook time.Duration
 	hostStats           []hashBeatHostStats
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncReplicationStatus(t *testing.T) {
+	s := &Shard{metrics: &Metrics{}}
+	assert.Nil(t, s.asyncReplicationStatus(), "hashbeater not running")
+
+	started := time.Now()
+	s.resetAsyncReplicationStatus(started)
+
+	status := s.asyncReplicationStatus()
+	require.NotNil(t, status)
+	assert.Zero(t, status.LastSync)
+	assert.Zero(t, status.LastInSync)
+	assert.Zero(t, status.LagSeconds)
+
+	t.Run("out of sync since start", func(t *testing.T) {
+		now := started.Add(30 * time.Second)
+		s.recordHashBeat(now, 12)
+
+		status := s.asyncReplicationStatus()
+		assert.Equal(t, int64(12), status.ObjectsOutOfSync)
+		assert.Equal(t, now.UnixMilli(), status.LastSync)
+		assert.Zero(t, status.LastInSync)
+		assert.Equal(t, int64(30), status.LagSeconds)
+	})
+
+	t.Run("in sync", func(t *testing.T) {
+		now := started.Add(40 * time.Second)
+		s.recordHashBeat(now, 0)
+
+		status := s.asyncReplicationStatus()
+		assert.Zero(t, status.ObjectsOutOfSync)
+		assert.Equal(t, now.UnixMilli(), status.LastSync)
+		assert.Equal(t, now.UnixMilli(), status.LastInSync)
+		assert.Zero(t, status.LagSeconds)
+	})
+
+	t.Run("out of sync since last in sync", func(t *testing.T) {
+		lastInSync := started.Add(40 * time.Second)
+		now := started.Add(100 * time.Second)
+		s.recordHashBeat(now, 3)
+
+		status := s.asyncReplicationStatus()
+		assert.Equal(t, int64(3), status.ObjectsOutOfSync)
+		assert.Equal(t, lastInSync.UnixMilli(), status.LastInSync)
+		assert.Equal(t, int64(60), status.LagSeconds)
+	})
+
+	s.resetAsyncReplicationStatus(time.Time{})
+	assert.Nil(t, s.asyncReplicationStatus(), "hashbeater stopped")
+}
@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// Keys of the history bucket are the id of the object followed by the
+// lastUpdateTimeUnix of the version in big endian, so that the versions of
+// an object are adjacent and ordered from the oldest to the latest. Values
+// are the binary of the version as it was stored in the objects bucket.
+
+func (s *Shard) historyEnabled() bool {
+	return s.index.Config.ObjectHistoryVersions > 0
+}
+
+func (s *Shard) initHistory(ctx context.Context) error {
+	opts := []lsmkv.BucketOption{
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+	}
+	opts = append(opts, s.valueCipherOptions()...)
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.HistoryBucketLSM, opts...); err != nil {
+		return fmt.Errorf("create history bucket: %w", err)
+	}
+	return nil
+}
+
+// keepVersion adds the version of an object which is about to be replaced to
+// its history and drops the versions beyond the configured number. It must
+// be called while holding the lock of the object.
+func (s *Shard) keepVersion(idBytes []byte, prevObj *storobj.Object) error {
+	if !s.historyEnabled() || prevObj == nil {
+		return nil
+	}
+
+	objBytes, err := prevObj.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal version of object %s: %w", prevObj.ID(), err)
+	}
+	bucket := s.store.Bucket(helpers.HistoryBucketLSM)
+	if err := bucket.Put(historyKey(idBytes, prevObj.LastUpdateTimeUnix()), objBytes); err != nil {
+		return fmt.Errorf("add version to history: %w", err)
+	}
+
+	keys := historyKeys(bucket, idBytes)
+	for _, key := range keys[:max(0, len(keys)-s.index.Config.ObjectHistoryVersions)] {
+		if err := bucket.Delete(key); err != nil {
+			return fmt.Errorf("drop version from history: %w", err)
+		}
+	}
+	return nil
+}
+
+// dropHistory deletes all versions of an object which is deleted. It must be
+// called while holding the lock of the object.
+func (s *Shard) dropHistory(idBytes []byte) error {
+	bucket := s.store.Bucket(helpers.HistoryBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+	for _, key := range historyKeys(bucket, idBytes) {
+		if err := bucket.Delete(key); err != nil {
+			return fmt.Errorf("drop version from history: %w", err)
+		}
+	}
+	return nil
+}
+
+// historyKeys returns the keys of the versions of an object, oldest first.
+// They are collected before any version is dropped, as buckets must not be
+// written to while a cursor is open on them. They are copied, as the cursor
+// reuses them.
+func historyKeys(bucket *lsmkv.Bucket, idBytes []byte) [][]byte {
+	var keys [][]byte
+	c := bucket.Cursor()
+	defer c.Close()
+	for k, _ := c.Seek(idBytes); k != nil && bytes.HasPrefix(k, idBytes); k, _ = c.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+	return keys
+}
+
+// objectHistory returns the binaries of the prior versions of an object,
+// latest first
+func objectHistory(ctx context.Context, shard ShardLike, idBytes []byte) ([][]byte, error) {
+	bucket := shard.Store().Bucket(helpers.HistoryBucketLSM)
+	if bucket == nil {
+		return nil, nil
+	}
+
+	var out [][]byte
+	c := bucket.Cursor()
+	defer c.Close()
+	for k, v := c.Seek(idBytes); k != nil && bytes.HasPrefix(k, idBytes); k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// the cursor reuses the value
+		out = append(out, append([]byte{}, v...))
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out, nil
+}
+
+func historyKey(idBytes []byte, version int64) []byte {
+	key := make([]byte, len(idBytes)+8)
+	copy(key, idBytes)
+	binary.BigEndian.PutUint64(key[len(idBytes):], uint64(version))
+	return key
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShard_History(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "HistoryClass"}
+
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false,
+		func(i *Index) { i.Config.ObjectHistoryVersions = 2 })
+	defer idx.drop()
+
+	obj := createRandomObjects(getRandomSeed(), class.Class, 1, 16)[0]
+	version := func(updated int64) *storobj.Object {
+		v := *obj
+		v.Object.LastUpdateTimeUnix = updated
+		v.Vector = []float32{float32(updated)}
+		return &v
+	}
+	for updated := int64(1); updated <= 4; updated++ {
+		require.Nil(t, shd.PutObject(ctx, version(updated)))
+	}
+
+	versionsOf := func(t *testing.T) []int64 {
+		versions, err := idx.objectHistory(ctx, obj.ID(), "")
+		require.Nil(t, err)
+		out := make([]int64, len(versions))
+		for i, v := range versions {
+			out[i] = v.LastUpdateTimeUnix()
+		}
+		return out
+	}
+
+	t.Run("only the latest prior versions are kept", func(t *testing.T) {
+		assert.Equal(t, []int64{3, 2}, versionsOf(t))
+	})
+
+	t.Run("the history can be read by other nodes", func(t *testing.T) {
+		versions, err := idx.IncomingObjectHistory(ctx, shd.Name(), obj.ID())
+		require.Nil(t, err)
+		require.Len(t, versions, 2)
+		v, err := storobj.FromBinary(versions[0])
+		require.Nil(t, err)
+		assert.Equal(t, []float32{3}, v.Vector)
+	})
+
+	t.Run("updates with an outdated If-Match fail", func(t *testing.T) {
+		ifMatch := objects.ContextWithIfMatch(ctx, objects.ParseIfMatch(objects.ETag(3)))
+		err := shd.PutObject(ifMatch, version(5))
+		assert.True(t, errors.As(err, &objects.ErrObjectChanged{}))
+		assert.Equal(t, []int64{3, 2}, versionsOf(t))
+	})
+
+	t.Run("updates with the current If-Match succeed", func(t *testing.T) {
+		ifMatch := objects.ContextWithIfMatch(ctx, objects.ParseIfMatch(objects.ETag(4)))
+		require.Nil(t, shd.PutObject(ifMatch, version(5)))
+		assert.Equal(t, []int64{4, 3}, versionsOf(t))
+	})
+
+	t.Run("deleting an object drops its history", func(t *testing.T) {
+		require.Nil(t, shd.DeleteObject(ctx, obj.ID(), time.Now()))
+		assert.Empty(t, versionsOf(t))
+
+		ifMatch := objects.ContextWithIfMatch(ctx, objects.ParseIfMatch("*"))
+		err := shd.PutObject(ifMatch, version(6))
+		assert.True(t, errors.As(err, &objects.ErrObjectChanged{}))
+	})
+}
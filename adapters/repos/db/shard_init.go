@@ -132,6 +132,12 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	s.initDimensionTracking()
 
+	if err := s.seedDimensions(); err != nil {
+		// the shard itself still rejects vectors it can't index
+		index.logger.WithField("action", "init_shard").WithField("shard", s.ID()).
+			WithError(err).Warn("could not seed vector dimensions")
+	}
+
 	if asyncEnabled() {
 		f := func() {
 			// preload unindexed objects in the background
@@ -45,6 +45,11 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return fmt.Errorf("init shard %q: lsm store: %w", s.ID(), err)
 	}
 
+	// the keyring is needed to load the encrypted buckets
+	if err := s.initKeyring(class); err != nil {
+		return fmt.Errorf("init shard %q: %w", s.ID(), err)
+	}
+
 	// the shard versioner is also dependency of some of the bucket
 	// initializations, so it also needs to happen synchronously
 	if err := s.initIndexCounterVersionerAndBitmapFactory(); err != nil {
@@ -64,6 +69,18 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return s.initProplenTracker()
 	})
 
+	if s.softDeleteEnabled() {
+		eg.Go(func() error {
+			return s.initTrash(ctx)
+		})
+	}
+
+	if s.historyEnabled() {
+		eg.Go(func() error {
+			return s.initHistory(ctx)
+		})
+	}
+
 	// geo props depend on the object bucket and we need to wait for its creation in this case
 	hasGeoProp := false
 	for _, prop := range class.Properties {
@@ -96,6 +113,11 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return fmt.Errorf("init shard %q: %w", s.ID(), err)
 	}
 
+	// the expiry bucket is indexed from the object bucket on first use
+	if err := s.initObjectExpiry(ctx); err != nil {
+		return fmt.Errorf("init shard %q: %w", s.ID(), err)
+	}
+
 	// Object bucket must be available, initHashTree depends on it
 	if s.index.asyncReplicationEnabled() {
 		err = s.initHashTree(ctx)
@@ -144,7 +166,9 @@ func (s *Shard) initObjectBucket(ctx context.Context) error {
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	}
+	opts = append(opts, s.valueCipherOptions()...)
 
 	if s.metrics != nil && !s.metrics.grouped {
 		// If metrics are grouped we cannot observe the count of an individual
@@ -97,6 +97,7 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	}
 
 	if inverted.HasFilterableIndex(prop) {
@@ -171,6 +172,7 @@ func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Prop
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	)
 }
 
@@ -186,6 +188,7 @@ func (s *Shard) createPropertyNullIndex(ctx context.Context, prop *models.Proper
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	)
 }
 
@@ -202,6 +205,7 @@ func (s *Shard) addIDProperty(ctx context.Context) error {
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	)
 	if err != nil {
 		return fmt.Errorf("create id property: %w", err)
@@ -223,6 +227,7 @@ func (s *Shard) addDimensionsProperty(ctx context.Context) error {
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	)
 	if err != nil {
 		return fmt.Errorf("create dimensions tracking property: %w", err)
@@ -256,6 +261,7 @@ func (s *Shard) addCreationTimeUnixProperty(ctx context.Context) error {
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	)
 }
 
@@ -268,5 +274,6 @@ func (s *Shard) addLastUpdateTimeUnixProperty(ctx context.Context) error {
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
+		s.segmentScrubConfig(),
 	)
 }
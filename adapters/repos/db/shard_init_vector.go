@@ -16,6 +16,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
@@ -24,6 +25,7 @@ import (
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
@@ -167,9 +169,29 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 		vectorIndex = vi
+	case vectorindex.VectorIndexTypeDISKANN:
+		diskannUserConfig, ok := vectorIndexUserConfig.(diskannent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("diskann vector index: config is not diskann.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+
+		vi, err := diskann.New(diskann.Config{
+			ID:               s.vectorIndexID(targetVector),
+			TargetVector:     targetVector,
+			RootPath:         s.path(),
+			Logger:           s.index.logger,
+			DistanceProvider: distProv,
+			AllocChecker:     s.index.allocChecker,
+		}, diskannUserConfig, s.store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: diskann index", s.ID())
+		}
+		vectorIndex = vi
 	default:
-		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
-			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
+		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\", \"%s\"]",
+			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC, vectorindex.VectorIndexTypeDISKANN)
 	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
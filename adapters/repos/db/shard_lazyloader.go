@@ -198,6 +198,16 @@ func (l *LazyLoadShard) ObjectCountAsync() int {
 	return l.shard.ObjectCountAsync()
 }
 
+func (l *LazyLoadShard) asyncReplicationStatus() *models.AsyncReplicationStatus {
+	l.mutex.Lock()
+	if !l.loaded {
+		l.mutex.Unlock()
+		return nil
+	}
+	l.mutex.Unlock()
+	return l.shard.asyncReplicationStatus()
+}
+
 func (l *LazyLoadShard) GetPropertyLengthTracker() *inverted.JsonShardMetaData {
 	l.mustLoad()
 	return l.shard.GetPropertyLengthTracker()
@@ -273,6 +283,13 @@ func (l *LazyLoadShard) UpdateAsyncReplication(ctx context.Context, enabled bool
 	return l.shard.UpdateAsyncReplication(ctx, enabled)
 }
 
+func (l *LazyLoadShard) RotateEncryptionKey(ctx context.Context, version int64) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.RotateEncryptionKey(ctx, version)
+}
+
 func (l *LazyLoadShard) AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error {
 	if err := l.Load(ctx); err != nil {
 		return []error{err}
@@ -614,6 +631,11 @@ func (l *LazyLoadShard) uuidFromDocID(docID uint64) (strfmt.UUID, error) {
 	return l.shard.uuidFromDocID(docID)
 }
 
+func (l *LazyLoadShard) objectLock(idBytes []byte) *sync.Mutex {
+	l.mustLoad()
+	return l.shard.objectLock(idBytes)
+}
+
 func (l *LazyLoadShard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error {
 	if err := l.Load(ctx); err != nil {
 		return err
@@ -621,9 +643,9 @@ func (l *LazyLoadShard) batchDeleteObject(ctx context.Context, id strfmt.UUID, d
 	return l.shard.batchDeleteObject(ctx, id, deletionTime)
 }
 
-func (l *LazyLoadShard) putObjectLSM(object *storobj.Object, idBytes []byte) (objectInsertStatus, error) {
+func (l *LazyLoadShard) putObjectLSM(ctx context.Context, object *storobj.Object, idBytes []byte) (objectInsertStatus, error) {
 	l.mustLoad()
-	return l.shard.putObjectLSM(object, idBytes)
+	return l.shard.putObjectLSM(ctx, object, idBytes)
 }
 
 func (l *LazyLoadShard) mayUpsertObjectHashTree(object *storobj.Object, idBytes []byte, status objectInsertStatus) error {
@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -358,6 +359,21 @@ func (s *Shard) VectorDistanceForQuery(ctx context.Context, docId uint64, search
 	return distances, nil
 }
 
+// publishVectorCacheStats reports the hit rate of the vector cache. Only
+// caches with an admission policy collect statistics.
+func (s *Shard) publishVectorCacheStats(targetVector string) {
+	statser, ok := s.getVectorIndex(targetVector).(interface{ VectorCacheStats() cache.Stats })
+	if !ok {
+		return
+	}
+
+	stats := statser.VectorCacheStats()
+	if stats.Hits+stats.Misses == 0 {
+		return
+	}
+	s.metrics.VectorCacheStats(targetVector, stats)
+}
+
 func (s *Shard) getIndexQueue(targetVector string) (*IndexQueue, error) {
 	if s.hasTargetVectors() {
 		if targetVector == "" {
@@ -454,6 +470,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 					return err
 				}
 			}
+			s.publishVectorCacheStats(targetVector)
 			if len(ids) == 0 {
 				return nil
 			}
@@ -560,6 +577,9 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	out := make([]*storobj.Object, c.Limit)
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		obj, err := storobj.FromBinary(val)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
@@ -657,13 +677,8 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionT
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
-	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
+	if err := s.deleteObjectData(bucket, idBytes, existing, deletionTime); err != nil {
+		return err
 	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
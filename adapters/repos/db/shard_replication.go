@@ -82,11 +82,16 @@ func (s *Shard) preparePutObject(ctx context.Context, requestID string, object *
 			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
 		}}}
 	}
+	withIfMatch := ifMatchOf(ctx)
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
-		if err := s.putOne(ctx, uuid, object); err != nil {
+		if err := s.putOne(withIfMatch(ctx), uuid, object); err != nil {
+			var code replica.StatusCode = replica.StatusConflict
+			if errors.As(err, &objects.ErrObjectChanged{}) {
+				code = replica.StatusObjectChanged
+			}
 			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
+				{Code: code, Msg: err.Error()},
 			}
 		}
 		return resp
@@ -102,12 +107,15 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 			{Code: replica.StatusPreconditionFailed, Msg: err.Error()},
 		}}
 	}
+	withIfMatch := ifMatchOf(ctx)
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
-		if err := s.merge(ctx, uuid, *doc); err != nil {
+		if err := s.merge(withIfMatch(ctx), uuid, *doc); err != nil {
 			var code replica.StatusCode
 			if errors.Is(err, errObjectNotFound) {
 				code = replica.StatusObjectNotFound
+			} else if errors.As(err, &objects.ErrObjectChanged{}) {
+				code = replica.StatusObjectChanged
 			} else {
 				code = replica.StatusConflict
 			}
@@ -121,6 +129,18 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 	return replica.SimpleResponse{}
 }
 
+// ifMatchOf returns a function which adds the precondition of a conditional
+// update in the context of the prepare request to the context of the commit
+func ifMatchOf(ctx context.Context) func(context.Context) context.Context {
+	m, ok := objects.IfMatchFromContext(ctx)
+	return func(ctx context.Context) context.Context {
+		if !ok {
+			return ctx
+		}
+		return objects.ContextWithIfMatch(ctx, m)
+	}
+}
+
 func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid strfmt.UUID, deletionTime time.Time) replica.SimpleResponse {
 	bucket, obj, idBytes, docID, updateTime, err := s.canDeleteOne(ctx, uuid)
 	if err != nil {
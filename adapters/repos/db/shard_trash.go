@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// expired objects are purged from the trash at most this often, the
+// compaction cycle the reaper runs on is much shorter
+const trashReapInterval = time.Minute
+
+// trashedObject is an object which was soft deleted. Entries of the trash
+// bucket are the deletion time in unix millis followed by the binary of the
+// object as it was stored in the objects bucket.
+type trashedObject struct {
+	object       *storobj.Object
+	deletionTime time.Time
+}
+
+func (s *Shard) softDeleteEnabled() bool {
+	return s.index.Config.SoftDeleteRetention > 0
+}
+
+func (s *Shard) initTrash(ctx context.Context) error {
+	opts := []lsmkv.BucketOption{
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+	}
+	opts = append(opts, s.valueCipherOptions()...)
+	err := s.store.CreateOrLoadBucket(ctx, helpers.TrashBucketLSM, opts...)
+	if err != nil {
+		return fmt.Errorf("create trash bucket: %w", err)
+	}
+
+	// the shard callbacks are unregistered on shutdown before the store is
+	// shut down, so the reaper never runs on a closed bucket
+	id := strings.Join([]string{"shard", s.index.ID(), s.name, "trash_reaper"}, "/")
+	s.cycleCallbacks.compactionCallbacks.Register(id, s.reapTrash)
+	return nil
+}
+
+// moveToTrash keeps the binary of an object which is about to be deleted,
+// so that it can be restored until the retention expires
+func (s *Shard) moveToTrash(idBytes, objBytes []byte, deletionTime time.Time) error {
+	if !s.softDeleteEnabled() {
+		return nil
+	}
+	if deletionTime.IsZero() {
+		deletionTime = time.Now()
+	}
+
+	value := trashEntry(objBytes, deletionTime)
+	if err := s.store.Bucket(helpers.TrashBucketLSM).Put(idBytes, value); err != nil {
+		return fmt.Errorf("move object to trash: %w", err)
+	}
+	return nil
+}
+
+// removeFromTrash is called whenever an object is written. Once an object
+// exists again, either because it was restored or because it was created
+// anew, its trashed version is obsolete.
+func (s *Shard) removeFromTrash(idBytes []byte) error {
+	if !s.softDeleteEnabled() {
+		return nil
+	}
+
+	bucket := s.store.Bucket(helpers.TrashBucketLSM)
+	existing, err := bucket.Get(idBytes)
+	if err != nil {
+		return fmt.Errorf("look up object in trash: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+	if err := bucket.Delete(idBytes); err != nil {
+		return fmt.Errorf("remove object from trash: %w", err)
+	}
+	return nil
+}
+
+func (s *Shard) reapTrash(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	if time.Since(s.lastTrashReap) < trashReapInterval {
+		return false
+	}
+	s.lastTrashReap = time.Now()
+
+	bucket := s.store.Bucket(helpers.TrashBucketLSM)
+	expiry := time.Now().Add(-s.index.Config.SoftDeleteRetention)
+
+	// the keys are collected first, as buckets must not be written to while
+	// a cursor is open on them. They are copied, as the cursor reuses them.
+	var expired [][]byte
+	c := bucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if shouldAbort() {
+			break
+		}
+		if len(v) < 8 || trashDeletionTime(v).Before(expiry) {
+			expired = append(expired, append([]byte{}, k...))
+		}
+	}
+	c.Close()
+
+	for _, key := range expired {
+		if err := bucket.Delete(key); err != nil {
+			s.index.logger.WithFields(logrus.Fields{
+				"action": "reap_trash",
+				"shard":  s.name,
+			}).WithError(err).Error("failed to purge object from trash")
+			return true
+		}
+	}
+	return len(expired) > 0
+}
+
+// trashedObjects returns the objects in the trash of a shard which did
+// not expire yet
+func trashedObjects(ctx context.Context, shard ShardLike, retention time.Duration,
+) ([]*trashedObject, error) {
+	bucket := shard.Store().Bucket(helpers.TrashBucketLSM)
+	if bucket == nil {
+		return nil, nil
+	}
+	expiry := time.Now().Add(-retention)
+
+	var out []*trashedObject
+	c := bucket.Cursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// the cursor reuses the value
+		obj, err := parseTrashEntry(append([]byte{}, v...), expiry)
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+// trashedObjectByID returns the object from the trash of a shard or nil if it
+// is not in the trash or expired
+func trashedObjectByID(shard ShardLike, idBytes []byte, retention time.Duration,
+) (*trashedObject, error) {
+	bucket := shard.Store().Bucket(helpers.TrashBucketLSM)
+	if bucket == nil {
+		return nil, nil
+	}
+
+	v, err := bucket.Get(idBytes)
+	if err != nil {
+		return nil, fmt.Errorf("look up object in trash: %w", err)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return parseTrashEntry(v, time.Now().Add(-retention))
+}
+
+func trashEntry(objBytes []byte, deletionTime time.Time) []byte {
+	value := make([]byte, 8+len(objBytes))
+	binary.LittleEndian.PutUint64(value, uint64(deletionTime.UnixMilli()))
+	copy(value[8:], objBytes)
+	return value
+}
+
+// entry returns the object in the format of the trash bucket
+func (o *trashedObject) entry() ([]byte, error) {
+	objBytes, err := o.object.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal trashed object: %w", err)
+	}
+	return trashEntry(objBytes, o.deletionTime), nil
+}
+
+func parseTrashEntry(v []byte, expiry time.Time) (*trashedObject, error) {
+	if len(v) < 8 {
+		return nil, fmt.Errorf("invalid trash entry of length %d", len(v))
+	}
+	deletionTime := trashDeletionTime(v)
+	if deletionTime.Before(expiry) {
+		// not purged yet
+		return nil, nil
+	}
+
+	obj, err := storobj.FromBinary(v[8:])
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal trashed object: %w", err)
+	}
+	return &trashedObject{object: obj, deletionTime: deletionTime}, nil
+}
+
+func trashDeletionTime(v []byte) time.Time {
+	return time.UnixMilli(int64(binary.LittleEndian.Uint64(v)))
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_Trash(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "TrashClass"}
+
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false,
+		func(i *Index) { i.Config.SoftDeleteRetention = time.Hour })
+	defer idx.drop()
+
+	objects := createRandomObjects(getRandomSeed(), class.Class, 3, 16)
+	for _, obj := range objects {
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+
+	deletionTime := time.Now()
+	require.Nil(t, shd.DeleteObject(ctx, objects[0].ID(), deletionTime))
+	res := shd.DeleteObjectBatch(ctx, []strfmt.UUID{objects[1].ID()}, time.Time{}, false)
+	require.Nil(t, res[0].Err)
+
+	t.Run("deleted objects are in the trash", func(t *testing.T) {
+		trashed, err := idx.trashedObjects(ctx, "")
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(trashed))
+		for i, obj := range trashed {
+			ids[i] = obj.object.ID()
+		}
+		assert.ElementsMatch(t, []strfmt.UUID{objects[0].ID(), objects[1].ID()}, ids)
+
+		obj, err := idx.trashedObjectByID(ctx, objects[0].ID(), "")
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, objects[0].Vector, obj.object.Vector)
+		assert.Equal(t, deletionTime.UnixMilli(), obj.deletionTime.UnixMilli())
+
+		obj, err = idx.trashedObjectByID(ctx, objects[2].ID(), "")
+		require.Nil(t, err)
+		assert.Nil(t, obj)
+	})
+
+	t.Run("the trash can be read by other nodes", func(t *testing.T) {
+		entries, err := idx.IncomingTrashEntries(ctx, shd.Name(), "")
+		require.Nil(t, err)
+		assert.Len(t, entries, 2)
+
+		entries, err = idx.IncomingTrashEntries(ctx, shd.Name(), objects[1].ID())
+		require.Nil(t, err)
+		require.Len(t, entries, 1)
+		obj, err := parseTrashEntry(entries[0], time.Time{})
+		require.Nil(t, err)
+		assert.Equal(t, objects[1].ID(), obj.object.ID())
+		assert.Equal(t, objects[1].Vector, obj.object.Vector)
+	})
+
+	t.Run("writing an object removes it from the trash", func(t *testing.T) {
+		obj, err := idx.trashedObjectByID(ctx, objects[0].ID(), "")
+		require.Nil(t, err)
+		require.Nil(t, shd.PutObject(ctx, obj.object))
+
+		obj, err = idx.trashedObjectByID(ctx, objects[0].ID(), "")
+		require.Nil(t, err)
+		assert.Nil(t, obj)
+
+		restored, err := shd.ObjectByID(ctx, objects[0].ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, restored)
+		assert.Equal(t, objects[0].Vector, restored.Vector)
+	})
+
+	t.Run("expired objects are purged", func(t *testing.T) {
+		idx.Config.SoftDeleteRetention = time.Nanosecond
+		// deletion times are kept in millis
+		time.Sleep(2 * time.Millisecond)
+
+		trashed, err := idx.trashedObjects(ctx, "")
+		require.Nil(t, err)
+		assert.Empty(t, trashed)
+
+		purged := shd.(*LazyLoadShard).shard.reapTrash(func() bool { return false })
+		assert.True(t, purged)
+
+		idx.Config.SoftDeleteRetention = time.Hour
+		trashed, err = idx.trashedObjects(ctx, "")
+		require.Nil(t, err)
+		assert.Empty(t, trashed)
+	})
+}
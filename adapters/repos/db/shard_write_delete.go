@@ -53,13 +53,8 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 		return fmt.Errorf("get existing doc id from object binary: %w", err)
 	}
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
-	if err != nil {
-		return fmt.Errorf("delete object from bucket: %w", err)
+	if err := s.deleteObjectData(bucket, idBytes, existing, deletionTime); err != nil {
+		return err
 	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
@@ -127,18 +122,16 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 		return nil
 	}
 
-	var err error
-
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
-	if err != nil {
-		return fmt.Errorf("delete object from bucket: %w", err)
+	if err := s.deleteObjectData(bucket, idBytes, obj, deletionTime); err != nil {
+		return err
 	}
+	return s.cleanupDeletedObject(obj, idBytes, docID, currentUpdateTime)
+}
 
-	err = s.cleanupInvertedIndexOnDelete(obj, docID)
+// cleanupDeletedObject removes an object from the indexes once its data was
+// deleted
+func (s *Shard) cleanupDeletedObject(obj, idBytes []byte, docID uint64, currentUpdateTime int64) error {
+	err := s.cleanupInvertedIndexOnDelete(obj, docID)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
@@ -174,6 +167,51 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	return nil
 }
 
+// deleteObjectData moves the object to the trash, drops its history and
+// deletes it from the objects bucket. It holds the lock of the id, so that
+// the deletion can't interleave with the re-encryption of the object, which
+// would restore it.
+func (s *Shard) deleteObjectData(bucket *lsmkv.Bucket, idBytes, existing []byte,
+	deletionTime time.Time,
+) error {
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	return s.deleteObjectDataLocked(bucket, idBytes, existing, deletionTime)
+}
+
+// deleteObjectDataLocked is deleteObjectData for callers already holding
+// the lock of the id
+func (s *Shard) deleteObjectDataLocked(bucket *lsmkv.Bucket, idBytes, existing []byte,
+	deletionTime time.Time,
+) error {
+	if err := s.moveToTrash(idBytes, existing, deletionTime); err != nil {
+		return err
+	}
+	if err := s.dropHistory(idBytes); err != nil {
+		return err
+	}
+	if expiresAt, err := storobj.ExpiresAtFromBinary(existing); err != nil {
+		return fmt.Errorf("read expiry time: %w", err)
+	} else if expiresAt != 0 {
+		if err := s.store.Bucket(helpers.ExpiryBucketLSM).Delete(expiryKey(expiresAt, idBytes)); err != nil {
+			return fmt.Errorf("delete expiry time: %w", err)
+		}
+	}
+
+	var err error
+	if deletionTime.IsZero() {
+		err = bucket.Delete(idBytes)
+	} else {
+		err = bucket.DeleteWith(idBytes, deletionTime)
+	}
+	if err != nil {
+		return fmt.Errorf("delete object from bucket: %w", err)
+	}
+	return nil
+}
+
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
 	previousObject, err := storobj.FromBinary(previous)
 	if err != nil {
@@ -62,7 +62,7 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 }
 
 func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocument) error {
-	obj, status, err := s.mergeObjectInStorage(doc, idBytes)
+	obj, status, err := s.mergeObjectInStorage(ctx, doc, idBytes)
 	if err != nil {
 		return err
 	}
@@ -100,7 +100,7 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 	return nil
 }
 
-func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
+func (s *Shard) mergeObjectInStorage(ctx context.Context, merge objects.MergeDocument,
 	idBytes []byte,
 ) (*storobj.Object, objectInsertStatus, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
@@ -121,6 +121,9 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		if err != nil {
 			return errors.Wrap(err, "get bucket")
 		}
+		if err := checkIfMatch(ctx, merge.ID, prevObj); err != nil {
+			return err
+		}
 
 		if prevObj == nil {
 			return errObjectNotFound
@@ -140,6 +143,12 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		if status.skipUpsert {
 			return nil
 		}
+		if err := s.keepVersion(idBytes, prevObj); err != nil {
+			return err
+		}
+		if err := s.updateExpiry(idBytes, prevObj, obj); err != nil {
+			return err
+		}
 
 		objBytes, err := obj.MarshalBinary()
 		if err != nil {
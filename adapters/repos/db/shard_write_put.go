@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/spaolacci/murmur3"
@@ -29,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
@@ -44,7 +46,7 @@ func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
 }
 
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
-	status, err := s.putObjectLSM(object, uuid)
+	status, err := s.putObjectLSM(ctx, object, uuid)
 	if err != nil {
 		return errors.Wrap(err, "store object in LSM store")
 	}
@@ -198,6 +200,16 @@ func (s *Shard) updateVectorInVectorIndex(ctx context.Context, vector []float32,
 	return nil
 }
 
+// checkIfMatch checks the precondition of a conditional update against the
+// stored object. It must be called while holding the lock of the object, so
+// that the object can't change between the check and the write.
+func checkIfMatch(ctx context.Context, id strfmt.UUID, prevObj *storobj.Object) error {
+	if prevObj == nil {
+		return objects.CheckIfMatch(ctx, id, false, 0)
+	}
+	return objects.CheckIfMatch(ctx, id, true, prevObj.LastUpdateTimeUnix())
+}
+
 func fetchObject(bucket *lsmkv.Bucket, idBytes []byte) (*storobj.Object, error) {
 	objBytes, err := bucket.Get(idBytes)
 	if err != nil {
@@ -215,7 +227,7 @@ func fetchObject(bucket *lsmkv.Bucket, idBytes []byte) (*storobj.Object, error)
 	return obj, nil
 }
 
-func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
+func (s *Shard) putObjectLSM(ctx context.Context, obj *storobj.Object, idBytes []byte,
 ) (status objectInsertStatus, err error) {
 	before := time.Now()
 	defer s.metrics.PutObject(before)
@@ -260,6 +272,9 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		if err != nil {
 			return err
 		}
+		if err := checkIfMatch(ctx, obj.ID(), prevObj); err != nil {
+			return err
+		}
 
 		status, err = s.determineInsertStatus(prevObj, obj)
 		if err != nil {
@@ -271,6 +286,12 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		if status.skipUpsert {
 			return nil
 		}
+		if err := s.keepVersion(idBytes, prevObj); err != nil {
+			return err
+		}
+		if err := s.updateExpiry(idBytes, prevObj, obj); err != nil {
+			return err
+		}
 
 		objBinary, err := obj.MarshalBinary()
 		if err != nil {
@@ -283,7 +304,7 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 		s.metrics.PutObjectUpsertObject(before)
 
-		return nil
+		return s.removeFromTrash(idBytes)
 	}(); err != nil {
 		return objectInsertStatus{}, err
 	} else if status.skipUpsert {
@@ -110,6 +110,10 @@ func (h *lsmSorterHelper) getSorted(ctx context.Context) ([]uint64, error) {
 	sorter := newInsertSorter(h.comparator, h.limit)
 
 	for k, objData := cursor.First(); k != nil; k, objData = cursor.Next() {
+		// the whole bucket is scanned, stop as soon as the request is gone
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		docID, _, err := storobj.DocIDAndTimeFromBinary(objData)
 		if err != nil {
 			return nil, errors.Wrapf(err, "lsm sorter - could not get doc id")
@@ -127,6 +131,9 @@ func (h *lsmSorterHelper) getSortedDocIDs(ctx context.Context, docIDs helpers.Al
 	it := docIDs.Iterator()
 
 	for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		objData, err := h.bucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
@@ -150,6 +157,9 @@ func (h *lsmSorterHelper) getSortedDocIDsAndDistances(ctx context.Context, docID
 	docIDBytes := make([]byte, 8)
 
 	for i, docID := range docIDs {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		objData, err := h.bucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestLSMSorterCancelled(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace), lsmkv.WithSecondaryIndices(1)))
+	bucket := store.Bucket(helpers.ObjectsBucketLSM)
+
+	var docIDs []uint64
+	for i, city := range sorterCitySchemaObjects() {
+		obj := storobj.FromObject(&city.Object, nil, nil)
+		obj.SetDocID(uint64(i))
+		data, err := obj.MarshalBinary()
+		require.Nil(t, err)
+		id, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+		require.Nil(t, err)
+		docIDBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(docIDBytes, uint64(i))
+		require.Nil(t, bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docIDBytes)))
+		docIDs = append(docIDs, uint64(i))
+	}
+
+	sorter, err := NewLSMSorter(store, sorterCitySchema().GetClass, schema.ClassName("City"))
+	require.Nil(t, err)
+	sort := []filters.Sort{{Path: []string{"population"}, Order: "desc"}}
+
+	t.Run("not cancelled", func(t *testing.T) {
+		sorted, err := sorter.Sort(ctx, 2, sort)
+		require.Nil(t, err)
+		// New York and Berlin
+		assert.Equal(t, []uint64{3, 2}, sorted)
+	})
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	t.Run("sort cancelled", func(t *testing.T) {
+		_, err := sorter.Sort(cancelled, 2, sort)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("sort doc ids cancelled", func(t *testing.T) {
+		_, err := sorter.SortDocIDs(cancelled, 2, sort, helpers.NewAllowList(docIDs...))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("sort doc ids and distances cancelled", func(t *testing.T) {
+		_, _, err := sorter.SortDocIDsAndDists(cancelled, 2, sort, docIDs, sorterCitySchemaDistances())
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,373 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package benchmark runs a standardized vector search benchmark against a
+// temporary HNSW index. It imports random vectors, runs random queries and
+// compares the results to an exact brute force search, so that operators
+// can validate hardware and index settings after a deployment.
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	DefaultVectors    = 10000
+	DefaultDimensions = 128
+	DefaultQueries    = 100
+	DefaultK          = 10
+
+	// MaxVectors keeps the benchmark from exhausting the memory of a
+	// production node
+	MaxVectors    = 1_000_000
+	MaxDimensions = 4096
+	MaxQueries    = 10_000
+)
+
+type vectorIndex interface {
+	Add(ctx context.Context, id uint64, vector []float32) error
+	SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	Shutdown(ctx context.Context) error
+}
+
+// Config describes the size of the benchmark
+type Config struct {
+	Vectors    int
+	Dimensions int
+	Queries    int
+	K          int
+	Seed       int64
+}
+
+func (c *Config) setDefaults() {
+	if c.Vectors == 0 {
+		c.Vectors = DefaultVectors
+	}
+	if c.Dimensions == 0 {
+		c.Dimensions = DefaultDimensions
+	}
+	if c.Queries == 0 {
+		c.Queries = DefaultQueries
+	}
+	if c.K == 0 {
+		c.K = DefaultK
+	}
+}
+
+func (c Config) validate() error {
+	if c.Vectors < 1 || c.Vectors > MaxVectors {
+		return fmt.Errorf("vectors must be between 1 and %d, got %d", MaxVectors, c.Vectors)
+	}
+	if c.Dimensions < 1 || c.Dimensions > MaxDimensions {
+		return fmt.Errorf("dimensions must be between 1 and %d, got %d", MaxDimensions, c.Dimensions)
+	}
+	if c.Queries < 1 || c.Queries > MaxQueries {
+		return fmt.Errorf("queries must be between 1 and %d, got %d", MaxQueries, c.Queries)
+	}
+	if c.K < 1 || c.K > c.Vectors {
+		return fmt.Errorf("k must be between 1 and the number of vectors (%d), got %d", c.Vectors, c.K)
+	}
+	return nil
+}
+
+// Result is the outcome of a benchmark run
+type Result struct {
+	Vectors        int    `json:"vectors"`
+	Dimensions     int    `json:"dimensions"`
+	Queries        int    `json:"queries"`
+	K              int    `json:"k"`
+	Distance       string `json:"distance"`
+	EF             int    `json:"ef"`
+	EFConstruction int    `json:"efConstruction"`
+	MaxConnections int    `json:"maxConnections"`
+
+	ImportDuration   time.Duration `json:"importDuration"`
+	ImportThroughput float64       `json:"importVectorsPerSecond"`
+	Recall           float64       `json:"recall"`
+	Latency          Latency       `json:"latency"`
+}
+
+// Latency percentiles of the queries
+type Latency struct {
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P90  time.Duration `json:"p90"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+}
+
+// Run executes the benchmark using the given index settings. The index is
+// created in a temporary directory below rootPath which is removed
+// afterwards.
+func Run(ctx context.Context, cfg Config, uc hnswent.UserConfig, rootPath string,
+	logger logrus.FieldLogger,
+) (*Result, error) {
+	cfg.setDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	distProv, err := DistanceProvider(uc.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp(rootPath, "vector-benchmark-")
+	if err != nil {
+		return nil, fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	rnd := rand.New(rand.NewSource(cfg.Seed))
+	vectors := randomVectors(rnd, cfg.Vectors, cfg.Dimensions, distProv)
+	queries := randomVectors(rnd, cfg.Queries, cfg.Dimensions, distProv)
+
+	index, store, err := newIndex(dir, uc, distProv, vectors, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Shutdown(context.Background())
+	defer index.Shutdown(context.Background())
+
+	importDuration, err := importVectors(ctx, index, vectors, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		latencies = make([]time.Duration, len(queries))
+		relevant  int
+	)
+	for i, query := range queries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		before := time.Now()
+		ids, _, err := index.SearchByVector(ctx, query, cfg.K, nil)
+		if err != nil {
+			return nil, fmt.Errorf("search: %w", err)
+		}
+		latencies[i] = time.Since(before)
+
+		truth, err := BruteForce(vectors, query, cfg.K, distProv)
+		if err != nil {
+			return nil, err
+		}
+		relevant += Matches(truth, ids)
+	}
+
+	res := &Result{
+		Vectors:          cfg.Vectors,
+		Dimensions:       cfg.Dimensions,
+		Queries:          cfg.Queries,
+		K:                cfg.K,
+		Distance:         uc.Distance,
+		EF:               uc.EF,
+		EFConstruction:   uc.EFConstruction,
+		MaxConnections:   uc.MaxConnections,
+		ImportDuration:   importDuration,
+		ImportThroughput: float64(cfg.Vectors) / importDuration.Seconds(),
+		Latency:          Percentiles(latencies),
+	}
+	if cfg.Queries > 0 {
+		res.Recall = float64(relevant) / float64(cfg.Queries*cfg.K)
+	}
+	return res, nil
+}
+
+func newIndex(dir string, uc hnswent.UserConfig, distProv distancer.Provider,
+	vectors [][]float32, logger logrus.FieldLogger,
+) (vectorIndex, *lsmkv.Store, error) {
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	if err != nil {
+		return nil, nil, fmt.Errorf("create store: %w", err)
+	}
+
+	index, err := hnsw.New(hnsw.Config{
+		RootPath:              dir,
+		ID:                    "vector-benchmark",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distProv,
+		Logger:                logger,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if id >= uint64(len(vectors)) {
+				return nil, fmt.Errorf("vector %d not found", id)
+			}
+			return vectors[id], nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), store)
+	if err != nil {
+		store.Shutdown(context.Background())
+		return nil, nil, fmt.Errorf("create index: %w", err)
+	}
+
+	return index, store, nil
+}
+
+func importVectors(ctx context.Context, index vectorIndex, vectors [][]float32,
+	logger logrus.FieldLogger,
+) (time.Duration, error) {
+	workers := runtime.GOMAXPROCS(0)
+	eg := enterrors.NewErrorGroupWrapper(logger)
+	eg.SetLimit(workers)
+
+	var mu sync.Mutex
+	var firstErr error
+
+	before := time.Now()
+	for i := range vectors {
+		id := uint64(i)
+		eg.Go(func() error {
+			if err := index.Add(ctx, id, vectors[id]); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	eg.Wait()
+
+	if firstErr != nil {
+		return 0, fmt.Errorf("import: %w", firstErr)
+	}
+	return time.Since(before), nil
+}
+
+func randomVectors(rnd *rand.Rand, n, dims int, distProv distancer.Provider) [][]float32 {
+	out := make([][]float32, n)
+	for i := range out {
+		vec := make([]float32, dims)
+		for j := range vec {
+			vec[j] = rnd.Float32()*2 - 1
+		}
+		if distProv.Type() == "cosine-dot" {
+			vec = distancer.Normalize(vec)
+		}
+		out[i] = vec
+	}
+	return out
+}
+
+// DistanceProvider returns the provider for a distance name as used in the
+// vector index config
+func DistanceProvider(name string) (distancer.Provider, error) {
+	switch name {
+	case "", common.DistanceCosine:
+		return distancer.NewCosineDistanceProvider(), nil
+	case common.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case common.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case common.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case common.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, fmt.Errorf("unrecognized distance metric %q", name)
+	}
+}
+
+// BruteForce returns the ids (positions) of the k vectors closest to the
+// query
+func BruteForce(vectors [][]float32, query []float32, k int, distProv distancer.Provider) ([]uint64, error) {
+	type scored struct {
+		id   uint64
+		dist float32
+	}
+
+	all := make([]scored, len(vectors))
+	for i, vec := range vectors {
+		dist, err := distProv.SingleDist(query, vec)
+		if err != nil {
+			return nil, fmt.Errorf("brute force distance: %w", err)
+		}
+		all[i] = scored{id: uint64(i), dist: dist}
+	}
+
+	sort.Slice(all, func(a, b int) bool { return all[a].dist < all[b].dist })
+	if k > len(all) {
+		k = len(all)
+	}
+
+	ids := make([]uint64, k)
+	for i := range ids {
+		ids[i] = all[i].id
+	}
+	return ids, nil
+}
+
+// Matches counts how many of the results are contained in truth
+func Matches(truth, results []uint64) int {
+	desired := make(map[uint64]struct{}, len(truth))
+	for _, id := range truth {
+		desired[id] = struct{}{}
+	}
+
+	var matches int
+	for _, id := range results {
+		if _, ok := desired[id]; ok {
+			matches++
+		}
+	}
+	return matches
+}
+
+// Percentiles computes the latency distribution
+func Percentiles(latencies []time.Duration) Latency {
+	if len(latencies) == 0 {
+		return Latency{}
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a] < sorted[b] })
+
+	var sum time.Duration
+	for _, l := range sorted {
+		sum += l
+	}
+
+	at := func(p float64) time.Duration {
+		return sorted[int(p*float64(len(sorted)-1))]
+	}
+
+	return Latency{
+		Mean: sum / time.Duration(len(sorted)),
+		P50:  at(0.5),
+		P90:  at(0.9),
+		P99:  at(0.99),
+		Max:  sorted[len(sorted)-1],
+	}
+}
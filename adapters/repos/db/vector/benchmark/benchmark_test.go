@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package benchmark
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRun(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	for _, distance := range []string{"cosine", "l2-squared"} {
+		t.Run(distance, func(t *testing.T) {
+			uc := hnswent.NewDefaultUserConfig()
+			uc.Distance = distance
+
+			res, err := Run(context.Background(), Config{
+				Vectors:    1000,
+				Dimensions: 16,
+				Queries:    20,
+				K:          5,
+				Seed:       1,
+			}, uc, t.TempDir(), logger)
+			require.Nil(t, err)
+
+			assert.Equal(t, 1000, res.Vectors)
+			assert.Equal(t, distance, res.Distance)
+			assert.Equal(t, uc.EF, res.EF)
+			assert.Greater(t, res.Recall, 0.9)
+			assert.Greater(t, res.ImportThroughput, 0.0)
+			assert.LessOrEqual(t, res.Latency.P50, res.Latency.P99)
+			assert.LessOrEqual(t, res.Latency.P99, res.Latency.Max)
+		})
+	}
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := Run(context.Background(), Config{Vectors: 10, K: 20},
+			hnswent.NewDefaultUserConfig(), t.TempDir(), logger)
+		assert.NotNil(t, err)
+
+		_, err = Run(context.Background(), Config{Vectors: MaxVectors + 1},
+			hnswent.NewDefaultUserConfig(), t.TempDir(), logger)
+		assert.NotNil(t, err)
+
+		_, err = Run(context.Background(), Config{Queries: MaxQueries + 1},
+			hnswent.NewDefaultUserConfig(), t.TempDir(), logger)
+		assert.ErrorContains(t, err, "queries must be between 1 and 10000")
+
+		_, err = Run(context.Background(), Config{Dimensions: -1},
+			hnswent.NewDefaultUserConfig(), t.TempDir(), logger)
+		assert.ErrorContains(t, err, "dimensions must be between 1 and 4096")
+	})
+}
+
+func TestBruteForce(t *testing.T) {
+	vectors := [][]float32{{0, 0}, {5, 5}, {1, 1}, {2, 2}}
+	ids, err := BruteForce(vectors, []float32{0.9, 0.9}, 2, distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{2, 0}, ids)
+
+	assert.Equal(t, 1, Matches([]uint64{2, 0}, []uint64{2, 3}))
+}
+
+func TestPercentiles(t *testing.T) {
+	var latencies []time.Duration
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	p := Percentiles(latencies)
+	assert.Equal(t, 50*time.Millisecond, p.P50)
+	assert.Equal(t, 90*time.Millisecond, p.P90)
+	assert.Equal(t, 99*time.Millisecond, p.P99)
+	assert.Equal(t, 100*time.Millisecond, p.Max)
+	assert.Equal(t, 50500*time.Microsecond, p.Mean)
+
+	assert.Equal(t, Latency{}, Percentiles(nil))
+}
@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cache
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	// the sketch uses one uint64 (16 4-bit counters) per cached vector, but
+	// is capped so unbounded caches don't allocate huge sketches. The same
+	// size is used for the ring of recently cached ids.
+	minSketchSize = 64
+	maxSketchSize = 1 << 18
+
+	// number of cached vectors that are compared with a candidate for
+	// admission, the least frequently used one of them is the victim
+	admissionSamples = 5
+	admissionProbes  = 4 * admissionSamples
+)
+
+// Stats are the access statistics of a cache with an admission policy
+type Stats struct {
+	Hits     int64
+	Misses   int64
+	Rejected int64
+}
+
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// frequencySketch is a count-min sketch with 4-bit counters that estimates
+// how often an id was accessed recently. All counters are halved
+// periodically, so that the estimates favor recent accesses.
+type frequencySketch struct {
+	table     []uint64
+	mask      uint64
+	additions atomic.Int64
+	resetAt   int64
+}
+
+var sketchSeeds = [4]uint64{
+	0x97cb3127dc1b5f49, 0xd6e8feb86659fd93, 0xbf58476d1ce4e5b9, 0x94d049bb133111eb,
+}
+
+func sketchSize(capacity int64) int64 {
+	size := int64(minSketchSize)
+	for size < capacity && size < maxSketchSize {
+		size <<= 1
+	}
+	return size
+}
+
+func newFrequencySketch(capacity int64) *frequencySketch {
+	size := sketchSize(capacity)
+	return &frequencySketch{
+		table:   make([]uint64, size),
+		mask:    uint64(size - 1),
+		resetAt: 10 * size,
+	}
+}
+
+func (f *frequencySketch) position(id uint64, row int) (int, uint) {
+	h := (id + sketchSeeds[row]) * sketchSeeds[(row+1)%len(sketchSeeds)]
+	h ^= h >> 31
+	return int(h & f.mask), uint((h>>59)&0xf) * 4
+}
+
+func (f *frequencySketch) Increment(id uint64) {
+	incremented := false
+	for row := range sketchSeeds {
+		i, shift := f.position(id, row)
+		for {
+			old := atomic.LoadUint64(&f.table[i])
+			if (old>>shift)&0xf == 0xf {
+				break
+			}
+			if atomic.CompareAndSwapUint64(&f.table[i], old, old+(1<<shift)) {
+				incremented = true
+				break
+			}
+		}
+	}
+
+	if incremented && f.additions.Add(1) == f.resetAt {
+		f.reset()
+	}
+}
+
+func (f *frequencySketch) Estimate(id uint64) uint64 {
+	min := uint64(0xf)
+	for row := range sketchSeeds {
+		i, shift := f.position(id, row)
+		if c := (atomic.LoadUint64(&f.table[i]) >> shift) & 0xf; c < min {
+			min = c
+		}
+	}
+	return min
+}
+
+// reset halves all counters
+func (f *frequencySketch) reset() {
+	for i := range f.table {
+		for {
+			old := atomic.LoadUint64(&f.table[i])
+			if atomic.CompareAndSwapUint64(&f.table[i], old, (old>>1)&0x7777777777777777) {
+				break
+			}
+		}
+	}
+	f.additions.Add(-f.resetAt / 2)
+}
+
+// tinyLFU combines the frequency sketch with a ring of recently cached ids,
+// from which eviction victims are sampled. Sampling the cache itself would
+// mostly hit empty slots if the cache is much smaller than the index.
+type tinyLFU struct {
+	*frequencySketch
+	recent    []uint64
+	recentPos atomic.Uint64
+}
+
+func newAdmission(policy string, maxSize int64) *tinyLFU {
+	if policy != vectorIndexCommon.VectorCacheAdmissionTinyLFU {
+		return nil
+	}
+	return &tinyLFU{
+		frequencySketch: newFrequencySketch(maxSize),
+		recent:          make([]uint64, sketchSize(maxSize)),
+	}
+}
+
+func (t *tinyLFU) cached(id uint64) {
+	pos := t.recentPos.Add(1) - 1
+	atomic.StoreUint64(&t.recent[pos%uint64(len(t.recent))], id)
+}
+
+func (t *tinyLFU) sample() uint64 {
+	n := t.recentPos.Load()
+	if n > uint64(len(t.recent)) {
+		n = uint64(len(t.recent))
+	}
+	return atomic.LoadUint64(&t.recent[rand.Int63n(int64(n))])
+}
+
+// admit decides whether the vector for id should be stored. As long as the
+// cache is not full every vector is admitted. Once it is full, the vector
+// replaces the least frequently used of a few sampled cached vectors, but
+// only if it is accessed more frequently than that victim (TinyLFU).
+func (s *shardedLockCache[T]) admit(id uint64) bool {
+	if s.admission == nil || atomic.LoadInt64(&s.count) < atomic.LoadInt64(&s.maxSize) {
+		return true
+	}
+
+	if s.admission.recentPos.Load() == 0 {
+		return true
+	}
+
+	victim, victimFreq, found := uint64(0), uint64(0), 0
+	for i := 0; i < admissionProbes && found < admissionSamples; i++ {
+		candidate := s.admission.sample()
+		if candidate == id || !s.isCached(candidate) {
+			continue
+		}
+		freq := s.admission.Estimate(candidate)
+		if found == 0 || freq < victimFreq {
+			victim, victimFreq = candidate, freq
+		}
+		found++
+	}
+
+	if found == 0 {
+		// the recently cached vectors are gone already, keep the cache
+		// bounded until cold vectors are evicted
+		s.rejected.Add(1)
+		return false
+	}
+
+	if s.admission.Estimate(id) <= victimFreq {
+		s.rejected.Add(1)
+		return false
+	}
+
+	s.Delete(context.Background(), victim)
+	return true
+}
+
+func (s *shardedLockCache[T]) isCached(id uint64) bool {
+	s.shardedLocks.RLock(id)
+	defer s.shardedLocks.RUnlock(id)
+
+	return id < uint64(len(s.cache)) && s.cache[id] != nil
+}
+
+// evictCold removes the least frequently used vectors until the cache is
+// below its maximum size again. It replaces dropping the whole cache for
+// caches with an admission policy.
+func (s *shardedLockCache[T]) evictCold() {
+	s.shardedLocks.LockAll()
+	defer s.shardedLocks.UnlockAll()
+
+	target := atomic.LoadInt64(&s.maxSize) * 9 / 10
+	for threshold := uint64(0); threshold <= 0xf; threshold++ {
+		for id := range s.cache {
+			if atomic.LoadInt64(&s.count) <= target {
+				return
+			}
+			if s.cache[id] != nil && s.admission.Estimate(uint64(id)) <= threshold {
+				s.cache[id] = nil
+				atomic.AddInt64(&s.count, -1)
+			}
+		}
+	}
+}
+
+func (s *shardedLockCache[T]) Stats() Stats {
+	return Stats{
+		Hits:     s.hits.Load(),
+		Misses:   s.misses.Load(),
+		Rejected: s.rejected.Load(),
+	}
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func TestFrequencySketch(t *testing.T) {
+	t.Run("estimates frequencies", func(t *testing.T) {
+		sketch := newFrequencySketch(1000)
+		for i := 0; i < 5; i++ {
+			sketch.Increment(7)
+		}
+		sketch.Increment(8)
+
+		assert.Equal(t, uint64(5), sketch.Estimate(7))
+		assert.Equal(t, uint64(1), sketch.Estimate(8))
+		assert.Equal(t, uint64(0), sketch.Estimate(9))
+	})
+
+	t.Run("counters saturate", func(t *testing.T) {
+		sketch := newFrequencySketch(1000)
+		for i := 0; i < 100; i++ {
+			sketch.Increment(7)
+		}
+
+		assert.Equal(t, uint64(15), sketch.Estimate(7))
+	})
+
+	t.Run("counters are halved periodically", func(t *testing.T) {
+		sketch := newFrequencySketch(minSketchSize)
+		for i := 0; i < 8; i++ {
+			sketch.Increment(7)
+		}
+		for id := uint64(1000); id < uint64(1000+sketch.resetAt); id++ {
+			sketch.Increment(id)
+		}
+
+		assert.LessOrEqual(t, sketch.Estimate(7), uint64(4))
+	})
+}
+
+func TestCacheAdmission(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return []float32{float32(id)}, nil
+	}
+	ctx := context.Background()
+	maxSize := 10
+
+	t.Run("hot vectors are kept when the cache is full", func(t *testing.T) {
+		c := NewShardedFloat32LockCache(vecForID, maxSize, 1, logger, false, 0, nil,
+			vectorIndexCommon.VectorCacheAdmissionTinyLFU)
+		c.Grow(1000)
+
+		// make the first vectors hot
+		for round := 0; round < 5; round++ {
+			for id := uint64(0); id < uint64(maxSize); id++ {
+				_, err := c.Get(ctx, id)
+				require.Nil(t, err)
+			}
+		}
+
+		// a scan over cold vectors is served but not admitted
+		for id := uint64(100); id < 1000; id++ {
+			vec, err := c.Get(ctx, id)
+			require.Nil(t, err)
+			assert.Equal(t, []float32{float32(id)}, vec)
+		}
+
+		assert.Equal(t, int64(maxSize), c.CountVectors())
+		for id := uint64(0); id < uint64(maxSize); id++ {
+			assert.Equal(t, []float32{float32(id)}, c.All()[id])
+		}
+
+		stats := c.Stats()
+		assert.Equal(t, int64(4*maxSize), stats.Hits)
+		assert.Equal(t, int64(maxSize+900), stats.Misses)
+		assert.Equal(t, int64(900), stats.Rejected)
+	})
+
+	t.Run("frequent vectors replace cold ones", func(t *testing.T) {
+		c := NewShardedFloat32LockCache(vecForID, maxSize, 1, logger, false, 0, nil,
+			vectorIndexCommon.VectorCacheAdmissionTinyLFU)
+		c.Grow(1000)
+
+		for id := uint64(0); id < uint64(maxSize); id++ {
+			_, err := c.Get(ctx, id)
+			require.Nil(t, err)
+		}
+		for round := 0; round < 5; round++ {
+			_, err := c.Get(ctx, 500)
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, []float32{500}, c.All()[500])
+		assert.Equal(t, int64(maxSize), c.CountVectors())
+	})
+
+	t.Run("cold vectors are evicted instead of dropping the cache", func(t *testing.T) {
+		c := NewShardedFloat32LockCache(vecForID, maxSize, 1, logger, false, 0, nil,
+			vectorIndexCommon.VectorCacheAdmissionTinyLFU)
+		c.Grow(1000)
+
+		for round := 0; round < 3; round++ {
+			_, err := c.Get(ctx, 1)
+			require.Nil(t, err)
+		}
+		// inserts are always preloaded, so they can exceed the max size
+		for id := uint64(100); id < 120; id++ {
+			c.Preload(id, []float32{float32(id)})
+		}
+
+		c.(*shardedLockCache[float32]).replaceIfFull()
+
+		assert.LessOrEqual(t, c.CountVectors(), int64(maxSize))
+		assert.Equal(t, []float32{1}, c.All()[1])
+	})
+
+	t.Run("no statistics without admission policy", func(t *testing.T) {
+		c := NewShardedFloat32LockCache(vecForID, maxSize, 1, logger, false, 0, nil, "")
+		c.Grow(1000)
+
+		for id := uint64(0); id < 100; id++ {
+			_, err := c.Get(ctx, id)
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, Stats{}, c.Stats())
+		assert.Equal(t, int64(100), c.CountVectors())
+	})
+}
@@ -37,4 +37,5 @@ type Cache[T any] interface {
 	All() [][]T
 	LockAll()
 	UnlockAll()
+	Stats() Stats
 }
@@ -38,6 +38,20 @@ type shardedLockCache[T float32 | byte | uint64] struct {
 	deletionInterval time.Duration
 	allocChecker     memwatch.AllocChecker
 
+	// vectorLen and evicted are used to account for the cache in the shared
+	// memory budget, see [memwatch.Budget]
+	vectorLen  int64
+	evicted    atomic.Bool
+	unregister func()
+
+	// admission is nil unless the cache uses the tinylfu admission policy.
+	// Access statistics are only collected with an admission policy, so that
+	// the default cache does not pay for them.
+	admission *tinyLFU
+	hits      atomic.Int64
+	misses    atomic.Int64
+	rejected  atomic.Int64
+
 	// The maintenanceLock makes sure that only one maintenance operation, such
 	// as growing the cache or clearing the cache happens at the same time.
 	maintenanceLock sync.RWMutex
@@ -48,11 +62,15 @@ const (
 	MinimumIndexGrowthDelta = 2000
 	indexGrowthRate         = 1.25
 	defaultCacheMaxSize     = 1e12
+
+	// BudgetGroup is the group under which vector caches register in the
+	// shared memory budget
+	BudgetGroup = "vector"
 )
 
 func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], maxSize int, pageSize uint64,
 	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, admission string,
 ) Cache[float32] {
 	vc := &shardedLockCache[float32]{
 		vectorForID: func(ctx context.Context, id uint64) ([]float32, error) {
@@ -77,6 +95,8 @@ func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], maxSize in
 		allocChecker:     allocChecker,
 	}
 
+	vc.admission = newAdmission(admission, int64(maxSize))
+	vc.registerInBudget()
 	vc.watchForDeletion()
 	return vc
 }
@@ -99,13 +119,14 @@ func NewShardedByteLockCache(vecForID common.VectorForID[byte], maxSize int, pag
 		allocChecker:     allocChecker,
 	}
 
+	vc.registerInBudget()
 	vc.watchForDeletion()
 	return vc
 }
 
 func NewShardedUInt64LockCache(vecForID common.VectorForID[uint64], maxSize int, pageSize uint64,
 	logger logrus.FieldLogger, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, admission string,
 ) Cache[uint64] {
 	vc := &shardedLockCache[uint64]{
 		vectorForID:      vecForID,
@@ -121,6 +142,8 @@ func NewShardedUInt64LockCache(vecForID common.VectorForID[uint64], maxSize int,
 		allocChecker:     allocChecker,
 	}
 
+	vc.admission = newAdmission(admission, int64(maxSize))
+	vc.registerInBudget()
 	vc.watchForDeletion()
 	return vc
 }
@@ -134,6 +157,7 @@ func (s *shardedLockCache[T]) Get(ctx context.Context, id uint64) ([]T, error) {
 	vec := s.cache[id]
 	s.shardedLocks.RUnlock(id)
 
+	s.recordAccess(id, vec != nil)
 	if vec != nil {
 		return vec, nil
 	}
@@ -179,17 +203,41 @@ func (s *shardedLockCache[T]) handleCacheMiss(ctx context.Context, id uint64) ([
 		return nil, err
 	}
 
+	if vec != nil && !s.admit(id) {
+		return vec, nil
+	}
+
 	atomic.AddInt64(&s.count, 1)
 
 	if vec != nil {
+		s.observeVectorLen(vec)
 		s.shardedLocks.Lock(id)
 		s.cache[id] = vec
 		s.shardedLocks.Unlock(id)
+		if s.admission != nil {
+			s.admission.cached(id)
+		}
 	}
 
 	return vec, nil
 }
 
+// recordAccess feeds the admission policy. Full scans through
+// GetAllInCurrentLock are deliberately not recorded, so that they don't make
+// every vector look frequently used.
+func (s *shardedLockCache[T]) recordAccess(id uint64, hit bool) {
+	if s.admission == nil {
+		return
+	}
+
+	s.admission.Increment(id)
+	if hit {
+		s.hits.Add(1)
+	} else {
+		s.misses.Add(1)
+	}
+}
+
 func (s *shardedLockCache[T]) MultiGet(ctx context.Context, ids []uint64) ([][]T, []error) {
 	out := make([][]T, len(ids))
 	errs := make([]error, len(ids))
@@ -199,6 +247,7 @@ func (s *shardedLockCache[T]) MultiGet(ctx context.Context, ids []uint64) ([][]T
 		vec := s.cache[id]
 		s.shardedLocks.RUnlock(id)
 
+		s.recordAccess(id, vec != nil)
 		if vec == nil {
 			vecFromDisk, err := s.handleCacheMiss(ctx, id)
 			errs[i] = err
@@ -233,7 +282,10 @@ func (s *shardedLockCache[T]) GetAllInCurrentLock(ctx context.Context, id uint64
 	// We don't expect cache misses in general as the default cache size is very large (1e12).
 	// Until the vector index cache is improved to handle nil vectors better, it makes sense here
 	// to exclude handling cache misses unless the cache size has been altered.
-	if cacheMiss && atomic.LoadInt64(&s.maxSize) != defaultCacheMaxSize {
+	//
+	// Vectors that were evicted by the memory budget need to be loaded again
+	// regardless of the cache size.
+	if cacheMiss && (atomic.LoadInt64(&s.maxSize) != defaultCacheMaxSize || s.evicted.Load()) {
 		for i := start; i < end; i++ {
 			if out[i-start] == nil {
 				vecFromDisk, err := s.handleCacheMiss(ctx, i)
@@ -275,7 +327,11 @@ func (s *shardedLockCache[T]) Preload(id uint64, vec []T) {
 	defer s.shardedLocks.Unlock(id)
 
 	atomic.AddInt64(&s.count, 1)
+	s.observeVectorLen(vec)
 	s.cache[id] = vec
+	if s.admission != nil {
+		s.admission.cached(id)
+	}
 }
 
 func (s *shardedLockCache[T]) PreloadNoLock(id uint64, vec []T) {
@@ -332,6 +388,9 @@ func (s *shardedLockCache[T]) CountVectors() int64 {
 }
 
 func (s *shardedLockCache[T]) Drop() {
+	if s.unregister != nil {
+		s.unregister()
+	}
 	s.deleteAllVectors()
 	if s.deletionInterval != 0 {
 		s.cancel <- true
@@ -371,10 +430,43 @@ func (s *shardedLockCache[T]) watchForDeletion() {
 
 func (s *shardedLockCache[T]) replaceIfFull() {
 	if atomic.LoadInt64(&s.count) >= atomic.LoadInt64(&s.maxSize) {
+		if s.admission != nil {
+			s.evictCold()
+			return
+		}
 		s.deleteAllVectors()
 	}
 }
 
+func (s *shardedLockCache[T]) registerInBudget() {
+	if registry, ok := s.allocChecker.(memwatch.CacheRegistry); ok {
+		s.unregister = registry.RegisterCache(BudgetGroup, s)
+	}
+}
+
+func (s *shardedLockCache[T]) observeVectorLen(vec []T) {
+	if len(vec) > 0 && atomic.LoadInt64(&s.vectorLen) == 0 {
+		atomic.StoreInt64(&s.vectorLen, int64(len(vec)))
+	}
+}
+
+// UsedBytes estimates the memory held by the cached vectors. It assumes all
+// vectors have the same length and ignores the slice headers.
+func (s *shardedLockCache[T]) UsedBytes() int64 {
+	var zero T
+	return atomic.LoadInt64(&s.count) * atomic.LoadInt64(&s.vectorLen) *
+		int64(unsafe.Sizeof(zero))
+}
+
+// Evict drops all cached vectors to free memory for the shared budget.
+// Vectors are loaded from disk again on the next access.
+func (s *shardedLockCache[T]) Evict() int64 {
+	freed := s.UsedBytes()
+	s.evicted.Store(true)
+	s.deleteAllVectors()
+	return freed
+}
+
 func (s *shardedLockCache[T]) UpdateMaxSize(size int64) {
 	atomic.StoreInt64(&s.maxSize, size)
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/usecases/memwatch"
 )
 
 func TestVectorCacheGrowth(t *testing.T) {
@@ -30,7 +31,7 @@ func TestVectorCacheGrowth(t *testing.T) {
 	id := 100_000
 	expectedCount := int64(0)
 
-	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, 1, logger, false, time.Duration(10_000), nil)
+	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, 1, logger, false, time.Duration(10_000), nil, "")
 	initialSize := vectorCache.Len()
 	assert.Less(t, int(initialSize), id)
 	assert.Equal(t, expectedCount, vectorCache.CountVectors())
@@ -52,7 +53,7 @@ func TestCache_ParallelGrowth(t *testing.T) {
 
 	logger, _ := test.NewNullLogger()
 	var vecForId common.VectorForID[float32] = func(context.Context, uint64) ([]float32, error) { return nil, nil }
-	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, 1, logger, false, time.Second, nil)
+	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, 1, logger, false, time.Second, nil, "")
 
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	count := 10_000
@@ -83,7 +84,7 @@ func TestCacheCleanup(t *testing.T) {
 	sleepMs := deletionInterval + 100*time.Millisecond
 
 	t.Run("count is not reset on unnecessary deletion", func(t *testing.T) {
-		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, 1, logger, false, deletionInterval, nil)
+		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, 1, logger, false, deletionInterval, nil, "")
 		shardedLockCache, ok := vectorCache.(*shardedLockCache[float32])
 		assert.True(t, ok)
 
@@ -102,7 +103,7 @@ func TestCacheCleanup(t *testing.T) {
 	})
 
 	t.Run("deletion clears cache and counter when maxSize exceeded", func(t *testing.T) {
-		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, 1, logger, false, deletionInterval, nil)
+		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, 1, logger, false, deletionInterval, nil, "")
 		shardedLockCache, ok := vectorCache.(*shardedLockCache[float32])
 		assert.True(t, ok)
 
@@ -141,7 +142,7 @@ func TestGetAllInCurrentLock(t *testing.T) {
 
 	t.Run("fully cached page", func(t *testing.T) {
 		// Setup a cache with some pre-loaded vectors
-		vectorCache := NewShardedFloat32LockCache(nil, maxSize, pageSize, logger, false, 0, nil)
+		vectorCache := NewShardedFloat32LockCache(nil, maxSize, pageSize, logger, false, 0, nil, "")
 		cache := vectorCache.(*shardedLockCache[float32])
 
 		// Preload vectors for a full page
@@ -173,7 +174,7 @@ func TestGetAllInCurrentLock(t *testing.T) {
 			return []float32{float32(id * 100)}, nil
 		}
 
-		vectorCache := NewShardedFloat32LockCache(vecForID, maxSize, pageSize, logger, false, 0, nil)
+		vectorCache := NewShardedFloat32LockCache(vecForID, maxSize, pageSize, logger, false, 0, nil, "")
 		cache := vectorCache.(*shardedLockCache[float32])
 
 		// Preload only some vectors
@@ -204,7 +205,7 @@ func TestGetAllInCurrentLock(t *testing.T) {
 	})
 
 	t.Run("page beyond cache size", func(t *testing.T) {
-		vectorCache := NewShardedFloat32LockCache(nil, maxSize, pageSize, logger, false, 0, nil)
+		vectorCache := NewShardedFloat32LockCache(nil, maxSize, pageSize, logger, false, 0, nil, "")
 		cache := vectorCache.(*shardedLockCache[float32])
 
 		// Request vectors beyond current cache size
@@ -232,7 +233,7 @@ func TestGetAllInCurrentLock(t *testing.T) {
 			return nil, expectedErr
 		}
 
-		vectorCache := NewShardedFloat32LockCache(vecForID, maxSize, pageSize, logger, false, 0, nil)
+		vectorCache := NewShardedFloat32LockCache(vecForID, maxSize, pageSize, logger, false, 0, nil, "")
 		cache := vectorCache.(*shardedLockCache[float32])
 
 		out := make([][]float32, pageSize)
@@ -249,3 +250,33 @@ func TestGetAllInCurrentLock(t *testing.T) {
 		}
 	})
 }
+
+func TestCacheBudgetEviction(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vecForId := func(ctx context.Context, id uint64) ([]float32, error) {
+		return []float32{float32(id), 1, 2, 3}, nil
+	}
+
+	monitor := memwatch.NewDummyMonitor()
+	budget := memwatch.NewBudget(func() int64 { return 1000 }, 5, nil, nil)
+	monitor.SetCacheBudget(budget)
+
+	vectorCache := NewShardedFloat32LockCache(vecForId, defaultCacheMaxSize, 1, logger, false, 0, monitor, "")
+	for id := uint64(0); id < 10; id++ {
+		vectorCache.Preload(id, []float32{float32(id), 1, 2, 3})
+	}
+	assert.Equal(t, int64(10*4*4), vectorCache.(*shardedLockCache[float32]).UsedBytes())
+
+	// the budget of 50 bytes is exceeded
+	assert.Equal(t, int64(160), budget.Enforce())
+	assert.Equal(t, int64(0), vectorCache.CountVectors())
+
+	// evicted vectors are loaded again, even though the cache size is the default
+	out := make([][]float32, vectorCache.PageSize())
+	errs := make([]error, vectorCache.PageSize())
+	vecs, _, _, _ := vectorCache.GetAllInCurrentLock(context.Background(), 0, out, errs)
+	assert.Equal(t, []float32{0, 1, 2, 3}, vecs[0])
+
+	vectorCache.Drop()
+	assert.Empty(t, budget.Shares())
+}
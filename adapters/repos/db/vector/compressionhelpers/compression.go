@@ -51,6 +51,7 @@ type VectorCompressor interface {
 	Prefetch(id uint64)
 	CountVectors() int64
 	PrefillCache()
+	CacheStats() cache.Stats
 
 	DistanceBetweenCompressedVectorsFromIDs(ctx context.Context, x, y uint64) (float32, error)
 	NewDistancer(vector []float32) (CompressorDistancer, ReturnDistancerFn)
@@ -86,6 +87,10 @@ func (compressor *quantizedVectorsCompressor[T]) CountVectors() int64 {
 	return compressor.cache.CountVectors()
 }
 
+func (compressor *quantizedVectorsCompressor[T]) CacheStats() cache.Stats {
+	return compressor.cache.Stats()
+}
+
 func (compressor *quantizedVectorsCompressor[T]) GetCacheMaxSize() int64 {
 	return compressor.cache.CopyMaxSize()
 }
@@ -341,10 +346,32 @@ func NewBQCompressor(
 	bqVectorsCompressor.initCompressedStore()
 	bqVectorsCompressor.cache = cache.NewShardedUInt64LockCache(
 		bqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger, 0,
-		allocChecker)
+		allocChecker, "")
 	return bqVectorsCompressor, nil
 }
 
+func NewFloat16Compressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer := NewFloat16Quantizer(distance)
+	f16VectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	f16VectorsCompressor.initCompressedStore()
+	f16VectorsCompressor.cache = cache.NewShardedByteLockCache(
+		f16VectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
+		0, allocChecker)
+	return f16VectorsCompressor, nil
+}
+
 func NewHNSWSQCompressor(
 	distance distancer.Provider,
 	vectorCacheMaxObjects int,
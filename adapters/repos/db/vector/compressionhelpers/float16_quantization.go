@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// Float16Quantizer stores vectors as IEEE 754 half precision floats. Unlike
+// the other quantizers it does not need any training data, the codes are
+// converted back to float32 to calculate distances.
+type Float16Quantizer struct {
+	distancer distancer.Provider
+}
+
+func NewFloat16Quantizer(distancer distancer.Provider) *Float16Quantizer {
+	return &Float16Quantizer{
+		distancer: distancer,
+	}
+}
+
+func (fq *Float16Quantizer) Encode(vec []float32) []byte {
+	code := make([]byte, len(vec)*2)
+	for i, v := range vec {
+		binary.LittleEndian.PutUint16(code[i*2:], Float32ToFloat16(v))
+	}
+	return code
+}
+
+func (fq *Float16Quantizer) decode(code []byte, buf []float32) []float32 {
+	l := len(code) / 2
+	if cap(buf) < l {
+		buf = make([]float32, l)
+	}
+	buf = buf[:l]
+	for i := range buf {
+		buf[i] = Float16ToFloat32(binary.LittleEndian.Uint16(code[i*2:]))
+	}
+	return buf
+}
+
+func (fq *Float16Quantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	return fq.distancer.SingleDist(fq.decode(x, nil), fq.decode(y, nil))
+}
+
+func (fq *Float16Quantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return &Float16Distancer{
+		x:  a,
+		fq: fq,
+	}
+}
+
+func (fq *Float16Quantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &Float16Distancer{
+		x:  fq.decode(a, nil),
+		fq: fq,
+	}
+}
+
+func (fq *Float16Quantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (fq *Float16Quantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (fq *Float16Quantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (fq *Float16Quantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+func (fq *Float16Quantizer) PersistCompression(logger CommitLogger) {}
+
+// Float16Distancer reuses its decode buffer, so it must not be used
+// concurrently
+type Float16Distancer struct {
+	x   []float32
+	fq  *Float16Quantizer
+	buf []float32
+}
+
+func (d *Float16Distancer) Distance(x []byte) (float32, error) {
+	d.buf = d.fq.decode(x, d.buf)
+	return d.fq.distancer.SingleDist(d.x, d.buf)
+}
+
+func (d *Float16Distancer) DistanceToFloat(x []float32) (float32, error) {
+	return d.fq.distancer.SingleDist(d.x, x)
+}
+
+// Float32ToFloat16 converts to half precision, rounding to the nearest even
+// value. Values out of range become +/-Inf.
+func Float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	rawExp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	switch {
+	case rawExp == 0xff:
+		if mant == 0 {
+			return sign | 0x7c00
+		}
+		return sign | 0x7e00
+	case bits&0x7fffffff == 0:
+		return sign
+	}
+
+	exp := rawExp - 127 + 15
+	switch {
+	case exp >= 0x1f:
+		return sign | 0x7c00
+	case exp <= 0:
+		// subnormal in half precision
+		if exp < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(14 - exp)
+		half := uint16(mant >> shift)
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | half
+	default:
+		half := sign | uint16(exp)<<10 | uint16(mant>>13)
+		rem := mant & 0x1fff
+		// a carry into the exponent is intended, it rounds up to the next
+		// power of two (or Inf)
+		if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+			half++
+		}
+		return half
+	}
+}
+
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// normalize the subnormal value
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | e<<23 | mant<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+func TestFloat16Conversion(t *testing.T) {
+	tests := []struct {
+		in   float32
+		bits uint16
+		out  float32
+	}{
+		{in: 0, bits: 0x0000, out: 0},
+		{in: 1, bits: 0x3c00, out: 1},
+		{in: -2, bits: 0xc000, out: -2},
+		{in: 0.5, bits: 0x3800, out: 0.5},
+		{in: 65504, bits: 0x7bff, out: 65504},
+		{in: 1e6, bits: 0x7c00, out: float32(math.Inf(1))},
+		{in: 5.960464477539063e-08, bits: 0x0001, out: 5.960464477539063e-08},
+		{in: 1e-10, bits: 0x0000, out: 0},
+		// 1 + 2^-11 is exactly halfway between 1 and the next half, rounds
+		// to even
+		{in: 1 + 1.0/2048, bits: 0x3c00, out: 1},
+		{in: float32(math.Inf(-1)), bits: 0xfc00, out: float32(math.Inf(-1))},
+	}
+
+	for _, test := range tests {
+		bits := compressionhelpers.Float32ToFloat16(test.in)
+		assert.Equal(t, test.bits, bits, "encode %v", test.in)
+		assert.Equal(t, test.out, compressionhelpers.Float16ToFloat32(bits), "decode %v", test.in)
+	}
+
+	assert.True(t, math.IsNaN(float64(compressionhelpers.Float16ToFloat32(
+		compressionhelpers.Float32ToFloat16(float32(math.NaN()))))))
+}
+
+func TestFloat16QuantizerDistance(t *testing.T) {
+	provider := distancer.NewL2SquaredProvider()
+	fq := compressionhelpers.NewFloat16Quantizer(provider)
+
+	x := []float32{0.1, 0.25, -0.3, 0.9}
+	y := []float32{-0.2, 0.5, 0.75, 0.125}
+
+	expected, err := provider.SingleDist(x, y)
+	require.Nil(t, err)
+
+	dist, err := fq.DistanceBetweenCompressedVectors(fq.Encode(x), fq.Encode(y))
+	require.Nil(t, err)
+	assert.InDelta(t, expected, dist, 1e-3)
+
+	d := fq.NewQuantizerDistancer(x)
+	dist, err = d.Distance(fq.Encode(y))
+	require.Nil(t, err)
+	assert.InDelta(t, expected, dist, 1e-3)
+
+	dist, err = d.DistanceToFloat(y)
+	require.Nil(t, err)
+	assert.Equal(t, expected, dist)
+
+	assert.Len(t, fq.Encode(x), 2*len(x))
+}
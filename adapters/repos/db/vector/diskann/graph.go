@@ -0,0 +1,371 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// node is the on-disk representation of a vertex. The full vector and the
+// adjacency list share a single value, so that expanding a node during a
+// search costs exactly one read.
+//
+// layout: | dims uint32 | dims * float32 | n * uint64 neighbors |
+type node struct {
+	vector    []float32
+	neighbors []uint64
+}
+
+func encodeNode(vector []float32, neighbors []uint64) []byte {
+	out := make([]byte, 4+len(vector)*4+len(neighbors)*8)
+	binary.LittleEndian.PutUint32(out, uint32(len(vector)))
+	pos := 4
+	for _, v := range vector {
+		binary.LittleEndian.PutUint32(out[pos:], math.Float32bits(v))
+		pos += 4
+	}
+	for _, n := range neighbors {
+		binary.LittleEndian.PutUint64(out[pos:], n)
+		pos += 8
+	}
+	return out
+}
+
+func decodeNode(data []byte) (node, error) {
+	if len(data) < 4 {
+		return node{}, fmt.Errorf("diskann node too short: %d bytes", len(data))
+	}
+	dims := int(binary.LittleEndian.Uint32(data))
+	vectorEnd := 4 + dims*4
+	if len(data) < vectorEnd || (len(data)-vectorEnd)%8 != 0 {
+		return node{}, fmt.Errorf("diskann node of %d bytes does not match %d dimensions",
+			len(data), dims)
+	}
+
+	n := node{
+		vector:    make([]float32, dims),
+		neighbors: make([]uint64, (len(data)-vectorEnd)/8),
+	}
+	for i := range n.vector {
+		n.vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4+i*4:]))
+	}
+	for i := range n.neighbors {
+		n.neighbors[i] = binary.LittleEndian.Uint64(data[vectorEnd+i*8:])
+	}
+	return n, nil
+}
+
+// compressed vectors are prefixed with a flag byte, which marks deleted
+// nodes. Deleted nodes are kept in the graph for navigation until the next
+// consolidation.
+const (
+	flagLive      byte = 0
+	flagTombstone byte = 1
+)
+
+func encodeCompressed(flag byte, codes []uint64) []byte {
+	out := make([]byte, 1+len(codes)*8)
+	out[0] = flag
+	for i, c := range codes {
+		binary.LittleEndian.PutUint64(out[1+i*8:], c)
+	}
+	return out
+}
+
+func decodeCompressed(data []byte) (byte, []uint64) {
+	codes := make([]uint64, (len(data)-1)/8)
+	for i := range codes {
+		codes[i] = binary.LittleEndian.Uint64(data[1+i*8:])
+	}
+	return data[0], codes
+}
+
+type candidate struct {
+	id       uint64
+	dist     float32
+	expanded bool
+}
+
+// beamSearch is the greedy search of the Vamana paper. The candidate list is
+// ordered by the distance of the in-memory binary quantized vectors, while
+// every expanded node is reported to visit with its exact distance, as the
+// full vector is read from disk together with the neighbors anyway.
+func (index *diskann) beamSearch(ctx context.Context, query []float32,
+	queryCodes []uint64, listSize int, visit func(id uint64, n node, dist float32),
+) error {
+	entryCodes, err := index.cache.Get(ctx, index.entryPoint)
+	if err != nil {
+		return fmt.Errorf("get entrypoint %d: %w", index.entryPoint, err)
+	}
+	entryDist, err := index.bq.DistanceBetweenCompressedVectors(entryCodes, queryCodes)
+	if err != nil {
+		return err
+	}
+
+	pool := make([]candidate, 1, listSize+1)
+	pool[0] = candidate{id: index.entryPoint, dist: entryDist}
+	seen := map[uint64]struct{}{index.entryPoint: {}}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		next := -1
+		for i := range pool {
+			if !pool[i].expanded {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil
+		}
+		pool[next].expanded = true
+
+		n, ok, err := index.readNode(pool[next].id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(query, n.vector)
+		if err != nil {
+			return err
+		}
+		visit(pool[next].id, n, dist)
+
+		for _, neighbor := range n.neighbors {
+			if _, ok := seen[neighbor]; ok {
+				continue
+			}
+			seen[neighbor] = struct{}{}
+
+			codes, err := index.cache.Get(ctx, neighbor)
+			if err != nil || len(codes) == 0 {
+				// the neighbor no longer exists
+				continue
+			}
+			d, err := index.bq.DistanceBetweenCompressedVectors(codes, queryCodes)
+			if err != nil {
+				return err
+			}
+			if len(pool) == listSize && d >= pool[len(pool)-1].dist {
+				continue
+			}
+
+			pos := sort.Search(len(pool), func(i int) bool { return pool[i].dist > d })
+			pool = append(pool, candidate{})
+			copy(pool[pos+1:], pool[pos:])
+			pool[pos] = candidate{id: neighbor, dist: d}
+			if len(pool) > listSize {
+				pool = pool[:listSize]
+			}
+		}
+	}
+}
+
+type pruneCandidate struct {
+	id     uint64
+	vector []float32
+	dist   float32
+}
+
+// robustPrune selects up to maxDegree neighbors from the candidates. A
+// candidate is skipped if an already selected neighbor is closer to it (by a
+// factor of alpha) than the node itself, which keeps long edges in the graph
+// that a pure nearest neighbor selection would drop.
+func (index *diskann) robustPrune(candidates []pruneCandidate) ([]uint64, error) {
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].dist < candidates[b].dist
+	})
+
+	alpha := float32(index.alpha)
+	selected := make([]uint64, 0, index.maxDegree)
+	for len(candidates) > 0 && len(selected) < index.maxDegree {
+		closest := candidates[0]
+		selected = append(selected, closest.id)
+
+		remaining := candidates[:0]
+		for _, c := range candidates[1:] {
+			d, err := index.distancerProvider.SingleDist(closest.vector, c.vector)
+			if err != nil {
+				return nil, err
+			}
+			if alpha*d > c.dist {
+				remaining = append(remaining, c)
+			}
+		}
+		candidates = remaining
+	}
+	return selected, nil
+}
+
+// pruneCandidates reads the full vectors of the given ids and calculates
+// their distance to vector, ids which no longer exist are skipped
+func (index *diskann) pruneCandidates(vector []float32, ids []uint64,
+	known map[uint64][]float32,
+) ([]pruneCandidate, error) {
+	out := make([]pruneCandidate, 0, len(ids))
+	for _, id := range ids {
+		vec, ok := known[id]
+		if !ok {
+			n, exists, err := index.readNode(id)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				continue
+			}
+			vec = n.vector
+		}
+		dist, err := index.distancerProvider.SingleDist(vector, vec)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pruneCandidate{id: id, vector: vec, dist: dist})
+	}
+	return out, nil
+}
+
+// addReverseEdge links from back to id and prunes the adjacency list of from
+// once it outgrows maxDegree
+func (index *diskann) addReverseEdge(from, id uint64, vector []float32) error {
+	n, ok, err := index.readNode(from)
+	if err != nil || !ok {
+		return err
+	}
+	for _, neighbor := range n.neighbors {
+		if neighbor == id {
+			return nil
+		}
+	}
+
+	n.neighbors = append(n.neighbors, id)
+	if len(n.neighbors) > index.maxDegree {
+		candidates, err := index.pruneCandidates(n.vector, n.neighbors,
+			map[uint64][]float32{id: vector})
+		if err != nil {
+			return err
+		}
+		if n.neighbors, err = index.robustPrune(candidates); err != nil {
+			return err
+		}
+	}
+	return index.writeNode(from, n.vector, n.neighbors)
+}
+
+// consolidate removes all tombstoned nodes from the graph. Every live node
+// that points to a deleted one inherits the deleted node's neighbors before
+// its adjacency list is pruned again, so the graph stays navigable.
+func (index *diskann) consolidate() error {
+	index.Lock()
+	defer index.Unlock()
+
+	if index.shutdown || len(index.tombstones) == 0 {
+		return nil
+	}
+
+	deletedNeighbors := make(map[uint64][]uint64, len(index.tombstones))
+	var affected []uint64
+
+	cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		id := binary.BigEndian.Uint64(k)
+		n, err := decodeNode(v)
+		if err != nil {
+			cursor.Close()
+			return fmt.Errorf("node %d: %w", id, err)
+		}
+		if _, deleted := index.tombstones[id]; deleted {
+			deletedNeighbors[id] = n.neighbors
+			continue
+		}
+		for _, neighbor := range n.neighbors {
+			if _, deleted := index.tombstones[neighbor]; deleted {
+				affected = append(affected, id)
+				break
+			}
+		}
+	}
+	cursor.Close()
+
+	for _, id := range affected {
+		n, ok, err := index.readNode(id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		ids := make([]uint64, 0, len(n.neighbors))
+		added := map[uint64]struct{}{id: {}}
+		appendLive := func(candidate uint64) {
+			if _, deleted := index.tombstones[candidate]; deleted {
+				return
+			}
+			if _, ok := added[candidate]; ok {
+				return
+			}
+			added[candidate] = struct{}{}
+			ids = append(ids, candidate)
+		}
+		for _, neighbor := range n.neighbors {
+			if inherited, deleted := deletedNeighbors[neighbor]; deleted {
+				for _, candidate := range inherited {
+					appendLive(candidate)
+				}
+				continue
+			}
+			appendLive(neighbor)
+		}
+
+		candidates, err := index.pruneCandidates(n.vector, ids, nil)
+		if err != nil {
+			return err
+		}
+		neighbors, err := index.robustPrune(candidates)
+		if err != nil {
+			return err
+		}
+		if err := index.writeNode(id, n.vector, neighbors); err != nil {
+			return err
+		}
+	}
+
+	for id := range index.tombstones {
+		if err := index.deleteNode(id); err != nil {
+			return err
+		}
+		index.nodes--
+	}
+	_, entryPointDeleted := index.tombstones[index.entryPoint]
+	index.tombstones = map[uint64]struct{}{}
+
+	if entryPointDeleted {
+		cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+		k, _ := cursor.First()
+		cursor.Close()
+		index.hasEntryPoint = k != nil
+		if k != nil {
+			index.entryPoint = binary.BigEndian.Uint64(k)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,769 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+const (
+	defaultCachePageSize = 32
+
+	// tombstones are consolidated once they make up this fraction of all
+	// nodes in the graph
+	consolidationRatio = 0.1
+)
+
+// diskann is a Vamana graph index in the spirit of DiskANN. The graph and
+// the full vectors are stored in an lsmkv bucket, only binary quantized
+// vectors are held in memory to guide the search. Exact distances are
+// calculated from the nodes that are read during the search, so no separate
+// rescoring step is required.
+//
+// Writes are serialized, searches run concurrently with each other.
+type diskann struct {
+	sync.RWMutex
+
+	id                string
+	targetVector      string
+	rootPath          string
+	store             *lsmkv.Store
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	bq                compressionhelpers.BinaryQuantizer
+	cache             cache.Cache[uint64]
+
+	maxDegree           int
+	alpha               float64
+	buildSearchListSize int
+	searchListSize      atomic.Int64
+	flatSearchCutoff    atomic.Int64
+
+	dims          atomic.Int32
+	count         atomic.Uint64
+	entryPoint    uint64
+	hasEntryPoint bool
+	nodes         int
+	tombstones    map[uint64]struct{}
+	consolidating atomic.Bool
+	shutdown      bool
+}
+
+func New(cfg Config, uc diskannent.UserConfig, store *lsmkv.Store) (*diskann, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &diskann{
+		id:                  cfg.ID,
+		targetVector:        cfg.TargetVector,
+		rootPath:            cfg.RootPath,
+		store:               store,
+		logger:              logger,
+		distancerProvider:   cfg.DistanceProvider,
+		bq:                  compressionhelpers.NewBinaryQuantizer(nil),
+		maxDegree:           uc.MaxDegree,
+		alpha:               uc.Alpha,
+		buildSearchListSize: uc.BuildSearchListSize,
+		tombstones:          map[uint64]struct{}{},
+	}
+	index.searchListSize.Store(int64(uc.SearchListSize))
+	index.flatSearchCutoff.Store(int64(uc.FlatSearchCutoff))
+
+	if err := index.initBuckets(context.Background()); err != nil {
+		return nil, fmt.Errorf("init diskann index buckets: %w", err)
+	}
+
+	index.cache = cache.NewShardedUInt64LockCache(index.getCompressedVector,
+		uc.VectorCacheMaxObjects, defaultCachePageSize, logger, 0, cfg.AllocChecker, "")
+
+	if err := index.restore(); err != nil {
+		return nil, fmt.Errorf("restore diskann index: %w", err)
+	}
+
+	return index, nil
+}
+
+func (index *diskann) graphBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsGraphBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsGraphBucketLSM
+}
+
+func (index *diskann) compressedBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsCompressedBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsCompressedBucketLSM
+}
+
+func (index *diskann) initBuckets(ctx context.Context) error {
+	if err := index.store.CreateOrLoadBucket(ctx, index.graphBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return fmt.Errorf("create or load diskann graph bucket: %w", err)
+	}
+	if err := index.store.CreateOrLoadBucket(ctx, index.compressedBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return fmt.Errorf("create or load diskann compressed vectors bucket: %w", err)
+	}
+	return nil
+}
+
+// restore loads the compressed vectors into memory and recovers the
+// entrypoint and the tombstones from them
+func (index *diskann) restore() error {
+	var vecs []compressionhelpers.VecAndID[uint64]
+	maxID := uint64(0)
+
+	cursor := index.store.Bucket(index.compressedBucketName()).Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		id := binary.BigEndian.Uint64(k)
+		flag, codes := decodeCompressed(v)
+		if flag == flagTombstone {
+			index.tombstones[id] = struct{}{}
+		} else if !index.hasEntryPoint {
+			index.entryPoint = id
+			index.hasEntryPoint = true
+		}
+		vecs = append(vecs, compressionhelpers.VecAndID[uint64]{Id: id, Vec: codes})
+		maxID = max(maxID, id)
+	}
+	cursor.Close()
+
+	if len(vecs) == 0 {
+		return nil
+	}
+	if !index.hasEntryPoint {
+		// only deleted nodes are left, they can still be navigated
+		index.entryPoint = vecs[0].Id
+		index.hasEntryPoint = true
+	}
+	index.nodes = len(vecs)
+	index.count.Store(uint64(len(vecs) - len(index.tombstones)))
+
+	n, ok, err := index.readNode(index.entryPoint)
+	if err != nil {
+		return err
+	}
+	if ok {
+		index.dims.Store(int32(len(n.vector)))
+	}
+
+	index.cache.LockAll()
+	defer index.cache.UnlockAll()
+	index.cache.SetSizeAndGrowNoLock(maxID)
+	for _, vec := range vecs {
+		index.cache.PreloadNoLock(vec.Id, vec.Vec)
+	}
+	return nil
+}
+
+func idKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func (index *diskann) getCompressedVector(ctx context.Context, id uint64) ([]uint64, error) {
+	data, err := index.store.Bucket(index.compressedBucketName()).Get(idKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	_, codes := decodeCompressed(data)
+	return codes, nil
+}
+
+func (index *diskann) readNode(id uint64) (node, bool, error) {
+	data, err := index.store.Bucket(index.graphBucketName()).Get(idKey(id))
+	if err != nil {
+		return node{}, false, err
+	}
+	if len(data) == 0 {
+		return node{}, false, nil
+	}
+	n, err := decodeNode(data)
+	if err != nil {
+		return node{}, false, fmt.Errorf("node %d: %w", id, err)
+	}
+	return n, true, nil
+}
+
+func (index *diskann) writeNode(id uint64, vector []float32, neighbors []uint64) error {
+	return index.store.Bucket(index.graphBucketName()).Put(idKey(id), encodeNode(vector, neighbors))
+}
+
+func (index *diskann) deleteNode(id uint64) error {
+	index.cache.Delete(context.Background(), id)
+	if err := index.store.Bucket(index.compressedBucketName()).Delete(idKey(id)); err != nil {
+		return err
+	}
+	return index.store.Bucket(index.graphBucketName()).Delete(idKey(id))
+}
+
+func (index *diskann) normalized(vector []float32) []float32 {
+	if index.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func (index *diskann) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := index.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *diskann) Add(ctx context.Context, id uint64, vector []float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := index.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+	index.dims.CompareAndSwap(0, int32(len(vector)))
+
+	vector = index.normalized(vector)
+	codes := index.bq.Encode(vector)
+
+	index.Lock()
+	defer index.Unlock()
+
+	if err := index.store.Bucket(index.compressedBucketName()).
+		Put(idKey(id), encodeCompressed(flagLive, codes)); err != nil {
+		return err
+	}
+	index.cache.Grow(id)
+	index.cache.Preload(id, codes)
+
+	_, existed, err := index.readNode(id)
+	if err != nil {
+		return err
+	}
+	if _, ok := index.tombstones[id]; ok {
+		delete(index.tombstones, id)
+		index.count.Add(1)
+	} else if !existed {
+		index.nodes++
+		index.count.Add(1)
+	}
+
+	if !index.hasEntryPoint {
+		index.entryPoint = id
+		index.hasEntryPoint = true
+		return index.writeNode(id, vector, nil)
+	}
+
+	visited := map[uint64][]float32{}
+	var ids []uint64
+	if err := index.beamSearch(ctx, vector, codes, index.buildSearchListSize,
+		func(candidate uint64, n node, _ float32) {
+			if candidate == id {
+				return
+			}
+			visited[candidate] = n.vector
+			ids = append(ids, candidate)
+		}); err != nil {
+		return err
+	}
+
+	candidates, err := index.pruneCandidates(vector, ids, visited)
+	if err != nil {
+		return err
+	}
+	neighbors, err := index.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+	if err := index.writeNode(id, vector, neighbors); err != nil {
+		return err
+	}
+
+	for _, neighbor := range neighbors {
+		if err := index.addReverseEdge(neighbor, id, vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *diskann) Delete(ids ...uint64) error {
+	index.Lock()
+	for _, id := range ids {
+		if _, ok := index.tombstones[id]; ok {
+			continue
+		}
+		data, err := index.store.Bucket(index.compressedBucketName()).Get(idKey(id))
+		if err != nil {
+			index.Unlock()
+			return err
+		}
+		if len(data) == 0 {
+			continue
+		}
+		// data may be the buffer of the memtable, it must not be changed in place
+		_, codes := decodeCompressed(data)
+		tombstoned := encodeCompressed(flagTombstone, codes)
+		if err := index.store.Bucket(index.compressedBucketName()).Put(idKey(id), tombstoned); err != nil {
+			index.Unlock()
+			return err
+		}
+		index.tombstones[id] = struct{}{}
+		index.count.Add(^uint64(0))
+	}
+	shouldConsolidate := float64(len(index.tombstones)) >= consolidationRatio*float64(index.nodes)
+	index.Unlock()
+
+	if shouldConsolidate && index.consolidating.CompareAndSwap(false, true) {
+		enterrors.GoWrapper(func() {
+			defer index.consolidating.Store(false)
+			if err := index.consolidate(); err != nil {
+				index.logger.WithField("action", "diskann_consolidate").
+					WithField("id", index.id).
+					WithError(err).Error("consolidate tombstones")
+			}
+		}, index.logger)
+	}
+	return nil
+}
+
+func (index *diskann) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	index.RLock()
+	defer index.RUnlock()
+
+	if !index.hasEntryPoint || k <= 0 {
+		return nil, nil, nil
+	}
+	if allow != nil && allow.IsEmpty() {
+		return nil, nil, nil
+	}
+
+	vector = index.normalized(vector)
+	if allow != nil && allow.Len() < int(index.flatSearchCutoff.Load()) {
+		return index.flatSearch(ctx, vector, k, allow)
+	}
+
+	results := priorityqueue.NewMax[any](k)
+	listSize := max(int(index.searchListSize.Load()), k)
+	if err := index.beamSearch(ctx, vector, index.bq.Encode(vector), listSize,
+		func(id uint64, _ node, dist float32) {
+			if _, deleted := index.tombstones[id]; deleted {
+				return
+			}
+			if allow != nil && !allow.Contains(id) {
+				return
+			}
+			insertToHeap(results, k, id, dist)
+		}); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := extractHeap(results)
+	return ids, dists, nil
+}
+
+// flatSearch reads every allowed node, this is cheaper than a graph search
+// when the filter is restrictive
+func (index *diskann) flatSearch(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	results := priorityqueue.NewMax[any](k)
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if _, deleted := index.tombstones[id]; deleted {
+			continue
+		}
+		n, exists, err := index.readNode(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !exists {
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(vector, n.vector)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(results, k, id, dist)
+	}
+
+	ids, dists := extractHeap(results)
+	return ids, dists, nil
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, distance float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, distance)
+	} else if heap.Top().Dist > distance {
+		heap.Pop()
+		heap.Insert(id, distance)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	len := heap.Len()
+
+	ids := make([]uint64, len)
+	dists := make([]float32, len)
+	for i := len - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists
+}
+
+func (index *diskann) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	var shouldContinue bool
+	var err error
+	for shouldContinue, err = recursiveSearch(); shouldContinue && err == nil; {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			index.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
+
+func (index *diskann) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(diskannent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// search parameters are read on every query, so they are stored
+	// atomically. The build parameters are only read while holding the lock.
+	index.searchListSize.Store(int64(parsed.SearchListSize))
+	index.flatSearchCutoff.Store(int64(parsed.FlatSearchCutoff))
+	index.cache.UpdateMaxSize(int64(parsed.VectorCacheMaxObjects))
+
+	index.Lock()
+	index.alpha = parsed.Alpha
+	index.buildSearchListSize = parsed.BuildSearchListSize
+	index.Unlock()
+
+	callback()
+	return nil
+}
+
+func (index *diskann) Drop(ctx context.Context) error {
+	index.Lock()
+	defer index.Unlock()
+
+	index.shutdown = true
+	index.cache.Drop()
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) Flush() error {
+	// nothing to do here
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) Shutdown(ctx context.Context) error {
+	index.Lock()
+	defer index.Unlock()
+
+	index.shutdown = true
+	index.cache.Drop()
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (index *diskann) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	// all state is kept in the store's buckets
+	return nil, nil
+}
+
+func (index *diskann) PostStartup() {
+	// the compressed vectors are already loaded as part of New, the
+	// entrypoint can not be determined without them
+}
+
+// Compressed is always true, only quantized vectors are held in memory
+func (index *diskann) Compressed() bool {
+	return true
+}
+
+func (index *diskann) ValidateBeforeInsert(vector []float32) error {
+	if dims := index.dims.Load(); dims != 0 && int(dims) != len(vector) {
+		return errors.Errorf("insert called with a vector of the wrong size: "+
+			"expected %d, got %d", dims, len(vector))
+	}
+	return nil
+}
+
+func (index *diskann) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("Entrypoint: %d\n", index.entryPoint)
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *diskann) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return index.distancerProvider.SingleDist(x, y)
+}
+
+func (index *diskann) ContainsNode(id uint64) bool {
+	index.RLock()
+	defer index.RUnlock()
+
+	if _, deleted := index.tombstones[id]; deleted {
+		return false
+	}
+	data, err := index.store.Bucket(index.compressedBucketName()).Get(idKey(id))
+	return err == nil && len(data) > 0
+}
+
+func (index *diskann) AlreadyIndexed() uint64 {
+	return index.count.Load()
+}
+
+func (index *diskann) Iterate(fn func(id uint64) bool) {
+	cursor := index.store.Bucket(index.compressedBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, v := cursor.First(); key != nil; key, v = cursor.Next() {
+		if len(v) > 0 && v[0] == flagTombstone {
+			continue
+		}
+		if !fn(binary.BigEndian.Uint64(key)) {
+			break
+		}
+	}
+}
+
+func (index *diskann) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *diskann) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = index.normalized(queryVector)
+	return common.QueryVectorDistancer{DistanceFunc: func(nodeID uint64) (float32, error) {
+		n, ok, err := index.readNode(nodeID)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, fmt.Errorf("node %d does not exist", nodeID)
+		}
+		return index.distancerProvider.SingleDist(queryVector, n.vector)
+	}}
+}
+
+// VectorCacheStats returns the access statistics of the compressed vector
+// cache
+func (index *diskann) VectorCacheStats() cache.Stats {
+	return index.cache.Stats()
+}
+
+func (index *diskann) Stats() (common.IndexStats, error) {
+	index.RLock()
+	defer index.RUnlock()
+
+	return &DiskANNStats{
+		Dimensions: index.dims.Load(),
+		Nodes:      index.nodes,
+		Tombstones: len(index.tombstones),
+	}, nil
+}
+
+type DiskANNStats struct {
+	Dimensions int32 `json:"dimensions"`
+	Nodes      int   `json:"nodes"`
+	Tombstones int   `json:"tombstones"`
+}
+
+func (s *DiskANNStats) IndexType() common.IndexType {
+	return common.IndexTypeDiskANN
+}
+
+type immutableParameter struct {
+	accessor func(c diskannent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next diskannent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
+
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(diskannent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(diskannent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	immutableFields := []immutableParameter{
+		{
+			name:     "distance",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.Distance },
+		},
+		{
+			name:     "maxDegree",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.MaxDegree },
+		},
+		{
+			name:     "dimensions",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.Dimensions },
+		},
+		{
+			name:     "normalizeOnWrite",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.NormalizeOnWrite },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+func distanceWrapper(provider distancer.Provider) func(x, y []float32) float32 {
+	return func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+func newTestIndex(t *testing.T, store *lsmkv.Store) *diskann {
+	uc := diskannent.NewDefaultUserConfig()
+	uc.MaxDegree = 32
+	// one bit per dimension is a coarse guide for these low dimensional
+	// random vectors, a longer list makes up for it
+	uc.SearchListSize = 400
+	uc.Distance = "l2-squared"
+	index, err := New(Config{
+		ID:               "diskann-test",
+		RootPath:         t.TempDir(),
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, uc, store)
+	require.Nil(t, err)
+	return index
+}
+
+func recall(t *testing.T, index *diskann, vectors, queries [][]float32, k int,
+	allow helpers.AllowList,
+) float32 {
+	logger, _ := test.NewNullLogger()
+	distance := distanceWrapper(distancer.NewL2SquaredProvider())
+
+	var found, total uint64
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, vectors, query, k, distance)
+		ids, _, err := index.SearchByVector(context.Background(), query, k, allow)
+		require.Nil(t, err)
+		found += testinghelpers.MatchesInLists(truth, ids)
+		total += uint64(len(truth))
+	}
+	return float32(found) / float32(total)
+}
+
+func TestDiskANNRecall(t *testing.T) {
+	ctx := context.Background()
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(ctx)
+
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(2000, 50, 32)
+	index := newTestIndex(t, store)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+	assert.GreaterOrEqual(t, recall(t, index, vectors, queries, 10, nil), float32(0.9))
+
+	t.Run("every node is within the degree bound", func(t *testing.T) {
+		for i := range vectors {
+			n, ok, err := index.readNode(uint64(i))
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.LessOrEqual(t, len(n.neighbors), index.maxDegree)
+		}
+	})
+
+	t.Run("restrictive filter falls back to a flat search", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		filtered := make([][]float32, len(vectors))
+		for i := 0; i < len(vectors); i += 20 {
+			allow.Insert(uint64(i))
+			filtered[i] = vectors[i]
+		}
+		assert.Equal(t, float32(1), recall(t, index, filtered, queries, 10, allow))
+	})
+
+	t.Run("vectors of a different size are rejected", func(t *testing.T) {
+		err := index.Add(ctx, uint64(len(vectors)), make([]float32, 3))
+		assert.ErrorContains(t, err, "wrong size")
+	})
+}
+
+func TestDiskANNDelete(t *testing.T) {
+	ctx := context.Background()
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(ctx)
+
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(1000, 20, 32)
+	index := newTestIndex(t, store)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	// delete below the consolidation threshold first, the nodes must no
+	// longer be returned even though they are still part of the graph
+	remaining := make([][]float32, len(vectors))
+	copy(remaining, vectors)
+	for i := 0; i < 50; i++ {
+		require.Nil(t, index.Delete(uint64(i)))
+		remaining[i] = nil
+	}
+	assert.False(t, index.ContainsNode(0))
+	assert.True(t, index.ContainsNode(50))
+	assert.Equal(t, uint64(950), index.AlreadyIndexed())
+	assert.GreaterOrEqual(t, recall(t, index, remaining, queries, 10, nil), float32(0.9))
+
+	for i := 50; i < 200; i++ {
+		require.Nil(t, index.Delete(uint64(i)))
+		remaining[i] = nil
+	}
+
+	require.Eventually(t, func() bool {
+		stats, err := index.Stats()
+		require.Nil(t, err)
+		return stats.(*DiskANNStats).Tombstones == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stats, err := index.Stats()
+	require.Nil(t, err)
+	assert.Equal(t, 800, stats.(*DiskANNStats).Nodes)
+
+	for i := 200; i < len(vectors); i++ {
+		n, ok, err := index.readNode(uint64(i))
+		require.Nil(t, err)
+		require.True(t, ok)
+		for _, neighbor := range n.neighbors {
+			assert.GreaterOrEqual(t, neighbor, uint64(200), "edge to a removed node")
+		}
+	}
+	assert.GreaterOrEqual(t, recall(t, index, remaining, queries, 10, nil), float32(0.9))
+}
+
+func TestDiskANNRestore(t *testing.T) {
+	ctx := context.Background()
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(ctx)
+
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(500, 20, 32)
+	index := newTestIndex(t, store)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+	require.Nil(t, index.Delete(3))
+	require.Nil(t, index.Shutdown(ctx))
+
+	restored := newTestIndex(t, store)
+	assert.Equal(t, uint64(len(vectors)-1), restored.AlreadyIndexed())
+	assert.Equal(t, int32(32), restored.dims.Load())
+	assert.False(t, restored.ContainsNode(3))
+	assert.True(t, restored.ContainsNode(4))
+
+	vectors[3] = nil
+	assert.GreaterOrEqual(t, recall(t, restored, vectors, queries, 10, nil), float32(0.9))
+}
+
+func TestDiskANNValidateUserConfigUpdate(t *testing.T) {
+	initial := diskannent.NewDefaultUserConfig()
+
+	updated := initial
+	updated.SearchListSize = 200
+	updated.Alpha = 1.4
+	assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+
+	updated = initial
+	updated.MaxDegree = 16
+	assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "maxDegree is immutable")
+}
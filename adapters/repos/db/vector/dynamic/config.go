@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
@@ -70,6 +71,10 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "normalizeOnWrite",
+			accessor: func(c ent.UserConfig) interface{} { return c.NormalizeOnWrite },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -77,6 +82,9 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			return err
 		}
 	}
+	if err := vectorIndexCommon.ValidateDimensionsUpdate(initialParsed.Dimensions, updatedParsed.Dimensions); err != nil {
+		return err
+	}
 	if err := flat.ValidateUserConfigUpdate(initialParsed.FlatUC, updatedParsed.FlatUC); err != nil {
 		return err
 	}
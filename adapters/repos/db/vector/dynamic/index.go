@@ -28,6 +28,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
@@ -74,6 +75,7 @@ type VectorIndex interface {
 	// If the callback returns false, the iteration will stop.
 	Iterate(fn func(id uint64) bool)
 	Stats() (common.IndexStats, error)
+	VectorCacheStats() cache.Stats
 }
 
 type upgradableIndexer interface {
@@ -215,6 +217,20 @@ func (dynamic *dynamic) Compressed() bool {
 	return dynamic.index.Compressed()
 }
 
+func (dynamic *dynamic) VectorCacheStats() cache.Stats {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	return dynamic.index.VectorCacheStats()
+}
+
+func (dynamic *dynamic) ObserveRecall(recall float64) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if observer, ok := dynamic.index.(interface{ ObserveRecall(recall float64) }); ok {
+		observer.ObserveRecall(recall)
+	}
+}
+
 func (dynamic *dynamic) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -313,6 +329,21 @@ func (dynamic *dynamic) PostStartup() {
 	dynamic.index.PostStartup()
 }
 
+// WaitForCachePrefill waits for the cache of the underlying index, if it
+// prefills its cache in the background
+func (dynamic *dynamic) WaitForCachePrefill(ctx context.Context) error {
+	dynamic.RLock()
+	index := dynamic.index
+	dynamic.RUnlock()
+
+	if prefiller, ok := index.(interface {
+		WaitForCachePrefill(ctx context.Context) error
+	}); ok {
+		return prefiller.WaitForCachePrefill(ctx)
+	}
+	return nil
+}
+
 func (dynamic *dynamic) Dump(labels ...string) {
 	if len(labels) > 0 {
 		fmt.Printf("--------------------------------------------------\n")
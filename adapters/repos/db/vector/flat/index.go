@@ -38,6 +38,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	bolt "go.etcd.io/bbolt"
@@ -106,7 +107,8 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 
 	if uc.BQ.Enabled && uc.BQ.Cache {
 		index.bqCache = cache.NewShardedUInt64LockCache(
-			index.getBQVector, uc.VectorCacheMaxObjects, defaultCachePageSize, cfg.Logger, 0, cfg.AllocChecker)
+			index.getBQVector, uc.VectorCacheMaxObjects, defaultCachePageSize, logger, 0, cfg.AllocChecker,
+			uc.VectorCacheAdmission)
 	}
 
 	if err := index.initMetadata(); err != nil {
@@ -186,6 +188,14 @@ func (index *flat) Compressed() bool {
 	return index.compression != compressionNone
 }
 
+// VectorCacheStats returns the access statistics of the bq cache
+func (index *flat) VectorCacheStats() cache.Stats {
+	if index.bqCache == nil {
+		return cache.Stats{}
+	}
+	return index.bqCache.Stats()
+}
+
 func (index *flat) getBucketName() string {
 	if index.targetVector != "" {
 		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, index.targetVector)
@@ -723,6 +733,9 @@ func (index *flat) Drop(ctx context.Context) error {
 			return err
 		}
 	}
+	if index.bqCache != nil {
+		index.bqCache.Drop()
+	}
 	// Shard::drop will take care of handling store's buckets
 	return nil
 }
@@ -739,6 +752,9 @@ func (index *flat) Shutdown(ctx context.Context) error {
 			return errors.Wrap(err, "close metadata")
 		}
 	}
+	if index.bqCache != nil {
+		index.bqCache.Drop()
+	}
 	// Shard::shutdown will take care of handling store's buckets
 	return nil
 }
@@ -941,6 +957,14 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c flatent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "normalizeOnWrite",
+			accessor: func(c flatent.UserConfig) interface{} { return c.NormalizeOnWrite },
+		},
+		{
+			name:     "vectorCacheAdmission",
+			accessor: func(c flatent.UserConfig) interface{} { return c.VectorCacheAdmission },
+		},
 		{
 			name:     "pq.cache",
 			accessor: func(c flatent.UserConfig) interface{} { return c.PQ.Cache },
@@ -964,6 +988,9 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			return err
 		}
 	}
+	if err := vectorIndexCommon.ValidateDimensionsUpdate(initialParsed.Dimensions, updatedParsed.Dimensions); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+)
+
+const (
+	// EFOverrideHeader sets ef for a single query, regardless of the
+	// configured ef or autoEf. The override only applies to shards that are
+	// searched on the node receiving the query.
+	EFOverrideHeader = "X-Weaviate-Ef"
+
+	// autoEf keeps the recall measured through the recall endpoint within
+	// this range by scaling ef
+	autoEFTargetRecall    = 0.95
+	autoEFRecallTolerance = 0.02
+
+	autoEFMinRecallFactor = 0.5
+	autoEFMaxRecallFactor = 4
+	// filters that match only few vectors require a larger ef to find k
+	// matches, but the increase is capped
+	autoEFMaxFilterFactor = 8
+)
+
+// efFromContext returns a per-query ef set through the EFOverrideHeader,
+// either as an HTTP header or as gRPC metadata
+func efFromContext(ctx context.Context) (int, bool) {
+	var value string
+	if v, ok := ctx.Value(EFOverrideHeader).([]string); ok && len(v) > 0 {
+		value = v[0]
+	} else if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(strings.ToLower(EFOverrideHeader)); len(v) > 0 {
+			value = v[0]
+		}
+	}
+	if value == "" {
+		return 0, false
+	}
+
+	ef, err := strconv.Atoi(value)
+	if err != nil || ef < 1 {
+		return 0, false
+	}
+	return ef, true
+}
+
+// queryEF picks ef for a search. A per-query override takes precedence, then
+// autoEf if enabled, then the configured (dynamic) ef.
+func (h *hnsw) queryEF(ctx context.Context, k int, allowList helpers.AllowList) int {
+	if ef, ok := efFromContext(ctx); ok {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_ef_override", ef)
+		return max(ef, k)
+	}
+
+	if !h.autoEF.Load() {
+		return h.searchTimeEF(k)
+	}
+
+	ef := h.adaptiveEF(k, allowList)
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_auto_ef", ef)
+	return ef
+}
+
+// adaptiveEF starts from the dynamic ef for k and scales it by the
+// selectivity of the filter and by the recall factor, which is adjusted from
+// recall measurements. The result stays within dynamicEfMin and dynamicEfMax,
+// but is never smaller than k.
+func (h *hnsw) adaptiveEF(k int, allowList helpers.AllowList) int {
+	ef := float64(h.autoEfFromK(k))
+
+	if allowList != nil {
+		h.RLock()
+		size := len(h.nodes)
+		h.RUnlock()
+
+		if size > 0 && allowList.Len() > 0 && allowList.Len() < size {
+			selectivity := float64(allowList.Len()) / float64(size)
+			ef *= math.Min(1/math.Sqrt(selectivity), autoEFMaxFilterFactor)
+		}
+	}
+
+	ef *= h.recallFactor()
+
+	efMin := int(atomic.LoadInt64(&h.efMin))
+	efMax := int(atomic.LoadInt64(&h.efMax))
+	out := min(max(int(ef), efMin), efMax)
+	return max(out, k)
+}
+
+func (h *hnsw) recallFactor() float64 {
+	bits := h.autoEFRecallFactor.Load()
+	if bits == 0 {
+		return 1
+	}
+	return math.Float64frombits(bits)
+}
+
+// ObserveRecall adjusts the ef used by autoEf after the recall of the index
+// was measured. Low recall increases ef, recall well above the target lowers
+// it again to save latency.
+func (h *hnsw) ObserveRecall(recall float64) {
+	if !h.autoEF.Load() {
+		return
+	}
+
+	factor := h.recallFactor()
+	switch {
+	case recall < autoEFTargetRecall:
+		factor *= 1.25
+	case recall > autoEFTargetRecall+autoEFRecallTolerance:
+		factor *= 0.9
+	default:
+		return
+	}
+	factor = math.Min(math.Max(factor, autoEFMinRecallFactor), autoEFMaxRecallFactor)
+	h.autoEFRecallFactor.Store(math.Float64bits(factor))
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+)
+
+func TestEFFromContext(t *testing.T) {
+	t.Run("no override", func(t *testing.T) {
+		_, ok := efFromContext(context.Background())
+		assert.False(t, ok)
+	})
+
+	t.Run("http header", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), EFOverrideHeader, []string{"300"})
+		ef, ok := efFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, 300, ef)
+	})
+
+	t.Run("grpc metadata", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs("x-weaviate-ef", "64"))
+		ef, ok := efFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, 64, ef)
+	})
+
+	t.Run("invalid values are ignored", func(t *testing.T) {
+		for _, value := range []string{"", "abc", "0", "-5"} {
+			ctx := context.WithValue(context.Background(), EFOverrideHeader, []string{value})
+			_, ok := efFromContext(ctx)
+			assert.False(t, ok, value)
+		}
+	})
+}
+
+func TestAutoEF(t *testing.T) {
+	newIndex := func() *hnsw {
+		h := &hnsw{
+			ef:       100,
+			efMin:    50,
+			efMax:    1000,
+			efFactor: 8,
+			nodes:    make([]*vertex, 10_000),
+		}
+		h.autoEF.Store(true)
+		return h
+	}
+	ctx := context.Background()
+
+	t.Run("disabled uses the configured ef", func(t *testing.T) {
+		h := newIndex()
+		h.autoEF.Store(false)
+		assert.Equal(t, 100, h.queryEF(ctx, 10, nil))
+	})
+
+	t.Run("derived from the limit", func(t *testing.T) {
+		h := newIndex()
+		assert.Equal(t, 80, h.queryEF(ctx, 10, nil))
+		assert.Equal(t, 50, h.queryEF(ctx, 2, nil))
+		assert.Equal(t, 1000, h.queryEF(ctx, 500, nil))
+		assert.Equal(t, 2000, h.queryEF(ctx, 2000, nil))
+	})
+
+	t.Run("selective filters increase ef", func(t *testing.T) {
+		h := newIndex()
+		allowList := helpers.NewAllowList()
+		for id := uint64(0); id < 100; id++ {
+			allowList.Insert(id)
+		}
+
+		// 1% of the vectors match, so ef grows by the capped factor
+		assert.Equal(t, 640, h.queryEF(ctx, 10, allowList))
+
+		for id := uint64(100); id < 2500; id++ {
+			allowList.Insert(id)
+		}
+		// 25% match
+		assert.Equal(t, 160, h.queryEF(ctx, 10, allowList))
+	})
+
+	t.Run("recall measurements tune ef", func(t *testing.T) {
+		h := newIndex()
+		h.ObserveRecall(0.8)
+		assert.Equal(t, 100, h.queryEF(ctx, 10, nil))
+
+		// within the target range nothing changes
+		h.ObserveRecall(0.96)
+		assert.Equal(t, 100, h.queryEF(ctx, 10, nil))
+
+		h.ObserveRecall(1)
+		assert.Equal(t, 90, h.queryEF(ctx, 10, nil))
+
+		for i := 0; i < 20; i++ {
+			h.ObserveRecall(0.5)
+		}
+		assert.Equal(t, 80*autoEFMaxRecallFactor, h.queryEF(ctx, 10, nil))
+	})
+
+	t.Run("recall is only tracked with autoEf", func(t *testing.T) {
+		h := newIndex()
+		h.autoEF.Store(false)
+		h.ObserveRecall(0.5)
+		assert.Equal(t, float64(1), h.recallFactor())
+	})
+
+	t.Run("per-query override wins", func(t *testing.T) {
+		h := newIndex()
+		ctx := context.WithValue(ctx, EFOverrideHeader, []string{"42"})
+		assert.Equal(t, 42, h.queryEF(ctx, 10, nil))
+		assert.Equal(t, 64, h.queryEF(ctx, 64, nil))
+	})
+}
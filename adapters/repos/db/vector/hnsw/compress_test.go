@@ -18,12 +18,14 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/storobj"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -88,3 +90,95 @@ func Test_NoRaceCompressReturnsErrorWhenNotEnoughData(t *testing.T) {
 	err := index.compress(uc)
 	assert.NotNil(t, err)
 }
+
+func Test_NoRaceFloat16Precision(t *testing.T) {
+	dimensions := 32
+	vectors, queries := testinghelpers.RandomVecs(200, 10, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Precision = ent.PrecisionFloat16
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "float16",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	assert.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	assert.True(t, index.Compressed())
+	assert.Nil(t, compressionhelpers.ConcurrentlyWithError(logger, uint64(len(vectors)), func(id uint64) error {
+		return index.Add(ctx, id, vectors[id])
+	}))
+
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, vectors, query, 1, func(x, y []float32) float32 {
+			dist, _ := distancer.SingleDist(x, y)
+			return dist
+		})
+		results, _, err := index.SearchByVector(ctx, query, 1, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, truth, results)
+	}
+
+	stats, err := index.Stats()
+	assert.Nil(t, err)
+	hnswStats := stats.(*HnswStats)
+	assert.Equal(t, ent.PrecisionFloat16, hnswStats.Precision)
+	assert.Equal(t, int64(hnswStats.CacheSize)*int64(dimensions)*2, hnswStats.MemorySavedBytes)
+}
+
+func TestVectorCacheStatsOfCompressedIndex(t *testing.T) {
+	dimensions := 16
+	vectors, queries := testinghelpers.RandomVecs(50, 1, dimensions)
+	ctx := context.Background()
+
+	for name, configure := range map[string]func(*ent.UserConfig){
+		"bq":      func(uc *ent.UserConfig) { uc.BQ.Enabled = true },
+		"float16": func(uc *ent.UserConfig) { uc.Precision = ent.PrecisionFloat16 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			uc := ent.NewDefaultUserConfig()
+			uc.VectorCacheAdmission = vectorIndexCommon.VectorCacheAdmissionTinyLFU
+			configure(&uc)
+
+			index, err := New(Config{
+				RootPath:              t.TempDir(),
+				ID:                    name,
+				MakeCommitLoggerThunk: MakeNoopCommitLogger,
+				DistanceProvider:      distancer.NewCosineDistanceProvider(),
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					return vectors[int(id)], nil
+				},
+				TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+					copy(container.Slice, vectors[int(id)])
+					return container.Slice, nil
+				},
+			}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			require.Nil(t, err)
+			defer index.Shutdown(context.Background())
+
+			for id, vec := range vectors {
+				require.Nil(t, index.Add(ctx, uint64(id), vec))
+			}
+			_, _, err = index.SearchByVector(ctx, queries[0], 5, nil)
+			require.Nil(t, err)
+
+			assert.NotPanics(t, func() { index.VectorCacheStats() })
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/schema/config"
 
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -56,6 +57,19 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "precision",
+			accessor: func(c ent.UserConfig) interface{} { return c.Precision },
+		},
+		{
+			// vectors which are already stored would not be normalized
+			name:     "normalizeOnWrite",
+			accessor: func(c ent.UserConfig) interface{} { return c.NormalizeOnWrite },
+		},
+		{
+			name:     "vectorCacheAdmission",
+			accessor: func(c ent.UserConfig) interface{} { return c.VectorCacheAdmission },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -63,6 +77,9 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			return err
 		}
 	}
+	if err := vectorIndexCommon.ValidateDimensionsUpdate(initialParsed.Dimensions, updatedParsed.Dimensions); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -101,6 +118,7 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
 
 	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
+	h.autoEF.Store(parsed.AutoEF)
 
 	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled {
 		callback()
@@ -63,6 +63,20 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:          "declaring dimensions",
+				initial:       ent.UserConfig{},
+				update:        ent.UserConfig{Dimensions: 384},
+				expectedError: nil,
+			},
+			{
+				name:    "attempting to change dimensions",
+				initial: ent.UserConfig{Dimensions: 384},
+				update:  ent.UserConfig{Dimensions: 768},
+				expectedError: errors.Errorf(
+					"dimensions is immutable: " +
+						"attempted change from \"384\" to \"768\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
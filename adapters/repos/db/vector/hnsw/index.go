@@ -92,6 +92,11 @@ type hnsw struct {
 	efMax    int64
 	efFactor int64
 
+	// autoEF picks ef per query, autoEFRecallFactor holds the float64 bits of
+	// the factor learned from recall measurements (0 means 1)
+	autoEF             atomic.Bool
+	autoEFRecallFactor atomic.Uint64
+
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff      int64
 	flatSearchConcurrency int
@@ -108,6 +113,10 @@ type hnsw struct {
 
 	cache               cache.Cache[float32]
 	waitForCachePrefill bool
+	// cachePrefilled is closed once the prefill started by PostStartup is
+	// done, see WaitForCachePrefill
+	cachePrefilled      chan struct{}
+	cachePrefillStarted atomic.Bool
 
 	commitLog CommitLogger
 
@@ -165,6 +174,7 @@ type hnsw struct {
 	pqConfig   ent.PQConfig
 	bqConfig   ent.BQConfig
 	sqConfig   ent.SQConfig
+	precision  string
 	// rescoring compressed vectors is disk-bound. On cold starts, we cannot
 	// rescore sequentially, as that would take very long. This setting allows us
 	// to define the rescoring concurrency.
@@ -237,7 +247,7 @@ func New(cfg Config, uc ent.UserConfig,
 	}
 
 	vectorCache := cache.NewShardedFloat32LockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects, 1,
-		cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
+		cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker, uc.VectorCacheAdmission)
 
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
 	shutdownCtx, shutdownCtxCancel := context.WithCancel(context.Background())
@@ -255,6 +265,7 @@ func New(cfg Config, uc ent.UserConfig,
 		nodes:                 make([]*vertex, cache.InitialSize),
 		cache:                 vectorCache,
 		waitForCachePrefill:   cfg.WaitForCachePrefill,
+		cachePrefilled:        make(chan struct{}),
 		vectorForID:           vectorCache.Get,
 		multiVectorForID:      vectorCache.MultiGet,
 		id:                    cfg.ID,
@@ -287,6 +298,7 @@ func New(cfg Config, uc ent.UserConfig,
 		pqConfig:             uc.PQ,
 		bqConfig:             uc.BQ,
 		sqConfig:             uc.SQ,
+		precision:            uc.Precision,
 		rescoreConcurrency:   2 * runtime.GOMAXPROCS(0), // our default for IO-bound activties
 		shardedNodeLocks:     common.NewDefaultShardedRWLocks(),
 
@@ -295,6 +307,7 @@ func New(cfg Config, uc ent.UserConfig,
 		visitedListPoolMaxSize: cfg.VisitedListPoolMaxSize,
 	}
 	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
+	index.autoEF.Store(uc.AutoEF)
 
 	if uc.BQ.Enabled {
 		var err error
@@ -307,6 +320,19 @@ func New(cfg Config, uc ent.UserConfig,
 		index.compressed.Store(true)
 		index.cache.Drop()
 		index.cache = nil
+	} else if uc.Precision == ent.PrecisionFloat16 {
+		// like bq, half precision needs no training data and can be used from
+		// the first insert on
+		var err error
+		index.compressor, err = compressionhelpers.NewFloat16Compressor(
+			index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
+			cfg.AllocChecker)
+		if err != nil {
+			return nil, err
+		}
+		index.compressed.Store(true)
+		index.cache.Drop()
+		index.cache = nil
 	}
 
 	if err := index.init(cfg); err != nil {
@@ -714,6 +740,22 @@ func (h *hnsw) Compressed() bool {
 	return h.compressed.Load()
 }
 
+// VectorCacheStats returns the access statistics of the vector cache in use,
+// which is the compressed one once the index is compressed. They are only
+// collected if an admission policy is configured.
+func (h *hnsw) VectorCacheStats() cache.Stats {
+	if h.compressed.Load() {
+		if h.compressor == nil {
+			return cache.Stats{}
+		}
+		return h.compressor.CacheStats()
+	}
+	if h.cache == nil {
+		return cache.Stats{}
+	}
+	return h.cache.Stats()
+}
+
 func (h *hnsw) Upgraded() bool {
 	return h.Compressed()
 }
@@ -811,6 +853,10 @@ type HnswStats struct {
 	NumTombstones      int          `json:"numTombstones"`
 	CacheSize          int32        `json:"cacheSize"`
 	PQConfiguration    ent.PQConfig `json:"pqConfiguration"`
+	Precision          string       `json:"precision,omitempty"`
+	// MemorySavedBytes is an estimate of the memory saved by storing vectors
+	// in a lower precision than float32
+	MemorySavedBytes int64 `json:"memorySavedBytes,omitempty"`
 }
 
 func (s *HnswStats) IndexType() common.IndexType {
@@ -848,9 +894,30 @@ func (h *hnsw) Stats() (common.IndexStats, error) {
 		DistributionLayers: distributionLayers,
 		UnreachablePoints:  h.calculateUnreachablePoints(),
 		NumTombstones:      len(h.tombstones),
-		CacheSize:          h.cache.Len(),
 		PQConfiguration:    h.pqConfig,
+		Precision:          h.precision,
+	}
+	if h.compressed.Load() {
+		stats.CacheSize = int32(h.compressor.CountVectors())
+		stats.MemorySavedBytes = h.memorySavedBytes(stats.CacheSize)
+	} else {
+		stats.CacheSize = h.cache.Len()
 	}
 
 	return &stats, nil
 }
+
+// memorySavedBytes estimates the memory saved by the configured precision
+// compared to float32 vectors
+func (h *hnsw) memorySavedBytes(vectors int32) int64 {
+	var savedPerDim int64
+	switch h.precision {
+	case ent.PrecisionFloat16:
+		savedPerDim = 2
+	case ent.PrecisionInt8:
+		savedPerDim = 3
+	default:
+		return 0
+	}
+	return int64(vectors) * int64(atomic.LoadInt32(&h.dims)) * savedPerDim
+}
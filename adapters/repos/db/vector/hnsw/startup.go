@@ -238,6 +238,22 @@ func (h *hnsw) PostStartup() {
 	h.prefillCache()
 }
 
+// WaitForCachePrefill blocks until the vector cache was prefilled after
+// startup. Indexes which were not started through PostStartup, e.g. the ones
+// created by upgrading a dynamic index, don't prefill and return right away.
+func (h *hnsw) WaitForCachePrefill(ctx context.Context) error {
+	if !h.cachePrefillStarted.Load() {
+		return nil
+	}
+
+	select {
+	case <-h.cachePrefilled:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (h *hnsw) prefillCache() {
 	limit := 0
 	if h.compressed.Load() {
@@ -246,7 +262,10 @@ func (h *hnsw) prefillCache() {
 		limit = int(h.cache.CopyMaxSize())
 	}
 
+	h.cachePrefillStarted.Store(true)
 	f := func() {
+		defer close(h.cachePrefilled)
+
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
 		defer cancel()
 
@@ -17,6 +17,7 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 )
 
@@ -73,6 +74,40 @@ func TestVectorCachePrefilling(t *testing.T) {
 	})
 }
 
+func TestWaitForCachePrefill(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	newIndex := func() *hnsw {
+		return &hnsw{
+			nodes:               generateDummyVertices(100),
+			currentMaximumLayer: 3,
+			shardedNodeLocks:    common.NewDefaultShardedRWLocks(),
+			cache:               newFakeCache(),
+			cachePrefilled:      make(chan struct{}),
+			waitForCachePrefill: true,
+			logger:              logger,
+		}
+	}
+
+	t.Run("without a prefill", func(t *testing.T) {
+		assert.Nil(t, newIndex().WaitForCachePrefill(ctx))
+	})
+
+	t.Run("after the prefill", func(t *testing.T) {
+		index := newIndex()
+		index.prefillCache()
+		assert.Nil(t, index.WaitForCachePrefill(ctx))
+	})
+
+	t.Run("while the prefill runs", func(t *testing.T) {
+		index := newIndex()
+		index.cachePrefillStarted.Store(true)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, index.WaitForCachePrefill(ctx), context.Canceled)
+	})
+}
+
 func newFakeCache() *fakeCache {
 	return &fakeCache{
 		store: map[uint64]struct{}{},
@@ -156,6 +191,10 @@ func (f *fakeCache) CountVectors() int64 {
 	panic("not implemented")
 }
 
+func (f *fakeCache) Stats() cache.Stats {
+	panic("not implemented")
+}
+
 func generateDummyVertices(amount int) []*vertex {
 	out := make([]*vertex, amount)
 	for i := range out {
@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+)
+
+var ErrVectorDimensionMismatch = errors.New("vector dimension mismatch")
+
+// seedDimensionsMaxObjects is how many objects of a shard are read to seed
+// the dimensions of its class when it is loaded
+const seedDimensionsMaxObjects = 100
+
+// dimensionRegistry keeps track of the vector dimensions of a class per
+// target vector. Dimensions are either declared in the vector index config
+// or recorded once the first vector was written successfully. The zero
+// value is ready to use.
+//
+// Recorded dimensions are kept in memory only. After the first import they
+// are declared in the vector index config through the schema, see
+// objects.declareVectorDimensions, which makes them known on all nodes and
+// across restarts. Until the schema update arrives, and for classes imported
+// before dimensions were declared, the dimensions of the objects of a shard
+// are recorded again when it is loaded, see Shard.seedDimensions.
+type dimensionRegistry struct {
+	sync.RWMutex
+	dims map[string]int
+}
+
+// get returns the dimensions for the target vector, declared dimensions take
+// precedence over recorded ones. 0 means that the dimensions are not known
+// yet.
+func (r *dimensionRegistry) get(targetVector string, cfg schemaConfig.VectorIndexConfig) int {
+	if declared := vectorindex.DeclaredDimensions(cfg); declared > 0 {
+		return declared
+	}
+
+	r.RLock()
+	defer r.RUnlock()
+	return r.dims[targetVector]
+}
+
+// record keeps the dimensions of a vector which was written, unless the
+// dimensions of the target vector are declared or known already
+func (r *dimensionRegistry) record(targetVector string, dims int,
+	cfg schemaConfig.VectorIndexConfig,
+) {
+	if dims == 0 || vectorindex.DeclaredDimensions(cfg) > 0 {
+		return
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.dims == nil {
+		r.dims = map[string]int{}
+	}
+	if _, ok := r.dims[targetVector]; !ok {
+		r.dims[targetVector] = dims
+	}
+}
+
+// check validates the vector against the known dimensions of the target
+// vector
+func (r *dimensionRegistry) check(targetVector string, vector []float32,
+	cfg schemaConfig.VectorIndexConfig,
+) error {
+	dims := r.get(targetVector, cfg)
+	if dims == 0 || len(vector) == 0 {
+		return nil
+	}
+	return checkDimensions(targetVector, len(vector), dims)
+}
+
+func checkDimensions(targetVector string, got, want int) error {
+	if got == want {
+		return nil
+	}
+	if targetVector == "" {
+		return fmt.Errorf("%w: vector has %d dimensions, but the class expects %d",
+			ErrVectorDimensionMismatch, got, want)
+	}
+	return fmt.Errorf("%w: vector %q has %d dimensions, but the class expects %d",
+		ErrVectorDimensionMismatch, targetVector, got, want)
+}
+
+// vectorIndexConfigFor returns the vector index config of the given target
+// vector, or the legacy config if no target vector is set
+func (i *Index) vectorIndexConfigFor(targetVector string) schemaConfig.VectorIndexConfig {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	if targetVector == "" {
+		return i.vectorIndexUserConfig
+	}
+	return i.vectorIndexUserConfigs[targetVector]
+}
+
+// checkObjectDimensions checks all vectors of the object against the
+// dimensions registered for the class
+func (i *Index) checkObjectDimensions(vector []float32, vectors map[string][]float32) error {
+	if err := i.dimensions.check("", vector, i.vectorIndexConfigFor("")); err != nil {
+		return err
+	}
+	for target, vec := range vectors {
+		if err := i.dimensions.check(target, vec, i.vectorIndexConfigFor(target)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordObjectDimensions records the dimensions of the vectors of an object
+// which was written successfully
+func (i *Index) recordObjectDimensions(vector []float32, vectors map[string][]float32) {
+	i.dimensions.record("", len(vector), i.vectorIndexConfigFor(""))
+	for target, vec := range vectors {
+		i.dimensions.record(target, len(vec), i.vectorIndexConfigFor(target))
+	}
+}
+
+// seedDimensions records the dimensions of the vectors the shard contains
+// already, so that they are known after a restart before the next write. At
+// most seedDimensionsMaxObjects objects are read.
+func (s *Shard) seedDimensions() error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return nil
+	}
+
+	c := bucket.Cursor()
+	defer c.Close()
+	n := 0
+	for k, v := c.First(); k != nil && n < seedDimensionsMaxObjects; k, v = c.Next() {
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return fmt.Errorf("unmarshal object: %w", err)
+		}
+		s.index.recordObjectDimensions(obj.Vector, obj.Vectors)
+		n++
+	}
+	return nil
+}
+
+// validateSearchDimensions checks the search vectors against the dimensions
+// registered for the class
+func (i *Index) validateSearchDimensions(searchVectors [][]float32, targetVectors []string) error {
+	for pos, vec := range searchVectors {
+		target := ""
+		if pos < len(targetVectors) {
+			target = targetVectors[pos]
+		}
+		if err := i.dimensions.check(target, vec, i.vectorIndexConfigFor(target)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
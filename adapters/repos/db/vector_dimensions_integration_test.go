@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_SeedDimensions(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "SeedDimensionsClass"}
+	cfg := hnsw.NewDefaultUserConfig()
+
+	shd, idx := testShard(t, ctx, class.Class)
+	defer idx.drop()
+
+	objects := createRandomObjects(getRandomSeed(), class.Class, 3, 16)
+	for _, obj := range objects {
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+
+	// as after a restart
+	idx.dimensions = dimensionRegistry{}
+	assert.Equal(t, 0, idx.dimensions.get("", cfg))
+
+	require.Nil(t, shd.(*LazyLoadShard).shard.seedDimensions())
+	assert.Equal(t, 16, idx.dimensions.get("", cfg))
+}
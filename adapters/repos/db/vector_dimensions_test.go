@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDimensionRegistry(t *testing.T) {
+	undeclared := hnsw.NewDefaultUserConfig()
+	declared := hnsw.NewDefaultUserConfig()
+	declared.Dimensions = 3
+
+	t.Run("records dimensions of written vectors", func(t *testing.T) {
+		r := dimensionRegistry{}
+
+		require.Nil(t, r.check("", []float32{1, 2}, undeclared))
+		assert.Equal(t, 0, r.get("", undeclared), "checking doesn't record")
+		r.record("", 2, undeclared)
+		assert.Equal(t, 2, r.get("", undeclared))
+		r.record("", 3, undeclared)
+		assert.Equal(t, 2, r.get("", undeclared), "the first write wins")
+
+		err := r.check("", []float32{1, 2, 3}, undeclared)
+		assert.ErrorIs(t, err, ErrVectorDimensionMismatch)
+		assert.ErrorContains(t, err, "vector has 3 dimensions, but the class expects 2")
+
+		err = r.check("", []float32{1}, undeclared)
+		assert.ErrorIs(t, err, ErrVectorDimensionMismatch)
+	})
+
+	t.Run("target vectors are tracked separately", func(t *testing.T) {
+		r := dimensionRegistry{}
+
+		r.record("a", 2, undeclared)
+		r.record("b", 3, undeclared)
+
+		err := r.check("b", []float32{1, 2}, undeclared)
+		assert.ErrorContains(t, err, `vector "b" has 2 dimensions, but the class expects 3`)
+	})
+
+	t.Run("declared dimensions take precedence", func(t *testing.T) {
+		r := dimensionRegistry{}
+
+		r.record("", 2, declared)
+		err := r.check("", []float32{1, 2}, declared)
+		assert.ErrorIs(t, err, ErrVectorDimensionMismatch)
+		assert.Nil(t, r.check("", []float32{1, 2, 3}, declared))
+		assert.ErrorIs(t, r.check("", []float32{1}, declared), ErrVectorDimensionMismatch)
+	})
+
+	t.Run("empty vectors are ignored", func(t *testing.T) {
+		r := dimensionRegistry{}
+
+		r.record("", 0, undeclared)
+		assert.Equal(t, 0, r.get("", undeclared))
+		r.record("", 2, undeclared)
+		assert.Nil(t, r.check("", nil, undeclared))
+	})
+}
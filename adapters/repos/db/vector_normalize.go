@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// normalizeOnWrite returns true if vectors of the target vector are
+// L2-normalized before they are stored or searched with
+func (i *Index) normalizeOnWrite(targetVector string) bool {
+	return vectorindex.NormalizeOnWrite(i.vectorIndexConfigFor(targetVector))
+}
+
+// normalizeObjectVectors replaces the vectors of the object with their
+// normalized counterparts where configured. Both vectors coming from a
+// vectorizer and vectors provided by the user pass through here.
+func (i *Index) normalizeObjectVectors(obj *storobj.Object) {
+	if len(obj.Vector) > 0 && i.normalizeOnWrite("") {
+		obj.Vector = distancer.Normalize(obj.Vector)
+	}
+	for target, vec := range obj.Vectors {
+		if len(vec) > 0 && i.normalizeOnWrite(target) {
+			obj.Vectors[target] = distancer.Normalize(vec)
+		}
+	}
+}
+
+func (i *Index) normalizeMergeVectors(merge *objects.MergeDocument) {
+	if len(merge.Vector) > 0 && i.normalizeOnWrite("") {
+		merge.Vector = distancer.Normalize(merge.Vector)
+	}
+	for target, vec := range merge.Vectors {
+		if len(vec) > 0 && i.normalizeOnWrite(target) {
+			merge.Vectors[target] = models.Vector(distancer.Normalize(vec))
+		}
+	}
+}
+
+// normalizeSearchVectors returns the search vectors normalized where
+// configured, so they are comparable to the stored vectors. The input is not
+// modified.
+func (i *Index) normalizeSearchVectors(searchVectors [][]float32, targetVectors []string) [][]float32 {
+	out := make([][]float32, len(searchVectors))
+	for pos, vec := range searchVectors {
+		target := ""
+		if pos < len(targetVectors) {
+			target = targetVectors[pos]
+		}
+		if len(vec) > 0 && i.normalizeOnWrite(target) {
+			vec = distancer.Normalize(vec)
+		}
+		out[pos] = vec
+	}
+	return out
+}
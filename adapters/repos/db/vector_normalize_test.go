@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestIndex_NormalizeOnWrite(t *testing.T) {
+	normalized := hnsw.NewDefaultUserConfig()
+	normalized.NormalizeOnWrite = true
+
+	idx := &Index{
+		vectorIndexUserConfig: normalized,
+		vectorIndexUserConfigs: map[string]schemaConfig.VectorIndexConfig{
+			"normalized": flat.UserConfig{NormalizeOnWrite: true},
+			"raw":        flat.NewDefaultUserConfig(),
+		},
+	}
+
+	t.Run("object vectors", func(t *testing.T) {
+		obj := &storobj.Object{
+			Vector: []float32{3, 4},
+			Vectors: map[string][]float32{
+				"normalized": {0, 2},
+				"raw":        {0, 2},
+			},
+		}
+
+		idx.normalizeObjectVectors(obj)
+
+		assert.InDeltaSlice(t, []float32{0.6, 0.8}, obj.Vector, 1e-6)
+		assert.Equal(t, []float32{0, 1}, obj.Vectors["normalized"])
+		assert.Equal(t, []float32{0, 2}, obj.Vectors["raw"])
+	})
+
+	t.Run("search vectors", func(t *testing.T) {
+		in := [][]float32{{0, 2}, {0, 2}}
+
+		out := idx.normalizeSearchVectors(in, []string{"normalized", "raw"})
+
+		assert.Equal(t, [][]float32{{0, 1}, {0, 2}}, out)
+		assert.Equal(t, [][]float32{{0, 2}, {0, 2}}, in, "input must not be modified")
+	})
+
+	t.Run("legacy search vector", func(t *testing.T) {
+		out := idx.normalizeSearchVectors([][]float32{{3, 4}}, nil)
+		assert.InDeltaSlice(t, []float32{0.6, 0.8}, out[0], 1e-6)
+	})
+}
@@ -0,0 +1,240 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// warmUpCursorBatch is the number of objects read before the cursor is
+// released again, so flushes are not blocked for the whole warm-up
+const warmUpCursorBatch = 1000
+
+// WarmUpStatus is the progress of the warm-up phase after startup
+type WarmUpStatus struct {
+	Enabled         bool  `json:"enabled"`
+	Done            bool  `json:"done"`
+	ShardsTotal     int   `json:"shardsTotal"`
+	ShardsWarmed    int   `json:"shardsWarmed"`
+	BytesLoaded     int64 `json:"bytesLoaded"`
+	BudgetBytes     int64 `json:"budgetBytes"`
+	BudgetExhausted bool  `json:"budgetExhausted"`
+}
+
+type warmUpState struct {
+	sync.Mutex
+	status WarmUpStatus
+	cancel context.CancelFunc
+}
+
+func (s *warmUpState) update(fn func(status *WarmUpStatus)) {
+	s.Lock()
+	defer s.Unlock()
+	fn(&s.status)
+}
+
+// WarmUpStatus returns the current progress of the warm-up
+func (db *DB) WarmUpStatus() WarmUpStatus {
+	db.warmUp.Lock()
+	defer db.warmUp.Unlock()
+	return db.warmUp.status
+}
+
+// startWarmUp loads all shards and reads their objects in the background,
+// so they are in memory (or the page cache) before the first queries arrive.
+// A shard only counts as warmed once its vector caches are prefilled as well.
+// Reading objects stops once the memory budget is used up, the vector caches
+// are bounded by their own size.
+func (db *DB) startWarmUp() {
+	budget := int64(db.config.WarmUp.MemoryBudgetMB)
+	if budget == 0 {
+		budget = config.DefaultWarmUpMemoryBudgetMB
+	}
+	budget *= 1024 * 1024
+
+	ctx, cancel := context.WithCancel(context.Background())
+	db.warmUp.Lock()
+	db.warmUp.status.Enabled = true
+	db.warmUp.status.BudgetBytes = budget
+	db.warmUp.cancel = cancel
+	db.warmUp.Unlock()
+
+	enterrors.GoWrapper(func() {
+		defer cancel()
+		db.runWarmUp(ctx)
+	}, db.logger)
+}
+
+func (db *DB) stopWarmUp() {
+	db.warmUp.Lock()
+	defer db.warmUp.Unlock()
+	if db.warmUp.cancel != nil {
+		db.warmUp.cancel()
+	}
+}
+
+func (db *DB) runWarmUp(ctx context.Context) {
+	started := time.Now()
+	logger := db.logger.WithField("action", "warm_up")
+
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, index := range db.indices {
+		indices = append(indices, index)
+	}
+	db.indexLock.RUnlock()
+
+	total := 0
+	for _, index := range indices {
+		index.ForEachShard(func(string, ShardLike) error {
+			total++
+			return nil
+		})
+	}
+	db.warmUp.update(func(status *WarmUpStatus) { status.ShardsTotal = total })
+	logger.WithField("shards", total).Info("warm-up started")
+
+	budget := db.WarmUpStatus().BudgetBytes
+	var loaded int64
+	for _, index := range indices {
+		err := index.ForEachShard(func(name string, shard ShardLike) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			n, err := warmUpShard(ctx, shard, budget-loaded)
+			if err != nil {
+				logger.WithField("shard", name).WithError(err).Warn("warm up shard")
+			}
+			loaded += n
+
+			db.warmUp.update(func(status *WarmUpStatus) {
+				status.ShardsWarmed++
+				status.BytesLoaded = loaded
+				status.BudgetExhausted = loaded >= budget
+			})
+			return nil
+		})
+		if err != nil {
+			logger.WithError(err).Warn("warm-up aborted")
+			break
+		}
+	}
+
+	db.warmUp.update(func(status *WarmUpStatus) { status.Done = true })
+	logger.WithFields(logrus.Fields{
+		"took":         time.Since(started),
+		"bytes_loaded": loaded,
+	}).Info("warm-up complete")
+}
+
+// cachePrefiller is implemented by vector indexes which prefill their cache
+// in the background once they are started
+type cachePrefiller interface {
+	WaitForCachePrefill(ctx context.Context) error
+}
+
+// warmUpShard makes sure the shard is loaded, waits for its vector caches to
+// be prefilled and reads its objects until the remaining budget is used up.
+// It returns the number of bytes read.
+func warmUpShard(ctx context.Context, shard ShardLike, remaining int64) (int64, error) {
+	// accessing the store loads lazy shards, which starts the prefill of
+	// their vector caches
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+
+	if err := waitForVectorCaches(ctx, shard); err != nil {
+		return 0, err
+	}
+
+	if bucket == nil || remaining <= 0 {
+		return 0, nil
+	}
+
+	var loaded int64
+	var lastKey []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return loaded, err
+		}
+
+		n, next, done := warmUpBatch(bucket.Cursor(), lastKey, remaining-loaded)
+		loaded += n
+		if done {
+			return loaded, nil
+		}
+		lastKey = next
+	}
+}
+
+func waitForVectorCaches(ctx context.Context, shard ShardLike) error {
+	indexes := []VectorIndex{shard.VectorIndex()}
+	for _, index := range shard.VectorIndexes() {
+		indexes = append(indexes, index)
+	}
+
+	for _, index := range indexes {
+		prefiller, ok := index.(cachePrefiller)
+		if !ok {
+			continue
+		}
+		if err := prefiller.WaitForCachePrefill(ctx); err != nil {
+			return fmt.Errorf("prefill vector cache: %w", err)
+		}
+	}
+	return nil
+}
+
+type replaceCursor interface {
+	First() ([]byte, []byte)
+	Seek([]byte) ([]byte, []byte)
+	Next() ([]byte, []byte)
+	Close()
+}
+
+// warmUpBatch reads up to warmUpCursorBatch objects after lastKey. It returns
+// the bytes read, the last key read and whether there is nothing left to do.
+func warmUpBatch(c replaceCursor, lastKey []byte, remaining int64) (int64, []byte, bool) {
+	defer c.Close()
+
+	var k, v []byte
+	if lastKey == nil {
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(lastKey)
+		if k != nil && bytes.Equal(k, lastKey) {
+			k, v = c.Next()
+		}
+	}
+
+	var loaded int64
+	for i := 0; k != nil; i++ {
+		loaded += int64(len(k) + len(v))
+		if loaded >= remaining {
+			return loaded, nil, true
+		}
+		if i == warmUpCursorBatch-1 {
+			return loaded, append([]byte{}, k...), false
+		}
+		k, v = c.Next()
+	}
+	return loaded, nil, true
+}
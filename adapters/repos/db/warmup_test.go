@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeReplaceCursor struct {
+	keys   [][]byte
+	pos    int
+	closed bool
+}
+
+func newFakeReplaceCursor(n int) *fakeReplaceCursor {
+	keys := make([][]byte, n)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key-%05d", i))
+	}
+	return &fakeReplaceCursor{keys: keys}
+}
+
+func (c *fakeReplaceCursor) current() ([]byte, []byte) {
+	if c.pos >= len(c.keys) {
+		return nil, nil
+	}
+	return c.keys[c.pos], make([]byte, 91)
+}
+
+func (c *fakeReplaceCursor) First() ([]byte, []byte) {
+	c.pos = 0
+	return c.current()
+}
+
+func (c *fakeReplaceCursor) Seek(key []byte) ([]byte, []byte) {
+	c.pos = sort.Search(len(c.keys), func(i int) bool {
+		return bytes.Compare(c.keys[i], key) >= 0
+	})
+	return c.current()
+}
+
+func (c *fakeReplaceCursor) Next() ([]byte, []byte) {
+	c.pos++
+	return c.current()
+}
+
+func (c *fakeReplaceCursor) Close() {
+	c.closed = true
+}
+
+func TestWarmUpBatch(t *testing.T) {
+	// every object is 100 bytes: 9 bytes key, 91 bytes value
+	t.Run("reads in batches until all objects are read", func(t *testing.T) {
+		c := newFakeReplaceCursor(2500)
+
+		var loaded int64
+		var lastKey []byte
+		batches := 0
+		for {
+			n, next, done := warmUpBatch(c, lastKey, 1<<30)
+			assert.True(t, c.closed)
+			c.closed = false
+			loaded += n
+			batches++
+			if done {
+				break
+			}
+			lastKey = next
+		}
+
+		assert.Equal(t, 3, batches)
+		assert.Equal(t, int64(2500*100), loaded)
+	})
+
+	t.Run("stops once the budget is used up", func(t *testing.T) {
+		c := newFakeReplaceCursor(2500)
+
+		n, _, done := warmUpBatch(c, nil, 250)
+		assert.True(t, done)
+		assert.Equal(t, int64(300), n)
+	})
+
+	t.Run("empty bucket", func(t *testing.T) {
+		c := newFakeReplaceCursor(0)
+
+		n, _, done := warmUpBatch(c, nil, 250)
+		assert.True(t, done)
+		assert.Equal(t, int64(0), n)
+	})
+}
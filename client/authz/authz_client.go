@@ -61,8 +61,12 @@ type ClientService interface {
 
 	RemovePermissions(params *RemovePermissionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RemovePermissionsOK, error)
 
+	RevokeKey(params *RevokeKeyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RevokeKeyOK, error)
+
 	RevokeRole(params *RevokeRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RevokeRoleOK, error)
 
+	RotateKey(params *RotateKeyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RotateKeyOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -456,6 +460,47 @@ func (a *Client) RemovePermissions(params *RemovePermissionsParams, authInfo run
 	panic(msg)
 }
 
+/*
+RevokeKey revokes an API key
+
+Adds the current token of the API key to the revocation list. Requests using a revoked token are rejected. Rotate the key to issue a new token.
+*/
+func (a *Client) RevokeKey(params *RevokeKeyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RevokeKeyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRevokeKeyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "revokeKey",
+		Method:             "POST",
+		PathPattern:        "/keys/{id}/revoke",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RevokeKeyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RevokeKeyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for revokeKey: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 RevokeRole revokes a role from a user
 */
@@ -495,6 +540,47 @@ func (a *Client) RevokeRole(params *RevokeRoleParams, authInfo runtime.ClientAut
 	panic(msg)
 }
 
+/*
+RotateKey rotates an API key
+
+Issues a new token for the API key and revokes the current one. The user the key belongs to, and therefore its roles, stay unchanged. The new token is only returned in this response.
+*/
+func (a *Client) RotateKey(params *RotateKeyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RotateKeyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRotateKeyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "rotateKey",
+		Method:             "POST",
+		PathPattern:        "/keys/{id}/rotate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RotateKeyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RotateKeyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for rotateKey: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
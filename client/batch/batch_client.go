@@ -41,15 +41,105 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	BatchJobsCreate(params *BatchJobsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchJobsCreateAccepted, error)
+
+	BatchJobsGet(params *BatchJobsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchJobsGetOK, error)
+
 	BatchObjectsCreate(params *BatchObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsCreateOK, error)
 
 	BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsDeleteOK, error)
 
+	BatchObjectsStream(params *BatchObjectsStreamParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsStreamOK, error)
+
+	BatchObjectsUpdate(params *BatchObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsUpdateOK, error)
+
 	BatchReferencesCreate(params *BatchReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchReferencesCreateOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+BatchJobsCreate creates new objects as a batch in the background
+
+Create new objects in bulk in the background. <br/><br/>The objects are validated and imported just like on `POST /batch/objects`, but the request returns as soon as the job is started. The status of the job and the result of every object can be queried with `GET /batch/jobs/{id}` until a while after the job finished. <br/><br/>Jobs are kept on the node which started them, so their status can only be queried on that node. Jobs which are still running when the node restarts fail. At most 16 jobs can run on a node at the same time, further jobs are rejected with status 429.
+*/
+func (a *Client) BatchJobsCreate(params *BatchJobsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchJobsCreateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchJobsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.jobs.create",
+		Method:             "POST",
+		PathPattern:        "/batch/jobs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchJobsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchJobsCreateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.jobs.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BatchJobsGet gets the status of a batch job
+
+Get the status of a batch job, and the result of every object it processed so far.
+*/
+func (a *Client) BatchJobsGet(params *BatchJobsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchJobsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchJobsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.jobs.get",
+		Method:             "GET",
+		PathPattern:        "/batch/jobs/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchJobsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchJobsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.jobs.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BatchObjectsCreate creates new objects based on a object template as a batch
 
@@ -132,6 +222,88 @@ func (a *Client) BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo r
 	panic(msg)
 }
 
+/*
+BatchObjectsStream creates new objects from a stream of newline delimited JSON
+
+Create new objects in bulk from a stream of newline-delimited JSON (NDJSON), one object per line. <br/><br/>Objects are imported in sub-batches as they arrive, so the request body is never held in memory as a whole. Meta-data and schema values are validated. <br/><br/>The response summarizes the import and lists the objects which failed, identified by their line. Lines which cannot be parsed fail individually and don't stop the import.
+*/
+func (a *Client) BatchObjectsStream(params *BatchObjectsStreamParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsStreamOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchObjectsStreamParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.objects.stream",
+		Method:             "POST",
+		PathPattern:        "/batch/objects/stream",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/x-ndjson"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchObjectsStreamReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchObjectsStreamOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.objects.stream: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BatchObjectsUpdate replaces existing objects as a batch
+
+Replace existing objects in bulk. <br/><br/>Every object needs its id. Meta-data and schema values are validated just like on `POST /batch/objects`. <br/><br/>Objects which don't exist are not created, but fail individually.
+*/
+func (a *Client) BatchObjectsUpdate(params *BatchObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchObjectsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.objects.update",
+		Method:             "PUT",
+		PathPattern:        "/batch/objects",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchObjectsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchObjectsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BatchReferencesCreate creates new cross references between arbitrary classes in bulk
 
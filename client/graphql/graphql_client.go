@@ -51,7 +51,7 @@ type ClientService interface {
 /*
 GraphqlBatch gets a response based on graph q l
 
-Perform a batched GraphQL query
+Perform a batched GraphQL query. <br/><br/>With an Accept header of application/x-ndjson the responses are streamed as newline-delimited JSON instead, one GraphQLResponse per query with an additional index field holding the position of the query in the batch. Each response is written as soon as its query finishes, so the responses arrive out of order.
 */
 func (a *Client) GraphqlBatch(params *GraphqlBatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlBatchOK, error) {
 	// TODO: Validate the params before sending
@@ -62,7 +62,7 @@ func (a *Client) GraphqlBatch(params *GraphqlBatchParams, authInfo runtime.Clien
 		ID:                 "graphql.batch",
 		Method:             "POST",
 		PathPattern:        "/graphql/batch",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
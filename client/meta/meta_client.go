@@ -43,6 +43,8 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	MetaGet(params *MetaGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MetaGetOK, error)
 
+	ModulesList(params *ModulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ModulesListOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -87,6 +89,47 @@ func (a *Client) MetaGet(params *MetaGetParams, authInfo runtime.ClientAuthInfoW
 	panic(msg)
 }
 
+/*
+ModulesList lists the enabled modules
+
+Returns the enabled modules, their capabilities, the module config defaults they set and the health of their upstream inference services. Can be used to verify a deployment before relying on module-specific query arguments.
+*/
+func (a *Client) ModulesList(params *ModulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ModulesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewModulesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "modules.list",
+		Method:             "GET",
+		PathPattern:        "/modules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ModulesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ModulesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for modules.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
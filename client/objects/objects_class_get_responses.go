@@ -92,6 +92,11 @@ ObjectsClassGetOK describes a response with status code 200, with default header
 Successful response.
 */
 type ObjectsClassGetOK struct {
+
+	/* Entity tag of the object. Pass it as If-Match to updates to only apply them if the object is unchanged.
+	 */
+	ETag string
+
 	Payload *models.Object
 }
 
@@ -139,6 +144,13 @@ func (o *ObjectsClassGetOK) GetPayload() *models.Object {
 
 func (o *ObjectsClassGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Object)
 
 	// response payload
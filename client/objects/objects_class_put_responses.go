@@ -58,6 +58,12 @@ func (o *ObjectsClassPutReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsClassPutPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassPutUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -323,6 +329,74 @@ func (o *ObjectsClassPutNotFound) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewObjectsClassPutPreconditionFailed creates a ObjectsClassPutPreconditionFailed with default headers values
+func NewObjectsClassPutPreconditionFailed() *ObjectsClassPutPreconditionFailed {
+	return &ObjectsClassPutPreconditionFailed{}
+}
+
+/*
+ObjectsClassPutPreconditionFailed describes a response with status code 412, with default header values.
+
+The object does not match the If-Match header, it was updated since.
+*/
+type ObjectsClassPutPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class put precondition failed response has a 2xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class put precondition failed response has a 3xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class put precondition failed response has a 4xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class put precondition failed response has a 5xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class put precondition failed response a status code equal to that given
+func (o *ObjectsClassPutPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsClassPutPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPutPreconditionFailed) String() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPutPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPutPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPutUnprocessableEntity creates a ObjectsClassPutUnprocessableEntity with default headers values
 func NewObjectsClassPutUnprocessableEntity() *ObjectsClassPutUnprocessableEntity {
 	return &ObjectsClassPutUnprocessableEntity{}
@@ -18,6 +18,7 @@ package objects
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -41,12 +42,18 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ObjectsClassBlobGet(params *ObjectsClassBlobGetParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsClassBlobGetOK, error)
+
 	ObjectsClassDelete(params *ObjectsClassDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassDeleteNoContent, error)
 
 	ObjectsClassGet(params *ObjectsClassGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassGetOK, error)
 
 	ObjectsClassHead(params *ObjectsClassHeadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassHeadNoContent, error)
 
+	ObjectsClassHistory(params *ObjectsClassHistoryParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassHistoryOK, error)
+
+	ObjectsClassLookup(params *ObjectsClassLookupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassLookupOK, error)
+
 	ObjectsClassPatch(params *ObjectsClassPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassPatchNoContent, error)
 
 	ObjectsClassPut(params *ObjectsClassPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassPutOK, error)
@@ -57,8 +64,12 @@ type ClientService interface {
 
 	ObjectsClassReferencesPut(params *ObjectsClassReferencesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassReferencesPutOK, error)
 
+	ObjectsClassRestore(params *ObjectsClassRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassRestoreOK, error)
+
 	ObjectsCreate(params *ObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsCreateOK, error)
 
+	ObjectsCreateMultipart(params *ObjectsCreateMultipartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsCreateMultipartOK, error)
+
 	ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsDeleteNoContent, error)
 
 	ObjectsGet(params *ObjectsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsGetOK, error)
@@ -75,6 +86,8 @@ type ClientService interface {
 
 	ObjectsReferencesUpdate(params *ObjectsReferencesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsReferencesUpdateOK, error)
 
+	ObjectsTrashList(params *ObjectsTrashListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsTrashListOK, error)
+
 	ObjectsUpdate(params *ObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsUpdateOK, error)
 
 	ObjectsValidate(params *ObjectsValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsValidateOK, error)
@@ -82,6 +95,47 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ObjectsClassBlobGet downloads a file attached to a blob property of an object
+
+Download the file which was uploaded to a blob property of an object with POST /objects/multipart.
+*/
+func (a *Client) ObjectsClassBlobGet(params *ObjectsClassBlobGetParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsClassBlobGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassBlobGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.blob.get",
+		Method:             "GET",
+		PathPattern:        "/objects/{className}/{id}/blobs/{propertyName}",
+		ProducesMediaTypes: []string{"application/octet-stream", "application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassBlobGetReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassBlobGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.blob.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsClassDelete deletes object based on its class and UUID
 
@@ -205,6 +259,88 @@ func (a *Client) ObjectsClassHead(params *ObjectsClassHeadParams, authInfo runti
 	panic(msg)
 }
 
+/*
+ObjectsClassHistory lists the prior versions of an object based on its class and UUID
+
+Lists the prior versions of an object, latest first. <br/><br/>Versions are only kept if object history is enabled by setting `OBJECT_HISTORY_VERSIONS`, which is the number of versions kept per object. They are dropped when the object is deleted.
+*/
+func (a *Client) ObjectsClassHistory(params *ObjectsClassHistoryParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.history",
+		Method:             "GET",
+		PathPattern:        "/objects/{className}/{id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassHistoryReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.history: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ObjectsClassLookup gets an object based on its collection and the value of one of its properties
+
+Get the data object of a collection whose property is equal to the given value, e.g. an external ID stored on the object. <br/><br/>The lookup uses the inverted index of the property, just like a Get query with an Equal where filter, so the property needs to be indexed. Text properties need `field` tokenization, so that their value is matched as a whole. Properties are not unique, a value matching more than one object is rejected.
+*/
+func (a *Client) ObjectsClassLookup(params *ObjectsClassLookupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassLookupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassLookupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.lookup",
+		Method:             "GET",
+		PathPattern:        "/objects/by/{className}/{propertyName}/{value}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassLookupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassLookupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.lookup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsClassPatch updates an object based on its UUID using patch semantics
 
@@ -410,6 +546,47 @@ func (a *Client) ObjectsClassReferencesPut(params *ObjectsClassReferencesPutPara
 	panic(msg)
 }
 
+/*
+ObjectsClassRestore restores a soft deleted object based on its class and UUID
+
+Restores a soft deleted object from the trash as it was when it was deleted. <br/><br/>Creating an object with the same UUID also removes it from the trash, so an object can only be restored while no object with its UUID exists.
+*/
+func (a *Client) ObjectsClassRestore(params *ObjectsClassRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassRestoreOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassRestoreParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.restore",
+		Method:             "POST",
+		PathPattern:        "/objects/{className}/{id}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassRestoreReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassRestoreOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.restore: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsCreate creates a new object
 
@@ -451,6 +628,47 @@ func (a *Client) ObjectsCreate(params *ObjectsCreateParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+ObjectsCreateMultipart creates a new object with files attached to its blob properties
+
+Create a new object and upload files to its blob properties in a single multipart request. <br/><br/>The object is sent as JSON in the form field `object`. Every other form field is a file, which is stored in the blob storage of the server. The name of the field is the name of the blob property the file is attached to. <br/><br/>The blob properties of the created object hold references to the stored files, which can be downloaded with GET /objects/{className}/{id}/blobs/{propertyName}.
+*/
+func (a *Client) ObjectsCreateMultipart(params *ObjectsCreateMultipartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsCreateMultipartOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsCreateMultipartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.create.multipart",
+		Method:             "POST",
+		PathPattern:        "/objects/multipart",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsCreateMultipartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsCreateMultipartOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.create.multipart: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsDelete deletes an object based on its UUID
 
@@ -779,6 +997,47 @@ func (a *Client) ObjectsReferencesUpdate(params *ObjectsReferencesUpdateParams,
 	panic(msg)
 }
 
+/*
+ObjectsTrashList lists soft deleted objects of a class
+
+Lists the soft deleted objects of a collection which can still be restored. <br/><br/>Deleted objects are only kept in the trash if soft delete is enabled by setting `SOFT_DELETE_RETENTION`, and are purged once the retention expires. The time of deletion is returned as additional property `deletionTimeUnix`. <br/><br/>The trash is kept on the nodes of a shard and is not replicated, the shards of all nodes are listed from the replica owning the shard.
+*/
+func (a *Client) ObjectsTrashList(params *ObjectsTrashListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsTrashListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsTrashListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.trash.list",
+		Method:             "GET",
+		PathPattern:        "/objects/.deleted",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsTrashListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsTrashListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.trash.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsUpdate updates an object based on its UUID
 
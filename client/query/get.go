@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package query builds parameterized GraphQL queries for use with the
+// generated graphql client. User supplied values are always bound through
+// GraphQL variables and never interpolated into the query string, so the
+// resulting queries are safe against injection and are accepted by servers
+// running with GRAPHQL_STRICT_MODE enabled.
+package query
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	classNameRegexp = regexp.MustCompile(`^[A-Z][_0-9A-Za-z]*$`)
+	fieldRegexp     = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*(\s*\{[\s._0-9A-Za-z{}]*\})?$`)
+)
+
+// GetBuilder builds a Get query for a single class
+type GetBuilder struct {
+	className string
+	fields    []string
+	where     *models.WhereFilter
+	bm25      *bm25
+	limit     *int64
+	offset    *int64
+	after     *string
+}
+
+type bm25 struct {
+	query      string
+	properties []string
+}
+
+// Get starts building a Get query for the given class
+func Get(className string) *GetBuilder {
+	return &GetBuilder{className: className}
+}
+
+// WithFields sets the fields to return. Nested selections such as
+// "_additional { id }" are allowed.
+func (b *GetBuilder) WithFields(fields ...string) *GetBuilder {
+	b.fields = append(b.fields, fields...)
+	return b
+}
+
+// WithWhere sets the filter. The entire filter is sent as a single variable.
+func (b *GetBuilder) WithWhere(where *models.WhereFilter) *GetBuilder {
+	b.where = where
+	return b
+}
+
+// WithBM25 adds a keyword search. The query is sent as a variable.
+func (b *GetBuilder) WithBM25(query string, properties ...string) *GetBuilder {
+	b.bm25 = &bm25{query: query, properties: properties}
+	return b
+}
+
+func (b *GetBuilder) WithLimit(limit int64) *GetBuilder {
+	b.limit = &limit
+	return b
+}
+
+func (b *GetBuilder) WithOffset(offset int64) *GetBuilder {
+	b.offset = &offset
+	return b
+}
+
+func (b *GetBuilder) WithAfter(after string) *GetBuilder {
+	b.after = &after
+	return b
+}
+
+// Build returns the query together with its variables, ready to be used as
+// the body of a graphql.GraphqlPostParams request.
+func (b *GetBuilder) Build() (*models.GraphQLQuery, error) {
+	if !classNameRegexp.MatchString(b.className) {
+		return nil, fmt.Errorf("invalid class name %q", b.className)
+	}
+	if len(b.fields) == 0 {
+		return nil, fmt.Errorf("at least one field must be selected")
+	}
+	for _, field := range b.fields {
+		if !fieldRegexp.MatchString(field) || !balanced(field) {
+			return nil, fmt.Errorf("invalid field %q", field)
+		}
+	}
+
+	p := newParams()
+	var args []string
+
+	if b.where != nil {
+		name := p.bind(fmt.Sprintf("GetObjects%sWhereInpObj", b.className), b.where)
+		args = append(args, "where: "+name)
+	}
+	if b.bm25 != nil {
+		name := p.bind("String", b.bm25.query)
+		arg := "bm25: {query: " + name
+		if len(b.bm25.properties) > 0 {
+			props := p.bind("[String]", b.bm25.properties)
+			arg += ", properties: " + props
+		}
+		args = append(args, arg+"}")
+	}
+	if b.limit != nil {
+		args = append(args, "limit: "+p.bind("Int", *b.limit))
+	}
+	if b.offset != nil {
+		args = append(args, "offset: "+p.bind("Int", *b.offset))
+	}
+	if b.after != nil {
+		args = append(args, "after: "+p.bind("String", *b.after))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("query")
+	if defs := p.definitions(); defs != "" {
+		sb.WriteString("(" + defs + ")")
+	}
+	sb.WriteString(" { Get { ")
+	sb.WriteString(b.className)
+	if len(args) > 0 {
+		sb.WriteString("(" + strings.Join(args, ", ") + ")")
+	}
+	sb.WriteString(" { ")
+	sb.WriteString(strings.Join(b.fields, " "))
+	sb.WriteString(" } } }")
+
+	return &models.GraphQLQuery{
+		Query:     sb.String(),
+		Variables: p.values,
+	}, nil
+}
+
+func balanced(field string) bool {
+	depth := 0
+	for _, r := range field {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+// SchemaValidator checks that a class and its properties exist, e.g. a
+// *schemacache.Cache
+type SchemaValidator interface {
+	ValidateClass(ctx context.Context, className string, properties ...string) error
+}
+
+// BuildValidated validates the class and the selected properties against the
+// given validator before building the query. Additional fields such as
+// "_additional { id }" are not validated.
+func (b *GetBuilder) BuildValidated(ctx context.Context, v SchemaValidator) (*models.GraphQLQuery, error) {
+	var props []string
+	for _, field := range b.fields {
+		name := strings.TrimSpace(strings.SplitN(field, "{", 2)[0])
+		if !strings.HasPrefix(name, "_") {
+			props = append(props, name)
+		}
+	}
+
+	if err := v.ValidateClass(ctx, b.className, props...); err != nil {
+		return nil, err
+	}
+	return b.Build()
+}
+
+// params collects the variables of a query. Variables are named
+// positionally, so their names never depend on user input.
+type params struct {
+	names  []string
+	types  map[string]string
+	values map[string]interface{}
+}
+
+func newParams() *params {
+	return &params{
+		types:  map[string]string{},
+		values: map[string]interface{}{},
+	}
+}
+
+func (p *params) bind(typ string, value interface{}) string {
+	name := fmt.Sprintf("p%d", len(p.names))
+	p.names = append(p.names, name)
+	p.types[name] = typ
+	p.values[name] = value
+	return "$" + name
+}
+
+func (p *params) definitions() string {
+	defs := make([]string, len(p.names))
+	for i, name := range p.names {
+		defs[i] = fmt.Sprintf("$%s: %s", name, p.types[name])
+	}
+	return strings.Join(defs, ", ")
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGetBuilder(t *testing.T) {
+	t.Run("values are bound as variables", func(t *testing.T) {
+		injection := `foo"}) { title } } } # `
+		where := &models.WhereFilter{
+			Path:      []string{"title"},
+			Operator:  models.WhereFilterOperatorEqual,
+			ValueText: &injection,
+		}
+
+		q, err := Get("Article").
+			WithFields("title", "_additional { id }").
+			WithWhere(where).
+			WithBM25(injection, "title").
+			WithLimit(10).
+			Build()
+		require.Nil(t, err)
+
+		assert.Equal(t, "query($p0: GetObjectsArticleWhereInpObj, $p1: String, $p2: [String], $p3: Int) "+
+			"{ Get { Article(where: $p0, bm25: {query: $p1, properties: $p2}, limit: $p3) "+
+			"{ title _additional { id } } } }", q.Query)
+		assert.NotContains(t, q.Query, injection)
+		assert.Equal(t, map[string]interface{}{
+			"p0": where,
+			"p1": injection,
+			"p2": []string{"title"},
+			"p3": int64(10),
+		}, q.Variables)
+	})
+
+	t.Run("without arguments", func(t *testing.T) {
+		q, err := Get("Article").WithFields("title").Build()
+		require.Nil(t, err)
+		assert.Equal(t, "query { Get { Article { title } } }", q.Query)
+	})
+
+	t.Run("rejects invalid identifiers", func(t *testing.T) {
+		_, err := Get(`Article(limit: 1)`).WithFields("title").Build()
+		assert.NotNil(t, err)
+
+		_, err = Get("Article").WithFields(`title } } } { Get { Other { secret`).Build()
+		assert.NotNil(t, err)
+
+		_, err = Get("Article").WithFields(`ref { id } } } { Get { Other { secret }`).Build()
+		assert.NotNil(t, err)
+
+		_, err = Get("Article").Build()
+		assert.NotNil(t, err)
+	})
+}
+
+type fakeValidator struct {
+	className  string
+	properties []string
+}
+
+func (v *fakeValidator) ValidateClass(ctx context.Context, className string, properties ...string) error {
+	v.className = className
+	v.properties = properties
+	return nil
+}
+
+func TestGetBuilderBuildValidated(t *testing.T) {
+	v := &fakeValidator{}
+	_, err := Get("Article").
+		WithFields("title", "hasAuthor { ... on Author { name } }", "_additional { id }").
+		BuildValidated(context.Background(), v)
+	require.Nil(t, err)
+
+	assert.Equal(t, "Article", v.className)
+	assert.Equal(t, []string{"title", "hasAuthor"}, v.properties)
+}
@@ -43,6 +43,8 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaDumpOK, error)
 
+	SchemaImport(params *SchemaImportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaImportOK, error)
+
 	SchemaObjectsCreate(params *SchemaObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsCreateOK, error)
 
 	SchemaObjectsDelete(params *SchemaObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDeleteOK, error)
@@ -51,12 +53,22 @@ type ClientService interface {
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsPropertiesLifecycleUpdate(params *SchemaObjectsPropertiesLifecycleUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesLifecycleUpdateOK, error)
+
+	SchemaObjectsPropertiesPurge(params *SchemaObjectsPropertiesPurgeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesPurgeAccepted, error)
+
+	SchemaObjectsPropertiesRename(params *SchemaObjectsPropertiesRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesRenameAccepted, error)
+
+	SchemaObjectsRename(params *SchemaObjectsRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRenameAccepted, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
+	SchemaValidate(params *SchemaValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaValidateOK, error)
+
 	TenantExists(params *TenantExistsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantExistsOK, error)
 
 	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error)
@@ -113,6 +125,47 @@ func (a *Client) SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAut
 	panic(msg)
 }
 
+/*
+SchemaImport imports a previously dumped schema
+
+Add all classes of a schema, as returned by `GET /v1/schema`, e.g. to migrate a schema between instances. All classes are validated, including cross-references between them, before any class is added. If adding a class fails, the classes added before are removed again, so that either all or none of the classes are added.
+*/
+func (a *Client) SchemaImport(params *SchemaImportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaImportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaImportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.import",
+		Method:             "POST",
+		PathPattern:        "/schema/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaImportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaImportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.import: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsCreate creates a new object class in the schema
 
@@ -273,6 +326,170 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+SchemaObjectsPropertiesLifecycleUpdate changes the lifecycle of a property
+
+Deprecate, tombstone or reactivate a property. <br/><br/>Writing a deprecated or tombstoned property is rejected, tombstoned properties are additionally hidden from GraphQL. The data of tombstoned properties is kept until it is removed with `POST /schema/{className}/properties/{propertyName}/purge`.
+*/
+func (a *Client) SchemaObjectsPropertiesLifecycleUpdate(params *SchemaObjectsPropertiesLifecycleUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesLifecycleUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesLifecycleUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.properties.lifecycle.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/properties/{propertyName}/lifecycle",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesLifecycleUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesLifecycleUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.lifecycle.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsPropertiesPurge purges the data of a tombstoned property
+
+Removes the values of a tombstoned property from all objects of the class in the background. The property itself stays in the schema as tombstoned. <br/><br/>The request returns as soon as the job is started, its status can be queried with `GET /batch/jobs/{id}`.
+*/
+func (a *Client) SchemaObjectsPropertiesPurge(params *SchemaObjectsPropertiesPurgeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesPurgeAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesPurgeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.properties.purge",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/properties/{propertyName}/purge",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesPurgeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesPurgeAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.purge: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsPropertiesRename renames a property without reimporting its data
+
+Adds a property with the new name and the same settings, deprecates the old property and starts a job in the background which moves the values of all objects from the old to the new property. Once all objects are migrated, the old property is tombstoned. Objects are not vectorized again. <br/><br/>The request returns as soon as the job is started, its status can be queried with `GET /batch/jobs/{id}`. Until the job is done, some objects only have a value for the old property.
+*/
+func (a *Client) SchemaObjectsPropertiesRename(params *SchemaObjectsPropertiesRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesRenameAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesRenameParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.properties.rename",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/properties/{propertyName}/rename",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesRenameReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesRenameAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.rename: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsRename renames a class without reimporting its data
+
+Makes the shards of the class read-only, adds a class with the new name and the same settings and tenants, then starts a job in the background which copies all objects including their vectors to the new class. Once all objects are copied, the old class is kept read-only and has to be deleted after switching to the new class. Until then the objects of the class are stored twice, set `deleteOldClass` to delete the old class as soon as the copy is complete. References of the class to itself are renamed as well, classes which are referenced by other classes can't be renamed. <br/><br/>The request returns as soon as the job is started, its status can be queried with `GET /batch/jobs/{id}`. If any object can't be copied, the job fails, both classes are kept and the old class is writable again.
+*/
+func (a *Client) SchemaObjectsRename(params *SchemaObjectsRenameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRenameAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsRenameParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.rename",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/rename",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsRenameReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsRenameAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.rename: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsShardsGet gets the shards status of an object class
 
@@ -396,6 +613,47 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	panic(msg)
 }
 
+/*
+SchemaValidate validates a proposed schema without applying it
+
+Compare a proposed schema, in the format returned by `GET /v1/schema`, with the current schema. The response lists the added, removed and changed classes and properties, as well as all validation errors, e.g. invalid module configuration or changes to immutable settings. Nothing is applied. Removed classes are only reported, they are never validation errors, since classes have to be deleted explicitly.
+*/
+func (a *Client) SchemaValidate(params *SchemaValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaValidateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaValidateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.validate",
+		Method:             "POST",
+		PathPattern:        "/schema/validate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaValidateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaValidateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.validate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 TenantExists checks whether a tenant exists
 
@@ -74,6 +74,11 @@ SchemaDumpOK describes a response with status code 200, with default header valu
 Successfully dumped the database schema.
 */
 type SchemaDumpOK struct {
+
+	/* Hash of the current schema. Pass it as If-Match to schema changes to only apply them if the schema is unchanged.
+	 */
+	XWeaviateSchemaHash string
+
 	Payload *models.Schema
 }
 
@@ -121,6 +126,13 @@ func (o *SchemaDumpOK) GetPayload() *models.Schema {
 
 func (o *SchemaDumpOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Weaviate-Schema-Hash
+	hdrXWeaviateSchemaHash := response.GetHeader("X-Weaviate-Schema-Hash")
+
+	if hdrXWeaviateSchemaHash != "" {
+		o.XWeaviateSchemaHash = hdrXWeaviateSchemaHash
+	}
+
 	o.Payload = new(models.Schema)
 
 	// response payload
@@ -74,6 +74,12 @@ SchemaObjectsCreateParams contains all the parameters to send to the API endpoin
 */
 type SchemaObjectsCreateParams struct {
 
+	/* IfMatch.
+
+	   Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	*/
+	IfMatch *string
+
 	// ObjectClass.
 	ObjectClass *models.Class
 
@@ -130,6 +136,17 @@ func (o *SchemaObjectsCreateParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the schema objects create params
+func (o *SchemaObjectsCreateParams) WithIfMatch(ifMatch *string) *SchemaObjectsCreateParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the schema objects create params
+func (o *SchemaObjectsCreateParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithObjectClass adds the objectClass to the schema objects create params
 func (o *SchemaObjectsCreateParams) WithObjectClass(objectClass *models.Class) *SchemaObjectsCreateParams {
 	o.SetObjectClass(objectClass)
@@ -148,6 +165,13 @@ func (o *SchemaObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg
 		return err
 	}
 	var res []error
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.ObjectClass != nil {
 		if err := r.SetBodyParam(o.ObjectClass); err != nil {
 			return err
@@ -52,6 +52,12 @@ func (o *SchemaObjectsCreateReader) ReadResponse(response runtime.ClientResponse
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewSchemaObjectsCreateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewSchemaObjectsCreateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -261,6 +267,74 @@ func (o *SchemaObjectsCreateForbidden) readResponse(response runtime.ClientRespo
 	return nil
 }
 
+// NewSchemaObjectsCreateConflict creates a SchemaObjectsCreateConflict with default headers values
+func NewSchemaObjectsCreateConflict() *SchemaObjectsCreateConflict {
+	return &SchemaObjectsCreateConflict{}
+}
+
+/*
+SchemaObjectsCreateConflict describes a response with status code 409, with default header values.
+
+A class with the same name already exists, or the schema changed since the given schema hash.
+*/
+type SchemaObjectsCreateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects create conflict response has a 2xx status code
+func (o *SchemaObjectsCreateConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects create conflict response has a 3xx status code
+func (o *SchemaObjectsCreateConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects create conflict response has a 4xx status code
+func (o *SchemaObjectsCreateConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects create conflict response has a 5xx status code
+func (o *SchemaObjectsCreateConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects create conflict response a status code equal to that given
+func (o *SchemaObjectsCreateConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the schema objects create conflict response
+func (o *SchemaObjectsCreateConflict) Code() int {
+	return 409
+}
+
+func (o *SchemaObjectsCreateConflict) Error() string {
+	return fmt.Sprintf("[POST /schema][%d] schemaObjectsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsCreateConflict) String() string {
+	return fmt.Sprintf("[POST /schema][%d] schemaObjectsCreateConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsCreateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsCreateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewSchemaObjectsCreateUnprocessableEntity creates a SchemaObjectsCreateUnprocessableEntity with default headers values
 func NewSchemaObjectsCreateUnprocessableEntity() *SchemaObjectsCreateUnprocessableEntity {
 	return &SchemaObjectsCreateUnprocessableEntity{}
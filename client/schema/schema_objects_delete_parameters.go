@@ -75,6 +75,12 @@ type SchemaObjectsDeleteParams struct {
 	// ClassName.
 	ClassName string
 
+	/* IfMatch.
+
+	   Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	*/
+	IfMatch *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -139,6 +145,17 @@ func (o *SchemaObjectsDeleteParams) SetClassName(className string) {
 	o.ClassName = className
 }
 
+// WithIfMatch adds the ifMatch to the schema objects delete params
+func (o *SchemaObjectsDeleteParams) WithIfMatch(ifMatch *string) *SchemaObjectsDeleteParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the schema objects delete params
+func (o *SchemaObjectsDeleteParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *SchemaObjectsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -152,6 +169,14 @@ func (o *SchemaObjectsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -58,6 +58,12 @@ func (o *SchemaObjectsDeleteReader) ReadResponse(response runtime.ClientResponse
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewSchemaObjectsDeleteConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewSchemaObjectsDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -317,6 +323,74 @@ func (o *SchemaObjectsDeleteForbidden) readResponse(response runtime.ClientRespo
 	return nil
 }
 
+// NewSchemaObjectsDeleteConflict creates a SchemaObjectsDeleteConflict with default headers values
+func NewSchemaObjectsDeleteConflict() *SchemaObjectsDeleteConflict {
+	return &SchemaObjectsDeleteConflict{}
+}
+
+/*
+SchemaObjectsDeleteConflict describes a response with status code 409, with default header values.
+
+The schema changed since the given schema hash.
+*/
+type SchemaObjectsDeleteConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects delete conflict response has a 2xx status code
+func (o *SchemaObjectsDeleteConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects delete conflict response has a 3xx status code
+func (o *SchemaObjectsDeleteConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects delete conflict response has a 4xx status code
+func (o *SchemaObjectsDeleteConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects delete conflict response has a 5xx status code
+func (o *SchemaObjectsDeleteConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects delete conflict response a status code equal to that given
+func (o *SchemaObjectsDeleteConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the schema objects delete conflict response
+func (o *SchemaObjectsDeleteConflict) Code() int {
+	return 409
+}
+
+func (o *SchemaObjectsDeleteConflict) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}][%d] schemaObjectsDeleteConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsDeleteConflict) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}][%d] schemaObjectsDeleteConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsDeleteConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsDeleteConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewSchemaObjectsDeleteInternalServerError creates a SchemaObjectsDeleteInternalServerError with default headers values
 func NewSchemaObjectsDeleteInternalServerError() *SchemaObjectsDeleteInternalServerError {
 	return &SchemaObjectsDeleteInternalServerError{}
@@ -80,6 +80,12 @@ type SchemaObjectsPropertiesAddParams struct {
 	// ClassName.
 	ClassName string
 
+	/* IfMatch.
+
+	   Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	*/
+	IfMatch *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -155,6 +161,17 @@ func (o *SchemaObjectsPropertiesAddParams) SetClassName(className string) {
 	o.ClassName = className
 }
 
+// WithIfMatch adds the ifMatch to the schema objects properties add params
+func (o *SchemaObjectsPropertiesAddParams) WithIfMatch(ifMatch *string) *SchemaObjectsPropertiesAddParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the schema objects properties add params
+func (o *SchemaObjectsPropertiesAddParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *SchemaObjectsPropertiesAddParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -173,6 +190,14 @@ func (o *SchemaObjectsPropertiesAddParams) WriteToRequest(r runtime.ClientReques
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -52,6 +52,12 @@ func (o *SchemaObjectsPropertiesAddReader) ReadResponse(response runtime.ClientR
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewSchemaObjectsPropertiesAddConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewSchemaObjectsPropertiesAddUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -261,6 +267,74 @@ func (o *SchemaObjectsPropertiesAddForbidden) readResponse(response runtime.Clie
 	return nil
 }
 
+// NewSchemaObjectsPropertiesAddConflict creates a SchemaObjectsPropertiesAddConflict with default headers values
+func NewSchemaObjectsPropertiesAddConflict() *SchemaObjectsPropertiesAddConflict {
+	return &SchemaObjectsPropertiesAddConflict{}
+}
+
+/*
+SchemaObjectsPropertiesAddConflict describes a response with status code 409, with default header values.
+
+A property with the same name already exists, or the schema changed since the given schema hash.
+*/
+type SchemaObjectsPropertiesAddConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties add conflict response has a 2xx status code
+func (o *SchemaObjectsPropertiesAddConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties add conflict response has a 3xx status code
+func (o *SchemaObjectsPropertiesAddConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties add conflict response has a 4xx status code
+func (o *SchemaObjectsPropertiesAddConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties add conflict response has a 5xx status code
+func (o *SchemaObjectsPropertiesAddConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties add conflict response a status code equal to that given
+func (o *SchemaObjectsPropertiesAddConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the schema objects properties add conflict response
+func (o *SchemaObjectsPropertiesAddConflict) Code() int {
+	return 409
+}
+
+func (o *SchemaObjectsPropertiesAddConflict) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/properties][%d] schemaObjectsPropertiesAddConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesAddConflict) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/properties][%d] schemaObjectsPropertiesAddConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesAddConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesAddConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewSchemaObjectsPropertiesAddUnprocessableEntity creates a SchemaObjectsPropertiesAddUnprocessableEntity with default headers values
 func NewSchemaObjectsPropertiesAddUnprocessableEntity() *SchemaObjectsPropertiesAddUnprocessableEntity {
 	return &SchemaObjectsPropertiesAddUnprocessableEntity{}
@@ -77,6 +77,12 @@ type SchemaObjectsUpdateParams struct {
 	// ClassName.
 	ClassName string
 
+	/* IfMatch.
+
+	   Only apply the change if the schema hash, as returned in the X-Weaviate-Schema-Hash header, still matches the current schema. Otherwise 409 is returned.
+	*/
+	IfMatch *string
+
 	// ObjectClass.
 	ObjectClass *models.Class
 
@@ -144,6 +150,17 @@ func (o *SchemaObjectsUpdateParams) SetClassName(className string) {
 	o.ClassName = className
 }
 
+// WithIfMatch adds the ifMatch to the schema objects update params
+func (o *SchemaObjectsUpdateParams) WithIfMatch(ifMatch *string) *SchemaObjectsUpdateParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the schema objects update params
+func (o *SchemaObjectsUpdateParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithObjectClass adds the objectClass to the schema objects update params
 func (o *SchemaObjectsUpdateParams) WithObjectClass(objectClass *models.Class) *SchemaObjectsUpdateParams {
 	o.SetObjectClass(objectClass)
@@ -167,6 +184,13 @@ func (o *SchemaObjectsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg
 	if err := r.SetPathParam("className", o.ClassName); err != nil {
 		return err
 	}
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.ObjectClass != nil {
 		if err := r.SetBodyParam(o.ObjectClass); err != nil {
 			return err
@@ -58,6 +58,12 @@ func (o *SchemaObjectsUpdateReader) ReadResponse(response runtime.ClientResponse
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewSchemaObjectsUpdateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewSchemaObjectsUpdateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -335,6 +341,74 @@ func (o *SchemaObjectsUpdateNotFound) readResponse(response runtime.ClientRespon
 	return nil
 }
 
+// NewSchemaObjectsUpdateConflict creates a SchemaObjectsUpdateConflict with default headers values
+func NewSchemaObjectsUpdateConflict() *SchemaObjectsUpdateConflict {
+	return &SchemaObjectsUpdateConflict{}
+}
+
+/*
+SchemaObjectsUpdateConflict describes a response with status code 409, with default header values.
+
+The schema changed since the given schema hash.
+*/
+type SchemaObjectsUpdateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects update conflict response has a 2xx status code
+func (o *SchemaObjectsUpdateConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects update conflict response has a 3xx status code
+func (o *SchemaObjectsUpdateConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects update conflict response has a 4xx status code
+func (o *SchemaObjectsUpdateConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects update conflict response has a 5xx status code
+func (o *SchemaObjectsUpdateConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects update conflict response a status code equal to that given
+func (o *SchemaObjectsUpdateConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the schema objects update conflict response
+func (o *SchemaObjectsUpdateConflict) Code() int {
+	return 409
+}
+
+func (o *SchemaObjectsUpdateConflict) Error() string {
+	return fmt.Sprintf("[PUT /schema/{className}][%d] schemaObjectsUpdateConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsUpdateConflict) String() string {
+	return fmt.Sprintf("[PUT /schema/{className}][%d] schemaObjectsUpdateConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaObjectsUpdateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsUpdateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewSchemaObjectsUpdateUnprocessableEntity creates a SchemaObjectsUpdateUnprocessableEntity with default headers values
 func NewSchemaObjectsUpdateUnprocessableEntity() *SchemaObjectsUpdateUnprocessableEntity {
 	return &SchemaObjectsUpdateUnprocessableEntity{}
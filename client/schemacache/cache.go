@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package schemacache caches the schema and meta information of a Weaviate
+// server on the client side. It allows validating classes and properties
+// before a request is sent and detects incompatible server versions early.
+package schemacache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/weaviate/weaviate/client/meta"
+	"github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// DefaultTTL is the time after which the cached schema is fetched again
+const DefaultTTL = time.Minute
+
+type schemaClient interface {
+	SchemaDump(params *schema.SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter,
+		opts ...schema.ClientOption) (*schema.SchemaDumpOK, error)
+}
+
+type metaClient interface {
+	MetaGet(params *meta.MetaGetParams, authInfo runtime.ClientAuthInfoWriter,
+		opts ...meta.ClientOption) (*meta.MetaGetOK, error)
+}
+
+// Cache holds the schema and server version. It is refreshed lazily once the
+// TTL has expired, or explicitly through Refresh.
+type Cache struct {
+	schema schemaClient
+	meta   metaClient
+	auth   runtime.ClientAuthInfoWriter
+	ttl    time.Duration
+	now    func() time.Time
+
+	sync.RWMutex
+	classes   map[string]*class
+	version   string
+	fetchedAt time.Time
+}
+
+type class struct {
+	properties map[string]struct{}
+}
+
+// New creates a cache on top of the generated schema and meta clients. auth
+// may be nil if the server does not require authentication.
+func New(schemaClient schemaClient, metaClient metaClient,
+	auth runtime.ClientAuthInfoWriter, ttl time.Duration,
+) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{
+		schema: schemaClient,
+		meta:   metaClient,
+		auth:   auth,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+// Refresh fetches /schema and /meta regardless of the TTL
+func (c *Cache) Refresh(ctx context.Context) error {
+	schemaRes, err := c.schema.SchemaDump(schema.NewSchemaDumpParamsWithContext(ctx), c.auth)
+	if err != nil {
+		return fmt.Errorf("fetch schema: %w", err)
+	}
+
+	metaRes, err := c.meta.MetaGet(meta.NewMetaGetParamsWithContext(ctx), c.auth)
+	if err != nil {
+		return fmt.Errorf("fetch meta: %w", err)
+	}
+
+	classes := map[string]*class{}
+	if schemaRes.Payload != nil {
+		for _, cls := range schemaRes.Payload.Classes {
+			classes[cls.Class] = newClass(cls)
+		}
+	}
+
+	var version string
+	if metaRes.Payload != nil {
+		version = metaRes.Payload.Version
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.classes = classes
+	c.version = version
+	c.fetchedAt = c.now()
+	return nil
+}
+
+// Invalidate forces the next lookup to fetch the schema again, e.g. after the
+// schema was changed by this client.
+func (c *Cache) Invalidate() {
+	c.Lock()
+	defer c.Unlock()
+	c.fetchedAt = time.Time{}
+}
+
+// ServerVersion returns the version reported by /meta
+func (c *Cache) ServerVersion(ctx context.Context) (string, error) {
+	if err := c.ensureFresh(ctx); err != nil {
+		return "", err
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+	return c.version, nil
+}
+
+// CheckCompatibility returns an *IncompatibleVersionError if the server is
+// older than minVersion (in the form "major.minor.patch").
+func (c *Cache) CheckCompatibility(ctx context.Context, minVersion string) error {
+	version, err := c.ServerVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	ok, err := versionAtLeast(version, minVersion)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &IncompatibleVersionError{ServerVersion: version, MinVersion: minVersion}
+	}
+	return nil
+}
+
+// ValidateClass checks that the class and all given properties exist. It
+// returns a *ClassNotFoundError or *PropertyNotFoundError otherwise. If the
+// class is not found in the cached schema, the schema is fetched once more
+// before failing, as it might have been created in the meantime.
+func (c *Cache) ValidateClass(ctx context.Context, className string, properties ...string) error {
+	if err := c.ensureFresh(ctx); err != nil {
+		return err
+	}
+
+	cls, ok := c.lookup(className)
+	if !ok {
+		if err := c.Refresh(ctx); err != nil {
+			return err
+		}
+		if cls, ok = c.lookup(className); !ok {
+			return &ClassNotFoundError{Class: className}
+		}
+	}
+
+	for _, prop := range properties {
+		if _, ok := cls.properties[prop]; !ok {
+			return &PropertyNotFoundError{Class: className, Property: prop}
+		}
+	}
+
+	return nil
+}
+
+func (c *Cache) lookup(className string) (*class, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	cls, ok := c.classes[className]
+	return cls, ok
+}
+
+func (c *Cache) ensureFresh(ctx context.Context) error {
+	c.RLock()
+	fresh := !c.fetchedAt.IsZero() && c.now().Sub(c.fetchedAt) < c.ttl
+	c.RUnlock()
+
+	if fresh {
+		return nil
+	}
+	return c.Refresh(ctx)
+}
+
+func newClass(cls *models.Class) *class {
+	props := make(map[string]struct{}, len(cls.Properties))
+	for _, prop := range cls.Properties {
+		props[prop.Name] = struct{}{}
+	}
+	return &class{properties: props}
+}
@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schemacache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/client/meta"
+	"github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeSchemaClient struct {
+	calls   int
+	classes []*models.Class
+	hash    string
+}
+
+func (f *fakeSchemaClient) SchemaDump(params *schema.SchemaDumpParams,
+	authInfo runtime.ClientAuthInfoWriter, opts ...schema.ClientOption,
+) (*schema.SchemaDumpOK, error) {
+	f.calls++
+	return &schema.SchemaDumpOK{
+		Payload:             &models.Schema{Classes: f.classes},
+		XWeaviateSchemaHash: f.hash,
+	}, nil
+}
+
+type fakeMetaClient struct {
+	version string
+}
+
+func (f *fakeMetaClient) MetaGet(params *meta.MetaGetParams,
+	authInfo runtime.ClientAuthInfoWriter, opts ...meta.ClientOption,
+) (*meta.MetaGetOK, error) {
+	return &meta.MetaGetOK{Payload: &models.Meta{Version: f.version}}, nil
+}
+
+func newTestCache() (*Cache, *fakeSchemaClient) {
+	sc := &fakeSchemaClient{classes: []*models.Class{
+		{
+			Class:      "Article",
+			Properties: []*models.Property{{Name: "title"}, {Name: "wordCount"}},
+		},
+	}}
+	return New(sc, &fakeMetaClient{version: "1.25.3"}, nil, time.Minute), sc
+}
+
+func TestCache_ValidateClass(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("existing class and properties", func(t *testing.T) {
+		c, sc := newTestCache()
+		require.Nil(t, c.ValidateClass(ctx, "Article", "title", "wordCount"))
+		require.Nil(t, c.ValidateClass(ctx, "Article"))
+		assert.Equal(t, 1, sc.calls, "schema is only fetched once within the ttl")
+	})
+
+	t.Run("missing class refreshes once", func(t *testing.T) {
+		c, sc := newTestCache()
+		err := c.ValidateClass(ctx, "Author")
+
+		var notFound *ClassNotFoundError
+		require.True(t, errors.As(err, &notFound))
+		assert.Equal(t, "Author", notFound.Class)
+		assert.Equal(t, 2, sc.calls)
+	})
+
+	t.Run("class created in the meantime", func(t *testing.T) {
+		c, sc := newTestCache()
+		require.Nil(t, c.ValidateClass(ctx, "Article"))
+
+		sc.classes = append(sc.classes, &models.Class{Class: "Author"})
+		require.Nil(t, c.ValidateClass(ctx, "Author"))
+	})
+
+	t.Run("missing property", func(t *testing.T) {
+		c, _ := newTestCache()
+		err := c.ValidateClass(ctx, "Article", "title", "body")
+
+		var notFound *PropertyNotFoundError
+		require.True(t, errors.As(err, &notFound))
+		assert.Equal(t, "body", notFound.Property)
+	})
+
+	t.Run("expired ttl", func(t *testing.T) {
+		c, sc := newTestCache()
+		require.Nil(t, c.ValidateClass(ctx, "Article"))
+
+		c.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+		require.Nil(t, c.ValidateClass(ctx, "Article"))
+		assert.Equal(t, 2, sc.calls)
+	})
+}
+
+func TestCache_CheckCompatibility(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestCache()
+
+	for _, min := range []string{"1.0.0", "1.25.0", "1.25.3"} {
+		assert.Nil(t, c.CheckCompatibility(ctx, min))
+	}
+
+	for _, min := range []string{"1.25.4", "1.26.0", "2.0.0"} {
+		err := c.CheckCompatibility(ctx, min)
+		var incompatible *IncompatibleVersionError
+		require.True(t, errors.As(err, &incompatible), min)
+		assert.Equal(t, "1.25.3", incompatible.ServerVersion)
+	}
+
+	assert.NotNil(t, c.CheckCompatibility(ctx, "latest"))
+}
+
+func TestCache_ApplySchemaChange(t *testing.T) {
+	ctx := context.Background()
+	schemaChanged := &schema.SchemaObjectsPropertiesAddConflict{Payload: &models.ErrorResponse{
+		Error: []*models.ErrorResponseErrorItems0{{Code: "SCHEMA_CHANGED", Message: "schema changed"}},
+	}}
+
+	t.Run("retries with refetched schema", func(t *testing.T) {
+		c, sc := newTestCache()
+		sc.hash = "h1"
+
+		var hashes []string
+		err := c.ApplySchemaChange(ctx, DefaultSchemaChangeRetries, func(s *models.Schema, hash string) error {
+			hashes = append(hashes, hash)
+			if len(hashes) == 1 {
+				sc.hash = "h2" // changed concurrently
+				return schemaChanged
+			}
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"h1", "h2"}, hashes)
+		assert.Equal(t, 2, sc.calls)
+	})
+
+	t.Run("gives up after retries", func(t *testing.T) {
+		c, sc := newTestCache()
+		err := c.ApplySchemaChange(ctx, 2, func(s *models.Schema, hash string) error {
+			return schemaChanged
+		})
+		assert.True(t, IsSchemaChanged(err))
+		assert.Equal(t, 3, sc.calls)
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		c, sc := newTestCache()
+		classExists := &schema.SchemaObjectsCreateConflict{Payload: &models.ErrorResponse{
+			Error: []*models.ErrorResponseErrorItems0{{Code: "CLASS_EXISTS", Message: "class exists"}},
+		}}
+		err := c.ApplySchemaChange(ctx, 2, func(s *models.Schema, hash string) error {
+			return classExists
+		})
+		assert.Equal(t, classExists, err)
+		assert.False(t, IsSchemaChanged(err))
+		assert.Equal(t, 1, sc.calls)
+	})
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schemacache
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// schemaChangedCode is the error code of schema changes rejected as stale
+const schemaChangedCode = "SCHEMA_CHANGED"
+
+// ClassNotFoundError is returned if a class does not exist on the server
+type ClassNotFoundError struct {
+	Class string
+}
+
+func (e *ClassNotFoundError) Error() string {
+	return fmt.Sprintf("class %q does not exist", e.Class)
+}
+
+// PropertyNotFoundError is returned if a class exists but does not have the
+// requested property
+type PropertyNotFoundError struct {
+	Class    string
+	Property string
+}
+
+func (e *PropertyNotFoundError) Error() string {
+	return fmt.Sprintf("class %q has no property %q", e.Class, e.Property)
+}
+
+// IncompatibleVersionError is returned if the server version is lower than
+// the version required by the caller
+type IncompatibleVersionError struct {
+	ServerVersion string
+	MinVersion    string
+}
+
+func (e *IncompatibleVersionError) Error() string {
+	return fmt.Sprintf("server version %s is not compatible, at least %s is required",
+		e.ServerVersion, e.MinVersion)
+}
+
+// IsSchemaChanged returns true if err is a 409 response of a schema change,
+// which was rejected as the schema changed since the hash passed as If-Match
+func IsSchemaChanged(err error) bool {
+	var conflict interface {
+		IsCode(code int) bool
+		GetPayload() *models.ErrorResponse
+	}
+	if !errors.As(err, &conflict) || !conflict.IsCode(http.StatusConflict) {
+		return false
+	}
+
+	payload := conflict.GetPayload()
+	return payload != nil && len(payload.Error) > 0 &&
+		payload.Error[0] != nil && payload.Error[0].Code == schemaChangedCode
+}
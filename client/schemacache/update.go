@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schemacache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// DefaultSchemaChangeRetries is the number of times a schema change is
+// retried by ApplySchemaChange if the schema changed concurrently
+const DefaultSchemaChangeRetries = 5
+
+// ApplySchemaChange applies a schema change with optimistic concurrency
+// control. change is called with the current schema and its hash, which it
+// must pass as If-Match, e.g.
+//
+//	params := schema.NewSchemaObjectsPropertiesAddParams().WithIfMatch(&hash)
+//
+// If the server rejects the change, because the schema was changed by someone
+// else since it was fetched, the schema is fetched again and change is called
+// once more, up to retries times. This prevents lost updates, e.g. when two
+// clients add properties to the same class at once.
+//
+// The cache is invalidated afterwards, as the schema most likely changed.
+func (c *Cache) ApplySchemaChange(ctx context.Context, retries int,
+	change func(schema *models.Schema, hash string) error,
+) error {
+	defer c.Invalidate()
+
+	for attempt := 0; ; attempt++ {
+		res, err := c.schema.SchemaDump(schema.NewSchemaDumpParamsWithContext(ctx), c.auth)
+		if err != nil {
+			return fmt.Errorf("fetch schema: %w", err)
+		}
+
+		err = change(res.Payload, res.XWeaviateSchemaHash)
+		if err == nil || !IsSchemaChanged(err) || attempt >= retries {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schemacache
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// versionRegexp matches versions such as "1.25.0", "v1.25.0" or
+// "1.25.0-rc.1". Pre-release suffixes are ignored for comparisons.
+var versionRegexp = regexp.MustCompile(`^v?([0-9]+)\.([0-9]+)\.([0-9]+)(-.*)?$`)
+
+func parseVersion(v string) ([3]int, error) {
+	var parsed [3]int
+
+	matches := versionRegexp.FindStringSubmatch(v)
+	if matches == nil {
+		return parsed, fmt.Errorf("unexpected version %q", v)
+	}
+
+	for i := range parsed {
+		parsed[i], _ = strconv.Atoi(matches[i+1])
+	}
+	return parsed, nil
+}
+
+func versionAtLeast(version, min string) (bool, error) {
+	v, err := parseVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("server version: %w", err)
+	}
+
+	m, err := parseVersion(min)
+	if err != nil {
+		return false, fmt.Errorf("minimum version: %w", err)
+	}
+
+	for i := range v {
+		if v[i] != m[i] {
+			return v[i] > m[i], nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package seed generates synthetic objects for a class and imports them
+// through the batch API. It is intended for load tests and demo environments.
+package seed
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+var words = []string{
+	"vector", "search", "database", "semantic", "index", "query", "graph",
+	"object", "class", "module", "shard", "replica", "cluster", "node",
+	"embedding", "model", "filter", "keyword", "hybrid", "ranking", "tenant",
+	"backup", "schema", "property", "reference", "distance", "cosine",
+	"neighbor", "cache", "segment", "bucket", "memtable", "compaction",
+	"latency", "throughput", "recall", "precision", "dataset", "import",
+}
+
+var countryCodes = []string{"DE", "NL", "US", "GB", "FR"}
+
+// DefaultBaseTime is the time generated dates lie before, unless another base
+// time is given
+var DefaultBaseTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Generator creates synthetic property values for a class. Values follow
+// simple but plausible distributions: text is built from a fixed vocabulary,
+// numbers are normally distributed, dates lie within five years before the
+// base time and arrays contain one to five elements. Cross-references are not
+// generated.
+type Generator struct {
+	class *models.Class
+	rnd   *rand.Rand
+	now   time.Time
+}
+
+// NewGenerator creates a deterministic generator for the given seed and base
+// time. DefaultBaseTime is used if baseTime is zero.
+func NewGenerator(class *models.Class, seed int64, baseTime time.Time) *Generator {
+	if baseTime.IsZero() {
+		baseTime = DefaultBaseTime
+	}
+	return &Generator{
+		class: class,
+		rnd:   rand.New(rand.NewSource(seed)),
+		now:   baseTime.UTC().Truncate(time.Second),
+	}
+}
+
+// Objects generates n objects
+func (g *Generator) Objects(n int) ([]*models.Object, error) {
+	objects := make([]*models.Object, n)
+	for i := range objects {
+		obj, err := g.Object()
+		if err != nil {
+			return nil, err
+		}
+		objects[i] = obj
+	}
+	return objects, nil
+}
+
+// Object generates a single object
+func (g *Generator) Object() (*models.Object, error) {
+	props := map[string]interface{}{}
+	for _, prop := range g.class.Properties {
+		val, ok, err := g.value(prop.DataType, prop.NestedProperties)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", prop.Name, err)
+		}
+		if ok {
+			props[prop.Name] = val
+		}
+	}
+
+	return &models.Object{
+		Class:      g.class.Class,
+		ID:         strfmt.UUID(g.uuid()),
+		Properties: props,
+	}, nil
+}
+
+// value returns false if no value should be generated for the data type,
+// which is the case for cross-references.
+func (g *Generator) value(dataType []string, nested []*models.NestedProperty) (interface{}, bool, error) {
+	if len(dataType) != 1 {
+		// multiple data types are only allowed for cross-references
+		return nil, false, nil
+	}
+
+	switch dt := schema.DataType(dataType[0]); dt {
+	case schema.DataTypeText, schema.DataTypeString:
+		return g.text(), true, nil
+	case schema.DataTypeInt:
+		return g.int(), true, nil
+	case schema.DataTypeNumber:
+		return g.number(), true, nil
+	case schema.DataTypeBoolean:
+		return g.rnd.Intn(2) == 0, true, nil
+	case schema.DataTypeDate:
+		return g.date(), true, nil
+	case schema.DataTypeUUID:
+		return g.uuid(), true, nil
+	case schema.DataTypeGeoCoordinates:
+		lat := float32(g.rnd.Float64()*180 - 90)
+		lon := float32(g.rnd.Float64()*360 - 180)
+		return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}, true, nil
+	case schema.DataTypePhoneNumber:
+		return &models.PhoneNumber{
+			Input:          fmt.Sprintf("0%d", 100000000+g.rnd.Intn(900000000)),
+			DefaultCountry: countryCodes[g.rnd.Intn(len(countryCodes))],
+		}, true, nil
+	case schema.DataTypeBlob:
+		b := make([]byte, 16+g.rnd.Intn(48))
+		g.rnd.Read(b)
+		return base64.StdEncoding.EncodeToString(b), true, nil
+	case schema.DataTypeObject:
+		obj, err := g.object(nested)
+		return obj, true, err
+	case schema.DataTypeTextArray, schema.DataTypeStringArray:
+		return g.array(func() interface{} { return g.text() }), true, nil
+	case schema.DataTypeIntArray:
+		return g.array(func() interface{} { return g.int() }), true, nil
+	case schema.DataTypeNumberArray:
+		return g.array(func() interface{} { return g.number() }), true, nil
+	case schema.DataTypeBooleanArray:
+		return g.array(func() interface{} { return g.rnd.Intn(2) == 0 }), true, nil
+	case schema.DataTypeDateArray:
+		return g.array(func() interface{} { return g.date() }), true, nil
+	case schema.DataTypeUUIDArray:
+		return g.array(func() interface{} { return g.uuid() }), true, nil
+	case schema.DataTypeObjectArray:
+		var err error
+		arr := g.array(func() interface{} {
+			obj, objErr := g.object(nested)
+			if objErr != nil {
+				err = objErr
+			}
+			return obj
+		})
+		return arr, true, err
+	default:
+		// class names start with an upper case letter, which makes this a
+		// cross-reference
+		if dt != "" && unicode.IsUpper(rune(dt[0])) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("unsupported data type %q", dt)
+	}
+}
+
+func (g *Generator) object(nested []*models.NestedProperty) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	for _, prop := range nested {
+		val, ok, err := g.value(prop.DataType, prop.NestedProperties)
+		if err != nil {
+			return nil, fmt.Errorf("nested property %q: %w", prop.Name, err)
+		}
+		if ok {
+			obj[prop.Name] = val
+		}
+	}
+	return obj, nil
+}
+
+func (g *Generator) array(gen func() interface{}) []interface{} {
+	arr := make([]interface{}, 1+g.rnd.Intn(5))
+	for i := range arr {
+		arr[i] = gen()
+	}
+	return arr
+}
+
+func (g *Generator) text() string {
+	n := 3 + g.rnd.Intn(10)
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = words[g.rnd.Intn(len(words))]
+	}
+	return strings.Join(parts, " ")
+}
+
+// int is roughly log-uniformly distributed between 0 and 100,000, so that
+// both small and large values are common
+func (g *Generator) int() int64 {
+	return int64(math.Pow(10, g.rnd.Float64()*5)) - 1
+}
+
+func (g *Generator) number() float64 {
+	return math.Round((100+g.rnd.NormFloat64()*25)*100) / 100
+}
+
+func (g *Generator) date() string {
+	fiveYears := int64(5 * 365 * 24 * time.Hour)
+	return g.now.Add(-time.Duration(g.rnd.Int63n(fiveYears))).Format(time.RFC3339)
+}
+
+func (g *Generator) uuid() string {
+	var b [16]byte
+	g.rnd.Read(b[:])
+	id, _ := uuid.FromBytes(b[:])
+	// make it a valid version 4 uuid
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return id.String()
+}
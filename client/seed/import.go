@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/weaviate/weaviate/client/batch"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// DefaultBatchSize is the number of objects sent per batch request
+const DefaultBatchSize = 100
+
+type batchClient interface {
+	BatchObjectsCreate(params *batch.BatchObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter,
+		opts ...batch.ClientOption) (*batch.BatchObjectsCreateOK, error)
+}
+
+// Options control how many objects are generated and imported
+type Options struct {
+	// Count is the total number of objects to import
+	Count int
+	// BatchSize defaults to DefaultBatchSize
+	BatchSize int
+	// Seed makes the generated data reproducible
+	Seed int64
+	// BaseTime is the time generated dates lie before, defaults to
+	// DefaultBaseTime
+	BaseTime time.Time
+	// Tenant is set on every object for multi-tenant classes
+	Tenant string
+}
+
+// Result summarizes an import
+type Result struct {
+	Imported int
+	Failed   int
+	// Errors contains the first error message of each failed object, capped
+	// at one message per batch to keep the result small
+	Errors []string
+}
+
+// Import generates opts.Count objects for the class and imports them in
+// batches. It stops at the first batch request which fails as a whole, but
+// continues if only individual objects fail.
+func Import(ctx context.Context, client batchClient, auth runtime.ClientAuthInfoWriter,
+	class *models.Class, opts Options,
+) (*Result, error) {
+	if opts.Count <= 0 {
+		return nil, fmt.Errorf("count must be greater than 0")
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	gen := NewGenerator(class, opts.Seed, opts.BaseTime)
+	res := &Result{}
+
+	for remaining := opts.Count; remaining > 0; remaining -= batchSize {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		objects, err := gen.Objects(min(batchSize, remaining))
+		if err != nil {
+			return res, fmt.Errorf("generate objects: %w", err)
+		}
+		for _, obj := range objects {
+			obj.Tenant = opts.Tenant
+		}
+
+		params := batch.NewBatchObjectsCreateParamsWithContext(ctx).
+			WithBody(batch.BatchObjectsCreateBody{Objects: objects})
+		resp, err := client.BatchObjectsCreate(params, auth)
+		if err != nil {
+			return res, fmt.Errorf("import batch: %w", err)
+		}
+
+		res.add(resp.Payload)
+	}
+
+	return res, nil
+}
+
+func (r *Result) add(payload []*models.ObjectsGetResponse) {
+	var batchErr string
+	for _, obj := range payload {
+		if obj.Result == nil || obj.Result.Errors == nil || len(obj.Result.Errors.Error) == 0 {
+			r.Imported++
+			continue
+		}
+
+		r.Failed++
+		if batchErr == "" {
+			msgs := make([]string, len(obj.Result.Errors.Error))
+			for i, e := range obj.Result.Errors.Error {
+				msgs[i] = e.Message
+			}
+			batchErr = strings.Join(msgs, ", ")
+		}
+	}
+
+	if batchErr != "" {
+		r.Errors = append(r.Errors, batchErr)
+	}
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package seed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/client/batch"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func testClass() *models.Class {
+	return &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "tags", DataType: []string{"text[]"}},
+			{Name: "wordCount", DataType: []string{"int"}},
+			{Name: "score", DataType: []string{"number"}},
+			{Name: "published", DataType: []string{"boolean"}},
+			{Name: "publishedAt", DataType: []string{"date"}},
+			{Name: "externalId", DataType: []string{"uuid"}},
+			{Name: "location", DataType: []string{"geoCoordinates"}},
+			{Name: "phone", DataType: []string{"phoneNumber"}},
+			{Name: "hasAuthor", DataType: []string{"Author"}},
+			{
+				Name:     "meta",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "source", DataType: []string{"text"}},
+					{Name: "pages", DataType: []string{"int[]"}},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	t.Run("respects property types", func(t *testing.T) {
+		objs, err := NewGenerator(testClass(), 1, time.Time{}).Objects(20)
+		require.Nil(t, err)
+		require.Len(t, objs, 20)
+
+		for _, obj := range objs {
+			assert.Equal(t, "Article", obj.Class)
+			assert.True(t, strfmt.IsUUID(obj.ID.String()))
+
+			props := obj.Properties.(map[string]interface{})
+			assert.IsType(t, "", props["title"])
+			assert.IsType(t, []interface{}{}, props["tags"])
+			assert.IsType(t, int64(0), props["wordCount"])
+			assert.IsType(t, float64(0), props["score"])
+			assert.IsType(t, true, props["published"])
+			assert.IsType(t, &models.GeoCoordinates{}, props["location"])
+			assert.IsType(t, &models.PhoneNumber{}, props["phone"])
+			assert.True(t, strfmt.IsUUID(props["externalId"].(string)))
+			_, err := time.Parse(time.RFC3339, props["publishedAt"].(string))
+			assert.Nil(t, err)
+			assert.NotContains(t, props, "hasAuthor")
+
+			meta := props["meta"].(map[string]interface{})
+			assert.IsType(t, "", meta["source"])
+			assert.IsType(t, []interface{}{}, meta["pages"])
+		}
+	})
+
+	t.Run("is deterministic for a given seed", func(t *testing.T) {
+		a, err := NewGenerator(testClass(), 42, time.Time{}).Objects(5)
+		require.Nil(t, err)
+		b, err := NewGenerator(testClass(), 42, time.Time{}).Objects(5)
+		require.Nil(t, err)
+
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("unsupported data type", func(t *testing.T) {
+		class := &models.Class{Class: "Article", Properties: []*models.Property{
+			{Name: "weird", DataType: []string{"unknown"}},
+		}}
+		_, err := NewGenerator(class, 1, time.Time{}).Object()
+		assert.NotNil(t, err)
+	})
+}
+
+type fakeBatchClient struct {
+	batchSizes []int
+	failFirst  bool
+}
+
+func (f *fakeBatchClient) BatchObjectsCreate(params *batch.BatchObjectsCreateParams,
+	authInfo runtime.ClientAuthInfoWriter, opts ...batch.ClientOption,
+) (*batch.BatchObjectsCreateOK, error) {
+	f.batchSizes = append(f.batchSizes, len(params.Body.Objects))
+
+	payload := make([]*models.ObjectsGetResponse, len(params.Body.Objects))
+	for i, obj := range params.Body.Objects {
+		payload[i] = &models.ObjectsGetResponse{Object: *obj}
+		if f.failFirst && i == 0 {
+			payload[i].Result = &models.ObjectsGetResponseAO2Result{
+				Errors: &models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{{Message: "invalid"}}},
+			}
+		}
+	}
+	return &batch.BatchObjectsCreateOK{Payload: payload}, nil
+}
+
+func TestImport(t *testing.T) {
+	t.Run("imports in batches", func(t *testing.T) {
+		client := &fakeBatchClient{}
+		res, err := Import(context.Background(), client, nil, testClass(), Options{Count: 250})
+		require.Nil(t, err)
+
+		assert.Equal(t, []int{100, 100, 50}, client.batchSizes)
+		assert.Equal(t, 250, res.Imported)
+		assert.Equal(t, 0, res.Failed)
+	})
+
+	t.Run("reports failed objects", func(t *testing.T) {
+		client := &fakeBatchClient{failFirst: true}
+		res, err := Import(context.Background(), client, nil, testClass(), Options{Count: 20, BatchSize: 10})
+		require.Nil(t, err)
+
+		assert.Equal(t, 18, res.Imported)
+		assert.Equal(t, 2, res.Failed)
+		assert.Equal(t, []string{"invalid", "invalid"}, res.Errors)
+	})
+
+	t.Run("invalid count", func(t *testing.T) {
+		_, err := Import(context.Background(), &fakeBatchClient{}, nil, testClass(), Options{})
+		assert.NotNil(t, err)
+	})
+}
@@ -40,6 +40,37 @@ type UpdateShardStatusRequest struct {
 	SchemaVersion        uint64
 }
 
+type CreateRoleRequest struct {
+	Role *models.Role
+}
+
+type DeleteRoleRequest struct {
+	Name string
+}
+
+// UpdatePermissionsRequest adds or removes permissions of a role
+type UpdatePermissionsRequest struct {
+	Role        string
+	Permissions []*models.Permission
+}
+
+// UpdateUserRolesRequest assigns or revokes roles of a user
+type UpdateUserRolesRequest struct {
+	User  string
+	Roles []string
+}
+
+// RotateKeyRequest replaces the token of an api key by the token with the
+// given hash, the token itself is never replicated
+type RotateKeyRequest struct {
+	ID   string
+	Hash string
+}
+
+type RevokeKeyRequest struct {
+	ID string
+}
+
 type QueryReadOnlyClassesRequest struct {
 	Classes []string
 }
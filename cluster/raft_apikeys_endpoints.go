@@ -0,0 +1,26 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+func (s *Raft) RotateKey(ctx context.Context, id, hash string) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_ROTATE_KEY, cmd.RotateKeyRequest{ID: id, Hash: hash})
+}
+
+func (s *Raft) RevokeKey(ctx context.Context, id string) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_REVOKE_KEY, cmd.RevokeKeyRequest{ID: id})
+}
@@ -14,6 +14,7 @@ package cluster
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,6 +26,8 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -203,6 +206,10 @@ func (s *Raft) Execute(ctx context.Context, req *cmd.ApplyRequest) (uint64, erro
 		))
 	defer t.ObserveDuration()
 
+	if hash, ok := types.ExpectedSchemaHash(ctx); ok && req.ExpectedSchemaHash == "" {
+		req.ExpectedSchemaHash = hash
+	}
+
 	var schemaVersion uint64
 	err := backoff.Retry(func() error {
 		var err error
@@ -217,6 +224,10 @@ func (s *Raft) Execute(ctx context.Context, req *cmd.ApplyRequest) (uint64, erro
 		// We are the leader, let's apply
 		if s.store.IsLeader() {
 			schemaVersion, err = s.store.Execute(req)
+			if errors.Is(err, types.ErrSchemaChanged) {
+				// applying it again won't change the schema it was based on
+				return backoff.Permanent(err)
+			}
 			// We might fail due to leader not found as we are losing or transferring leadership, retry
 			return err
 		}
@@ -231,6 +242,10 @@ func (s *Raft) Execute(ctx context.Context, req *cmd.ApplyRequest) (uint64, erro
 		var resp *cmd.ApplyResponse
 		resp, err = s.cl.Apply(ctx, leader, req)
 		if err != nil {
+			if status.Code(err) == codes.Aborted {
+				// keep the error type, so that the caller can fetch the schema and retry
+				err = fmt.Errorf("%w: %s", types.ErrSchemaChanged, status.Convert(err).Message())
+			}
 			// Don't retry if the actual apply to the leader failed, we have retry at the network layer already
 			return backoff.Permanent(err)
 		}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func (s *Raft) CreateRole(ctx context.Context, role *models.Role) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_CREATE_ROLE, cmd.CreateRoleRequest{Role: role})
+}
+
+func (s *Raft) DeleteRole(ctx context.Context, name string) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_DELETE_ROLE, cmd.DeleteRoleRequest{Name: name})
+}
+
+func (s *Raft) AddPermissions(ctx context.Context, role string, perms []*models.Permission) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_ADD_PERMISSIONS,
+		cmd.UpdatePermissionsRequest{Role: role, Permissions: perms})
+}
+
+func (s *Raft) RemovePermissions(ctx context.Context, role string, perms []*models.Permission) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_REMOVE_PERMISSIONS,
+		cmd.UpdatePermissionsRequest{Role: role, Permissions: perms})
+}
+
+func (s *Raft) AssignRoles(ctx context.Context, user string, roles []string) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_ASSIGN_ROLES,
+		cmd.UpdateUserRolesRequest{User: user, Roles: roles})
+}
+
+func (s *Raft) RevokeRoles(ctx context.Context, user string, roles []string) error {
+	return s.executeJSON(ctx, cmd.ApplyRequest_TYPE_REVOKE_ROLES,
+		cmd.UpdateUserRolesRequest{User: user, Roles: roles})
+}
+
+// executeJSON executes a command whose sub command is the JSON encoded request
+func (s *Raft) executeJSON(ctx context.Context, typ cmd.ApplyRequest_Type, req any) error {
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	_, err = s.Execute(ctx, &cmd.ApplyRequest{Type: typ, SubCommand: subCommand})
+	return err
+}
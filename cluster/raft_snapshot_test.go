@@ -13,6 +13,8 @@ package cluster
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -24,7 +26,10 @@ import (
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/utils"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -115,3 +120,81 @@ func TestSnapshotRestoreSchemaOnly(t *testing.T) {
 	// Ensure there was no supplementary call to the underlying DB as we were just recovering the schema
 	m.indexer.AssertExpectations(t)
 }
+
+// TestSnapshotRestoreRolesAndKeys ensures that roles and api keys are part of
+// the snapshot and that changes committed after it are replayed from the log
+func TestSnapshotRestoreRolesAndKeys(t *testing.T) {
+	ctx := context.Background()
+	m := NewMockStore(t, "Node-1", utils.MustGetFreeTCPPort())
+	m.cfg.Roles = rbac.New(rbac.Config{Enabled: true})
+	newKeys := func() *apikey.Client {
+		keys, err := apikey.New(config.Config{Authentication: config.Authentication{APIKey: config.APIKey{
+			Enabled: true, AllowedKeys: []string{"first-key", "second-key"}, Users: []string{"alice"},
+		}}})
+		require.Nil(t, err)
+		return keys
+	}
+	m.cfg.APIKeys = newKeys()
+	s := NewFSM(m.cfg)
+	m.store = &s
+	addr := fmt.Sprintf("%s:%d", m.cfg.Host, m.cfg.RaftPort)
+	srv := NewRaft(mocks.NewMockNodeSelector(), m.store, nil)
+
+	m.indexer.On("Open", Anything).Return(nil)
+	assert.Nil(t, srv.Open(ctx, m.indexer))
+	assert.Nil(t, srv.store.Notify(m.cfg.NodeID, addr))
+	assert.Nil(t, srv.WaitUntilDBRestored(ctx, time.Second*1, make(chan struct{})))
+	assert.True(t, tryNTimesWithWait(10, time.Millisecond*200, srv.Ready))
+	tryNTimesWithWait(20, time.Millisecond*100, srv.store.IsLeader)
+	assert.True(t, srv.store.IsLeader())
+
+	name, action := "books", models.PermissionActionReadCollections
+	require.Nil(t, srv.CreateRole(ctx, &models.Role{
+		Name:        &name,
+		Permissions: []*models.Permission{{Action: &action}},
+	}))
+	firstID, secondID := apiKeyID("first-key"), apiKeyID("second-key")
+	require.Nil(t, srv.RotateKey(ctx, firstID, apiKeyHash("rotated-key")))
+	assert.Nil(t, srv.store.raft.Barrier(2*time.Second).Error())
+	assert.Nil(t, srv.store.raft.Snapshot().Error())
+
+	// these are log entries and not included in the snapshot
+	require.Nil(t, srv.AssignRoles(ctx, "alice", []string{name}))
+	require.Nil(t, srv.RevokeKey(ctx, secondID))
+
+	m.indexer.On("Close", Anything).Return(nil)
+	assert.Nil(t, srv.Close(ctx))
+
+	roles, keys := rbac.New(rbac.Config{Enabled: true}), newKeys()
+	m.cfg.Roles, m.cfg.APIKeys = roles, keys
+	s = NewFSM(m.cfg)
+	m.store = &s
+	m.indexer = fakes.NewMockSchemaExecutor()
+	srv = NewRaft(mocks.NewMockNodeSelector(), m.store, nil)
+	m.indexer.On("Open", Anything).Return(nil)
+	assert.Nil(t, srv.Open(ctx, m.indexer))
+	assert.Nil(t, srv.store.Notify(m.cfg.NodeID, addr))
+	assert.Nil(t, srv.WaitUntilDBRestored(ctx, time.Second*1, make(chan struct{})))
+	assert.True(t, tryNTimesWithWait(10, time.Millisecond*200, srv.Ready))
+
+	require.Len(t, roles.GetRoles(), 1)
+	assert.Equal(t, name, *roles.GetRoles()[0].Name)
+	assert.Len(t, roles.GetRolesForUser("alice"), 1)
+	for key, valid := range map[string]bool{"first-key": false, "rotated-key": true, "second-key": false} {
+		_, err := keys.ValidateAndExtract(key, nil)
+		assert.Equal(t, valid, err == nil, key)
+	}
+
+	m.indexer.On("Close", Anything).Return(nil)
+	assert.Nil(t, srv.Close(ctx))
+}
+
+func apiKeyID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
+
+func apiKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
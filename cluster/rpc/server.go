@@ -177,6 +177,8 @@ func toRPCError(err error) error {
 		ec = codes.Unavailable
 	case errors.Is(err, schema.ErrMTDisabled):
 		ec = codes.FailedPrecondition
+	case errors.Is(err, types.ErrSchemaChanged):
+		ec = codes.Aborted
 	default:
 		ec = codes.Internal
 	}
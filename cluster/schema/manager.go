@@ -21,7 +21,9 @@ import (
 	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/models"
+	entSchema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/exp/metadata"
 	gproto "google.golang.org/protobuf/proto"
 )
@@ -95,6 +97,10 @@ func (s *SchemaManager) Restore(rc io.ReadCloser, parser Parser) error {
 }
 
 func (s *SchemaManager) PreApplyFilter(req *command.ApplyRequest) error {
+	if err := s.CheckExpectedHash(req); err != nil {
+		return err
+	}
+
 	classInfo := s.schema.ClassInfo(req.Class)
 
 	// Discard restoring a class if it already exists
@@ -115,6 +121,20 @@ func (s *SchemaManager) PreApplyFilter(req *command.ApplyRequest) error {
 	return nil
 }
 
+// CheckExpectedHash rejects commands which expect another schema hash than the
+// one of the current schema, see schema.Hash. It's checked when the command
+// is applied, so that it's deterministic on every node.
+func (s *SchemaManager) CheckExpectedHash(req *command.ApplyRequest) error {
+	if req.ExpectedSchemaHash == "" {
+		return nil
+	}
+	current := s.schema.ReadOnlySchema()
+	if hash := entSchema.Hash(&current); hash != req.ExpectedSchemaHash {
+		return fmt.Errorf("%w: expected schema hash %s, got %s", types.ErrSchemaChanged, req.ExpectedSchemaHash, hash)
+	}
+	return nil
+}
+
 func (s *SchemaManager) Load(ctx context.Context, nodeID string) error {
 	if err := s.db.Open(ctx); err != nil {
 		return err
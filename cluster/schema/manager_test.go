@@ -19,10 +19,14 @@ import (
 	"io"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/models"
+	entSchema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -307,6 +311,24 @@ func TestSchemaSnapshot(t *testing.T) {
 	assert.ErrorContains(t, sc.Restore(sink3, parser2), "pars")
 }
 
+func TestSchemaManagerCheckExpectedHash(t *testing.T) {
+	sm := NewSchemaManager("N1", fakes.NewMockSchemaExecutor(), fakes.NewMockParser(), logrus.New())
+	require.Nil(t, sm.schema.addClass(&models.Class{Class: "C"}, &sharding.State{}, 1))
+	current := sm.schema.ReadOnlySchema()
+	hash := entSchema.Hash(&current)
+
+	req := &command.ApplyRequest{Type: command.ApplyRequest_TYPE_DELETE_CLASS, Class: "C"}
+	assert.Nil(t, sm.CheckExpectedHash(req))
+
+	req.ExpectedSchemaHash = hash
+	assert.Nil(t, sm.CheckExpectedHash(req))
+	assert.Nil(t, sm.PreApplyFilter(req))
+
+	req.ExpectedSchemaHash = "outdated"
+	assert.ErrorIs(t, sm.CheckExpectedHash(req), types.ErrSchemaChanged)
+	assert.ErrorIs(t, sm.PreApplyFilter(req), types.ErrSchemaChanged)
+}
+
 // TestPropertiesMigration ensures that our migration function sets proper default values
 // The test verifies that we migrate top level properties and then at least one layer deep nested properties
 func TestPropertiesMigration(t *testing.T) {
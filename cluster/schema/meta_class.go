@@ -172,7 +172,8 @@ func (m *metaClass) AddProperty(v uint64, props ...*models.Property) error {
 // MergeProps makes sure duplicates are not created by ignoring new props
 // with the same names as old props.
 // If property of nested type is present in both new and old slices,
-// final property is created by merging new property into copy of old one
+// final property is created by merging new property into copy of old one.
+// The lifecycle of an old property is changed if the new one sets it.
 func MergeProps(old, new []*models.Property) []*models.Property {
 	mergedProps := make([]*models.Property, len(old), len(old)+len(new))
 	copy(mergedProps, old)
@@ -191,6 +192,12 @@ func MergeProps(old, new []*models.Property) []*models.Property {
 		} else {
 			mergedProps[oldIdx].IndexRangeFilters = new[idx].IndexRangeFilters
 
+			if lifecycle := new[idx].Lifecycle; lifecycle != "" && lifecycle != mergedProps[oldIdx].Lifecycle {
+				propCopy := *mergedProps[oldIdx]
+				propCopy.Lifecycle = lifecycle
+				mergedProps[oldIdx] = &propCopy
+			}
+
 			nestedProperties, merged := entSchema.MergeRecursivelyNestedProperties(
 				mergedProps[oldIdx].NestedProperties,
 				new[idx].NestedProperties)
@@ -145,6 +145,14 @@ type Config struct {
 	// being frozen happen, with the goal of being able to alert the metadata nodes. This
 	// channel will be nil if the metadata server is not enabled.
 	ClassTenantDataEvents chan metadata.ClassTenant
+
+	// Roles applies the role changes committed through RAFT, their state is
+	// part of the snapshots
+	Roles Roles
+
+	// APIKeys applies the rotations and revocations of api keys committed
+	// through RAFT, their state is part of the snapshots
+	APIKeys APIKeys
 }
 
 // Store is the implementation of RAFT on this local node. It will handle the local schema and RAFT operations (startup,
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+var errAPIKeysNotConfigured = errors.New("api keys are not configured on this node")
+
+// APIKeys is the state of the rotated and revoked api keys replicated through
+// RAFT, see apikey.Client
+type APIKeys interface {
+	ApplyRotateKey(id, hash string) error
+	ApplyRevokeKey(id string) error
+
+	// Snapshot and Restore the state as part of the RAFT snapshots
+	Snapshot() ([]byte, error)
+	Restore(data []byte) error
+}
+
+func (st *Store) applyAPIKeys(cmd *api.ApplyRequest) error {
+	keys := st.cfg.APIKeys
+	if keys == nil {
+		return errAPIKeysNotConfigured
+	}
+
+	switch cmd.Type {
+	case api.ApplyRequest_TYPE_ROTATE_KEY:
+		req := api.RotateKeyRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+			return fmt.Errorf("unmarshal rotate key: %w", err)
+		}
+		return keys.ApplyRotateKey(req.ID, req.Hash)
+	case api.ApplyRequest_TYPE_REVOKE_KEY:
+		req := api.RevokeKeyRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+			return fmt.Errorf("unmarshal revoke key: %w", err)
+		}
+		return keys.ApplyRevokeKey(req.ID)
+	default:
+		return fmt.Errorf("unknown api key command %s", cmd.Type)
+	}
+}
@@ -134,6 +134,12 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 		"cmd_schema_only": schemaOnly,
 	}).Debug("server.apply")
 
+	// commands based on an outdated schema are rejected on every node
+	if err := st.schemaManager.CheckExpectedHash(&cmd); err != nil {
+		ret.Error = err
+		return ret
+	}
+
 	f := func() {}
 
 	switch cmd.Type {
@@ -188,6 +194,18 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 			ret.Error = st.schemaManager.UpdateTenantsProcess(&cmd, schemaOnly)
 		}
 
+	case api.ApplyRequest_TYPE_CREATE_ROLE, api.ApplyRequest_TYPE_DELETE_ROLE,
+		api.ApplyRequest_TYPE_ADD_PERMISSIONS, api.ApplyRequest_TYPE_REMOVE_PERMISSIONS,
+		api.ApplyRequest_TYPE_ASSIGN_ROLES, api.ApplyRequest_TYPE_REVOKE_ROLES:
+		f = func() {
+			ret.Error = st.applyRoles(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_ROTATE_KEY, api.ApplyRequest_TYPE_REVOKE_KEY:
+		f = func() {
+			ret.Error = st.applyAPIKeys(&cmd)
+		}
+
 	case api.ApplyRequest_TYPE_STORE_SCHEMA_V1:
 		f = func() {
 			ret.Error = st.StoreSchemaV1()
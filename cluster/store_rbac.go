@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var errRolesNotConfigured = errors.New("roles are not configured on this node")
+
+// Roles is the state of the roles replicated through RAFT, see rbac.Manager
+type Roles interface {
+	ApplyCreateRole(role *models.Role) error
+	ApplyDeleteRole(name string)
+	ApplyAddPermissions(role string, perms []*models.Permission) error
+	ApplyRemovePermissions(role string, perms []*models.Permission) error
+	ApplyAssignRoles(user string, roles []string) error
+	ApplyRevokeRoles(user string, roles []string)
+
+	// Snapshot and Restore the state as part of the RAFT snapshots
+	Snapshot() ([]byte, error)
+	Restore(data []byte) error
+}
+
+func (st *Store) applyRoles(cmd *api.ApplyRequest) error {
+	roles := st.cfg.Roles
+	if roles == nil {
+		return errRolesNotConfigured
+	}
+
+	switch cmd.Type {
+	case api.ApplyRequest_TYPE_CREATE_ROLE:
+		req := api.CreateRoleRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+			return fmt.Errorf("unmarshal create role: %w", err)
+		}
+		return roles.ApplyCreateRole(req.Role)
+	case api.ApplyRequest_TYPE_DELETE_ROLE:
+		req := api.DeleteRoleRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+			return fmt.Errorf("unmarshal delete role: %w", err)
+		}
+		roles.ApplyDeleteRole(req.Name)
+		return nil
+	case api.ApplyRequest_TYPE_ADD_PERMISSIONS, api.ApplyRequest_TYPE_REMOVE_PERMISSIONS:
+		req := api.UpdatePermissionsRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+			return fmt.Errorf("unmarshal update permissions: %w", err)
+		}
+		if cmd.Type == api.ApplyRequest_TYPE_ADD_PERMISSIONS {
+			return roles.ApplyAddPermissions(req.Role, req.Permissions)
+		}
+		return roles.ApplyRemovePermissions(req.Role, req.Permissions)
+	case api.ApplyRequest_TYPE_ASSIGN_ROLES, api.ApplyRequest_TYPE_REVOKE_ROLES:
+		req := api.UpdateUserRolesRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+			return fmt.Errorf("unmarshal update user roles: %w", err)
+		}
+		if cmd.Type == api.ApplyRequest_TYPE_ASSIGN_ROLES {
+			return roles.ApplyAssignRoles(req.User, req.Roles)
+		}
+		roles.ApplyRevokeRoles(req.User, req.Roles)
+		return nil
+	default:
+		return fmt.Errorf("unknown role command %s", cmd.Type)
+	}
+}
@@ -12,6 +12,8 @@
 package cluster
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sync"
@@ -35,9 +37,82 @@ import (
 // be implemented to allow for concurrent updates while a snapshot is happening.
 func (st *Store) Snapshot() (raft.FSMSnapshot, error) {
 	st.log.Info("persisting snapshot")
-	return st.schemaManager.Snapshot(), nil
+	snap := &fsmSnapshot{schema: st.schemaManager.Snapshot(), states: map[string]json.RawMessage{}}
+	for field, state := range st.snapshotStates() {
+		data, err := state.Snapshot()
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s: %w", field, err)
+		}
+		snap.states[field] = data
+	}
+	return snap, nil
+}
+
+// snapshotState is state replicated through RAFT besides the schema
+type snapshotState interface {
+	Snapshot() ([]byte, error)
+	Restore(data []byte) error
+}
+
+// snapshotStates returns the configured states by the snapshot field they are
+// stored in
+func (st *Store) snapshotStates() map[string]snapshotState {
+	states := map[string]snapshotState{}
+	if st.cfg.Roles != nil {
+		states["rbac"] = st.cfg.Roles
+	}
+	if st.cfg.APIKeys != nil {
+		states["apikeys"] = st.cfg.APIKeys
+	}
+	return states
 }
 
+// fsmSnapshot adds the states of the roles and api keys to the schema
+// snapshot. They are stored in their own fields, which older versions ignore.
+type fsmSnapshot struct {
+	schema raft.FSMSnapshot
+	states map[string]json.RawMessage
+}
+
+func (s *fsmSnapshot) Persist(sink raft.SnapshotSink) error {
+	if len(s.states) == 0 {
+		return s.schema.Persist(sink)
+	}
+
+	buf := &bufferedSink{SnapshotSink: sink}
+	if err := s.schema.Persist(buf); err != nil {
+		sink.Cancel()
+		return err
+	}
+	snap := map[string]json.RawMessage{}
+	if err := json.Unmarshal(buf.Bytes(), &snap); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("decode schema snapshot: %w", err)
+	}
+	for field, state := range s.states {
+		snap[field] = state
+	}
+
+	if err := json.NewEncoder(sink).Encode(snap); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("encode: %w", err)
+	}
+	return sink.Close()
+}
+
+func (s *fsmSnapshot) Release() {
+	s.schema.Release()
+}
+
+// bufferedSink keeps everything written to it in memory, closing it is a no-op
+type bufferedSink struct {
+	raft.SnapshotSink
+	bytes.Buffer
+}
+
+func (b *bufferedSink) Write(p []byte) (int, error) { return b.Buffer.Write(p) }
+func (b *bufferedSink) Close() error                { return nil }
+
 // Restore is used to restore an FSM from a snapshot. It is not called
 // concurrently with any other command. The FSM must discard all previous
 // state before restoring the snapshot.
@@ -50,12 +125,30 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 			}
 		}()
 
-		if err := st.schemaManager.Restore(rc, st.cfg.Parser); err != nil {
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			return fmt.Errorf("read snapshot: %w", err)
+		}
+		if err := st.schemaManager.Restore(io.NopCloser(bytes.NewReader(data)), st.cfg.Parser); err != nil {
 			st.log.WithError(err).Error("restoring schema from snapshot")
 			return fmt.Errorf("restore schema from snapshot: %w", err)
 		}
 		st.log.Info("successfully restored schema from snapshot")
 
+		if states := st.snapshotStates(); len(states) > 0 {
+			// missing fields clear the state, as the FSM has to discard all
+			// previous state
+			snap := map[string]json.RawMessage{}
+			if err := json.Unmarshal(data, &snap); err != nil {
+				return fmt.Errorf("decode snapshot: %w", err)
+			}
+			for field, state := range states {
+				if err := state.Restore(snap[field]); err != nil {
+					return fmt.Errorf("restore %s from snapshot: %w", field, err)
+				}
+			}
+		}
+
 		if st.cfg.MetadataOnlyVoters {
 			return nil
 		}
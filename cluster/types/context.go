@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package types
+
+import "context"
+
+type expectedSchemaHashKey struct{}
+
+// ContextWithExpectedSchemaHash returns a context whose schema commands are
+// only applied if the hash of the schema still equals hash. An empty hash
+// removes the expectation.
+func ContextWithExpectedSchemaHash(ctx context.Context, hash string) context.Context {
+	return context.WithValue(ctx, expectedSchemaHashKey{}, hash)
+}
+
+// ExpectedSchemaHash returns the hash set by ContextWithExpectedSchemaHash
+func ExpectedSchemaHash(ctx context.Context) (string, bool) {
+	hash, ok := ctx.Value(expectedSchemaHashKey{}).(string)
+	return hash, ok && hash != ""
+}
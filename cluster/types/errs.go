@@ -22,4 +22,7 @@ var (
 	ErrUnknownCommand = errors.New("unknown command")
 	// ErrDeadlineExceeded represents an error returned when the deadline for waiting for a specific update is exceeded.
 	ErrDeadlineExceeded = errors.New("deadline exceeded for waiting for update")
+	// ErrSchemaChanged is returned when a command expected a schema hash
+	// which doesn't match the schema it is applied to
+	ErrSchemaChanged = errors.New("schema changed")
 )
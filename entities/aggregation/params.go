@@ -26,6 +26,8 @@ type Params struct {
 	GroupBy          *filters.Path              `json:"groupBy"`
 	IncludeMetaCount bool                       `json:"includeMetaCount"`
 	Limit            *int                       `json:"limit"`
+	Offset           *int                       `json:"offset"`
+	GroupSort        *GroupSort                 `json:"groupSort"`
 	ObjectLimit      *int                       `json:"objectLimit"`
 	SearchVector     []float32                  `json:"searchVector"`
 	TargetVector     string                     `json:"targetVector"`
@@ -37,6 +39,29 @@ type Params struct {
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
 }
 
+// GroupSort orders the groups of a grouped aggregation. Without it the
+// groups are ordered by their count, largest first.
+type GroupSort struct {
+	By    string `json:"by"`    // GroupSortByCount or GroupSortByValue
+	Order string `json:"order"` // "asc" or "desc"
+}
+
+const (
+	GroupSortByCount = "count"
+	GroupSortByValue = "groupedBy"
+)
+
+func (s *GroupSort) Validate() error {
+	if s.By != GroupSortByCount && s.By != GroupSortByValue {
+		return fmt.Errorf("invalid group sort by %q, must be %q or %q",
+			s.By, GroupSortByCount, GroupSortByValue)
+	}
+	if s.Order != "asc" && s.Order != "desc" {
+		return fmt.Errorf("invalid group sort order %q, must be \"asc\" or \"desc\"", s.Order)
+	}
+	return nil
+}
+
 type ParamProperty struct {
 	Name        schema.PropertyName `json:"name"`
 	Aggregators []Aggregator        `json:"aggregators"`
@@ -13,6 +13,7 @@ package backup
 
 import (
 	"fmt"
+	"slices"
 	"time"
 )
 
@@ -35,6 +36,21 @@ type DistributedBackupDescriptor struct {
 	ServerVersion string                     `json:"serverVersion"`
 	Leader        string                     `json:"leader"`
 	Error         string                     `json:"error"`
+	// SchemaVersion is the version of the schema log that every node caught
+	// up to before taking its part, so that all nodes captured the same
+	// classes and sharding state. It does not order the data: every node
+	// captures its shards at its own time. It is zero for backups which were
+	// not coordinated this way, and stored as snapshotVersion for backups
+	// already written under that name.
+	SchemaVersion uint64 `json:"snapshotVersion,omitempty"`
+	// BaseID is the backup this one is an increment of. Files which did not
+	// change since are not stored again but referenced from BaseID or its own
+	// bases, which therefore must be kept for as long as this backup is.
+	BaseID string `json:"baseId,omitempty"`
+	// Signature authenticates the descriptor, including the nodes and
+	// classes to restore. It is only set if the backup was taken with an
+	// encryption key.
+	Signature string `json:"signature,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -224,6 +240,67 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// Manifest maps each shard file to the backups holding its content. It
+	// is what allows later backups to only capture what changed since.
+	Manifest map[string]*FileInfo `json:"manifest,omitempty"`
+}
+
+// FileInfo describes a shard file at the time of the backup
+type FileInfo struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+	// TailSum is the checksum of the last block of an append-only file. A later
+	// backup uses it to tell whether such a file only grew in the meantime.
+	TailSum uint32 `json:"tailSum,omitempty"`
+	// Parts are the consecutive byte ranges the file is made of, oldest first
+	Parts []FilePart `json:"parts"`
+}
+
+// FilePart is the range of a file starting at Offset and stored in chunk
+// Chunk of backup BackupID. It extends to the offset of the next part or to
+// the end of the file.
+type FilePart struct {
+	BackupID string `json:"backupId"`
+	// Chunk is zero until the part has been written
+	Chunk  int32 `json:"chunk"`
+	Offset int64 `json:"offset"`
+}
+
+// Pending returns the part which still needs to be written by the current
+// backup, or nil if the whole content is held by earlier backups
+func (f *FileInfo) Pending() *FilePart {
+	if n := len(f.Parts); n > 0 && f.Parts[n-1].Chunk == 0 {
+		return &f.Parts[n-1]
+	}
+	return nil
+}
+
+// Shard returns the descriptor of shard name or nil if it doesn't exist
+func (c *ClassDescriptor) Shard(name string) *ShardDescriptor {
+	for _, s := range c.Shards {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// FilterShards keeps the shards satisfying pred and drops the chunks which
+// don't hold any of them
+func (c *ClassDescriptor) FilterShards(pred func(name string) bool) {
+	shards := make([]*ShardDescriptor, 0, len(c.Shards))
+	for _, s := range c.Shards {
+		if pred(s.Name) {
+			shards = append(shards, s)
+		}
+	}
+	c.Shards = shards
+	for k, names := range c.Chunks {
+		if !slices.ContainsFunc(names, pred) {
+			delete(c.Chunks, k)
+		}
+	}
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -246,7 +323,9 @@ type ClassDescriptor struct {
 	ShardingState []byte             `json:"shardingState"`
 	Schema        []byte             `json:"schema"`
 	Chunks        map[int32][]string `json:"chunks,omitempty"`
-	Error         error              `json:"-"`
+	// Checksums are the hex encoded SHA-256 sums of the stored chunks
+	Checksums map[int32]string `json:"checksums,omitempty"`
+	Error     error            `json:"-"`
 }
 
 // BackupDescriptor contains everything needed to completely restore a list of classes
@@ -259,6 +338,27 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+	// SchemaVersion is the schema version this node's part was taken at, see
+	// DistributedBackupDescriptor.SchemaVersion
+	SchemaVersion uint64 `json:"snapshotVersion,omitempty"`
+	// BaseID is the backup this one is an increment of, see
+	// DistributedBackupDescriptor.BaseID
+	BaseID string `json:"baseId,omitempty"`
+	// Encrypted is true if the chunks are encrypted
+	Encrypted bool `json:"encrypted,omitempty"`
+	// Signature authenticates the descriptor, including the chunk checksums.
+	// It is only set if the backup was taken with an encryption key.
+	Signature string `json:"signature,omitempty"`
+}
+
+// Class returns the descriptor of class name or nil if it doesn't exist
+func (d *BackupDescriptor) Class(name string) *ClassDescriptor {
+	for i := range d.Classes {
+		if d.Classes[i].Name == name {
+			return &d.Classes[i]
+		}
+	}
+	return nil
 }
 
 // List all existing classes in d
@@ -396,6 +496,8 @@ func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 		Version:       d.Version,
 		ServerVersion: d.ServerVersion,
 		Error:         d.Error,
+		SchemaVersion: d.SchemaVersion,
+		BaseID:        d.BaseID,
 	}
 	if node != "" && len(cs) > 0 {
 		result.Nodes = map[string]*NodeDescriptor{node: {Classes: cs}}
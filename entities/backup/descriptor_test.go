@@ -473,3 +473,14 @@ func TestShardDescriptorClear(t *testing.T) {
 	s.ClearTemporary()
 	assert.Equal(t, want, s)
 }
+
+func TestClassDescriptorFilterShards(t *testing.T) {
+	c := ClassDescriptor{
+		Name:   "a",
+		Shards: []*ShardDescriptor{{Name: "t1"}, {Name: "t2"}, {Name: "t3"}},
+		Chunks: map[int32][]string{1: {"t1", "t2"}, 2: {"t3"}},
+	}
+	c.FilterShards(func(name string) bool { return name == "t2" })
+	assert.Equal(t, []*ShardDescriptor{{Name: "t2"}}, c.Shards)
+	assert.Equal(t, map[int32][]string{1: {"t1", "t2"}}, c.Chunks)
+}
@@ -42,9 +42,16 @@ func Class(c *models.Class) *models.Class {
 		}
 	}
 
+	var encryptionConf *models.EncryptionConfig
+	if c.EncryptionConfig != nil {
+		conf := *c.EncryptionConfig
+		encryptionConf = &conf
+	}
+
 	return &models.Class{
 		Class:               c.Class,
 		Description:         c.Description,
+		EncryptionConfig:    encryptionConf,
 		ModuleConfig:        c.ModuleConfig,
 		ShardingConfig:      c.ShardingConfig,
 		VectorIndexConfig:   c.VectorIndexConfig,
@@ -53,6 +60,7 @@ func Class(c *models.Class) *models.Class {
 		Vectorizer:          c.Vectorizer,
 		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
 		Properties:          properties,
+		Templates:           append([]string(nil), c.Templates...),
 	}
 }
 
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "file.json")
+
+	require.Nil(t, WriteFileAtomic(path, []byte("first"), 0o600))
+	require.Nil(t, WriteFileAtomic(path, []byte("second"), 0o600))
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, "second", string(data))
+
+	exists, err := FileExists(path + ".tmp")
+	require.Nil(t, err)
+	assert.False(t, exists, "temporary file is renamed")
+
+	info, err := os.Stat(path)
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
@@ -51,6 +51,9 @@ type BackupConfig struct {
 	// name of the endpoint, e.g. s3.amazonaws.com
 	Endpoint string `json:"Endpoint,omitempty"`
 
+	// ID of a successful backup on the same backend, bucket and path to take an incremental backup against. Only files which changed since are stored, unchanged ones are referenced from the base backup, which therefore must be kept.
+	IncrementalBaseBackupID string `json:"IncrementalBaseBackupID,omitempty"`
+
 	// Path or key within the bucket
 	Path string `json:"Path,omitempty"`
 }
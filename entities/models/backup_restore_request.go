@@ -29,6 +29,12 @@ import (
 // swagger:model BackupRestoreRequest
 type BackupRestoreRequest struct {
 
+	// Restores the backup even if it is not signed although an encryption key is configured, e.g. a backup taken before the key was configured. Signatures which are present are still checked.
+	AllowUnsigned bool `json:"allow_unsigned,omitempty"`
+
+	// Allows restoring classes under different names. Keys are class names in the backup, values are the new names. Useful when cloning data into an environment which already holds the original classes.
+	ClassMapping map[string]string `json:"class_mapping,omitempty"`
+
 	// Custom configuration for the backup restoration process
 	Config *RestoreConfig `json:"config,omitempty"`
 
@@ -40,6 +46,9 @@ type BackupRestoreRequest struct {
 
 	// Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.
 	NodeMapping map[string]string `json:"node_mapping,omitempty"`
+
+	// Allows restoring only some tenants of multi-tenant classes. Keys are class names in the backup, values are the tenants to restore.
+	Tenants map[string][]string `json:"tenants,omitempty"`
 }
 
 // Validate validates this backup restore request
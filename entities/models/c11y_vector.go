@@ -22,7 +22,7 @@ import (
 	"github.com/go-openapi/strfmt"
 )
 
-// C11yVector A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting.
+// C11yVector A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting. On writes, the vector can also be sent as a base64-encoded string (standard encoding with padding) of its values as little-endian float32, which is about a quarter of the size. NaN and infinite values are rejected.
 //
 // swagger:model C11yVector
 type C11yVector []float32
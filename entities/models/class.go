@@ -37,6 +37,9 @@ type Class struct {
 	// Description of the collection for metadata purposes.
 	Description string `json:"description,omitempty"`
 
+	// encryption config
+	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
+
 	// inverted index config
 	InvertedIndexConfig *InvertedIndexConfig `json:"invertedIndexConfig,omitempty"`
 
@@ -55,6 +58,9 @@ type Class struct {
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Names of the schema templates whose properties are included in the collection. Template properties are added when the collection is created and whenever the template gains new properties.
+	Templates []string `json:"templates,omitempty"`
+
 	// Configure named vectors. Either use this field or `vectorizer`, `vectorIndexType`, and `vectorIndexConfig` fields. Available from `v1.24.0`.
 	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
 
@@ -72,6 +78,10 @@ type Class struct {
 func (m *Class) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEncryptionConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInvertedIndexConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -98,6 +108,25 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateEncryptionConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.EncryptionConfig) { // not required
+		return nil
+	}
+
+	if m.EncryptionConfig != nil {
+		if err := m.EncryptionConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryptionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryptionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.InvertedIndexConfig) { // not required
 		return nil
@@ -211,6 +240,10 @@ func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateEncryptionConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateInvertedIndexConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -237,6 +270,22 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 	return nil
 }
 
+func (m *Class) contextValidateEncryptionConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.EncryptionConfig != nil {
+		if err := m.EncryptionConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryptionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryptionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.InvertedIndexConfig != nil {
@@ -131,6 +131,9 @@ func (m *ErrorResponse) UnmarshalBinary(b []byte) error {
 // swagger:model ErrorResponseErrorItems0
 type ErrorResponseErrorItems0 struct {
 
+	// Machine-readable code of the error, only set for errors of a known kind, e.g. CLASS_EXISTS.
+	Code string `json:"code,omitempty"`
+
 	// message
 	Message string `json:"message,omitempty"`
 }
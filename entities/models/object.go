@@ -39,6 +39,9 @@ type Object struct {
 	// (Response only) Timestamp of creation of this object in milliseconds since epoch UTC.
 	CreationTimeUnix int64 `json:"creationTimeUnix,omitempty"`
 
+	// Timestamp in milliseconds since epoch UTC after which the object is deleted automatically. Objects without it never expire.
+	ExpiresAtUnix int64 `json:"expiresAtUnix,omitempty"`
+
 	// ID of the Object.
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
@@ -33,6 +33,9 @@ type ObjectsListResponse struct {
 	// deprecations
 	Deprecations []*Deprecation `json:"deprecations"`
 
+	// Cursor to pass as `after` to retrieve the next page. Only set when the request was paged with `after`.
+	NextCursor string `json:"nextCursor,omitempty"`
+
 	// The actual list of Objects.
 	Objects []*Object `json:"objects"`
 
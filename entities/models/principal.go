@@ -28,6 +28,9 @@ import (
 // swagger:model Principal
 type Principal struct {
 
+	// The collections the principal is limited to, e.g. by the scope of its API key. Empty if it is not limited.
+	Collections []string `json:"collections"`
+
 	// groups
 	Groups []string `json:"groups"`
 
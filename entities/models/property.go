@@ -50,6 +50,10 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
 	IndexSearchable *bool `json:"indexSearchable,omitempty"`
 
+	// Lifecycle of the property, used to remove it safely in steps. `deprecated` properties can still be read, but writing them is rejected. `tombstoned` properties are additionally hidden from GraphQL, their data is kept until it is purged. Defaults to `active`.
+	// Enum: [active deprecated tombstoned]
+	Lifecycle string `json:"lifecycle,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
@@ -68,6 +72,10 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateLifecycle(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNestedProperties(formats); err != nil {
 		res = append(res, err)
 	}
@@ -82,6 +90,51 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var propertyTypeLifecyclePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["active","deprecated","tombstoned"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeLifecyclePropEnum = append(propertyTypeLifecyclePropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyLifecycleActive captures enum value "active"
+	PropertyLifecycleActive string = "active"
+
+	// PropertyLifecycleDeprecated captures enum value "deprecated"
+	PropertyLifecycleDeprecated string = "deprecated"
+
+	// PropertyLifecycleTombstoned captures enum value "tombstoned"
+	PropertyLifecycleTombstoned string = "tombstoned"
+)
+
+// prop value enum
+func (m *Property) validateLifecycleEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeLifecyclePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateLifecycle(formats strfmt.Registry) error {
+	if swag.IsZero(m.Lifecycle) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateLifecycleEnum("lifecycle", "body", m.Lifecycle); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
 	if swag.IsZero(m.NestedProperties) { // not required
 		return nil
@@ -34,6 +34,10 @@ type ReplicationConfig struct {
 	// Enable asynchronous replication (default: false).
 	AsyncEnabled bool `json:"asyncEnabled"`
 
+	// Conflict resolution strategy for objects which differ between replicas (default: LastWriteWins).
+	// Enum: [LastWriteWins PropertyMerge]
+	ConflictResolution string `json:"conflictResolution,omitempty"`
+
 	// Conflict resolution strategy for deleted objects.
 	// Enum: [NoAutomatedResolution DeleteOnConflict TimeBasedResolution]
 	DeletionStrategy string `json:"deletionStrategy,omitempty"`
@@ -46,6 +50,10 @@ type ReplicationConfig struct {
 func (m *ReplicationConfig) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConflictResolution(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDeletionStrategy(formats); err != nil {
 		res = append(res, err)
 	}
@@ -56,6 +64,48 @@ func (m *ReplicationConfig) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var replicationConfigTypeConflictResolutionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LastWriteWins","PropertyMerge"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replicationConfigTypeConflictResolutionPropEnum = append(replicationConfigTypeConflictResolutionPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplicationConfigConflictResolutionLastWriteWins captures enum value "LastWriteWins"
+	ReplicationConfigConflictResolutionLastWriteWins string = "LastWriteWins"
+
+	// ReplicationConfigConflictResolutionPropertyMerge captures enum value "PropertyMerge"
+	ReplicationConfigConflictResolutionPropertyMerge string = "PropertyMerge"
+)
+
+// prop value enum
+func (m *ReplicationConfig) validateConflictResolutionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, replicationConfigTypeConflictResolutionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReplicationConfig) validateConflictResolution(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConflictResolution) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateConflictResolutionEnum("conflictResolution", "body", m.ConflictResolution); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var replicationConfigTypeDeletionStrategyPropEnum []interface{}
 
 func init() {
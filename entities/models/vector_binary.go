@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package models
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// UnmarshalJSON accepts either a JSON array of numbers or a base64-encoded
+// string of little-endian float32 values. The latter is about a quarter of
+// the size for high-dimensional vectors.
+func (m *C11yVector) UnmarshalJSON(data []byte) error {
+	return unmarshalVectorJSON(data, (*[]float32)(m))
+}
+
+// UnmarshalJSON accepts either a JSON array of numbers or a base64-encoded
+// string of little-endian float32 values, see C11yVector.
+func (m *Vector) UnmarshalJSON(data []byte) error {
+	return unmarshalVectorJSON(data, (*[]float32)(m))
+}
+
+func unmarshalVectorJSON(data []byte, target *[]float32) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '"' {
+		return json.Unmarshal(data, target)
+	}
+
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+
+	vector, err := DecodeVectorBase64(encoded)
+	if err != nil {
+		return err
+	}
+	*target = vector
+	return nil
+}
+
+// DecodeVectorBase64 decodes a base64-encoded (standard encoding, padded)
+// array of little-endian float32 values. NaN and infinite values are
+// rejected, as they can't be sent as JSON numbers either.
+func DecodeVectorBase64(encoded string) ([]float32, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode base64 vector: %w", err)
+	}
+	if len(raw)%4 != 0 {
+		return nil, fmt.Errorf("decode base64 vector: length of %d bytes is not a "+
+			"multiple of 4", len(raw))
+	}
+
+	vector := make([]float32, len(raw)/4)
+	for i := range vector {
+		v := math.Float32frombits(binary.LittleEndian.Uint32(raw[i*4:]))
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return nil, fmt.Errorf("decode base64 vector: value %v at position %d "+
+				"is not a finite number", v, i)
+		}
+		vector[i] = v
+	}
+	return vector, nil
+}
+
+// EncodeVectorBase64 is the inverse of DecodeVectorBase64
+func EncodeVectorBase64(vector []float32) string {
+	raw := make([]byte, len(vector)*4)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(raw[i*4:], math.Float32bits(v))
+	}
+	return base64.StdEncoding.EncodeToString(raw)
+}
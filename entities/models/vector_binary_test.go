@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package models
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorUnmarshalJSON(t *testing.T) {
+	vector := []float32{0.5, -1, 3.25, 0}
+	encoded := EncodeVectorBase64(vector)
+
+	t.Run("object with array and base64 vectors", func(t *testing.T) {
+		body := `{"class":"Foo","vector":[0.5,-1,3.25,0],"vectors":{"a":"` + encoded + `","b":[1,2]}}`
+
+		var obj Object
+		require.Nil(t, json.Unmarshal([]byte(body), &obj))
+
+		assert.Equal(t, C11yVector(vector), obj.Vector)
+		assert.Equal(t, Vector(vector), obj.Vectors["a"])
+		assert.Equal(t, Vector{1, 2}, obj.Vectors["b"])
+	})
+
+	t.Run("base64 legacy vector", func(t *testing.T) {
+		var obj Object
+		require.Nil(t, json.Unmarshal([]byte(`{"vector":"`+encoded+`"}`), &obj))
+		assert.Equal(t, C11yVector(vector), obj.Vector)
+	})
+
+	t.Run("null vector", func(t *testing.T) {
+		var obj Object
+		require.Nil(t, json.Unmarshal([]byte(`{"vector":null}`), &obj))
+		assert.Nil(t, obj.Vector)
+	})
+
+	t.Run("invalid base64", func(t *testing.T) {
+		var obj Object
+		err := json.Unmarshal([]byte(`{"vector":"not base64!"}`), &obj)
+		assert.ErrorContains(t, err, "decode base64 vector")
+	})
+
+	t.Run("length not a multiple of 4", func(t *testing.T) {
+		var obj Object
+		err := json.Unmarshal([]byte(`{"vector":"AAAA"}`), &obj)
+		assert.ErrorContains(t, err, "not a multiple of 4")
+	})
+
+	t.Run("NaN and infinite values", func(t *testing.T) {
+		for _, v := range []float32{float32(math.NaN()), float32(math.Inf(1)), float32(math.Inf(-1))} {
+			var obj Object
+			err := json.Unmarshal([]byte(`{"vector":"`+EncodeVectorBase64([]float32{1, v})+`"}`), &obj)
+			assert.ErrorContains(t, err, "at position 1 is not a finite number")
+		}
+	})
+}
@@ -30,6 +30,7 @@ const (
 	Ref2Vec             ModuleType = "Ref2Vec"
 	Text2MultiVec       ModuleType = "Text2MultiVec"
 	Text2ColBERT        ModuleType = "Text2ColBERT"
+	Text2Chunks         ModuleType = "Text2Chunks"
 	Text2TextGenerative ModuleType = "Text2TextGenerative"
 	Text2TextSummarize  ModuleType = "Text2TextSummarize"
 	Text2TextReranker   ModuleType = "Text2TextReranker"
@@ -65,3 +66,9 @@ type Dependency[T types.Embedding] interface {
 type ModuleHasAltNames interface {
 	AltNames() []string
 }
+
+// ModuleHasHealthCheck is implemented by modules which depend on a remote
+// inference service. CheckHealth returns an error if the service is not ready.
+type ModuleHasHealthCheck interface {
+	CheckHealth(ctx context.Context) error
+}
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type vectorizationStatsKey struct{}
+
+// VectorizationStats counts the vectors computed by vectorizer modules and
+// the ones which were kept, because none of the vectorized properties of
+// the object changed. Every target vector counts separately.
+type VectorizationStats struct {
+	recomputed atomic.Int64
+	skipped    atomic.Int64
+}
+
+func (s *VectorizationStats) Recomputed() int64 {
+	return s.recomputed.Load()
+}
+
+func (s *VectorizationStats) Skipped() int64 {
+	return s.skipped.Load()
+}
+
+// ContextWithVectorizationStats makes the vectorization of objects with ctx
+// count its vectors in stats
+func ContextWithVectorizationStats(ctx context.Context, stats *VectorizationStats) context.Context {
+	return context.WithValue(ctx, vectorizationStatsKey{}, stats)
+}
+
+// CountVectors adds to the stats of ctx, if there are any
+func CountVectors(ctx context.Context, recomputed, skipped int) {
+	stats, ok := ctx.Value(vectorizationStatsKey{}).(*VectorizationStats)
+	if !ok {
+		return
+	}
+	stats.recomputed.Add(int64(recomputed))
+	stats.skipped.Add(int64(skipped))
+}
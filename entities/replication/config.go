@@ -20,4 +20,8 @@ type GlobalConfig struct {
 	MinimumFactor int `json:"minimum_factor" yaml:"minimum_factor"`
 
 	DeletionStrategy string `json:"deletion_strategy" yaml:"deletion_strategy"`
+
+	// ReadRouting is the default order in which replicas are asked to serve
+	// reads, either "local-first" or "nearest". Requests may override it.
+	ReadRouting string `json:"read_routing" yaml:"read_routing"`
 }
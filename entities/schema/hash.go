@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Hash returns a hex encoded sha256 of the schema. It does not depend on the
+// order of the classes, so it only changes if a class changes.
+func Hash(s *models.Schema) string {
+	classes := []*models.Class{}
+	if s != nil {
+		classes = append(classes, s.Classes...)
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].Class < classes[j].Class
+	})
+
+	b, err := json.Marshal(classes)
+	if err != nil {
+		// models.Class is plain data and always marshals
+		panic(err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHash(t *testing.T) {
+	a := &models.Class{Class: "Article"}
+	b := &models.Class{Class: "Author"}
+
+	hash := Hash(&models.Schema{Classes: []*models.Class{a, b}})
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, Hash(&models.Schema{Classes: []*models.Class{b, a}}),
+		"order of classes does not matter")
+	assert.NotEqual(t, hash, Hash(&models.Schema{Classes: []*models.Class{a}}))
+	assert.NotEqual(t, hash, Hash(&models.Schema{Classes: []*models.Class{
+		a, {Class: "Author", Properties: []*models.Property{{Name: "name"}}},
+	}}))
+	assert.Equal(t, Hash(nil), Hash(&models.Schema{}))
+}
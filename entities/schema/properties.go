@@ -76,3 +76,16 @@ func DedupProperties(oldProps, newProps []*models.Property) []*models.Property {
 
 	return uniqueProps
 }
+
+// PropertyDeprecated reports whether writing the property is rejected, which
+// is the case for deprecated and tombstoned properties
+func PropertyDeprecated(prop *models.Property) bool {
+	return prop.Lifecycle == models.PropertyLifecycleDeprecated ||
+		prop.Lifecycle == models.PropertyLifecycleTombstoned
+}
+
+// PropertyTombstoned reports whether the property is hidden from GraphQL. Its
+// data is kept until it is purged.
+func PropertyTombstoned(prop *models.Property) bool {
+	return prop.Lifecycle == models.PropertyLifecycleTombstoned
+}
@@ -35,6 +35,7 @@ type Result struct {
 	Schema               models.PropertySchema
 	Created              int64
 	Updated              int64
+	ExpiresAt            int64
 	AdditionalProperties models.AdditionalProperties
 	VectorWeights        map[string]string
 	IsConsistent         bool
@@ -63,6 +64,7 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 		Properties:         schema,
 		CreationTimeUnix:   r.Created,
 		LastUpdateTimeUnix: r.Updated,
+		ExpiresAtUnix:      r.ExpiresAt,
 		VectorWeights:      r.VectorWeights,
 		Tenant:             r.Tenant,
 	}
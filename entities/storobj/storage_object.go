@@ -245,8 +245,11 @@ func FromBinaryOptional(data []byte,
 					return nil, errors.Wrap(err, "Could not unmarshal multivectors")
 				}
 			}
+		} else {
+			rw.MoveBufferPositionForward(uint64(multiVectorsLength))
 		}
 	}
+	expiresAt := readExpiresAt(&rw)
 
 	// some object members need additional "enrichment". Only do this if necessary, ie if they are actually present
 	if len(props) > 0 ||
@@ -278,6 +281,7 @@ func FromBinaryOptional(data []byte,
 		ko.Object.LastUpdateTimeUnix = updateTime
 		ko.Object.Class = className
 	}
+	ko.Object.ExpiresAtUnix = expiresAt
 
 	return ko, nil
 }
@@ -464,6 +468,12 @@ func (ko *Object) LastUpdateTimeUnix() int64 {
 
 // AdditionalProperties groups all properties which are stored with the
 // object and not generated at runtime
+// ExpiresAtUnix is the time in unix millis after which the object is
+// deleted, 0 if it does not expire
+func (ko *Object) ExpiresAtUnix() int64 {
+	return ko.Object.ExpiresAtUnix
+}
+
 func (ko *Object) AdditionalProperties() models.AdditionalProperties {
 	return ko.Object.Additional
 }
@@ -549,6 +559,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
+		ExpiresAt:            ko.ExpiresAtUnix(),
 		AdditionalProperties: additionalProperties,
 		// Score is filled in later
 		ExplainScore: ko.ExplainScore(),
@@ -699,6 +710,10 @@ func DocIDAndTimeFromBinary(in []byte) (docID uint64, updateTime int64, err erro
 // 4          | uint32        | dimension count of multivectors
 // 4          | uint32        | length of multivectors as msgpack
 // n          | []byte        | multivectors as msgpack
+// 8          | int64         | expiry time in unix millis, 0 if the object does not expire
+//
+// Objects written before the expiry time was added end with 4 zero bytes
+// instead, so the expiry time is only read if 8 bytes are left.
 
 const (
 	maxVectorLength               int = math.MaxUint16
@@ -819,7 +834,8 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + vectorWeightsLength +
 		4 + targetVectorsOffsetsLength +
 		4 + uint32(targetVectorsSegmentLength) +
-		8 + uint32(len(multiVectorsPacked)) // multivectors
+		8 + uint32(len(multiVectorsPacked)) + // multivectors
+		4 // expiry time, the multivectors reserve the first 4 bytes
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -895,6 +911,8 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	rw.WriteUint64(uint64(ko.ExpiresAtUnix()))
+
 	return byteBuffer, nil
 }
 
@@ -1098,8 +1116,9 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 			}
 		}
 	}
+	expiresAt := readExpiresAt(&rw)
 
-	return ko.parseObject(
+	if err := ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
 		updateTime,
@@ -1107,7 +1126,53 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights, nil, 0,
-	)
+	); err != nil {
+		return err
+	}
+	ko.Object.ExpiresAtUnix = expiresAt
+	return nil
+}
+
+// readExpiresAt reads the expiry time which follows the multivectors. It is 0
+// for objects which were written before it was added.
+func readExpiresAt(rw *byteops.ReadWriter) int64 {
+	if rw.Position+8 > uint64(len(rw.Buffer)) {
+		return 0
+	}
+	return int64(rw.ReadUint64())
+}
+
+// ExpiresAtFromBinary reads only the expiry time of an object, skipping all
+// other contents. It is 0 if the object does not expire.
+func ExpiresAtFromBinary(in []byte) (int64, error) {
+	if len(in) < 1+8+1+16+8+8+2 {
+		return 0, errors.Errorf("binary data too short")
+	}
+	if in[0] != 1 {
+		return 0, errors.Errorf("unsupported binary marshaller version %d", in[0])
+	}
+
+	rw := byteops.NewReadWriter(in, byteops.WithPosition(1+8+1+16+8+8))
+	vectorLength := uint64(rw.ReadUint16())
+	rw.MoveBufferPositionForward(vectorLength * 4)
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint16())) // class name
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint32())) // schema
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint32())) // meta
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint32())) // vector weights
+
+	// the remaining segments were added later and may be missing
+	for i := 0; i < 3; i++ {
+		if rw.Position+4 > uint64(len(rw.Buffer)) {
+			return 0, nil
+		}
+		// target vectors offsets, target vectors, multivectors
+		rw.MoveBufferPositionForward(uint64(rw.ReadUint32()))
+	}
+	if rw.Position > uint64(len(rw.Buffer)) {
+		return 0, errors.Errorf("binary data too short")
+	}
+
+	return readExpiresAt(&rw), nil
 }
 
 func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error) {
@@ -1344,6 +1409,7 @@ func deepCopyObject(orig models.Object) models.Object {
 		ID:                 orig.ID,
 		CreationTimeUnix:   orig.CreationTimeUnix,
 		LastUpdateTimeUnix: orig.LastUpdateTimeUnix,
+		ExpiresAtUnix:      orig.ExpiresAtUnix,
 		Vector:             deepCopyVector(orig.Vector),
 		VectorWeights:      orig.VectorWeights,
 		Additional:         orig.Additional, // WARNING: not a deep copy!!
@@ -98,6 +98,76 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingExpiry(t *testing.T) {
+	newObject := func(expiresAt int64, multiVectors map[string][][]float32) *Object {
+		obj := FromObjectMulti(
+			&models.Object{
+				Class:              "MyFavoriteClass",
+				CreationTimeUnix:   123456,
+				LastUpdateTimeUnix: 56789,
+				ExpiresAtUnix:      expiresAt,
+				ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+				Properties: map[string]interface{}{
+					"name": "MyName",
+				},
+			},
+			[]float32{1, 2, 0.7},
+			models.Vectors{"vector1": {1, 2, 3}},
+			multiVectors,
+		)
+		obj.DocID = 7
+		return obj
+	}
+
+	t.Run("with expiry", func(t *testing.T) {
+		for _, multiVectors := range []map[string][][]float32{
+			nil, {"vector2": {{4, 5}, {6, 7}}},
+		} {
+			before := newObject(987654321, multiVectors)
+			asBinary, err := before.MarshalBinary()
+			require.Nil(t, err)
+
+			after, err := FromBinary(asBinary)
+			require.Nil(t, err)
+			assert.Equal(t, before, after)
+
+			optional, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
+			require.Nil(t, err)
+			assert.Equal(t, int64(987654321), optional.ExpiresAtUnix())
+
+			expiresAt, err := ExpiresAtFromBinary(asBinary)
+			require.Nil(t, err)
+			assert.Equal(t, int64(987654321), expiresAt)
+			assert.Equal(t, int64(987654321), after.SearchResult(additional.Properties{}, "").Object().ExpiresAtUnix)
+		}
+	})
+
+	t.Run("without expiry", func(t *testing.T) {
+		asBinary, err := newObject(0, nil).MarshalBinary()
+		require.Nil(t, err)
+
+		expiresAt, err := ExpiresAtFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Zero(t, expiresAt)
+	})
+
+	t.Run("written before expiry was added", func(t *testing.T) {
+		asBinary, err := newObject(987654321, nil).MarshalBinary()
+		require.Nil(t, err)
+		// previously, the 4 bytes after the multivectors were left empty
+		legacy := append(asBinary[:len(asBinary)-8:len(asBinary)-8], 0, 0, 0, 0)
+
+		after, err := FromBinary(legacy)
+		require.Nil(t, err)
+		assert.Zero(t, after.ExpiresAtUnix())
+		assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+
+		expiresAt, err := ExpiresAtFromBinary(legacy)
+		require.Nil(t, err)
+		assert.Zero(t, expiresAt)
+	})
+}
+
 func TestStorageObjectMarshallingMultiVector(t *testing.T) {
 	before := FromObjectMulti(
 		&models.Object{
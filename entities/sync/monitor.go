@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// HoldObserver is notified about how long a lock was held once it is
+// released
+type HoldObserver interface {
+	ObserveLockHold(lock string, d time.Duration)
+}
+
+// Holder describes a lock which is currently held
+type Holder struct {
+	Lock      string    `json:"lock"`
+	Key       string    `json:"key"`
+	Exclusive bool      `json:"exclusive"`
+	Since     time.Time `json:"since"`
+}
+
+type holdKey struct {
+	lock      string
+	key       string
+	exclusive bool
+}
+
+// LockMonitor keeps track of the locks which are currently held by the
+// lockers which report to it. A nil *LockMonitor is valid and does nothing,
+// so lockers without a monitor do not need to check for one.
+//
+// Shared locks do not know which of their holders releases them, so the
+// oldest shared hold of a key is considered released first.
+type LockMonitor struct {
+	sync.Mutex
+	held     map[holdKey][]time.Time
+	observer HoldObserver
+	now      func() time.Time
+}
+
+// NewLockMonitor creates a LockMonitor. The observer is optional.
+func NewLockMonitor(observer HoldObserver) *LockMonitor {
+	return &LockMonitor{
+		held:     map[holdKey][]time.Time{},
+		observer: observer,
+		now:      time.Now,
+	}
+}
+
+func (m *LockMonitor) acquired(lock, key string, exclusive bool) {
+	if m == nil {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	k := holdKey{lock: lock, key: key, exclusive: exclusive}
+	m.held[k] = append(m.held[k], m.now())
+}
+
+func (m *LockMonitor) released(lock, key string, exclusive bool) {
+	if m == nil {
+		return
+	}
+
+	m.Lock()
+	k := holdKey{lock: lock, key: key, exclusive: exclusive}
+	since := m.held[k]
+	if len(since) == 0 {
+		m.Unlock()
+		return
+	}
+	d := m.now().Sub(since[0])
+	if len(since) == 1 {
+		delete(m.held, k)
+	} else {
+		m.held[k] = since[1:]
+	}
+	m.Unlock()
+
+	if m.observer != nil {
+		m.observer.ObserveLockHold(lock, d)
+	}
+}
+
+// Acquired records that key of the named lock was locked. It is meant for
+// locks other than the lockers of this package, which report on their own.
+func (m *LockMonitor) Acquired(lock, key string, exclusive bool) {
+	m.acquired(lock, key, exclusive)
+}
+
+// Released records that key of the named lock was unlocked
+func (m *LockMonitor) Released(lock, key string, exclusive bool) {
+	m.released(lock, key, exclusive)
+}
+
+// Holders lists the locks which are currently held, longest held first
+func (m *LockMonitor) Holders() []Holder {
+	if m == nil {
+		return []Holder{}
+	}
+
+	m.Lock()
+	holders := make([]Holder, 0, len(m.held))
+	for k, since := range m.held {
+		for _, s := range since {
+			holders = append(holders, Holder{
+				Lock:      k.lock,
+				Key:       k.key,
+				Exclusive: k.exclusive,
+				Since:     s,
+			})
+		}
+	}
+	m.Unlock()
+
+	sort.Slice(holders, func(i, j int) bool {
+		return holders[i].Since.Before(holders[j].Since)
+	})
+	return holders
+}
+
+// Watch logs the locks which are held for longer than threshold, together
+// with the stacks of all goroutines, every interval until ctx is done. A
+// lock is only reported once per hold.
+func (m *LockMonitor) Watch(ctx context.Context, threshold, interval time.Duration,
+	logger logrus.FieldLogger,
+) {
+	reported := map[Holder]struct{}{}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		now := m.now()
+		holders := m.Holders()
+		current := make(map[Holder]struct{}, len(holders))
+		var stuck []Holder
+		for _, h := range holders {
+			current[h] = struct{}{}
+			if now.Sub(h.Since) < threshold {
+				continue
+			}
+			if _, ok := reported[h]; !ok {
+				stuck = append(stuck, h)
+			}
+		}
+		reported = current
+		if len(stuck) == 0 {
+			continue
+		}
+
+		var stacks bytes.Buffer
+		pprof.Lookup("goroutine").WriteTo(&stacks, 2)
+		for _, h := range stuck {
+			logger.WithField("action", "lock_watchdog").
+				WithField("lock", h.Lock).
+				WithField("key", h.Key).
+				WithField("exclusive", h.Exclusive).
+				WithField("held_for", now.Sub(h.Since).String()).
+				Warn("lock held beyond threshold")
+		}
+		logger.WithField("action", "lock_watchdog").
+			WithField("goroutines", stacks.String()).
+			Warn("goroutine stacks of locks held beyond threshold")
+	}
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHoldObserver struct {
+	holds map[string][]time.Duration
+}
+
+func (o *fakeHoldObserver) ObserveLockHold(lock string, d time.Duration) {
+	o.holds[lock] = append(o.holds[lock], d)
+}
+
+func newTestLockMonitor() (*LockMonitor, *fakeHoldObserver, *time.Time) {
+	observer := &fakeHoldObserver{holds: map[string][]time.Duration{}}
+	now := time.Unix(1000, 0)
+	m := NewLockMonitor(observer)
+	m.now = func() time.Time { return now }
+	return m, observer, &now
+}
+
+func TestLockMonitorKeyLocker(t *testing.T) {
+	m, observer, now := newTestLockMonitor()
+	s := NewKeyLocker().WithMonitor("class", m)
+
+	s.Lock("t1")
+	*now = now.Add(time.Second)
+	s.Lock("t2")
+
+	assert.Equal(t, []Holder{
+		{Lock: "class", Key: "t1", Exclusive: true, Since: time.Unix(1000, 0)},
+		{Lock: "class", Key: "t2", Exclusive: true, Since: time.Unix(1001, 0)},
+	}, m.Holders())
+
+	*now = now.Add(time.Second)
+	s.Unlock("t1")
+	s.Unlock("t2")
+
+	assert.Empty(t, m.Holders())
+	assert.Equal(t, []time.Duration{2 * time.Second, time.Second}, observer.holds["class"])
+}
+
+func TestLockMonitorKeyRWLocker(t *testing.T) {
+	m, observer, now := newTestLockMonitor()
+	s := NewKeyRWLocker().WithMonitor("shard", m)
+
+	s.RLock("t1")
+	*now = now.Add(time.Second)
+	s.RLock("t1")
+
+	require.Len(t, m.Holders(), 2)
+	assert.False(t, m.Holders()[0].Exclusive)
+
+	*now = now.Add(time.Second)
+	s.RUnlock("t1")
+	s.RUnlock("t1")
+	s.Lock("t1")
+	*now = now.Add(time.Second)
+	s.Unlock("t1")
+
+	assert.Empty(t, m.Holders())
+	assert.Equal(t, []time.Duration{2 * time.Second, time.Second, time.Second}, observer.holds["shard"])
+}
+
+func TestLockMonitorNil(t *testing.T) {
+	s := NewKeyLocker()
+	s.Lock("t1")
+	s.Unlock("t1")
+
+	var m *LockMonitor
+	assert.Empty(t, m.Holders())
+}
+
+func TestLockMonitorWatch(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	m := NewLockMonitor(nil)
+	s := NewKeyLocker().WithMonitor("class", m)
+
+	s.Lock("t1")
+	defer s.Unlock("t1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.Watch(ctx, time.Millisecond, time.Millisecond, logger)
+
+	require.Eventually(t, func() bool {
+		return len(hook.AllEntries()) >= 2
+	}, time.Second, time.Millisecond)
+	cancel()
+
+	entry := hook.AllEntries()[0]
+	assert.Equal(t, "lock held beyond threshold", entry.Message)
+	assert.Equal(t, "t1", entry.Data["key"])
+	assert.Contains(t, hook.AllEntries()[1].Data["goroutines"], "TestLockMonitorWatch")
+}
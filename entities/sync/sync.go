@@ -22,7 +22,9 @@ import (
 //	locker.Lock(id)
 //	defer locker.Unlock(id)
 type KeyLocker struct {
-	m sync.Map
+	m       sync.Map
+	name    string
+	monitor *LockMonitor
 }
 
 // NewKeyLocker creates Keylocker
@@ -32,6 +34,13 @@ func NewKeyLocker() *KeyLocker {
 	}
 }
 
+// WithMonitor reports the keys which are held to m under the given lock name
+func (s *KeyLocker) WithMonitor(name string, m *LockMonitor) *KeyLocker {
+	s.name = name
+	s.monitor = m
+	return s
+}
+
 // Lock it locks a specific bucket by it's ID
 // to hold ant concurrent access to that specific item
 //
@@ -42,12 +51,14 @@ func (s *KeyLocker) Lock(ID string) {
 
 	iLock = iLocks.(*sync.Mutex)
 	iLock.Lock()
+	s.monitor.acquired(s.name, ID, true)
 }
 
 // Unlock it unlocks a specific item by it's ID
 func (s *KeyLocker) Unlock(ID string) {
 	iLocks, _ := s.m.Load(ID)
 	iLock := iLocks.(*sync.Mutex)
+	s.monitor.released(s.name, ID, true)
 	iLock.Unlock()
 }
 
@@ -63,7 +74,9 @@ func (s *KeyLocker) Unlock(ID string) {
 //	locker.RLock(id)
 //	defer locker.RUnlock(id)
 type KeyRWLocker struct {
-	m sync.Map
+	m       sync.Map
+	name    string
+	monitor *LockMonitor
 }
 
 // NewKeyLocker creates Keylocker
@@ -73,6 +86,13 @@ func NewKeyRWLocker() *KeyRWLocker {
 	}
 }
 
+// WithMonitor reports the keys which are held to m under the given lock name
+func (s *KeyRWLocker) WithMonitor(name string, m *LockMonitor) *KeyRWLocker {
+	s.name = name
+	s.monitor = m
+	return s
+}
+
 // Lock it locks a specific bucket by it's ID
 // to hold ant concurrent access to that specific item
 //
@@ -83,12 +103,14 @@ func (s *KeyRWLocker) Lock(ID string) {
 
 	iLock = iLocks.(*sync.RWMutex)
 	iLock.Lock()
+	s.monitor.acquired(s.name, ID, true)
 }
 
 // Unlock it unlocks a specific item by it's ID
 func (s *KeyRWLocker) Unlock(ID string) {
 	iLocks, _ := s.m.Load(ID)
 	iLock := iLocks.(*sync.RWMutex)
+	s.monitor.released(s.name, ID, true)
 	iLock.Unlock()
 }
 
@@ -102,11 +124,13 @@ func (s *KeyRWLocker) RLock(ID string) {
 
 	iLock = iLocks.(*sync.RWMutex)
 	iLock.RLock()
+	s.monitor.acquired(s.name, ID, false)
 }
 
 // RUnlock it runlocks a specific item by it's ID
 func (s *KeyRWLocker) RUnlock(ID string) {
 	iLocks, _ := s.m.Load(ID)
 	iLock := iLocks.(*sync.RWMutex)
+	s.monitor.released(s.name, ID, false)
 	iLock.RUnlock()
 }
@@ -29,8 +29,25 @@ const (
 	// Set these defaults if the user leaves them blank
 	DefaultVectorCacheMaxObjects = 1e12
 	DefaultDistanceMetric        = DistanceCosine
+
+	// VectorCacheAdmissionNone caches every vector that is read, while
+	// VectorCacheAdmissionTinyLFU only admits vectors into a full cache if
+	// they are accessed more frequently than the ones they replace
+	VectorCacheAdmissionNone    = "none"
+	VectorCacheAdmissionTinyLFU = "tinylfu"
 )
 
+// ValidVectorCacheAdmission reports whether the admission policy is known.
+// An empty policy is treated as VectorCacheAdmissionNone.
+func ValidVectorCacheAdmission(policy string) bool {
+	switch policy {
+	case "", VectorCacheAdmissionNone, VectorCacheAdmissionTinyLFU:
+		return true
+	default:
+		return false
+	}
+}
+
 // Tries to parse the int value from the map, if it overflows math.MaxInt64, it
 // uses math.MaxInt64 instead. This is to protect from rounding errors from
 // json marshalling where the type may be assumed as float64
@@ -67,6 +84,33 @@ func OptionalIntFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+func OptionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	var asFloat float64
+	var err error
+
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat, err = typed.Float64()
+	case float64:
+		asFloat = typed
+	default:
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "json.Number to float64 for %q", name)
+	}
+
+	setFn(asFloat)
+	return nil
+}
+
 func OptionalBoolFromMap(in map[string]interface{}, name string,
 	setFn func(v bool),
 ) error {
@@ -100,3 +144,14 @@ func OptionalStringFromMap(in map[string]interface{}, name string,
 	setFn(asString)
 	return nil
 }
+
+// ValidateDimensionsUpdate allows declaring the dimensions of a vector index
+// which had none, as they are declared once the first vectors are imported.
+// Declared dimensions can't be changed.
+func ValidateDimensionsUpdate(initial, updated int) error {
+	if initial != 0 && initial != updated {
+		return errors.Errorf("dimensions is immutable: attempted change from \"%d\" to \"%d\"",
+			initial, updated)
+	}
+	return nil
+}
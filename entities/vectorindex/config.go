@@ -15,6 +15,7 @@ import (
 	"fmt"
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
@@ -25,6 +26,7 @@ const (
 	VectorIndexTypeHNSW    = "hnsw"
 	VectorIndexTypeFLAT    = "flat"
 	VectorIndexTypeDYNAMIC = "dynamic"
+	VectorIndexTypeDISKANN = "diskann"
 )
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -41,7 +43,62 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string) (schemaCo
 		return flat.ParseAndValidateConfig(input)
 	case VectorIndexTypeDYNAMIC:
 		return dynamic.ParseAndValidateConfig(input)
+	case VectorIndexTypeDISKANN:
+		return diskann.ParseAndValidateConfig(input)
 	default:
-		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
+		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw, flat, dynamic and diskann", vectorIndexType)
+	}
+}
+
+// DeclaredDimensions returns the vector dimensions declared in the given
+// config, or 0 if none were declared
+func DeclaredDimensions(cfg schemaConfig.VectorIndexConfig) int {
+	switch typed := cfg.(type) {
+	case hnsw.UserConfig:
+		return typed.Dimensions
+	case flat.UserConfig:
+		return typed.Dimensions
+	case dynamic.UserConfig:
+		return typed.Dimensions
+	case diskann.UserConfig:
+		return typed.Dimensions
+	default:
+		return 0
+	}
+}
+
+// NormalizeOnWrite returns true if vectors of the given config should be
+// L2-normalized before they are stored or searched with
+func NormalizeOnWrite(cfg schemaConfig.VectorIndexConfig) bool {
+	switch typed := cfg.(type) {
+	case hnsw.UserConfig:
+		return typed.NormalizeOnWrite
+	case flat.UserConfig:
+		return typed.NormalizeOnWrite
+	case dynamic.UserConfig:
+		return typed.NormalizeOnWrite
+	case diskann.UserConfig:
+		return typed.NormalizeOnWrite
+	default:
+		return false
+	}
+}
+
+// WithDimensions returns a copy of the config with the given vector
+// dimensions declared. Configs of index types which can't declare dimensions
+// are returned unchanged.
+func WithDimensions(cfg schemaConfig.VectorIndexConfig, dims int) schemaConfig.VectorIndexConfig {
+	switch typed := cfg.(type) {
+	case hnsw.UserConfig:
+		typed.Dimensions = dims
+		return typed
+	case flat.UserConfig:
+		typed.Dimensions = dims
+		return typed
+	case dynamic.UserConfig:
+		typed.Dimensions = dims
+		return typed
+	default:
+		return cfg
 	}
 }
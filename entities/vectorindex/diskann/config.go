@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"fmt"
+	"strings"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultMaxDegree             = 64
+	DefaultBuildSearchListSize   = 128
+	DefaultSearchListSize        = 256
+	DefaultAlpha                 = 1.2
+	DefaultFlatSearchCutoff      = 40000
+	DefaultVectorCacheMaxObjects = 1e12
+
+	// MinimumAlpha keeps the pruning rule from removing edges that the greedy
+	// search relies on, values below 1 would produce a disconnected graph
+	MinimumAlpha = 1.0
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of a diskann index. The graph and the full vectors live on disk, only the
+// binary quantized vectors are kept in memory.
+type UserConfig struct {
+	Distance              string  `json:"distance"`
+	MaxDegree             int     `json:"maxDegree"`
+	BuildSearchListSize   int     `json:"buildSearchListSize"`
+	SearchListSize        int     `json:"searchListSize"`
+	Alpha                 float64 `json:"alpha"`
+	FlatSearchCutoff      int     `json:"flatSearchCutoff"`
+	VectorCacheMaxObjects int     `json:"vectorCacheMaxObjects"`
+	Dimensions            int     `json:"dimensions,omitempty"`
+	NormalizeOnWrite      bool    `json:"normalizeOnWrite,omitempty"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "diskann"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorIndexCommon.DefaultDistanceMetric
+	u.MaxDegree = DefaultMaxDegree
+	u.BuildSearchListSize = DefaultBuildSearchListSize
+	u.SearchListSize = DefaultSearchListSize
+	u.Alpha = DefaultAlpha
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.VectorCacheMaxObjects = DefaultVectorCacheMaxObjects
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "maxDegree", func(v int) {
+		uc.MaxDegree = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "buildSearchListSize", func(v int) {
+		uc.BuildSearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "searchListSize", func(v int) {
+		uc.SearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "vectorCacheMaxObjects", func(v int) {
+		uc.VectorCacheMaxObjects = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "dimensions", func(v int) {
+		uc.Dimensions = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalBoolFromMap(asMap, "normalizeOnWrite", func(v bool) {
+		uc.NormalizeOnWrite = v
+	}); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	var errMsgs []string
+	if u.MaxDegree < 2 {
+		errMsgs = append(errMsgs, "maxDegree must be an integer with a minimum of 2")
+	}
+
+	if u.BuildSearchListSize < 1 {
+		errMsgs = append(errMsgs, "buildSearchListSize must be a positive integer")
+	}
+
+	if u.SearchListSize < 1 {
+		errMsgs = append(errMsgs, "searchListSize must be a positive integer")
+	}
+
+	if u.Alpha < MinimumAlpha {
+		errMsgs = append(errMsgs, fmt.Sprintf("alpha must be at least %v", MinimumAlpha))
+	}
+
+	if u.FlatSearchCutoff < 0 {
+		errMsgs = append(errMsgs, "flatSearchCutoff must be a non-negative integer")
+	}
+
+	if u.Dimensions < 0 {
+		errMsgs = append(errMsgs, "dimensions must be a non-negative integer")
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("invalid diskann config: %s",
+			strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
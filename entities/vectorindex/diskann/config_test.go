@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_DiskANNUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:              common.DefaultDistanceMetric,
+				MaxDegree:             DefaultMaxDegree,
+				BuildSearchListSize:   DefaultBuildSearchListSize,
+				SearchListSize:        DefaultSearchListSize,
+				Alpha:                 DefaultAlpha,
+				FlatSearchCutoff:      DefaultFlatSearchCutoff,
+				VectorCacheMaxObjects: DefaultVectorCacheMaxObjects,
+			},
+		},
+		{
+			name: "with all optional fields",
+			input: map[string]interface{}{
+				"distance":              "l2-squared",
+				"maxDegree":             float64(32),
+				"buildSearchListSize":   float64(64),
+				"searchListSize":        json.Number("50"),
+				"alpha":                 float64(1.5),
+				"flatSearchCutoff":      float64(1000),
+				"vectorCacheMaxObjects": float64(500),
+				"dimensions":            float64(128),
+				"normalizeOnWrite":      true,
+			},
+			expected: UserConfig{
+				Distance:              common.DistanceL2Squared,
+				MaxDegree:             32,
+				BuildSearchListSize:   64,
+				SearchListSize:        50,
+				Alpha:                 1.5,
+				FlatSearchCutoff:      1000,
+				VectorCacheMaxObjects: 500,
+				Dimensions:            128,
+				NormalizeOnWrite:      true,
+			},
+		},
+		{
+			name: "alpha as json number",
+			input: map[string]interface{}{
+				"alpha": json.Number("1.1"),
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Alpha = 1.1
+				return uc
+			}(),
+		},
+		{
+			name: "alpha below minimum",
+			input: map[string]interface{}{
+				"alpha": float64(0.9),
+			},
+			expectErr:    true,
+			expectErrMsg: "alpha must be at least 1",
+		},
+		{
+			name: "invalid maxDegree",
+			input: map[string]interface{}{
+				"maxDegree": float64(1),
+			},
+			expectErr:    true,
+			expectErrMsg: "maxDegree must be an integer with a minimum of 2",
+		},
+		{
+			name: "invalid searchListSize",
+			input: map[string]interface{}{
+				"searchListSize": float64(0),
+			},
+			expectErr:    true,
+			expectErrMsg: "searchListSize must be a positive integer",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
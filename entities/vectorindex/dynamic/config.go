@@ -29,6 +29,9 @@ type UserConfig struct {
 	Threshold uint64          `json:"threshold"`
 	HnswUC    hnsw.UserConfig `json:"hnsw"`
 	FlatUC    flat.UserConfig `json:"flat"`
+	// Dimensions and NormalizeOnWrite are shared by both underlying indexes
+	Dimensions       int  `json:"dimensions,omitempty"`
+	NormalizeOnWrite bool `json:"normalizeOnWrite,omitempty"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -82,6 +85,21 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := common.OptionalIntFromMap(asMap, "dimensions", func(v int) {
+		uc.Dimensions = v
+	}); err != nil {
+		return uc, err
+	}
+	if uc.Dimensions < 0 {
+		return uc, fmt.Errorf("invalid dynamic config: dimensions must be a non-negative integer")
+	}
+
+	if err := common.OptionalBoolFromMap(asMap, "normalizeOnWrite", func(v bool) {
+		uc.NormalizeOnWrite = v
+	}); err != nil {
+		return uc, err
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig)
@@ -38,6 +38,9 @@ type UserConfig struct {
 	PQ                    CompressionUserConfig `json:"pq"`
 	BQ                    CompressionUserConfig `json:"bq"`
 	SQ                    CompressionUserConfig `json:"sq"`
+	Dimensions            int                   `json:"dimensions,omitempty"`
+	NormalizeOnWrite      bool                  `json:"normalizeOnWrite,omitempty"`
+	VectorCacheAdmission  string                `json:"vectorCacheAdmission,omitempty"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -91,6 +94,30 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "dimensions", func(v int) {
+		uc.Dimensions = v
+	}); err != nil {
+		return uc, err
+	}
+	if uc.Dimensions < 0 {
+		return uc, fmt.Errorf("invalid flat config: dimensions must be a non-negative integer")
+	}
+
+	if err := vectorindexcommon.OptionalBoolFromMap(asMap, "normalizeOnWrite", func(v bool) {
+		uc.NormalizeOnWrite = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "vectorCacheAdmission", func(v string) {
+		uc.VectorCacheAdmission = v
+	}); err != nil {
+		return uc, err
+	}
+	if !vectorindexcommon.ValidVectorCacheAdmission(uc.VectorCacheAdmission) {
+		return uc, fmt.Errorf("invalid flat config: vectorCacheAdmission must be either 'none' or 'tinylfu'")
+	}
+
 	if err := parseCompression(asMap, &uc); err != nil {
 		return uc, err
 	}
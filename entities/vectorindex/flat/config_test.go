@@ -130,6 +130,44 @@ func Test_FlatUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "cannot enable multiple quantization methods at the same time",
 		},
+		{
+			name: "bq cache with tinylfu admission",
+			input: map[string]interface{}{
+				"vectorCacheAdmission": "tinylfu",
+				"bq": map[string]interface{}{
+					"enabled": true,
+					"cache":   true,
+				},
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DefaultDistanceMetric,
+				VectorCacheAdmission:  common.VectorCacheAdmissionTinyLFU,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      true,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        true,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+			},
+		},
+		{
+			name: "invalid vector cache admission",
+			input: map[string]interface{}{
+				"vectorCacheAdmission": "lru",
+			},
+			expectErr:    true,
+			expectErrMsg: "vectorCacheAdmission must be either 'none' or 'tinylfu'",
+		},
 	}
 
 	for _, test := range tests {
@@ -36,6 +36,13 @@ const (
 
 	DefaultFilterStrategy = FilterStrategySweeping
 
+	// PrecisionFloat32 is the default and keeps vectors as they are,
+	// PrecisionFloat16 stores half precision floats and PrecisionInt8 is a
+	// shorthand for scalar quantization (sq)
+	PrecisionFloat32 = "float32"
+	PrecisionFloat16 = "float16"
+	PrecisionInt8    = "int8"
+
 	// Fail validation if those criteria are not met
 	MinmumMaxConnections = 4
 	MinmumEFConstruction = 4
@@ -58,6 +65,11 @@ type UserConfig struct {
 	BQ                     BQConfig `json:"bq"`
 	SQ                     SQConfig `json:"sq"`
 	FilterStrategy         string   `json:"filterStrategy"`
+	Dimensions             int      `json:"dimensions,omitempty"`
+	NormalizeOnWrite       bool     `json:"normalizeOnWrite,omitempty"`
+	Precision              string   `json:"precision,omitempty"`
+	VectorCacheAdmission   string   `json:"vectorCacheAdmission,omitempty"`
+	AutoEF                 bool     `json:"autoEf,omitempty"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -204,6 +216,39 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalIntFromMap(asMap, "dimensions", func(v int) {
+		uc.Dimensions = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalBoolFromMap(asMap, "normalizeOnWrite", func(v bool) {
+		uc.NormalizeOnWrite = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "precision", func(v string) {
+		uc.Precision = v
+	}); err != nil {
+		return uc, err
+	}
+	if uc.Precision == PrecisionInt8 {
+		uc.SQ.Enabled = true
+	}
+
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "vectorCacheAdmission", func(v string) {
+		uc.VectorCacheAdmission = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorIndexCommon.OptionalBoolFromMap(asMap, "autoEf", func(v bool) {
+		uc.AutoEF = v
+	}); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -227,6 +272,20 @@ func (u *UserConfig) validate() error {
 		errMsgs = append(errMsgs, "filterStrategy must be either 'sweeping' or 'acorn'")
 	}
 
+	if u.Dimensions < 0 {
+		errMsgs = append(errMsgs, "dimensions must be a non-negative integer")
+	}
+
+	switch u.Precision {
+	case "", PrecisionFloat32, PrecisionFloat16, PrecisionInt8:
+	default:
+		errMsgs = append(errMsgs, "precision must be one of 'float32', 'float16' or 'int8'")
+	}
+
+	if !vectorIndexCommon.ValidVectorCacheAdmission(u.VectorCacheAdmission) {
+		errMsgs = append(errMsgs, "vectorCacheAdmission must be either 'none' or 'tinylfu'")
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -245,6 +304,9 @@ func (u *UserConfig) validate() error {
 	if enabled > 1 {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
+	if enabled > 0 && u.Precision == PrecisionFloat16 {
+		return fmt.Errorf("invalid hnsw config: float16 precision cannot be combined with compression")
+	}
 
 	return nil
 }
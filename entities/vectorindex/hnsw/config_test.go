@@ -657,6 +657,97 @@ func Test_UserConfig(t *testing.T) {
 				FilterStrategy: FilterStrategyAcorn,
 			},
 		},
+		{
+			name: "with declared dimensions",
+			input: map[string]interface{}{
+				"dimensions": json.Number("384"),
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Dimensions = 384
+				return uc
+			}(),
+		},
+		{
+			name: "with normalize on write",
+			input: map[string]interface{}{
+				"normalizeOnWrite": true,
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.NormalizeOnWrite = true
+				return uc
+			}(),
+		},
+		{
+			name: "with int8 precision",
+			input: map[string]interface{}{
+				"precision": "int8",
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Precision = PrecisionInt8
+				uc.SQ.Enabled = true
+				return uc
+			}(),
+		},
+		{
+			name: "float16 precision with compression",
+			input: map[string]interface{}{
+				"precision": "float16",
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "float16 precision cannot be combined with compression",
+		},
+		{
+			name: "invalid precision",
+			input: map[string]interface{}{
+				"precision": "float64",
+			},
+			expectErr:    true,
+			expectErrMsg: "precision must be one of",
+		},
+		{
+			name: "invalid dimensions",
+			input: map[string]interface{}{
+				"dimensions": json.Number("-1"),
+			},
+			expectErr:    true,
+			expectErrMsg: "dimensions must be a non-negative integer",
+		},
+		{
+			name: "with tinylfu vector cache admission",
+			input: map[string]interface{}{
+				"vectorCacheAdmission": "tinylfu",
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.VectorCacheAdmission = common.VectorCacheAdmissionTinyLFU
+				return uc
+			}(),
+		},
+		{
+			name: "with autoEf",
+			input: map[string]interface{}{
+				"autoEf": true,
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.AutoEF = true
+				return uc
+			}(),
+		},
+		{
+			name: "invalid vector cache admission",
+			input: map[string]interface{}{
+				"vectorCacheAdmission": "lru",
+			},
+			expectErr:    true,
+			expectErrMsg: "vectorCacheAdmission must be either 'none' or 'tinylfu'",
+		},
 	}
 
 	for _, test := range tests {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
 	"github.com/pkg/errors"
@@ -95,6 +96,22 @@ func newClient(ctx context.Context, config *clientConfig, dataPath string) (*azu
 		},
 	}
 
+	// An Azure AD identity, be it a service principal from the environment,
+	// a federated workload identity or a user-assigned managed identity, is
+	// always announced through AZURE_CLIENT_ID. Without it access stays
+	// anonymous as before.
+	if os.Getenv("AZURE_CLIENT_ID") != "" {
+		cred, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "create default azure credential")
+		}
+		client, err := azblob.NewClient(serviceURL, cred, options)
+		if err != nil {
+			return nil, err
+		}
+		return &azureClient{client, *config, serviceURL, dataPath}, nil
+	}
+
 	client, err := azblob.NewClientWithNoCredential(serviceURL, options)
 	if err != nil {
 		return nil, err
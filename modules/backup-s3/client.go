@@ -59,9 +59,10 @@ func newClient(config *clientConfig, logger logrus.FieldLogger, dataPath, bucket
 	}
 
 	client, err := minio.New(config.Endpoint, &minio.Options{
-		Creds:  creds,
-		Region: region,
-		Secure: config.UseSSL,
+		Creds:        creds,
+		Region:       region,
+		Secure:       config.UseSSL,
+		BucketLookup: config.bucketLookup(),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "create client")
@@ -75,9 +76,10 @@ func (s *s3Client) getClient(ctx context.Context) (*minio.Client, error) {
 	xAwsSessionToken := modulecomponents.GetValueFromContext(ctx, "X-AWS-SESSION-TOKEN")
 	if xAwsAccessKey != "" && xAwsSecretKey != "" && xAwsSessionToken != "" {
 		return minio.New(s.config.Endpoint, &minio.Options{
-			Creds:  credentials.NewStaticV4(xAwsAccessKey, xAwsSecretKey, xAwsSessionToken),
-			Region: s.region,
-			Secure: s.config.UseSSL,
+			Creds:        credentials.NewStaticV4(xAwsAccessKey, xAwsSecretKey, xAwsSessionToken),
+			Region:       s.region,
+			Secure:       s.config.UseSSL,
+			BucketLookup: s.config.bucketLookup(),
 		})
 	}
 	return s.client, nil
@@ -11,21 +11,33 @@
 
 package modstgs3
 
+import "github.com/minio/minio-go/v7"
+
 type clientConfig struct {
 	Endpoint string
 	Bucket   string
 	UseSSL   bool
 
+	// use path-style instead of virtual-hosted bucket addressing
+	ForcePathStyle bool
+
 	// this is an optional value, allowing for
 	// the backup to be stored in a specific
 	// directory inside the provided bucket
 	BackupPath string
 }
 
-func newConfig(endpoint, bucket, path string, useSSL bool) *clientConfig {
+func newConfig(endpoint, bucket, path string, useSSL, forcePathStyle bool) *clientConfig {
 	const DEFAULT_ENDPOINT = "s3.amazonaws.com"
 	if endpoint == "" {
 		endpoint = DEFAULT_ENDPOINT
 	}
-	return &clientConfig{endpoint, bucket, useSSL, path}
+	return &clientConfig{endpoint, bucket, useSSL, forcePathStyle, path}
+}
+
+func (c *clientConfig) bucketLookup() minio.BucketLookupType {
+	if c.ForcePathStyle {
+		return minio.BucketLookupPath
+	}
+	return minio.BucketLookupAuto
 }
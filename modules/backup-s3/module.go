@@ -30,6 +30,11 @@ const (
 	s3Bucket   = "BACKUP_S3_BUCKET"
 	s3UseSSL   = "BACKUP_S3_USE_SSL"
 
+	// S3-compatible stores such as MinIO are often reached through a
+	// plain host name or IP, where virtual-hosted bucket addressing
+	// cannot work. Setting this forces path-style requests.
+	s3ForcePathStyle = "BACKUP_S3_FORCE_PATH_STYLE"
+
 	// this is an optional value, allowing for
 	// the backup to be stored in a specific
 	// directory inside the provided bucket.
@@ -80,7 +85,8 @@ func (m *Module) Init(ctx context.Context,
 	}
 	// SSL on by default
 	useSSL := strings.ToLower(os.Getenv(s3UseSSL)) != "false"
-	config := newConfig(os.Getenv(s3Endpoint), bucket, os.Getenv(s3Path), useSSL)
+	forcePathStyle := strings.ToLower(os.Getenv(s3ForcePathStyle)) == "true"
+	config := newConfig(os.Getenv(s3Endpoint), bucket, os.Getenv(s3Path), useSSL, forcePathStyle)
 	client, err := newClient(config, m.logger, m.dataPath, m.bucket, m.path)
 	if err != nil {
 		return errors.Wrap(err, "initialize S3 backup module")
@@ -95,13 +101,14 @@ func (m *Module) RootHandler() http.Handler {
 }
 
 func (m *Module) MetaInfo() (map[string]interface{}, error) {
-	metaInfo := make(map[string]interface{}, 4)
+	metaInfo := make(map[string]interface{}, 5)
 	metaInfo["endpoint"] = m.config.Endpoint
 	metaInfo["bucketName"] = m.config.Bucket
 	if root := m.config.BackupPath; root != "" {
 		metaInfo["rootName"] = root
 	}
 	metaInfo["useSSL"] = m.config.UseSSL
+	metaInfo["forcePathStyle"] = m.config.ForcePathStyle
 	return metaInfo, nil
 }
 
@@ -68,7 +68,7 @@ func (a *anthropic) GenerateAllResults(ctx context.Context, textProperties []map
 }
 
 func (a *anthropic) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
-	params := a.getParameters(cfg, options)
+	params := a.getParameters(ctx, cfg, options)
 	debugInformation := a.getDebugInformation(debug, prompt)
 
 	anthropicURL, err := a.getAnthropicURL(ctx, params.BaseURL)
@@ -141,7 +141,7 @@ func (a *anthropic) Generate(ctx context.Context, cfg moduletools.ClassConfig, p
 	}, nil
 }
 
-func (a *anthropic) getParameters(cfg moduletools.ClassConfig, options interface{}) anthropicparams.Params {
+func (a *anthropic) getParameters(ctx context.Context, cfg moduletools.ClassConfig, options interface{}) anthropicparams.Params {
 	settings := config.NewClassSettings(cfg)
 
 	var params anthropicparams.Params
@@ -152,6 +152,10 @@ func (a *anthropic) getParameters(cfg moduletools.ClassConfig, options interface
 	if params.BaseURL == "" {
 		params.BaseURL = settings.BaseURL()
 	}
+	if params.Model == "" {
+		// a model passed in the request header wins over the class settings
+		params.Model = modulecomponents.GetValueFromContext(ctx, "X-Anthropic-Model")
+	}
 	if params.Model == "" {
 		params.Model = settings.Model()
 	}
@@ -24,6 +24,8 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/generative-anthropic/config"
+	anthropicparams "github.com/weaviate/weaviate/modules/generative-anthropic/parameters"
 )
 
 func nullLogger() logrus.FieldLogger {
@@ -100,6 +102,23 @@ func TestGenerate(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "http://default-url.com/v1/messages", buildURL)
 	})
+
+	t.Run("when X-Anthropic-Model header is passed", func(t *testing.T) {
+		a := New("apiKey", 5*time.Second, nullLogger())
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Anthropic-Model", []string{"claude-3-haiku-20240307"})
+
+		params := a.getParameters(ctxWithValue, nil, nil)
+		assert.Equal(t, "claude-3-haiku-20240307", params.Model)
+
+		params = a.getParameters(context.TODO(), nil, nil)
+		assert.Equal(t, config.DefaultAnthropicModel, params.Model)
+
+		// a model set in the query wins over the header
+		params = a.getParameters(ctxWithValue, nil, anthropicparams.Params{Model: "claude-3-opus-20240229"})
+		assert.Equal(t, "claude-3-opus-20240229", params.Model)
+	})
 }
 
 type testAnthropicHandler struct {
@@ -68,7 +68,7 @@ func (v *cohere) GenerateAllResults(ctx context.Context, textProperties []map[st
 }
 
 func (v *cohere) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
-	params := v.getParameters(cfg, options)
+	params := v.getParameters(ctx, cfg, options)
 	debugInformation := v.getDebugInformation(debug, prompt)
 
 	cohereUrl, err := v.getCohereUrl(ctx, params.BaseURL)
@@ -137,7 +137,7 @@ func (v *cohere) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}, nil
 }
 
-func (v *cohere) getParameters(cfg moduletools.ClassConfig, options interface{}) cohereparams.Params {
+func (v *cohere) getParameters(ctx context.Context, cfg moduletools.ClassConfig, options interface{}) cohereparams.Params {
 	settings := config.NewClassSettings(cfg)
 
 	var params cohereparams.Params
@@ -148,6 +148,10 @@ func (v *cohere) getParameters(cfg moduletools.ClassConfig, options interface{})
 		baseURL := settings.BaseURL()
 		params.BaseURL = baseURL
 	}
+	if params.Model == "" {
+		// a model passed in the request header wins over the class settings
+		params.Model = modulecomponents.GetValueFromContext(ctx, "X-Cohere-Model")
+	}
 	if params.Model == "" {
 		model := settings.Model()
 		params.Model = model
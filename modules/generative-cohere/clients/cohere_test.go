@@ -24,6 +24,8 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/generative-cohere/config"
+	cohereparams "github.com/weaviate/weaviate/modules/generative-cohere/parameters"
 )
 
 func nullLogger() logrus.FieldLogger {
@@ -97,6 +99,23 @@ func TestGetAnswer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "http://default-url.com/v1/chat", buildURL)
 	})
+
+	t.Run("when X-Cohere-Model header is passed", func(t *testing.T) {
+		c := New("apiKey", 5*time.Second, nullLogger())
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Cohere-Model", []string{"command-r"})
+
+		params := c.getParameters(ctxWithValue, nil, nil)
+		assert.Equal(t, "command-r", params.Model)
+
+		params = c.getParameters(context.TODO(), nil, nil)
+		assert.Equal(t, config.DefaultCohereModel, params.Model)
+
+		// a model set in the query wins over the header
+		params = c.getParameters(ctxWithValue, nil, cohereparams.Params{Model: "command-r-plus"})
+		assert.Equal(t, "command-r-plus", params.Model)
+	})
 }
 
 type testAnswerHandler struct {
@@ -68,7 +68,7 @@ func (v *mistral) GenerateAllResults(ctx context.Context, textProperties []map[s
 }
 
 func (v *mistral) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
-	params := v.getParameters(cfg, options)
+	params := v.getParameters(ctx, cfg, options)
 	debugInformation := v.getDebugInformation(debug, prompt)
 
 	mistralUrl, err := v.getMistralUrl(ctx, params.BaseURL)
@@ -154,7 +154,7 @@ func GetResponseParams(result map[string]interface{}) *responseParams {
 	return nil
 }
 
-func (v *mistral) getParameters(cfg moduletools.ClassConfig, options interface{}) mistralparams.Params {
+func (v *mistral) getParameters(ctx context.Context, cfg moduletools.ClassConfig, options interface{}) mistralparams.Params {
 	settings := config.NewClassSettings(cfg)
 
 	var params mistralparams.Params
@@ -164,6 +164,10 @@ func (v *mistral) getParameters(cfg moduletools.ClassConfig, options interface{}
 	if params.BaseURL == "" {
 		params.BaseURL = settings.BaseURL()
 	}
+	if params.Model == "" {
+		// a model passed in the request header wins over the class settings
+		params.Model = v.getValueFromContext(ctx, "X-Mistral-Model")
+	}
 	if params.Model == "" {
 		model := settings.Model()
 		params.Model = model
@@ -24,6 +24,8 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/generative-mistral/config"
+	mistralparams "github.com/weaviate/weaviate/modules/generative-mistral/parameters"
 )
 
 func nullLogger() logrus.FieldLogger {
@@ -105,6 +107,23 @@ func TestGetAnswer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "http://default-url.com/v1/chat/completions", buildURL)
 	})
+
+	t.Run("when X-Mistral-Model header is passed", func(t *testing.T) {
+		c := New("apiKey", 5*time.Second, nullLogger())
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Mistral-Model", []string{"mistral-small-latest"})
+
+		params := c.getParameters(ctxWithValue, nil, nil)
+		assert.Equal(t, "mistral-small-latest", params.Model)
+
+		params = c.getParameters(context.TODO(), nil, nil)
+		assert.Equal(t, config.DefaultMistralModel, params.Model)
+
+		// a model set in the query wins over the header
+		params = c.getParameters(ctxWithValue, nil, mistralparams.Params{Model: "mistral-large-latest"})
+		assert.Equal(t, "mistral-large-latest", params.Model)
+	})
 }
 
 type testAnswerHandler struct {
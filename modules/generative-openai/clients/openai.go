@@ -93,7 +93,7 @@ func (v *openai) GenerateAllResults(ctx context.Context, textProperties []map[st
 }
 
 func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
-	params := v.getParameters(cfg, options)
+	params := v.getParameters(ctx, cfg, options)
 	isAzure := config.IsAzure(params.IsAzure, params.ResourceName, params.DeploymentID)
 	debugInformation := v.getDebugInformation(debug, prompt)
 
@@ -118,10 +118,15 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 		return nil, errors.Wrap(err, "create POST request")
 	}
 	apiKey, err := v.getApiKey(ctx, isAzure)
-	if err != nil {
+	if err != nil && !config.IsThirdPartyProvider(v.getBaseURL(ctx, params), isAzure, params.ResourceName, params.DeploymentID) {
 		return nil, errors.Wrapf(err, "OpenAI API Key")
 	}
-	req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, isAzure))
+	// OpenAI-compatible endpoints, e.g. a local inference server in an
+	// air-gapped deployment, are called without authentication if no key
+	// is configured
+	if apiKey != "" {
+		req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, isAzure))
+	}
 	if openAIOrganization := v.getOpenAIOrganization(ctx); openAIOrganization != "" {
 		req.Header.Add("OpenAI-Organization", openAIOrganization)
 	}
@@ -176,7 +181,7 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}, nil
 }
 
-func (v *openai) getParameters(cfg moduletools.ClassConfig, options interface{}) openaiparams.Params {
+func (v *openai) getParameters(ctx context.Context, cfg moduletools.ClassConfig, options interface{}) openaiparams.Params {
 	settings := config.NewClassSettings(cfg)
 
 	var params openaiparams.Params
@@ -199,6 +204,10 @@ func (v *openai) getParameters(cfg moduletools.ClassConfig, options interface{})
 	if !params.IsAzure {
 		params.IsAzure = settings.IsAzure()
 	}
+	if params.Model == "" {
+		// a model passed in the request header wins over the class settings
+		params.Model = v.getValueFromContext(ctx, "X-Openai-Model")
+	}
 	if params.Model == "" {
 		params.Model = settings.Model()
 	}
@@ -251,15 +260,11 @@ func GetResponseParams(result map[string]interface{}) *responseParams {
 }
 
 func (v *openai) buildOpenAIUrl(ctx context.Context, params openaiparams.Params) (string, error) {
-	baseURL := params.BaseURL
+	baseURL := v.getBaseURL(ctx, params)
 
 	deploymentID := params.DeploymentID
 	resourceName := params.ResourceName
 
-	if headerBaseURL := v.getValueFromContext(ctx, "X-Openai-Baseurl"); headerBaseURL != "" {
-		baseURL = headerBaseURL
-	}
-
 	if headerDeploymentID := v.getValueFromContext(ctx, "X-Azure-Deployment-Id"); headerDeploymentID != "" {
 		deploymentID = headerDeploymentID
 	}
@@ -274,6 +279,13 @@ func (v *openai) buildOpenAIUrl(ctx context.Context, params openaiparams.Params)
 	return v.buildUrl(isLegacy, isAzure, resourceName, deploymentID, baseURL, params.ApiVersion)
 }
 
+func (v *openai) getBaseURL(ctx context.Context, params openaiparams.Params) string {
+	if headerBaseURL := v.getValueFromContext(ctx, "X-Openai-Baseurl"); headerBaseURL != "" {
+		return headerBaseURL
+	}
+	return params.BaseURL
+}
+
 func (v *openai) generateInput(prompt string, params openaiparams.Params) (generateInput, error) {
 	if config.IsLegacy(params.Model) {
 		return generateInput{
@@ -294,17 +306,18 @@ func (v *openai) generateInput(prompt string, params openaiparams.Params) (gener
 			Content: prompt,
 		}}
 
-		var tokens *int
-		var err error
+		tokens := params.MaxTokens
 		if config.IsThirdPartyProvider(params.BaseURL, params.IsAzure, params.ResourceName, params.DeploymentID) {
-			tokens, err = v.determineTokens(config.GetMaxTokensForModel(params.Model), *params.MaxTokens, params.Model, messages)
-		} else {
-			tokens = params.MaxTokens
+			// the encodings are not known for models served by OpenAI-compatible
+			// endpoints and cannot be downloaded in air-gapped deployments, in
+			// which case the configured max tokens are used as they are
+			if determined, err := v.determineTokens(config.GetMaxTokensForModel(params.Model), *params.MaxTokens, params.Model, messages); err != nil {
+				v.logger.WithField("model", params.Model).WithError(err).Debug("determine tokens count")
+			} else {
+				tokens = determined
+			}
 		}
 
-		if err != nil {
-			return input, errors.Wrap(err, "determine tokens count")
-		}
 		input = generateInput{
 			Messages:         messages,
 			Stream:           false,
@@ -145,6 +145,33 @@ func TestGetAnswer(t *testing.T) {
 		assert.Error(t, err, "connection to OpenAI failed with status: 500 request-id: some-request-id error: some error from the server")
 	})
 
+	t.Run("when no key is set and an OpenAI-compatible endpoint is used", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Choices: []choice{{
+					FinishReason: "test",
+					Index:        0,
+					Text:         "John",
+				}},
+			},
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("Authorization"))
+			handler.ServeHTTP(w, r)
+		}))
+		defer server.Close()
+
+		c := New("", "", "", 0, nullLogger())
+
+		// the encoding of a local model is unknown, so the tokens can't be counted
+		params := openaiparams.Params{BaseURL: server.URL, Model: "llama3"}
+		res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", params, false, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, "John", *res.Result)
+	})
+
 	t.Run("when X-OpenAI-BaseURL header is passed", func(t *testing.T) {
 		params := openaiparams.Params{
 			BaseURL: "http://default-url.com",
@@ -163,6 +190,23 @@ func TestGetAnswer(t *testing.T) {
 		assert.Equal(t, "http://default-url.com/v1/chat/completions", buildURL)
 	})
 
+	t.Run("when X-Openai-Model header is passed", func(t *testing.T) {
+		c := New("openAIApiKey", "", "", 0, nullLogger())
+
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Openai-Model", []string{"gpt-4o-mini"})
+
+		params := c.getParameters(ctxWithValue, nil, nil)
+		assert.Equal(t, "gpt-4o-mini", params.Model)
+
+		params = c.getParameters(context.TODO(), nil, nil)
+		assert.Equal(t, config.DefaultOpenAIModel, params.Model)
+
+		// a model set in the query wins over the header
+		params = c.getParameters(ctxWithValue, nil, openaiparams.Params{Model: "gpt-4o"})
+		assert.Equal(t, "gpt-4o", params.Model)
+	})
+
 	t.Run("when X-Azure-DeploymentId is passed", func(t *testing.T) {
 		params := openaiparams.Params{
 			IsAzure:      true,
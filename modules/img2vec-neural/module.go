@@ -39,6 +39,11 @@ type ImageModule struct {
 	graphqlProvider modulecapabilities.GraphQLArguments
 	searcher        modulecapabilities.Searcher[[]float32]
 	logger          logrus.FieldLogger
+	readyChecker    readyChecker
+}
+
+type readyChecker interface {
+	CheckReady(ctx context.Context) error
 }
 
 type imageVectorizer interface {
@@ -85,6 +90,7 @@ func (m *ImageModule) initVectorizer(ctx context.Context, timeout time.Duration,
 	}
 
 	m.vectorizer = vectorizer.New(client)
+	m.readyChecker = client
 
 	return nil
 }
@@ -114,8 +120,13 @@ func (m *ImageModule) MetaInfo() (map[string]interface{}, error) {
 	return map[string]interface{}{}, nil
 }
 
+func (m *ImageModule) CheckHealth(ctx context.Context) error {
+	return m.readyChecker.CheckReady(ctx)
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.Vectorizer[[]float32](New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -58,6 +58,7 @@ type BindModule struct {
 
 type metaClient interface {
 	MetaInfo() (map[string]interface{}, error)
+	CheckReady(ctx context.Context) error
 }
 
 type bindVectorizer interface {
@@ -179,6 +180,10 @@ func (m *BindModule) MetaInfo() (map[string]interface{}, error) {
 	return m.metaClient.MetaInfo()
 }
 
+func (m *BindModule) CheckHealth(ctx context.Context) error {
+	return m.metaClient.CheckReady(ctx)
+}
+
 func (m *BindModule) VectorizeBatch(ctx context.Context, objs []*models.Object, skipObject []bool, cfg moduletools.ClassConfig) ([][]float32, []models.AdditionalProperties, map[int]error) {
 	return batch.VectorizeBatch(ctx, objs, skipObject, cfg, m.logger, m.bindVectorizer.Object)
 }
@@ -194,4 +199,5 @@ var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.Vectorizer[[]float32](New())
 	_ = modulecapabilities.InputVectorizer[[]float32](New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -48,6 +48,7 @@ type ClipModule struct {
 
 type metaClient interface {
 	MetaInfo() (map[string]interface{}, error)
+	CheckReady(ctx context.Context) error
 }
 
 type imageVectorizer interface {
@@ -141,6 +142,10 @@ func (m *ClipModule) MetaInfo() (map[string]interface{}, error) {
 	return m.metaClient.MetaInfo()
 }
 
+func (m *ClipModule) CheckHealth(ctx context.Context) error {
+	return m.metaClient.CheckReady(ctx)
+}
+
 func (m *ClipModule) VectorizeInput(ctx context.Context,
 	input string, cfg moduletools.ClassConfig,
 ) ([]float32, error) {
@@ -158,4 +163,5 @@ var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.Vectorizer[[]float32](New())
 	_ = modulecapabilities.InputVectorizer[[]float32](New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -41,6 +41,7 @@ type NERModule struct {
 type nerClient interface {
 	GetTokens(ctx context.Context, property, text string) ([]ent.TokenResult, error)
 	MetaInfo() (map[string]interface{}, error)
+	CheckReady(ctx context.Context) error
 }
 
 func (m *NERModule) Name() string {
@@ -90,6 +91,10 @@ func (m *NERModule) MetaInfo() (map[string]interface{}, error) {
 	return m.ner.MetaInfo()
 }
 
+func (m *NERModule) CheckHealth(ctx context.Context) error {
+	return m.ner.CheckReady(ctx)
+}
+
 func (m *NERModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
@@ -99,4 +104,5 @@ var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -48,6 +48,7 @@ type qnaClient interface {
 	Answer(ctx context.Context,
 		text, question string) (*ent.AnswerResult, error)
 	MetaInfo() (map[string]interface{}, error)
+	CheckReady(ctx context.Context) error
 }
 
 func (m *QnAModule) Name() string {
@@ -162,6 +163,10 @@ func (m *QnAModule) MetaInfo() (map[string]interface{}, error) {
 	return m.qna.MetaInfo()
 }
 
+func (m *QnAModule) CheckHealth(ctx context.Context) error {
+	return m.qna.CheckReady(ctx)
+}
+
 func (m *QnAModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
@@ -171,4 +176,5 @@ var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -0,0 +1,262 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+
+	"github.com/weaviate/weaviate/usecases/modulecomponents"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/reranker-huggingface/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+)
+
+var _NUMCPU = runtime.NumCPU()
+
+type client struct {
+	lock         sync.RWMutex
+	apiKey       string
+	path         string
+	httpClient   *http.Client
+	maxDocuments int
+	// retryBackoff is the wait before the first retry, it doubles with every
+	// further retry unless the endpoint tells us how long to wait
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	logger       logrus.FieldLogger
+}
+
+func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *client {
+	return &client{
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: timeout},
+		path:       "/rerank",
+		// Text Embeddings Inference accepts 32 texts per request by default
+		maxDocuments: 32,
+		retryBackoff: time.Second,
+		maxBackoff:   30 * time.Second,
+		logger:       logger,
+	}
+}
+
+func (c *client) Rank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
+) (*ent.RankResult, error) {
+	eg := enterrors.NewErrorGroupWrapper(c.logger)
+	eg.SetLimit(_NUMCPU)
+
+	chunkedDocuments := c.chunkDocuments(documents, c.maxDocuments)
+	documentScoreResponses := make([][]ent.DocumentScore, len(chunkedDocuments))
+	for i := range chunkedDocuments {
+		i := i // https://golang.org/doc/faq#closures_and_goroutines
+		eg.Go(func() error {
+			documentScoreResponse, err := c.performRank(ctx, query, chunkedDocuments[i], cfg)
+			if err != nil {
+				return err
+			}
+			c.lockGuard(func() {
+				documentScoreResponses[i] = documentScoreResponse
+			})
+			return nil
+		}, chunkedDocuments[i])
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return c.toRankResult(query, documentScoreResponses), nil
+}
+
+func (c *client) lockGuard(mutate func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	mutate()
+}
+
+func (c *client) performRank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
+) ([]ent.DocumentScore, error) {
+	settings := config.NewClassSettings(cfg)
+	rankUrl, err := url.JoinPath(settings.EndpointURL(), c.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "join Hugging Face endpoint and path")
+	}
+
+	body, err := json.Marshal(RankInput{
+		Query:      query,
+		Texts:      documents,
+		RawScores:  false,
+		ReturnText: false,
+		Truncate:   true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	for attempt := 0; ; attempt++ {
+		statusCode, header, bodyBytes, err := c.send(ctx, rankUrl, body)
+		if err != nil {
+			return nil, err
+		}
+
+		if statusCode == http.StatusOK {
+			var results []Result
+			if err := json.Unmarshal(bodyBytes, &results); err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("unmarshal response body. Got: %v", string(bodyBytes)))
+			}
+			return c.toDocumentScores(documents, results)
+		}
+
+		var apiError huggingFaceApiError
+		// the body is not necessarily json, e.g. for errors of a proxy
+		_ = json.Unmarshal(bodyBytes, &apiError)
+
+		retryable := statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+		if !retryable || attempt >= settings.MaxRetries() {
+			if apiError.Error != "" {
+				return nil, errors.Errorf("connection to Hugging Face API failed with status %d: %s", statusCode, apiError.Error)
+			}
+			return nil, errors.Errorf("connection to Hugging Face API failed with status %d", statusCode)
+		}
+
+		wait := c.backoff(attempt, header, apiError)
+		c.logger.WithField("action", "rerank").
+			WithField("status", statusCode).
+			WithField("wait", wait).
+			Debug("Hugging Face endpoint is busy, retrying")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (c *client) send(ctx context.Context, rankUrl string, body []byte) (int, http.Header, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", rankUrl, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, nil, errors.Wrap(err, "create POST request")
+	}
+	// the key is optional, Inference Endpoints can also be public
+	if apiKey := c.getApiKey(ctx); apiKey != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, nil, nil, errors.Wrap(err, "read response body")
+	}
+	return res.StatusCode, res.Header, bodyBytes, nil
+}
+
+// backoff returns how long to wait before the next attempt. The Retry-After
+// header of a rate limited request and the estimated time until a model is
+// loaded take precedence over the exponential backoff.
+func (c *client) backoff(attempt int, header http.Header, apiError huggingFaceApiError) time.Duration {
+	wait := c.retryBackoff << attempt
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds >= 0 {
+		wait = time.Duration(seconds) * time.Second
+	} else if apiError.EstimatedTime != nil && *apiError.EstimatedTime > 0 {
+		wait = time.Duration(*apiError.EstimatedTime * float32(time.Second))
+	}
+	if wait > c.maxBackoff {
+		return c.maxBackoff
+	}
+	return wait
+}
+
+func (c *client) chunkDocuments(documents []string, chunkSize int) [][]string {
+	var requests [][]string
+	for i := 0; i < len(documents); i += chunkSize {
+		end := i + chunkSize
+
+		if end > len(documents) {
+			end = len(documents)
+		}
+
+		requests = append(requests, documents[i:end])
+	}
+
+	return requests
+}
+
+func (c *client) toDocumentScores(documents []string, results []Result) ([]ent.DocumentScore, error) {
+	documentScores := make([]ent.DocumentScore, len(documents))
+	for _, result := range results {
+		if result.Index < 0 || result.Index >= len(documents) {
+			return nil, errors.Errorf("Hugging Face API returned a score for unknown document %d", result.Index)
+		}
+		documentScores[result.Index] = ent.DocumentScore{
+			Document: documents[result.Index],
+			Score:    result.Score,
+		}
+	}
+	return documentScores, nil
+}
+
+func (c *client) toRankResult(query string, results [][]ent.DocumentScore) *ent.RankResult {
+	documentScores := []ent.DocumentScore{}
+	for i := range results {
+		documentScores = append(documentScores, results[i]...)
+	}
+	return &ent.RankResult{
+		Query:          query,
+		DocumentScores: documentScores,
+	}
+}
+
+func (c *client) getApiKey(ctx context.Context) string {
+	if apiKey := modulecomponents.GetValueFromContext(ctx, "X-Huggingface-Api-Key"); apiKey != "" {
+		return apiKey
+	}
+	return c.apiKey
+}
+
+type RankInput struct {
+	Query      string   `json:"query"`
+	Texts      []string `json:"texts"`
+	RawScores  bool     `json:"raw_scores"`
+	ReturnText bool     `json:"return_text"`
+	Truncate   bool     `json:"truncate"`
+}
+
+type Result struct {
+	Index int     `json:"index"`
+	Score float64 `json:"score"`
+}
+
+type huggingFaceApiError struct {
+	Error         string   `json:"error"`
+	EstimatedTime *float32 `json:"estimated_time,omitempty"`
+}
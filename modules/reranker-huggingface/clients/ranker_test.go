@@ -0,0 +1,279 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+)
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func TestRank(t *testing.T) {
+	t.Run("when the server has a successful response", func(t *testing.T) {
+		handler := &testRankHandler{
+			t:        t,
+			response: []Result{{Index: 0, Score: 0.9}},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", 0, nullLogger())
+
+		expected := &ent.RankResult{
+			DocumentScores: []ent.DocumentScore{
+				{
+					Document: "I work at Apple",
+					Score:    0.9,
+				},
+			},
+			Query: "Where do I work?",
+		}
+
+		res, err := c.Rank(context.Background(), "Where do I work?", []string{"I work at Apple"}, classConfig(server.URL))
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, "Bearer apiKey", handler.authorization)
+	})
+
+	t.Run("when the api key is passed in the header", func(t *testing.T) {
+		handler := &testRankHandler{
+			t:        t,
+			response: []Result{{Index: 0, Score: 0.9}},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("", 0, nullLogger())
+		ctx := context.WithValue(context.Background(), "X-Huggingface-Api-Key", []string{"headerKey"})
+
+		_, err := c.Rank(ctx, "Where do I work?", []string{"I work at Apple"}, classConfig(server.URL))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer headerKey", handler.authorization)
+	})
+
+	t.Run("when the server has an error", func(t *testing.T) {
+		handler := &testRankHandler{
+			t:            t,
+			errorStatus:  http.StatusInternalServerError,
+			errorMessage: "some error from the server",
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", 0, nullLogger())
+
+		_, err := c.Rank(context.Background(), "I work at Apple", []string{"Where do I work?"}, classConfig(server.URL))
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Hugging Face API failed with status 500: some error from the server")
+		assert.Equal(t, 1, handler.calls)
+	})
+
+	t.Run("when the model is loading the request is retried", func(t *testing.T) {
+		handler := &testRankHandler{
+			t:            t,
+			response:     []Result{{Index: 0, Score: 0.9}},
+			errorStatus:  http.StatusServiceUnavailable,
+			errorMessage: "Model is currently loading",
+			failures:     2,
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", 0, nullLogger())
+		c.retryBackoff = time.Millisecond
+
+		res, err := c.Rank(context.Background(), "Where do I work?", []string{"I work at Apple"}, classConfig(server.URL))
+
+		require.Nil(t, err)
+		assert.Equal(t, 0.9, res.DocumentScores[0].Score)
+		assert.Equal(t, 3, handler.calls)
+	})
+
+	t.Run("when the server stays rate limited", func(t *testing.T) {
+		handler := &testRankHandler{
+			t:            t,
+			errorStatus:  http.StatusTooManyRequests,
+			errorMessage: "rate limit reached",
+			failures:     10,
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", 0, nullLogger())
+		c.retryBackoff = time.Millisecond
+
+		_, err := c.Rank(context.Background(), "Where do I work?", []string{"I work at Apple"}, classConfig(server.URL))
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to Hugging Face API failed with status 429: rate limit reached")
+		// the first attempt and 3 retries
+		assert.Equal(t, 4, handler.calls)
+	})
+
+	t.Run("when we send requests in batches", func(t *testing.T) {
+		handler := &testRankHandler{
+			t: t,
+			batchedResults: [][]Result{
+				{{Index: 0, Score: 0.99}, {Index: 1, Score: 0.89}},
+				{{Index: 0, Score: 0.19}, {Index: 1, Score: 0.29}},
+				{{Index: 0, Score: 0.79}, {Index: 1, Score: 0.789}},
+				{{Index: 0, Score: 0.0001}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("apiKey", 0, nullLogger())
+		// this will trigger 4 go routines
+		c.maxDocuments = 2
+
+		query := "Where do I work?"
+		documents := []string{
+			"Response 1", "Response 2", "Response 3", "Response 4",
+			"Response 5", "Response 6", "Response 7",
+		}
+
+		resp, err := c.Rank(context.Background(), query, documents, classConfig(server.URL))
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.DocumentScores, len(documents))
+		for i := range resp.DocumentScores {
+			assert.Equal(t, documents[i], resp.DocumentScores[i].Document)
+		}
+		assert.Equal(t, 0.99, resp.DocumentScores[0].Score)
+		assert.Equal(t, 0.0001, resp.DocumentScores[len(documents)-1].Score)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	c := New("", 0, nullLogger())
+	estimated := float32(2.5)
+
+	assert.Equal(t, time.Second, c.backoff(0, http.Header{}, huggingFaceApiError{}))
+	assert.Equal(t, 4*time.Second, c.backoff(2, http.Header{}, huggingFaceApiError{}))
+	assert.Equal(t, 30*time.Second, c.backoff(10, http.Header{}, huggingFaceApiError{}))
+	assert.Equal(t, 7*time.Second, c.backoff(0, http.Header{"Retry-After": []string{"7"}}, huggingFaceApiError{}))
+	assert.Equal(t, 2500*time.Millisecond, c.backoff(0, http.Header{}, huggingFaceApiError{EstimatedTime: &estimated}))
+}
+
+func classConfig(endpointURL string) fakeClassConfig {
+	return fakeClassConfig{classConfig: map[string]interface{}{"endpointURL": endpointURL}}
+}
+
+type testRankHandler struct {
+	lock           sync.RWMutex
+	t              *testing.T
+	response       []Result
+	batchedResults [][]Result
+	errorStatus    int
+	errorMessage   string
+	// failures is the number of requests which fail before the server
+	// responds successfully, all of them fail if it is not set
+	failures      int
+	calls         int
+	authorization string
+}
+
+func (f *testRankHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	assert.Equal(f.t, "/rerank", r.URL.Path)
+	f.calls++
+	f.authorization = r.Header.Get("Authorization")
+
+	if f.errorMessage != "" && (f.failures == 0 || f.calls <= f.failures) {
+		w.WriteHeader(f.errorStatus)
+		w.Write([]byte(`{"error":"` + f.errorMessage + `"}`))
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	var req RankInput
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &req))
+
+	containsDocument := func(req RankInput, in string) bool {
+		for _, doc := range req.Texts {
+			if doc == in {
+				return true
+			}
+		}
+		return false
+	}
+
+	response := f.response
+	if len(f.batchedResults) > 0 {
+		index := 0
+		if containsDocument(req, "Response 3") {
+			index = 1
+		}
+		if containsDocument(req, "Response 5") {
+			index = 2
+		}
+		if containsDocument(req, "Response 7") {
+			index = 3
+		}
+		response = f.batchedResults[index]
+	}
+
+	outBytes, err := json.Marshal(response)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (f fakeClassConfig) TargetVector() string {
+	return ""
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	basesettings "github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+)
+
+const (
+	endpointURLProperty = "endpointURL"
+	maxRetriesProperty  = "maxRetries"
+)
+
+const (
+	DefaultMaxRetries = 3
+)
+
+type classSettings struct {
+	cfg                  moduletools.ClassConfig
+	propertyValuesHelper basesettings.PropertyValuesHelper
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg, propertyValuesHelper: basesettings.NewPropertyValuesHelper("reranker-huggingface")}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+	endpointURL := ic.EndpointURL()
+	if endpointURL == "" {
+		return errors.New("endpointURL is required, it must point to a Hugging Face Inference Endpoint serving a reranker model")
+	}
+	if u, err := url.Parse(endpointURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.Errorf("endpointURL %q is not a valid URL", endpointURL)
+	}
+	if ic.MaxRetries() < 0 {
+		return errors.New("maxRetries must not be negative")
+	}
+
+	return nil
+}
+
+// EndpointURL is the URL of a Hugging Face Inference Endpoint which serves a
+// reranker model with Text Embeddings Inference, the model is chosen when the
+// endpoint is deployed
+func (ic *classSettings) EndpointURL() string {
+	return ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, endpointURLProperty, "")
+}
+
+// MaxRetries is the number of times a request is retried if the endpoint is
+// rate limited or still loading the model
+func (ic *classSettings) MaxRetries() int {
+	maxRetries := DefaultMaxRetries
+	return *ic.propertyValuesHelper.GetPropertyAsInt(ic.cfg, maxRetriesProperty, &maxRetries)
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             moduletools.ClassConfig
+		wantEndpointURL string
+		wantMaxRetries  int
+		wantErr         error
+	}{
+		{
+			name: "endpoint with default retries",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpointURL": "https://xyz.endpoints.huggingface.cloud",
+				},
+			},
+			wantEndpointURL: "https://xyz.endpoints.huggingface.cloud",
+			wantMaxRetries:  3,
+		},
+		{
+			name: "custom retries",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpointURL": "https://xyz.endpoints.huggingface.cloud",
+					"maxRetries":  0,
+				},
+			},
+			wantEndpointURL: "https://xyz.endpoints.huggingface.cloud",
+			wantMaxRetries:  0,
+		},
+		{
+			name: "missing endpoint",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantErr: fmt.Errorf("endpointURL is required, it must point to a Hugging Face Inference Endpoint serving a reranker model"),
+		},
+		{
+			name: "invalid endpoint",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpointURL": "xyz.endpoints.huggingface.cloud",
+				},
+			},
+			wantErr: fmt.Errorf("endpointURL \"xyz.endpoints.huggingface.cloud\" is not a valid URL"),
+		},
+		{
+			name: "negative retries",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"endpointURL": "https://xyz.endpoints.huggingface.cloud",
+					"maxRetries":  -1,
+				},
+			},
+			wantErr: fmt.Errorf("maxRetries must not be negative"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(nil), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantEndpointURL, ic.EndpointURL())
+				assert.Equal(t, tt.wantMaxRetries, ic.MaxRetries())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (f fakeClassConfig) TargetVector() string {
+	return ""
+}
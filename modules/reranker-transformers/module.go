@@ -40,6 +40,7 @@ type ReRankerModule struct {
 type ReRankerClient interface {
 	Rank(ctx context.Context, query string, documents []string, cfg moduletools.ClassConfig) (*ent.RankResult, error)
 	MetaInfo() (map[string]interface{}, error)
+	CheckReady(ctx context.Context) error
 }
 
 func (m *ReRankerModule) Name() string {
@@ -83,6 +84,10 @@ func (m *ReRankerModule) MetaInfo() (map[string]interface{}, error) {
 	return m.reranker.MetaInfo()
 }
 
+func (m *ReRankerModule) CheckHealth(ctx context.Context) error {
+	return m.reranker.CheckReady(ctx)
+}
+
 func (m *ReRankerModule) RootHandler() http.Handler {
 	// TODO: remove once this is a capability interface
 	return nil
@@ -97,4 +102,5 @@ var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -25,15 +25,16 @@ import (
 )
 
 type sumClient interface {
-	GetSummary(ctx context.Context, property, text string) ([]ent.SummaryResult, error)
+	GetSummary(ctx context.Context, property, text string, length ent.SummaryLength) ([]ent.SummaryResult, error)
 }
 
 type SummaryProvider struct {
-	sum sumClient
+	sum   sumClient
+	cache *summaryCache
 }
 
 func New(sum sumClient) *SummaryProvider {
-	return &SummaryProvider{sum}
+	return &SummaryProvider{sum: sum, cache: newSummaryCache(summaryCacheSize())}
 }
 
 func (p *SummaryProvider) AdditionalPropertyDefaultValue() interface{} {
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package summary
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/weaviate/weaviate/modules/sum-transformers/ent"
+)
+
+// DefaultSummaryCacheSize is the number of summaries kept in memory, unless
+// SUM_CACHE_SIZE is set. A size of 0 disables the cache.
+const DefaultSummaryCacheSize = 1000
+
+type summaryCacheKey [32]byte
+
+type summaryCacheEntry struct {
+	key     summaryCacheKey
+	results []string
+}
+
+// summaryCache is a bounded LRU cache of summaries keyed by the hash of the
+// summarized text and the requested length
+type summaryCache struct {
+	sync.Mutex
+	maxSize int
+	entries map[summaryCacheKey]*list.Element
+	order   *list.List
+}
+
+func newSummaryCache(maxSize int) *summaryCache {
+	return &summaryCache{
+		maxSize: maxSize,
+		entries: make(map[summaryCacheKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// summaryCacheSize returns the size configured with SUM_CACHE_SIZE
+func summaryCacheSize() int {
+	if size, err := strconv.Atoi(os.Getenv("SUM_CACHE_SIZE")); err == nil && size >= 0 {
+		return size
+	}
+	return DefaultSummaryCacheSize
+}
+
+func (c *summaryCache) enabled() bool {
+	return c != nil && c.maxSize > 0
+}
+
+func (c *summaryCache) key(text string, length ent.SummaryLength) summaryCacheKey {
+	h := sha256.New()
+	binary.Write(h, binary.LittleEndian, int64(length.MinLength))
+	binary.Write(h, binary.LittleEndian, int64(length.MaxLength))
+	h.Write([]byte(text))
+
+	var key summaryCacheKey
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+func (c *summaryCache) get(key summaryCacheKey) ([]string, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*summaryCacheEntry).results, true
+}
+
+func (c *summaryCache) put(key summaryCacheKey, results []string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*summaryCacheEntry).results = results
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&summaryCacheEntry{key: key, results: results})
+	for c.order.Len() > c.maxSize {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*summaryCacheEntry).key)
+	}
+}
+
+func (c *summaryCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.order.Len()
+}
@@ -25,6 +25,14 @@ func (p *SummaryProvider) additionalSummaryField(classname string) *graphql.Fiel
 				Type:         graphql.NewList(graphql.String),
 				DefaultValue: nil,
 			},
+			"minLength": &graphql.ArgumentConfig{
+				Description: "Minimum length of the summaries in tokens",
+				Type:        graphql.Int,
+			},
+			"maxLength": &graphql.ArgumentConfig{
+				Description: "Maximum length of the summaries in tokens",
+				Type:        graphql.Int,
+			},
 		},
 		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
 			Name: fmt.Sprintf("%sAdditionalSummary", classname),
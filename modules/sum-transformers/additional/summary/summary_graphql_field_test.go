@@ -38,6 +38,8 @@ func Test_additionalSummaryField(t *testing.T) {
 	assert.NotNil(t, summaryObject.Fields()["result"])
 
 	assert.NotNil(t, summary.Args)
-	assert.Equal(t, 1, len(summary.Args))
+	assert.Equal(t, 3, len(summary.Args))
 	assert.NotNil(t, summary.Args["properties"])
+	assert.NotNil(t, summary.Args["minLength"])
+	assert.NotNil(t, summary.Args["maxLength"])
 }
@@ -11,8 +11,12 @@
 
 package summary
 
+import "github.com/weaviate/weaviate/modules/sum-transformers/ent"
+
 type Params struct {
 	Properties []string
+	MinLength  int
+	MaxLength  int
 }
 
 func (n Params) GetProperties() []string {
@@ -22,3 +26,7 @@ func (n Params) GetProperties() []string {
 func (n Params) GetPropertiesToExtract() []string {
 	return n.Properties
 }
+
+func (n Params) GetLength() ent.SummaryLength {
+	return ent.SummaryLength{MinLength: n.MinLength, MaxLength: n.MaxLength}
+}
@@ -13,6 +13,7 @@ package summary
 
 import (
 	"log"
+	"strconv"
 
 	"github.com/tailor-inc/graphql/language/ast"
 )
@@ -30,6 +31,12 @@ func (p *SummaryProvider) parseSummaryArguments(args []*ast.Argument) *Params {
 				out.Properties[i] = value.(*ast.StringValue).Value
 			}
 
+		case "minLength":
+			out.MinLength = parseIntArgument(arg)
+
+		case "maxLength":
+			out.MaxLength = parseIntArgument(arg)
+
 		default:
 			// ignore what we don't recognize
 			log.Printf("Igonore not recognized value: %v", arg.Name.Value)
@@ -38,3 +45,12 @@ func (p *SummaryProvider) parseSummaryArguments(args []*ast.Argument) *Params {
 
 	return out
 }
+
+func parseIntArgument(arg *ast.Argument) int {
+	value, ok := arg.Value.(*ast.IntValue)
+	if !ok {
+		return 0
+	}
+	i, _ := strconv.Atoi(value.Value)
+	return i
+}
@@ -38,10 +38,14 @@ func Test_parseSummaryArguments(t *testing.T) {
 			args: args{
 				args: []*ast.Argument{
 					createListArg("properties", []string{"prop1", "prop2"}),
+					createIntArg("minLength", "10"),
+					createIntArg("maxLength", "50"),
 				},
 			},
 			want: &Params{
 				Properties: []string{"prop1", "prop2"},
+				MinLength:  10,
+				MaxLength:  50,
 			},
 		},
 	}
@@ -81,3 +85,15 @@ func createListArg(name string, valuesIn []string) *ast.Argument {
 	a := ast.NewArgument(&arg)
 	return a
 }
+
+func createIntArg(name, value string) *ast.Argument {
+	n := ast.Name{
+		Value: name,
+	}
+	arg := ast.Argument{
+		Name:  ast.NewName(&n),
+		Kind:  "Kind",
+		Value: &ast.IntValue{Kind: "Kind", Value: value},
+	}
+	return ast.NewArgument(&arg)
+}
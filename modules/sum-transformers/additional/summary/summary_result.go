@@ -37,6 +37,13 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 		if len(properties) == 0 {
 			return in, errors.New("no properties provided")
 		}
+		length := params.GetLength()
+		if length.MinLength < 0 || length.MaxLength < 0 {
+			return in, errors.New("minLength and maxLength must not be negative")
+		}
+		if length.MaxLength > 0 && length.MinLength > length.MaxLength {
+			return in, errors.New("minLength must not be greater than maxLength")
+		}
 
 		for i := range in { // for each result of the general GraphQL Query
 			ap := in[i].AdditionalProperties
@@ -59,7 +66,7 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 
 			// for each text property result, call the SUM function and add to additional result
 			for property, value := range textProperties {
-				summary, err := p.sum.GetSummary(ctx, property, value)
+				summary, err := p.getSummary(ctx, property, value, length)
 				if err != nil {
 					return in, err
 				}
@@ -75,6 +82,40 @@ func (p *SummaryProvider) findSummary(ctx context.Context,
 	return in, nil
 }
 
+// getSummary returns the cached summary of text, or asks the inference
+// service for it. Identical texts are common in results, e.g. of chunked
+// documents, and summarizing them is slow.
+func (p *SummaryProvider) getSummary(ctx context.Context, property, text string,
+	length ent.SummaryLength,
+) ([]ent.SummaryResult, error) {
+	if !p.cache.enabled() {
+		return p.sum.GetSummary(ctx, property, text, length)
+	}
+
+	key := p.cache.key(text, length)
+	if results, ok := p.cache.get(key); ok {
+		return withProperty(results, property), nil
+	}
+	summary, err := p.sum.GetSummary(ctx, property, text, length)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]string, len(summary))
+	for i := range summary {
+		results[i] = summary[i].Result
+	}
+	p.cache.put(key, results)
+	return summary, nil
+}
+
+func withProperty(results []string, property string) []ent.SummaryResult {
+	summary := make([]ent.SummaryResult, len(results))
+	for i := range results {
+		summary[i] = ent.SummaryResult{Property: property, Result: results[i]}
+	}
+	return summary
+}
+
 func (p *SummaryProvider) containsProperty(property string, properties []string) bool {
 	if len(properties) == 0 {
 		return true
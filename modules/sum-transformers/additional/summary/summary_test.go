@@ -98,12 +98,117 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 		assert.Equal(t, "this is the summary", answerAdditional[0].Result)
 		assert.Equal(t, "content", answerAdditional[0].Property)
 	})
+
+	t.Run("should pass the length bounds", func(t *testing.T) {
+		sumClient := &fakeSUMClient{}
+		summaryProvider := New(sumClient)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "this is the content",
+				},
+			},
+		}
+		fakeParams := &Params{Properties: []string{"content"}, MinLength: 10, MaxLength: 50}
+
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []ent.SummaryLength{{MinLength: 10, MaxLength: 50}}, sumClient.lengths)
+	})
+
+	t.Run("should fail with invalid length bounds", func(t *testing.T) {
+		for _, params := range []*Params{
+			{Properties: []string{"content"}, MinLength: -1},
+			{Properties: []string{"content"}, MinLength: 50, MaxLength: 10},
+		} {
+			sumClient := &fakeSUMClient{}
+			in := []search.Result{{ID: "some-uuid", Schema: map[string]interface{}{"content": "content"}}}
+
+			_, err := New(sumClient).AdditionalPropertyFn(context.Background(), in, params, nil, nil, nil)
+			require.NotNil(t, err)
+			assert.Equal(t, 0, sumClient.calls)
+		}
+	})
+
+	t.Run("should cache summaries", func(t *testing.T) {
+		sumClient := &fakeSUMClient{}
+		summaryProvider := New(sumClient)
+		newResults := func() []search.Result {
+			return []search.Result{
+				{ID: "uuid-1", Schema: map[string]interface{}{"content": "same text", "title": "same text"}},
+				{ID: "uuid-2", Schema: map[string]interface{}{"content": "same text"}},
+			}
+		}
+		params := &Params{Properties: []string{"content", "title"}}
+
+		out, err := summaryProvider.AdditionalPropertyFn(context.Background(), newResults(), params, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 1, sumClient.calls)
+		assert.ElementsMatch(t, []ent.SummaryResult{
+			{Property: "content", Result: "this is the summary"},
+			{Property: "title", Result: "this is the summary"},
+		}, out[0].AdditionalProperties["summary"])
+		assert.Equal(t, []ent.SummaryResult{
+			{Property: "content", Result: "this is the summary"},
+		}, out[1].AdditionalProperties["summary"])
+
+		// other bounds result in other summaries
+		params.MaxLength = 10
+		_, err = summaryProvider.AdditionalPropertyFn(context.Background(), newResults(), params, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, sumClient.calls)
+		assert.Equal(t, 2, summaryProvider.cache.len())
+	})
+
+	t.Run("should not cache with a cache size of 0", func(t *testing.T) {
+		t.Setenv("SUM_CACHE_SIZE", "0")
+		sumClient := &fakeSUMClient{}
+		summaryProvider := New(sumClient)
+		in := []search.Result{
+			{ID: "uuid-1", Schema: map[string]interface{}{"content": "same text"}},
+			{ID: "uuid-2", Schema: map[string]interface{}{"content": "same text"}},
+		}
+
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), in,
+			&Params{Properties: []string{"content"}}, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, sumClient.calls)
+	})
 }
 
-type fakeSUMClient struct{}
+func TestSummaryCacheEviction(t *testing.T) {
+	cache := newSummaryCache(2)
+	keys := []summaryCacheKey{
+		cache.key("a", ent.SummaryLength{}),
+		cache.key("b", ent.SummaryLength{}),
+		cache.key("c", ent.SummaryLength{}),
+	}
+	cache.put(keys[0], []string{"a"})
+	cache.put(keys[1], []string{"b"})
+	// a was used last, so b is evicted
+	_, ok := cache.get(keys[0])
+	require.True(t, ok)
+	cache.put(keys[2], []string{"c"})
+
+	assert.Equal(t, 2, cache.len())
+	_, ok = cache.get(keys[1])
+	assert.False(t, ok)
+	results, ok := cache.get(keys[0])
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a"}, results)
+}
+
+type fakeSUMClient struct {
+	calls   int
+	lengths []ent.SummaryLength
+}
 
 func (c *fakeSUMClient) GetSummary(ctx context.Context, property, text string,
+	length ent.SummaryLength,
 ) ([]ent.SummaryResult, error) {
+	c.calls++
+	c.lengths = append(c.lengths, length)
 	return c.getSummary(property), nil
 }
 
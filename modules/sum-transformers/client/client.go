@@ -32,7 +32,9 @@ type client struct {
 }
 
 type sumInput struct {
-	Text string `json:"text"`
+	Text      string `json:"text"`
+	MinLength int    `json:"min_length,omitempty"`
+	MaxLength int    `json:"max_length,omitempty"`
 }
 
 type summaryResponse struct {
@@ -57,9 +59,12 @@ func New(origin string, timeout time.Duration, logger logrus.FieldLogger) *clien
 }
 
 func (c *client) GetSummary(ctx context.Context, property, text string,
+	length ent.SummaryLength,
 ) ([]ent.SummaryResult, error) {
 	body, err := json.Marshal(sumInput{
-		Text: text,
+		Text:      text,
+		MinLength: length.MinLength,
+		MaxLength: length.MaxLength,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
@@ -41,7 +41,7 @@ func TestGetAnswer(t *testing.T) {
 		defer server.Close()
 		c := New(server.URL, 0, nullLogger())
 		res, err := c.GetSummary(context.Background(), "prop",
-			"I work at Apple")
+			"I work at Apple", ent.SummaryLength{})
 
 		assert.Nil(t, err)
 		assert.Equal(t, []ent.SummaryResult{
@@ -52,6 +52,23 @@ func TestGetAnswer(t *testing.T) {
 		}, res)
 	})
 
+	t.Run("with length bounds", func(t *testing.T) {
+		handler := &testSUMHandler{
+			t: t,
+			res: sumResponse{
+				Summary: []summaryResponse{{Result: "Apple"}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New(server.URL, 0, nullLogger())
+		_, err := c.GetSummary(context.Background(), "prop",
+			"I work at Apple", ent.SummaryLength{MinLength: 5, MaxLength: 20})
+
+		require.Nil(t, err)
+		assert.Equal(t, sumInput{Text: "I work at Apple", MinLength: 5, MaxLength: 20}, handler.input)
+	})
+
 	t.Run("when the server has a an error", func(t *testing.T) {
 		server := httptest.NewServer(&testSUMHandler{
 			t: t,
@@ -62,7 +79,7 @@ func TestGetAnswer(t *testing.T) {
 		defer server.Close()
 		c := New(server.URL, 0, nullLogger())
 		_, err := c.GetSummary(context.Background(), "prop",
-			"I work at Apple")
+			"I work at Apple", ent.SummaryLength{})
 
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "some error from the server")
@@ -72,12 +89,14 @@ func TestGetAnswer(t *testing.T) {
 type testSUMHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
-	res sumResponse
+	res   sumResponse
+	input sumInput
 }
 
 func (f *testSUMHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	assert.Equal(f.t, "/sum/", r.URL.String())
 	assert.Equal(f.t, http.MethodPost, r.Method)
+	assert.Nil(f.t, json.NewDecoder(r.Body).Decode(&f.input))
 
 	if f.res.Error != "" {
 		w.WriteHeader(500)
@@ -16,6 +16,13 @@ type SummaryResult struct {
 	Result   string
 }
 
+// SummaryLength bounds the length of a summary in tokens of the model, zero
+// values leave the bound to the model
+type SummaryLength struct {
+	MinLength int
+	MaxLength int
+}
+
 type SumResult struct {
 	Summary []SummaryResult
 }
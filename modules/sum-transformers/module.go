@@ -39,7 +39,7 @@ type SUMModule struct {
 }
 
 type sumClient interface {
-	GetSummary(ctx context.Context, property, text string) ([]ent.SummaryResult, error)
+	GetSummary(ctx context.Context, property, text string, length ent.SummaryLength) ([]ent.SummaryResult, error)
 	MetaInfo() (map[string]interface{}, error)
 }
 
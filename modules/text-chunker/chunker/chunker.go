@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package chunker
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/tiktoken-go"
+)
+
+// Span is the position of a token in a text, in bytes
+type Span struct {
+	Start, End int
+}
+
+type Tokenizer interface {
+	Tokenize(text string) []Span
+}
+
+// Split splits text into chunks of size tokens. Consecutive chunks share
+// overlap tokens, overlap must be smaller than size. The chunks are cut out
+// of text, so the text between the tokens of a chunk is kept as is.
+func Split(text string, tokenizer Tokenizer, size, overlap int) []string {
+	spans := tokenizer.Tokenize(text)
+	if len(spans) == 0 {
+		return nil
+	}
+
+	var chunks []string
+	for start := 0; ; start += size - overlap {
+		end := min(start+size, len(spans))
+		chunks = append(chunks, cut(text, spans[start].Start, spans[end-1].End))
+		if end == len(spans) {
+			return chunks
+		}
+	}
+}
+
+// cut widens the bytes from start to end to whole runes, since tokens of
+// byte-level tokenizers may split multi-byte characters
+func cut(text string, start, end int) string {
+	for start > 0 && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end++
+	}
+	return text[start:end]
+}
+
+// Whitespace tokenizes text into the words separated by whitespace
+type Whitespace struct{}
+
+func (Whitespace) Tokenize(text string) []Span {
+	var spans []Span
+	start := -1
+	for i, r := range text {
+		switch {
+		case unicode.IsSpace(r) && start >= 0:
+			spans = append(spans, Span{Start: start, End: i})
+			start = -1
+		case !unicode.IsSpace(r) && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, Span{Start: start, End: len(text)})
+	}
+	return spans
+}
+
+// Tiktoken tokenizes text with the BPE encodings of OpenAI models, so that
+// chunks fit the context of the models
+type Tiktoken struct {
+	encoding *tiktoken.Tiktoken
+}
+
+func NewTiktoken(encoding string) (*Tiktoken, error) {
+	tke, err := tiktoken.GetEncoding(encoding)
+	if err != nil {
+		return nil, err
+	}
+	return &Tiktoken{encoding: tke}, nil
+}
+
+func (t *Tiktoken) Tokenize(text string) []Span {
+	tokens := t.encoding.EncodeOrdinary(text)
+	spans := make([]Span, 0, len(tokens))
+	pos := 0
+	for _, token := range tokens {
+		// the bytes of the tokens make up the text
+		end := pos + len(t.encoding.Decode([]int{token}))
+		// tokens which start with whitespace don't need to start the chunk
+		start := pos
+		for start < end && text[start] == ' ' {
+			start++
+		}
+		if start == end {
+			start = pos
+		}
+		spans = append(spans, Span{Start: start, End: end})
+		pos = end
+	}
+	return spans
+}
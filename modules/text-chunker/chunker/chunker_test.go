@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package chunker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		size    int
+		overlap int
+		want    []string
+	}{
+		{
+			name: "empty text",
+			text: " \n ",
+			size: 3,
+			want: nil,
+		},
+		{
+			name: "shorter than a chunk",
+			text: "  one two ",
+			size: 3,
+			want: []string{"one two"},
+		},
+		{
+			name: "without overlap",
+			text: "one two three four five six seven",
+			size: 3,
+			want: []string{"one two three", "four five six", "seven"},
+		},
+		{
+			name:    "with overlap",
+			text:    "one two three four five six seven",
+			size:    3,
+			overlap: 1,
+			want:    []string{"one two three", "three four five", "five six seven"},
+		},
+		{
+			name: "keeps the text between tokens",
+			text: "one,\ttwo\n\nthree four",
+			size: 3,
+			want: []string{"one,\ttwo\n\nthree", "four"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Split(tt.text, Whitespace{}, tt.size, tt.overlap))
+		})
+	}
+}
+
+type fixedTokenizer []Span
+
+func (f fixedTokenizer) Tokenize(text string) []Span {
+	return f
+}
+
+func TestSplitKeepsRunesWhole(t *testing.T) {
+	// "€" has 3 bytes, byte-level tokenizers can split it
+	text := "a€b"
+	tokenizer := fixedTokenizer{{0, 2}, {2, 4}, {4, 5}}
+	assert.Equal(t, []string{"a€", "€", "b"}, Split(text, tokenizer, 1, 0))
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modtextchunker
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/modules/text-chunker/config"
+)
+
+func (m *TextChunkerModule) ClassConfigDefaults() map[string]interface{} {
+	return map[string]interface{}{
+		"chunkProperty":  config.DefaultChunkProperty,
+		"parentProperty": config.DefaultParentProperty,
+		"chunkSize":      config.DefaultChunkSize,
+		"chunkOverlap":   config.DefaultChunkOverlap,
+		"tokenizer":      config.DefaultTokenizer,
+	}
+}
+
+func (m *TextChunkerModule) PropertyConfigDefaults(
+	dt *schema.DataType,
+) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (m *TextChunkerModule) ValidateClass(ctx context.Context,
+	class *models.Class, cfg moduletools.ClassConfig,
+) error {
+	return config.NewClassSettings(cfg).Validate(class)
+}
+
+var _ = modulecapabilities.ClassConfigurator(New())
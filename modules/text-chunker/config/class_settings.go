@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	basesettings "github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+)
+
+const (
+	sourcePropertyProperty = "sourceProperty"
+	chunkClassProperty     = "chunkClass"
+	chunkPropertyProperty  = "chunkProperty"
+	parentPropertyProperty = "parentProperty"
+	chunkSizeProperty      = "chunkSize"
+	chunkOverlapProperty   = "chunkOverlap"
+	tokenizerProperty      = "tokenizer"
+)
+
+const (
+	DefaultChunkProperty  = "text"
+	DefaultParentProperty = "parent"
+	DefaultChunkSize      = 256
+	DefaultChunkOverlap   = 0
+	DefaultTokenizer      = TokenizerWhitespace
+)
+
+// TokenizerWhitespace counts words, all other tokenizers are the BPE
+// encodings of OpenAI models
+const TokenizerWhitespace = "whitespace"
+
+var availableTokenizers = []string{
+	TokenizerWhitespace,
+	"cl100k_base",
+	"p50k_base",
+	"r50k_base",
+}
+
+type classSettings struct {
+	cfg                  moduletools.ClassConfig
+	propertyValuesHelper basesettings.PropertyValuesHelper
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg, propertyValuesHelper: basesettings.NewPropertyValuesHelper("text-chunker")}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	sourceProperty := ic.SourceProperty()
+	if sourceProperty == "" {
+		return errors.New("sourceProperty is required, it must name the text property to chunk")
+	}
+	if class != nil {
+		prop, err := schema.GetPropertyByName(class, sourceProperty)
+		if err != nil {
+			return errors.Errorf("sourceProperty %q is not a property of the class", sourceProperty)
+		}
+		if dt, _ := schema.AsPrimitive(prop.DataType); dt != schema.DataTypeText {
+			return errors.Errorf("sourceProperty %q must be of type text", sourceProperty)
+		}
+	}
+
+	chunkClass := ic.ChunkClass()
+	if chunkClass == "" {
+		return errors.New("chunkClass is required, it must name the class the chunks are imported to")
+	}
+	if class != nil && schema.UppercaseClassName(chunkClass) == class.Class {
+		return errors.New("chunkClass must not be the class itself")
+	}
+	if ic.ChunkProperty() == "" || ic.ParentProperty() == "" {
+		return errors.New("chunkProperty and parentProperty must not be empty")
+	}
+	if ic.ChunkProperty() == ic.ParentProperty() {
+		return errors.New("chunkProperty and parentProperty must be different properties")
+	}
+
+	if ic.ChunkSize() <= 0 {
+		return errors.New("chunkSize must be positive")
+	}
+	if ic.ChunkOverlap() < 0 || ic.ChunkOverlap() >= ic.ChunkSize() {
+		return errors.New("chunkOverlap must not be negative and must be smaller than chunkSize")
+	}
+	if !basesettings.ValidateSetting[string](ic.Tokenizer(), availableTokenizers) {
+		return errors.Errorf("wrong tokenizer, available tokenizers are: %v", availableTokenizers)
+	}
+
+	return nil
+}
+
+// SourceProperty is the text property which is split into chunks
+func (ic *classSettings) SourceProperty() string {
+	return ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, sourcePropertyProperty, "")
+}
+
+// ChunkClass is the class the chunks are imported to
+func (ic *classSettings) ChunkClass() string {
+	return schema.UppercaseClassName(ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, chunkClassProperty, ""))
+}
+
+// ChunkProperty is the text property of the chunk class which holds the text
+// of a chunk
+func (ic *classSettings) ChunkProperty() string {
+	return ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, chunkPropertyProperty, DefaultChunkProperty)
+}
+
+// ParentProperty is the reference property of the chunk class which points
+// to the object the chunk was split from
+func (ic *classSettings) ParentProperty() string {
+	return ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, parentPropertyProperty, DefaultParentProperty)
+}
+
+// ChunkSize is the maximum number of tokens of a chunk
+func (ic *classSettings) ChunkSize() int {
+	chunkSize := DefaultChunkSize
+	return *ic.propertyValuesHelper.GetPropertyAsInt(ic.cfg, chunkSizeProperty, &chunkSize)
+}
+
+// ChunkOverlap is the number of tokens consecutive chunks share
+func (ic *classSettings) ChunkOverlap() int {
+	chunkOverlap := DefaultChunkOverlap
+	return *ic.propertyValuesHelper.GetPropertyAsInt(ic.cfg, chunkOverlapProperty, &chunkOverlap)
+}
+
+func (ic *classSettings) Tokenizer() string {
+	return ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, tokenizerProperty, DefaultTokenizer)
+}
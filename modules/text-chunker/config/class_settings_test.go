@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Doc",
+		Properties: []*models.Property{
+			{Name: "body", DataType: schema.DataTypeText.PropString()},
+			{Name: "pages", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	tests := []struct {
+		name          string
+		classConfig   map[string]interface{}
+		wantChunkSize int
+		wantOverlap   int
+		wantTokenizer string
+		wantErr       error
+	}{
+		{
+			name:          "defaults",
+			classConfig:   map[string]interface{}{"sourceProperty": "body", "chunkClass": "docChunk"},
+			wantChunkSize: 256,
+			wantTokenizer: "whitespace",
+		},
+		{
+			name: "custom settings",
+			classConfig: map[string]interface{}{
+				"sourceProperty": "body", "chunkClass": "DocChunk",
+				"chunkSize": 512, "chunkOverlap": 64, "tokenizer": "cl100k_base",
+			},
+			wantChunkSize: 512,
+			wantOverlap:   64,
+			wantTokenizer: "cl100k_base",
+		},
+		{
+			name:        "missing source property",
+			classConfig: map[string]interface{}{"chunkClass": "DocChunk"},
+			wantErr:     fmt.Errorf("sourceProperty is required, it must name the text property to chunk"),
+		},
+		{
+			name:        "unknown source property",
+			classConfig: map[string]interface{}{"sourceProperty": "title", "chunkClass": "DocChunk"},
+			wantErr:     fmt.Errorf("sourceProperty \"title\" is not a property of the class"),
+		},
+		{
+			name:        "source property which is not text",
+			classConfig: map[string]interface{}{"sourceProperty": "pages", "chunkClass": "DocChunk"},
+			wantErr:     fmt.Errorf("sourceProperty \"pages\" must be of type text"),
+		},
+		{
+			name:        "missing chunk class",
+			classConfig: map[string]interface{}{"sourceProperty": "body"},
+			wantErr:     fmt.Errorf("chunkClass is required, it must name the class the chunks are imported to"),
+		},
+		{
+			name:        "chunks into the class itself",
+			classConfig: map[string]interface{}{"sourceProperty": "body", "chunkClass": "doc"},
+			wantErr:     fmt.Errorf("chunkClass must not be the class itself"),
+		},
+		{
+			name: "same chunk and parent property",
+			classConfig: map[string]interface{}{
+				"sourceProperty": "body", "chunkClass": "DocChunk", "parentProperty": "text",
+			},
+			wantErr: fmt.Errorf("chunkProperty and parentProperty must be different properties"),
+		},
+		{
+			name: "overlap as large as the chunks",
+			classConfig: map[string]interface{}{
+				"sourceProperty": "body", "chunkClass": "DocChunk", "chunkSize": 10, "chunkOverlap": 10,
+			},
+			wantErr: fmt.Errorf("chunkOverlap must not be negative and must be smaller than chunkSize"),
+		},
+		{
+			name: "unknown tokenizer",
+			classConfig: map[string]interface{}{
+				"sourceProperty": "body", "chunkClass": "DocChunk", "tokenizer": "bert",
+			},
+			wantErr: fmt.Errorf("wrong tokenizer, available tokenizers are: [whitespace cl100k_base p50k_base r50k_base]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(fakeClassConfig{classConfig: tt.classConfig})
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(class), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(class))
+				assert.Equal(t, "DocChunk", ic.ChunkClass())
+				assert.Equal(t, "text", ic.ChunkProperty())
+				assert.Equal(t, "parent", ic.ParentProperty())
+				assert.Equal(t, tt.wantChunkSize, ic.ChunkSize())
+				assert.Equal(t, tt.wantOverlap, ic.ChunkOverlap())
+				assert.Equal(t, tt.wantTokenizer, ic.Tokenizer())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (f fakeClassConfig) TargetVector() string {
+	return ""
+}
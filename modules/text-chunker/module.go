@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modtextchunker
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/modules/text-chunker/chunker"
+	"github.com/weaviate/weaviate/modules/text-chunker/config"
+)
+
+const Name = "text-chunker"
+
+func New() *TextChunkerModule {
+	return &TextChunkerModule{
+		tokenizers: map[string]chunker.Tokenizer{
+			config.TokenizerWhitespace: chunker.Whitespace{},
+		},
+	}
+}
+
+// TextChunkerModule splits a text property of objects into chunks at import
+// time. The chunks are imported as objects of another class, which reference
+// the object they were split from.
+type TextChunkerModule struct {
+	sync.Mutex
+	tokenizers map[string]chunker.Tokenizer
+}
+
+func (m *TextChunkerModule) Name() string {
+	return Name
+}
+
+func (m *TextChunkerModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2Chunks
+}
+
+func (m *TextChunkerModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	return nil
+}
+
+func (m *TextChunkerModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *TextChunkerModule) Chunk(ctx context.Context, object *models.Object,
+	cfg moduletools.ClassConfig,
+) ([]*models.Object, error) {
+	settings := config.NewClassSettings(cfg)
+	props, _ := object.Properties.(map[string]interface{})
+	text, _ := props[settings.SourceProperty()].(string)
+	if text == "" {
+		return nil, nil
+	}
+
+	tokenizer, err := m.tokenizer(settings.Tokenizer())
+	if err != nil {
+		return nil, err
+	}
+	texts := chunker.Split(text, tokenizer, settings.ChunkSize(), settings.ChunkOverlap())
+
+	parentID, err := uuid.Parse(object.ID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "parse id")
+	}
+	// references are passed like in the REST API, as they are validated
+	// like any other imported object
+	parent := []interface{}{map[string]interface{}{
+		"beacon": crossref.NewLocalhost(object.Class, object.ID).String(),
+	}}
+	chunks := make([]*models.Object, len(texts))
+	for i, text := range texts {
+		chunks[i] = &models.Object{
+			Class: settings.ChunkClass(),
+			// the ids are derived from the parent, so that importing the
+			// parent again replaces its chunks
+			ID:     strfmt.UUID(uuid.NewSHA1(parentID, []byte(strconv.Itoa(i))).String()),
+			Tenant: object.Tenant,
+			Properties: map[string]interface{}{
+				settings.ChunkProperty():  text,
+				settings.ParentProperty(): parent,
+			},
+		}
+	}
+	return chunks, nil
+}
+
+// tokenizer returns the tokenizer by name. The encodings of tiktoken are
+// loaded on first use, so that they're only downloaded if they're used.
+func (m *TextChunkerModule) tokenizer(name string) (chunker.Tokenizer, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if tokenizer, ok := m.tokenizers[name]; ok {
+		return tokenizer, nil
+	}
+	tokenizer, err := chunker.NewTiktoken(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load tokenizer %s", name)
+	}
+	m.tokenizers[name] = tokenizer
+	return tokenizer, nil
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.Chunker(New())
+)
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modtextchunker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestChunk(t *testing.T) {
+	m := New()
+	cfg := fakeClassConfig{
+		"sourceProperty": "body",
+		"chunkClass":     "DocChunk",
+		"parentProperty": "document",
+		"chunkSize":      2,
+	}
+	object := &models.Object{
+		Class:  "Doc",
+		ID:     "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+		Tenant: "tenant1",
+		Properties: map[string]interface{}{
+			"body":  "one two three",
+			"title": "numbers",
+		},
+	}
+
+	chunks, err := m.Chunk(context.Background(), object, cfg)
+	require.Nil(t, err)
+	require.Len(t, chunks, 2)
+	for i, text := range []string{"one two", "three"} {
+		assert.Equal(t, "DocChunk", chunks[i].Class)
+		assert.Equal(t, "tenant1", chunks[i].Tenant)
+		assert.Equal(t, map[string]interface{}{
+			"text": text,
+			"document": []interface{}{map[string]interface{}{
+				"beacon": "weaviate://localhost/Doc/73f2eb5f-5abf-447a-81ca-74b1dd168247",
+			}},
+		}, chunks[i].Properties)
+	}
+	assert.NotEqual(t, chunks[0].ID, chunks[1].ID)
+
+	again, err := m.Chunk(context.Background(), object, cfg)
+	require.Nil(t, err)
+	assert.Equal(t, chunks[0].ID, again[0].ID, "chunk ids are stable")
+
+	object.Properties = map[string]interface{}{"title": "no body"}
+	chunks, err = m.Chunk(context.Background(), object, cfg)
+	require.Nil(t, err)
+	assert.Empty(t, chunks)
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (f fakeClassConfig) TargetVector() string {
+	return ""
+}
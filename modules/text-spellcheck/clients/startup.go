@@ -29,7 +29,7 @@ func (s *spellCheck) WaitForStartup(initCtx context.Context,
 	for {
 		select {
 		case <-t.C:
-			lastErr = s.checkReady(initCtx)
+			lastErr = s.CheckReady(initCtx)
 			if lastErr == nil {
 				return nil
 			}
@@ -42,7 +42,8 @@ func (s *spellCheck) WaitForStartup(initCtx context.Context,
 	}
 }
 
-func (s *spellCheck) checkReady(initCtx context.Context) error {
+// CheckReady checks once whether the remote inference service is ready
+func (s *spellCheck) CheckReady(initCtx context.Context) error {
 	// spawn a new context (derived on the overall context) which is used to
 	// consider an individual request timed out
 	requestCtx, cancel := context.WithTimeout(initCtx, 500*time.Millisecond)
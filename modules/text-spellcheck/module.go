@@ -43,6 +43,7 @@ type SpellCheckModule struct {
 type spellCheckClient interface {
 	Check(ctx context.Context, text []string) (*ent.SpellCheckResult, error)
 	MetaInfo() (map[string]interface{}, error)
+	CheckReady(ctx context.Context) error
 }
 
 func (m *SpellCheckModule) Name() string {
@@ -94,6 +95,10 @@ func (m *SpellCheckModule) MetaInfo() (map[string]interface{}, error) {
 	return m.spellCheck.MetaInfo()
 }
 
+func (m *SpellCheckModule) CheckHealth(ctx context.Context) error {
+	return m.spellCheck.CheckReady(ctx)
+}
+
 func (m *SpellCheckModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
@@ -108,4 +113,5 @@ var (
 	_ = modulecapabilities.AdditionalProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
 	_ = modulecapabilities.TextTransformers(New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -13,9 +13,11 @@ package concepts
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/modules/text2vec-contextionary/vectorizer"
 )
 
 type RESTHandlers struct {
@@ -39,6 +41,48 @@ func (h *RESTHandlers) Handler() http.Handler {
 	})
 }
 
+// KeywordsPreviewHandler vectorizes a corpus with the given keyword weights,
+// so the effect of keywords can be checked before setting them in the schema
+func (h *RESTHandlers) KeywordsPreviewHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.preview(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+type keywordsPreviewRequest struct {
+	Corpus   string               `json:"corpus"`
+	Keywords []vectorizer.Keyword `json:"keywords"`
+}
+
+func (h *RESTHandlers) preview(w http.ResponseWriter, r *http.Request) {
+	var body keywordsPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, err, http.StatusUnprocessableEntity)
+		return
+	}
+
+	res, err := h.inspector.Preview(r.Context(), body.Corpus, body.Keywords)
+	if err != nil {
+		h.writeError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.writeError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("content-type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
 func (h *RESTHandlers) get(w http.ResponseWriter, r *http.Request) {
 	if len(r.URL.String()) == 0 || h.extractConcept(r) == "" {
 		w.WriteHeader(http.StatusNotFound)
@@ -93,4 +137,5 @@ func (h *RESTHandlers) extractConcept(r *http.Request) string {
 
 type Inspector interface {
 	GetWords(ctx context.Context, words string) (*models.C11yWordsResponse, error)
+	Preview(ctx context.Context, corpus string, keywords []vectorizer.Keyword) (*vectorizer.KeywordsPreview, error)
 }
@@ -16,12 +16,14 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/modules/text2vec-contextionary/vectorizer"
 )
 
 func TestHandlers(t *testing.T) {
@@ -74,12 +76,95 @@ func TestHandlers(t *testing.T) {
 	})
 }
 
+func TestKeywordsPreviewHandler(t *testing.T) {
+	insp := newFakeInspector()
+	h := NewRESTHandlers(insp)
+
+	t.Run("with the wrong method", func(t *testing.T) {
+		insp.reset()
+		r := httptest.NewRequest("GET", "/", nil)
+		w := httptest.NewRecorder()
+		h.KeywordsPreviewHandler().ServeHTTP(w, r)
+
+		res := w.Result()
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	})
+
+	t.Run("with an invalid body", func(t *testing.T) {
+		insp.reset()
+		r := httptest.NewRequest("POST", "/", strings.NewReader("{"))
+		w := httptest.NewRecorder()
+		h.KeywordsPreviewHandler().ServeHTTP(w, r)
+
+		res := w.Result()
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	})
+
+	t.Run("without any errors", func(t *testing.T) {
+		insp.reset()
+		body := `{"corpus":"fast car","keywords":[{"keyword":"vehicle","weight":2}]}`
+		r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.KeywordsPreviewHandler().ServeHTTP(w, r)
+
+		res := w.Result()
+		defer res.Body.Close()
+		json, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+		expected := `{"vector":[0.1,0.2],"nearestNeighbors":[{"distance":0.1,"word":"vehicle"}],"source":null}`
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, expected, string(json))
+		assert.Equal(t, "fast car", insp.lastCorpus)
+		assert.Equal(t, []vectorizer.Keyword{{Keyword: "vehicle", Weight: 2}}, insp.lastKeywords)
+	})
+
+	t.Run("with an error from the UC", func(t *testing.T) {
+		insp.reset()
+		insp.err = errors.Errorf("invalid input")
+		r := httptest.NewRequest("POST", "/", strings.NewReader(`{"corpus":""}`))
+		w := httptest.NewRecorder()
+		h.KeywordsPreviewHandler().ServeHTTP(w, r)
+
+		res := w.Result()
+		defer res.Body.Close()
+		json, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+		expected := `{"error":[{"message":"invalid input"}]}`
+
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.Equal(t, expected, string(json))
+	})
+}
+
 type fakeInspector struct {
-	err error
+	err          error
+	lastCorpus   string
+	lastKeywords []vectorizer.Keyword
 }
 
 func (f *fakeInspector) reset() {
 	f.err = nil
+	f.lastCorpus = ""
+	f.lastKeywords = nil
+}
+
+func (f *fakeInspector) Preview(ctx context.Context, corpus string,
+	keywords []vectorizer.Keyword,
+) (*vectorizer.KeywordsPreview, error) {
+	f.lastCorpus = corpus
+	f.lastKeywords = keywords
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &vectorizer.KeywordsPreview{
+		Vector: []float32{0.1, 0.2},
+		NearestNeighbors: []*models.C11yNearestNeighborsItems0{
+			{Word: "vehicle", Distance: 0.1},
+		},
+	}, nil
 }
 
 func (f *fakeInspector) GetWords(ctx context.Context,
@@ -208,6 +208,7 @@ func (m *ContextionaryModule) RootHandler() http.Handler {
 	mux.Handle("/extensions", http.StripPrefix("/extensions",
 		m.extensions.UserFacingHandler()))
 	mux.Handle("/concepts/", http.StripPrefix("/concepts", m.concepts.Handler()))
+	mux.Handle("/keywords/preview", m.concepts.KeywordsPreviewHandler())
 
 	return mux
 }
@@ -18,11 +18,13 @@ import (
 )
 
 type fakeClient struct {
-	lastInput []string
+	lastInput     []string
+	lastOverrides map[string]string
 }
 
 func (c *fakeClient) VectorForCorpi(ctx context.Context, corpi []string, overrides map[string]string) ([]float32, []txt2vecmodels.InterpretationSource, error) {
 	c.lastInput = corpi
+	c.lastOverrides = overrides
 	return []float32{0, 1, 2, 3}, nil, nil
 }
 
@@ -60,6 +60,39 @@ func (i *Inspector) GetWords(ctx context.Context, words string) (*models.C11yWor
 	}, nil
 }
 
+// Preview vectorizes the corpus with the keywords applied and returns the
+// nearest neighbors of the resulting vector, so keyword weights can be tuned
+// before they are set in the schema
+func (i *Inspector) Preview(ctx context.Context, corpus string,
+	keywords []Keyword,
+) (*KeywordsPreview, error) {
+	if strings.TrimSpace(corpus) == "" {
+		return nil, fmt.Errorf("invalid input: corpus cannot be empty")
+	}
+	for _, k := range keywords {
+		if err := k.validate(); err != nil {
+			return nil, fmt.Errorf("invalid input: %w", err)
+		}
+	}
+
+	vector, sources, err := i.client.VectorForCorpi(ctx,
+		[]string{camelCaseToLower(corpus)}, keywordOverrides(keywords))
+	if err != nil {
+		return nil, err
+	}
+
+	nearestNeighbors, err := i.nearestNeighbors(ctx, vector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeywordsPreview{
+		Vector:           vector,
+		NearestNeighbors: nearestNeighbors,
+		Source:           sourceFromInputElements(sources),
+	}, nil
+}
+
 func (i *Inspector) validateAndSplit(words string) ([]string, error) {
 	// set first character to lowercase
 	wordChars := []rune(words)
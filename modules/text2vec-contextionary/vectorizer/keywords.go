@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/weaviate/weaviate/entities/models"
+	txt2vecmodels "github.com/weaviate/weaviate/modules/text2vec-contextionary/additional/models"
+)
+
+// Keyword is a word with a weight, set as "keywords" in the moduleConfig of a
+// class or a property. The weight multiplies the weight the contextionary
+// gives the word when it builds the centroid, so a weight above 1 pulls the
+// vector towards the keyword and a weight below 1 pushes it away.
+type Keyword struct {
+	Keyword string  `json:"keyword"`
+	Weight  float32 `json:"weight"`
+}
+
+// KeywordsPreview is the result of vectorizing a corpus with keywords applied
+type KeywordsPreview struct {
+	Vector           []float32                             `json:"vector"`
+	NearestNeighbors []*models.C11yNearestNeighborsItems0  `json:"nearestNeighbors"`
+	Source           []*txt2vecmodels.InterpretationSource `json:"source"`
+}
+
+// ClassKeywords returns the keywords set on the class
+func (ic *classSettings) ClassKeywords() ([]Keyword, error) {
+	if ic.cfg == nil {
+		return nil, nil
+	}
+	return ic.parseKeywords(ic.GetSettings()["keywords"])
+}
+
+// PropertyKeywords returns the keywords set on the property
+func (ic *classSettings) PropertyKeywords(propName string) ([]Keyword, error) {
+	if ic.cfg == nil {
+		return nil, nil
+	}
+	return ic.parseKeywords(ic.cfg.Property(propName)["keywords"])
+}
+
+func (ic *classSettings) parseKeywords(in interface{}) ([]Keyword, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var items []interface{}
+	switch typed := in.(type) {
+	case []interface{}:
+		items = typed
+	case []map[string]interface{}:
+		for _, item := range typed {
+			items = append(items, item)
+		}
+	default:
+		return nil, fmt.Errorf("keywords must be an array, got %T", in)
+	}
+
+	keywords := make([]Keyword, len(items))
+	for i, item := range items {
+		asMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("keywords[%d] must be an object, got %T", i, item)
+		}
+		word, ok := asMap["keyword"].(string)
+		if !ok {
+			return nil, fmt.Errorf("keywords[%d].keyword must be a string", i)
+		}
+		weight, err := ic.GetNumber(asMap["weight"])
+		if err != nil {
+			return nil, fmt.Errorf("keywords[%d].weight: %w", i, err)
+		}
+		keywords[i] = Keyword{Keyword: word, Weight: weight}
+		if err := keywords[i].validate(); err != nil {
+			return nil, fmt.Errorf("keywords[%d]: %w", i, err)
+		}
+	}
+
+	return keywords, nil
+}
+
+func (k Keyword) validate() error {
+	if k.Keyword == "" {
+		return fmt.Errorf("keyword cannot be empty")
+	}
+	for _, r := range k.Keyword {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			return fmt.Errorf("keyword %q must be a single word of unicode letters and digits", k.Keyword)
+		}
+	}
+	if k.Weight <= 0 {
+		return fmt.Errorf("weight of keyword %q must be greater than 0, got %v", k.Keyword, k.Weight)
+	}
+	return nil
+}
+
+// keywordOverrides turns keywords into the weight overrides understood by the
+// contextionary. Later keywords win over earlier ones for the same word.
+func keywordOverrides(keywords ...[]Keyword) map[string]string {
+	var overrides map[string]string
+	for _, list := range keywords {
+		for _, k := range list {
+			if overrides == nil {
+				overrides = map[string]string{}
+			}
+			overrides[strings.ToLower(k.Keyword)] = fmt.Sprintf("w * %v", k.Weight)
+		}
+	}
+	return overrides
+}
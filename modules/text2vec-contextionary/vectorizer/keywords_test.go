@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/modules"
+)
+
+func keywordsClass(classKeywords, propKeywords interface{}) *models.Class {
+	return &models.Class{
+		Class: "Car",
+		ModuleConfig: map[string]interface{}{
+			"my-module": map[string]interface{}{
+				"keywords": classKeywords,
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:     "brand",
+				DataType: []string{"text"},
+				ModuleConfig: map[string]interface{}{
+					"my-module": map[string]interface{}{
+						"keywords": propKeywords,
+					},
+				},
+			},
+			{
+				Name:     "color",
+				DataType: []string{"text"},
+			},
+		},
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	classKeywords := []interface{}{
+		map[string]interface{}{"keyword": "vehicle", "weight": 2.0},
+		map[string]interface{}{"keyword": "brand", "weight": 0.5},
+	}
+	propKeywords := []interface{}{
+		map[string]interface{}{"keyword": "brand", "weight": 3.0},
+	}
+
+	t.Run("parsing", func(t *testing.T) {
+		class := keywordsClass(classKeywords, propKeywords)
+		ic := NewIndexChecker(modules.NewClassBasedModuleConfig(class, "my-module", "", ""))
+
+		keywords, err := ic.ClassKeywords()
+		require.Nil(t, err)
+		assert.Equal(t, []Keyword{{"vehicle", 2}, {"brand", 0.5}}, keywords)
+
+		keywords, err = ic.PropertyKeywords("brand")
+		require.Nil(t, err)
+		assert.Equal(t, []Keyword{{"brand", 3}}, keywords)
+
+		keywords, err = ic.PropertyKeywords("color")
+		require.Nil(t, err)
+		assert.Empty(t, keywords)
+	})
+
+	t.Run("invalid keywords", func(t *testing.T) {
+		for name, keywords := range map[string]interface{}{
+			"not an array":     "vehicle",
+			"not an object":    []interface{}{"vehicle"},
+			"missing keyword":  []interface{}{map[string]interface{}{"weight": 1.0}},
+			"multiple words":   []interface{}{map[string]interface{}{"keyword": "fast car", "weight": 1.0}},
+			"missing weight":   []interface{}{map[string]interface{}{"keyword": "vehicle"}},
+			"negative weight":  []interface{}{map[string]interface{}{"keyword": "vehicle", "weight": -1.0}},
+			"weight of 0":      []interface{}{map[string]interface{}{"keyword": "vehicle", "weight": 0.0}},
+			"non-number value": []interface{}{map[string]interface{}{"keyword": "vehicle", "weight": true}},
+		} {
+			class := keywordsClass(keywords, nil)
+			ic := NewIndexChecker(modules.NewClassBasedModuleConfig(class, "my-module", "", ""))
+			_, err := ic.ClassKeywords()
+			assert.NotNil(t, err, name)
+		}
+	})
+
+	t.Run("objects are vectorized with class and property keywords", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(client)
+		class := keywordsClass(classKeywords, propKeywords)
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "", "")
+
+		_, _, err := v.Object(context.Background(), &models.Object{
+			Class:      "Car",
+			Properties: map[string]interface{}{"brand": "Mercedes"},
+		}, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"vehicle": "w * 2",
+			"brand":   "w * 3",
+		}, client.lastOverrides)
+	})
+
+	t.Run("keywords of properties not set on the object are ignored", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(client)
+		class := keywordsClass(classKeywords, propKeywords)
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "", "")
+
+		_, _, err := v.Object(context.Background(), &models.Object{
+			Class:      "Car",
+			Properties: map[string]interface{}{"color": "red"},
+		}, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"vehicle": "w * 2",
+			"brand":   "w * 0.5",
+		}, client.lastOverrides)
+	})
+
+	t.Run("vector weights of the object win over keywords", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(client)
+		class := keywordsClass(classKeywords, propKeywords)
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "", "")
+
+		_, _, err := v.Object(context.Background(), &models.Object{
+			Class:         "Car",
+			Properties:    map[string]interface{}{"brand": "Mercedes"},
+			VectorWeights: map[string]string{"brand": "7"},
+		}, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"vehicle": "w * 2",
+			"brand":   "7",
+		}, client.lastOverrides)
+	})
+
+	t.Run("queries are vectorized with class keywords", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(client)
+		class := keywordsClass(classKeywords, propKeywords)
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "", "")
+
+		_, err := v.Texts(context.Background(), []string{"fast car"}, cfg)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"vehicle": "w * 2",
+			"brand":   "w * 0.5",
+		}, client.lastOverrides)
+	})
+
+	t.Run("keywords must be present in the contextionary", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		icheck := &fakeIndexChecker{vectorizeClassName: true, propertyIndexed: true}
+
+		class := keywordsClass(classKeywords, []interface{}{
+			map[string]interface{}{"keyword": "carrot", "weight": 2.0},
+		})
+		cfg := modules.NewClassBasedModuleConfig(class, "my-module", "", "")
+		err := NewConfigValidator(&fakeRemote{}, logger).Do(context.Background(), class, cfg, icheck)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "could not find the keyword 'carrot' in the contextionary")
+
+		class = keywordsClass(classKeywords, propKeywords)
+		cfg = modules.NewClassBasedModuleConfig(class, "my-module", "", "")
+		err = NewConfigValidator(&fakeRemote{}, logger).Do(context.Background(), class, cfg, icheck)
+		assert.Nil(t, err)
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		client := &fakeClient{}
+		i := NewInspector(client)
+
+		res, err := i.Preview(context.Background(), "FastCar", []Keyword{{"vehicle", 2}})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"fast car"}, client.lastInput)
+		assert.Equal(t, map[string]string{"vehicle": "w * 2"}, client.lastOverrides)
+		assert.Equal(t, []float32{0, 1, 2, 3}, res.Vector)
+		require.Len(t, res.NearestNeighbors, 2)
+		assert.Equal(t, "word1", res.NearestNeighbors[0].Word)
+
+		_, err = i.Preview(context.Background(), "", nil)
+		assert.NotNil(t, err)
+		_, err = i.Preview(context.Background(), "car", []Keyword{{"vehicle", 0}})
+		assert.NotNil(t, err)
+	})
+}
@@ -70,6 +70,10 @@ func (cv *ConfigValidator) Do(ctx context.Context, class *models.Class,
 		return errors.Wrap(err, "invalid combination of properties")
 	}
 
+	if err := cv.validateKeywords(ctx, class, cfg); err != nil {
+		return errors.Wrapf(err, "class %q: invalid keywords", class.Class)
+	}
+
 	cv.checkForPossibilityOfDuplicateVectors(ctx, class, icheck)
 
 	return nil
@@ -162,6 +166,51 @@ func (cv *ConfigValidator) validatePropertyName(ctx context.Context,
 	return nil
 }
 
+// validateKeywords makes sure every keyword of the class and its properties is
+// known to the contextionary, otherwise its weight would silently be ignored
+func (cv *ConfigValidator) validateKeywords(ctx context.Context,
+	class *models.Class, cfg moduletools.ClassConfig,
+) error {
+	settings := NewIndexChecker(cfg)
+	keywords, err := settings.ClassKeywords()
+	if err != nil {
+		return err
+	}
+	if err := cv.validateKeywordPresence(ctx, keywords); err != nil {
+		return err
+	}
+
+	for _, prop := range class.Properties {
+		keywords, err := settings.PropertyKeywords(prop.Name)
+		if err != nil {
+			return fmt.Errorf("property %q: %w", prop.Name, err)
+		}
+		if err := cv.validateKeywordPresence(ctx, keywords); err != nil {
+			return fmt.Errorf("property %q: %w", prop.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (cv *ConfigValidator) validateKeywordPresence(ctx context.Context,
+	keywords []Keyword,
+) error {
+	for _, k := range keywords {
+		present, err := cv.remote.IsWordPresent(ctx, strings.ToLower(k.Keyword))
+		if err != nil {
+			return fmt.Errorf("check word presence: %v", err)
+		}
+
+		if !present {
+			return fmt.Errorf("could not find the keyword '%s' in the contextionary",
+				k.Keyword)
+		}
+	}
+
+	return nil
+}
+
 func (cv *ConfigValidator) validateIndexState(ctx context.Context,
 	class *models.Class, icheck IndexChecker,
 ) error {
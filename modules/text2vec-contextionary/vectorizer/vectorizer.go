@@ -18,6 +18,7 @@ package vectorizer
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/fatih/camelcase"
@@ -65,18 +66,24 @@ func New(client client) *Vectorizer {
 	}
 }
 
+// Texts vectorizes query inputs, the keywords of the class are applied
 func (v *Vectorizer) Texts(ctx context.Context, inputs []string,
 	cfg moduletools.ClassConfig,
 ) ([]float32, error) {
-	return v.Corpi(ctx, inputs)
+	keywords, err := NewIndexChecker(cfg).ClassKeywords()
+	if err != nil {
+		return nil, err
+	}
+
+	return v.corpi(ctx, inputs, keywordOverrides(keywords))
 }
 
 // Object object to vector
 func (v *Vectorizer) Object(ctx context.Context, object *models.Object, cfg moduletools.ClassConfig,
 ) ([]float32, models.AdditionalProperties, error) {
-	var overrides map[string]string
-	if object.VectorWeights != nil {
-		overrides = object.VectorWeights.(map[string]string)
+	overrides, err := v.objectOverrides(object, cfg)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	vec, sources, err := v.object(ctx, object, overrides, cfg)
@@ -92,6 +99,49 @@ func (v *Vectorizer) Object(ctx context.Context, object *models.Object, cfg modu
 	return vec, additional, nil
 }
 
+// objectOverrides combines the keywords of the class and of the indexed
+// properties set on the object. Property keywords win over class keywords and
+// the vector weights of the object win over both.
+func (v *Vectorizer) objectOverrides(object *models.Object, cfg moduletools.ClassConfig,
+) (map[string]string, error) {
+	icheck := NewIndexChecker(cfg)
+	classKeywords, err := icheck.ClassKeywords()
+	if err != nil {
+		return nil, err
+	}
+	keywords := [][]Keyword{classKeywords}
+
+	if props, ok := object.Properties.(map[string]interface{}); ok {
+		names := make([]string, 0, len(props))
+		for name := range props {
+			if icheck.PropertyIndexed(name) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			propKeywords, err := icheck.PropertyKeywords(name)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: %w", name, err)
+			}
+			keywords = append(keywords, propKeywords)
+		}
+	}
+
+	overrides := keywordOverrides(keywords...)
+	if object.VectorWeights != nil {
+		weights := object.VectorWeights.(map[string]string)
+		if overrides == nil {
+			return weights, nil
+		}
+		for word, weight := range weights {
+			overrides[word] = weight
+		}
+	}
+
+	return overrides, nil
+}
+
 func (v *Vectorizer) object(ctx context.Context, object *models.Object, overrides map[string]string,
 	cfg moduletools.ClassConfig,
 ) ([]float32, []txt2vecmodels.InterpretationSource, error) {
@@ -134,6 +184,12 @@ func (v *Vectorizer) object(ctx context.Context, object *models.Object, override
 
 // Corpi takes any list of strings and builds a common vector for all of them
 func (v *Vectorizer) Corpi(ctx context.Context, corpi []string,
+) ([]float32, error) {
+	return v.corpi(ctx, corpi, nil)
+}
+
+func (v *Vectorizer) corpi(ctx context.Context, corpi []string,
+	overrides map[string]string,
 ) ([]float32, error) {
 	// can be written to concurrently if multiple named vectors are used
 	corpiTmp := make([]string, len(corpi))
@@ -141,7 +197,7 @@ func (v *Vectorizer) Corpi(ctx context.Context, corpi []string,
 		corpiTmp[i] = camelCaseToLower(corpus)
 	}
 
-	vector, _, err := v.client.VectorForCorpi(ctx, corpiTmp, nil)
+	vector, _, err := v.client.VectorForCorpi(ctx, corpiTmp, overrides)
 	if err != nil {
 		return nil, fmt.Errorf("vectorizing corpus '%+v': %v", corpiTmp, err)
 	}
@@ -45,6 +45,11 @@ type GPT4AllModule struct {
 	nearTextTransformer          modulecapabilities.TextTransform
 	logger                       logrus.FieldLogger
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	readyChecker                 readyChecker
+}
+
+type readyChecker interface {
+	CheckReady(ctx context.Context) error
 }
 
 func (m *GPT4AllModule) Name() string {
@@ -104,6 +109,7 @@ func (m *GPT4AllModule) initVectorizer(ctx context.Context, timeout time.Duratio
 
 	m.vectorizer = vectorizer.New(client)
 	m.metaProvider = client
+	m.readyChecker = client
 
 	return nil
 }
@@ -132,6 +138,10 @@ func (m *GPT4AllModule) MetaInfo() (map[string]interface{}, error) {
 	return m.metaProvider.MetaInfo()
 }
 
+func (m *GPT4AllModule) CheckHealth(ctx context.Context) error {
+	return m.readyChecker.CheckReady(ctx)
+}
+
 func (m *GPT4AllModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
@@ -151,4 +161,5 @@ var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.Vectorizer[[]float32](New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
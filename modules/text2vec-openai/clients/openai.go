@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/moduletools"
@@ -155,10 +156,12 @@ func (v *client) vectorize(ctx context.Context, input []string, model string, co
 		return nil, nil, 0, errors.Wrap(err, "create POST request")
 	}
 	apiKey, err := v.getApiKey(ctx, config.IsAzure)
-	if err != nil {
+	if err != nil && requiresApiKey(v.getBaseURL(ctx, config), config.IsAzure) {
 		return nil, nil, 0, errors.Wrap(err, "API Key")
 	}
-	req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, config.IsAzure))
+	if apiKey != "" {
+		req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, config.IsAzure))
+	}
 	if openAIOrganization := v.getOpenAIOrganization(ctx); openAIOrganization != "" {
 		req.Header.Add("OpenAI-Organization", openAIOrganization)
 	}
@@ -206,11 +209,7 @@ func (v *client) vectorize(ctx context.Context, input []string, model string, co
 }
 
 func (v *client) buildURL(ctx context.Context, config ent.VectorizationConfig) (string, error) {
-	baseURL, resourceName, deploymentID, apiVersion, isAzure := config.BaseURL, config.ResourceName, config.DeploymentID, config.ApiVersion, config.IsAzure
-
-	if headerBaseURL := modulecomponents.GetValueFromContext(ctx, "X-Openai-Baseurl"); headerBaseURL != "" {
-		baseURL = headerBaseURL
-	}
+	baseURL, resourceName, deploymentID, apiVersion, isAzure := v.getBaseURL(ctx, config), config.ResourceName, config.DeploymentID, config.ApiVersion, config.IsAzure
 
 	if headerDeploymentID := modulecomponents.GetValueFromContext(ctx, "X-Azure-Deployment-Id"); headerDeploymentID != "" {
 		deploymentID = headerDeploymentID
@@ -223,6 +222,20 @@ func (v *client) buildURL(ctx context.Context, config ent.VectorizationConfig) (
 	return v.buildUrlFn(baseURL, resourceName, deploymentID, apiVersion, isAzure)
 }
 
+func (v *client) getBaseURL(ctx context.Context, config ent.VectorizationConfig) string {
+	if headerBaseURL := modulecomponents.GetValueFromContext(ctx, "X-Openai-Baseurl"); headerBaseURL != "" {
+		return headerBaseURL
+	}
+	return config.BaseURL
+}
+
+// requiresApiKey reports whether the base URL is hosted by OpenAI or Azure.
+// OpenAI-compatible endpoints, e.g. a local inference server in an air-gapped
+// deployment, are called without authentication if no key is configured.
+func requiresApiKey(baseURL string, isAzure bool) bool {
+	return isAzure || strings.Contains(baseURL, "api.openai.com")
+}
+
 func (v *client) getError(statusCode int, requestID string, resBodyError *openAIApiError, isAzure bool) error {
 	endpoint := "OpenAI API"
 	if isAzure {
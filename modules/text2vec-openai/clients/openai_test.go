@@ -250,6 +250,22 @@ func TestClient(t *testing.T) {
 			"nor in environment variable under OPENAI_APIKEY")
 	})
 
+	t.Run("when OpenAI key is empty and an OpenAI-compatible endpoint is used", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("Authorization"))
+			handler.ServeHTTP(w, r)
+		}))
+		defer server.Close()
+		c := New("", "", "", 0, nullLogger())
+
+		res, _, _, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			fakeClassConfig{classConfig: map[string]interface{}{"Type": "text", "Model": "ada", "baseURL": server.URL}})
+
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, res.Vector)
+	})
+
 	t.Run("when X-OpenAI-BaseURL header is passed", func(t *testing.T) {
 		c := New("", "", "", 0, nullLogger())
 
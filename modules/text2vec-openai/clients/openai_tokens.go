@@ -24,6 +24,11 @@ func GetTokensCount(model string, input string, tke *tiktoken.Tiktoken) int {
 	}
 
 	tokensCount := tokensPerMessage
+	if tke == nil {
+		// no encoding could be loaded, e.g. in air-gapped deployments, so the
+		// count is estimated with OpenAI's rule of thumb of 4 characters per token
+		return tokensCount + (len(input)+3)/4
+	}
 	tokensCount += len(tke.Encode(input, nil, nil))
 	return tokensCount
 }
@@ -65,3 +65,8 @@ func Test_getTokensCount(t *testing.T) {
 		})
 	}
 }
+
+func Test_getTokensCountWithoutEncoding(t *testing.T) {
+	assert.Equal(t, 3+4, GetTokensCount("llama3", "sixteen chars!!!", nil))
+	assert.Equal(t, 3+5, GetTokensCount("llama3", "seventeen chars!!", nil))
+}
@@ -27,14 +27,7 @@ import (
 func (v *vectorizer) WaitForStartup(initCtx context.Context,
 	interval time.Duration,
 ) error {
-	endpoints := map[string]string{}
-	if v.originPassage != v.originQuery {
-		endpoints["passage"] = v.urlPassage("/.well-known/ready", ent.VectorizationConfig{})
-		endpoints["query"] = v.urlQuery("/.well-known/ready", ent.VectorizationConfig{})
-	} else {
-		endpoints[""] = v.urlPassage("/.well-known/ready", ent.VectorizationConfig{})
-	}
-
+	endpoints := v.readyEndpoints()
 	ch := make(chan error, len(endpoints))
 	var wg sync.WaitGroup
 	for serviceName, endpoint := range endpoints {
@@ -60,6 +53,31 @@ func (v *vectorizer) WaitForStartup(initCtx context.Context,
 	return nil
 }
 
+// CheckReady checks once whether the remote inference services are ready
+func (v *vectorizer) CheckReady(ctx context.Context) error {
+	for serviceName, endpoint := range v.readyEndpoints() {
+		if err := v.checkReady(ctx, endpoint, serviceName); err != nil {
+			if serviceName != "" {
+				return errors.Wrapf(err, "[%s]", serviceName)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// readyEndpoints returns the ready endpoints by service name, the name is
+// empty if passages and queries are vectorized by the same service
+func (v *vectorizer) readyEndpoints() map[string]string {
+	if v.originPassage != v.originQuery {
+		return map[string]string{
+			"passage": v.urlPassage("/.well-known/ready", ent.VectorizationConfig{}),
+			"query":   v.urlQuery("/.well-known/ready", ent.VectorizationConfig{}),
+		}
+	}
+	return map[string]string{"": v.urlPassage("/.well-known/ready", ent.VectorizationConfig{})}
+}
+
 func (v *vectorizer) waitFor(initCtx context.Context, interval time.Duration, endpoint string, serviceName string) error {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
@@ -169,6 +169,32 @@ func TestWaitForStartup(t *testing.T) {
 	})
 }
 
+func TestCheckReady(t *testing.T) {
+	t.Run("when common server is ready", func(t *testing.T) {
+		server := httptest.NewServer(&testReadyHandler{t: t})
+		defer server.Close()
+		v := New(server.URL, server.URL, 0, nullLogger())
+
+		assert.Nil(t, v.CheckReady(context.Background()))
+	})
+
+	t.Run("when query server is not ready", func(t *testing.T) {
+		serverPassage := httptest.NewServer(&testReadyHandler{t: t})
+		serverQuery := httptest.NewServer(&testReadyHandler{
+			t:         t,
+			readyTime: time.Now().Add(time.Hour),
+		})
+		defer serverPassage.Close()
+		defer serverQuery.Close()
+		v := New(serverPassage.URL, serverQuery.URL, 0, nullLogger())
+		err := v.CheckReady(context.Background())
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "[query]")
+		assert.Contains(t, err.Error(), "not ready: status 503")
+	})
+}
+
 type testReadyHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
@@ -43,6 +43,11 @@ type TransformersModule struct {
 	nearTextTransformer          modulecapabilities.TextTransform
 	logger                       logrus.FieldLogger
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	readyChecker                 readyChecker
+}
+
+type readyChecker interface {
+	CheckReady(ctx context.Context) error
 }
 
 func (m *TransformersModule) Name() string {
@@ -120,6 +125,7 @@ func (m *TransformersModule) initVectorizer(ctx context.Context, timeout time.Du
 
 	m.vectorizer = vectorizer.New(client)
 	m.metaProvider = client
+	m.readyChecker = client
 
 	return nil
 }
@@ -166,6 +172,10 @@ func (m *TransformersModule) MetaInfo() (map[string]interface{}, error) {
 	return m.metaProvider.MetaInfo()
 }
 
+func (m *TransformersModule) CheckHealth(ctx context.Context) error {
+	return m.readyChecker.CheckReady(ctx)
+}
+
 func (m *TransformersModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
 	return m.additionalPropertiesProvider.AdditionalProperties()
 }
@@ -185,4 +195,5 @@ var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.Vectorizer[[]float32](New())
 	_ = modulecapabilities.MetaProvider(New())
+	_ = modulecapabilities.ModuleHasHealthCheck(New())
 )
@@ -15,6 +15,8 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"fmt"
+	"strings"
+	"sync"
 
 	errors "github.com/go-openapi/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -22,15 +24,24 @@ import (
 )
 
 type Client struct {
-	config     config.APIKey
+	config config.APIKey
+	// cluster replicates rotations and revocations to all nodes
+	cluster Cluster
+
+	sync.RWMutex
+	// ids are derived from the position of the configured keys, so they
+	// reveal nothing about the keys and stay the same when a key is rotated.
+	// keystorage holds the hash of the current token of each key
+	ids        []string
 	keystorage [][sha256.Size]byte
+	revoked    map[[sha256.Size]byte]struct{}
 }
 
 func New(cfg config.Config) (*Client, error) {
 	c := &Client{
-		config: cfg.Authentication.APIKey,
+		config:  cfg.Authentication.APIKey,
+		revoked: map[[sha256.Size]byte]struct{}{},
 	}
-
 	if err := c.validateConfig(); err != nil {
 		return nil, fmt.Errorf("invalid apikey config: %w", err)
 	}
@@ -41,12 +52,18 @@ func New(cfg config.Config) (*Client, error) {
 }
 
 func (c *Client) parseKeys() {
+	c.ids = make([]string, len(c.config.AllowedKeys))
 	c.keystorage = make([][sha256.Size]byte, len(c.config.AllowedKeys))
 	for i, rawKey := range c.config.AllowedKeys {
 		c.keystorage[i] = sha256.Sum256([]byte(rawKey))
+		c.ids[i] = keyID(i)
 	}
 }
 
+func keyID(pos int) string {
+	return fmt.Sprintf("key-%d", pos)
+}
+
 func (c *Client) validateConfig() error {
 	if !c.config.Enabled {
 		// don't validate if this scheme isn't used
@@ -77,6 +94,10 @@ func (c *Client) validateConfig() error {
 		return fmt.Errorf("length of users and keys must match, alternatively provide single user for all keys")
 	}
 
+	if len(c.config.Collections) > 0 && len(c.config.Collections) != len(c.config.AllowedKeys) {
+		return fmt.Errorf("length of collections and keys must match, use \"*\" for keys which are not limited to collections")
+	}
+
 	return nil
 }
 
@@ -91,13 +112,21 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 	}
 
 	return &models.Principal{
-		Username: c.getUser(tokenPos),
+		Username:    c.getUser(tokenPos),
+		Collections: c.getCollections(tokenPos),
 	}, nil
 }
 
 func (c *Client) isTokenAllowed(token string) (int, bool) {
 	tokenHash := sha256.Sum256([]byte(token))
 
+	c.RLock()
+	defer c.RUnlock()
+
+	if _, ok := c.revoked[tokenHash]; ok {
+		return -1, false
+	}
+
 	for i, allowed := range c.keystorage {
 		if subtle.ConstantTimeCompare(tokenHash[:], allowed[:]) == 1 {
 			return i, true
@@ -115,3 +144,23 @@ func (c *Client) getUser(pos int) string {
 
 	return c.config.Users[pos]
 }
+
+// getCollections returns the collections the key at pos is limited to, nil if
+// it is not limited
+func (c *Client) getCollections(pos int) []string {
+	if pos >= len(c.config.Collections) {
+		return nil
+	}
+
+	var collections []string
+	for _, collection := range c.config.Collections[pos] {
+		collection = strings.TrimSpace(collection)
+		if collection == "*" {
+			return nil
+		}
+		if collection != "" {
+			collections = append(collections, collection)
+		}
+	}
+	return collections
+}
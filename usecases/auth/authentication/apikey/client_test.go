@@ -153,6 +153,42 @@ func Test_APIKeyClient(t *testing.T) {
 				require.NotNil(t, err)
 			},
 		},
+		{
+			name: "keys limited to collections",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"secret-key", "another-secret-key", "third-key"},
+				Users:       []string{"jane"},
+				Collections: [][]string{{"Books", "Movies"}, {"*"}, {}},
+			},
+			expectConfigErr: false,
+			validate: func(t *testing.T, c *Client) {
+				p, err := c.ValidateAndExtract("secret-key", nil)
+				require.Nil(t, err)
+				assert.Equal(t, "jane", p.Username)
+				assert.Equal(t, []string{"Books", "Movies"}, p.Collections)
+
+				p, err = c.ValidateAndExtract("another-secret-key", nil)
+				require.Nil(t, err)
+				assert.Nil(t, p.Collections)
+
+				p, err = c.ValidateAndExtract("third-key", nil)
+				require.Nil(t, err)
+				assert.Nil(t, p.Collections)
+			},
+		},
+		{
+			// this is invalid, the collections cannot be mapped to the keys
+			name: "collections for some keys only",
+			config: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"secret-key", "another-secret-key"},
+				Users:       []string{"jane"},
+				Collections: [][]string{{"Books"}},
+			},
+			expectConfigErr:    true,
+			expectConfigErrMsg: "length of collections and keys must match",
+		},
 		{
 			// this is invalid, the keys cannot be mapped to the users
 			name: "2 users, 3 keys",
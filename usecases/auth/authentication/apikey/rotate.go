@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	ErrNotEnabled = errors.New("apikey auth is not enabled")
+	ErrNotFound   = errors.New("api key not found")
+)
+
+// Cluster replicates rotations and revocations through RAFT. Every node
+// applies them through ApplyRotateKey and ApplyRevokeKey.
+type Cluster interface {
+	RotateKey(ctx context.Context, id, hash string) error
+	RevokeKey(ctx context.Context, id string) error
+}
+
+// keyState is the replicated part of the client. Only hashes are stored, the
+// tokens themselves never leave the node which issued them.
+type keyState struct {
+	// Keys maps the id of every rotated key to the hash of its current token
+	Keys    map[string]string `json:"keys"`
+	Revoked []string          `json:"revoked"`
+}
+
+func (c *Client) SetCluster(cluster Cluster) {
+	c.cluster = cluster
+}
+
+// Rotate issues a new token for the key with the given id and revokes the
+// current one. The key keeps its user, so everything attached to the user,
+// e.g. its roles, stays intact. The new token is only returned here.
+func (c *Client) Rotate(ctx context.Context, id string) (*models.APIKey, error) {
+	pos, err := c.checkKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	sum := sha256.Sum256([]byte(token))
+
+	if err := c.cluster.RotateKey(ctx, id, hex.EncodeToString(sum[:])); err != nil {
+		return nil, err
+	}
+	return c.key(pos, token, false), nil
+}
+
+// Revoke adds the current token of the key with the given id to the
+// revocation list. The key can be used again after it has been rotated.
+func (c *Client) Revoke(ctx context.Context, id string) (*models.APIKey, error) {
+	pos, err := c.checkKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cluster.RevokeKey(ctx, id); err != nil {
+		return nil, err
+	}
+	return c.key(pos, "", true), nil
+}
+
+// checkKey returns the position of the key with the given id. The ids are
+// derived from the configuration and never change, so no lock is needed.
+func (c *Client) checkKey(id string) (int, error) {
+	if !c.config.Enabled {
+		return -1, ErrNotEnabled
+	}
+	if c.cluster == nil {
+		return -1, fmt.Errorf("apikey: cluster is not set")
+	}
+	pos := slices.Index(c.ids, id)
+	if pos < 0 {
+		return -1, ErrNotFound
+	}
+	return pos, nil
+}
+
+func (c *Client) key(pos int, token string, revoked bool) *models.APIKey {
+	id, user := c.ids[pos], c.getUser(pos)
+	return &models.APIKey{
+		ID:      &id,
+		User:    &user,
+		Key:     token,
+		Revoked: revoked,
+	}
+}
+
+// ApplyRotateKey replaces the current token of the key with the given id by
+// the token with the given hash and revokes the current one
+func (c *Client) ApplyRotateKey(id, hash string) error {
+	sum, err := decodeHash(hash)
+	if err != nil {
+		return fmt.Errorf("key %s: %w", id, err)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	pos := slices.Index(c.ids, id)
+	if pos < 0 {
+		return ErrNotFound
+	}
+	c.revoked[c.keystorage[pos]] = struct{}{}
+	c.keystorage[pos] = sum
+	return nil
+}
+
+// ApplyRevokeKey revokes the current token of the key with the given id
+func (c *Client) ApplyRevokeKey(id string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	pos := slices.Index(c.ids, id)
+	if pos < 0 {
+		return ErrNotFound
+	}
+	c.revoked[c.keystorage[pos]] = struct{}{}
+	return nil
+}
+
+// Snapshot returns the rotated and revoked keys
+func (c *Client) Snapshot() ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	state := keyState{Keys: map[string]string{}}
+	for pos, id := range c.ids {
+		if sum := sha256.Sum256([]byte(c.config.AllowedKeys[pos])); sum != c.keystorage[pos] {
+			state.Keys[id] = hex.EncodeToString(c.keystorage[pos][:])
+		}
+	}
+	for sum := range c.revoked {
+		state.Revoked = append(state.Revoked, hex.EncodeToString(sum[:]))
+	}
+	slices.Sort(state.Revoked)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("marshal apikeys: %w", err)
+	}
+	return data, nil
+}
+
+// Restore replaces the rotated and revoked keys by the ones of the snapshot,
+// empty data resets all keys to the configured ones
+func (c *Client) Restore(data []byte) error {
+	var state keyState
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &state); err != nil {
+			return fmt.Errorf("unmarshal apikeys: %w", err)
+		}
+	}
+
+	keystorage := make([][sha256.Size]byte, len(c.config.AllowedKeys))
+	for i, rawKey := range c.config.AllowedKeys {
+		keystorage[i] = sha256.Sum256([]byte(rawKey))
+	}
+	for id, hash := range state.Keys {
+		// keys which have been removed from the config are ignored
+		pos := slices.Index(c.ids, id)
+		if pos < 0 {
+			continue
+		}
+		sum, err := decodeHash(hash)
+		if err != nil {
+			return fmt.Errorf("key %s: %w", id, err)
+		}
+		keystorage[pos] = sum
+	}
+	revoked := make(map[[sha256.Size]byte]struct{}, len(state.Revoked))
+	for _, hash := range state.Revoked {
+		sum, err := decodeHash(hash)
+		if err != nil {
+			return fmt.Errorf("revoked key: %w", err)
+		}
+		revoked[sum] = struct{}{}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.keystorage, c.revoked = keystorage, revoked
+	return nil
+}
+
+func decodeHash(hash string) (sum [sha256.Size]byte, err error) {
+	b, err := hex.DecodeString(hash)
+	if err != nil {
+		return sum, err
+	}
+	if len(b) != sha256.Size {
+		return sum, fmt.Errorf("invalid hash length %d", len(b))
+	}
+	copy(sum[:], b)
+	return sum, nil
+}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// fakeCluster applies changes right away, like a single node cluster
+type fakeCluster struct {
+	c *Client
+}
+
+func (f fakeCluster) RotateKey(_ context.Context, id, hash string) error {
+	return f.c.ApplyRotateKey(id, hash)
+}
+
+func (f fakeCluster) RevokeKey(_ context.Context, id string) error {
+	return f.c.ApplyRevokeKey(id)
+}
+
+func Test_APIKeyClient_RotateRevoke(t *testing.T) {
+	ctx := context.Background()
+	newClient := func(t *testing.T) *Client {
+		c, err := New(config.Config{
+			Authentication: config.Authentication{
+				APIKey: config.APIKey{
+					Enabled:     true,
+					AllowedKeys: []string{"secret-key", "another-secret-key"},
+					Users:       []string{"jane", "jessica"},
+				},
+			},
+		})
+		require.Nil(t, err)
+		c.SetCluster(fakeCluster{c})
+		return c
+	}
+
+	c := newClient(t)
+	// ids must not be derived from the keys themselves
+	require.Equal(t, []string{"key-0", "key-1"}, c.ids)
+	id := c.ids[0]
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := c.Rotate(ctx, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = c.Revoke(ctx, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	var token string
+	t.Run("rotate", func(t *testing.T) {
+		key, err := c.Rotate(ctx, id)
+		require.Nil(t, err)
+		assert.Equal(t, id, *key.ID)
+		assert.Equal(t, "jane", *key.User)
+		assert.False(t, key.Revoked)
+		require.NotEmpty(t, key.Key)
+		token = key.Key
+
+		_, err = c.ValidateAndExtract("secret-key", nil)
+		assert.NotNil(t, err)
+		p, err := c.ValidateAndExtract(token, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "jane", p.Username)
+
+		// the other key is not affected
+		p, err = c.ValidateAndExtract("another-secret-key", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "jessica", p.Username)
+	})
+
+	t.Run("snapshot and restore", func(t *testing.T) {
+		snap, err := c.Snapshot()
+		require.Nil(t, err)
+		assert.NotContains(t, string(snap), token)
+
+		restored := newClient(t)
+		require.Nil(t, restored.Restore(snap))
+		_, err = restored.ValidateAndExtract("secret-key", nil)
+		assert.NotNil(t, err)
+		p, err := restored.ValidateAndExtract(token, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "jane", p.Username)
+
+		// an empty snapshot resets the keys to the configured ones
+		require.Nil(t, restored.Restore(nil))
+		_, err = restored.ValidateAndExtract(token, nil)
+		assert.NotNil(t, err)
+		_, err = restored.ValidateAndExtract("secret-key", nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		key, err := c.Revoke(ctx, id)
+		require.Nil(t, err)
+		assert.True(t, key.Revoked)
+		assert.Empty(t, key.Key)
+
+		_, err = c.ValidateAndExtract(token, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("rotate a revoked key", func(t *testing.T) {
+		key, err := c.Rotate(ctx, id)
+		require.Nil(t, err)
+		assert.False(t, key.Revoked)
+
+		p, err := c.ValidateAndExtract(key.Key, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "jane", p.Username)
+	})
+
+	t.Run("not enabled", func(t *testing.T) {
+		c, err := New(config.Config{})
+		require.Nil(t, err)
+		c.SetCluster(fakeCluster{c})
+		_, err = c.Rotate(ctx, id)
+		assert.ErrorIs(t, err, ErrNotEnabled)
+		_, err = c.Revoke(ctx, id)
+		assert.ErrorIs(t, err, ErrNotEnabled)
+	})
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import "fmt"
+
+// Config enables role based access control. Admins have full access and can
+// manage the roles of everyone else, who only has the permissions of the
+// roles assigned to them
+type Config struct {
+	Enabled bool     `json:"enabled" yaml:"enabled"`
+	Admins  []string `json:"admins" yaml:"admins"`
+}
+
+// Validate rbac config for viability, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if len(c.Admins) == 0 {
+		return fmt.Errorf("rbac: at least one admin is required to manage roles")
+	}
+
+	return nil
+}
@@ -0,0 +1,515 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const anonymousPrincipalUsername = "anonymous"
+
+var (
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrRoleExists        = errors.New("role already exists")
+	ErrInvalidPermission = errors.New("invalid permission")
+)
+
+// Cluster commits role changes through RAFT. They are applied on every node
+// through the Apply methods of the Manager.
+type Cluster interface {
+	CreateRole(ctx context.Context, role *models.Role) error
+	DeleteRole(ctx context.Context, name string) error
+	AddPermissions(ctx context.Context, role string, perms []*models.Permission) error
+	RemovePermissions(ctx context.Context, role string, perms []*models.Permission) error
+	AssignRoles(ctx context.Context, user string, roles []string) error
+	RevokeRoles(ctx context.Context, user string, roles []string) error
+}
+
+// Manager stores roles, the permissions they grant and the users they are
+// assigned to. It authorizes every request based on the roles of the
+// principal. Users are identified by their name, so for API keys roles are
+// assigned to the user the key belongs to.
+//
+// Changes are validated against the local state and committed through RAFT,
+// the state is part of the RAFT snapshots. Changes are therefore applied on
+// every node, even if rbac is not enabled on all of them.
+type Manager struct {
+	sync.RWMutex
+	enabled bool
+	admins  map[string]struct{}
+	cluster Cluster
+
+	roles    map[string][]*models.Permission
+	policies map[string][]policy
+	users    map[string][]string
+}
+
+// roleState is the state of the Manager as it's stored in snapshots
+type roleState struct {
+	Roles map[string][]*models.Permission `json:"roles"`
+	Users map[string][]string             `json:"users"`
+}
+
+// New Manager without any roles. They are restored from the RAFT snapshot
+// and log once the cluster is set.
+func New(cfg Config) *Manager {
+	m := &Manager{
+		enabled:  cfg.Enabled,
+		admins:   map[string]struct{}{},
+		roles:    map[string][]*models.Permission{},
+		policies: map[string][]policy{},
+		users:    map[string][]string{},
+	}
+	for _, admin := range cfg.Admins {
+		m.admins[admin] = struct{}{}
+	}
+	return m
+}
+
+// SetCluster sets the cluster role changes are committed to
+func (m *Manager) SetCluster(cluster Cluster) {
+	m.cluster = cluster
+}
+
+// Enabled reports whether rbac is enabled on this node
+func (m *Manager) Enabled() bool {
+	return m.enabled
+}
+
+// Authorize allows admins to access any resource. Everyone else needs a role
+// that allows the verb on every requested resource.
+func (m *Manager) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	if principal == nil {
+		principal = &models.Principal{Username: anonymousPrincipalUsername}
+	}
+
+	if _, ok := m.admins[principal.Username]; ok {
+		return nil
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, resource := range resources {
+		if !m.allows(principal.Username, verb, resource) {
+			return autherrs.NewForbidden(principal, verb, resources...)
+		}
+	}
+	return nil
+}
+
+// AuthorizeGrant makes sure the principal holds all the permissions it wants
+// to grant, so nobody can grant more than they are allowed themselves.
+// Admins may grant everything.
+func (m *Manager) AuthorizeGrant(principal *models.Principal, perms []*models.Permission) error {
+	if principal == nil {
+		principal = &models.Principal{Username: anonymousPrincipalUsername}
+	}
+
+	if _, ok := m.admins[principal.Username]; ok {
+		return nil
+	}
+
+	pols, err := policiesOf(perms)
+	if err != nil {
+		return err
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, p := range pols {
+		if !m.covers(principal.Username, p) {
+			return autherrs.NewForbidden(principal, p.verb, p.pattern)
+		}
+	}
+	return nil
+}
+
+// AuthorizeAssign makes sure the principal holds all the permissions of the
+// roles it wants to assign, see AuthorizeGrant
+func (m *Manager) AuthorizeAssign(principal *models.Principal, roles ...string) error {
+	var perms []*models.Permission
+	for _, role := range m.GetRoles(roles...) {
+		perms = append(perms, role.Permissions...)
+	}
+	return m.AuthorizeGrant(principal, perms)
+}
+
+func (m *Manager) allows(user, verb, resource string) bool {
+	for _, role := range m.users[user] {
+		for _, p := range m.policies[role] {
+			if p.allows(verb, resource) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *Manager) covers(user string, other policy) bool {
+	for _, role := range m.users[user] {
+		for _, p := range m.policies[role] {
+			if p.covers(other) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CreateRole with the given permissions
+func (m *Manager) CreateRole(ctx context.Context, role *models.Role) error {
+	if err := validateRole(role); err != nil {
+		return err
+	}
+	if _, err := policiesOf(role.Permissions); err != nil {
+		return err
+	}
+
+	m.RLock()
+	_, exists := m.roles[*role.Name]
+	m.RUnlock()
+	if exists {
+		return fmt.Errorf("%w: %s", ErrRoleExists, *role.Name)
+	}
+	if err := m.checkCluster(); err != nil {
+		return err
+	}
+	return m.cluster.CreateRole(ctx, role)
+}
+
+// DeleteRole and all its assignments. Deleting a role which does not exist
+// is not an error.
+func (m *Manager) DeleteRole(ctx context.Context, name string) error {
+	m.RLock()
+	_, exists := m.roles[name]
+	m.RUnlock()
+	if !exists {
+		return nil
+	}
+	if err := m.checkCluster(); err != nil {
+		return err
+	}
+	return m.cluster.DeleteRole(ctx, name)
+}
+
+// AddPermissions to an existing role, permissions the role already has are
+// skipped
+func (m *Manager) AddPermissions(ctx context.Context, name string, perms []*models.Permission) error {
+	if _, err := policiesOf(perms); err != nil {
+		return err
+	}
+	if err := m.checkRoles(name); err != nil {
+		return err
+	}
+	return m.cluster.AddPermissions(ctx, name, perms)
+}
+
+// RemovePermissions from an existing role
+func (m *Manager) RemovePermissions(ctx context.Context, name string, perms []*models.Permission) error {
+	if err := m.checkRoles(name); err != nil {
+		return err
+	}
+	return m.cluster.RemovePermissions(ctx, name, perms)
+}
+
+// AssignRoles to a user, all roles need to exist
+func (m *Manager) AssignRoles(ctx context.Context, user string, roles ...string) error {
+	if err := m.checkRoles(roles...); err != nil {
+		return err
+	}
+	return m.cluster.AssignRoles(ctx, user, roles)
+}
+
+// RevokeRoles from a user
+func (m *Manager) RevokeRoles(ctx context.Context, user string, roles ...string) error {
+	if err := m.checkCluster(); err != nil {
+		return err
+	}
+	return m.cluster.RevokeRoles(ctx, user, roles)
+}
+
+func (m *Manager) checkRoles(roles ...string) error {
+	if err := m.checkCluster(); err != nil {
+		return err
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, role := range roles {
+		if _, ok := m.roles[role]; !ok {
+			return fmt.Errorf("%w: %s", ErrRoleNotFound, role)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) checkCluster() error {
+	if m.cluster == nil {
+		return fmt.Errorf("rbac: cluster is not set")
+	}
+	return nil
+}
+
+// ApplyCreateRole applies a role creation committed through RAFT. Changes are
+// validated again, as they might conflict with a change committed
+// concurrently.
+func (m *Manager) ApplyCreateRole(role *models.Role) error {
+	if err := validateRole(role); err != nil {
+		return err
+	}
+	name := *role.Name
+	pols, err := policiesOf(role.Permissions)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.roles[name]; ok {
+		return fmt.Errorf("%w: %s", ErrRoleExists, name)
+	}
+	m.roles[name] = role.Permissions
+	m.policies[name] = pols
+	return nil
+}
+
+// ApplyDeleteRole applies a role deletion committed through RAFT
+func (m *Manager) ApplyDeleteRole(name string) {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.roles, name)
+	delete(m.policies, name)
+	for user := range m.users {
+		m.removeAssignments(user, name)
+	}
+}
+
+// ApplyAddPermissions applies added permissions committed through RAFT
+func (m *Manager) ApplyAddPermissions(name string, perms []*models.Permission) error {
+	m.Lock()
+	defer m.Unlock()
+
+	existing, ok := m.roles[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrRoleNotFound, name)
+	}
+	updated := slices.Clone(existing)
+	for _, perm := range perms {
+		if indexOf(updated, perm) < 0 {
+			updated = append(updated, perm)
+		}
+	}
+	return m.setPermissions(name, updated)
+}
+
+// ApplyRemovePermissions applies removed permissions committed through RAFT
+func (m *Manager) ApplyRemovePermissions(name string, perms []*models.Permission) error {
+	m.Lock()
+	defer m.Unlock()
+
+	existing, ok := m.roles[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrRoleNotFound, name)
+	}
+	updated := make([]*models.Permission, 0, len(existing))
+	for _, perm := range existing {
+		if indexOf(perms, perm) < 0 {
+			updated = append(updated, perm)
+		}
+	}
+	return m.setPermissions(name, updated)
+}
+
+func (m *Manager) setPermissions(name string, perms []*models.Permission) error {
+	pols, err := policiesOf(perms)
+	if err != nil {
+		return err
+	}
+	m.roles[name] = perms
+	m.policies[name] = pols
+	return nil
+}
+
+// ApplyAssignRoles applies role assignments committed through RAFT
+func (m *Manager) ApplyAssignRoles(user string, roles []string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, role := range roles {
+		if _, ok := m.roles[role]; !ok {
+			return fmt.Errorf("%w: %s", ErrRoleNotFound, role)
+		}
+	}
+	for _, role := range roles {
+		if !slices.Contains(m.users[user], role) {
+			m.users[user] = append(m.users[user], role)
+		}
+	}
+	sort.Strings(m.users[user])
+	return nil
+}
+
+// ApplyRevokeRoles applies revoked role assignments committed through RAFT
+func (m *Manager) ApplyRevokeRoles(user string, roles []string) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.removeAssignments(user, roles...)
+}
+
+func (m *Manager) removeAssignments(user string, roles ...string) {
+	assigned := slices.DeleteFunc(m.users[user], func(role string) bool {
+		return slices.Contains(roles, role)
+	})
+	if len(assigned) == 0 {
+		delete(m.users, user)
+		return
+	}
+	m.users[user] = assigned
+}
+
+// GetRoles by name, or all roles if no names are given. Roles which do not
+// exist are skipped.
+func (m *Manager) GetRoles(names ...string) []*models.Role {
+	m.RLock()
+	defer m.RUnlock()
+
+	if len(names) == 0 {
+		for name := range m.roles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	roles := make([]*models.Role, 0, len(names))
+	for _, name := range names {
+		if perms, ok := m.roles[name]; ok {
+			name := name
+			roles = append(roles, &models.Role{Name: &name, Permissions: perms})
+		}
+	}
+	return roles
+}
+
+// GetRolesForUser returns the roles assigned to the user
+func (m *Manager) GetRolesForUser(user string) []*models.Role {
+	m.RLock()
+	assigned := slices.Clone(m.users[user])
+	m.RUnlock()
+
+	if len(assigned) == 0 {
+		return []*models.Role{}
+	}
+	return m.GetRoles(assigned...)
+}
+
+// GetUsersForRole returns the users a role is assigned to
+func (m *Manager) GetUsersForRole(role string) ([]string, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if _, ok := m.roles[role]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, role)
+	}
+	users := []string{}
+	for user, assigned := range m.users {
+		if slices.Contains(assigned, role) {
+			users = append(users, user)
+		}
+	}
+	sort.Strings(users)
+	return users, nil
+}
+
+// Snapshot returns the state of all roles and assignments, see Restore
+func (m *Manager) Snapshot() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	data, err := json.Marshal(roleState{Roles: m.roles, Users: m.users})
+	if err != nil {
+		return nil, fmt.Errorf("marshal roles: %w", err)
+	}
+	return data, nil
+}
+
+// Restore replaces the state of all roles and assignments with a snapshot.
+// Restoring an empty snapshot removes all roles.
+func (m *Manager) Restore(data []byte) error {
+	var state roleState
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &state); err != nil {
+			return fmt.Errorf("unmarshal roles: %w", err)
+		}
+	}
+
+	roles := map[string][]*models.Permission{}
+	policies := map[string][]policy{}
+	for name, perms := range state.Roles {
+		pols, err := policiesOf(perms)
+		if err != nil {
+			return fmt.Errorf("role %s: %w", name, err)
+		}
+		roles[name] = perms
+		policies[name] = pols
+	}
+	users := map[string][]string{}
+	for user, assigned := range state.Users {
+		users[user] = assigned
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.roles, m.policies, m.users = roles, policies, users
+	return nil
+}
+
+func validateRole(role *models.Role) error {
+	if role == nil || role.Name == nil || *role.Name == "" {
+		return fmt.Errorf("%w: role name is required", ErrInvalidPermission)
+	}
+	return nil
+}
+
+func policiesOf(perms []*models.Permission) ([]policy, error) {
+	var pols []policy
+	for _, perm := range perms {
+		p, err := policies(perm)
+		if err != nil {
+			return nil, err
+		}
+		pols = append(pols, p...)
+	}
+	return pols, nil
+}
+
+func indexOf(perms []*models.Permission, perm *models.Permission) int {
+	return slices.IndexFunc(perms, func(p *models.Permission) bool {
+		return reflect.DeepEqual(p, perm)
+	})
+}
@@ -0,0 +1,273 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+// fakeCluster applies changes right away, like a single node cluster
+type fakeCluster struct {
+	m *rbac.Manager
+}
+
+func (c fakeCluster) CreateRole(_ context.Context, role *models.Role) error {
+	return c.m.ApplyCreateRole(role)
+}
+
+func (c fakeCluster) DeleteRole(_ context.Context, name string) error {
+	c.m.ApplyDeleteRole(name)
+	return nil
+}
+
+func (c fakeCluster) AddPermissions(_ context.Context, role string, perms []*models.Permission) error {
+	return c.m.ApplyAddPermissions(role, perms)
+}
+
+func (c fakeCluster) RemovePermissions(_ context.Context, role string, perms []*models.Permission) error {
+	return c.m.ApplyRemovePermissions(role, perms)
+}
+
+func (c fakeCluster) AssignRoles(_ context.Context, user string, roles []string) error {
+	return c.m.ApplyAssignRoles(user, roles)
+}
+
+func (c fakeCluster) RevokeRoles(_ context.Context, user string, roles []string) error {
+	c.m.ApplyRevokeRoles(user, roles)
+	return nil
+}
+
+func newTestManager(t *testing.T) *rbac.Manager {
+	m := rbac.New(rbac.Config{Enabled: true, Admins: []string{"admin"}})
+	m.SetCluster(fakeCluster{m})
+	return m
+}
+
+func dataPermission(action, collection string) *models.Permission {
+	return &models.Permission{Action: &action, Data: &models.PermissionData{Collection: &collection}}
+}
+
+func role(name string, perms ...*models.Permission) *models.Role {
+	return &models.Role{Name: &name, Permissions: perms}
+}
+
+func Test_Rbac_Authorizer(t *testing.T) {
+	ctx := context.Background()
+	alice := &models.Principal{Username: "alice"}
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	t.Run("admins are allowed everything", func(t *testing.T) {
+		m := newTestManager(t)
+		err := m.Authorize(&models.Principal{Username: "admin"}, authorization.DELETE, authorization.Roles()...)
+		assert.Nil(t, err)
+	})
+
+	t.Run("users without roles are denied", func(t *testing.T) {
+		m := newTestManager(t)
+		err := m.Authorize(alice, authorization.READ, authorization.Collections("Books")...)
+		assert.Equal(t, errors.NewForbidden(alice, authorization.READ, authorization.Collections("Books")...), err)
+	})
+
+	t.Run("nil principal is anonymous", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("reader", dataPermission(models.PermissionActionReadData, "*"))))
+		require.Nil(t, m.AssignRoles(ctx, "anonymous", "reader"))
+
+		assert.Nil(t, m.Authorize(nil, authorization.READ, authorization.Objects("Books", "", id)))
+	})
+
+	t.Run("permissions are limited per collection and verb", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("books",
+			dataPermission(models.PermissionActionReadData, "Books"),
+			dataPermission(models.PermissionActionUpdateData, "Books"))))
+		require.Nil(t, m.AssignRoles(ctx, "alice", "books"))
+
+		assert.Nil(t, m.Authorize(alice, authorization.READ, authorization.Objects("Books", "", id)))
+		assert.Nil(t, m.Authorize(alice, authorization.READ, authorization.Collections("Books")...))
+		assert.Nil(t, m.Authorize(alice, authorization.UPDATE, authorization.Shards("Books", "")...))
+		assert.NotNil(t, m.Authorize(alice, authorization.DELETE, authorization.Objects("Books", "", id)))
+		assert.NotNil(t, m.Authorize(alice, authorization.READ, authorization.Objects("Movies", "", id)))
+		assert.NotNil(t, m.Authorize(alice, authorization.READ, authorization.Objects("", "", id)),
+			"reading an object of any collection needs access to all collections")
+		assert.NotNil(t, m.Authorize(alice, authorization.UPDATE, authorization.Collections("Books")...),
+			"data permissions do not allow schema changes")
+	})
+
+	t.Run("all resources need to be allowed", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("books", dataPermission(models.PermissionActionManageData, "Books"))))
+		require.Nil(t, m.AssignRoles(ctx, "alice", "books"))
+
+		assert.Nil(t, m.Authorize(alice, authorization.UPDATE, authorization.Shards("Books", "t1", "t2")...))
+		resources := append(authorization.Shards("Books", "t1"), authorization.Shards("Movies", "t1")...)
+		assert.NotNil(t, m.Authorize(alice, authorization.UPDATE, resources...))
+	})
+
+	t.Run("revoked roles no longer apply", func(t *testing.T) {
+		m := newTestManager(t)
+		action := models.PermissionActionReadNodes
+		require.Nil(t, m.CreateRole(ctx, role("nodes", &models.Permission{Action: &action})))
+		require.Nil(t, m.AssignRoles(ctx, "alice", "nodes"))
+		require.Nil(t, m.Authorize(alice, authorization.READ, authorization.Cluster()))
+
+		require.Nil(t, m.RevokeRoles(ctx, "alice", "nodes"))
+		assert.NotNil(t, m.Authorize(alice, authorization.READ, authorization.Cluster()))
+	})
+}
+
+func Test_Rbac_Roles(t *testing.T) {
+	ctx := context.Background()
+	t.Run("create and get roles", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("b", dataPermission(models.PermissionActionReadData, "B"))))
+		require.Nil(t, m.CreateRole(ctx, role("a")))
+
+		err := m.CreateRole(ctx, role("a"))
+		assert.ErrorIs(t, err, rbac.ErrRoleExists)
+
+		roles := m.GetRoles()
+		require.Len(t, roles, 2)
+		assert.Equal(t, "a", *roles[0].Name)
+		assert.Equal(t, "b", *roles[1].Name)
+		assert.Empty(t, m.GetRoles("c"))
+	})
+
+	t.Run("invalid permissions are rejected", func(t *testing.T) {
+		m := newTestManager(t)
+		unknown := "manage_everything"
+		err := m.CreateRole(ctx, role("a", &models.Permission{Action: &unknown}))
+		assert.ErrorIs(t, err, rbac.ErrInvalidPermission)
+
+		err = m.CreateRole(ctx, role("a", dataPermission(models.PermissionActionReadData, "[Books")))
+		assert.ErrorIs(t, err, rbac.ErrInvalidPermission)
+		assert.Empty(t, m.GetRoles())
+	})
+
+	t.Run("add and remove permissions", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("a", dataPermission(models.PermissionActionReadData, "A"))))
+
+		perm := dataPermission(models.PermissionActionReadData, "B")
+		require.Nil(t, m.AddPermissions(ctx, "a", []*models.Permission{perm, perm}))
+		assert.Len(t, m.GetRoles("a")[0].Permissions, 2)
+
+		require.Nil(t, m.RemovePermissions(ctx, "a", []*models.Permission{dataPermission(models.PermissionActionReadData, "A")}))
+		assert.Equal(t, []*models.Permission{perm}, m.GetRoles("a")[0].Permissions)
+
+		assert.ErrorIs(t, m.AddPermissions(ctx, "b", []*models.Permission{perm}), rbac.ErrRoleNotFound)
+	})
+
+	t.Run("assign roles to users", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("a")))
+		require.Nil(t, m.CreateRole(ctx, role("b")))
+
+		assert.ErrorIs(t, m.AssignRoles(ctx, "alice", "a", "c"), rbac.ErrRoleNotFound)
+		assert.Empty(t, m.GetRolesForUser("alice"))
+
+		require.Nil(t, m.AssignRoles(ctx, "alice", "b", "a"))
+		require.Nil(t, m.AssignRoles(ctx, "bob", "a"))
+		assert.Len(t, m.GetRolesForUser("alice"), 2)
+
+		users, err := m.GetUsersForRole("a")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"alice", "bob"}, users)
+
+		_, err = m.GetUsersForRole("c")
+		assert.ErrorIs(t, err, rbac.ErrRoleNotFound)
+	})
+
+	t.Run("deleting a role removes its assignments", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("a")))
+		require.Nil(t, m.AssignRoles(ctx, "alice", "a"))
+
+		require.Nil(t, m.DeleteRole(ctx, "a"))
+		require.Nil(t, m.DeleteRole(ctx, "a"))
+		assert.Empty(t, m.GetRolesForUser("alice"))
+	})
+
+	t.Run("snapshot and restore", func(t *testing.T) {
+		m := newTestManager(t)
+		require.Nil(t, m.CreateRole(ctx, role("books", dataPermission(models.PermissionActionReadData, "Books"))))
+		require.Nil(t, m.AssignRoles(ctx, "alice", "books"))
+		snap, err := m.Snapshot()
+		require.Nil(t, err)
+
+		restored := newTestManager(t)
+		require.Nil(t, restored.CreateRole(ctx, role("other")))
+		require.Nil(t, restored.Restore(snap))
+		assert.Equal(t, m.GetRoles(), restored.GetRoles())
+		assert.Nil(t, restored.Authorize(&models.Principal{Username: "alice"},
+			authorization.READ, authorization.Collections("Books")...))
+
+		require.Nil(t, restored.Restore(nil))
+		assert.Empty(t, restored.GetRoles())
+	})
+}
+
+func Test_Rbac_Grants(t *testing.T) {
+	ctx := context.Background()
+	alice := &models.Principal{Username: "alice"}
+	manageRoles := models.PermissionActionManageRoles
+	editor := "editor"
+
+	m := newTestManager(t)
+	require.Nil(t, m.CreateRole(ctx, role("editor",
+		&models.Permission{Action: &manageRoles, Roles: &models.PermissionRoles{Role: &editor}},
+		dataPermission(models.PermissionActionReadData, "Books"),
+		dataPermission(models.PermissionActionManageData, "Movies"))))
+	require.Nil(t, m.AssignRoles(ctx, "alice", "editor"))
+	require.Nil(t, m.CreateRole(ctx, role("admin", &models.Permission{Action: &manageRoles})))
+	require.Nil(t, m.CreateRole(ctx, role("books", dataPermission(models.PermissionActionReadData, "Books"))))
+
+	t.Run("permissions the principal holds", func(t *testing.T) {
+		assert.Nil(t, m.AuthorizeGrant(alice, []*models.Permission{
+			dataPermission(models.PermissionActionReadData, "Books"),
+			dataPermission(models.PermissionActionUpdateData, "Movies"),
+		}))
+		assert.Nil(t, m.AuthorizeAssign(alice, "books"))
+	})
+
+	t.Run("permissions the principal does not hold", func(t *testing.T) {
+		for name, perm := range map[string]*models.Permission{
+			"other verb":       dataPermission(models.PermissionActionUpdateData, "Books"),
+			"other collection": dataPermission(models.PermissionActionReadData, "Songs"),
+			"all collections":  dataPermission(models.PermissionActionReadData, "*"),
+			"wider pattern":    dataPermission(models.PermissionActionReadData, "Boo*"),
+			"manage roles":     {Action: &manageRoles},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var forbidden errors.Forbidden
+				assert.ErrorAs(t, m.AuthorizeGrant(alice, []*models.Permission{perm}), &forbidden)
+			})
+		}
+		var forbidden errors.Forbidden
+		assert.ErrorAs(t, m.AuthorizeAssign(alice, "admin"), &forbidden)
+	})
+
+	t.Run("admins may grant everything", func(t *testing.T) {
+		admin := &models.Principal{Username: "admin"}
+		assert.Nil(t, m.AuthorizeGrant(admin, []*models.Permission{{Action: &manageRoles}}))
+		assert.Nil(t, m.AuthorizeAssign(admin, "admin"))
+	})
+}
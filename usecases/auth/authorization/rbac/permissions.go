@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// verbs as used by the authorization package
+const (
+	verbCreate = "C"
+	verbRead   = "R"
+	verbUpdate = "U"
+	verbDelete = "D"
+)
+
+var allVerbs = []string{verbCreate, verbRead, verbUpdate, verbDelete}
+
+// policy allows a single verb on every resource matching the pattern, see
+// path.Match
+type policy struct {
+	verb    string
+	pattern string
+}
+
+func (p policy) allows(verb, resource string) bool {
+	if p.verb != verb {
+		return false
+	}
+	ok, _ := path.Match(p.pattern, resource)
+	return ok
+}
+
+// covers reports whether the policy allows everything the other policy
+// allows. Patterns are compared segment by segment: a wildcard segment covers
+// any segment, any other pattern only covers literal segments it matches.
+func (p policy) covers(other policy) bool {
+	if p.verb != other.verb {
+		return false
+	}
+	have, want := strings.Split(p.pattern, "/"), strings.Split(other.pattern, "/")
+	if len(have) != len(want) {
+		return false
+	}
+	for i := range have {
+		if have[i] == "*" || have[i] == want[i] {
+			continue
+		}
+		if strings.ContainsAny(want[i], `*?[\`) {
+			return false
+		}
+		if ok, _ := path.Match(have[i], want[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// policies translates a permission into the verbs and resources the
+// authorizer is asked for. The verb is derived from the action prefix, i.e.
+// read_data allows READ, manage_data allows all verbs.
+//
+// Resources follow the resource strings of the authorization package:
+//   - backups and nodes are authorized cluster-wide
+//   - collection permissions include the shards of the selected tenants
+//   - data permissions cover shards and objects. Objects are written through
+//     their shard, which is authorized as an update, so adding objects requires
+//     update_data. Reading data also allows to query the collection.
+func policies(perm *models.Permission) ([]policy, error) {
+	if perm == nil || perm.Action == nil {
+		return nil, fmt.Errorf("%w: missing action", ErrInvalidPermission)
+	}
+
+	action := *perm.Action
+	verbs, err := actionVerbs(action)
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns []string
+	switch action {
+	case models.PermissionActionManageBackups, models.PermissionActionReadCluster,
+		models.PermissionActionReadNodes:
+		patterns = []string{"cluster/*"}
+	case models.PermissionActionManageRoles, models.PermissionActionReadRoles:
+		role := "*"
+		if perm.Roles != nil {
+			role = orAll(perm.Roles.Role)
+		}
+		patterns = []string{fmt.Sprintf("roles/%s", role)}
+	case models.PermissionActionManageCollections, models.PermissionActionCreateCollections,
+		models.PermissionActionReadCollections, models.PermissionActionUpdateCollections,
+		models.PermissionActionDeleteCollections:
+		collection, tenant := "*", "*"
+		if perm.Collections != nil {
+			collection, tenant = orAll(perm.Collections.Collection), orAll(perm.Collections.Tenant)
+		}
+		patterns = []string{
+			fmt.Sprintf("collections/%s", collection),
+			fmt.Sprintf("collection/%s/shards/%s", collection, tenant),
+		}
+	case models.PermissionActionManageData, models.PermissionActionCreateData,
+		models.PermissionActionReadData, models.PermissionActionUpdateData,
+		models.PermissionActionDeleteData:
+		collection, tenant, object := "*", "*", "*"
+		if perm.Data != nil {
+			collection, tenant = orAll(perm.Data.Collection), orAll(perm.Data.Tenant)
+			object = orAll(perm.Data.Object)
+		}
+		patterns = []string{
+			fmt.Sprintf("collection/%s/shards/%s", collection, tenant),
+			fmt.Sprintf("collections/%s/shards/%s/objects/%s", collection, tenant, object),
+		}
+		if action == models.PermissionActionReadData {
+			patterns = append(patterns, fmt.Sprintf("collections/%s", collection))
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown action %q", ErrInvalidPermission, action)
+	}
+
+	pols := make([]policy, 0, len(verbs)*len(patterns))
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%w: action %s: resource %q: %v", ErrInvalidPermission, action, pattern, err)
+		}
+		for _, verb := range verbs {
+			pols = append(pols, policy{verb: verb, pattern: pattern})
+		}
+	}
+	return pols, nil
+}
+
+func actionVerbs(action string) ([]string, error) {
+	prefix, _, _ := strings.Cut(action, "_")
+	switch prefix {
+	case "manage":
+		return allVerbs, nil
+	case "create":
+		return []string{verbCreate}, nil
+	case "read":
+		return []string{verbRead}, nil
+	case "update":
+		return []string{verbUpdate}, nil
+	case "delete":
+		return []string{verbDelete}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown action %q", ErrInvalidPermission, action)
+	}
+}
+
+func orAll(s *string) string {
+	if s == nil || *s == "" {
+		return "*"
+	}
+	return *s
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// Scoped wraps an Authorizer so that principals which are limited to a set of
+// collections, e.g. by the scope of their API key, can only access resources
+// of those collections. Resources which do not belong to a single collection,
+// such as the cluster, roles or a wildcard over all collections, are out of
+// scope for them. Everything else is decided by the wrapped Authorizer.
+func Scoped(authorizer Authorizer) Authorizer {
+	return &scopedAuthorizer{authorizer: authorizer}
+}
+
+type scopedAuthorizer struct {
+	authorizer Authorizer
+}
+
+func (s *scopedAuthorizer) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	if principal != nil && len(principal.Collections) > 0 {
+		for _, resource := range resources {
+			if !inScope(principal.Collections, resource) {
+				return errors.NewForbidden(principal, verb, resources...)
+			}
+		}
+	}
+
+	return s.authorizer.Authorize(principal, verb, resources...)
+}
+
+// inScope checks whether the resource belongs to one of the collections. Both
+// "collections/{class}/..." and "collection/{class}/shards/..." are collection
+// resources.
+func inScope(collections []string, resource string) bool {
+	var rest string
+	switch {
+	case strings.HasPrefix(resource, "collections/"):
+		rest = strings.TrimPrefix(resource, "collections/")
+	case strings.HasPrefix(resource, "collection/"):
+		rest = strings.TrimPrefix(resource, "collection/")
+	default:
+		return false
+	}
+
+	class, _, _ := strings.Cut(rest, "/")
+	if class == "" || class == "*" {
+		return false
+	}
+	class = schema.UppercaseClassName(class)
+	for _, collection := range collections {
+		if schema.UppercaseClassName(collection) == class {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func TestScoped(t *testing.T) {
+	id := strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	scoped := &models.Principal{Username: "jane", Collections: []string{"Books", "movies"}}
+	unscoped := &models.Principal{Username: "jane"}
+	authorizer := Scoped(&DummyAuthorizer{})
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		resources []string
+		allowed   bool
+	}{
+		{"unscoped principal", unscoped, []string{Cluster()}, true},
+		{"anonymous", nil, Collections(), true},
+		{"collection in scope", scoped, Collections("Books"), true},
+		{"lowercase collection in scope", scoped, Collections("books"), true},
+		{"collection configured in lowercase", scoped, Collections("Movies"), true},
+		{"multiple collections in scope", scoped, Collections("Books", "Movies"), true},
+		{"one collection out of scope", scoped, Collections("Books", "Songs"), false},
+		{"collection out of scope", scoped, Collections("Songs"), false},
+		{"all collections", scoped, Collections(), false},
+		{"shards in scope", scoped, Shards("Books", "t1"), true},
+		{"shards out of scope", scoped, Shards("Songs", "t1"), false},
+		{"shards of all collections", scoped, Shards(""), false},
+		{"object in scope", scoped, []string{Objects("Books", "", id)}, true},
+		{"object out of scope", scoped, []string{Objects("Songs", "", id)}, false},
+		{"object of any collection", scoped, []string{Objects("", "", id)}, false},
+		{"cluster", scoped, []string{Cluster()}, false},
+		{"roles", scoped, Roles(), false},
+		{"keys", scoped, Keys(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizer.Authorize(tt.principal, READ, tt.resources...)
+			if tt.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &errors.Forbidden{})
+			}
+		})
+	}
+}
@@ -65,6 +65,31 @@ func Roles(roles ...string) []string {
 	return resources
 }
 
+// Keys generates a list of API key resource strings based on the provided key ids.
+// If no key ids are provided, it returns a default key resource string "keys/*".
+//
+// Parameters:
+//
+//	keys - A variadic parameter representing the key ids.
+//
+// Returns:
+//
+//	A slice of strings where each string is a formatted key resource string.
+func Keys(keys ...string) []string {
+	if len(keys) == 0 {
+		return []string{
+			"keys/*",
+		}
+	}
+
+	resources := make([]string, len(keys))
+	for idx := range keys {
+		resources[idx] = fmt.Sprintf("keys/%s", keys[idx])
+	}
+
+	return resources
+}
+
 // Collections generates a list of resource strings for the given classes.
 // If no classes are provided, it returns a default resource string "collections/*".
 // Each class is formatted as "collection/{class}".
@@ -37,6 +37,25 @@ func TestRoles(t *testing.T) {
 	}
 }
 
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []string
+		expected []string
+	}{
+		{"No keys", []string{}, []string{"keys/*"}},
+		{"Single key", []string{"a1b2"}, []string{"keys/a1b2"}},
+		{"Multiple keys", []string{"a1b2", "c3d4"}, []string{"keys/a1b2", "keys/c3d4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Keys(tt.keys...)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestCluster(t *testing.T) {
 	expected := "cluster/*"
 	result := Cluster()
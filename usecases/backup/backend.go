@@ -21,6 +21,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -151,6 +152,11 @@ func (s *nodeStore) Meta(ctx context.Context, backupID, overrideBucket, override
 	return &result, err
 }
 
+// sibling returns the store of node's part of another backup kept in the same bucket and path
+func (s *nodeStore) sibling(backupID, node string) nodeStore {
+	return nodeStore{objectStore{s.backend, fmt.Sprintf("%s/%s", backupID, node), s.bucket, s.path}}
+}
+
 // meta marshals and uploads metadata
 func (s *nodeStore) PutMeta(ctx context.Context, desc *backup.BackupDescriptor, overrideBucket, overridePath string) error {
 	return s.putMeta(ctx, BackupFile, overrideBucket, overridePath, desc)
@@ -186,6 +192,11 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+	// base is this node's part of the backup to take an increment of
+	base *backup.BackupDescriptor
+	// sealer encrypts chunks and signs the metadata, it is nil if no
+	// encryption key is configured
+	sealer *Sealer
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
@@ -201,6 +212,8 @@ func newUploader(sourcer Sourcer, backend nodeStore,
 		}),
 		setstatus,
 		l,
+		nil,
+		nil,
 	}
 }
 
@@ -209,11 +222,41 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+func (u *uploader) withBase(base *backup.BackupDescriptor) *uploader {
+	u.base = base
+	return u
+}
+
+func (u *uploader) withSealer(sealer *Sealer) *uploader {
+	u.sealer = sealer
+	return u
+}
+
+// putMeta signs desc if needed and uploads it
+func (u *uploader) putMeta(ctx context.Context, desc *backup.BackupDescriptor, overrideBucket, overridePath string) error {
+	if u.sealer != nil {
+		if err := u.sealer.sign(desc); err != nil {
+			return err
+		}
+	}
+	return u.backend.PutMeta(ctx, desc, overrideBucket, overridePath)
+}
+
+// baseShard returns the descriptor base recorded for shard or nil
+func (u *uploader) baseShard(class, shard string) *backup.ShardDescriptor {
+	if u.base == nil {
+		return nil
+	}
+	if c := u.base.Class(class); c != nil {
+		return c.Shard(shard)
+	}
+	return nil
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor, overrideBucket, overridePath string) (err error) {
 	u.setStatus(backup.Transferring)
 	desc.Status = string(backup.Transferring)
-	ch := u.sourcer.BackupDescriptors(ctx, desc.ID, classes)
 	defer func() {
 		//  make sure context is not cancelled when uploading metadata
 		ctx := context.Background()
@@ -224,16 +267,25 @@ func (u *uploader) all(ctx context.Context, classes []string, desc *backup.Backu
 				desc.Status = string(backup.Cancelled)
 				u.releaseIndexes(classes, desc.ID)
 			}
-			err = fmt.Errorf("upload %w: %v", err, u.backend.PutMeta(ctx, desc, overrideBucket, overridePath))
+			err = fmt.Errorf("upload %w: %v", err, u.putMeta(ctx, desc, overrideBucket, overridePath))
 		} else {
 			u.log.Info("start uploading meta data")
-			if err = u.backend.PutMeta(ctx, desc, overrideBucket, overridePath); err != nil {
+			if err = u.putMeta(ctx, desc, overrideBucket, overridePath); err != nil {
 				desc.Status = string(backup.Transferred)
 			}
 			u.setStatus(backup.Success)
 			u.log.Info("finish uploading meta data")
 		}
 	}()
+
+	// writes into all classes are held from here until the files of each
+	// class are listed, so that the classes reflect the same point of this
+	// node's writes. Other nodes hold their writes at their own time, there
+	// is no such point across nodes.
+	if err = u.sourcer.HoldWrites(ctx, desc.ID, classes); err != nil {
+		return fmt.Errorf("hold writes: %w", err)
+	}
+	ch := u.sourcer.BackupDescriptors(ctx, desc.ID, classes)
 Loop:
 	for {
 		select {
@@ -317,6 +369,7 @@ func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescr
 	}
 
 	desc.Chunks = make(map[int32][]string, 1+nShards/2)
+	desc.Checksums = make(map[int32]string, 1+nShards/2)
 	var (
 		hasJobs   atomic.Bool
 		lastChunk = int32(0)
@@ -367,12 +420,12 @@ func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescr
 							return err
 						}
 						chunk := atomic.AddInt32(&lastChunk, 1)
-						shards, err := u.compress(ctx, desc.Name, chunk, sender, overrideBucket, overridePath)
+						shards, sum, err := u.compress(ctx, desc.Name, chunk, sender, overrideBucket, overridePath)
 						if err != nil {
 							return err
 						}
 						if m := int32(len(shards)); m > 0 {
-							recvCh <- chuckShards{chunk, shards}
+							recvCh <- chuckShards{chunk, shards, sum}
 						}
 					}
 					return err
@@ -386,13 +439,15 @@ func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescr
 
 	for x := range processor(nWorker, jobs(desc.Shards)) {
 		desc.Chunks[x.chunk] = x.shards
+		desc.Checksums[x.chunk] = x.checksum
 	}
 	return
 }
 
 type chuckShards struct {
-	chunk  int32
-	shards []string
+	chunk    int32
+	shards   []string
+	checksum string
 }
 
 func (u *uploader) compress(ctx context.Context,
@@ -400,7 +455,7 @@ func (u *uploader) compress(ctx context.Context,
 	chunk int32, // chunk index
 	ch <-chan *backup.ShardDescriptor, // chan of shards
 	overrideBucket, overridePath string, // bucket name and path
-) ([]string, error) {
+) ([]string, string, error) {
 	var (
 		chunkKey = chunkKey(class, chunk)
 		shards   = make([]string, 0, 10)
@@ -408,6 +463,10 @@ func (u *uploader) compress(ctx context.Context,
 		maxSize = int64(u.ChunkSize + u.ChunkSize/20) // size + 5%
 	)
 	zip, reader := NewZip(u.backend.SourceDataPath(), u.Level)
+	src, err := newChunkSource(reader, u.sealer)
+	if err != nil {
+		return shards, "", err
+	}
 	producer := func() error {
 		defer zip.Close()
 		lastShardSize := int64(0)
@@ -415,10 +474,20 @@ func (u *uploader) compress(ctx context.Context,
 			if err := ctx.Err(); err != nil {
 				return err
 			}
+			m, err := shardManifest(zip.sourcePath, u.backupID, shard, u.baseShard(class, shard.Name))
+			if err != nil {
+				return err
+			}
+			shard.Manifest = m
 			if _, err := zip.WriteShard(ctx, shard); err != nil {
 				return err
 			}
 			shard.Chunk = chunk
+			for _, info := range shard.Manifest {
+				if p := info.Pending(); p != nil {
+					p.Chunk = chunk
+				}
+			}
 			shards = append(shards, shard.Name)
 			shard.ClearTemporary()
 			zip.gzw.Flush() // flush new shard
@@ -433,23 +502,28 @@ func (u *uploader) compress(ctx context.Context,
 	// consumer
 	eg := enterrors.NewErrorGroupWrapper(u.log)
 	eg.Go(func() error {
-		if _, err := u.backend.Write(ctx, chunkKey, overrideBucket, overridePath, reader); err != nil {
+		if _, err := u.backend.Write(ctx, chunkKey, overrideBucket, overridePath, src); err != nil {
 			return err
 		}
 		return nil
 	})
 
 	if err := producer(); err != nil {
-		return shards, err
+		return shards, "", err
 	}
 	// wait for the consumer to finish
-	return shards, eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return shards, "", err
+	}
+	return shards, src.checksum(), nil
 }
 
 // fileWriter downloads files from object store and writes files to the destination folder destDir
 type fileWriter struct {
 	sourcer    Sourcer
 	backend    nodeStore
+	meta       *backup.BackupDescriptor // node metadata of the backup to restore
+	sealer     *Sealer
 	tempDir    string
 	destDir    string
 	movedFiles []string // files successfully moved to destination folder
@@ -457,15 +531,21 @@ type fileWriter struct {
 	GoPoolSize int
 	migrator   func(classPath string) error
 	logger     logrus.FieldLogger
+	// target is the name the class is restored as if it differs
+	target string
+	// shards, if set, are the only shards of the class to restore
+	shards map[string]struct{}
 }
 
-func newFileWriter(sourcer Sourcer, backend nodeStore,
-	compressed bool, logger logrus.FieldLogger,
+func newFileWriter(sourcer Sourcer, backend nodeStore, meta *backup.BackupDescriptor,
+	sealer *Sealer, compressed bool, logger logrus.FieldLogger,
 ) *fileWriter {
 	destDir := backend.SourceDataPath()
 	return &fileWriter{
 		sourcer:    sourcer,
 		backend:    backend,
+		meta:       meta,
+		sealer:     sealer,
 		destDir:    destDir,
 		tempDir:    path.Join(destDir, TempDirectory),
 		movedFiles: make([]string, 0, 64),
@@ -482,12 +562,25 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 
 func (fw *fileWriter) setMigrator(m func(classPath string) error) { fw.migrator = m }
 
+func (fw *fileWriter) setTarget(class string) { fw.target = class }
+
+func (fw *fileWriter) selectShards(shards []*backup.ShardDescriptor) {
+	fw.shards = make(map[string]struct{}, len(shards))
+	for _, s := range shards {
+		fw.shards[s.Name] = struct{}{}
+	}
+}
+
 // Write downloads files and put them in the destination directory
 func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, overrideBucket, overridePath string) (err error) {
 	if len(desc.Shards) == 0 { // nothing to copy
 		return nil
 	}
-	classTempDir := path.Join(fw.tempDir, desc.Name)
+	name := desc.Name
+	if fw.target != "" {
+		name = fw.target
+	}
+	classTempDir := path.Join(fw.tempDir, name)
 
 	if err := fw.writeTempFiles(ctx, classTempDir, overrideBucket, overridePath, desc); err != nil {
 		return fmt.Errorf("get files: %w", err)
@@ -499,6 +592,14 @@ func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, o
 		}
 	}
 
+	// the class directory is named after the index, which follows the class
+	if from, to := strings.ToLower(desc.Name), strings.ToLower(name); from != to {
+		from, to = path.Join(classTempDir, from), path.Join(classTempDir, to)
+		if err := os.Rename(from, to); err != nil {
+			return fmt.Errorf("rename class directory %s %s: %w", from, to, err)
+		}
+	}
+
 	return nil
 }
 
@@ -528,21 +629,92 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir, override
 
 	// source files are compressed
 
+	// files of an incremental backup might be partially or entirely held by
+	// earlier backups of its chain
+	inherited := inheritedParts(fw.meta.ID, desc)
+	bases, err := fw.baseMetas(ctx, inherited, overrideBucket, overridePath)
+	if err != nil {
+		return err
+	}
+
 	eg.SetLimit(fw.GoPoolSize)
 	for k := range desc.Chunks {
 		chunk := chunkKey(desc.Name, k)
 		eg.Go(func() error {
 			uz, w := NewUnzip(classTempDir)
-			enterrors.GoWrapper(func() {
-				fw.backend.Read(ctx, chunk, overrideBucket, overridePath, w)
-			}, fw.logger)
-			_, err := uz.ReadChunk()
-			return err
+			uz.shards = fw.shards
+			return fw.readChunk(ctx, fw.backend, chunk, desc.Checksums[k], fw.meta.Encrypted,
+				uz, w, overrideBucket, overridePath)
+		})
+	}
+
+	// as parts are written at their offsets the order in which chunks are
+	// extracted doesn't matter
+	for ref, files := range inherited {
+		store := fw.backend.sibling(ref.backupID, ref.node)
+		chunk := chunkKey(desc.Name, ref.chunk)
+		base := bases[ref.backupID]
+		cdesc := base.Class(desc.Name)
+		if cdesc == nil {
+			return fmt.Errorf("backup %s: class %s not found", ref.backupID, desc.Name)
+		}
+		eg.Go(func() error {
+			uz, w := NewUnzip(classTempDir)
+			uz.shards = fw.shards
+			uz.filter = func(relPath string, offset int64) bool {
+				o, ok := files[relPath]
+				return ok && o == offset
+			}
+			if err := fw.readChunk(ctx, store, chunk, cdesc.Checksums[ref.chunk], base.Encrypted,
+				uz, w, overrideBucket, overridePath); err != nil {
+				return fmt.Errorf("backup %s: %w", ref.backupID, err)
+			}
+			return nil
 		})
 	}
 	return eg.Wait()
 }
 
+// readChunk downloads chunk key from store and extracts it with uz.
+// The chunk is verified against checksum and decrypted if needed.
+func (fw *fileWriter) readChunk(ctx context.Context, store nodeStore, key, checksum string, encrypted bool,
+	uz unzip, w *io.PipeWriter, overrideBucket, overridePath string,
+) error {
+	sink, err := newChunkSink(w, checksum, fw.sealer, encrypted)
+	if err != nil {
+		return err
+	}
+	enterrors.GoWrapper(func() {
+		store.Read(ctx, key, overrideBucket, overridePath, sink)
+	}, fw.logger)
+	_, err = uz.ReadChunk()
+	return err
+}
+
+// baseMetas fetches and verifies the node metadata of the earlier backups
+// which hold inherited parts
+func (fw *fileWriter) baseMetas(ctx context.Context, inherited map[inheritedChunk]map[string]int64,
+	overrideBucket, overridePath string,
+) (map[string]*backup.BackupDescriptor, error) {
+	metas := make(map[string]*backup.BackupDescriptor)
+	for ref := range inherited {
+		if _, ok := metas[ref.backupID]; ok {
+			continue
+		}
+		store := fw.backend.sibling(ref.backupID, ref.node)
+		meta, err := store.Meta(ctx, ref.backupID, overrideBucket, overridePath, false)
+		if err != nil {
+			return nil, fmt.Errorf("get base backup %s: %w", ref.backupID, err)
+		}
+		// bases are trusted like the backup itself
+		if err := verifyMeta(fw.sealer, meta, fw.meta.Signature == ""); err != nil {
+			return nil, fmt.Errorf("base backup %s: %w", ref.backupID, err)
+		}
+		metas[ref.backupID] = meta
+	}
+	return metas, nil
+}
+
 func (fw *fileWriter) writeTempShard(ctx context.Context, sd *backup.ShardDescriptor, classTempDir, overrideBucket, overridePath string) error {
 	for _, key := range sd.Files {
 		destPath := path.Join(classTempDir, key)
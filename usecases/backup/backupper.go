@@ -30,17 +30,20 @@ type backupper struct {
 	logger   logrus.FieldLogger
 	sourcer  Sourcer
 	backends BackupBackendProvider
+	sealer   *Sealer
 	// shardCoordinationChan is sync and coordinate operations
 	shardSyncChan
 }
 
 func newBackupper(node string, logger logrus.FieldLogger, sourcer Sourcer, backends BackupBackendProvider,
+	sealer *Sealer,
 ) *backupper {
 	return &backupper{
 		node:          node,
 		logger:        logger,
 		sourcer:       sourcer,
 		backends:      backends,
+		sealer:        sealer,
 		shardSyncChan: shardSyncChan{coordChan: make(chan interface{}, 5)},
 	}
 }
@@ -57,7 +60,7 @@ func (b *backupper) Backup(ctx context.Context,
 		Bucket:  overrideBucket,
 		Path:    overridePath,
 	}
-	if _, err := b.backup(store, &req); err != nil {
+	if _, err := b.backup(ctx, store, &req); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
 
@@ -126,7 +129,7 @@ func (b *backupper) OnStatus(ctx context.Context, req *StatusRequest) (reqState,
 // Moreover it starts a goroutine in the background which waits for the
 // next instruction from the coordinator (second phase).
 // It will start the backup as soon as it receives an ack, or abort otherwise
-func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, error) {
+func (b *backupper) backup(ctx context.Context, store nodeStore, req *Request) (CanCommitResponse, error) {
 	id := req.ID
 	expiration := req.Duration
 	if expiration > _TimeoutShardCommit {
@@ -138,6 +141,14 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 		Timeout: expiration,
 	}
 
+	var base *backup.BackupDescriptor
+	if req.BaseID != "" {
+		var err error
+		if base, err = b.baseMeta(ctx, store, req); err != nil {
+			return ret, err
+		}
+	}
+
 	// make sure there is no active backup
 	if prevID := b.lastOp.renew(id, store.HomeDir(req.Bucket, req.Path), req.Bucket, req.Path); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
@@ -150,11 +161,16 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			b.logger.WithField("action", "create_backup").
 				Error(err)
 			b.lastAsyncError = err
+			for _, cls := range req.Classes {
+				b.sourcer.ReleaseBackup(context.Background(), id, cls)
+			}
 			return
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set, b.logger).
-			withCompression(newZipConfig(req.Compression))
+			withCompression(newZipConfig(req.Compression)).
+			withBase(base).
+			withSealer(b.sealer)
 
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
@@ -162,6 +178,9 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			SchemaVersion: req.SchemaVersion,
+			BaseID:        req.BaseID,
+			Encrypted:     b.sealer != nil,
 		}
 
 		// the coordinator might want to abort the backup
@@ -183,3 +202,22 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 	return ret, nil
 }
+
+// baseMeta returns this node's part of the base of incremental backup req.
+// It is nil if the node didn't take part in the base, in which case all
+// files are captured.
+func (b *backupper) baseMeta(ctx context.Context, store nodeStore, req *Request) (*backup.BackupDescriptor, error) {
+	bstore := store.sibling(req.BaseID, b.node)
+	meta, err := bstore.Meta(ctx, req.BaseID, req.Bucket, req.Path, false)
+	if err != nil {
+		nerr := backup.ErrNotFound{}
+		if errors.As(err, &nerr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get base backup %q: %w", req.BaseID, err)
+	}
+	if meta.Status != string(backup.Success) {
+		return nil, fmt.Errorf("base backup %q has status %s", req.BaseID, meta.Status)
+	}
+	return meta, nil
+}
@@ -233,6 +233,7 @@ func TestManagerCreateBackup(t *testing.T) {
 		sourcer := &fakeSourcer{}
 		// first
 		sourcer.On("Backupable", ctx, req1.Include).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		sourcer.On("CreateBackup", ctx, any).Return(nil, nil)
 		sourcer.On("ReleaseBackup", ctx, any).Return(nil)
 		var ch <-chan backup.ClassDescriptor
@@ -244,6 +245,7 @@ func TestManagerCreateBackup(t *testing.T) {
 		backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
 		backend.On("HomeDir", any, any, any).Return(path)
 		sourcer.On("Backupable", any, req1.Include).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		sourcer.On("CreateBackup", ctx, any).Return(nil, ErrAny)
 		sourcer.On("ReleaseBackup", ctx, any).Return(nil)
@@ -272,6 +274,7 @@ func TestManagerCreateBackup(t *testing.T) {
 
 		sourcer := &fakeSourcer{}
 		sourcer.On("Backupable", ctx, classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		backend := &fakeBackend{}
 		backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
 		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(nil, errNotFound)
@@ -300,6 +303,7 @@ func TestManagerCreateBackup(t *testing.T) {
 			backend    = newFakeBackend()
 		)
 		sourcer.On("Backupable", ctx, classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		ch := fakeBackupDescriptor(genClassDescriptions(t, sourcePath, cls, cls2)...)
 		sourcer.On("BackupDescriptors", any, backupID, mock.Anything).Return(ch)
 		sourcer.On("ReleaseBackup", ctx, backupID, mock.Anything).Return(nil)
@@ -339,6 +343,7 @@ func TestManagerCreateBackup(t *testing.T) {
 			backend    = newFakeBackend()
 		)
 		sourcer.On("Backupable", ctx, classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		ch := fakeBackupDescriptor(genClassDescriptions(t, sourcePath, cls, cls2)...)
 		sourcer.On("BackupDescriptors", any, backupID, mock.Anything).Return(ch)
 		sourcer.On("ReleaseBackup", ctx, backupID, mock.Anything).Return(nil)
@@ -382,6 +387,7 @@ func TestManagerCreateBackup(t *testing.T) {
 		)
 
 		sourcer.On("Backupable", ctx, classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		cs := genClassDescriptions(t, sourcePath, cls, cls2)
 		cs[1].Error = ErrAny
 		ch := fakeBackupDescriptor(cs...)
@@ -444,6 +450,20 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		assert.Contains(t, ret.Err, backendName)
 	})
 
+	t.Run("SchemaBehindSchemaVersion", func(t *testing.T) {
+		req := req
+		req.SchemaVersion = 5
+		backend := &fakeBackend{}
+		backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		schema := &fakeSchemaManger{nodeName: nodeName, errWaitForUpdate: ErrAny}
+		bm := createManager(nil, schema, backend, nil)
+
+		resp := bm.OnCanCommit(ctx, &req)
+		assert.Contains(t, resp.Err, ErrAny.Error())
+		assert.Equal(t, uint64(5), schema.waitedFor)
+		assert.Equal(t, resp.Timeout, time.Duration(0))
+	})
+
 	t.Run("ClassNotBackupable", func(t *testing.T) {
 		backend := &fakeBackend{}
 		backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
@@ -463,6 +483,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(nil, errNotFound)
 		sourcer := &fakeSourcer{}
 		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		backend.On("Initialize", ctx, nodeHome).Return(errors.New("init meta failed"))
 		bm := createManager(sourcer, nil, backend, nil)
 
@@ -475,8 +496,9 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		// first
 		sourcer := &fakeSourcer{}
 		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		sourcer.On("CreateBackup", mock.Anything, mock.Anything).Return(nil, nil)
-		sourcer.On("ReleaseBackup", mock.Anything, mock.Anything).Return(nil)
+		sourcer.On("ReleaseBackup", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		var ch <-chan backup.ClassDescriptor
 		sourcer.On("BackupDescriptors", any, any, any).Return(ch)
 
@@ -506,6 +528,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		)
 
 		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		ch := fakeBackupDescriptor(genClassDescriptions(t, sourcePath, cls, cls2)...)
 		sourcer.On("BackupDescriptors", any, backupID, mock.Anything).Return(ch)
 		sourcer.On("ReleaseBackup", ctx, backupID, mock.Anything).Return(nil)
@@ -539,6 +562,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		)
 
 		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		ch := fakeBackupDescriptor(genClassDescriptions(t, sourcePath, cls, cls2)...)
 		sourcer.On("BackupDescriptors", any, backupID, mock.Anything).Return(ch)
 		sourcer.On("ReleaseBackup", ctx, backupID, mock.Anything).Return(nil)
@@ -573,6 +597,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		)
 
 		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		ch := fakeBackupDescriptor(genClassDescriptions(t, sourcePath, cls, cls2)...)
 		sourcer.On("BackupDescriptors", any, backupID, mock.Anything).Return(ch).RunFn = func(a mock.Arguments) {
 			m.OnAbort(ctx, &AbortRequest{OpCreate, req.ID, backendName, "", ""})
@@ -611,6 +636,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		)
 
 		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
+		sourcer.On("HoldWrites", any, any, any).Return(nil)
 		ch := fakeBackupDescriptor(genClassDescriptions(t, sourcePath, cls, cls2)...)
 		sourcer.On("BackupDescriptors", any, backupID, mock.Anything).Return(ch)
 		sourcer.On("ReleaseBackup", ctx, backupID, mock.Anything).Return(nil)
@@ -691,5 +717,5 @@ func createManager(sourcer Sourcer, schema schemaManger, backend modulecapabilit
 	}
 
 	logger, _ := test.NewNullLogger()
-	return NewHandler(logger, mocks.NewMockAuthorizer(), schema, sourcer, backends)
+	return NewHandler(logger, mocks.NewMockAuthorizer(), schema, sourcer, backends, nil)
 }
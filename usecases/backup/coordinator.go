@@ -100,6 +100,9 @@ type coordinator struct {
 	Participants map[string]participantStatus
 	descriptor   *backup.DistributedBackupDescriptor
 	shardSyncChan
+	// sealer signs the descriptor, it is nil if no encryption key is
+	// configured
+	sealer *Sealer
 
 	// timeouts
 	timeoutNodeDown    time.Duration
@@ -178,6 +181,8 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		ServerVersion: config.ServerVersion,
 		Leader:        leader,
 	}
+	c.descriptor.SchemaVersion = c.schema.ClassesVersion(c.descriptor.Classes())
+	c.descriptor.BaseID = req.BaseID
 
 	for key := range c.Participants {
 		delete(c.Participants, key)
@@ -191,7 +196,7 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 
 	overrideBucket := req.Bucket
 	overridePath := req.Path
-	if err := cstore.PutMeta(ctx, GlobalBackupFile, c.descriptor, overrideBucket, overridePath); err != nil {
+	if err := c.putMeta(ctx, cstore, GlobalBackupFile, overrideBucket, overridePath); err != nil {
 		c.lastOp.reset()
 		return fmt.Errorf("coordinator: cannot init meta file: %w", err)
 	}
@@ -209,7 +214,7 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		ctx := context.Background()
 		c.commit(ctx, &statusReq, nodes, false)
 		logFields := logrus.Fields{"action": OpCreate, "backup_id": req.ID}
-		if err := cstore.PutMeta(ctx, GlobalBackupFile, c.descriptor, overrideBucket, overridePath); err != nil {
+		if err := c.putMeta(ctx, cstore, GlobalBackupFile, overrideBucket, overridePath); err != nil {
 			c.log.WithFields(logFields).Errorf("coordinator: put_meta: %v", err)
 		}
 		if c.descriptor.Status == backup.Success {
@@ -223,6 +228,18 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 	return nil
 }
 
+// putMeta signs the descriptor if needed and uploads it as filename
+func (c *coordinator) putMeta(ctx context.Context, store coordStore,
+	filename, overrideBucket, overridePath string,
+) error {
+	if c.sealer != nil {
+		if err := c.sealer.signGlobal(c.descriptor); err != nil {
+			return err
+		}
+	}
+	return store.PutMeta(ctx, filename, c.descriptor, overrideBucket, overridePath)
+}
+
 // Restore coordinates a distributed restoration among participants
 func (c *coordinator) Restore(
 	ctx context.Context,
@@ -252,7 +269,7 @@ func (c *coordinator) Restore(
 	overridePath := req.Path
 
 	// initial put so restore status is immediately available
-	if err := store.PutMeta(ctx, GlobalRestoreFile, c.descriptor, overrideBucket, overridePath); err != nil {
+	if err := c.putMeta(ctx, store, GlobalRestoreFile, overrideBucket, overridePath); err != nil {
 		c.lastOp.reset()
 		req := &AbortRequest{Method: OpRestore, ID: desc.ID, Backend: req.Backend}
 		c.abortAll(ctx, req, nodes)
@@ -266,7 +283,7 @@ func (c *coordinator) Restore(
 		c.commit(ctx, &statusReq, nodes, true)
 		c.restoreClasses(ctx, schema, req)
 		logFields := logrus.Fields{"action": OpRestore, "backup_id": desc.ID}
-		if err := store.PutMeta(ctx, GlobalRestoreFile, c.descriptor, overrideBucket, overridePath); err != nil {
+		if err := c.putMeta(ctx, store, GlobalRestoreFile, overrideBucket, overridePath); err != nil {
 			c.log.WithFields(logFields).Errorf("coordinator: put_meta: %v", err)
 		}
 		if c.descriptor.Status == backup.Success {
@@ -299,7 +316,11 @@ func (c *coordinator) restoreClasses(
 		if hasReqClasses && !slices.Contains(req.Classes, cls.Name) {
 			continue
 		}
-		if err := c.schema.RestoreClass(ctx, &cls, req.NodeMapping); err != nil {
+		d, err := remapClass(cls, req.ClassMapping, req.Tenants)
+		if err == nil {
+			err = c.schema.RestoreClass(ctx, &d, req.NodeMapping)
+		}
+		if err != nil {
 			c.descriptor.Error = fmt.Sprintf("restore class %q: %v", cls.Name, err)
 			errors = append(errors, fmt.Sprintf("%q: %v", cls.Name, err))
 		}
@@ -362,6 +383,7 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 	}
 
 	id := c.descriptor.ID
+	schemaVersion := c.descriptor.SchemaVersion
 	nodeMapping := c.descriptor.NodeMapping
 	groups := c.descriptor.Nodes
 
@@ -397,6 +419,9 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 					Compression: req.Compression,
 					Bucket:      req.Bucket,
 					Path:        req.Path,
+
+					SchemaVersion: schemaVersion,
+					BaseID:        req.BaseID,
 				},
 			}
 		}
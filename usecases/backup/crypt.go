@@ -0,0 +1,377 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+const (
+	// segmentSize is the size of the plaintext segments chunks are encrypted in
+	segmentSize = 64 << 10
+	// noncePrefixSize is the size of the random nonce prefix of an encrypted
+	// chunk. The remaining bytes of a nonce hold the segment counter and a flag
+	// marking the last segment, so that truncation is detected.
+	noncePrefixSize = 7
+	// chunkMagic starts every encrypted chunk
+	chunkMagic = "WVBE"
+)
+
+var (
+	errNotSigned        = errors.New("backup is not signed, but an encryption key is configured: set allow_unsigned to restore it anyway")
+	errNoEncryptionKey  = errors.New("backup is encrypted or signed, but no encryption key is configured")
+	errInvalidSignature = errors.New("invalid backup signature: the backup was tampered with or taken with another key")
+)
+
+// Sealer encrypts backup chunks and signs the descriptors of the nodes and
+// of the coordinator. Both keys are derived from a single 256 bit key.
+type Sealer struct {
+	aead   cipher.AEAD
+	macKey []byte
+}
+
+// NewSealer returns a sealer for key, which must be 32 bytes long
+func NewSealer(key []byte) (*Sealer, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("backup encryption key must be 32 bytes long, got %d", len(key))
+	}
+	block, err := aes.NewCipher(deriveKey(key, "weaviate backup encryption"))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Sealer{aead: aead, macKey: deriveKey(key, "weaviate backup signature")}, nil
+}
+
+func deriveKey(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// sign sets the signature of desc
+func (s *Sealer) sign(desc *backup.BackupDescriptor) error {
+	d := *desc
+	d.Signature = ""
+	sig, err := s.signature(&d)
+	if err != nil {
+		return err
+	}
+	desc.Signature = sig
+	return nil
+}
+
+// signGlobal sets the signature of the coordinator's descriptor desc
+func (s *Sealer) signGlobal(desc *backup.DistributedBackupDescriptor) error {
+	d := *desc
+	d.Signature = ""
+	sig, err := s.signature(&d)
+	if err != nil {
+		return err
+	}
+	desc.Signature = sig
+	return nil
+}
+
+// verify checks the signature of desc
+func (s *Sealer) verify(desc *backup.BackupDescriptor) error {
+	d := *desc
+	d.Signature = ""
+	return s.check(&d, desc.Signature)
+}
+
+// verifyGlobal checks the signature of the coordinator's descriptor desc
+func (s *Sealer) verifyGlobal(desc *backup.DistributedBackupDescriptor) error {
+	d := *desc
+	d.Signature = ""
+	return s.check(&d, desc.Signature)
+}
+
+// check compares sig to the signature of the unsigned descriptor desc
+func (s *Sealer) check(desc interface{}, sig string) error {
+	if sig == "" {
+		return errNotSigned
+	}
+	want, err := s.signature(desc)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(want), []byte(sig)) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// signature computes the MAC of the JSON encoding of desc
+func (s *Sealer) signature(desc interface{}) (string, error) {
+	bytes, err := json.Marshal(desc)
+	if err != nil {
+		return "", fmt.Errorf("marshal descriptor: %w", err)
+	}
+	mac := hmac.New(sha256.New, s.macKey)
+	mac.Write(bytes)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verifyMeta checks that meta can be trusted and decrypted with sealer,
+// which is nil if no encryption key is configured. Unsigned backups are
+// rejected once a key is configured, unless allowUnsigned is set, e.g. to
+// restore backups taken before the key was. Signatures which are present
+// are always checked.
+func verifyMeta(sealer *Sealer, meta *backup.BackupDescriptor, allowUnsigned bool) error {
+	if sealer == nil {
+		if meta.Encrypted || meta.Signature != "" {
+			return errNoEncryptionKey
+		}
+		return nil
+	}
+	if allowUnsigned && meta.Signature == "" && !meta.Encrypted {
+		return nil
+	}
+	return sealer.verify(meta)
+}
+
+// verifyGlobalMeta is verifyMeta for the coordinator's descriptor
+func verifyGlobalMeta(sealer *Sealer, meta *backup.DistributedBackupDescriptor, allowUnsigned bool) error {
+	if sealer == nil {
+		if meta.Signature != "" {
+			return errNoEncryptionKey
+		}
+		return nil
+	}
+	if allowUnsigned && meta.Signature == "" {
+		return nil
+	}
+	return sealer.verifyGlobal(meta)
+}
+
+func segmentNonce(dst []byte, prefix []byte, counter uint32, last bool) []byte {
+	copy(dst, prefix)
+	binary.BigEndian.PutUint32(dst[noncePrefixSize:], counter)
+	dst[noncePrefixSize+4] = 0
+	if last {
+		dst[noncePrefixSize+4] = 1
+	}
+	return dst
+}
+
+// chunkSource is the content of a chunk as it is stored. It encrypts the
+// content if a sealer is set and computes the checksum of the stored bytes.
+type chunkSource struct {
+	src  io.ReadCloser
+	r    io.Reader
+	hash hash.Hash
+}
+
+func newChunkSource(src io.ReadCloser, sealer *Sealer) (*chunkSource, error) {
+	c := &chunkSource{src: src, r: src, hash: sha256.New()}
+	if sealer != nil {
+		enc, err := newEncryptingReader(src, sealer.aead)
+		if err != nil {
+			return nil, err
+		}
+		c.r = enc
+	}
+	return c, nil
+}
+
+func (c *chunkSource) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.hash.Write(p[:n])
+	return n, err
+}
+
+func (c *chunkSource) Close() error { return c.src.Close() }
+
+// checksum returns the checksum of the bytes read so far
+func (c *chunkSource) checksum() string { return hex.EncodeToString(c.hash.Sum(nil)) }
+
+// encryptingReader encrypts src segment by segment
+type encryptingReader struct {
+	src     io.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	nonce   []byte
+	counter uint32
+	buf     []byte // plaintext, one byte larger than a segment to look ahead
+	carried int    // bytes of buf carried over from the previous segment
+	sealed  []byte
+	out     []byte // sealed bytes not yet read
+	done    bool
+}
+
+func newEncryptingReader(src io.Reader, aead cipher.AEAD) (*encryptingReader, error) {
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	r := &encryptingReader{
+		src:    src,
+		aead:   aead,
+		prefix: prefix,
+		nonce:  make([]byte, aead.NonceSize()),
+		buf:    make([]byte, segmentSize+1),
+		sealed: make([]byte, 0, segmentSize+aead.Overhead()),
+	}
+	r.out = append([]byte(chunkMagic), prefix...)
+	return r, nil
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(r.src, r.buf[r.carried:])
+		n += r.carried
+		last := false
+		switch {
+		case err == nil:
+			n = segmentSize
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			last = true
+		default:
+			return 0, err
+		}
+		if r.counter == ^uint32(0) {
+			return 0, fmt.Errorf("chunk too large to be encrypted")
+		}
+		nonce := segmentNonce(r.nonce, r.prefix, r.counter, last)
+		r.out = r.aead.Seal(r.sealed[:0], nonce, r.buf[:n], nil)
+		r.counter++
+		if last {
+			r.done = true
+		} else {
+			r.buf[0] = r.buf[segmentSize]
+			r.carried = 1
+		}
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// chunkSink receives a chunk as it is stored and forwards its plain content
+// to dst. It checks the chunk against its checksum and, if the chunk is
+// encrypted, authenticates every segment. Any failure is passed on to the
+// reader of dst.
+type chunkSink struct {
+	dst      *io.PipeWriter
+	hash     hash.Hash
+	checksum string // expected checksum or empty if unknown
+
+	aead    cipher.AEAD // nil if not encrypted
+	header  []byte
+	nonce   []byte
+	counter uint32
+	buf     []byte // sealed bytes not yet decrypted
+	plain   []byte
+	err     error
+}
+
+func newChunkSink(dst *io.PipeWriter, checksum string, sealer *Sealer, encrypted bool) (*chunkSink, error) {
+	s := &chunkSink{dst: dst, hash: sha256.New(), checksum: checksum}
+	if encrypted {
+		if sealer == nil {
+			return nil, errNoEncryptionKey
+		}
+		s.aead = sealer.aead
+		s.nonce = make([]byte, s.aead.NonceSize())
+		s.header = make([]byte, 0, len(chunkMagic)+noncePrefixSize)
+	}
+	return s, nil
+}
+
+func (s *chunkSink) Write(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	s.hash.Write(p)
+	if s.aead == nil {
+		return s.forward(p, len(p))
+	}
+
+	n := len(p)
+	if missing := cap(s.header) - len(s.header); missing > 0 {
+		k := min(missing, len(p))
+		s.header = append(s.header, p[:k]...)
+		p = p[k:]
+		if len(s.header) == cap(s.header) && string(s.header[:len(chunkMagic)]) != chunkMagic {
+			s.err = fmt.Errorf("chunk is not encrypted")
+			return 0, s.err
+		}
+	}
+	s.buf = append(s.buf, p...)
+	// a full segment followed by more data can't be the last one
+	sealedSize := segmentSize + s.aead.Overhead()
+	for len(s.buf) > sealedSize {
+		if err := s.open(s.buf[:sealedSize], false); err != nil {
+			return 0, err
+		}
+		s.buf = s.buf[:copy(s.buf, s.buf[sealedSize:])]
+	}
+	return n, nil
+}
+
+// open decrypts segment and forwards its content
+func (s *chunkSink) open(segment []byte, last bool) (err error) {
+	nonce := segmentNonce(s.nonce, s.header[len(chunkMagic):], s.counter, last)
+	s.plain, err = s.aead.Open(s.plain[:0], nonce, segment, nil)
+	if err != nil {
+		s.err = fmt.Errorf("decrypt chunk segment %d: %w", s.counter, err)
+		return s.err
+	}
+	s.counter++
+	_, err = s.forward(s.plain, len(s.plain))
+	return err
+}
+
+func (s *chunkSink) forward(p []byte, n int) (int, error) {
+	if _, err := s.dst.Write(p); err != nil {
+		s.err = err
+		return 0, err
+	}
+	return n, nil
+}
+
+// Close verifies the end of the chunk and closes dst
+func (s *chunkSink) Close() error {
+	if s.err == nil && s.aead != nil {
+		if len(s.header) < cap(s.header) {
+			s.err = fmt.Errorf("chunk is truncated")
+		} else {
+			s.open(s.buf, true)
+		}
+	}
+	if s.err == nil && s.checksum != "" {
+		if sum := hex.EncodeToString(s.hash.Sum(nil)); sum != s.checksum {
+			s.err = fmt.Errorf("chunk checksum mismatch: want %s got %s", s.checksum, sum)
+		}
+	}
+	return s.dst.CloseWithError(s.err)
+}
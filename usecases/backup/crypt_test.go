@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestChunkEncryption(t *testing.T) {
+	sealer, err := NewSealer(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+
+	for _, size := range []int{0, 1, segmentSize - 1, segmentSize, segmentSize + 1, 3*segmentSize + 17} {
+		data := make([]byte, size)
+		rand.New(rand.NewSource(int64(size))).Read(data)
+
+		for _, s := range []*Sealer{nil, sealer} {
+			stored, sum := storeChunk(t, data, s)
+			if s != nil && size > 0 {
+				assert.False(t, bytes.Contains(stored, data), "chunk must be encrypted")
+			}
+			got, err := loadChunk(stored, sum, sealer, s != nil)
+			require.Nil(t, err, "size=%d encrypted=%v", size, s != nil)
+			assert.Equal(t, data, got, "size=%d encrypted=%v", size, s != nil)
+		}
+	}
+
+	data := make([]byte, 2*segmentSize+100)
+	rand.New(rand.NewSource(1)).Read(data)
+	stored, sum := storeChunk(t, data, sealer)
+
+	t.Run("Tampered", func(t *testing.T) {
+		tampered := bytes.Clone(stored)
+		tampered[len(tampered)/2] ^= 1
+		_, err := loadChunk(tampered, "", sealer, true)
+		assert.ErrorContains(t, err, "decrypt chunk segment")
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		// dropping whole segments must be detected as well
+		_, err := loadChunk(stored[:len(stored)-100-sealer.aead.Overhead()], "", sealer, true)
+		assert.ErrorContains(t, err, "decrypt chunk segment")
+	})
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		plain, plainSum := storeChunk(t, data, nil)
+		plain[0] ^= 1
+		_, err := loadChunk(plain, plainSum, nil, false)
+		assert.ErrorContains(t, err, "checksum mismatch")
+	})
+	t.Run("WrongKey", func(t *testing.T) {
+		other, err := NewSealer(bytes.Repeat([]byte{2}, 32))
+		require.Nil(t, err)
+		_, err = loadChunk(stored, sum, other, true)
+		assert.NotNil(t, err)
+	})
+	t.Run("NoKey", func(t *testing.T) {
+		_, err := loadChunk(stored, sum, nil, true)
+		assert.ErrorIs(t, err, errNoEncryptionKey)
+	})
+}
+
+func TestMetaSignature(t *testing.T) {
+	sealer, err := NewSealer(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	desc := &backup.BackupDescriptor{
+		ID:        "1",
+		StartedAt: time.Now().UTC(),
+		Encrypted: true,
+		Classes: []backup.ClassDescriptor{{
+			Name:      "C1",
+			Checksums: map[int32]string{1: "abc"},
+		}},
+	}
+	require.Nil(t, sealer.sign(desc))
+
+	// the signature must survive a round trip through the object store
+	got := &backup.BackupDescriptor{}
+	require.Nil(t, json.Unmarshal(marshalMeta(*desc), got))
+	assert.Nil(t, verifyMeta(sealer, got, false))
+
+	got.Classes[0].Checksums[1] = "abd"
+	assert.ErrorIs(t, verifyMeta(sealer, got, false), errInvalidSignature)
+	assert.ErrorIs(t, verifyMeta(sealer, got, true), errInvalidSignature)
+	assert.ErrorIs(t, verifyMeta(nil, got, false), errNoEncryptionKey)
+	assert.ErrorIs(t, verifyMeta(sealer, &backup.BackupDescriptor{ID: "1"}, false), errNotSigned)
+	assert.Nil(t, verifyMeta(sealer, &backup.BackupDescriptor{ID: "1"}, true))
+	assert.ErrorIs(t, verifyMeta(sealer, &backup.BackupDescriptor{ID: "1", Encrypted: true}, true), errNotSigned)
+	assert.Nil(t, verifyMeta(nil, &backup.BackupDescriptor{ID: "1"}, false))
+}
+
+func TestGlobalMetaSignature(t *testing.T) {
+	sealer, err := NewSealer(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	desc := &backup.DistributedBackupDescriptor{
+		ID:        "1",
+		StartedAt: time.Now().UTC(),
+		Status:    backup.Success,
+		Nodes: map[string]*backup.NodeDescriptor{
+			"N1": {Classes: []string{"C1"}, Status: backup.Success},
+		},
+	}
+	require.Nil(t, sealer.signGlobal(desc))
+
+	got := &backup.DistributedBackupDescriptor{}
+	require.Nil(t, json.Unmarshal(marshalCoordinatorMeta(*desc), got))
+	assert.Nil(t, verifyGlobalMeta(sealer, got, false))
+
+	got.Nodes["N1"].Classes = append(got.Nodes["N1"].Classes, "C2")
+	assert.ErrorIs(t, verifyGlobalMeta(sealer, got, false), errInvalidSignature)
+	assert.ErrorIs(t, verifyGlobalMeta(sealer, got, true), errInvalidSignature)
+	assert.ErrorIs(t, verifyGlobalMeta(nil, got, false), errNoEncryptionKey)
+	assert.ErrorIs(t, verifyGlobalMeta(sealer, &backup.DistributedBackupDescriptor{ID: "1"}, false), errNotSigned)
+	assert.Nil(t, verifyGlobalMeta(sealer, &backup.DistributedBackupDescriptor{ID: "1"}, true))
+	assert.Nil(t, verifyGlobalMeta(nil, &backup.DistributedBackupDescriptor{ID: "1"}, false))
+}
+
+// storeChunk returns data as it would be stored and its checksum
+func storeChunk(t *testing.T, data []byte, sealer *Sealer) ([]byte, string) {
+	src, err := newChunkSource(io.NopCloser(bytes.NewReader(data)), sealer)
+	require.Nil(t, err)
+	stored, err := io.ReadAll(src)
+	require.Nil(t, err)
+	return stored, src.checksum()
+}
+
+// loadChunk returns the plain content of a stored chunk
+func loadChunk(stored []byte, checksum string, sealer *Sealer, encrypted bool) ([]byte, error) {
+	pr, pw := io.Pipe()
+	sink, err := newChunkSink(pw, checksum, sealer, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		// write in odd sizes to exercise the segment boundaries
+		for r := bytes.NewReader(stored); r.Len() > 0; {
+			buf := make([]byte, min(r.Len(), 1000+r.Len()%7))
+			r.Read(buf)
+			if _, err := sink.Write(buf); err != nil {
+				break
+			}
+		}
+		sink.Close()
+	}()
+	return io.ReadAll(pr)
+}
@@ -61,6 +61,11 @@ func (s *fakeSourcer) ReleaseBackup(ctx context.Context, id, class string) error
 	return args.Error(0)
 }
 
+func (s *fakeSourcer) HoldWrites(ctx context.Context, id string, classes []string) error {
+	args := s.Called(ctx, id, classes)
+	return args.Error(0)
+}
+
 func (s *fakeSourcer) Backupable(ctx context.Context, classes []string) error {
 	args := s.Called(ctx, classes)
 	return args.Error(0)
@@ -26,8 +26,10 @@ import (
 // Version of backup structure
 const (
 	// Version > version1 support compression
+	// "2.2" support incremental backups
+	Version = "2.2"
 	// "2.1" support restore on 2 phases
-	Version = "2.1"
+	// Version = "2.1"
 	// "2.0" support compression
 	// Version = "2.0"
 	// version1 store plain files without compression
@@ -47,6 +49,13 @@ type BackupBackendProvider interface {
 type schemaManger interface {
 	RestoreClass(ctx context.Context, d *backup.ClassDescriptor, nodeMapping map[string]string) error
 	NodeName() string
+	// ClassesVersion returns the highest schema version of the given classes
+	ClassesVersion(classes []string) uint64
+	// WaitForUpdate ensures that the local schema has caught up to version
+	WaitForUpdate(ctx context.Context, version uint64) error
+	// ClassEqual returns the name of an existing class which equals name
+	// ignoring case, and "" otherwise
+	ClassEqual(name string) string
 }
 
 type nodeResolver interface {
@@ -72,6 +81,7 @@ type Handler struct {
 	// deps
 	logger     logrus.FieldLogger
 	authorizer authorization.Authorizer
+	schema     schemaManger
 	backupper  *backupper
 	restorer   *restorer
 	backends   BackupBackendProvider
@@ -83,19 +93,23 @@ func NewHandler(
 	schema schemaManger,
 	sourcer Sourcer,
 	backends BackupBackendProvider,
+	sealer *Sealer,
 ) *Handler {
 	node := schema.NodeName()
 	m := &Handler{
 		node:       node,
 		logger:     logger,
 		authorizer: authorizer,
+		schema:     schema,
 		backends:   backends,
 		backupper: newBackupper(node, logger,
 			sourcer,
-			backends),
+			backends,
+			sealer),
 		restorer: newRestorer(node, logger,
 			sourcer,
 			backends,
+			sealer,
 		),
 	}
 	return m
@@ -142,6 +156,24 @@ type BackupRequest struct {
 
 	// Override path (optional) - replaces environement variable for one call
 	Path string
+
+	// BaseID (optional) is the ID of a successful backup stored on the same
+	// backend, bucket and path. If set, only files which changed since
+	// are captured.
+	BaseID string
+
+	// ClassMapping (optional) restores classes under a different name.
+	// Keys are class names in the backup, values are the new names.
+	ClassMapping map[string]string
+
+	// Tenants (optional) restores only the listed tenants of a
+	// multi-tenant class. Keys are class names in the backup.
+	Tenants map[string][]string
+
+	// AllowUnsigned (optional) restores backups which are not signed even
+	// though an encryption key is configured, e.g. backups taken before the
+	// key was. Signatures which are present are still checked.
+	AllowUnsigned bool
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
@@ -168,6 +200,12 @@ func (m *Handler) OnCanCommit(ctx context.Context, req *Request) *CanCommitRespo
 
 	switch req.Method {
 	case OpCreate:
+		// the schema must be at the version chosen by the coordinator, so that
+		// every node captures the same classes and sharding state
+		if err := m.schema.WaitForUpdate(ctx, req.SchemaVersion); err != nil {
+			ret.Err = fmt.Sprintf("wait for schema version %d: %v", req.SchemaVersion, err)
+			return ret
+		}
 		if err := m.backupper.sourcer.Backupable(ctx, req.Classes); err != nil {
 			ret.Err = err.Error()
 			return ret
@@ -176,7 +214,7 @@ func (m *Handler) OnCanCommit(ctx context.Context, req *Request) *CanCommitRespo
 			ret.Err = fmt.Sprintf("init uploader: %v", err)
 			return ret
 		}
-		res, err := m.backupper.backup(store, req)
+		res, err := m.backupper.backup(ctx, store, req)
 		if err != nil {
 			ret.Err = err.Error()
 			return ret
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -104,12 +105,18 @@ func (m *Handler) validateRestoreRequest(ctx context.Context, store nodeStore, r
 }
 
 type fakeSchemaManger struct {
-	errRestoreClass error
-	nodeName        string
+	errRestoreClass  error
+	nodeName         string
+	classesVersion   uint64
+	errWaitForUpdate error
+	waitedFor        uint64
+	classes          []string
+	restored         []backup.ClassDescriptor
 }
 
-func (f *fakeSchemaManger) RestoreClass(context.Context, *backup.ClassDescriptor, map[string]string,
+func (f *fakeSchemaManger) RestoreClass(_ context.Context, d *backup.ClassDescriptor, _ map[string]string,
 ) error {
+	f.restored = append(f.restored, *d)
 	return f.errRestoreClass
 }
 
@@ -117,6 +124,24 @@ func (f *fakeSchemaManger) NodeName() string {
 	return f.nodeName
 }
 
+func (f *fakeSchemaManger) ClassesVersion([]string) uint64 {
+	return f.classesVersion
+}
+
+func (f *fakeSchemaManger) WaitForUpdate(_ context.Context, version uint64) error {
+	f.waitedFor = version
+	return f.errWaitForUpdate
+}
+
+func (f *fakeSchemaManger) ClassEqual(name string) string {
+	for _, c := range f.classes {
+		if strings.EqualFold(c, name) {
+			return c
+		}
+	}
+	return ""
+}
+
 func TestFilterClasses(t *testing.T) {
 	tests := []struct {
 		in  []string
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// tailBlockSize is the size of the block at the end of an append-only file
+// whose checksum is recorded, see backup.FileInfo.TailSum
+const tailBlockSize = 4 << 10
+
+// appendOnly reports whether the file at relPath is only ever appended to,
+// which is the case for HNSW commit logs. Other files which are part of a
+// backup are either immutable or rewritten as a whole.
+func appendOnly(relPath string) bool {
+	return strings.Contains(filepath.ToSlash(relPath), ".hnsw.commitlog.d/")
+}
+
+// shardManifest describes the files of shard sd for backup backupID.
+//
+// Files which did not change since base reference the parts recorded by base,
+// append-only files which only grew get an additional part for the appended
+// range, and all other files get a single part to be written by this backup.
+// base is nil for a full backup.
+func shardManifest(sourcePath, backupID string, sd, base *backup.ShardDescriptor,
+) (map[string]*backup.FileInfo, error) {
+	var prev map[string]*backup.FileInfo
+	if base != nil {
+		prev = base.Manifest
+	}
+	m := make(map[string]*backup.FileInfo, len(sd.Files))
+	for _, relPath := range sd.Files {
+		if filepath.Base(relPath) == ".DS_Store" {
+			continue
+		}
+		info, err := newFileInfo(filepath.Join(sourcePath, relPath), appendOnly(relPath), backupID, prev[relPath])
+		if err != nil {
+			return nil, fmt.Errorf("manifest %s: %w", relPath, err)
+		}
+		if info != nil {
+			m[relPath] = info
+		}
+	}
+	return m, nil
+}
+
+// newFileInfo returns the description of the file at absPath, or nil if it
+// isn't a regular file
+func newFileInfo(absPath string, appendable bool, backupID string, prev *backup.FileInfo,
+) (*backup.FileInfo, error) {
+	f, err := os.Open(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat: %w", err)
+	}
+	if !st.Mode().IsRegular() {
+		return nil, nil
+	}
+	info := &backup.FileInfo{Size: st.Size(), ModTime: st.ModTime().UTC()}
+	if appendable {
+		if info.TailSum, err = tailSum(f, info.Size); err != nil {
+			return nil, err
+		}
+	}
+
+	// parts of a base which failed half way are not usable
+	reusable := prev != nil && len(prev.Parts) > 0 && prev.Pending() == nil
+	switch {
+	case reusable && prev.Size == info.Size && prev.ModTime.Equal(info.ModTime):
+		info.Parts = prev.Parts
+	case reusable && appendable && prev.Size > 0 && info.Size > prev.Size && grewFrom(f, prev):
+		info.Parts = append(slices.Clip(prev.Parts), backup.FilePart{BackupID: backupID, Offset: prev.Size})
+	default:
+		info.Parts = []backup.FilePart{{BackupID: backupID}}
+	}
+	return info, nil
+}
+
+// grewFrom reports whether f still starts with the content prev described
+func grewFrom(f io.ReaderAt, prev *backup.FileInfo) bool {
+	sum, err := tailSum(f, prev.Size)
+	return err == nil && sum == prev.TailSum
+}
+
+// tailSum returns the checksum of the block of f which ends at offset end
+func tailSum(f io.ReaderAt, end int64) (uint32, error) {
+	start := max(end-tailBlockSize, 0)
+	buf := make([]byte, end-start)
+	if n, err := f.ReadAt(buf, start); n < len(buf) {
+		return 0, fmt.Errorf("read tail block: %w", err)
+	}
+	return crc32.ChecksumIEEE(buf), nil
+}
+
+// inheritedChunk identifies a chunk of an earlier backup of the chain
+type inheritedChunk struct {
+	backupID string
+	node     string
+	chunk    int32
+}
+
+// inheritedParts groups the file parts of class desc which are stored by
+// earlier backups than backupID by the chunk containing them. For each chunk
+// it maps the relative file path to the offset of its part.
+func inheritedParts(backupID string, desc *backup.ClassDescriptor) map[inheritedChunk]map[string]int64 {
+	refs := make(map[inheritedChunk]map[string]int64)
+	for _, s := range desc.Shards {
+		for relPath, info := range s.Manifest {
+			for _, p := range info.Parts {
+				if p.BackupID == backupID {
+					continue
+				}
+				key := inheritedChunk{backupID: p.BackupID, node: s.Node, chunk: p.Chunk}
+				if refs[key] == nil {
+					refs[key] = make(map[string]int64)
+				}
+				refs[key][relPath] = p.Offset
+			}
+		}
+	}
+	return refs
+}
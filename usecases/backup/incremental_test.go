@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestShardManifest(t *testing.T) {
+	var (
+		src     = t.TempDir()
+		segment = "cls/shard/lsm/objects/segment-1.db"
+		log     = "cls/shard/main.hnsw.commitlog.d/1700000000"
+		meta    = "cls/shard/meta.db"
+		sd      = &backup.ShardDescriptor{Name: "shard", Files: []string{segment, log, meta}}
+	)
+	writeFile(t, src, segment, []byte("immutable"))
+	writeFile(t, src, log, []byte("first entries"))
+	writeFile(t, src, meta, []byte("v1"))
+
+	full, err := shardManifest(src, "b1", sd, nil)
+	require.Nil(t, err)
+	for _, relPath := range sd.Files {
+		assert.Equal(t, []backup.FilePart{{BackupID: "b1"}}, full[relPath].Parts, relPath)
+	}
+	setChunk(full, 1)
+	base := &backup.ShardDescriptor{Name: "shard", Manifest: full}
+
+	appendFile(t, src, log, []byte(", more entries"))
+	writeFile(t, src, meta, []byte("v2"))
+	// make sure the modification time differs on file systems with a coarse resolution
+	later := time.Now().Add(time.Minute)
+	require.Nil(t, os.Chtimes(filepath.Join(src, meta), later, later))
+
+	incr, err := shardManifest(src, "b2", sd, base)
+	require.Nil(t, err)
+	assert.Equal(t, full[segment].Parts, incr[segment].Parts)
+	assert.Nil(t, incr[segment].Pending())
+	assert.Equal(t, []backup.FilePart{
+		{BackupID: "b1", Chunk: 1},
+		{BackupID: "b2", Offset: int64(len("first entries"))},
+	}, incr[log].Parts)
+	assert.Equal(t, []backup.FilePart{{BackupID: "b2"}}, incr[meta].Parts)
+
+	t.Run("RewrittenLog", func(t *testing.T) {
+		writeFile(t, src, log, []byte("rewritten entirely, not appended"))
+		m, err := shardManifest(src, "b3", sd, base)
+		require.Nil(t, err)
+		assert.Equal(t, []backup.FilePart{{BackupID: "b3"}}, m[log].Parts)
+	})
+}
+
+func TestIncrementalZipRoundTrip(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		src  = t.TempDir()
+		dst  = t.TempDir()
+		seg  = "cls/shard/lsm/objects/segment-1.db"
+		old  = "cls/shard/lsm/objects/segment-0.db"
+		log  = "cls/shard/main.hnsw.commitlog.d/1700000000"
+		desc = func(files ...string) *backup.ShardDescriptor {
+			return &backup.ShardDescriptor{
+				Name: "shard", Files: files,
+				DocIDCounterPath: "cls/shard/indexcount", DocIDCounter: []byte("1"),
+				PropLengthTrackerPath: "cls/shard/proplengths", PropLengthTracker: []byte("2"),
+				ShardVersionPath: "cls/shard/version", Version: []byte("3"),
+			}
+		}
+	)
+	writeFile(t, src, seg, []byte("segment"))
+	writeFile(t, src, old, []byte("compacted away later"))
+	writeFile(t, src, log, []byte("0123456789"))
+
+	sd1 := desc(seg, old, log)
+	m, err := shardManifest(src, "b1", sd1, nil)
+	require.Nil(t, err)
+	sd1.Manifest = m
+	chunk1 := zipShard(t, ctx, src, sd1)
+	setChunk(sd1.Manifest, 1)
+
+	require.Nil(t, os.Remove(filepath.Join(src, old)))
+	appendFile(t, src, log, []byte("abcdef"))
+	sd2 := desc(seg, log)
+	m, err = shardManifest(src, "b2", sd2, sd1)
+	require.Nil(t, err)
+	sd2.Manifest = m
+	chunk2 := zipShard(t, ctx, src, sd2)
+	setChunk(sd2.Manifest, 1)
+
+	// restore b2, taking what b2 doesn't hold from b1
+	unzipChunk(t, dst, chunk2, nil)
+	cd := &backup.ClassDescriptor{Name: "cls", Shards: []*backup.ShardDescriptor{sd2}}
+	for ref, files := range inheritedParts("b2", cd) {
+		assert.Equal(t, inheritedChunk{backupID: "b1", chunk: 1}, ref)
+		unzipChunk(t, dst, chunk1, func(relPath string, offset int64) bool {
+			o, ok := files[relPath]
+			return ok && o == offset
+		})
+	}
+
+	for _, relPath := range []string{seg, log} {
+		want, err := os.ReadFile(filepath.Join(src, relPath))
+		require.Nil(t, err)
+		got, err := os.ReadFile(filepath.Join(dst, relPath))
+		require.Nil(t, err)
+		assert.Equal(t, want, got, relPath)
+	}
+	_, err = os.Stat(filepath.Join(dst, old))
+	assert.True(t, os.IsNotExist(err), "file removed before the increment must not be restored")
+}
+
+func zipShard(t *testing.T, ctx context.Context, src string, sd *backup.ShardDescriptor) []byte {
+	z, rc := NewZip(src, 0)
+	go func() {
+		if _, err := z.WriteShard(ctx, sd); err != nil {
+			t.Errorf("compress: %v", err)
+		}
+		z.Close()
+	}()
+	buf, err := io.ReadAll(rc)
+	require.Nil(t, err)
+	return buf
+}
+
+func unzipChunk(t *testing.T, dst string, chunk []byte, filter func(string, int64) bool) {
+	uz, wc := NewUnzip(dst)
+	uz.filter = filter
+	go func() {
+		io.Copy(wc, bytes.NewReader(chunk))
+		wc.Close()
+	}()
+	_, err := uz.ReadChunk()
+	require.Nil(t, err)
+	require.Nil(t, uz.Close())
+}
+
+func setChunk(m map[string]*backup.FileInfo, chunk int32) {
+	for _, info := range m {
+		if p := info.Pending(); p != nil {
+			p.Chunk = chunk
+		}
+	}
+}
+
+func writeFile(t *testing.T, dir, relPath string, data []byte) {
+	p := filepath.Join(dir, relPath)
+	require.Nil(t, os.MkdirAll(filepath.Dir(p), os.ModePerm))
+	require.Nil(t, os.WriteFile(p, data, 0o644))
+}
+
+func appendFile(t *testing.T, dir, relPath string, data []byte) {
+	f, err := os.OpenFile(filepath.Join(dir, relPath), os.O_APPEND|os.O_WRONLY, 0o644)
+	require.Nil(t, err)
+	defer f.Close()
+	_, err = f.Write(data)
+	require.Nil(t, err)
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// targetClass returns the name class is restored as
+func targetClass(mapping map[string]string, class string) string {
+	if name, ok := mapping[class]; ok {
+		return schema.UppercaseClassName(name)
+	}
+	return class
+}
+
+// validateClassMapping checks that classes can be restored under their
+// target names: names must be valid and must neither collide with each
+// other nor with a class of the live schema.
+func validateClassMapping(sm schemaManger, meta *backup.DistributedBackupDescriptor, req *BackupRequest) error {
+	// older backups store shard files in a flat layout
+	if (len(req.ClassMapping) > 0 || len(req.Tenants) > 0) && meta.ServerVersion < "1.23" {
+		return fmt.Errorf("class mapping and tenants require a backup taken by version 1.23 or later, got %s",
+			meta.ServerVersion)
+	}
+	classes := meta.Classes()
+	for class, name := range req.ClassMapping {
+		if !slices.Contains(classes, class) {
+			return fmt.Errorf("class mapping: class %s is not being restored: please choose from: %v", class, classes)
+		}
+		if _, err := schema.ValidateClassName(schema.UppercaseClassName(name)); err != nil {
+			return fmt.Errorf("class mapping: %w", err)
+		}
+	}
+	for class := range req.Tenants {
+		if !slices.Contains(classes, class) {
+			return fmt.Errorf("tenants: class %s is not being restored: please choose from: %v", class, classes)
+		}
+		if dup := findDuplicate(req.Tenants[class]); dup != "" {
+			return fmt.Errorf("tenants of class %s contain duplicate: %s", class, dup)
+		}
+	}
+
+	// class directories are named after the lower case class name
+	targets := make(map[string]string, len(classes))
+	for _, class := range classes {
+		name := targetClass(req.ClassMapping, class)
+		if prev, ok := targets[strings.ToLower(name)]; ok {
+			return fmt.Errorf("classes %s and %s would both be restored as %s", prev, class, name)
+		}
+		targets[strings.ToLower(name)] = class
+		if existing := sm.ClassEqual(name); existing != "" {
+			return fmt.Errorf("cannot restore class %s as %s: class %s already exists", class, name, existing)
+		}
+	}
+	return nil
+}
+
+// remapClass returns a copy of d whose schema is renamed according to
+// mapping and whose sharding state only holds the tenants selected for it
+func remapClass(d backup.ClassDescriptor, mapping map[string]string, tenants map[string][]string) (backup.ClassDescriptor, error) {
+	selected, partial := tenants[d.Name]
+	if len(mapping) == 0 && !partial {
+		return d, nil
+	}
+	name := targetClass(mapping, d.Name)
+
+	class := &models.Class{}
+	if err := json.Unmarshal(d.Schema, class); err != nil {
+		return d, fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	class.Class = name
+	// references to renamed classes must follow them
+	for _, p := range class.Properties {
+		for i, dt := range p.DataType {
+			if _, ok := mapping[dt]; ok {
+				p.DataType[i] = targetClass(mapping, dt)
+			}
+		}
+	}
+	schemaJSON, err := json.Marshal(class)
+	if err != nil {
+		return d, fmt.Errorf("marshal class schema: %w", err)
+	}
+
+	var ss sharding.State
+	if d.ShardingState != nil {
+		if err := json.Unmarshal(d.ShardingState, &ss); err != nil {
+			return d, fmt.Errorf("unmarshal sharding state: %w", err)
+		}
+	}
+	if partial {
+		if !ss.PartitioningEnabled {
+			return d, fmt.Errorf("class %s is not multi-tenant: tenants cannot be selected", d.Name)
+		}
+		for _, t := range selected {
+			if _, ok := ss.Physical[t]; !ok {
+				return d, fmt.Errorf("tenant %s of class %s doesn't exist in the backup", t, d.Name)
+			}
+		}
+		for t := range ss.Physical {
+			if !slices.Contains(selected, t) {
+				delete(ss.Physical, t)
+			}
+		}
+	}
+	ss.IndexID = name
+	ssJSON, err := json.Marshal(&ss)
+	if err != nil {
+		return d, fmt.Errorf("marshal sharding state: %w", err)
+	}
+
+	d.Name = name
+	d.Schema = schemaJSON
+	d.ShardingState = ssJSON
+	return d, nil
+}
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestValidateClassMapping(t *testing.T) {
+	meta := &backup.DistributedBackupDescriptor{
+		ServerVersion: "1.25.0",
+		Nodes:         map[string]*backup.NodeDescriptor{"N1": {Classes: []string{"A", "B"}}},
+	}
+	sm := &fakeSchemaManger{classes: []string{"Live"}}
+	tests := []struct {
+		name    string
+		mapping map[string]string
+		tenants map[string][]string
+		version string
+		err     string
+	}{
+		{name: "NoMapping"},
+		{name: "Rename", mapping: map[string]string{"A": "a2", "B": "A"}},
+		{name: "Tenants", tenants: map[string][]string{"A": {"t1"}}},
+		{name: "UnknownClass", mapping: map[string]string{"C": "C2"}, err: "class C is not being restored"},
+		{name: "InvalidName", mapping: map[string]string{"A": "A-2"}, err: "not a valid class name"},
+		{name: "Collision", mapping: map[string]string{"A": "b"}, err: "would both be restored as B"},
+		{name: "LiveClass", mapping: map[string]string{"A": "live"}, err: "class Live already exists"},
+		{name: "TenantsOfUnknownClass", tenants: map[string][]string{"C": {"t1"}}, err: "class C is not being restored"},
+		{name: "DuplicateTenant", tenants: map[string][]string{"A": {"t1", "t1"}}, err: "duplicate: t1"},
+		{name: "OldBackup", mapping: map[string]string{"A": "A2"}, version: "1.22.0", err: "1.23 or later"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := *meta
+			if tc.version != "" {
+				m.ServerVersion = tc.version
+			}
+			req := &BackupRequest{ClassMapping: tc.mapping, Tenants: tc.tenants}
+			err := validateClassMapping(sm, &m, req)
+			if tc.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+
+	t.Run("ExistingClass", func(t *testing.T) {
+		sm := &fakeSchemaManger{classes: []string{"a"}}
+		err := validateClassMapping(sm, meta, &BackupRequest{})
+		assert.ErrorContains(t, err, "class a already exists")
+	})
+}
+
+func TestRemapClass(t *testing.T) {
+	class := &models.Class{
+		Class: "A",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "ofB", DataType: []string{"B"}},
+		},
+	}
+	schemaJSON, err := json.Marshal(class)
+	require.Nil(t, err)
+	ss := sharding.State{
+		IndexID:             "A",
+		PartitioningEnabled: true,
+		Physical: map[string]sharding.Physical{
+			"t1": {Name: "t1", BelongsToNodes: []string{"N1"}},
+			"t2": {Name: "t2", BelongsToNodes: []string{"N1"}},
+		},
+	}
+	ssJSON, err := json.Marshal(&ss)
+	require.Nil(t, err)
+	d := backup.ClassDescriptor{Name: "A", Schema: schemaJSON, ShardingState: ssJSON}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		got, err := remapClass(d, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, d, got)
+	})
+
+	t.Run("RenameAndSelectTenants", func(t *testing.T) {
+		mapping := map[string]string{"A": "a2", "B": "B2"}
+		got, err := remapClass(d, mapping, map[string][]string{"A": {"t1"}})
+		require.Nil(t, err)
+		assert.Equal(t, "A2", got.Name)
+
+		var c models.Class
+		require.Nil(t, json.Unmarshal(got.Schema, &c))
+		assert.Equal(t, "A2", c.Class)
+		assert.Equal(t, []string{"text"}, c.Properties[0].DataType)
+		assert.Equal(t, []string{"B2"}, c.Properties[1].DataType)
+
+		var s sharding.State
+		require.Nil(t, json.Unmarshal(got.ShardingState, &s))
+		assert.Equal(t, "A2", s.IndexID)
+		assert.Equal(t, []string{"t1"}, s.AllPhysicalShards())
+
+		// the original descriptor must not be modified
+		assert.Equal(t, "A", d.Name)
+		assert.Equal(t, schemaJSON, d.Schema)
+	})
+
+	t.Run("UnknownTenant", func(t *testing.T) {
+		_, err := remapClass(d, nil, map[string][]string{"A": {"t3"}})
+		assert.ErrorContains(t, err, "tenant t3 of class A doesn't exist")
+	})
+
+	t.Run("NotMultiTenant", func(t *testing.T) {
+		ss := sharding.State{Physical: map[string]sharding.Physical{"s1": {Name: "s1"}}}
+		ssJSON, err := json.Marshal(&ss)
+		require.Nil(t, err)
+		d := backup.ClassDescriptor{Name: "A", Schema: schemaJSON, ShardingState: ssJSON}
+		_, err = remapClass(d, nil, map[string][]string{"A": {"s1"}})
+		assert.ErrorContains(t, err, "not multi-tenant")
+	})
+}
+
+func TestUnzipSelectedShards(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		src   = t.TempDir()
+		dst   = t.TempDir()
+		shard = func(name string) *backup.ShardDescriptor {
+			dir := "cls/" + name
+			writeFile(t, src, dir+"/lsm/objects/segment-1.db", []byte(name))
+			return &backup.ShardDescriptor{
+				Name: name, Files: []string{dir + "/lsm/objects/segment-1.db"},
+				DocIDCounterPath: dir + "/indexcount", DocIDCounter: []byte("1"),
+				PropLengthTrackerPath: dir + "/proplengths", PropLengthTracker: []byte("2"),
+				ShardVersionPath: dir + "/version", Version: []byte("3"),
+			}
+		}
+	)
+	sd1, sd2 := shard("t1"), shard("t2")
+	z, rc := NewZip(src, 0)
+	go func() {
+		for _, sd := range []*backup.ShardDescriptor{sd1, sd2} {
+			if _, err := z.WriteShard(ctx, sd); err != nil {
+				t.Errorf("compress: %v", err)
+			}
+		}
+		z.Close()
+	}()
+	chunk, err := io.ReadAll(rc)
+	require.Nil(t, err)
+
+	uz, wc := NewUnzip(dst)
+	uz.shards = map[string]struct{}{"t2": {}}
+	go func() {
+		wc.Write(chunk)
+		wc.Close()
+	}()
+	_, err = uz.ReadChunk()
+	require.Nil(t, err)
+	require.Nil(t, uz.Close())
+
+	got, err := os.ReadFile(filepath.Join(dst, "cls/t2/lsm/objects/segment-1.db"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("t2"), got)
+	_, err = os.Stat(filepath.Join(dst, "cls/t1"))
+	assert.True(t, os.IsNotExist(err), "unselected shard must not be restored")
+}
@@ -17,6 +17,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -37,6 +38,7 @@ type restorer struct {
 	logger   logrus.FieldLogger
 	sourcer  Sourcer
 	backends BackupBackendProvider
+	sealer   *Sealer
 	shardSyncChan
 
 	// TODO: keeping status in memory after restore has been done
@@ -49,12 +51,14 @@ type restorer struct {
 func newRestorer(node string, logger logrus.FieldLogger,
 	sourcer Sourcer,
 	backends BackupBackendProvider,
+	sealer *Sealer,
 ) *restorer {
 	return &restorer{
 		node:          node,
 		logger:        logger,
 		sourcer:       sourcer,
 		backends:      backends,
+		sealer:        sealer,
 		shardSyncChan: shardSyncChan{coordChan: make(chan interface{}, 5)},
 	}
 }
@@ -112,7 +116,7 @@ func (r *restorer) restore(
 		overrideBucket := req.Bucket
 		overridePath := req.Path
 
-		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store, overrideBucket, overridePath)
+		err = r.restoreAll(context.Background(), desc, req, store, overrideBucket, overridePath)
 		logFields := logrus.Fields{"action": "restore", "backup_id": req.ID}
 		if err != nil {
 			r.logger.WithFields(logFields).Error(err)
@@ -128,13 +132,16 @@ func (r *restorer) restore(
 // restoreAll restores classes in temporary directories on the filesystem.
 // The final backup restoration is orchestrated by the raft store.
 func (r *restorer) restoreAll(ctx context.Context,
-	desc *backup.BackupDescriptor, cpuPercentage int,
+	desc *backup.BackupDescriptor, req *Request,
 	store nodeStore, overrideBucket, overridePath string,
 ) (err error) {
 	compressed := desc.Version > version1
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressed, cpuPercentage, store, overrideBucket, overridePath); err != nil {
+		target := targetClass(req.ClassMapping, cdesc.Name)
+		_, partial := req.Tenants[cdesc.Name]
+		if err := r.restoreOne(ctx, desc, &cdesc, target, partial, compressed, req.CPUPercentage,
+			store, overrideBucket, overridePath); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
@@ -152,9 +159,11 @@ func getType(myvar interface{}) string {
 	}
 }
 
+// restoreOne restores class desc as target. If partial is set, desc only
+// holds some of the shards of the class and the others must be skipped.
 func (r *restorer) restoreOne(ctx context.Context,
-	desc *backup.ClassDescriptor, serverVersion string,
-	compressed bool, cpuPercentage int, store nodeStore,
+	meta *backup.BackupDescriptor, desc *backup.ClassDescriptor, target string,
+	partial, compressed bool, cpuPercentage int, store nodeStore,
 	overrideBucket, overridePath string,
 ) (err error) {
 	classLabel := desc.Name
@@ -167,17 +176,23 @@ func (r *restorer) restoreOne(ctx context.Context,
 		defer timer.ObserveDuration()
 	}
 
-	fw := newFileWriter(r.sourcer, store, compressed, r.logger).
+	fw := newFileWriter(r.sourcer, store, meta, r.sealer, compressed, r.logger).
 		WithPoolPercentage(cpuPercentage)
 
 	// Pre-v1.23 versions store files in a flat format
-	if serverVersion < "1.23" {
+	if serverVersion := meta.ServerVersion; serverVersion < "1.23" {
 		f, err := hfsMigrator(desc, r.node, serverVersion)
 		if err != nil {
 			return fmt.Errorf("migrate to pre 1.23: %w", err)
 		}
 		fw.setMigrator(f)
 	}
+	if target != desc.Name {
+		fw.setTarget(target)
+	}
+	if partial {
+		fw.selectShards(desc.Shards)
+	}
 
 	if err := fw.Write(ctx, desc, overrideBucket, overridePath); err != nil {
 		return fmt.Errorf("write files: %w", err)
@@ -226,6 +241,13 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 	if v := meta.Version; v[0] > Version[0] {
 		return nil, nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
+	if err := verifyMeta(r.sealer, meta, req.AllowUnsigned); err != nil {
+		return nil, nil, err
+	}
+	if meta.SchemaVersion != req.SchemaVersion {
+		return nil, nil, fmt.Errorf("inconsistent backup: node part was taken at schema version %d, "+
+			"but the backup was coordinated at %d", meta.SchemaVersion, req.SchemaVersion)
+	}
 	cs := meta.List()
 	if len(req.Classes) > 0 {
 		if first := meta.AllExist(req.Classes); first != "" {
@@ -234,6 +256,13 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 		}
 		meta.Include(req.Classes)
 	}
+	for i := range meta.Classes {
+		if tenants, ok := req.Tenants[meta.Classes[i].Name]; ok {
+			meta.Classes[i].FilterShards(func(name string) bool {
+				return slices.Contains(tenants, name)
+			})
+		}
+	}
 	return meta, cs, nil
 }
 
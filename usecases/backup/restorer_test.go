@@ -562,6 +562,22 @@ func TestManagerCoordinatedRestore(t *testing.T) {
 		assert.Equal(t, resp.Timeout, time.Duration(0))
 	})
 
+	t.Run("SchemaVersionMismatch", func(t *testing.T) {
+		req := req
+		req.SchemaVersion = 7
+		meta := metadata
+		meta.SchemaVersion = 6
+		backend := newFakeBackend()
+		sourcer := &fakeSourcer{}
+		sourcer.On("ClassExists", cls).Return(false)
+		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(marshalMeta(meta), nil)
+		backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		m := createManager(sourcer, nil, backend, nil)
+		resp := m.OnCanCommit(ctx, &req)
+		assert.Contains(t, resp.Err, "inconsistent backup")
+		assert.Equal(t, time.Duration(0), resp.Timeout)
+	})
+
 	t.Run("AnotherBackupIsInProgress", func(t *testing.T) {
 		backend := newFakeBackend()
 		sourcer := &fakeSourcer{}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -40,6 +41,9 @@ type Scheduler struct {
 	backupper  *coordinator
 	restorer   *coordinator
 	backends   BackupBackendProvider
+	// sealer signs and verifies the coordinator's descriptors, it is nil if
+	// no encryption key is configured
+	sealer *Sealer
 }
 
 // NewScheduler creates a new scheduler with two coordinators
@@ -70,6 +74,15 @@ func NewScheduler(
 	return m
 }
 
+// WithSealer signs the descriptors of new backups with sealer and restores
+// only backups it can verify
+func (s *Scheduler) WithSealer(sealer *Sealer) *Scheduler {
+	s.sealer = sealer
+	s.backupper.sealer = sealer
+	s.restorer.sealer = sealer
+	return s
+}
+
 func (s *Scheduler) CleanupUnfinishedBackups(ctx context.Context) {
 	for _, backend := range s.backends.EnabledBackupBackends() {
 		backups, err := backend.AllBackups(ctx)
@@ -90,6 +103,13 @@ func (s *Scheduler) CleanupUnfinishedBackups(ctx context.Context) {
 						Error(fmt.Errorf("init coordinator store: %w", err))
 					continue
 				}
+				if s.sealer != nil {
+					if err := s.sealer.signGlobal(bak); err != nil {
+						s.logger.WithField("action", "cleanup_unfinished_backups").
+							Error(fmt.Errorf("sign meta file: %w", err))
+						continue
+					}
+				}
 				// TODO: make compatible with override bucket/path?
 				if err := store.PutMeta(ctx, GlobalBackupFile, bak, "", ""); err != nil {
 					s.logger.WithField("action", "cleanup_unfinished_backups").
@@ -138,6 +158,7 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		Compression: req.Compression,
 		Bucket:      req.Bucket,
 		Path:        req.Path,
+		BaseID:      req.BaseID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -178,10 +199,18 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 		}
 		return nil, backup.NewErrUnprocessable(err)
 	}
-	schema, err := s.fetchSchema(ctx, req.Backend, req.Bucket, req.Path, meta)
+	schema, err := s.fetchSchema(ctx, req.Backend, req.Bucket, req.Path, meta, req.AllowUnsigned)
 	if err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
+	// fail before any data is transferred if a class cannot be remapped
+	for _, cls := range schema {
+		if slices.Contains(meta.Classes(), cls.Name) {
+			if _, err := remapClass(cls, req.ClassMapping, req.Tenants); err != nil {
+				return nil, backup.NewErrUnprocessable(err)
+			}
+		}
+	}
 	status := string(backup.Started)
 	data := &models.BackupRestoreResponse{
 		Backend: req.Backend,
@@ -191,13 +220,16 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 	}
 
 	rReq := Request{
-		Method:      OpRestore,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Compression: req.Compression,
-		Classes:     meta.Classes(),
-		Bucket:      req.Bucket,
-		Path:        req.Path,
+		Method:        OpRestore,
+		ID:            req.ID,
+		Backend:       req.Backend,
+		Compression:   req.Compression,
+		Classes:       meta.Classes(),
+		Bucket:        req.Bucket,
+		Path:          req.Path,
+		ClassMapping:  req.ClassMapping,
+		Tenants:       req.Tenants,
+		AllowUnsigned: req.AllowUnsigned,
 	}
 	err = s.restorer.Restore(ctx, store, &rReq, meta, schema)
 	if err != nil {
@@ -360,9 +392,36 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if err := s.checkIfBackupExists(ctx, store, req); err != nil {
 		return nil, err
 	}
+	if req.BaseID != "" {
+		if err := s.validateBase(ctx, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// validateBase makes sure that an incremental backup can be taken against req.BaseID
+func (s *Scheduler) validateBase(ctx context.Context, req *BackupRequest) error {
+	if req.BaseID == req.ID {
+		return fmt.Errorf("backup %q cannot be an increment of itself", req.ID)
+	}
+	if err := validateID(req.BaseID); err != nil {
+		return fmt.Errorf("base: %w", err)
+	}
+	store, err := coordBackend(s.backends, req.Backend, req.BaseID, req.Bucket, req.Path)
+	if err != nil {
+		return err
+	}
+	meta, err := store.Meta(ctx, GlobalBackupFile, req.Bucket, req.Path)
+	if err != nil {
+		return fmt.Errorf("find base backup %q: %w", req.BaseID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("base backup %q has status %s, only successful backups can be used", req.BaseID, meta.Status)
+	}
+	return nil
+}
+
 func (s *Scheduler) checkIfBackupExists(ctx context.Context, store coordStore, req *BackupRequest) error {
 	destPath := store.HomeDir(req.Bucket, req.Path)
 	// there is no backup with given id on the backend, regardless of its state (valid or corrupted)
@@ -402,6 +461,9 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if meta.ID != req.ID {
 		return nil, fmt.Errorf("wrong backup file: expected %q got %q", req.ID, meta.ID)
 	}
+	if err := verifyGlobalMeta(s.sealer, meta, req.AllowUnsigned); err != nil {
+		return nil, err
+	}
 	if meta.Status != backup.Success {
 		return nil, fmt.Errorf("invalid backup in scheduler %s status: %s", destPath, meta.Status)
 	}
@@ -424,6 +486,9 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if meta.RemoveEmpty().Count() == 0 {
 		return nil, fmt.Errorf("nothing left to restore: please choose from : %v", cs)
 	}
+	if err := validateClassMapping(s.restorer.schema, meta, req); err != nil {
+		return nil, err
+	}
 	if len(req.NodeMapping) > 0 {
 		meta.NodeMapping = req.NodeMapping
 		meta.ApplyNodeMapping()
@@ -439,6 +504,7 @@ func (s *Scheduler) fetchSchema(
 	overrideBucket string,
 	overridePath string,
 	req *backup.DistributedBackupDescriptor,
+	allowUnsigned bool,
 ) ([]backup.ClassDescriptor, error) {
 	f := func(node string) ([]backup.ClassDescriptor, error) {
 		store, err := nodeBackend(node, s.backends, backend, req.ID, overrideBucket, overridePath)
@@ -449,6 +515,9 @@ func (s *Scheduler) fetchSchema(
 		if err != nil {
 			return nil, err
 		}
+		if err := verifyMeta(s.sealer, meta, allowUnsigned); err != nil {
+			return nil, fmt.Errorf("node %s: %w", node, err)
+		}
 		return meta.Classes, nil
 	}
 
@@ -12,6 +12,7 @@
 package backup
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -140,6 +142,40 @@ func TestSchedulerValidateCreateBackup(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("backup %q already exists", id))
 		assert.IsType(t, backup.ErrUnprocessable{}, err)
 	})
+	t.Run("BaseNotSuccessful", func(t *testing.T) {
+		baseID := "122"
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		bytes := marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: baseID, Status: backup.Failed})
+		fs.backend.On("GetObject", ctx, baseID, GlobalBackupFile).Return(bytes, nil)
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend: backendName,
+			ID:      id,
+			Include: []string{cls},
+			BaseID:  baseID,
+		})
+
+		assert.Nil(t, meta)
+		assert.ErrorContains(t, err, fmt.Sprintf("base backup %q has status", baseID))
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+	t.Run("BaseIsItself", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		_, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend: backendName,
+			ID:      id,
+			Include: []string{cls},
+			BaseID:  id,
+		})
+		assert.ErrorContains(t, err, "increment of itself")
+	})
 }
 
 func TestSchedulerBackupStatus(t *testing.T) {
@@ -739,6 +775,37 @@ func TestSchedulerRestoreRequestValidation(t *testing.T) {
 		assert.Contains(t, err.Error(), "unknown")
 	})
 
+	t.Run("UnsignedBackup", func(t *testing.T) {
+		sealer, err := NewSealer(bytes.Repeat([]byte{1}, 32))
+		require.Nil(t, err)
+		fs := newFakeScheduler(nil)
+
+		data := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(data, nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		_, err = fs.scheduler().WithSealer(sealer).Restore(ctx, nil, req)
+		assert.ErrorContains(t, err, errNotSigned.Error())
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("TamperedBackup", func(t *testing.T) {
+		sealer, err := NewSealer(bytes.Repeat([]byte{1}, 32))
+		require.Nil(t, err)
+		fs := newFakeScheduler(nil)
+
+		signed := meta
+		signed.Nodes = map[string]*backup.NodeDescriptor{nodeName: {Classes: []string{cls}}}
+		require.Nil(t, sealer.signGlobal(&signed))
+		signed.Nodes[nodeName].Classes = append(signed.Nodes[nodeName].Classes, "Other")
+		data := marshalCoordinatorMeta(signed)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(data, nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		_, err = fs.scheduler().WithSealer(sealer).Restore(ctx, nil, &BackupRequest{
+			Backend: backendName, ID: id, AllowUnsigned: true,
+		})
+		assert.ErrorContains(t, err, errInvalidSignature.Error())
+	})
+
 	t.Run("EmptyResultClassList", func(t *testing.T) { //  backup was successful but class list is empty
 		fs := newFakeScheduler(&fakeNodeResolver{})
 
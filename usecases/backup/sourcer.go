@@ -27,6 +27,12 @@ type Sourcer interface { // implemented by the index
 	// Backupable returns whether all given class can be backed up.
 	Backupable(_ context.Context, classes []string) error
 
+	// HoldWrites blocks writes into the given classes until the descriptor of
+	// each class has been collected by BackupDescriptors or until
+	// ReleaseBackup is called. It makes all classes of this node's part of a
+	// backup reflect the same point of its writes.
+	HoldWrites(_ context.Context, bakid string, classes []string) error
+
 	// BackupDescriptors returns a channel of class descriptors.
 	// Class descriptor records everything needed to restore a class
 	// If an error happens a descriptor with an error will be written to the channel just before closing it.
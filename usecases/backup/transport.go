@@ -54,6 +54,23 @@ type Request struct {
 
 	// Additional path prefix override
 	Path string
+
+	// SchemaVersion is the schema version the backup is taken at. On create
+	// participants catch up to it before taking their part, on restore it
+	// must match the version recorded by each participant.
+	SchemaVersion uint64
+
+	// BaseID is the backup to take an incremental backup against
+	BaseID string
+
+	// ClassMapping renames classes on restore, see BackupRequest.ClassMapping
+	ClassMapping map[string]string
+
+	// Tenants limits the shards restored per class, see BackupRequest.Tenants
+	Tenants map[string][]string
+
+	// AllowUnsigned restores unsigned backups, see BackupRequest.AllowUnsigned
+	AllowUnsigned bool
 }
 
 type CanCommitResponse struct {
@@ -21,7 +21,10 @@ import (
 	"io"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -37,6 +40,10 @@ const (
 	BestCompression
 )
 
+// paxOffset is the PAX record holding the offset of a file part within its
+// file. Entries without it contain the file from the start.
+const paxOffset = "WEAVIATE.offset"
+
 type zip struct {
 	sourcePath string
 	w          *tar.Writer
@@ -91,19 +98,62 @@ func (z *zip) WriteShard(ctx context.Context, sd *backup.ShardDescriptor) (writt
 			name: filepath.Base(x.relPath),
 			size: len(x.data),
 		}
-		if n, err = z.writeOne(ctx, info, x.relPath, bytes.NewReader(x.data)); err != nil {
+		if n, err = z.writeOne(ctx, info, x.relPath, 0, bytes.NewReader(x.data)); err != nil {
 			return written, err
 		}
 		written += n
 
 	}
 
-	n, err = z.WriteRegulars(ctx, sd.Files)
+	if sd.Manifest == nil {
+		n, err = z.WriteRegulars(ctx, sd.Files)
+	} else {
+		n, err = z.writePending(ctx, sd)
+	}
 	written += n
 
 	return
 }
 
+// writePending writes the parts of sd's files which are not held by earlier backups
+func (z *zip) writePending(ctx context.Context, sd *backup.ShardDescriptor) (written int64, err error) {
+	for _, relPath := range sd.Files {
+		info := sd.Manifest[relPath]
+		if info == nil {
+			continue
+		}
+		p := info.Pending()
+		if p == nil {
+			continue
+		}
+		n, err := z.writePart(ctx, relPath, p.Offset, info.Size)
+		if err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// writePart writes the range [offset, size) of the file at relPath
+func (z *zip) writePart(ctx context.Context, relPath string, offset, size int64) (written int64, err error) {
+	f, err := os.Open(filepath.Join(z.sourcePath, relPath))
+	if err != nil {
+		return written, fmt.Errorf("open: %w", err)
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return written, fmt.Errorf("stat: %w", err)
+	}
+	info := vFileInfo{
+		name:    st.Name(),
+		size:    int(size - offset),
+		modTime: st.ModTime(),
+	}
+	return z.writeOne(ctx, info, relPath, offset, io.NewSectionReader(f, offset, size-offset))
+}
+
 func (z *zip) WriteRegulars(ctx context.Context, relPaths []string) (written int64, err error) {
 	for _, relPath := range relPaths {
 		if filepath.Base(relPath) == ".DS_Store" {
@@ -140,10 +190,10 @@ func (z *zip) WriteRegular(ctx context.Context, relPath string) (written int64,
 	}
 	defer f.Close()
 
-	return z.writeOne(ctx, info, relPath, f)
+	return z.writeOne(ctx, info, relPath, 0, f)
 }
 
-func (z *zip) writeOne(ctx context.Context, info fs.FileInfo, relPath string, r io.Reader) (written int64, err error) {
+func (z *zip) writeOne(ctx context.Context, info fs.FileInfo, relPath string, offset int64, r io.Reader) (written int64, err error) {
 	if err := ctx.Err(); err != nil {
 		return written, err
 	}
@@ -154,6 +204,9 @@ func (z *zip) writeOne(ctx context.Context, info fs.FileInfo, relPath string, r
 	}
 	header.Name = relPath
 	header.ChangeTime = info.ModTime()
+	if offset > 0 {
+		header.PAXRecords = map[string]string{paxOffset: strconv.FormatInt(offset, 10)}
+	}
 	if err := z.w.WriteHeader(header); err != nil {
 		return written, fmt.Errorf("write backup header in file %s: %s: %w", z.sourcePath, relPath, err)
 	}
@@ -179,9 +232,13 @@ type unzip struct {
 	gzr        *gzip.Reader
 	r          *tar.Reader
 	pipeReader *io.PipeReader
+	// filter, if set, selects the file parts to extract by path and offset
+	filter func(relPath string, offset int64) bool
+	// shards, if set, are the only shard directories to extract
+	shards map[string]struct{}
 }
 
-func NewUnzip(dst string) (unzip, io.WriteCloser) {
+func NewUnzip(dst string) (unzip, *io.PipeWriter) {
 	pr, pw := io.Pipe()
 	return unzip{
 		destPath:   dst,
@@ -226,6 +283,10 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 		header, err := u.r.Next()
 		if err != nil {
 			if err == io.EOF { // end of the loop
+				// the writer might still verify the end of the chunk
+				if _, err := io.Copy(io.Discard, u.pipeReader); err != nil {
+					return written, fmt.Errorf("read chunk: %w", err)
+				}
 				return written, nil
 			}
 			return written, fmt.Errorf("fetch next: %w", err)
@@ -234,6 +295,10 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 			continue
 		}
 
+		if !u.selected(header.Name) {
+			continue
+		}
+
 		// target file
 		target := filepath.Join(u.destPath, header.Name)
 		switch header.Typeflag {
@@ -242,13 +307,20 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 				return written, fmt.Errorf("crateDir %s: %w", target, err)
 			}
 		case tar.TypeReg:
+			offset, err := partOffset(header)
+			if err != nil {
+				return written, fmt.Errorf("file %s: %w", target, err)
+			}
+			if u.filter != nil && !u.filter(header.Name, offset) {
+				continue
+			}
 			if pp := filepath.Dir(target); pp != parentPath {
 				parentPath = pp
 				if err := os.MkdirAll(parentPath, 0o755); err != nil {
 					return written, fmt.Errorf("crateDir %s: %w", target, err)
 				}
 			}
-			n, err := copyFile(target, header, u.r)
+			n, err := copyFile(target, header, offset, u.r)
 			if err != nil {
 				return written, fmt.Errorf("copy file %s: %w", target, err)
 			}
@@ -257,12 +329,47 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 	}
 }
 
-func copyFile(target string, h *tar.Header, r io.Reader) (written int64, err error) {
+// selected reports whether relPath, which is relative to the data path,
+// belongs to one of the selected shards
+func (u *unzip) selected(relPath string) bool {
+	if u.shards == nil {
+		return true
+	}
+	// relPath = class/shard/...
+	parts := strings.SplitN(path.Clean(relPath), "/", 3)
+	if len(parts) < 2 {
+		return true
+	}
+	_, ok := u.shards[parts[1]]
+	return ok
+}
+
+// partOffset returns the offset within its file of the part stored in entry h
+func partOffset(h *tar.Header) (int64, error) {
+	v, ok := h.PAXRecords[paxOffset]
+	if !ok {
+		return 0, nil
+	}
+	offset, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid part offset %q", v)
+	}
+	return offset, nil
+}
+
+// copyFile writes the content of r into the file target starting at offset.
+// Content beyond that range is kept, as it belongs to other parts of the file.
+func copyFile(target string, h *tar.Header, offset int64, r io.Reader) (written int64, err error) {
 	f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(h.Mode))
 	if err != nil {
 		return written, fmt.Errorf("create: %w", err)
 	}
 	defer f.Close()
+	if offset > 0 {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			return written, fmt.Errorf("seek: %w", err)
+		}
+	}
 	written, err = io.Copy(f, r)
 	if err != nil {
 		return written, fmt.Errorf("copy: %w", err)
@@ -479,6 +479,18 @@ func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	return "", nil
 }
 
+func (f *fakeRemoteClient) TrashEntries(ctx context.Context,
+	hostName, indexName, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) ObjectHistory(ctx context.Context,
+	hostName, indexName, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string, schemaVersion uint64,
 ) error {
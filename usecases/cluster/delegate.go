@@ -112,6 +112,7 @@ func (d *spaceMsg) unmarshal(data []byte) (err error) {
 // delegate implements the memberList delegate interface
 type delegate struct {
 	Name     string
+	Zone     string
 	dataPath string
 	log      logrus.FieldLogger
 	sync.Mutex
@@ -159,7 +160,12 @@ func (d *delegate) init(diskSpace func(path string) (DiskUsage, error)) error {
 // when broadcasting an alive message. It's length is limited to
 // the given byte size. This metadata is available in the Node structure.
 func (d *delegate) NodeMeta(limit int) (meta []byte) {
-	return nil
+	if len(d.Zone) > limit {
+		d.log.WithField("action", "delegate.node_meta").
+			Warnf("zone %q exceeds %d bytes and is not shared", d.Zone, limit)
+		return nil
+	}
+	return []byte(d.Zone)
 }
 
 // LocalState is used for a TCP Push/Pull. This is sent to
@@ -70,6 +70,9 @@ type Config struct {
 	// mode. In addition, we may want to have the cluster nodes not in maintenance mode be aware of
 	// which nodes are in maintenance mode in the future.
 	MaintenanceNodes []string `json:"maintenanceNodes" yaml:"maintenanceNodes"`
+	// Zone is the availability zone of this node. It is shared with the other
+	// nodes, so that reads can prefer replicas in the same zone.
+	Zone string `json:"zone" yaml:"zone"`
 }
 
 type AuthConfig struct {
@@ -94,6 +97,7 @@ func Init(userConfig Config, dataPath string, nonStorageNodes map[string]struct{
 		nonStorageNodes: nonStorageNodes,
 		delegate: delegate{
 			Name:     cfg.Name,
+			Zone:     userConfig.Zone,
 			dataPath: dataPath,
 			log:      logger,
 		},
@@ -299,6 +303,20 @@ func (s *State) NodeHostname(nodeName string) (string, bool) {
 	return "", false
 }
 
+// NodeZone returns the zone of the given node, or an empty string if the
+// node is unknown or has no zone configured
+func (s *State) NodeZone(nodeName string) string {
+	s.listLock.RLock()
+	defer s.listLock.RUnlock()
+
+	for _, mem := range s.list.Members() {
+		if mem.Name == nodeName {
+			return string(mem.Meta)
+		}
+	}
+	return ""
+}
+
 // NodeAddress is used to resolve the node name into an ip address without the port
 func (s *State) NodeAddress(id string) string {
 	s.listLock.RLock()
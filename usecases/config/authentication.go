@@ -65,4 +65,7 @@ type APIKey struct {
 	Enabled     bool     `json:"enabled" yaml:"enabled"`
 	Users       []string `json:"users" yaml:"users"`
 	AllowedKeys []string `json:"allowed_keys" yaml:"allowed_keys"`
+	// Collections optionally limits the key at pos i to the collections at pos
+	// i. An empty list or "*" means the key is not limited.
+	Collections [][]string `json:"collections" yaml:"collections"`
 }
@@ -15,11 +15,13 @@ import (
 	"fmt"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 )
 
 // Authorization configuration
 type Authorization struct {
 	AdminList adminlist.Config `json:"admin_list" yaml:"admin_list"`
+	Rbac      rbac.Config      `json:"rbac" yaml:"rbac"`
 }
 
 // Validate the Authorization configuration. This only validates at a general
@@ -32,5 +34,14 @@ func (a Authorization) Validate() error {
 		}
 	}
 
+	if a.Rbac.Enabled {
+		if a.AdminList.Enabled {
+			return fmt.Errorf("authorization: admin list and rbac cannot be enabled at the same time")
+		}
+		if err := a.Rbac.Validate(); err != nil {
+			return fmt.Errorf("authorization: %s", err)
+		}
+	}
+
 	return nil
 }
@@ -12,9 +12,11 @@
 package config
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math"
+	"net"
 	"os"
 	"regexp"
 	"strings"
@@ -29,7 +31,9 @@ import (
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/logging"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"gopkg.in/yaml.v2"
 )
 
@@ -102,6 +106,7 @@ type Config struct {
 	ModulesPath                         string                   `json:"modules_path" yaml:"modules_path"`
 	ModuleHttpClientTimeout             time.Duration            `json:"modules_client_timeout" yaml:"modules_client_timeout"`
 	AutoSchema                          AutoSchema               `json:"auto_schema" yaml:"auto_schema"`
+	SchemaTemplatesPath                 string                   `json:"schema_templates_path" yaml:"schema_templates_path"`
 	Cluster                             cluster.Config           `json:"cluster" yaml:"cluster"`
 	Replication                         replication.GlobalConfig `json:"replication" yaml:"replication"`
 	Monitoring                          monitoring.Config        `json:"monitoring" yaml:"monitoring"`
@@ -114,18 +119,36 @@ type Config struct {
 	ReindexVectorDimensionsAtStartup    bool                     `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
 	DisableLazyLoadShards               bool                     `json:"disable_lazy_load_shards" yaml:"disable_lazy_load_shards"`
 	ForceFullReplicasSearch             bool                     `json:"force_full_replicas_search" yaml:"force_full_replicas_search"`
+	QueryEarlyTerminationCertainty      float64                  `json:"query_early_termination_certainty" yaml:"query_early_termination_certainty"`
+	SoftDeleteRetention                 time.Duration            `json:"soft_delete_retention" yaml:"soft_delete_retention"`
+	ObjectHistoryVersions               int                      `json:"object_history_versions" yaml:"object_history_versions"`
+	LockWatchdogThreshold               time.Duration            `json:"lock_watchdog_threshold" yaml:"lock_watchdog_threshold"`
 	RecountPropertiesAtStartup          bool                     `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
 	ReindexSetToRoaringsetAtStartup     bool                     `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
 	IndexMissingTextFilterableAtStartup bool                     `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
 	DisableGraphQL                      bool                     `json:"disable_graphql" yaml:"disable_graphql"`
+	GraphQLStrictMode                   bool                     `json:"graphql_strict_mode" yaml:"graphql_strict_mode"`
 	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
 	CORS                                CORS                     `json:"cors" yaml:"cors"`
+	RateLimit                           RateLimit                `json:"rate_limit" yaml:"rate_limit"`
+	NetworkACL                          NetworkACL               `json:"network_acl" yaml:"network_acl"`
+	SecurityHeaders                     SecurityHeaders          `json:"security_headers" yaml:"security_headers"`
 	DisableTelemetry                    bool                     `json:"disable_telemetry" yaml:"disable_telemetry"`
+	UsageReporting                      UsageReporting           `json:"usage_reporting" yaml:"usage_reporting"`
 	HNSWStartupWaitForVectorCache       bool                     `json:"hnsw_startup_wait_for_vector_cache" yaml:"hnsw_startup_wait_for_vector_cache"`
+	WarmUp                              WarmUp                   `json:"warm_up" yaml:"warm_up"`
+	CacheBudget                         CacheBudget              `json:"cache_budget" yaml:"cache_budget"`
+	ModuleBudgets                       ModuleBudgets            `json:"module_budgets" yaml:"module_budgets"`
+	Backup                              Backup                   `json:"backup" yaml:"backup"`
+	DataEncryption                      DataEncryption           `json:"data_encryption" yaml:"data_encryption"`
+	BlobStorage                         BlobStorage              `json:"blob_storage" yaml:"blob_storage"`
 	HNSWVisitedListPoolMaxSize          int                      `json:"hnsw_visited_list_pool_max_size" yaml:"hnsw_visited_list_pool_max_size"`
 	HNSWFlatSearchConcurrency           int                      `json:"hnsw_flat_search_concurrency" yaml:"hnsw_flat_search_concurrency"`
 	Sentry                              *entsentry.ConfigOpts    `json:"sentry" yaml:"sentry"`
 	MetadataServer                      MetadataServer           `json:"metadata_server" yaml:"metadata_server"`
+	Logging                             logging.Config           `json:"logging" yaml:"logging"`
+	LogLevel                            string                   `json:"log_level" yaml:"log_level"`
+	Tracing                             tracing.Config           `json:"tracing" yaml:"tracing"`
 
 	// Raft Specific configuration
 	// TODO-RAFT: Do we want to be able to specify these with config file as well ?
@@ -211,6 +234,193 @@ type GRPC struct {
 	MaxMsgSize int    `json:"maxMsgSize" yaml:"maxMsgSize"`
 }
 
+// UsageReporting is the opt-in anonymized usage report which is sent to a
+// user provided endpoint. It is independent of the regular telemetry.
+type UsageReporting struct {
+	Enabled  bool          `json:"enabled" yaml:"enabled"`
+	Endpoint string        `json:"endpoint" yaml:"endpoint"`
+	Interval time.Duration `json:"interval" yaml:"interval"`
+}
+
+const DefaultUsageReportingInterval = 24 * time.Hour
+
+func (u UsageReporting) Validate() error {
+	if !u.Enabled {
+		return nil
+	}
+
+	if u.Endpoint == "" {
+		return fmt.Errorf("usage_reporting.endpoint must be set when usage reporting is enabled")
+	}
+
+	if u.Interval < 0 {
+		return fmt.Errorf("usage_reporting.interval must not be negative")
+	}
+
+	return nil
+}
+
+// WarmUp preloads shard data into memory after startup. The node reports
+// itself as not ready until the warm-up is complete.
+type WarmUp struct {
+	Enabled        bool `json:"enabled" yaml:"enabled"`
+	MemoryBudgetMB int  `json:"memory_budget_mb" yaml:"memory_budget_mb"`
+}
+
+const DefaultWarmUpMemoryBudgetMB = 1024
+
+func (w WarmUp) Validate() error {
+	if w.MemoryBudgetMB < 0 {
+		return fmt.Errorf("warm_up.memory_budget_mb must not be negative")
+	}
+
+	return nil
+}
+
+// CacheBudget limits the memory the vector caches may hold together to a
+// percentage of the memory limit. The budget is split between cache groups
+// according to their weights, groups without a weight count as 1. A
+// percentage of 0 disables the budget.
+type CacheBudget struct {
+	Percentage int            `json:"percentage" yaml:"percentage"`
+	Weights    map[string]int `json:"weights" yaml:"weights"`
+}
+
+func (c CacheBudget) Enabled() bool {
+	return c.Percentage > 0
+}
+
+func (c CacheBudget) Validate() error {
+	if c.Percentage < 0 || c.Percentage > 100 {
+		return fmt.Errorf("cache_budget.percentage must be between 0 and 100, got %d", c.Percentage)
+	}
+	for group, weight := range c.Weights {
+		if weight <= 0 {
+			return fmt.Errorf("cache_budget.weights: weight of %q must be positive, got %d", group, weight)
+		}
+	}
+
+	return nil
+}
+
+// ModuleBudget limits the calls a module makes to its inference API, so that
+// bulk imports can't run up unexpected bills. Every vectorized object or
+// query counts as one request, tokens are estimated from the length of the
+// vectorized text. Calls which exceed the budget are queued for up to
+// MaxWaitSeconds and rejected afterwards. At most MaxQueued calls wait at the
+// same time. 0 means unlimited for all values except MaxWaitSeconds, which
+// defaults to DefaultModuleBudgetMaxWaitSeconds.
+type ModuleBudget struct {
+	RequestsPerSecond int `json:"requests_per_second" yaml:"requests_per_second"`
+	TokensPerMinute   int `json:"tokens_per_minute" yaml:"tokens_per_minute"`
+	MaxWaitSeconds    int `json:"max_wait_seconds" yaml:"max_wait_seconds"`
+	MaxQueued         int `json:"max_queued" yaml:"max_queued"`
+}
+
+const DefaultModuleBudgetMaxWaitSeconds = 30
+
+func (m ModuleBudget) Enabled() bool {
+	return m.RequestsPerSecond > 0 || m.TokensPerMinute > 0
+}
+
+// ModuleBudgets are the budgets per module name
+type ModuleBudgets map[string]ModuleBudget
+
+func (m ModuleBudgets) Validate() error {
+	for name, budget := range m {
+		if budget.RequestsPerSecond < 0 || budget.TokensPerMinute < 0 ||
+			budget.MaxWaitSeconds < 0 || budget.MaxQueued < 0 {
+			return fmt.Errorf("module_budgets: values of %q must not be negative", name)
+		}
+	}
+
+	return nil
+}
+
+// Backup configures the protection of backups. If an encryption key is set,
+// backup chunks are encrypted and the metadata of each node is signed, so
+// that restoring a tampered backup fails. Restoring then also requires the key.
+type Backup struct {
+	// EncryptionKey is a base64 encoded 256 bit key
+	EncryptionKey string `json:"encryption_key" yaml:"encryption_key"`
+}
+
+// Key returns the decoded encryption key or nil if none is set
+func (b Backup) Key() ([]byte, error) {
+	if b.EncryptionKey == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(b.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("backup.encryption_key must be base64 encoded: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("backup.encryption_key must be 32 bytes long, got %d", len(key))
+	}
+	return key, nil
+}
+
+func (b Backup) Validate() error {
+	_, err := b.Key()
+	return err
+}
+
+// BlobStorage configures where files uploaded to blob properties are stored
+type BlobStorage struct {
+	// Path is the directory of the local blob storage
+	Path string `json:"path" yaml:"path"`
+}
+
+// DefaultBlobStorageDir is the directory in the persistence data path which
+// is used if no path is configured. Class names can't start with a dot, so
+// it never collides with the directory of a class.
+const DefaultBlobStorageDir = ".blobs"
+
+// DataEncryption configures the encryption of collections at rest. Every
+// shard of an encrypted collection has its own data encryption keys, which
+// are wrapped by the master key. To rotate the master key, set the old one as
+// the previous master key until every shard was loaded once.
+type DataEncryption struct {
+	// MasterKey is a base64 encoded 256 bit key
+	MasterKey string `json:"master_key" yaml:"master_key"`
+	// PreviousMasterKey is a base64 encoded 256 bit key, it is only used to
+	// unwrap data encryption keys which are then wrapped by MasterKey
+	PreviousMasterKey string `json:"previous_master_key" yaml:"previous_master_key"`
+}
+
+// Keys returns the decoded master keys, which are nil if not set
+func (d DataEncryption) Keys() (master, previous []byte, err error) {
+	if master, err = decodeDataEncryptionKey("master_key", d.MasterKey); err != nil {
+		return nil, nil, err
+	}
+	if previous, err = decodeDataEncryptionKey("previous_master_key", d.PreviousMasterKey); err != nil {
+		return nil, nil, err
+	}
+	if previous != nil && master == nil {
+		return nil, nil, fmt.Errorf("data_encryption.previous_master_key requires a master_key")
+	}
+	return master, previous, nil
+}
+
+func decodeDataEncryptionKey(name, encoded string) ([]byte, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("data_encryption.%s must be base64 encoded: %w", name, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("data_encryption.%s must be 32 bytes long, got %d", name, len(key))
+	}
+	return key, nil
+}
+
+func (d DataEncryption) Validate() error {
+	_, _, err := d.Keys()
+	return err
+}
+
 type Profiling struct {
 	BlockProfileRate     int  `json:"blockProfileRate" yaml:"blockProfileRate"`
 	MutexProfileFraction int  `json:"mutexProfileFraction" yaml:"mutexProfileFraction"`
@@ -226,6 +436,7 @@ type Persistence struct {
 	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
 	LSMMaxSegmentSize                 int64  `json:"lsmMaxSegmentSize" yaml:"lsmMaxSegmentSize"`
 	LSMSegmentsCleanupIntervalSeconds int    `json:"lsmSegmentsCleanupIntervalSeconds" yaml:"lsmSegmentsCleanupIntervalSeconds"`
+	LSMSegmentsScrubIntervalSeconds   int    `json:"lsmSegmentsScrubIntervalSeconds" yaml:"lsmSegmentsScrubIntervalSeconds"`
 	LSMSeparateObjectsCompactions     bool   `json:"lsmSeparateObjectsCompactions" yaml:"lsmSeparateObjectsCompactions"`
 	HNSWMaxLogSize                    int64  `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
 }
@@ -242,6 +453,10 @@ const DefaultPersistenceLSMMaxSegmentSize = math.MaxInt64
 // value = 0 means cleanup is turned off.
 const DefaultPersistenceLSMSegmentsCleanupIntervalSeconds = 0
 
+// DefaultPersistenceLSMSegmentsScrubIntervalSeconds = 0 means scrubbing is
+// turned off, segment checksums are then not written either.
+const DefaultPersistenceLSMSegmentsScrubIntervalSeconds = 0
+
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
 // MetadataServer is experimental.
@@ -315,10 +530,97 @@ type CORS struct {
 	AllowHeaders string `json:"allow_headers" yaml:"allow_headers"`
 }
 
+// RateLimit configures the number of requests per second the REST API
+// accepts per authenticated user and in total. Requests without valid
+// credentials are limited per remote address. 0 means unlimited. A burst of 0 defaults
+// to the rate.
+type RateLimit struct {
+	PerKey      int `json:"per_key" yaml:"per_key"`
+	PerKeyBurst int `json:"per_key_burst" yaml:"per_key_burst"`
+	Global      int `json:"global" yaml:"global"`
+	GlobalBurst int `json:"global_burst" yaml:"global_burst"`
+}
+
+func (r RateLimit) Enabled() bool {
+	return r.PerKey > 0 || r.Global > 0
+}
+
+// NetworkACL restricts which client addresses can reach the REST and gRPC
+// APIs. Admin endpoints (schema, backups, nodes, cluster, authz and keys) and
+// all other data endpoints have their own rules. The gRPC API only serves
+// data endpoints.
+type NetworkACL struct {
+	Admin NetworkACLRules `json:"admin" yaml:"admin"`
+	Data  NetworkACLRules `json:"data" yaml:"data"`
+}
+
+// NetworkACLRules are comma separated lists of CIDR ranges. Deny takes
+// precedence over allow, an empty allow list allows every address which
+// isn't denied.
+type NetworkACLRules struct {
+	Allow string `json:"allow" yaml:"allow"`
+	Deny  string `json:"deny" yaml:"deny"`
+}
+
+func (n NetworkACL) Validate() error {
+	for _, list := range []struct{ name, value string }{
+		{"admin.allow", n.Admin.Allow},
+		{"admin.deny", n.Admin.Deny},
+		{"data.allow", n.Data.Allow},
+		{"data.deny", n.Data.Deny},
+	} {
+		if _, err := ParseCIDRs(list.value); err != nil {
+			return fmt.Errorf("network_acl.%s: %w", list.name, err)
+		}
+	}
+	return nil
+}
+
+// SecurityHeaders configures the headers which protect browser clients of the
+// REST API. HSTS is only sent if a max age is set, as it must only be enabled
+// for deployments which are exclusively served via HTTPS. The swagger UI is a
+// development tool and should be disabled in production.
+type SecurityHeaders struct {
+	Disabled              bool `json:"disabled" yaml:"disabled"`
+	HSTSMaxAgeSeconds     int  `json:"hsts_max_age_seconds" yaml:"hsts_max_age_seconds"`
+	HSTSIncludeSubdomains bool `json:"hsts_include_subdomains" yaml:"hsts_include_subdomains"`
+	DisableSwaggerUI      bool `json:"disable_swagger_ui" yaml:"disable_swagger_ui"`
+}
+
+// ParseCIDRs parses a comma separated list of CIDR ranges. Single addresses
+// are accepted as well and match only themselves.
+func ParseCIDRs(list string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR range %q", entry)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
 const (
 	DefaultCORSAllowOrigin  = "*"
 	DefaultCORSAllowMethods = "*"
-	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Azure-Deployment-Id, X-Azure-Resource-Name, X-Google-Api-Key, X-Google-Vertex-Api-Key, X-Google-Studio-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Voyageai-Baseurl, X-Voyageai-Api-Key, X-Mistral-Baseurl, X-Mistral-Api-Key, X-Anthropic-Baseurl, X-Anthropic-Api-Key, X-Databricks-Endpoint, X-Databricks-Token, X-Databricks-User-Agent, X-Friendli-Token, X-Friendli-Baseurl, X-Weaviate-Api-Key"
+	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Openai-Model, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Cohere-Model, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Azure-Deployment-Id, X-Azure-Resource-Name, X-Google-Api-Key, X-Google-Vertex-Api-Key, X-Google-Studio-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Voyageai-Baseurl, X-Voyageai-Api-Key, X-Mistral-Baseurl, X-Mistral-Api-Key, X-Mistral-Model, X-Anthropic-Baseurl, X-Anthropic-Api-Key, X-Anthropic-Model, X-Databricks-Endpoint, X-Databricks-Token, X-Databricks-User-Agent, X-Friendli-Token, X-Friendli-Baseurl, X-Weaviate-Api-Key"
 )
 
 func (r ResourceUsage) Validate() error {
@@ -445,12 +747,7 @@ func (f *WeaviateConfig) GetHostAddress() string {
 // 3. Command line flags
 // If a config option is specified multiple times in different locations, the latest one will be used in this order.
 func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger logrus.FieldLogger) error {
-	// Get command line flags
-	configFileName := flags.Options.(*Flags).ConfigFile
-	// Set default if not given
-	if configFileName == "" {
-		configFileName = DefaultConfigFile
-	}
+	configFileName := configFile(flags)
 
 	// Read config file
 	file, err := os.ReadFile(configFileName)
@@ -474,6 +771,11 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return configErr(err)
 	}
 
+	// CONFIG_OVERRIDE_* variables override any field, including the ones set above
+	if err := fromEnvOverlay(&f.Config); err != nil {
+		return configErr(err)
+	}
+
 	// Load config from flags
 	f.fromFlags(flags.Options.(*Flags))
 
@@ -497,13 +799,54 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return configErr(err)
 	}
 
+	if err := f.Config.Logging.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.WarmUp.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.CacheBudget.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.ModuleBudgets.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.Backup.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.DataEncryption.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.UsageReporting.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	if err := f.Config.Raft.Validate(); err != nil {
 		return configErr(err)
 	}
 
+	if err := f.Config.NetworkACL.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	return nil
 }
 
+// configFile returns the path of the config file given as command line flag
+// or the default path
+func configFile(flags *swag.CommandLineOptionsGroup) string {
+	if name := flags.Options.(*Flags).ConfigFile; name != "" {
+		return name
+	}
+	return DefaultConfigFile
+}
+
 func (f *WeaviateConfig) parseConfigFile(file []byte, name string) (Config, error) {
 	var config Config
 
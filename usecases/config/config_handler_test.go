@@ -175,3 +175,28 @@ func TestConfig(t *testing.T) {
 		assert.ElementsMatch(t, []string{"user1@weaviate.io", "user2@weaviate.io"}, config.Authentication.APIKey.Users)
 	})
 }
+
+func TestNetworkACL(t *testing.T) {
+	t.Run("parse ranges and addresses", func(t *testing.T) {
+		nets, err := ParseCIDRs(" 10.0.0.0/8, 192.168.1.7,,2001:db8::/32,::1")
+		require.Nil(t, err)
+		require.Len(t, nets, 4)
+		assert.Equal(t, "10.0.0.0/8", nets[0].String())
+		assert.Equal(t, "192.168.1.7/32", nets[1].String())
+		assert.Equal(t, "2001:db8::/32", nets[2].String())
+		assert.Equal(t, "::1/128", nets[3].String())
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		assert.Nil(t, NetworkACL{}.Validate())
+		assert.Nil(t, NetworkACL{Admin: NetworkACLRules{Allow: "10.0.0.0/8", Deny: "10.1.0.0/16"}}.Validate())
+
+		err := NetworkACL{Data: NetworkACLRules{Deny: "10.0.0.0/33"}}.Validate()
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "network_acl.data.deny")
+
+		err = NetworkACL{Admin: NetworkACLRules{Allow: "localhost"}}.Validate()
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "network_acl.admin.allow")
+	})
+}
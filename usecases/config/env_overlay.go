@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvOverlayPrefix is the prefix of the environment variables which set any
+// field of the config by the path of its json names, e.g.
+// CONFIG_OVERRIDE_QUERY_DEFAULTS_LIMIT sets query_defaults.limit and
+// CONFIG_OVERRIDE_CONTEXTIONARY_URL sets contextionary.url. It's not
+// WEAVIATE_, as Kubernetes sets variables such as WEAVIATE_GRPC_PORT for the
+// services of the helm chart.
+const EnvOverlayPrefix = "CONFIG_OVERRIDE_"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// fromEnvOverlay sets the fields of config for which a CONFIG_OVERRIDE_*
+// variable is set. It's applied after all other environment variables, so it
+// overrides them. Strings, booleans, numbers, durations (e.g. "30s") and
+// comma-separated string lists are supported, maps can only be set through
+// the config file. Variables which don't match a field are ignored, values
+// which can't be parsed fail the loading of the config.
+func fromEnvOverlay(config *Config) error {
+	if !hasEnvPrefix(EnvOverlayPrefix) {
+		return nil
+	}
+	return overlayStruct(reflect.ValueOf(config).Elem(), EnvOverlayPrefix)
+}
+
+func hasEnvPrefix(prefix string) bool {
+	for _, env := range os.Environ() {
+		if strings.HasPrefix(env, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func overlayStruct(v reflect.Value, prefix string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := envOverlayName(field)
+		if name == "" {
+			continue
+		}
+		if err := overlayValue(v.Field(i), prefix+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func overlayValue(v reflect.Value, envName string) error {
+	switch {
+	case v.Kind() == reflect.Struct:
+		return overlayStruct(v, envName+"_")
+	case v.Kind() == reflect.Pointer && v.Type().Elem().Kind() == reflect.Struct:
+		// only allocate nil structs if one of their fields is set
+		if v.IsNil() {
+			if !hasEnvPrefix(envName + "_") {
+				return nil
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return overlayStruct(v.Elem(), envName+"_")
+	default:
+		return overlayLeaf(v, envName)
+	}
+}
+
+func overlayLeaf(v reflect.Value, envName string) error {
+	raw, ok := os.LookupEnv(envName)
+	if !ok {
+		return nil
+	}
+
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("parse %s as duration: %w", envName, err)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("parse %s as bool: %w", envName, err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("parse %s as int: %w", envName, err)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("parse %s as unsigned int: %w", envName, err)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("parse %s as float: %w", envName, err)
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("%s: lists of %s can't be set from the environment", envName, v.Type().Elem())
+		}
+		list := reflect.MakeSlice(v.Type(), 0, 0)
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = reflect.Append(list, reflect.ValueOf(item).Convert(v.Type().Elem()))
+			}
+		}
+		v.Set(list)
+	default:
+		return fmt.Errorf("%s: %s can't be set from the environment", envName, v.Type())
+	}
+	return nil
+}
+
+// envOverlayName is the upper-cased json name of the field. Fields without a
+// json name use their Go name, like encoding/json does.
+func envOverlayName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return strings.ToUpper(name)
+}
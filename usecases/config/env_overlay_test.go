@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvOverlay(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("sets fields by their json path", func(t *testing.T) {
+		t.Setenv("CONFIG_OVERRIDE_ORIGIN", "https://weaviate.example")
+		t.Setenv("CONFIG_OVERRIDE_QUERY_DEFAULTS_LIMIT", "25")
+		t.Setenv("CONFIG_OVERRIDE_CONTEXTIONARY_URL", "contextionary:9999")
+		t.Setenv("CONFIG_OVERRIDE_AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED", "true")
+		t.Setenv("CONFIG_OVERRIDE_MAX_IMPORT_GOROUTINE_FACTOR", "2.5")
+		t.Setenv("CONFIG_OVERRIDE_MODULES_CLIENT_TIMEOUT", "90s")
+		t.Setenv("CONFIG_OVERRIDE_RAFT_JOIN", "node1, node2")
+		t.Setenv("CONFIG_OVERRIDE_RAFT_SNAPSHOTTHRESHOLD", "100")
+		t.Setenv("CONFIG_OVERRIDE_APIKEY", "not a config field")
+
+		var conf Config
+		require.Nil(t, fromEnvOverlay(&conf))
+		assert.Equal(t, "https://weaviate.example", conf.Origin)
+		assert.Equal(t, int64(25), conf.QueryDefaults.Limit)
+		assert.Equal(t, "contextionary:9999", conf.Contextionary.URL)
+		assert.True(t, conf.Authentication.AnonymousAccess.Enabled)
+		assert.Equal(t, 2.5, conf.MaxImportGoroutinesFactor)
+		assert.Equal(t, 90*time.Second, conf.ModuleHttpClientTimeout)
+		assert.Equal(t, []string{"node1", "node2"}, conf.Raft.Join)
+		assert.Equal(t, uint64(100), conf.Raft.SnapshotThreshold)
+		assert.Nil(t, conf.Sentry)
+	})
+
+	t.Run("allocates nil structs only if needed", func(t *testing.T) {
+		t.Setenv("CONFIG_OVERRIDE_SENTRY_DSN", "https://sentry.example")
+
+		var conf Config
+		require.Nil(t, fromEnvOverlay(&conf))
+		require.NotNil(t, conf.Sentry)
+		assert.Equal(t, "https://sentry.example", conf.Sentry.DSN)
+	})
+
+	t.Run("invalid values are rejected", func(t *testing.T) {
+		for env, value := range map[string]string{
+			"CONFIG_OVERRIDE_QUERY_DEFAULTS_LIMIT":   "many",
+			"CONFIG_OVERRIDE_DEBUG":                  "sometimes",
+			"CONFIG_OVERRIDE_MODULES_CLIENT_TIMEOUT": "30",
+			"CONFIG_OVERRIDE_MODULE_BUDGETS":         "text2vec-openai",
+		} {
+			t.Run(env, func(t *testing.T) {
+				t.Setenv(env, value)
+
+				var conf Config
+				err := fromEnvOverlay(&conf)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), env)
+			})
+		}
+	})
+
+	t.Run("invalid values fail loading the config", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_DATA_PATH", t.TempDir())
+		t.Setenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED", "true")
+		t.Setenv("CONFIG_OVERRIDE_QUERY_DEFAULTS_LIMIT", "many")
+
+		var conf WeaviateConfig
+		err := conf.LoadConfig(&swag.CommandLineOptionsGroup{Options: &Flags{}}, logger)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "CONFIG_OVERRIDE_QUERY_DEFAULTS_LIMIT")
+	})
+
+	t.Run("ignores kubernetes service variables", func(t *testing.T) {
+		t.Setenv("WEAVIATE_GRPC_PORT", "tcp://10.0.0.1:50051")
+		t.Setenv("PERSISTENCE_DATA_PATH", t.TempDir())
+		t.Setenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED", "true")
+
+		var conf WeaviateConfig
+		require.Nil(t, conf.LoadConfig(&swag.CommandLineOptionsGroup{Options: &Flags{}}, logger))
+	})
+
+	t.Run("overrides the config file and other variables", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_DATA_PATH", t.TempDir())
+		t.Setenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED", "true")
+		t.Setenv("QUERY_DEFAULTS_LIMIT", "20")
+		t.Setenv("CONFIG_OVERRIDE_QUERY_DEFAULTS_LIMIT", "30")
+		t.Setenv("CONFIG_OVERRIDE_ORIGIN", "https://env.example")
+
+		file := filepath.Join(t.TempDir(), "weaviate.conf.json")
+		require.Nil(t, os.WriteFile(file, []byte(`{"origin": "https://file.example", "debug": true}`), 0o644))
+
+		var conf WeaviateConfig
+		require.Nil(t, conf.LoadConfig(&swag.CommandLineOptionsGroup{Options: &Flags{ConfigFile: file}}, logger))
+		assert.Equal(t, int64(30), conf.Config.QueryDefaults.Limit)
+		assert.Equal(t, "https://env.example", conf.Config.Origin)
+		assert.True(t, conf.Config.Debug)
+	})
+}
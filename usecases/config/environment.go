@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 const (
@@ -162,6 +164,17 @@ func FromEnv(config *Config) error {
 			keys := strings.Split(keysString, ",")
 			config.Authentication.APIKey.Users = keys
 		}
+
+		// collections of different keys are separated by commas, collections of
+		// the same key by semicolons, e.g. "Article;Author,*"
+		if collectionsString, ok := os.LookupEnv("AUTHENTICATION_APIKEY_COLLECTIONS"); ok {
+			perKey := strings.Split(collectionsString, ",")
+			collections := make([][]string, len(perKey))
+			for i, c := range perKey {
+				collections[i] = strings.Split(c, ";")
+			}
+			config.Authentication.APIKey.Collections = collections
+		}
 	}
 
 	if entcfg.Enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
@@ -188,6 +201,14 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if entcfg.Enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.Rbac.Enabled = true
+
+		if adminsString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ADMINS"); ok {
+			config.Authorization.Rbac.Admins = strings.Split(adminsString, ",")
+		}
+	}
+
 	config.Profiling.Disabled = entcfg.Enabled(os.Getenv("GO_PROFILING_DISABLE"))
 
 	if !config.Authentication.AnyAuthMethodSelected() {
@@ -217,6 +238,14 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if err := parseNonNegativeInt(
+		"PERSISTENCE_LSM_SEGMENTS_SCRUB_INTERVAL_HOURS",
+		func(hours int) { config.Persistence.LSMSegmentsScrubIntervalSeconds = hours * 3600 },
+		DefaultPersistenceLSMSegmentsScrubIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
 	if entcfg.Enabled(os.Getenv("PERSISTENCE_LSM_SEPARATE_OBJECTS_COMPACTIONS")) {
 		config.Persistence.LSMSeparateObjectsCompactions = true
 	}
@@ -271,6 +300,12 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	config.parseNetworkACLConfig()
+
+	if err := config.parseSecurityHeadersConfig(); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("ORIGIN"); v != "" {
 		config.Origin = v
 	}
@@ -336,6 +371,47 @@ func FromEnv(config *Config) error {
 		config.MaxImportGoroutinesFactor = DefaultMaxImportGoroutinesFactor
 	}
 
+	if v := os.Getenv("QUERY_EARLY_TERMINATION_CERTAINTY"); v != "" {
+		asFloat, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse QUERY_EARLY_TERMINATION_CERTAINTY as float: %w", err)
+		} else if asFloat <= 0 || asFloat > 1 {
+			return fmt.Errorf("QUERY_EARLY_TERMINATION_CERTAINTY must be in the range (0, 1], got %v", asFloat)
+		}
+
+		config.QueryEarlyTerminationCertainty = asFloat
+	}
+
+	if v := os.Getenv("SOFT_DELETE_RETENTION"); v != "" {
+		retention, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse SOFT_DELETE_RETENTION as duration: %w", err)
+		} else if retention < 0 {
+			return fmt.Errorf("negative SOFT_DELETE_RETENTION")
+		}
+		config.SoftDeleteRetention = retention
+	}
+
+	if v := os.Getenv("OBJECT_HISTORY_VERSIONS"); v != "" {
+		versions, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse OBJECT_HISTORY_VERSIONS as int: %w", err)
+		} else if versions < 0 {
+			return fmt.Errorf("negative OBJECT_HISTORY_VERSIONS")
+		}
+		config.ObjectHistoryVersions = versions
+	}
+
+	if v := os.Getenv("LOCK_WATCHDOG_THRESHOLD"); v != "" {
+		threshold, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse LOCK_WATCHDOG_THRESHOLD as duration: %w", err)
+		} else if threshold < 0 {
+			return fmt.Errorf("negative LOCK_WATCHDOG_THRESHOLD")
+		}
+		config.LockWatchdogThreshold = threshold
+	}
+
 	if v := os.Getenv("DEFAULT_VECTORIZER_MODULE"); v != "" {
 		config.DefaultVectorizerModule = v
 	} else {
@@ -415,10 +491,46 @@ func FromEnv(config *Config) error {
 			return fmt.Errorf("parse MAXIMUM_CONCURRENT_GET_REQUESTS as int: %w", err)
 		}
 		config.MaximumConcurrentGetRequests = int(asInt)
-	} else {
+	} else if config.MaximumConcurrentGetRequests == 0 {
 		config.MaximumConcurrentGetRequests = DefaultMaxConcurrentGetRequests
 	}
 
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		config.LogLevel = v
+	}
+
+	if err := parseNonNegativeInt(
+		"RATE_LIMIT_PER_KEY",
+		func(val int) { config.RateLimit.PerKey = val },
+		config.RateLimit.PerKey,
+	); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt(
+		"RATE_LIMIT_PER_KEY_BURST",
+		func(val int) { config.RateLimit.PerKeyBurst = val },
+		config.RateLimit.PerKeyBurst,
+	); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt(
+		"RATE_LIMIT_GLOBAL",
+		func(val int) { config.RateLimit.Global = val },
+		config.RateLimit.Global,
+	); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt(
+		"RATE_LIMIT_GLOBAL_BURST",
+		func(val int) { config.RateLimit.GlobalBurst = val },
+		config.RateLimit.GlobalBurst,
+	); err != nil {
+		return err
+	}
+
 	if err := parsePositiveInt(
 		"GRPC_MAX_MESSAGE_SIZE",
 		func(val int) { config.GRPC.MaxMsgSize = val },
@@ -444,6 +556,14 @@ func FromEnv(config *Config) error {
 
 	config.DisableGraphQL = entcfg.Enabled(os.Getenv("DISABLE_GRAPHQL"))
 
+	if entcfg.Enabled(os.Getenv("GRAPHQL_STRICT_MODE")) {
+		config.GraphQLStrictMode = true
+	}
+
+	if v := os.Getenv("SCHEMA_TEMPLATES_PATH"); v != "" {
+		config.SchemaTemplatesPath = v
+	}
+
 	if config.Raft, err = parseRAFTConfig(config.Cluster.Hostname); err != nil {
 		return fmt.Errorf("parse raft config: %w", err)
 	}
@@ -460,15 +580,102 @@ func FromEnv(config *Config) error {
 		config.Replication.DeletionStrategy = v
 	}
 
+	if v := os.Getenv("REPLICATION_READ_ROUTING"); v != "" {
+		switch v {
+		case "local-first", "nearest":
+			config.Replication.ReadRouting = v
+		default:
+			return fmt.Errorf("REPLICATION_READ_ROUTING must be one of %q, %q. Got: %q",
+				"local-first", "nearest", v)
+		}
+	}
+
 	config.DisableTelemetry = false
 	if entcfg.Enabled(os.Getenv("DISABLE_TELEMETRY")) {
 		config.DisableTelemetry = true
 	}
 
+	if entcfg.Enabled(os.Getenv("USAGE_REPORTING_ENABLED")) {
+		config.UsageReporting.Enabled = true
+	}
+
+	if v := os.Getenv("USAGE_REPORTING_ENDPOINT"); v != "" {
+		config.UsageReporting.Endpoint = v
+	}
+
+	if v := os.Getenv("USAGE_REPORTING_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse USAGE_REPORTING_INTERVAL as duration: %w", err)
+		}
+		config.UsageReporting.Interval = interval
+	}
+
 	if entcfg.Enabled(os.Getenv("HNSW_STARTUP_WAIT_FOR_VECTOR_CACHE")) {
 		config.HNSWStartupWaitForVectorCache = true
 	}
 
+	if entcfg.Enabled(os.Getenv("WARMUP_ENABLED")) {
+		config.WarmUp.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"WARMUP_MEMORY_BUDGET_MB",
+		func(val int) { config.WarmUp.MemoryBudgetMB = val },
+		DefaultWarmUpMemoryBudgetMB,
+	); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt(
+		"CACHE_BUDGET_PERCENTAGE",
+		func(val int) { config.CacheBudget.Percentage = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("CACHE_BUDGET_WEIGHTS"); v != "" {
+		weights, err := parseCacheBudgetWeights(v)
+		if err != nil {
+			return err
+		}
+		config.CacheBudget.Weights = weights
+	}
+
+	if err := parseModuleBudgets(config); err != nil {
+		return err
+	}
+
+	config.Backup.EncryptionKey = os.Getenv("BACKUP_ENCRYPTION_KEY")
+	config.DataEncryption.MasterKey = os.Getenv("DATA_ENCRYPTION_MASTER_KEY")
+	config.DataEncryption.PreviousMasterKey = os.Getenv("DATA_ENCRYPTION_PREVIOUS_MASTER_KEY")
+
+	if v := os.Getenv("BLOB_STORAGE_PATH"); v != "" {
+		config.BlobStorage.Path = v
+	} else if config.BlobStorage.Path == "" {
+		config.BlobStorage.Path = filepath.Join(config.Persistence.DataPath, DefaultBlobStorageDir)
+	}
+
+	if entcfg.Enabled(os.Getenv("TRACING_ENABLED")) {
+		config.Tracing.Enabled = true
+	}
+	if v := os.Getenv("TRACING_ENDPOINT"); v != "" {
+		config.Tracing.Endpoint = v
+	}
+	if v := os.Getenv("TRACING_SAMPLE_RATE"); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse TRACING_SAMPLE_RATE as float: %w", err)
+		}
+		config.Tracing.SampleRate = rate
+	} else if config.Tracing.SampleRate == 0 {
+		config.Tracing.SampleRate = tracing.DefaultSampleRate
+	}
+	if err := config.Tracing.Validate(); err != nil {
+		return err
+	}
+
 	// explicitly reset sentry config
 	sentry.Config = nil
 	config.Sentry, err = sentry.InitSentryConfig()
@@ -605,25 +812,58 @@ func parseRAFTConfig(hostname string) (Raft, error) {
 func (c *Config) parseCORSConfig() error {
 	if v := os.Getenv("CORS_ALLOW_ORIGIN"); v != "" {
 		c.CORS.AllowOrigin = v
-	} else {
+	} else if c.CORS.AllowOrigin == "" {
 		c.CORS.AllowOrigin = DefaultCORSAllowOrigin
 	}
 
 	if v := os.Getenv("CORS_ALLOW_METHODS"); v != "" {
 		c.CORS.AllowMethods = v
-	} else {
+	} else if c.CORS.AllowMethods == "" {
 		c.CORS.AllowMethods = DefaultCORSAllowMethods
 	}
 
 	if v := os.Getenv("CORS_ALLOW_HEADERS"); v != "" {
 		c.CORS.AllowHeaders = v
-	} else {
+	} else if c.CORS.AllowHeaders == "" {
 		c.CORS.AllowHeaders = DefaultCORSAllowHeaders
 	}
 
 	return nil
 }
 
+func (c *Config) parseNetworkACLConfig() {
+	if v, ok := os.LookupEnv("NETWORK_ACL_ADMIN_ALLOW"); ok {
+		c.NetworkACL.Admin.Allow = v
+	}
+	if v, ok := os.LookupEnv("NETWORK_ACL_ADMIN_DENY"); ok {
+		c.NetworkACL.Admin.Deny = v
+	}
+	if v, ok := os.LookupEnv("NETWORK_ACL_DATA_ALLOW"); ok {
+		c.NetworkACL.Data.Allow = v
+	}
+	if v, ok := os.LookupEnv("NETWORK_ACL_DATA_DENY"); ok {
+		c.NetworkACL.Data.Deny = v
+	}
+}
+
+func (c *Config) parseSecurityHeadersConfig() error {
+	if entcfg.Enabled(os.Getenv("SECURITY_HEADERS_DISABLED")) {
+		c.SecurityHeaders.Disabled = true
+	}
+	if entcfg.Enabled(os.Getenv("HSTS_INCLUDE_SUBDOMAINS")) {
+		c.SecurityHeaders.HSTSIncludeSubdomains = true
+	}
+	if entcfg.Enabled(os.Getenv("SWAGGER_UI_DISABLED")) {
+		c.SecurityHeaders.DisableSwaggerUI = true
+	}
+
+	return parseNonNegativeInt(
+		"HSTS_MAX_AGE_SECONDS",
+		func(val int) { c.SecurityHeaders.HSTSMaxAgeSeconds = val },
+		c.SecurityHeaders.HSTSMaxAgeSeconds,
+	)
+}
+
 func (c *Config) parseMemtableConfig() error {
 	// first parse old idle name for flush value
 	if err := parsePositiveInt(
@@ -677,6 +917,67 @@ func (c *Config) parseMemtableConfig() error {
 	return nil
 }
 
+// parseCacheBudgetWeights parses a list such as "vector=2"
+func parseCacheBudgetWeights(v string) (map[string]int, error) {
+	weights := map[string]int{}
+	for _, pair := range strings.Split(v, ",") {
+		group, weight, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || group == "" {
+			return nil, fmt.Errorf("CACHE_BUDGET_WEIGHTS: expected <cache>=<weight>, got %q", pair)
+		}
+		asInt, err := strconv.Atoi(weight)
+		if err != nil {
+			return nil, fmt.Errorf("CACHE_BUDGET_WEIGHTS: parse weight of %q: %w", group, err)
+		}
+		if asInt <= 0 {
+			return nil, fmt.Errorf("CACHE_BUDGET_WEIGHTS: weight of %q must be positive, got %d", group, asInt)
+		}
+		weights[group] = asInt
+	}
+	return weights, nil
+}
+
+// parseModuleBudgets sets the module budgets from lists such as
+// "text2vec-openai=10,text2vec-cohere=5", one variable per budget value
+func parseModuleBudgets(config *Config) error {
+	fields := []struct {
+		envName string
+		set     func(budget *ModuleBudget, val int)
+	}{
+		{"MODULE_BUDGET_REQUESTS_PER_SECOND", func(b *ModuleBudget, val int) { b.RequestsPerSecond = val }},
+		{"MODULE_BUDGET_TOKENS_PER_MINUTE", func(b *ModuleBudget, val int) { b.TokensPerMinute = val }},
+		{"MODULE_BUDGET_MAX_WAIT_SECONDS", func(b *ModuleBudget, val int) { b.MaxWaitSeconds = val }},
+		{"MODULE_BUDGET_MAX_QUEUED", func(b *ModuleBudget, val int) { b.MaxQueued = val }},
+	}
+
+	for _, field := range fields {
+		v := os.Getenv(field.envName)
+		if v == "" {
+			continue
+		}
+		for _, pair := range strings.Split(v, ",") {
+			module, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || module == "" {
+				return fmt.Errorf("%s: expected <module>=<value>, got %q", field.envName, pair)
+			}
+			asInt, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("%s: parse value of %q: %w", field.envName, module, err)
+			}
+			if asInt < 0 {
+				return fmt.Errorf("%s: value of %q must not be negative, got %d", field.envName, module, asInt)
+			}
+			if config.ModuleBudgets == nil {
+				config.ModuleBudgets = ModuleBudgets{}
+			}
+			budget := config.ModuleBudgets[module]
+			field.set(&budget, asInt)
+			config.ModuleBudgets[module] = budget
+		}
+	}
+	return nil
+}
+
 func parsePositiveInt(envName string, cb func(val int), defaultValue int) error {
 	return parseInt(envName, defaultValue, func(val int) error {
 		if val <= 0 {
@@ -807,6 +1108,7 @@ func parseClusterConfig() (cluster.Config, error) {
 		cfg.Hostname, _ = os.Hostname()
 	}
 	cfg.Join = os.Getenv("CLUSTER_JOIN")
+	cfg.Zone = os.Getenv("CLUSTER_ZONE")
 
 	advertiseAddr, advertiseAddrSet := os.LookupEnv("CLUSTER_ADVERTISE_ADDR")
 	advertisePort, advertisePortSet := os.LookupEnv("CLUSTER_ADVERTISE_PORT")
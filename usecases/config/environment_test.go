@@ -12,6 +12,8 @@
 package config
 
 import (
+	"bytes"
+	"encoding/base64"
 	"errors"
 	"os"
 	"testing"
@@ -164,6 +166,34 @@ func TestEnvironmentPersistence_dataPath(t *testing.T) {
 	}
 }
 
+func TestEnvironmentBlobStoragePath(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_DATA_PATH", "/var/lib/weaviate")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, "/var/lib/weaviate/.blobs", conf.BlobStorage.Path)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("BLOB_STORAGE_PATH", "/var/lib/weaviate-blobs")
+		conf := Config{BlobStorage: BlobStorage{Path: "/var/data/blobs"}}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, "/var/lib/weaviate-blobs", conf.BlobStorage.Path)
+	})
+}
+
+func TestEnvironmentAPIKeyCollections(t *testing.T) {
+	t.Setenv("AUTHENTICATION_APIKEY_ENABLED", "true")
+	t.Setenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS", "key1,key2")
+	t.Setenv("AUTHENTICATION_APIKEY_USERS", "jane")
+	t.Setenv("AUTHENTICATION_APIKEY_COLLECTIONS", "Books;Movies,*")
+
+	conf := Config{}
+	err := FromEnv(&conf)
+	require.Nil(t, err)
+	require.Equal(t, [][]string{{"Books", "Movies"}, {"*"}}, conf.Authentication.APIKey.Collections)
+}
+
 func TestEnvironmentMemtable_MaxSize(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -396,6 +426,129 @@ func TestEnvironmentMaxConcurrentGetRequests(t *testing.T) {
 	}
 }
 
+func TestEnvironmentRateLimit(t *testing.T) {
+	factors := []struct {
+		name        string
+		env         map[string]string
+		expected    RateLimit
+		expectedErr bool
+	}{
+		{"not given", map[string]string{}, RateLimit{}, false},
+		{
+			"per key and global",
+			map[string]string{
+				"RATE_LIMIT_PER_KEY":       "10",
+				"RATE_LIMIT_PER_KEY_BURST": "20",
+				"RATE_LIMIT_GLOBAL":        "100",
+				"RATE_LIMIT_GLOBAL_BURST":  "150",
+			},
+			RateLimit{PerKey: 10, PerKeyBurst: 20, Global: 100, GlobalBurst: 150},
+			false,
+		},
+		{"negative", map[string]string{"RATE_LIMIT_PER_KEY": "-1"}, RateLimit{}, true},
+		{"not parsable", map[string]string{"RATE_LIMIT_GLOBAL": "lots"}, RateLimit{}, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.RateLimit)
+			}
+		})
+	}
+}
+
+func TestEnvironmentNetworkACL(t *testing.T) {
+	t.Setenv("NETWORK_ACL_ADMIN_ALLOW", "10.0.0.0/8,192.168.1.7")
+	t.Setenv("NETWORK_ACL_DATA_DENY", "203.0.113.0/24")
+
+	conf := Config{NetworkACL: NetworkACL{Data: NetworkACLRules{Allow: "0.0.0.0/0"}}}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, NetworkACL{
+		Admin: NetworkACLRules{Allow: "10.0.0.0/8,192.168.1.7"},
+		Data:  NetworkACLRules{Allow: "0.0.0.0/0", Deny: "203.0.113.0/24"},
+	}, conf.NetworkACL)
+}
+
+func TestEnvironmentSecurityHeaders(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, SecurityHeaders{}, conf.SecurityHeaders)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("HSTS_MAX_AGE_SECONDS", "31536000")
+		t.Setenv("HSTS_INCLUDE_SUBDOMAINS", "true")
+		t.Setenv("SWAGGER_UI_DISABLED", "true")
+
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, SecurityHeaders{
+			HSTSMaxAgeSeconds:     31536000,
+			HSTSIncludeSubdomains: true,
+			DisableSwaggerUI:      true,
+		}, conf.SecurityHeaders)
+	})
+
+	t.Run("negative max age", func(t *testing.T) {
+		t.Setenv("HSTS_MAX_AGE_SECONDS", "-1")
+		assert.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentModuleBudgets(t *testing.T) {
+	factors := []struct {
+		name        string
+		env         map[string]string
+		expected    ModuleBudgets
+		expectedErr bool
+	}{
+		{"not given", map[string]string{}, nil, false},
+		{
+			"multiple modules",
+			map[string]string{
+				"MODULE_BUDGET_REQUESTS_PER_SECOND": "text2vec-openai=10, text2vec-cohere=5",
+				"MODULE_BUDGET_TOKENS_PER_MINUTE":   "text2vec-openai=150000",
+				"MODULE_BUDGET_MAX_WAIT_SECONDS":    "text2vec-cohere=5",
+				"MODULE_BUDGET_MAX_QUEUED":          "text2vec-openai=100",
+			},
+			ModuleBudgets{
+				"text2vec-openai": {RequestsPerSecond: 10, TokensPerMinute: 150000, MaxQueued: 100},
+				"text2vec-cohere": {RequestsPerSecond: 5, MaxWaitSeconds: 5},
+			},
+			false,
+		},
+		{"negative", map[string]string{"MODULE_BUDGET_REQUESTS_PER_SECOND": "text2vec-openai=-1"}, nil, true},
+		{"not parsable", map[string]string{"MODULE_BUDGET_MAX_QUEUED": "text2vec-openai=lots"}, nil, true},
+		{"missing module", map[string]string{"MODULE_BUDGET_TOKENS_PER_MINUTE": "100"}, nil, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.ModuleBudgets)
+			}
+		})
+	}
+}
+
 func TestEnvironmentCORS_Origin(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -811,6 +964,185 @@ func TestEnvironmentHNSWWaitForPrefill(t *testing.T) {
 	}
 }
 
+func TestEnvironmentWarmUp(t *testing.T) {
+	factors := []struct {
+		name        string
+		enabled     string
+		budget      string
+		expected    WarmUp
+		expectedErr bool
+	}{
+		{"not given", "", "", WarmUp{MemoryBudgetMB: DefaultWarmUpMemoryBudgetMB}, false},
+		{"enabled", "true", "", WarmUp{Enabled: true, MemoryBudgetMB: DefaultWarmUpMemoryBudgetMB}, false},
+		{"enabled with budget", "on", "512", WarmUp{Enabled: true, MemoryBudgetMB: 512}, false},
+		{"invalid budget", "true", "-1", WarmUp{}, true},
+		{"budget not a number", "true", "lots", WarmUp{}, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.enabled != "" {
+				t.Setenv("WARMUP_ENABLED", tt.enabled)
+			}
+			if tt.budget != "" {
+				t.Setenv("WARMUP_MEMORY_BUDGET_MB", tt.budget)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.WarmUp)
+			}
+		})
+	}
+}
+
+func TestEnvironmentQueryEarlyTermination(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    float64
+		expectedErr bool
+	}{
+		{"not given", []string{}, 0, false},
+		{"valid", []string{"0.9"}, 0.9, false},
+		{"one", []string{"1"}, 1, false},
+		{"zero", []string{"0"}, 0, true},
+		{"larger than one", []string{"1.5"}, 0, true},
+		{"not parsable", []string{"high"}, 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("QUERY_EARLY_TERMINATION_CERTAINTY", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.QueryEarlyTerminationCertainty)
+			}
+		})
+	}
+}
+
+func TestEnvironmentCacheBudget(t *testing.T) {
+	factors := []struct {
+		name        string
+		percentage  string
+		weights     string
+		expected    CacheBudget
+		expectedErr bool
+	}{
+		{"not given", "", "", CacheBudget{}, false},
+		{"percentage only", "60", "", CacheBudget{Percentage: 60}, false},
+		{
+			"with weights", "60", "vector=3, object=1",
+			CacheBudget{Percentage: 60, Weights: map[string]int{"vector": 3, "object": 1}}, false,
+		},
+		{"negative percentage", "-5", "", CacheBudget{}, true},
+		{"malformed weights", "60", "vector", CacheBudget{}, true},
+		{"weight not a number", "60", "vector=lots", CacheBudget{}, true},
+		{"zero weight", "60", "vector=0", CacheBudget{}, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.percentage != "" {
+				t.Setenv("CACHE_BUDGET_PERCENTAGE", tt.percentage)
+			}
+			if tt.weights != "" {
+				t.Setenv("CACHE_BUDGET_WEIGHTS", tt.weights)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.CacheBudget)
+			}
+		})
+	}
+}
+
+func TestEnvironmentBackupEncryptionKey(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	factors := []struct {
+		name        string
+		value       string
+		expected    []byte
+		expectedErr bool
+	}{
+		{"not given", "", nil, false},
+		{"valid", base64.StdEncoding.EncodeToString(key), key, false},
+		{"not base64", "not a key!", nil, true},
+		{"too short", base64.StdEncoding.EncodeToString(key[:16]), nil, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BACKUP_ENCRYPTION_KEY", tt.value)
+			conf := Config{}
+			require.Nil(t, FromEnv(&conf))
+
+			got, err := conf.Backup.Key()
+			if tt.expectedErr {
+				require.NotNil(t, err)
+				require.NotNil(t, conf.Backup.Validate())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEnvironmentDataEncryptionKeys(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	previous := bytes.Repeat([]byte{8}, 32)
+	factors := []struct {
+		name             string
+		master           string
+		previousMaster   string
+		expected         []byte
+		expectedPrevious []byte
+		expectedErr      bool
+	}{
+		{"not given", "", "", nil, nil, false},
+		{"valid", base64.StdEncoding.EncodeToString(key), "", key, nil, false},
+		{
+			"valid with previous", base64.StdEncoding.EncodeToString(key),
+			base64.StdEncoding.EncodeToString(previous), key, previous, false,
+		},
+		{"not base64", "not a key!", "", nil, nil, true},
+		{"too short", base64.StdEncoding.EncodeToString(key[:16]), "", nil, nil, true},
+		{"previous without master", "", base64.StdEncoding.EncodeToString(previous), nil, nil, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("DATA_ENCRYPTION_MASTER_KEY", tt.master)
+			t.Setenv("DATA_ENCRYPTION_PREVIOUS_MASTER_KEY", tt.previousMaster)
+			conf := Config{}
+			require.Nil(t, FromEnv(&conf))
+
+			master, previous, err := conf.DataEncryption.Keys()
+			if tt.expectedErr {
+				require.NotNil(t, err)
+				require.NotNil(t, conf.DataEncryption.Validate())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, master)
+				require.Equal(t, tt.expectedPrevious, previous)
+			}
+		})
+	}
+}
+
 func TestEnvironmentHNSWVisitedListPoolMaxSize(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// DefaultConfigWatchInterval is how often the config file is checked for
+// changes
+const DefaultConfigWatchInterval = 10 * time.Second
+
+// RuntimeSettings are the parts of the config which are applied without a
+// restart when the config is reloaded. Changes to any other setting are only
+// logged and require a restart.
+type RuntimeSettings struct {
+	LogLevel                     string
+	CORS                         CORS
+	RateLimit                    RateLimit
+	NetworkACL                   NetworkACL
+	MaximumConcurrentGetRequests int
+}
+
+// RuntimeSettings returns the settings which can be changed at runtime
+func (c Config) RuntimeSettings() RuntimeSettings {
+	return RuntimeSettings{
+		LogLevel:                     c.LogLevel,
+		CORS:                         c.CORS,
+		RateLimit:                    c.RateLimit,
+		NetworkACL:                   c.NetworkACL,
+		MaximumConcurrentGetRequests: c.MaximumConcurrentGetRequests,
+	}
+}
+
+// withoutRuntimeSettings returns a copy of the config without the settings
+// which can be changed at runtime
+func (c Config) withoutRuntimeSettings() Config {
+	c.LogLevel = ""
+	c.CORS = CORS{}
+	c.RateLimit = RateLimit{}
+	c.NetworkACL = NetworkACL{}
+	c.MaximumConcurrentGetRequests = 0
+	return c
+}
+
+// Watcher reloads the config when the process receives SIGHUP or when the
+// config file changes. The config is loaded the same way as at startup, so
+// environment variables and command line flags still take precedence over
+// the config file. Changed runtime settings are passed to the listeners.
+type Watcher struct {
+	load     func() (Config, error)
+	file     string
+	interval time.Duration
+	logger   logrus.FieldLogger
+
+	sync.Mutex
+	current   Config
+	listeners []func(RuntimeSettings)
+}
+
+// NewWatcher creates a watcher for the config loaded with the given flags.
+// current is the config the server was started with.
+func NewWatcher(flags *swag.CommandLineOptionsGroup, current Config, logger logrus.FieldLogger) *Watcher {
+	return &Watcher{
+		load: func() (Config, error) {
+			var cfg WeaviateConfig
+			err := cfg.LoadConfig(flags, logger)
+			return cfg.Config, err
+		},
+		file:     configFile(flags),
+		interval: DefaultConfigWatchInterval,
+		logger:   logger,
+		current:  current,
+	}
+}
+
+// OnChange registers a listener which is called with the new runtime
+// settings whenever they change
+func (w *Watcher) OnChange(listener func(RuntimeSettings)) {
+	w.Lock()
+	defer w.Unlock()
+	w.listeners = append(w.listeners, listener)
+}
+
+// Reload reads the config again and applies the runtime settings if they
+// changed. An invalid config is rejected and the current config is kept.
+func (w *Watcher) Reload() error {
+	cfg, err := w.load()
+	if err != nil {
+		return err
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	previous := w.current
+	w.current = cfg
+
+	if !reflect.DeepEqual(previous.withoutRuntimeSettings(), cfg.withoutRuntimeSettings()) {
+		w.logger.WithField("action", "config_reload").
+			Warn("settings changed which can't be applied at runtime, they take effect after a restart")
+	}
+
+	settings := cfg.RuntimeSettings()
+	if settings == previous.RuntimeSettings() {
+		return nil
+	}
+	for _, listener := range w.listeners {
+		listener(settings)
+	}
+	w.logger.WithField("action", "config_reload").Info("applied reloaded config")
+	return nil
+}
+
+// Start reloads the config on SIGHUP and whenever the modification time of
+// the config file changes until ctx is done. SIGHUP is handled as soon as
+// Start returns.
+func (w *Watcher) Start(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	enterrors.GoWrapper(func() {
+		defer signal.Stop(hup)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		modTime := w.fileModTime()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				w.reload("signal")
+			case <-ticker.C:
+				if t := w.fileModTime(); !t.Equal(modTime) {
+					modTime = t
+					w.reload("file_change")
+				}
+			}
+		}
+	}, w.logger)
+}
+
+func (w *Watcher) reload(trigger string) {
+	if err := w.Reload(); err != nil {
+		w.logger.WithField("action", "config_reload").WithField("trigger", trigger).WithError(err).
+			Error("could not reload config, keeping the current config")
+	}
+}
+
+// fileModTime returns the zero time if the config file doesn't exist, so
+// that creating the file is detected as a change as well
+func (w *Watcher) fileModTime() time.Time {
+	info, err := os.Stat(w.file)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
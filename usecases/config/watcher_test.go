@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	t.Setenv("PERSISTENCE_DATA_PATH", t.TempDir())
+	t.Setenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED", "true")
+
+	file := filepath.Join(t.TempDir(), "weaviate.conf.json")
+	writeConfig := func(t *testing.T, content string) {
+		require.Nil(t, os.WriteFile(file, []byte(content), 0o644))
+	}
+	writeConfig(t, `{"rate_limit": {"global": 10}, "cors": {"allow_origin": "https://a.example"}}`)
+
+	flags := &swag.CommandLineOptionsGroup{Options: &Flags{ConfigFile: file}}
+	logger, hook := test.NewNullLogger()
+	var startup WeaviateConfig
+	require.Nil(t, startup.LoadConfig(flags, logger))
+	assert.Equal(t, 10, startup.Config.RateLimit.Global)
+	assert.Equal(t, "https://a.example", startup.Config.CORS.AllowOrigin)
+	assert.Equal(t, DefaultCORSAllowMethods, startup.Config.CORS.AllowMethods)
+
+	newWatcher := func() (*Watcher, *[]RuntimeSettings) {
+		w := NewWatcher(flags, startup.Config, logger)
+		var changes []RuntimeSettings
+		w.OnChange(func(s RuntimeSettings) { changes = append(changes, s) })
+		return w, &changes
+	}
+
+	t.Run("unchanged config", func(t *testing.T) {
+		w, changes := newWatcher()
+		require.Nil(t, w.Reload())
+		assert.Empty(t, *changes)
+	})
+
+	t.Run("changed runtime settings", func(t *testing.T) {
+		t.Setenv("MAXIMUM_CONCURRENT_GET_REQUESTS", "5")
+		writeConfig(t, `{"rate_limit": {"global": 20}, "cors": {"allow_origin": "https://b.example"}, "log_level": "debug"}`)
+		hook.Reset()
+
+		w, changes := newWatcher()
+		require.Nil(t, w.Reload())
+		require.Len(t, *changes, 1)
+		assert.Equal(t, 20, (*changes)[0].RateLimit.Global)
+		assert.Equal(t, "https://b.example", (*changes)[0].CORS.AllowOrigin)
+		assert.Equal(t, "debug", (*changes)[0].LogLevel)
+		assert.Equal(t, 5, (*changes)[0].MaximumConcurrentGetRequests)
+		for _, entry := range hook.AllEntries() {
+			assert.NotContains(t, entry.Message, "after a restart")
+		}
+
+		// environment variables still take precedence
+		t.Setenv("LOG_LEVEL", "warn")
+		require.Nil(t, w.Reload())
+		require.Len(t, *changes, 2)
+		assert.Equal(t, "warn", (*changes)[1].LogLevel)
+	})
+
+	t.Run("changed settings which require a restart", func(t *testing.T) {
+		writeConfig(t, `{"rate_limit": {"global": 10}, "cors": {"allow_origin": "https://a.example"}, "origin": "changed"}`)
+		hook.Reset()
+
+		w, changes := newWatcher()
+		require.Nil(t, w.Reload())
+		assert.Empty(t, *changes)
+		require.NotNil(t, hook.LastEntry())
+		assert.Contains(t, hook.LastEntry().Message, "after a restart")
+	})
+
+	t.Run("invalid config is rejected", func(t *testing.T) {
+		writeConfig(t, `{"rate_limit": `)
+
+		w, changes := newWatcher()
+		assert.NotNil(t, w.Reload())
+		assert.Empty(t, *changes)
+	})
+
+	t.Run("reloads when the file changes", func(t *testing.T) {
+		w, _ := newWatcher()
+		w.interval = 10 * time.Millisecond
+		changed := make(chan RuntimeSettings, 1)
+		w.OnChange(func(s RuntimeSettings) { changed <- s })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		w.Start(ctx)
+
+		time.Sleep(50 * time.Millisecond)
+		writeConfig(t, `{"rate_limit": {"global": 40}, "cors": {"allow_origin": "https://a.example"}}`)
+		require.Nil(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+
+		select {
+		case s := <-changed:
+			assert.Equal(t, 40, s.RateLimit.Global)
+		case <-time.After(5 * time.Second):
+			t.Fatal("config was not reloaded")
+		}
+	})
+}
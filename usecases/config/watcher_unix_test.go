@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !windows
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcherReloadsOnSIGHUP(t *testing.T) {
+	t.Setenv("PERSISTENCE_DATA_PATH", t.TempDir())
+	t.Setenv("AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED", "true")
+
+	file := filepath.Join(t.TempDir(), "weaviate.conf.json")
+	require.Nil(t, os.WriteFile(file, []byte(`{"rate_limit": {"global": 10}}`), 0o644))
+	flags := &swag.CommandLineOptionsGroup{Options: &Flags{ConfigFile: file}}
+	logger, _ := test.NewNullLogger()
+	var startup WeaviateConfig
+	require.Nil(t, startup.LoadConfig(flags, logger))
+
+	w := NewWatcher(flags, startup.Config, logger)
+	// only the signal may trigger the reload
+	w.interval = time.Hour
+	changed := make(chan RuntimeSettings, 1)
+	w.OnChange(func(s RuntimeSettings) { changed <- s })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.Start(ctx)
+
+	require.Nil(t, os.WriteFile(file, []byte(`{"rate_limit": {"global": 30}}`), 0o644))
+	require.Nil(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+
+	select {
+	case s := <-changed:
+		assert.Equal(t, 30, s.RateLimit.Global)
+	case <-time.After(5 * time.Second):
+		t.Fatal("config was not reloaded")
+	}
+}
@@ -0,0 +1,316 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package encryption encrypts data at rest with per-shard data keys. The data
+// keys are stored on disk, wrapped with a master key from the configuration,
+// so that rotating the master key does not require to re-encrypt any data.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+const (
+	keySize     = 32
+	versionSize = 4
+)
+
+var (
+	ErrNoMasterKey       = errors.New("no master key configured")
+	ErrUnknownKeyVersion = errors.New("data was encrypted with an unknown key version")
+	ErrInvalidCiphertext = errors.New("ciphertext is too short")
+)
+
+// keyringFile is the persisted part of the keyring. Data keys are only ever
+// written wrapped with the master key.
+type keyringFile struct {
+	Current uint32            `json:"current"`
+	Keys    map[uint32][]byte `json:"keys"`
+}
+
+// Keyring holds the data keys of a single shard. Values are always encrypted
+// with the current key, the older keys are kept to decrypt values written
+// before the last rotation until they are re-encrypted, see Retire.
+type Keyring struct {
+	sync.RWMutex
+	path    string
+	master  cipher.AEAD
+	current uint32
+	keys    map[uint32]cipher.AEAD
+	wrapped map[uint32][]byte
+}
+
+// OpenKeyring loads the keyring at path or creates it. Data keys which can
+// only be unwrapped with the previous master key are wrapped with the
+// current one, which completes a rotation of the master key. If version is
+// newer than the current key, a new data key is created, see Rotate.
+func OpenKeyring(path string, master, previous []byte, version uint32) (*Keyring, error) {
+	if len(master) == 0 {
+		return nil, ErrNoMasterKey
+	}
+	masterAEAD, err := newAEAD(master)
+	if err != nil {
+		return nil, fmt.Errorf("master key: %w", err)
+	}
+	var previousAEAD cipher.AEAD
+	if len(previous) > 0 {
+		if previousAEAD, err = newAEAD(previous); err != nil {
+			return nil, fmt.Errorf("previous master key: %w", err)
+		}
+	}
+
+	k := &Keyring{
+		path:    path,
+		master:  masterAEAD,
+		keys:    map[uint32]cipher.AEAD{},
+		wrapped: map[uint32][]byte{},
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := k.addKey(version); err != nil {
+			return nil, err
+		}
+		return k, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read keyring: %w", err)
+	}
+
+	var file keyringFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse keyring %q: %w", path, err)
+	}
+
+	rewrapped := false
+	for v, wrapped := range file.Keys {
+		key, err := unwrapKey(masterAEAD, v, wrapped)
+		if err != nil && previousAEAD != nil {
+			key, err = unwrapKey(previousAEAD, v, wrapped)
+			if err == nil {
+				wrapped, err = wrapKey(masterAEAD, v, key)
+				rewrapped = true
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unwrap data key %d of keyring %q: %w", v, path, err)
+		}
+		if k.keys[v], err = newAEAD(key); err != nil {
+			return nil, fmt.Errorf("data key %d: %w", v, err)
+		}
+		k.wrapped[v] = wrapped
+	}
+	k.current = file.Current
+	if _, ok := k.keys[k.current]; !ok {
+		return nil, fmt.Errorf("keyring %q: current data key %d is missing", path, k.current)
+	}
+
+	if version > k.current {
+		if err := k.addKey(version); err != nil {
+			return nil, err
+		}
+		return k, nil
+	}
+	if rewrapped {
+		return k, k.persist(k.current, k.wrapped)
+	}
+	return k, nil
+}
+
+// Rotate creates a new data key, which is used to encrypt all values from
+// now on. Versions never decrease, so rotating to the current or an older
+// version is a no-op.
+func (k *Keyring) Rotate(version uint32) error {
+	k.Lock()
+	defer k.Unlock()
+
+	if version <= k.current {
+		return nil
+	}
+	return k.addKey(version)
+}
+
+// Retire drops all but the current data key. It must only be called once all
+// values were re-encrypted with the current key, as the values encrypted with
+// the older keys can no longer be decrypted afterwards.
+func (k *Keyring) Retire() error {
+	k.Lock()
+	defer k.Unlock()
+
+	if len(k.keys) == 1 {
+		return nil
+	}
+
+	// the older keys are only dropped from memory once the file without them
+	// is durable, so that a failed write doesn't lose them
+	wrapped := map[uint32][]byte{k.current: k.wrapped[k.current]}
+	if err := k.persist(k.current, wrapped); err != nil {
+		return err
+	}
+	for v := range k.keys {
+		if v != k.current {
+			delete(k.keys, v)
+		}
+	}
+	k.wrapped = wrapped
+	return nil
+}
+
+// Current is the version of the key values are encrypted with
+func (k *Keyring) Current() uint32 {
+	k.RLock()
+	defer k.RUnlock()
+
+	return k.current
+}
+
+// Versions is the number of data keys in the keyring. More than one means
+// that values encrypted with an older key may exist.
+func (k *Keyring) Versions() int {
+	k.RLock()
+	defer k.RUnlock()
+
+	return len(k.keys)
+}
+
+// Path is the location of the keyring file, it has to be included in backups
+// of the shard
+func (k *Keyring) Path() string {
+	return k.path
+}
+
+// Encrypt seals plain with the current data key. The result is laid out as
+// [key version uint32][nonce][ciphertext and tag].
+func (k *Keyring) Encrypt(plain []byte) ([]byte, error) {
+	k.RLock()
+	version := k.current
+	aead := k.keys[version]
+	k.RUnlock()
+
+	out := make([]byte, versionSize+aead.NonceSize(), versionSize+aead.NonceSize()+len(plain)+aead.Overhead())
+	binary.LittleEndian.PutUint32(out, version)
+	nonce := out[versionSize:]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return aead.Seal(out, nonce, plain, out[:versionSize]), nil
+}
+
+// Decrypt opens a value sealed by Encrypt and appends the plaintext to dst
+func (k *Keyring) Decrypt(dst, sealed []byte) ([]byte, error) {
+	if len(sealed) < versionSize {
+		return nil, ErrInvalidCiphertext
+	}
+	version := binary.LittleEndian.Uint32(sealed)
+
+	k.RLock()
+	aead, ok := k.keys[version]
+	k.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownKeyVersion, version)
+	}
+
+	if len(sealed) < versionSize+aead.NonceSize()+aead.Overhead() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce := sealed[versionSize : versionSize+aead.NonceSize()]
+	plain, err := aead.Open(dst, nonce, sealed[versionSize+aead.NonceSize():], sealed[:versionSize])
+	if err != nil {
+		return nil, fmt.Errorf("decrypt: %w", err)
+	}
+	return plain, nil
+}
+
+// addKey creates a new data key and makes it the current one. The key is
+// only used once the keyring file including it is durable. It must be called
+// with the lock held.
+func (k *Keyring) addKey(version uint32) error {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("generate data key: %w", err)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	wrapped, err := wrapKey(k.master, version, key)
+	if err != nil {
+		return err
+	}
+
+	all := make(map[uint32][]byte, len(k.wrapped)+1)
+	for v, w := range k.wrapped {
+		all[v] = w
+	}
+	all[version] = wrapped
+	if err := k.persist(version, all); err != nil {
+		return err
+	}
+
+	k.keys[version] = aead
+	k.wrapped = all
+	k.current = version
+	return nil
+}
+
+// persist replaces the keyring file with the given keys. The previous file
+// is only replaced once the new one was synced to disk, see
+// diskio.WriteFileAtomic. It must be called with the lock held.
+func (k *Keyring) persist(current uint32, wrapped map[uint32][]byte) error {
+	data, err := json.Marshal(keyringFile{Current: current, Keys: wrapped})
+	if err != nil {
+		return fmt.Errorf("marshal keyring: %w", err)
+	}
+	if err := diskio.WriteFileAtomic(k.path, data, 0o600); err != nil {
+		return fmt.Errorf("persist keyring: %w", err)
+	}
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", keySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// wrapKey encrypts a data key with the master key. The version is
+// authenticated, so that wrapped keys can't be swapped in the file.
+func wrapKey(master cipher.AEAD, version uint32, key []byte) ([]byte, error) {
+	ad := binary.LittleEndian.AppendUint32(nil, version)
+	nonce := make([]byte, master.NonceSize(), master.NonceSize()+len(key)+master.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return master.Seal(nonce, nonce, key, ad), nil
+}
+
+func unwrapKey(master cipher.AEAD, version uint32, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < master.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+	ad := binary.LittleEndian.AppendUint32(nil, version)
+	return master.Open(nil, wrapped[:master.NonceSize()], wrapped[master.NonceSize():], ad)
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring(t *testing.T) {
+	master := bytes.Repeat([]byte{1}, keySize)
+	otherMaster := bytes.Repeat([]byte{2}, keySize)
+	plain := []byte("some object")
+
+	t.Run("master key is required", func(t *testing.T) {
+		_, err := OpenKeyring(filepath.Join(t.TempDir(), "keyring"), nil, nil, 0)
+		assert.ErrorIs(t, err, ErrNoMasterKey)
+	})
+
+	t.Run("encrypt and decrypt", func(t *testing.T) {
+		k, err := OpenKeyring(filepath.Join(t.TempDir(), "keyring"), master, nil, 0)
+		require.Nil(t, err)
+
+		sealed, err := k.Encrypt(plain)
+		require.Nil(t, err)
+		assert.False(t, bytes.Contains(sealed, plain))
+
+		res, err := k.Decrypt([]byte("prefix-"), sealed)
+		require.Nil(t, err)
+		assert.Equal(t, "prefix-some object", string(res))
+
+		sealed[len(sealed)-1] ^= 0xff
+		_, err = k.Decrypt(nil, sealed)
+		assert.NotNil(t, err)
+
+		_, err = k.Decrypt(nil, sealed[:2])
+		assert.ErrorIs(t, err, ErrInvalidCiphertext)
+	})
+
+	t.Run("data keys are never stored in plain", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keyring")
+		k, err := OpenKeyring(path, master, nil, 0)
+		require.Nil(t, err)
+		sealed, err := k.Encrypt(plain)
+		require.Nil(t, err)
+
+		_, err = OpenKeyring(path, otherMaster, nil, 0)
+		assert.NotNil(t, err, "keyring must not open with a different master key")
+
+		reopened, err := OpenKeyring(path, master, nil, 0)
+		require.Nil(t, err)
+		res, err := reopened.Decrypt(nil, sealed)
+		require.Nil(t, err)
+		assert.Equal(t, plain, res)
+	})
+
+	t.Run("rotate and retire data keys", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keyring")
+		k, err := OpenKeyring(path, master, nil, 0)
+		require.Nil(t, err)
+		old, err := k.Encrypt(plain)
+		require.Nil(t, err)
+
+		require.Nil(t, k.Rotate(1))
+		assert.Equal(t, uint32(1), k.Current())
+		assert.Equal(t, 2, k.Versions())
+
+		rotated, err := k.Encrypt(plain)
+		require.Nil(t, err)
+		for _, sealed := range [][]byte{old, rotated} {
+			res, err := k.Decrypt(nil, sealed)
+			require.Nil(t, err)
+			assert.Equal(t, plain, res)
+		}
+
+		require.Nil(t, k.Rotate(0), "older versions are ignored")
+		assert.Equal(t, uint32(1), k.Current())
+
+		require.Nil(t, k.Retire())
+		assert.Equal(t, 1, k.Versions())
+		_, err = k.Decrypt(nil, old)
+		assert.ErrorIs(t, err, ErrUnknownKeyVersion)
+
+		reopened, err := OpenKeyring(path, master, nil, 1)
+		require.Nil(t, err)
+		assert.Equal(t, 1, reopened.Versions())
+		res, err := reopened.Decrypt(nil, rotated)
+		require.Nil(t, err)
+		assert.Equal(t, plain, res)
+	})
+
+	t.Run("keys are kept if the keyring can't be written", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keyring")
+		k, err := OpenKeyring(path, master, nil, 0)
+		require.Nil(t, err)
+		sealed, err := k.Encrypt(plain)
+		require.Nil(t, err)
+		require.Nil(t, k.Rotate(1))
+
+		// a directory in place of the file makes every write fail
+		require.Nil(t, os.Remove(path))
+		require.Nil(t, os.Mkdir(path, 0o755))
+
+		assert.NotNil(t, k.Retire())
+		assert.Equal(t, 2, k.Versions())
+		res, err := k.Decrypt(nil, sealed)
+		require.Nil(t, err)
+		assert.Equal(t, plain, res)
+
+		assert.NotNil(t, k.Rotate(2))
+		assert.Equal(t, uint32(1), k.Current())
+	})
+
+	t.Run("newer version on open rotates", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keyring")
+		_, err := OpenKeyring(path, master, nil, 0)
+		require.Nil(t, err)
+
+		k, err := OpenKeyring(path, master, nil, 3)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(3), k.Current())
+		assert.Equal(t, 2, k.Versions())
+	})
+
+	t.Run("master key rotation", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keyring")
+		k, err := OpenKeyring(path, master, nil, 0)
+		require.Nil(t, err)
+		sealed, err := k.Encrypt(plain)
+		require.Nil(t, err)
+
+		_, err = OpenKeyring(path, otherMaster, nil, 0)
+		require.NotNil(t, err)
+
+		k, err = OpenKeyring(path, otherMaster, master, 0)
+		require.Nil(t, err)
+		res, err := k.Decrypt(nil, sealed)
+		require.Nil(t, err)
+		assert.Equal(t, plain, res)
+
+		// the data keys were wrapped with the new master key
+		_, err = OpenKeyring(path, otherMaster, nil, 0)
+		require.Nil(t, err)
+		_, err = os.Stat(path + ".tmp")
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package logging
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultSyslogTag      = "weaviate"
+	DefaultFileMaxSizeMB  = 100
+	DefaultFileMaxBackups = 5
+)
+
+// Config outlines the additional log sinks. Log output to stdout is always
+// active, the sinks configured here are used in addition to it.
+type Config struct {
+	Syslog Syslog `json:"syslog" yaml:"syslog"`
+	File   File   `json:"file" yaml:"file"`
+	GELF   GELF   `json:"gelf" yaml:"gelf"`
+}
+
+// Syslog forwards log entries to a local or remote syslog daemon. If Network
+// and Address are empty, the local syslog socket is used.
+type Syslog struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Network string `json:"network" yaml:"network"`
+	Address string `json:"address" yaml:"address"`
+	Tag     string `json:"tag" yaml:"tag"`
+	Level   string `json:"level" yaml:"level"`
+}
+
+// File writes log entries to a file which is rotated once it exceeds
+// MaxSizeMB or once MaxAge has passed since it was opened. At most MaxBackups
+// rotated files are kept.
+type File struct {
+	Enabled    bool          `json:"enabled" yaml:"enabled"`
+	Path       string        `json:"path" yaml:"path"`
+	MaxSizeMB  int           `json:"max_size_mb" yaml:"max_size_mb"`
+	MaxAge     time.Duration `json:"max_age" yaml:"max_age"`
+	MaxBackups int           `json:"max_backups" yaml:"max_backups"`
+	Level      string        `json:"level" yaml:"level"`
+}
+
+// GELF sends log entries as Graylog Extended Log Format messages over UDP.
+type GELF struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Address string `json:"address" yaml:"address"`
+	Host    string `json:"host" yaml:"host"`
+	Level   string `json:"level" yaml:"level"`
+}
+
+func (c Config) Validate() error {
+	if c.Syslog.Enabled {
+		if _, err := levelsFrom(c.Syslog.Level); err != nil {
+			return fmt.Errorf("logging.syslog.level: %w", err)
+		}
+		if (c.Syslog.Network == "") != (c.Syslog.Address == "") {
+			return fmt.Errorf("logging.syslog.network and logging.syslog.address must be set together")
+		}
+	}
+
+	if c.File.Enabled {
+		if c.File.Path == "" {
+			return fmt.Errorf("logging.file.path must be set")
+		}
+		if c.File.MaxSizeMB < 0 {
+			return fmt.Errorf("logging.file.max_size_mb must not be negative")
+		}
+		if c.File.MaxBackups < 0 {
+			return fmt.Errorf("logging.file.max_backups must not be negative")
+		}
+		if c.File.MaxAge < 0 {
+			return fmt.Errorf("logging.file.max_age must not be negative")
+		}
+		if _, err := levelsFrom(c.File.Level); err != nil {
+			return fmt.Errorf("logging.file.level: %w", err)
+		}
+	}
+
+	if c.GELF.Enabled {
+		if c.GELF.Address == "" {
+			return fmt.Errorf("logging.gelf.address must be set")
+		}
+		if _, err := levelsFrom(c.GELF.Level); err != nil {
+			return fmt.Errorf("logging.gelf.level: %w", err)
+		}
+	}
+
+	return nil
+}
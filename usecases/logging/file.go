@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const rotatedFileTimeFormat = "20060102T150405.000"
+
+// fileSink writes log entries to a file and rotates it based on size and
+// age. Rotated files are renamed to <path>.<timestamp> and the oldest ones
+// are removed once more than maxBackups exist.
+type fileSink struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+	levels     []logrus.Level
+
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	now      func() time.Time
+}
+
+func newFileSink(cfg File) (*fileSink, error) {
+	levels, err := levelsFrom(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSizeMB := cfg.MaxSizeMB
+	if maxSizeMB == 0 {
+		maxSizeMB = DefaultFileMaxSizeMB
+	}
+	maxBackups := cfg.MaxBackups
+	if maxBackups == 0 {
+		maxBackups = DefaultFileMaxBackups
+	}
+
+	s := &fileSink{
+		path:       cfg.Path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxAge:     cfg.MaxAge,
+		maxBackups: maxBackups,
+		levels:     levels,
+		now:        time.Now,
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return nil, fmt.Errorf("create log directory: %w", err)
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *fileSink) Levels() []logrus.Level {
+	return s.levels
+}
+
+func (s *fileSink) Fire(entry *logrus.Entry) error {
+	line, err := entry.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Write(line)
+	return err
+}
+
+func (s *fileSink) Write(p []byte) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.file == nil {
+		return 0, fmt.Errorf("log file %q is closed", s.path)
+	}
+
+	if s.shouldRotate(int64(len(p))) {
+		if err := s.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := s.file.Write(p)
+	s.size += int64(n)
+	return n, err
+}
+
+func (s *fileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *fileSink) shouldRotate(incoming int64) bool {
+	if s.size > 0 && s.size+incoming > s.maxSize {
+		return true
+	}
+
+	return s.maxAge > 0 && s.now().Sub(s.openedAt) >= s.maxAge
+}
+
+func (s *fileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open log file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("stat log file: %w", err)
+	}
+
+	s.file = f
+	s.size = info.Size()
+	s.openedAt = s.now()
+	return nil
+}
+
+func (s *fileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("close log file: %w", err)
+	}
+	s.file = nil
+
+	rotated := fmt.Sprintf("%s.%s", s.path, s.now().UTC().Format(rotatedFileTimeFormat))
+	if err := os.Rename(s.path, rotated); err != nil {
+		return fmt.Errorf("rotate log file: %w", err)
+	}
+
+	if err := s.removeOldBackups(); err != nil {
+		return err
+	}
+
+	return s.open()
+}
+
+func (s *fileSink) removeOldBackups() error {
+	dir, base := filepath.Split(s.path)
+	if dir == "" {
+		dir = "."
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("list rotated log files: %w", err)
+	}
+
+	var backups []string
+	for _, e := range entries {
+		if !e.IsDir() && isRotatedFile(base, e.Name()) {
+			backups = append(backups, e.Name())
+		}
+	}
+
+	if len(backups) <= s.maxBackups {
+		return nil
+	}
+
+	// the timestamp suffix sorts lexicographically, so the oldest backups come first
+	sort.Strings(backups)
+	for _, name := range backups[:len(backups)-s.maxBackups] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			return fmt.Errorf("remove rotated log file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// isRotatedFile is true if name is a file rotated by the sink, i.e. base
+// followed by a timestamp in rotatedFileTimeFormat. Other files with the
+// same prefix, e.g. weaviate.log.gz of another tool, are never removed.
+func isRotatedFile(base, name string) bool {
+	suffix, ok := strings.CutPrefix(name, base+".")
+	if !ok || len(suffix) != len(rotatedFileTimeFormat) {
+		return false
+	}
+	_, err := time.Parse(rotatedFileTimeFormat, suffix)
+	return err == nil
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	newSink := func(t *testing.T, cfg File) *fileSink {
+		cfg.Enabled = true
+		cfg.Path = filepath.Join(t.TempDir(), "logs", "weaviate.log")
+		s, err := newFileSink(cfg)
+		require.Nil(t, err)
+		t.Cleanup(func() { s.Close() })
+		return s
+	}
+
+	listBackups := func(t *testing.T, s *fileSink) []string {
+		matches, err := filepath.Glob(s.path + ".*")
+		require.Nil(t, err)
+		return matches
+	}
+
+	t.Run("writes entries to the file", func(t *testing.T) {
+		s := newSink(t, File{})
+		logger := logrus.New()
+		logger.SetOutput(&discard{})
+		logger.AddHook(s)
+
+		logger.Info("hello sink")
+
+		content, err := os.ReadFile(s.path)
+		require.Nil(t, err)
+		assert.Contains(t, string(content), "hello sink")
+	})
+
+	t.Run("rotates when the size limit is exceeded", func(t *testing.T) {
+		s := newSink(t, File{MaxBackups: 2})
+		s.maxSize = 10
+
+		for i := 0; i < 5; i++ {
+			s.now = fakeClock(time.Duration(i) * time.Second)
+			_, err := s.Write([]byte("0123456789"))
+			require.Nil(t, err)
+		}
+
+		assert.Len(t, listBackups(t, s), 2)
+		content, err := os.ReadFile(s.path)
+		require.Nil(t, err)
+		assert.Equal(t, "0123456789", string(content))
+	})
+
+	t.Run("only removes rotated files", func(t *testing.T) {
+		s := newSink(t, File{MaxBackups: 1})
+		s.maxSize = 10
+		others := []string{s.path + ".gz", s.path + ".bak", s.path + ".20240101T000000"}
+		for _, name := range others {
+			require.Nil(t, os.WriteFile(name, []byte("keep"), 0o644))
+		}
+
+		for i := 0; i < 3; i++ {
+			s.now = fakeClock(time.Duration(i) * time.Second)
+			_, err := s.Write([]byte("0123456789"))
+			require.Nil(t, err)
+		}
+
+		for _, name := range others {
+			assert.FileExists(t, name)
+		}
+		assert.Len(t, listBackups(t, s), len(others)+1)
+	})
+
+	t.Run("rotates when the max age is exceeded", func(t *testing.T) {
+		s := newSink(t, File{MaxAge: time.Minute})
+		s.now = fakeClock(0)
+		s.openedAt = s.now()
+
+		_, err := s.Write([]byte("first"))
+		require.Nil(t, err)
+		assert.Len(t, listBackups(t, s), 0)
+
+		s.now = fakeClock(2 * time.Minute)
+		_, err = s.Write([]byte("second"))
+		require.Nil(t, err)
+
+		assert.Len(t, listBackups(t, s), 1)
+		content, err := os.ReadFile(s.path)
+		require.Nil(t, err)
+		assert.Equal(t, "second", string(content))
+	})
+
+	t.Run("respects the configured level", func(t *testing.T) {
+		s := newSink(t, File{Level: "warning"})
+		assert.ElementsMatch(t, []logrus.Level{
+			logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel, logrus.WarnLevel,
+		}, s.Levels())
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       Config
+		expectErr bool
+	}{
+		{name: "nothing enabled", cfg: Config{}},
+		{name: "file without path", cfg: Config{File: File{Enabled: true}}, expectErr: true},
+		{name: "file with invalid level", cfg: Config{File: File{Enabled: true, Path: "x", Level: "loud"}}, expectErr: true},
+		{name: "gelf without address", cfg: Config{GELF: GELF{Enabled: true}}, expectErr: true},
+		{name: "syslog with address only", cfg: Config{Syslog: Syslog{Enabled: true, Address: "localhost:514"}}, expectErr: true},
+		{name: "valid", cfg: Config{
+			Syslog: Syslog{Enabled: true, Network: "udp", Address: "localhost:514"},
+			File:   File{Enabled: true, Path: "/var/log/weaviate.log", Level: "info"},
+			GELF:   GELF{Enabled: true, Address: "localhost:12201"},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			if test.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+type discard struct{}
+
+func (d *discard) Write(p []byte) (int, error) { return len(p), nil }
+
+func fakeClock(offset time.Duration) func() time.Time {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return func() time.Time { return base.Add(offset) }
+}
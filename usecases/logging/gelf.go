@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	gelfVersion = "1.1"
+	// gelfChunkSize is the maximum size of a single UDP datagram, chosen to
+	// stay below typical WAN MTUs.
+	gelfChunkSize = 1420
+	// gelfChunkHeaderSize consists of 2 magic bytes, an 8 byte message id, a
+	// sequence number and the total sequence count.
+	gelfChunkHeaderSize = 12
+	gelfMaxChunks       = 128
+)
+
+var gelfChunkMagic = []byte{0x1e, 0x0f}
+
+// gelfSink sends log entries as GELF 1.1 messages over UDP. Messages which
+// exceed a single datagram are split into GELF chunks.
+type gelfSink struct {
+	conn   net.Conn
+	host   string
+	levels []logrus.Level
+}
+
+func newGELFSink(cfg GELF) (*gelfSink, error) {
+	levels, err := levelsFrom(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	host := cfg.Host
+	if host == "" {
+		host, _ = os.Hostname()
+	}
+
+	conn, err := net.Dial("udp", cfg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gelfSink{conn: conn, host: host, levels: levels}, nil
+}
+
+func (s *gelfSink) Levels() []logrus.Level {
+	return s.levels
+}
+
+func (s *gelfSink) Fire(entry *logrus.Entry) error {
+	msg, err := s.marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return s.send(msg)
+}
+
+func (s *gelfSink) Close() error {
+	return s.conn.Close()
+}
+
+func (s *gelfSink) marshal(entry *logrus.Entry) ([]byte, error) {
+	short, full, _ := strings.Cut(entry.Message, "\n")
+
+	msg := map[string]interface{}{
+		"version":       gelfVersion,
+		"host":          s.host,
+		"short_message": short,
+		"timestamp":     float64(entry.Time.UnixNano()) / 1e9,
+		"level":         gelfLevel(entry.Level),
+	}
+	if full != "" {
+		msg["full_message"] = entry.Message
+	}
+
+	for k, v := range entry.Data {
+		// "_id" is reserved by the GELF spec
+		if k == "id" {
+			k = "field_id"
+		}
+		if err, ok := v.(error); ok {
+			v = err.Error()
+		}
+		msg["_"+k] = v
+	}
+
+	return json.Marshal(msg)
+}
+
+func (s *gelfSink) send(msg []byte) error {
+	if len(msg) <= gelfChunkSize {
+		_, err := s.conn.Write(msg)
+		return err
+	}
+
+	chunks, err := gelfChunks(msg)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range chunks {
+		if _, err := s.conn.Write(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func gelfChunks(msg []byte) ([][]byte, error) {
+	payloadSize := gelfChunkSize - gelfChunkHeaderSize
+	count := (len(msg) + payloadSize - 1) / payloadSize
+	if count > gelfMaxChunks {
+		return nil, fmt.Errorf("gelf message of %d bytes exceeds %d chunks", len(msg), gelfMaxChunks)
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("generate gelf message id: %w", err)
+	}
+
+	chunks := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		end := (i + 1) * payloadSize
+		if end > len(msg) {
+			end = len(msg)
+		}
+
+		chunk := make([]byte, 0, gelfChunkHeaderSize+end-i*payloadSize)
+		chunk = append(chunk, gelfChunkMagic...)
+		chunk = append(chunk, id...)
+		chunk = append(chunk, byte(i), byte(count))
+		chunk = append(chunk, msg[i*payloadSize:end]...)
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
+// gelfLevel maps logrus levels to syslog severities as required by GELF
+func gelfLevel(l logrus.Level) int {
+	switch l {
+	case logrus.PanicLevel:
+		return 1
+	case logrus.FatalLevel:
+		return 2
+	case logrus.ErrorLevel:
+		return 3
+	case logrus.WarnLevel:
+		return 4
+	case logrus.InfoLevel:
+		return 6
+	default:
+		return 7
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGELFSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer conn.Close()
+
+	s, err := newGELFSink(GELF{Enabled: true, Address: conn.LocalAddr().String(), Host: "node1"})
+	require.Nil(t, err)
+	defer s.Close()
+
+	read := func(t *testing.T) []byte {
+		buf := make([]byte, 65535)
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		require.Nil(t, err)
+		return buf[:n]
+	}
+
+	t.Run("sends a single message", func(t *testing.T) {
+		logger := logrus.New()
+		logger.SetOutput(&discard{})
+		logger.AddHook(s)
+
+		logger.WithField("action", "startup").WithError(errors.New("boom")).
+			Error("something failed\nwith details")
+
+		var msg map[string]interface{}
+		require.Nil(t, json.Unmarshal(read(t), &msg))
+		assert.Equal(t, "1.1", msg["version"])
+		assert.Equal(t, "node1", msg["host"])
+		assert.Equal(t, "something failed", msg["short_message"])
+		assert.Equal(t, "something failed\nwith details", msg["full_message"])
+		assert.Equal(t, float64(3), msg["level"])
+		assert.Equal(t, "startup", msg["_action"])
+		assert.Equal(t, "boom", msg["_error"])
+	})
+
+	t.Run("chunks large messages", func(t *testing.T) {
+		entry := logrus.NewEntry(logrus.New())
+		entry.Level = logrus.InfoLevel
+		entry.Message = strings.Repeat("a", 3*gelfChunkSize)
+		require.Nil(t, s.Fire(entry))
+
+		var payload []byte
+		for i := 0; i < 4; i++ {
+			chunk := read(t)
+			require.True(t, len(chunk) <= gelfChunkSize)
+			assert.Equal(t, gelfChunkMagic, chunk[:2])
+			assert.Equal(t, byte(i), chunk[10])
+			assert.Equal(t, byte(4), chunk[11])
+			payload = append(payload, chunk[gelfChunkHeaderSize:]...)
+		}
+
+		var msg map[string]interface{}
+		require.Nil(t, json.Unmarshal(payload, &msg))
+		assert.Equal(t, entry.Message, msg["short_message"])
+	})
+}
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequest(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	ctx := WithRequestID(context.Background(), "abc")
+	assert.Equal(t, "abc", RequestID(ctx))
+	assert.Equal(t, "", RequestID(context.Background()))
+
+	WithRequest(ctx, logger).Info("with id")
+	WithRequest(context.Background(), logger).Info("without id")
+
+	entries := hook.AllEntries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "abc", entries[0].Data["request_id"])
+	_, ok := entries[1].Data["request_id"]
+	assert.False(t, ok)
+}
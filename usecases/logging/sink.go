@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package logging provides additional destinations ("sinks") for the
+// server's log output. Sinks are attached to the main logger as logrus hooks,
+// so every log line that is written to stdout is also forwarded to each of
+// the configured sinks.
+package logging
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Sink is an additional destination for log entries. Every sink is a logrus
+// hook and must release its underlying resources (files, sockets, etc.) on
+// Close.
+type Sink interface {
+	logrus.Hook
+	io.Closer
+}
+
+// NewSinks creates all sinks that are enabled in the given config. If any of
+// the sinks cannot be created, the ones created so far are closed again.
+func NewSinks(cfg Config) ([]Sink, error) {
+	var sinks []Sink
+
+	if cfg.Syslog.Enabled {
+		s, err := newSyslogSink(cfg.Syslog)
+		if err != nil {
+			closeAll(sinks)
+			return nil, fmt.Errorf("syslog sink: %w", err)
+		}
+		sinks = append(sinks, s)
+	}
+
+	if cfg.File.Enabled {
+		s, err := newFileSink(cfg.File)
+		if err != nil {
+			closeAll(sinks)
+			return nil, fmt.Errorf("file sink: %w", err)
+		}
+		sinks = append(sinks, s)
+	}
+
+	if cfg.GELF.Enabled {
+		s, err := newGELFSink(cfg.GELF)
+		if err != nil {
+			closeAll(sinks)
+			return nil, fmt.Errorf("gelf sink: %w", err)
+		}
+		sinks = append(sinks, s)
+	}
+
+	return sinks, nil
+}
+
+// AttachSinks creates the configured sinks and registers them as hooks on the
+// logger. The returned sinks should be closed on shutdown.
+func AttachSinks(logger *logrus.Logger, cfg Config) ([]Sink, error) {
+	sinks, err := NewSinks(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sinks {
+		logger.AddHook(s)
+	}
+
+	return sinks, nil
+}
+
+func closeAll(sinks []Sink) {
+	for _, s := range sinks {
+		s.Close()
+	}
+}
+
+// levelsFrom returns all logrus levels which are at least as severe as the
+// given level. An empty string means all levels.
+func levelsFrom(level string) ([]logrus.Level, error) {
+	if level == "" {
+		return logrus.AllLevels, nil
+	}
+
+	min, err := logrus.ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	var levels []logrus.Level
+	for _, l := range logrus.AllLevels {
+		if l <= min {
+			levels = append(levels, l)
+		}
+	}
+	return levels, nil
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !windows
+
+package logging
+
+import (
+	"log/syslog"
+
+	"github.com/sirupsen/logrus"
+)
+
+type syslogSink struct {
+	writer *syslog.Writer
+	levels []logrus.Level
+}
+
+func newSyslogSink(cfg Syslog) (*syslogSink, error) {
+	levels, err := levelsFrom(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := cfg.Tag
+	if tag == "" {
+		tag = DefaultSyslogTag
+	}
+
+	w, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syslogSink{writer: w, levels: levels}, nil
+}
+
+func (s *syslogSink) Levels() []logrus.Level {
+	return s.levels
+}
+
+func (s *syslogSink) Fire(entry *logrus.Entry) error {
+	line, err := entry.String()
+	if err != nil {
+		return err
+	}
+
+	switch entry.Level {
+	case logrus.PanicLevel, logrus.FatalLevel:
+		return s.writer.Crit(line)
+	case logrus.ErrorLevel:
+		return s.writer.Err(line)
+	case logrus.WarnLevel:
+		return s.writer.Warning(line)
+	case logrus.InfoLevel:
+		return s.writer.Info(line)
+	default:
+		return s.writer.Debug(line)
+	}
+}
+
+func (s *syslogSink) Close() error {
+	return s.writer.Close()
+}
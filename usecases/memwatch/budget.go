@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package memwatch
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// Evictable is a cache whose memory can be accounted for and reclaimed by a
+// [Budget].
+type Evictable interface {
+	// UsedBytes returns an estimate of the heap currently held by the cache
+	UsedBytes() int64
+	// Evict drops the cache's contents and returns the number of bytes freed
+	Evict() int64
+}
+
+// CacheRegistry is implemented by allocation checkers that can track caches
+// against a shared memory budget. Caches should check for it with a type
+// assertion on their [AllocChecker], so that checkers without a budget keep
+// working unchanged.
+type CacheRegistry interface {
+	RegisterCache(group string, c Evictable) (unregister func())
+}
+
+// BudgetObserver receives the per-group accounting of every enforcement
+// cycle, typically to export it as metrics.
+type BudgetObserver interface {
+	ObserveCacheBudget(group string, usedBytes, shareBytes int64)
+	ObserveCacheEviction(group string, freedBytes int64)
+}
+
+// Budget is a memory budget shared by all registered caches. The total budget
+// is a percentage of the memory limit and is split between cache groups
+// according to their weights. When a group exceeds its share, its largest
+// caches are evicted until it fits again.
+//
+// At the moment only the vector caches register, in the "vector" group.
+// Other caches can join by registering under their own group.
+type Budget struct {
+	limitFn    func() int64
+	percentage int
+	weights    map[string]int
+	observer   BudgetObserver
+
+	mu     sync.Mutex
+	caches map[string]map[*registeredCache]struct{}
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+type registeredCache struct {
+	Evictable
+}
+
+// DefaultCacheGroupWeight is used for groups that have no explicit weight
+const DefaultCacheGroupWeight = 1
+
+// NewBudget creates a [Budget] of percentage% of the limit returned by
+// limitFn. Groups that are missing from weights use
+// [DefaultCacheGroupWeight]. The observer is optional.
+func NewBudget(limitFn func() int64, percentage int, weights map[string]int,
+	observer BudgetObserver,
+) *Budget {
+	w := make(map[string]int, len(weights))
+	for group, weight := range weights {
+		w[group] = weight
+	}
+
+	return &Budget{
+		limitFn:    limitFn,
+		percentage: percentage,
+		weights:    w,
+		observer:   observer,
+		caches:     map[string]map[*registeredCache]struct{}{},
+	}
+}
+
+func (b *Budget) RegisterCache(group string, c Evictable) func() {
+	rc := &registeredCache{c}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.caches[group] == nil {
+		b.caches[group] = map[*registeredCache]struct{}{}
+	}
+	b.caches[group][rc] = struct{}{}
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.caches[group], rc)
+		if len(b.caches[group]) == 0 {
+			// groups without caches don't take a share of the budget
+			delete(b.caches, group)
+		}
+	}
+}
+
+func (b *Budget) weight(group string) int {
+	if w, ok := b.weights[group]; ok {
+		return w
+	}
+	return DefaultCacheGroupWeight
+}
+
+// Shares returns the number of bytes each currently registered group may
+// hold.
+func (b *Budget) Shares() map[string]int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.shares()
+}
+
+func (b *Budget) shares() map[string]int64 {
+	total := b.limitFn() / 100 * int64(b.percentage)
+
+	sumWeights := 0
+	for group := range b.caches {
+		sumWeights += b.weight(group)
+	}
+
+	out := make(map[string]int64, len(b.caches))
+	for group := range b.caches {
+		if sumWeights == 0 {
+			out[group] = 0
+			continue
+		}
+		out[group] = total * int64(b.weight(group)) / int64(sumWeights)
+	}
+	return out
+}
+
+// Enforce evicts caches of every group that exceeds its share of the budget
+// and returns the total number of bytes freed.
+func (b *Budget) Enforce() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var freedTotal int64
+	for group, share := range b.shares() {
+		caches := make([]*registeredCache, 0, len(b.caches[group]))
+		used := make(map[*registeredCache]int64, len(b.caches[group]))
+		var usedTotal int64
+		for c := range b.caches[group] {
+			caches = append(caches, c)
+			used[c] = c.UsedBytes()
+			usedTotal += used[c]
+		}
+
+		// evicting the largest caches first frees the budget with the fewest
+		// evictions
+		sort.Slice(caches, func(i, j int) bool {
+			return used[caches[i]] > used[caches[j]]
+		})
+
+		var freed int64
+		for _, c := range caches {
+			if usedTotal <= share {
+				break
+			}
+			if used[c] == 0 {
+				break
+			}
+			n := c.Evict()
+			freed += n
+			usedTotal -= n
+		}
+
+		if b.observer != nil {
+			b.observer.ObserveCacheBudget(group, usedTotal, share)
+			if freed > 0 {
+				b.observer.ObserveCacheEviction(group, freed)
+			}
+		}
+		freedTotal += freed
+	}
+
+	return freedTotal
+}
+
+// Start enforces the budget periodically until [Budget.Stop] is called.
+func (b *Budget) Start(interval time.Duration, logger logrus.FieldLogger) {
+	b.stop = make(chan struct{})
+	b.wg.Add(1)
+	enterrors.GoWrapper(func() {
+		defer b.wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-b.stop:
+				return
+			case <-t.C:
+				b.Enforce()
+			}
+		}
+	}, logger)
+}
+
+func (b *Budget) Stop() {
+	if b.stop == nil {
+		return
+	}
+	close(b.stop)
+	b.wg.Wait()
+	b.stop = nil
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package memwatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEvictable struct {
+	used    int64
+	evicted int
+}
+
+func (f *fakeEvictable) UsedBytes() int64 {
+	return f.used
+}
+
+func (f *fakeEvictable) Evict() int64 {
+	freed := f.used
+	f.used = 0
+	f.evicted++
+	return freed
+}
+
+type fakeBudgetObserver struct {
+	used  map[string]int64
+	share map[string]int64
+	freed map[string]int64
+}
+
+func newFakeBudgetObserver() *fakeBudgetObserver {
+	return &fakeBudgetObserver{
+		used:  map[string]int64{},
+		share: map[string]int64{},
+		freed: map[string]int64{},
+	}
+}
+
+func (f *fakeBudgetObserver) ObserveCacheBudget(group string, used, share int64) {
+	f.used[group] = used
+	f.share[group] = share
+}
+
+func (f *fakeBudgetObserver) ObserveCacheEviction(group string, freed int64) {
+	f.freed[group] += freed
+}
+
+func TestBudget(t *testing.T) {
+	limit := func() int64 { return 1000 }
+
+	t.Run("shares are split by weight", func(t *testing.T) {
+		b := NewBudget(limit, 60, map[string]int{"vector": 2}, nil)
+		b.RegisterCache("vector", &fakeEvictable{})
+		b.RegisterCache("object", &fakeEvictable{})
+
+		assert.Equal(t, map[string]int64{"vector": 400, "object": 200}, b.Shares())
+	})
+
+	t.Run("nothing is evicted within the budget", func(t *testing.T) {
+		b := NewBudget(limit, 50, nil, nil)
+		c1 := &fakeEvictable{used: 200}
+		c2 := &fakeEvictable{used: 300}
+		b.RegisterCache("vector", c1)
+		b.RegisterCache("vector", c2)
+
+		assert.Equal(t, int64(0), b.Enforce())
+		assert.Equal(t, 0, c1.evicted)
+		assert.Equal(t, 0, c2.evicted)
+	})
+
+	t.Run("largest caches of a group over its share are evicted first", func(t *testing.T) {
+		obs := newFakeBudgetObserver()
+		b := NewBudget(limit, 100, nil, obs)
+		small := &fakeEvictable{used: 100}
+		medium := &fakeEvictable{used: 250}
+		large := &fakeEvictable{used: 400}
+		other := &fakeEvictable{used: 450}
+		b.RegisterCache("vector", small)
+		b.RegisterCache("vector", medium)
+		b.RegisterCache("vector", large)
+		b.RegisterCache("object", other)
+
+		// both groups have a share of 500
+		assert.Equal(t, int64(400), b.Enforce())
+		assert.Equal(t, 1, large.evicted)
+		assert.Equal(t, 0, medium.evicted)
+		assert.Equal(t, 0, small.evicted)
+		assert.Equal(t, 0, other.evicted)
+
+		assert.Equal(t, int64(350), obs.used["vector"])
+		assert.Equal(t, int64(500), obs.share["vector"])
+		assert.Equal(t, int64(400), obs.freed["vector"])
+		assert.Equal(t, int64(450), obs.used["object"])
+		assert.Zero(t, obs.freed["object"])
+	})
+
+	t.Run("unregistered caches are no longer evicted", func(t *testing.T) {
+		b := NewBudget(limit, 10, nil, nil)
+		c := &fakeEvictable{used: 500}
+		unregister := b.RegisterCache("vector", c)
+		unregister()
+
+		assert.Equal(t, int64(0), b.Enforce())
+		assert.Equal(t, 0, c.evicted)
+	})
+
+	t.Run("monitor only registers with a budget", func(t *testing.T) {
+		m := NewDummyMonitor()
+		c := &fakeEvictable{used: 500}
+		m.RegisterCache("vector", c)()
+
+		b := NewBudget(limit, 10, nil, nil)
+		m.SetCacheBudget(b)
+		m.RegisterCache("vector", c)
+		require.Len(t, b.Shares(), 1)
+
+		b.Enforce()
+		assert.Equal(t, 1, c.evicted)
+	})
+}
@@ -59,6 +59,7 @@ type Monitor struct {
 	reservedMappings       int64
 	reservedMappingsBuffer []int64
 	lastReservationsClear  time.Time
+	budget                 *Budget
 }
 
 // Refresh retrieves the current memory stats from the runtime and stores them
@@ -164,6 +165,34 @@ func clearReservedMappings(lastClear time.Time, now time.Time, reservedMappingsB
 	return clearedMappings
 }
 
+// SetCacheBudget makes caches that register with the monitor share the given
+// budget. Without a budget registering is a no-op.
+func (m *Monitor) SetCacheBudget(b *Budget) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.budget = b
+}
+
+func (m *Monitor) RegisterCache(group string, c Evictable) func() {
+	m.mu.Lock()
+	b := m.budget
+	m.mu.Unlock()
+
+	if b == nil {
+		return func() {}
+	}
+	return b.RegisterCache(group, c)
+}
+
+// Limit returns the memory limit observed during the last refresh
+func (m *Monitor) Limit() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.limit
+}
+
 func (m *Monitor) Ratio() float64 {
 	m.mu.Lock()
 	defer m.mu.Unlock()
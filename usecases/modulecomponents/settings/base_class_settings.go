@@ -73,17 +73,16 @@ func (s BaseClassSettings) PropertyIndexed(propName string) bool {
 		return s.isPropertyIndexed(propName)
 	}
 
-	vcn, ok := s.cfg.Property(propName)["skip"]
-	if !ok {
-		return DefaultPropertyIndexed
-	}
-
-	asBool, ok := vcn.(bool)
-	if !ok {
-		return DefaultPropertyIndexed
+	// skipVectorization is the more descriptive name of skip, validation
+	// makes sure they don't contradict each other
+	settings := s.cfg.Property(propName)
+	for _, name := range []string{"skipVectorization", "skip"} {
+		if asBool, ok := settings[name].(bool); ok {
+			return !asBool
+		}
 	}
 
-	return !asBool
+	return DefaultPropertyIndexed
 }
 
 func (s BaseClassSettings) VectorizePropertyName(propName string) bool {
@@ -265,6 +264,10 @@ func (s BaseClassSettings) Validate(class *models.Class) error {
 		return err
 	}
 
+	if err := s.validateVectorizationFlags(class); err != nil {
+		return err
+	}
+
 	err := s.ValidateIndexState(class)
 	if err != nil {
 		return err
@@ -273,6 +276,35 @@ func (s BaseClassSettings) Validate(class *models.Class) error {
 	return nil
 }
 
+// validateVectorizationFlags makes sure the flags controlling which parts
+// of an object are vectorized are booleans, as other values would be
+// ignored silently
+func (s BaseClassSettings) validateVectorizationFlags(class *models.Class) error {
+	if value, ok := s.GetSettings()["vectorizeClassName"]; ok {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("vectorizeClassName must be a boolean, got %T", value)
+		}
+	}
+
+	for _, prop := range class.Properties {
+		settings := s.cfg.Property(prop.Name)
+		for _, name := range []string{"skip", "skipVectorization", "vectorizePropertyName"} {
+			if value, ok := settings[name]; ok {
+				if _, ok := value.(bool); !ok {
+					return fmt.Errorf("property %q: %s must be a boolean, got %T", prop.Name, name, value)
+				}
+			}
+		}
+		skip, skipOk := settings["skip"].(bool)
+		skipVectorization, skipVectorizationOk := settings["skipVectorization"].(bool)
+		if skipOk && skipVectorizationOk && skip != skipVectorization {
+			return fmt.Errorf("property %q: skip and skipVectorization contradict each other", prop.Name)
+		}
+	}
+
+	return nil
+}
+
 func ValidateSetting[T string | int64](value T, availableValues []T) bool {
 	for i := range availableValues {
 		if value == availableValues[i] {
@@ -63,6 +63,80 @@ func Test_BaseClassSettings(t *testing.T) {
 	assert.False(t, ic.VectorizeClassName())
 }
 
+func Test_BaseClassSettings_VectorizationFlags(t *testing.T) {
+	getClass := func(classSettings, propSettings map[string]interface{}) *models.Class {
+		return &models.Class{
+			Class:        "MyClass",
+			ModuleConfig: map[string]interface{}{"my-module": classSettings},
+			Properties: []*models.Property{
+				{
+					Name:         "url",
+					DataType:     []string{"text"},
+					ModuleConfig: map[string]interface{}{"my-module": propSettings},
+				},
+				{
+					Name:     "description",
+					DataType: []string{"text"},
+				},
+			},
+		}
+	}
+	settings := func(class *models.Class) *BaseClassSettings {
+		return NewBaseClassSettings(modules.NewClassBasedModuleConfig(class, "my-module", "", ""), false)
+	}
+
+	t.Run("skipVectorization", func(t *testing.T) {
+		class := getClass(nil, map[string]interface{}{"skipVectorization": true})
+		require.Nil(t, settings(class).Validate(class))
+		assert.False(t, settings(class).PropertyIndexed("url"))
+		assert.True(t, settings(class).PropertyIndexed("description"))
+	})
+
+	tests := []struct {
+		name          string
+		classSettings map[string]interface{}
+		propSettings  map[string]interface{}
+		wantErr       string
+	}{
+		{
+			name:          "valid flags",
+			classSettings: map[string]interface{}{"vectorizeClassName": false},
+			propSettings:  map[string]interface{}{"skip": true, "skipVectorization": true, "vectorizePropertyName": false},
+		},
+		{
+			name:          "vectorizeClassName is not a boolean",
+			classSettings: map[string]interface{}{"vectorizeClassName": "false"},
+			wantErr:       "vectorizeClassName must be a boolean, got string",
+		},
+		{
+			name:         "skipVectorization is not a boolean",
+			propSettings: map[string]interface{}{"skipVectorization": "yes"},
+			wantErr:      `property "url": skipVectorization must be a boolean, got string`,
+		},
+		{
+			name:         "vectorizePropertyName is not a boolean",
+			propSettings: map[string]interface{}{"vectorizePropertyName": 1},
+			wantErr:      `property "url": vectorizePropertyName must be a boolean, got int`,
+		},
+		{
+			name:         "contradicting skip flags",
+			propSettings: map[string]interface{}{"skip": false, "skipVectorization": true},
+			wantErr:      `property "url": skip and skipVectorization contradict each other`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class := getClass(tt.classSettings, tt.propSettings)
+			err := settings(class).Validate(class)
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func Test_BaseClassSettings_Validate(t *testing.T) {
 	targetVector := "targetVector"
 	getClass := func(moduleSettings map[string]interface{}) *models.Class {
@@ -33,6 +33,7 @@ func newBatchVectorizer[T types.Embedding](client BatchClient[T], batchVectorize
 		objectVectorizer: objectsvectorizer.New(),
 		batchVectorizer:  batchVectorizer,
 		tokenizerFunc:    tokenizerFunc,
+		cache:            newVectorCache[T](vectorCacheSize()),
 	}
 
 	return vec
@@ -47,15 +48,26 @@ func (v *BatchVectorizer[T]) Object(ctx context.Context, object *models.Object,
 func (v *BatchVectorizer[T]) object(ctx context.Context, object *models.Object, cfg moduletools.ClassConfig, cs objectsvectorizer.ClassSettings,
 ) (T, error) {
 	text := v.objectVectorizer.Texts(ctx, object, cs)
+	key, cacheable := v.cacheKey(ctx, text, cfg)
+	if cacheable {
+		if vec, ok := v.cache.get(key); ok {
+			return vec, nil
+		}
+	}
+
 	res, _, _, err := v.client.Vectorize(ctx, []string{text}, cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	vec := res.Vector[0]
 	if len(res.Vector) > 1 {
-		return libvectorizer.CombineVectors(res.Vector), nil
+		vec = libvectorizer.CombineVectors(res.Vector)
 	}
-	return res.Vector[0], nil
+	if cacheable {
+		v.cache.put(key, vec)
+	}
+	return vec, nil
 }
 
 func (v *BatchVectorizer[T]) ObjectBatch(ctx context.Context, objects []*models.Object, skipObject []bool, cfg moduletools.ClassConfig,
@@ -73,7 +85,54 @@ func (v *BatchVectorizer[T]) ObjectBatch(ctx context.Context, objects []*models.
 		return make([]T, len(objects)), make(map[int]error)
 	}
 
-	return v.batchVectorizer.SubmitBatchAndWait(ctx, cfg, skipObject, tokenCounts, texts)
+	if !v.cache.enabled() {
+		return v.batchVectorizer.SubmitBatchAndWait(ctx, cfg, skipObject, tokenCounts, texts)
+	}
+
+	// objects whose text was vectorized before are taken from the cache and
+	// skipped in the request to the inference service
+	request := requestHash(ctx, v.client.GetApiKeyHash(ctx, cfg))
+	cached := make([]T, len(objects))
+	keys := make([]vectorCacheKey, len(objects))
+	cacheable := make([]bool, len(objects))
+	skipRemote := make([]bool, len(skipObject))
+	copy(skipRemote, skipObject)
+	allCached := true
+	for i := range objects {
+		if skipObject[i] {
+			continue
+		}
+		keys[i], cacheable[i] = v.cache.key(texts[i], cfg, request)
+		if cacheable[i] {
+			if vec, ok := v.cache.get(keys[i]); ok {
+				cached[i] = vec
+				skipRemote[i] = true
+				continue
+			}
+		}
+		allCached = false
+	}
+	if allCached {
+		return cached, make(map[int]error)
+	}
+
+	vecs, errs := v.batchVectorizer.SubmitBatchAndWait(ctx, cfg, skipRemote, tokenCounts, texts)
+	for i := range vecs {
+		switch {
+		case cached[i] != nil:
+			vecs[i] = cached[i]
+		case cacheable[i] && !skipRemote[i] && errs[i] == nil:
+			v.cache.put(keys[i], vecs[i])
+		}
+	}
+	return vecs, errs
+}
+
+func (v *BatchVectorizer[T]) cacheKey(ctx context.Context, text string, cfg moduletools.ClassConfig) (vectorCacheKey, bool) {
+	if !v.cache.enabled() {
+		return vectorCacheKey{}, false
+	}
+	return v.cache.key(text, cfg, requestHash(ctx, v.client.GetApiKeyHash(ctx, cfg)))
 }
 
 func (v *BatchVectorizer[T]) Texts(ctx context.Context, inputs []string,
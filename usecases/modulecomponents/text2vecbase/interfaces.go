@@ -46,6 +46,7 @@ type BatchVectorizer[T types.Embedding] struct {
 	objectVectorizer *objectsvectorizer.ObjectVectorizer
 	batchVectorizer  *batch.Batch[T]
 	tokenizerFunc    batch.TokenizerFuncType
+	cache            *vectorCache[T]
 }
 
 type BatchClient[T types.Embedding] interface {
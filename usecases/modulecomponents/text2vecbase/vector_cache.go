@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package text2vecbase
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/types"
+	"github.com/weaviate/weaviate/usecases/modulecomponents"
+)
+
+// DefaultVectorCacheSize is the number of vectors each vectorizer keeps in
+// memory, unless VECTORIZER_CACHE_SIZE is set. A size of 0 disables the cache.
+const DefaultVectorCacheSize = 10000
+
+type vectorCacheKey [32]byte
+
+type vectorCacheEntry[T types.Embedding] struct {
+	key vectorCacheKey
+	vec T
+}
+
+// vectorCache is a bounded LRU cache of vectors keyed by the hash of the
+// vectorized text and everything else that influences the resulting vector,
+// so that unchanged objects are not sent to the inference service again.
+type vectorCache[T types.Embedding] struct {
+	sync.Mutex
+	maxSize int
+	entries map[vectorCacheKey]*list.Element
+	order   *list.List
+}
+
+func newVectorCache[T types.Embedding](maxSize int) *vectorCache[T] {
+	return &vectorCache[T]{
+		maxSize: maxSize,
+		entries: make(map[vectorCacheKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// vectorCacheSize returns the size configured with VECTORIZER_CACHE_SIZE
+func vectorCacheSize() int {
+	if size, err := strconv.Atoi(os.Getenv("VECTORIZER_CACHE_SIZE")); err == nil && size >= 0 {
+		return size
+	}
+	return DefaultVectorCacheSize
+}
+
+func (c *vectorCache[T]) enabled() bool {
+	return c != nil && c.maxSize > 0
+}
+
+// requestHeaders are the headers with which a request overrides the
+// endpoint or model the vectorizers use instead of the ones configured for
+// the class
+var requestHeaders = []string{
+	"X-Azure-Deployment-Id",
+	"X-Azure-Resource-Name",
+	"X-Cohere-Baseurl",
+	"X-Databricks-Endpoint",
+	"X-Openai-Baseurl",
+	"X-Voyageai-Baseurl",
+	"X-Weaviate-Baseurl",
+	"X-Weaviate-Cluster-URL",
+}
+
+// requestHash hashes the hash of the api key used for the request together
+// with the request headers which change the resulting vector, so that
+// requests aimed at different endpoints don't share cached vectors
+func requestHash(ctx context.Context, apiKeyHash [32]byte) [32]byte {
+	h := sha256.New()
+	h.Write(apiKeyHash[:])
+	for _, header := range requestHeaders {
+		if value := modulecomponents.GetValueFromContext(ctx, header); value != "" {
+			h.Write([]byte(header))
+			h.Write([]byte{0})
+			h.Write([]byte(value))
+			h.Write([]byte{0})
+		}
+	}
+
+	var hash [32]byte
+	copy(hash[:], h.Sum(nil))
+	return hash
+}
+
+// key hashes the text together with the module settings of the class and the
+// hash of the request, see requestHash. The settings are serialized as json,
+// which sorts map keys and therefore results in the same key for equal
+// settings.
+func (c *vectorCache[T]) key(text string, cfg moduletools.ClassConfig, request [32]byte) (vectorCacheKey, bool) {
+	settings, err := json.Marshal(cfg.Class())
+	if err != nil {
+		return vectorCacheKey{}, false
+	}
+	h := sha256.New()
+	h.Write(request[:])
+	h.Write(settings)
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+
+	var key vectorCacheKey
+	copy(key[:], h.Sum(nil))
+	return key, true
+}
+
+func (c *vectorCache[T]) get(key vectorCacheKey) (T, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return copyEmbedding(elem.Value.(*vectorCacheEntry[T]).vec), true
+}
+
+func (c *vectorCache[T]) put(key vectorCacheKey, vec T) {
+	if vec == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*vectorCacheEntry[T]).vec = copyEmbedding(vec)
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&vectorCacheEntry[T]{key: key, vec: copyEmbedding(vec)})
+	for c.order.Len() > c.maxSize {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*vectorCacheEntry[T]).key)
+	}
+}
+
+func (c *vectorCache[T]) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.order.Len()
+}
+
+// copyEmbedding copies the vector, so that callers modifying the returned
+// vector in place don't change the cached one
+func copyEmbedding[T types.Embedding](vec T) T {
+	switch v := any(vec).(type) {
+	case []float32:
+		return any(append([]float32(nil), v...)).(T)
+	case [][]float32:
+		copied := make([][]float32, len(v))
+		for i := range v {
+			copied[i] = append([]float32(nil), v[i]...)
+		}
+		return any(copied).(T)
+	default:
+		return vec
+	}
+}
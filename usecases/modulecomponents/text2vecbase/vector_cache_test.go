@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package text2vecbase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/batch"
+	objectsvectorizer "github.com/weaviate/weaviate/usecases/modulecomponents/vectorizer"
+)
+
+func TestVectorCache(t *testing.T) {
+	cfg := fakeClassConfig{"model": "a"}
+
+	t.Run("evicts the least recently used vector", func(t *testing.T) {
+		cache := newVectorCache[[]float32](2)
+		keyA, _ := cache.key("a", cfg, [32]byte{})
+		keyB, _ := cache.key("b", cfg, [32]byte{})
+		keyC, _ := cache.key("c", cfg, [32]byte{})
+
+		cache.put(keyA, []float32{1})
+		cache.put(keyB, []float32{2})
+		_, ok := cache.get(keyA)
+		require.True(t, ok)
+		cache.put(keyC, []float32{3})
+
+		assert.Equal(t, 2, cache.len())
+		_, ok = cache.get(keyB)
+		assert.False(t, ok)
+		vec, ok := cache.get(keyA)
+		assert.True(t, ok)
+		assert.Equal(t, []float32{1}, vec)
+	})
+
+	t.Run("key depends on text, settings and api key", func(t *testing.T) {
+		cache := newVectorCache[[]float32](2)
+		key, _ := cache.key("text", cfg, [32]byte{})
+		same, _ := cache.key("text", fakeClassConfig{"model": "a"}, [32]byte{})
+		otherText, _ := cache.key("other text", cfg, [32]byte{})
+		otherModel, _ := cache.key("text", fakeClassConfig{"model": "b"}, [32]byte{})
+		otherApiKey, _ := cache.key("text", cfg, [32]byte{1})
+
+		assert.Equal(t, key, same)
+		assert.NotEqual(t, key, otherText)
+		assert.NotEqual(t, key, otherModel)
+		assert.NotEqual(t, key, otherApiKey)
+	})
+
+	t.Run("request hash depends on api key and endpoint headers", func(t *testing.T) {
+		ctx := context.Background()
+		withBaseURL := func(baseURL string) context.Context {
+			return context.WithValue(ctx, "X-Openai-Baseurl", []string{baseURL})
+		}
+
+		hash := requestHash(ctx, [32]byte{})
+		assert.Equal(t, hash, requestHash(ctx, [32]byte{}))
+		assert.Equal(t, hash, requestHash(context.WithValue(ctx, "X-Openai-Organization", []string{"org"}), [32]byte{}))
+		assert.NotEqual(t, hash, requestHash(ctx, [32]byte{1}))
+		assert.NotEqual(t, hash, requestHash(withBaseURL("http://a"), [32]byte{}))
+		assert.NotEqual(t, requestHash(withBaseURL("http://a"), [32]byte{}),
+			requestHash(withBaseURL("http://b"), [32]byte{}))
+	})
+
+	t.Run("returns copies of the cached vectors", func(t *testing.T) {
+		cache := newVectorCache[[][]float32](2)
+		key, _ := cache.key("text", cfg, [32]byte{})
+		vec := [][]float32{{1, 2}}
+		cache.put(key, vec)
+		vec[0][0] = 10
+
+		cached, _ := cache.get(key)
+		assert.Equal(t, [][]float32{{1, 2}}, cached)
+		cached[0][0] = 10
+		cached, _ = cache.get(key)
+		assert.Equal(t, [][]float32{{1, 2}}, cached)
+	})
+
+	t.Run("size 0 disables the cache", func(t *testing.T) {
+		assert.False(t, newVectorCache[[]float32](0).enabled())
+	})
+}
+
+func TestBatchVectorizerCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	cfg := fakeClassConfig{"model": "a"}
+	objects := func(texts ...string) []*models.Object {
+		objs := make([]*models.Object, len(texts))
+		for i, text := range texts {
+			objs[i] = &models.Object{Class: "Car", Properties: map[string]interface{}{"text": text}}
+		}
+		return objs
+	}
+	newVectorizer := func() (*BatchVectorizer[[]float32], *fakeBatchClient) {
+		client := &fakeBatchClient{}
+		settings := batch.Settings{
+			MaxObjectsPerBatch: 100,
+			MaxTokensPerBatch:  func(cfg moduletools.ClassConfig) int { return 500000 },
+			MaxTimePerBatch:    10,
+		}
+		return New[[]float32](client, batch.NewBatchVectorizer[[]float32](client, 50*time.Second, settings, logger, "test"),
+			fakeTokenizer), client
+	}
+
+	t.Run("only sends texts which are not cached", func(t *testing.T) {
+		v, client := newVectorizer()
+		vecs, errs := v.ObjectBatch(context.Background(), objects("a", "b"), []bool{false, false}, cfg)
+		require.Empty(t, errs)
+		assert.Equal(t, [][]float32{{1}, {1}}, vecs)
+
+		vecs, errs = v.ObjectBatch(context.Background(), objects("b", "error c", "skipped", "a", "d"),
+			[]bool{false, false, true, false, false}, cfg)
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[1], "c")
+		assert.Equal(t, [][]float32{{1}, nil, nil, {1}, {1}}, vecs)
+		assert.Equal(t, [][]string{{"a", "b"}, {"error c", "d"}}, client.requests())
+
+		vecs, errs = v.ObjectBatch(context.Background(), objects("a", "d"), []bool{false, false}, cfg)
+		require.Empty(t, errs)
+		assert.Equal(t, [][]float32{{1}, {1}}, vecs)
+		assert.Len(t, client.requests(), 2)
+	})
+
+	t.Run("single objects are cached", func(t *testing.T) {
+		v, client := newVectorizer()
+		for i := 0; i < 2; i++ {
+			vec, _, err := v.Object(context.Background(), objects("a")[0], cfg, cfg)
+			require.Nil(t, err)
+			assert.NotEmpty(t, vec)
+		}
+		assert.Len(t, client.requests(), 1)
+	})
+}
+
+type fakeBatchClient struct {
+	sync.Mutex
+	texts [][]string
+}
+
+func (c *fakeBatchClient) Vectorize(ctx context.Context, input []string, cfg moduletools.ClassConfig,
+) (*modulecomponents.VectorizationResult[[]float32], *modulecomponents.RateLimits, int, error) {
+	c.Lock()
+	c.texts = append(c.texts, input)
+	c.Unlock()
+
+	res := &modulecomponents.VectorizationResult[[]float32]{
+		Text:   input,
+		Vector: make([][]float32, len(input)),
+		Errors: make([]error, len(input)),
+	}
+	for i, text := range input {
+		if msg, ok := strings.CutPrefix(text, "error "); ok {
+			res.Errors[i] = errors.New(msg)
+			continue
+		}
+		res.Vector[i] = []float32{1}
+	}
+	return res, c.GetVectorizerRateLimit(ctx, cfg), 0, nil
+}
+
+func (c *fakeBatchClient) VectorizeQuery(ctx context.Context, input []string, cfg moduletools.ClassConfig,
+) (*modulecomponents.VectorizationResult[[]float32], error) {
+	panic("not implemented")
+}
+
+func (c *fakeBatchClient) GetVectorizerRateLimit(ctx context.Context, cfg moduletools.ClassConfig) *modulecomponents.RateLimits {
+	return &modulecomponents.RateLimits{
+		LimitRequests: 1000, RemainingRequests: 1000, LimitTokens: 100000, RemainingTokens: 100000,
+		ResetRequests: time.Now().Add(time.Minute), ResetTokens: time.Now().Add(time.Minute),
+	}
+}
+
+func (c *fakeBatchClient) GetApiKeyHash(ctx context.Context, cfg moduletools.ClassConfig) [32]byte {
+	return [32]byte{}
+}
+
+func (c *fakeBatchClient) requests() [][]string {
+	c.Lock()
+	defer c.Unlock()
+	return c.texts
+}
+
+func fakeTokenizer(ctx context.Context, objects []*models.Object, skipObject []bool, cfg moduletools.ClassConfig,
+	objectVectorizer *objectsvectorizer.ObjectVectorizer,
+) ([]string, []int, bool, error) {
+	texts := make([]string, len(objects))
+	tokens := make([]int, len(objects))
+	skipAll := true
+	for i, obj := range objects {
+		if skipObject[i] {
+			continue
+		}
+		skipAll = false
+		texts[i] = obj.Properties.(map[string]interface{})["text"].(string)
+		tokens[i] = len(texts[i])
+	}
+	return texts, tokens, skipAll, nil
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) TargetVector() string {
+	return ""
+}
+
+func (f fakeClassConfig) PropertyIndexed(property string) bool {
+	return true
+}
+
+func (f fakeClassConfig) VectorizePropertyName(propertyName string) bool {
+	return false
+}
+
+func (f fakeClassConfig) VectorizeClassName() bool {
+	return false
+}
+
+func (f fakeClassConfig) Properties() []string {
+	return nil
+}
+
+func (f fakeClassConfig) LowerCaseInput() bool {
+	return false
+}
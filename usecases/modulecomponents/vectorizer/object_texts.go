@@ -114,7 +114,7 @@ func (v *ObjectVectorizer) TextsWithTitleProperty(ctx context.Context, object *m
 				if isTitleProperty {
 					titlePropertyValue = append(titlePropertyValue, val)
 				}
-				if icheck.VectorizePropertyName(propName) {
+				if isNameVectorizable {
 					val = fmt.Sprintf("%s %s", propName, val)
 				}
 				corpi = append(corpi, val)
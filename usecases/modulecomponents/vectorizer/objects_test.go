@@ -194,6 +194,19 @@ func TestVectorizingObjects(t *testing.T) {
 			lowerCase:          false,
 			expectedClientCall: "Super Car brand Of The Car best brand review a very great car",
 		},
+		{
+			name:             "with compound prop name not vectorized",
+			excludedProperty: "brandOfTheCar",
+			input: &models.Object{
+				Class: "SuperCar",
+				Properties: map[string]interface{}{
+					"brandOfTheCar": "best brand",
+					"review":        "a very great car",
+				},
+			},
+			lowerCase:          true,
+			expectedClientCall: "super car best brand review a very great car",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+// SetBudgets limits the calls modules make to their inference APIs. It must
+// be called after all modules are registered, modules without a budget are
+// not limited.
+func (p *Provider) SetBudgets(budgets config.ModuleBudgets) {
+	p.budgets = map[string]*ratelimiter.Budget{}
+	for name, budget := range budgets {
+		if !budget.Enabled() {
+			continue
+		}
+		mod := p.GetByName(name)
+		if mod == nil {
+			p.logger.WithField("action", "startup").WithField("module", name).
+				Warn("ignoring budget of module which is not enabled")
+			continue
+		}
+
+		maxWait := budget.MaxWaitSeconds
+		if maxWait == 0 {
+			maxWait = config.DefaultModuleBudgetMaxWaitSeconds
+		}
+		p.budgets[mod.Name()] = ratelimiter.NewBudget(mod.Name(), budget.RequestsPerSecond,
+			budget.TokensPerMinute, time.Duration(maxWait)*time.Second, budget.MaxQueued)
+	}
+}
+
+// waitForBudget blocks until a call of the module fits into its budget
+func (p *Provider) waitForBudget(ctx context.Context, module string, requests, tokens int) error {
+	budget, ok := p.budgets[module]
+	if !ok {
+		return nil
+	}
+	return budget.Wait(ctx, requests, tokens)
+}
+
+// waitForBatchBudget blocks until the objects which aren't skipped fit into
+// the budget of the module. If they don't, the error is returned for each of
+// them, so that none of the batch is sent.
+func (p *Provider) waitForBatchBudget(ctx context.Context, module string,
+	objects []*models.Object, skipObject []bool,
+) map[int]error {
+	if _, ok := p.budgets[module]; !ok {
+		return nil
+	}
+
+	requests, tokens := 0, 0
+	for i, obj := range objects {
+		if skipObject[i] {
+			continue
+		}
+		requests++
+		tokens += estimateTokens(obj)
+	}
+	if requests == 0 {
+		return nil
+	}
+
+	err := p.waitForBudget(ctx, module, requests, tokens)
+	if err == nil {
+		return nil
+	}
+	errs := make(map[int]error, requests)
+	for i := range objects {
+		if !skipObject[i] {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
+// estimateTokens approximates the tokens of the text vectorized for obj. It
+// only needs to be good enough for budgets, so it uses the rule of thumb of 4
+// characters per token instead of the tokenizer of the module.
+func estimateTokens(obj *models.Object) int {
+	chars := len(obj.Class)
+	if props, ok := obj.Properties.(map[string]interface{}); ok {
+		for _, value := range props {
+			switch v := value.(type) {
+			case string:
+				chars += len(v)
+			case []string:
+				for _, s := range v {
+					chars += len(s)
+				}
+			case []interface{}:
+				for _, item := range v {
+					if s, ok := item.(string); ok {
+						chars += len(s)
+					}
+				}
+			}
+		}
+	}
+	return tokensForChars(chars)
+}
+
+func tokensForChars(chars int) int {
+	return chars/4 + 1
+}
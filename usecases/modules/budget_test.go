@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+func TestProvider_Budgets(t *testing.T) {
+	modName := "some-vzr"
+	class := &models.Class{
+		Class:             "SomeClass",
+		ModuleConfig:      map[string]interface{}{modName: map[string]interface{}{}},
+		VectorIndexConfig: hnsw.UserConfig{},
+	}
+	repo := &fakeObjectsRepo{}
+	logger, hook := test.NewNullLogger()
+
+	p := NewProvider(logger)
+	p.Register(newDummyModule(modName, modulecapabilities.Text2Vec))
+	p.SetSchemaGetter(&fakeSchemaGetter{schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}})
+	p.SetBudgets(config.ModuleBudgets{
+		modName:         {RequestsPerSecond: 2},
+		"not-a-module":  {RequestsPerSecond: 2},
+		"unlimited-vzr": {MaxQueued: 1},
+	})
+	require.Len(t, p.budgets, 1)
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, "not-a-module", hook.LastEntry().Data["module"])
+
+	newObjects := func(n int) []*models.Object {
+		objs := make([]*models.Object, n)
+		for i := range objs {
+			objs[i] = &models.Object{Class: class.Class, ID: newUUID()}
+		}
+		return objs
+	}
+	// the budget allows 2 objects at once, waiting for more exceeds the
+	// deadline of the request
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	objs := newObjects(3)
+	errs, err := p.BatchUpdateVector(ctx, class, objs, repo.Object, logger)
+	require.Nil(t, err)
+	require.Len(t, errs, 3)
+	for i, obj := range objs {
+		assert.True(t, errors.As(errs[i], &ratelimiter.ErrBudgetExhausted{}))
+		assert.Nil(t, obj.Vector)
+	}
+
+	objs = newObjects(2)
+	errs, err = p.BatchUpdateVector(ctx, class, objs, repo.Object, logger)
+	require.Nil(t, err)
+	assert.Empty(t, errs)
+	for _, obj := range objs {
+		assert.Equal(t, []float32{1, 2, 3}, []float32(obj.Vector))
+	}
+
+	obj := newObjects(1)[0]
+	err = p.UpdateVector(ctx, obj, class, repo.Object, logger)
+	var exhausted ratelimiter.ErrBudgetExhausted
+	require.True(t, errors.As(err, &exhausted))
+	assert.Equal(t, modName, exhausted.Name)
+	assert.Nil(t, obj.Vector)
+
+	// objects with a vector don't count
+	objs = newObjects(3)
+	for _, obj := range objs {
+		obj.Vector = []float32{4, 5, 6}
+	}
+	errs, err = p.BatchUpdateVector(ctx, class, objs, repo.Object, logger)
+	require.Nil(t, err)
+	assert.Empty(t, errs)
+}
+
+func TestEstimateTokens(t *testing.T) {
+	obj := &models.Object{
+		Class: "Car",
+		Properties: map[string]interface{}{
+			"name":  "a very fast car",
+			"tags":  []interface{}{"fast", "red"},
+			"notes": []string{"four wheels"},
+			"speed": 300.0,
+		},
+	}
+	// 3 + 15 + 7 + 11 characters
+	assert.Equal(t, 10, estimateTokens(obj))
+	assert.Equal(t, 1, estimateTokens(&models.Object{}))
+}
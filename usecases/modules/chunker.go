@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// ChunkObject splits object into chunks with the chunker module configured
+// in the moduleConfig of class. Objects of classes without a chunker have no
+// chunks.
+func (p *Provider) ChunkObject(ctx context.Context, class *models.Class,
+	object *models.Object,
+) ([]*models.Object, error) {
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	for name := range moduleConfig {
+		chunker, ok := p.GetByName(name).(modulecapabilities.Chunker)
+		if !ok {
+			continue
+		}
+		cfg := NewClassBasedModuleConfig(class, name, object.Tenant, "")
+		chunks, err := chunker.Chunk(ctx, object, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("chunk object with module %s: %w", name, err)
+		}
+		return chunks, nil
+	}
+	return nil, nil
+}
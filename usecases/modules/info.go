@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// healthCheckTimeout limits how long the health check of a single module may
+// take, so that one unreachable inference service doesn't block the listing
+const healthCheckTimeout = 2 * time.Second
+
+// GetModulesInfo returns all enabled modules sorted by name, together with
+// their capabilities, module config defaults and the health of their remote
+// inference services. The health checks run concurrently.
+func (p *Provider) GetModulesInfo(ctx context.Context) []*models.ModuleInfo {
+	modules := p.GetAll()
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Name() < modules[j].Name()
+	})
+
+	infos := make([]*models.ModuleInfo, len(modules))
+	eg := enterrors.NewErrorGroupWrapper(p.logger)
+	for i, module := range modules {
+		i, module := i, module
+		infos[i] = p.moduleInfo(module)
+		checker, ok := module.(modulecapabilities.ModuleHasHealthCheck)
+		if !ok {
+			infos[i].Status = models.ModuleInfoStatusUNKNOWN
+			continue
+		}
+		eg.Go(func() error {
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+			if err := checker.CheckHealth(checkCtx); err != nil {
+				infos[i].Status = models.ModuleInfoStatusUNHEALTHY
+				infos[i].Error = err.Error()
+				return nil
+			}
+			infos[i].Status = models.ModuleInfoStatusHEALTHY
+			return nil
+		}, module.Name())
+	}
+	// the health checks never return an error, failures are part of the info
+	_ = eg.Wait()
+	return infos
+}
+
+func (p *Provider) moduleInfo(module modulecapabilities.Module) *models.ModuleInfo {
+	info := &models.ModuleInfo{
+		Name:             module.Name(),
+		Type:             string(module.Type()),
+		AltNames:         p.getModuleAltNames(module),
+		Capabilities:     p.moduleCapabilities(module.Type()),
+		GraphQLArguments: []string{},
+	}
+	if info.AltNames == nil {
+		info.AltNames = []string{}
+	}
+	if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
+		for name := range arg.Arguments() {
+			info.GraphQLArguments = append(info.GraphQLArguments, name)
+		}
+		sort.Strings(info.GraphQLArguments)
+	}
+	if configurator, ok := module.(modulecapabilities.ClassConfigurator); ok {
+		info.Config = configurator.ClassConfigDefaults()
+	}
+	return info
+}
+
+func (p *Provider) moduleCapabilities(moduleType modulecapabilities.ModuleType) []string {
+	if p.isVectorizerModule(moduleType) {
+		return []string{"vectorizer"}
+	}
+	switch moduleType {
+	case modulecapabilities.Text2TextReranker:
+		return []string{"reranker"}
+	case modulecapabilities.Text2TextGenerative:
+		return []string{"generative"}
+	case modulecapabilities.Text2TextQnA:
+		return []string{"qna"}
+	case modulecapabilities.Text2TextNER:
+		return []string{"ner"}
+	case modulecapabilities.Text2TextSummarize:
+		return []string{"summarizer"}
+	case modulecapabilities.Text2Chunks:
+		return []string{"chunker"}
+	case modulecapabilities.Backup:
+		return []string{"backup"}
+	case modulecapabilities.Offload:
+		return []string{"offload"}
+	default:
+		return []string{}
+	}
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+func TestProvider_GetModulesInfo(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	modulesProvider := NewProvider(logger)
+	modulesProvider.Register(newGraphQLModule("text2vec-mod").withArg("nearText").withArg("nearArgument"))
+	modulesProvider.Register(newDummyRerankerModule("reranker-healthy", nil))
+	modulesProvider.Register(newDummyRerankerModule("reranker-unhealthy", errors.New("service not ready")))
+	modulesProvider.Register(newDummyNonVectorizerModule("backup-mod"))
+
+	infos := modulesProvider.GetModulesInfo(context.Background())
+
+	require.Len(t, infos, 4)
+	assert.Equal(t, &models.ModuleInfo{
+		Name:             "backup-mod",
+		Type:             "NonVectorizer",
+		AltNames:         []string{},
+		Capabilities:     []string{},
+		GraphQLArguments: []string{},
+		Status:           models.ModuleInfoStatusUNKNOWN,
+	}, infos[0])
+	assert.Equal(t, &models.ModuleInfo{
+		Name:             "reranker-healthy",
+		Type:             string(modulecapabilities.Text2TextReranker),
+		AltNames:         []string{},
+		Capabilities:     []string{"reranker"},
+		GraphQLArguments: []string{},
+		Status:           models.ModuleInfoStatusHEALTHY,
+	}, infos[1])
+	assert.Equal(t, &models.ModuleInfo{
+		Name:             "reranker-unhealthy",
+		Type:             string(modulecapabilities.Text2TextReranker),
+		AltNames:         []string{},
+		Capabilities:     []string{"reranker"},
+		GraphQLArguments: []string{},
+		Status:           models.ModuleInfoStatusUNHEALTHY,
+		Error:            "service not ready",
+	}, infos[2])
+	assert.Equal(t, &models.ModuleInfo{
+		Name:             "text2vec-mod",
+		Type:             string(modulecapabilities.Text2Vec),
+		AltNames:         []string{},
+		Capabilities:     []string{"vectorizer"},
+		GraphQLArguments: []string{"nearArgument", "nearText"},
+		Status:           models.ModuleInfoStatusUNKNOWN,
+	}, infos[3])
+}
+
+func newDummyRerankerModule(name string, healthErr error) dummyRerankerModule {
+	return dummyRerankerModule{
+		dummyNonVectorizerModule: newDummyNonVectorizerModule(name),
+		healthErr:                healthErr,
+	}
+}
+
+type dummyRerankerModule struct {
+	dummyNonVectorizerModule
+	healthErr error
+}
+
+func (m dummyRerankerModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2TextReranker
+}
+
+func (m dummyRerankerModule) CheckHealth(ctx context.Context) error {
+	return m.healthErr
+}
@@ -83,6 +83,15 @@ func (p *Provider) setPerClassConfigDefaults(cfg *ClassBasedModuleConfig,
 		mergedConfig[key] = value
 	}
 
+	// skipVectorization is an alias of skip, which is stored instead so that
+	// the default of skip doesn't contradict it
+	if skip, ok := userSpecified["skipVectorization"].(bool); ok {
+		if _, ok := userSpecified["skip"]; !ok {
+			mergedConfig["skip"] = skip
+			delete(mergedConfig, "skipVectorization")
+		}
+	}
+
 	if len(mergedConfig) > 0 {
 		storeFn(mergedConfig)
 	}
@@ -148,6 +157,15 @@ func (p *Provider) setSinglePropertyConfigDefaults(prop *models.Property,
 		mergedConfig[key] = value
 	}
 
+	// skipVectorization is an alias of skip, which is stored instead so that
+	// the default of skip doesn't contradict it
+	if skip, ok := userSpecified["skipVectorization"].(bool); ok {
+		if _, ok := userSpecified["skip"]; !ok {
+			mergedConfig["skip"] = skip
+			delete(mergedConfig, "skipVectorization")
+		}
+	}
+
 	if len(mergedConfig) > 0 {
 		if prop.ModuleConfig == nil {
 			prop.ModuleConfig = map[string]interface{}{}
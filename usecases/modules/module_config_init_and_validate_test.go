@@ -279,6 +279,43 @@ func TestSetSinglePropertyDefaults(t *testing.T) {
 		"user specified module config is used, for rest the default value is used")
 }
 
+func TestSetSinglePropertyDefaultsSkipVectorization(t *testing.T) {
+	class := &models.Class{Class: "Foo", Vectorizer: "my-module"}
+	newProp := func(moduleConfig map[string]interface{}) *models.Property {
+		return &models.Property{
+			Name:         "url",
+			DataType:     schema.DataTypeText.PropString(),
+			ModuleConfig: map[string]interface{}{"my-module": moduleConfig},
+		}
+	}
+
+	logger, _ := test.NewNullLogger()
+	p := NewProvider(logger)
+	p.Register(&dummyModuleClassConfigurator{
+		dummyText2VecModuleNoCapabilities: dummyText2VecModuleNoCapabilities{
+			name: "my-module",
+		},
+	})
+
+	t.Run("stored as skip", func(t *testing.T) {
+		prop := newProp(map[string]interface{}{"skipVectorization": true})
+		p.SetSinglePropertyDefaults(class, prop)
+		assert.Equal(t, map[string]interface{}{
+			"skip":       true,
+			"per-prop-1": "prop default value",
+			"per-prop-2": "prop default value",
+		}, prop.ModuleConfig.(map[string]interface{})["my-module"])
+	})
+
+	t.Run("kept if skip is set as well", func(t *testing.T) {
+		prop := newProp(map[string]interface{}{"skipVectorization": true, "skip": false})
+		p.SetSinglePropertyDefaults(class, prop)
+		cfg := prop.ModuleConfig.(map[string]interface{})["my-module"].(map[string]interface{})
+		assert.Equal(t, true, cfg["skipVectorization"])
+		assert.Equal(t, false, cfg["skip"])
+	})
+}
+
 type dummyModuleClassConfigurator struct {
 	dummyText2VecModuleNoCapabilities
 	validateError error
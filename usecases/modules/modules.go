@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 var (
@@ -48,6 +49,7 @@ type Provider struct {
 	hasMultipleVectorizers    bool
 	targetVectorNameValidator *regexp.Regexp
 	logger                    logrus.FieldLogger
+	budgets                   map[string]*ratelimiter.Budget
 }
 
 type schemaGetter interface {
@@ -926,6 +928,9 @@ func (p *Provider) VectorFromInput(ctx context.Context,
 	for _, mod := range p.GetAll() {
 		if mod.Name() == targetModule {
 			if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type(), p.getModuleAltNames(mod)) {
+				if err := p.waitForBudget(ctx, mod.Name(), 1, tokensForChars(len(input))); err != nil {
+					return nil, err
+				}
 				if found, vector, err := vectorFromInput[[]float32](ctx, mod, class, input, targetModule); found {
 					return vector, err
 				}
@@ -948,6 +953,9 @@ func (p *Provider) MultiVectorFromInput(ctx context.Context,
 	for _, mod := range p.GetAll() {
 		if mod.Name() == targetModule {
 			if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type(), p.getModuleAltNames(mod)) {
+				if err := p.waitForBudget(ctx, mod.Name(), 1, tokensForChars(len(input))); err != nil {
+					return nil, err
+				}
 				if found, vector, err := vectorFromInput[[][]float32](ctx, mod, class, input, targetModule); found {
 					return vector, err
 				}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestProvider_VectorizationStats(t *testing.T) {
+	modName := "some-vzr"
+	class := &models.Class{
+		Class:             "SomeClass",
+		ModuleConfig:      map[string]interface{}{modName: map[string]interface{}{}},
+		VectorIndexConfig: hnsw.UserConfig{},
+		Properties: []*models.Property{
+			{Name: "text", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+	logger, _ := test.NewNullLogger()
+	p := NewProvider(logger)
+	p.Register(newDummyModule(modName, modulecapabilities.Text2Vec))
+	p.SetSchemaGetter(&fakeSchemaGetter{schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}})
+
+	unchanged, changed := newUUID(), newUUID()
+	stored := map[strfmt.UUID]*search.Result{
+		unchanged: {Schema: map[string]interface{}{"text": "same"}, Vector: []float32{4, 5, 6}},
+		changed:   {Schema: map[string]interface{}{"text": "before"}, Vector: []float32{4, 5, 6}},
+	}
+	findObject := func(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, adds additional.Properties, tenant string,
+	) (*search.Result, error) {
+		return stored[id], nil
+	}
+	newObject := func(id strfmt.UUID, text string) *models.Object {
+		return &models.Object{Class: class.Class, ID: id, Properties: map[string]interface{}{"text": text}}
+	}
+
+	stats := &moduletools.VectorizationStats{}
+	ctx := moduletools.ContextWithVectorizationStats(context.Background(), stats)
+
+	objs := []*models.Object{
+		newObject(newUUID(), "new"),
+		newObject(unchanged, "same"),
+		newObject(changed, "after"),
+	}
+	errs, err := p.BatchUpdateVector(ctx, class, objs, findObject, logger)
+	require.Nil(t, err)
+	require.Empty(t, errs)
+	assert.Equal(t, int64(2), stats.Recomputed())
+	assert.Equal(t, int64(1), stats.Skipped())
+	assert.Equal(t, []float32{1, 2, 3}, []float32(objs[0].Vector))
+	assert.Equal(t, []float32{4, 5, 6}, []float32(objs[1].Vector))
+	assert.Equal(t, []float32{1, 2, 3}, []float32(objs[2].Vector))
+
+	require.Nil(t, p.UpdateVector(ctx, newObject(unchanged, "same"), class, findObject, logger))
+	assert.Equal(t, int64(2), stats.Skipped())
+
+	// without stats in the context nothing is counted
+	require.Nil(t, p.UpdateVector(context.Background(), newObject(changed, "after"), class, findObject, logger))
+	assert.Equal(t, int64(2), stats.Recomputed())
+}
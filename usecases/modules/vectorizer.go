@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
@@ -194,6 +195,7 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 		// vectorizer needs to act on it or not. This allows us to use the same objects slice for all vectorizers and
 		// simplifies the mapping of the returned vectors to the objects.
 		skipRevectorization := make([]bool, len(objects))
+		unchanged := 0
 		for i, obj := range objects {
 			if !p.shouldVectorizeObject(obj, cfg) {
 				skipRevectorization[i] = true
@@ -202,16 +204,23 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 			reVectorize, addProps, vector := reVectorize(ctx, cfg, vectorizer, obj, class, nil, targetVector, findObjectFn)
 			if !reVectorize {
 				skipRevectorization[i] = true
+				unchanged++
 				p.lockGuard(func() {
 					p.addVectorToObject(obj, vector, nil, addProps, cfg)
 				})
 			}
 		}
+		if errs := p.waitForBatchBudget(ctx, found.Name(), objects, skipRevectorization); errs != nil {
+			moduletools.CountVectors(ctx, 0, unchanged)
+			return errs, nil
+		}
 		vectors, addProps, vecErrors := vectorizer.VectorizeBatch(ctx, objects, skipRevectorization, cfg)
+		recomputed := 0
 		for i := range objects {
 			if _, ok := vecErrors[i]; ok || skipRevectorization[i] {
 				continue
 			}
+			recomputed++
 
 			var addProp models.AdditionalProperties = nil
 			if addProps != nil { // only present for contextionary and probably nobody is using this
@@ -222,6 +231,7 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 				p.addVectorToObject(objects[i], vectors[i], nil, addProp, cfg)
 			})
 		}
+		moduletools.CountVectors(ctx, recomputed, unchanged)
 
 		return vecErrors, nil
 	} else if vectorizer, ok := found.(modulecapabilities.Vectorizer[[][]float32]); ok {
@@ -230,6 +240,7 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 		// vectorizer needs to act on it or not. This allows us to use the same objects slice for all vectorizers and
 		// simplifies the mapping of the returned vectors to the objects.
 		skipRevectorization := make([]bool, len(objects))
+		unchanged := 0
 		for i, obj := range objects {
 			if !p.shouldVectorizeObject(obj, cfg) {
 				skipRevectorization[i] = true
@@ -238,16 +249,23 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 			reVectorize, addProps, multiVector := reVectorizeMulti(ctx, cfg, vectorizer, obj, class, nil, targetVector, findObjectFn)
 			if !reVectorize {
 				skipRevectorization[i] = true
+				unchanged++
 				p.lockGuard(func() {
 					p.addVectorToObject(obj, nil, multiVector, addProps, cfg)
 				})
 			}
 		}
+		if errs := p.waitForBatchBudget(ctx, found.Name(), objects, skipRevectorization); errs != nil {
+			moduletools.CountVectors(ctx, 0, unchanged)
+			return errs, nil
+		}
 		multiVectors, addProps, vecErrors := vectorizer.VectorizeBatch(ctx, objects, skipRevectorization, cfg)
+		recomputed := 0
 		for i := range objects {
 			if _, ok := vecErrors[i]; ok || skipRevectorization[i] {
 				continue
 			}
+			recomputed++
 
 			var addProp models.AdditionalProperties = nil
 			if addProps != nil { // only present for contextionary and probably nobody is using this
@@ -258,6 +276,7 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 				p.addVectorToObject(objects[i], nil, multiVectors[i], addProp, cfg)
 			})
 		}
+		moduletools.CountVectors(ctx, recomputed, unchanged)
 
 		return vecErrors, nil
 	} else {
@@ -409,11 +428,17 @@ func (p *Provider) vectorize(ctx context.Context, object *models.Object, class *
 			}
 			needsRevectorization, additionalProperties, vector := reVectorize(ctx, cfg, vectorizer, object, class, targetProperties, targetVector, findObjectFn)
 			if needsRevectorization {
+				if err := p.waitForBudget(ctx, found.Name(), 1, estimateTokens(object)); err != nil {
+					return fmt.Errorf("update vector: %w", err)
+				}
 				var err error
 				vector, additionalProperties, err = vectorizer.VectorizeObject(ctx, object, cfg)
 				if err != nil {
 					return fmt.Errorf("update vector: %w", err)
 				}
+				moduletools.CountVectors(ctx, 1, 0)
+			} else {
+				moduletools.CountVectors(ctx, 0, 1)
 			}
 
 			p.lockGuard(func() {
@@ -434,11 +459,17 @@ func (p *Provider) vectorize(ctx context.Context, object *models.Object, class *
 			}
 			needsRevectorization, additionalProperties, multiVector := reVectorizeMulti(ctx, cfg, vectorizer, object, class, targetProperties, targetVector, findObjectFn)
 			if needsRevectorization {
+				if err := p.waitForBudget(ctx, found.Name(), 1, estimateTokens(object)); err != nil {
+					return fmt.Errorf("update vector: %w", err)
+				}
 				var err error
 				multiVector, additionalProperties, err = vectorizer.VectorizeObject(ctx, object, cfg)
 				if err != nil {
 					return fmt.Errorf("update vector: %w", err)
 				}
+				moduletools.CountVectors(ctx, 1, 0)
+			} else {
+				moduletools.CountVectors(ctx, 0, 1)
 			}
 
 			p.lockGuard(func() {
@@ -532,7 +563,8 @@ func (p *Provider) getVectorIndexConfig(class *models.Class, targetVector string
 	hnswConfig, okHnsw := vectorIndexConfig.(hnsw.UserConfig)
 	_, okFlat := vectorIndexConfig.(flat.UserConfig)
 	_, okDynamic := vectorIndexConfig.(dynamic.UserConfig)
-	if !(okHnsw || okFlat || okDynamic) {
+	_, okDiskANN := vectorIndexConfig.(diskann.UserConfig)
+	if !(okHnsw || okFlat || okDynamic || okDiskANN) {
 		return hnsw.UserConfig{}, fmt.Errorf(errorVectorIndexType, vectorIndexConfig)
 	}
 	return hnswConfig, nil
@@ -13,6 +13,7 @@ package monitoring
 
 import (
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -54,9 +55,12 @@ type PrometheusMetrics struct {
 	LSMSegmentSize                      *prometheus.GaugeVec
 	LSMMemtableSize                     *prometheus.GaugeVec
 	LSMMemtableDurations                *prometheus.SummaryVec
+	LSMSegmentsScrubbed                 *prometheus.CounterVec
+	LSMSegmentsCorrupted                *prometheus.CounterVec
 	ObjectCount                         *prometheus.GaugeVec
 	QueriesCount                        *prometheus.GaugeVec
 	RequestsTotal                       *prometheus.GaugeVec
+	DeprecatedRequestsTotal             *prometheus.CounterVec
 	QueriesDurations                    *prometheus.HistogramVec
 	QueriesFilteredVectorDurations      *prometheus.SummaryVec
 	QueryDimensions                     *prometheus.CounterVec
@@ -94,6 +98,7 @@ type PrometheusMetrics struct {
 	VectorIndexOperations              *prometheus.GaugeVec
 	VectorIndexDurations               *prometheus.SummaryVec
 	VectorIndexSize                    *prometheus.GaugeVec
+	VectorIndexCacheStats              *prometheus.GaugeVec
 	VectorIndexMaintenanceDurations    *prometheus.SummaryVec
 	VectorDimensionsSum                *prometheus.GaugeVec
 	VectorSegmentsSum                  *prometheus.GaugeVec
@@ -104,11 +109,24 @@ type PrometheusMetrics struct {
 	StartupDurations *prometheus.SummaryVec
 	StartupDiskIO    *prometheus.SummaryVec
 
+	// Shared memory budget of the caches
+	CacheBudgetUsedBytes  *prometheus.GaugeVec
+	CacheBudgetShareBytes *prometheus.GaugeVec
+	CacheBudgetEvictions  *prometheus.CounterVec
+	CacheBudgetFreedBytes *prometheus.CounterVec
+
+	// How long locks are held, see esync.LockMonitor
+	LockHoldDuration *prometheus.HistogramVec
+
 	ShardsLoaded    *prometheus.GaugeVec
 	ShardsUnloaded  *prometheus.GaugeVec
 	ShardsLoading   *prometheus.GaugeVec
 	ShardsUnloading *prometheus.GaugeVec
 
+	// Async replication
+	AsyncReplicationObjectsOutOfSync *prometheus.GaugeVec
+	AsyncReplicationLastInSync       *prometheus.GaugeVec
+
 	// RAFT-based schema metrics
 	SchemaWrites         *prometheus.SummaryVec
 	SchemaReadsLocal     *prometheus.SummaryVec
@@ -255,6 +273,8 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.LSMSegmentCount.DeletePartialMatch(labels)
 	pm.LSMSegmentSize.DeletePartialMatch(labels)
 	pm.LSMSegmentCountByLevel.DeletePartialMatch(labels)
+	pm.LSMSegmentsScrubbed.DeletePartialMatch(labels)
+	pm.LSMSegmentsCorrupted.DeletePartialMatch(labels)
 	pm.IndexQueuePushDuration.DeletePartialMatch(labels)
 	pm.IndexQueueDeleteDuration.DeletePartialMatch(labels)
 	pm.IndexQueuePreloadDuration.DeletePartialMatch(labels)
@@ -276,9 +296,12 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.VectorIndexMaintenanceDurations.DeletePartialMatch(labels)
 	pm.VectorIndexDurations.DeletePartialMatch(labels)
 	pm.VectorIndexSize.DeletePartialMatch(labels)
+	pm.VectorIndexCacheStats.DeletePartialMatch(labels)
 	pm.StartupProgress.DeletePartialMatch(labels)
 	pm.StartupDurations.DeletePartialMatch(labels)
 	pm.StartupDiskIO.DeletePartialMatch(labels)
+	pm.AsyncReplicationObjectsOutOfSync.DeletePartialMatch(labels)
+	pm.AsyncReplicationLastInSync.DeletePartialMatch(labels)
 	return nil
 }
 
@@ -391,6 +414,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "requests_total",
 			Help: "Number of all requests made",
 		}, []string{"status", "class_name", "api", "query_type"}),
+		DeprecatedRequestsTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "deprecated_requests_total",
+			Help: "Number of requests to deprecated REST operations per user",
+		}, []string{"operation", "user"}),
 
 		QueriesDurations: promauto.NewHistogramVec(prometheus.HistogramOpts{
 			Name:    "queries_durations_ms",
@@ -450,6 +477,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_memtable_durations_ms",
 			Help: "Time in ms for a bucket operation to complete",
 		}, []string{"strategy", "class_name", "shard_name", "path", "operation"}),
+		LSMSegmentsScrubbed: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_segments_scrubbed_total",
+			Help: "Number of segments whose checksum was verified by the scrubber",
+		}, []string{"strategy", "class_name", "shard_name"}),
+		LSMSegmentsCorrupted: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_segments_corrupted_total",
+			Help: "Number of segments the scrubber found corrupted and quarantined",
+		}, []string{"strategy", "class_name", "shard_name"}),
 
 		// Async indexing metrics
 		IndexQueuePushDuration: promauto.NewSummaryVec(prometheus.SummaryOpts{
@@ -530,6 +565,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_size",
 			Help: "The size of the vector index. Typically larger than number of vectors, as it grows proactively.",
 		}, []string{"class_name", "shard_name"}),
+		VectorIndexCacheStats: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_cache_stats",
+			Help: "Hits, misses, rejected admissions and hit rate of vector caches with an admission policy",
+		}, []string{"class_name", "shard_name", "target_vector", "stat"}),
 		VectorIndexMaintenanceDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "vector_index_maintenance_durations_ms",
 			Help: "Duration of a sync or async vector index maintenance operation",
@@ -568,6 +607,30 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "startup_diskio_throughput",
 			Help: "Disk I/O throuhput in bytes per second",
 		}, []string{"operation", "class_name", "shard_name"}),
+
+		// Cache budget metrics
+		CacheBudgetUsedBytes: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "cache_budget_used_bytes",
+			Help: "Estimated memory held by all caches of a group",
+		}, []string{"cache"}),
+		CacheBudgetShareBytes: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "cache_budget_share_bytes",
+			Help: "Share of the shared cache memory budget assigned to a group",
+		}, []string{"cache"}),
+		CacheBudgetEvictions: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "cache_budget_evictions_total",
+			Help: "Number of times a cache group was evicted for exceeding its budget share",
+		}, []string{"cache"}),
+		CacheBudgetFreedBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "cache_budget_freed_bytes_total",
+			Help: "Memory freed by evicting caches that exceeded their budget share",
+		}, []string{"cache"}),
+
+		LockHoldDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "lock_hold_duration_seconds",
+			Help:    "Duration for which a lock was held until it was released",
+			Buckets: []float64{0.001, 0.01, 0.1, 1, 10, 60, 300},
+		}, []string{"lock"}),
 		QueryDimensions: promauto.NewCounterVec(prometheus.CounterOpts{
 			Name: "query_dimensions_total",
 			Help: "The vector dimensions used by any read-query that involves vectors",
@@ -629,6 +692,16 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Help: "Number of shards in process of unloading",
 		}, []string{"class_name"}),
 
+		// Async replication metrics
+		AsyncReplicationObjectsOutOfSync: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "async_replication_objects_out_of_sync",
+			Help: "Number of objects which diverged from other replicas in the last async replication iteration",
+		}, []string{"class_name", "shard_name"}),
+		AsyncReplicationLastInSync: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "async_replication_last_in_sync_timestamp_seconds",
+			Help: "Unix time at which the shard was last found in sync with all other replicas",
+		}, []string{"class_name", "shard_name"}),
+
 		// Schema TX-metrics. Can be removed when RAFT is ready
 		SchemaTxOpened: promauto.NewCounterVec(prometheus.CounterOpts{
 			Name: "schema_tx_opened_total",
@@ -714,6 +787,23 @@ func newPrometheusMetrics() *PrometheusMetrics {
 	}
 }
 
+// ObserveCacheBudget implements memwatch.BudgetObserver
+func (pm *PrometheusMetrics) ObserveCacheBudget(group string, usedBytes, shareBytes int64) {
+	pm.CacheBudgetUsedBytes.WithLabelValues(group).Set(float64(usedBytes))
+	pm.CacheBudgetShareBytes.WithLabelValues(group).Set(float64(shareBytes))
+}
+
+// ObserveCacheEviction implements memwatch.BudgetObserver
+func (pm *PrometheusMetrics) ObserveCacheEviction(group string, freedBytes int64) {
+	pm.CacheBudgetEvictions.WithLabelValues(group).Inc()
+	pm.CacheBudgetFreedBytes.WithLabelValues(group).Add(float64(freedBytes))
+}
+
+// ObserveLockHold implements esync.HoldObserver
+func (pm *PrometheusMetrics) ObserveLockHold(lock string, d time.Duration) {
+	pm.LockHoldDuration.WithLabelValues(lock).Observe(d.Seconds())
+}
+
 type OnceUponATimer struct {
 	sync.Once
 	Timer *prometheus.Timer
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package networkacl
+
+import (
+	"net"
+	"path"
+	"strings"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	GroupAdmin = "admin"
+	GroupData  = "data"
+)
+
+// adminPathPrefixes are the REST endpoints which are subject to the admin
+// rules, all other endpoints are subject to the data rules. The gRPC API
+// only serves data endpoints.
+var adminPathPrefixes = []string{
+	"/v1/schema", "/v1/backups", "/v1/nodes", "/v1/cluster", "/v1/authz", "/v1/keys",
+}
+
+// ACL holds the parsed rules of a network ACL config
+type ACL struct {
+	cfg   config.NetworkACL
+	admin Rules
+	data  Rules
+}
+
+type Rules struct {
+	allow []*net.IPNet
+	deny  []*net.IPNet
+}
+
+// New expects a validated config, invalid ranges are skipped
+func New(cfg config.NetworkACL) *ACL {
+	parse := func(list string) []*net.IPNet {
+		nets, _ := config.ParseCIDRs(list)
+		return nets
+	}
+	return &ACL{
+		cfg:   cfg,
+		admin: Rules{allow: parse(cfg.Admin.Allow), deny: parse(cfg.Admin.Deny)},
+		data:  Rules{allow: parse(cfg.Data.Allow), deny: parse(cfg.Data.Deny)},
+	}
+}
+
+// Config returns the config the rules were parsed from
+func (a *ACL) Config() config.NetworkACL {
+	return a.cfg
+}
+
+// Data returns the rules of the data endpoints
+func (a *ACL) Data() Rules {
+	return a.data
+}
+
+// RESTGroup returns the name and the rules of the group the REST path
+// belongs to. The path is cleaned and compared case-insensitively, so
+// e.g. "/v1//Schema/" or "/v1/objects/../schema" are admin paths as well.
+func (a *ACL) RESTGroup(urlPath string) (string, Rules) {
+	cleaned := strings.ToLower(path.Clean("/" + urlPath))
+	for _, prefix := range adminPathPrefixes {
+		if cleaned == prefix || strings.HasPrefix(cleaned, prefix+"/") {
+			return GroupAdmin, a.admin
+		}
+	}
+	return GroupData, a.data
+}
+
+// Empty is true if the rules allow every address
+func (r Rules) Empty() bool {
+	return len(r.allow) == 0 && len(r.deny) == 0
+}
+
+// Allows checks the address against the rules, deny takes precedence over
+// allow. A nil address is never allowed.
+func (r Rules) Allows(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, n := range r.deny {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	if len(r.allow) == 0 {
+		return true
+	}
+	for _, n := range r.allow {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -82,7 +82,7 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 
 	err = m.validateObjectAndNormalizeNames(ctx, principal, repl, object, nil)
 	if err != nil {
-		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+		return nil, newErrInvalidObject(err)
 	}
 
 	now := m.timeSource.Now()
@@ -96,7 +96,12 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, err
 	}
+	restoreBlobRefs, err := resolveBlobRefs(ctx, m.Blobs, vclasses[object.Class].Class, object)
+	if err != nil {
+		return nil, NewErrInternal("%v", err)
+	}
 	err = m.modulesProvider.UpdateVector(ctx, object, vclasses[object.Class].Class, m.findObject, m.logger)
+	restoreBlobRefs()
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +114,9 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, fmt.Errorf("put object: %w", err)
 	}
+	declareVectorDimensions(ctx, m.schemaManager, m.logger, object)
 
+	m.Events.publishObject(EventCreate, object)
 	return object, nil
 }
 
@@ -163,6 +170,7 @@ func (m *Manager) validateObjectAndNormalizeNames(ctx context.Context,
 	}
 
 	return validation.New(m.vectorRepo.Exists, m.config, repl).
+		WithBlobs(blobExists(m.Blobs)).
 		Object(ctx, class, incoming, existing)
 }
 
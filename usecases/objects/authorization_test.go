@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 
@@ -118,6 +119,42 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedResources: []string{authorization.Objects("", "", "foo")},
 		},
 
+		// trash
+		{
+			methodName:        "GetTrashedObjects",
+			additionalArgs:    []interface{}{"class", false},
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Objects("class", "", "")},
+		},
+		{
+			methodName:        "RestoreObject",
+			additionalArgs:    []interface{}{"class", strfmt.UUID("foo")},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Objects("class", "", "foo")},
+		},
+
+		// history
+		{
+			methodName:        "GetObjectHistory",
+			additionalArgs:    []interface{}{"class", strfmt.UUID("foo"), false},
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Objects("class", "", "foo")},
+		},
+
+		// blobs
+		{
+			methodName:        "AddObjectWithBlobs",
+			additionalArgs:    []interface{}{&models.Object{Class: "class"}, map[string]io.Reader{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Shards("class", ""),
+		},
+		{
+			methodName:        "GetObjectBlob",
+			additionalArgs:    []interface{}{"class", strfmt.UUID("foo"), "prop"},
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Objects("class", "", "foo")},
+		},
+
 		// query objects
 		{
 			methodName:        "Query",
@@ -219,6 +256,49 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.Shards("", ""),
 		},
+		{
+			methodName: "AddObjectsAsync",
+			additionalArgs: []interface{}{
+				[]*models.Object{{}},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Shards("", ""),
+		},
+		{
+			methodName: "UpdateObjects",
+			additionalArgs: []interface{}{
+				[]*models.Object{{}},
+				[]*string{},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Shards("", ""),
+		},
+		{
+			methodName:        "GetBatchJob",
+			additionalArgs:    []interface{}{""},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Collections(),
+		},
+		{
+			methodName:        "PurgeProperty",
+			additionalArgs:    []interface{}{"class", "prop", &additional.ReplicationProperties{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Shards("class"),
+		},
+		{
+			methodName:        "RenameProperty",
+			additionalArgs:    []interface{}{"class", "prop", "newProp", &additional.ReplicationProperties{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Shards("class"),
+		},
+		{
+			methodName:        "RenameClass",
+			additionalArgs:    []interface{}{"Class", "NewClass", &additional.ReplicationProperties{}},
+			expectedVerb:      authorization.DELETE,
+			expectedResources: authorization.Collections("Class"),
+		},
 		{
 			methodName: "AddReferences",
 			additionalArgs: []interface{}{
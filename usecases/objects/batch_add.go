@@ -31,20 +31,26 @@ var errEmptyObjects = NewErrInvalidUserInput("invalid param 'objects': cannot be
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
-	classesShards := make(map[string][]string)
-	for _, obj := range objects {
-		classesShards[obj.Class] = append(classesShards[obj.Class], obj.Tenant)
-	}
-
-	for class, shards := range classesShards {
-		err := b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(class, shards...)...)
-		if err != nil {
-			return nil, err
-		}
+	if err := b.authorizeAddObjects(principal, objects); err != nil {
+		return nil, err
 	}
 
 	ctx = classcache.ContextWithClassCache(ctx)
+	res, err := b.putObjects(ctx, principal, objects, nil, repl, EventCreate)
+	if err != nil {
+		return nil, err
+	}
+	b.addChunks(ctx, principal, res, repl)
+	return res, nil
+}
 
+// putObjects validates, vectorizes and stores the objects, and publishes
+// them as event. If creationTimes is set, it holds the creation time to keep
+// for every object, otherwise all objects are created now.
+func (b *BatchManager) putObjects(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, creationTimes []int64, repl *additional.ReplicationProperties,
+	event string,
+) (BatchObjects, error) {
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -63,6 +69,11 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 
 	var maxSchemaVersion uint64
 	batchObjects, maxSchemaVersion := b.validateAndGetVector(ctx, principal, objects, repl)
+	for i, created := range creationTimes {
+		if batchObjects[i].Object != nil {
+			batchObjects[i].Object.CreationTimeUnix = created
+		}
+	}
 	schemaVersion, tenantCount, err := b.autoSchemaManager.autoTenants(ctx, principal, objects)
 	if err != nil {
 		return nil, fmt.Errorf("auto create tenants: %w", err)
@@ -88,9 +99,33 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
 
+	imported := make([]*models.Object, 0, len(res))
+	for _, obj := range res {
+		if obj.Err == nil {
+			imported = append(imported, obj.Object)
+			b.Events.publishObject(event, obj.Object)
+		}
+	}
+	declareVectorDimensions(ctx, b.schemaManager, b.logger, imported...)
+
 	return res, nil
 }
 
+func (b *BatchManager) authorizeAddObjects(principal *models.Principal, objects []*models.Object) error {
+	classesShards := make(map[string][]string)
+	for _, obj := range objects {
+		classesShards[obj.Class] = append(classesShards[obj.Class], obj.Tenant)
+	}
+
+	for class, shards := range classesShards {
+		err := b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(class, shards...)...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, repl *additional.ReplicationProperties,
 ) (BatchObjects, uint64) {
@@ -101,7 +136,7 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 		objectsPerClass       = make(map[string][]*models.Object)
 		classPerClassName     = make(map[string]*models.Class)
 		originalIndexPerClass = make(map[string][]int)
-		validator             = validation.New(b.vectorRepo.Exists, b.config, repl)
+		validator             = validation.New(b.vectorRepo.Exists, b.config, repl).WithBlobs(blobExists(b.Blobs))
 	)
 
 	// validate each object and sort by class (==vectorizer)
@@ -172,7 +207,18 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 
 	for className, objectsForClass := range objectsPerClass {
 		class := classPerClassName[className]
+		restoreBlobRefs := make([]func(), 0, len(objectsForClass))
+		for i, obj := range objectsForClass {
+			restore, err := resolveBlobRefs(ctx, b.Blobs, class, obj)
+			if err != nil {
+				batchObjects[originalIndexPerClass[className][i]].Err = err
+			}
+			restoreBlobRefs = append(restoreBlobRefs, restore)
+		}
 		errorsPerObj, err := b.modulesProvider.BatchUpdateVector(ctx, class, objectsForClass, b.findObject, b.logger)
+		for _, restore := range restoreBlobRefs {
+			restore()
+		}
 		if err != nil {
 			for i := range objectsForClass {
 				origIndex := originalIndexPerClass[className][i]
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// addChunks imports the chunks of the imported objects of res whose class
+// has a chunker module configured. The chunks reference their parent, so
+// they can only be imported after it. Parents stay imported if their chunks
+// fail, the error is reported for them.
+func (b *BatchManager) addChunks(ctx context.Context, principal *models.Principal,
+	res BatchObjects, repl *additional.ReplicationProperties,
+) {
+	var (
+		chunks  []*models.Object
+		parents []int // index in res of the parent of each chunk
+	)
+	for i, obj := range res {
+		if obj.Err != nil || obj.Object == nil {
+			continue
+		}
+		vclasses, err := b.schemaManager.GetCachedClass(ctx, principal, obj.Object.Class)
+		if err != nil {
+			res[i].Err = errChunks(err)
+			continue
+		}
+		class := vclasses[obj.Object.Class].Class
+		if class == nil {
+			continue
+		}
+
+		objChunks, err := b.modulesProvider.ChunkObject(ctx, class, obj.Object)
+		if err != nil {
+			res[i].Err = errChunks(err)
+			continue
+		}
+		for range objChunks {
+			parents = append(parents, i)
+		}
+		chunks = append(chunks, objChunks...)
+	}
+	if len(chunks) == 0 {
+		return
+	}
+
+	failAll := func(err error) {
+		for _, i := range parents {
+			res[i].Err = errChunks(err)
+		}
+	}
+	if err := b.authorizeAddObjects(principal, chunks); err != nil {
+		failAll(err)
+		return
+	}
+	chunkRes, err := b.putObjects(ctx, principal, chunks, nil, repl, EventCreate)
+	if err != nil {
+		failAll(err)
+		return
+	}
+	for j, chunk := range chunkRes {
+		if chunk.Err != nil && res[parents[j]].Err == nil {
+			res[parents[j]].Err = errChunks(fmt.Errorf("chunk %s: %w", chunk.UUID, chunk.Err))
+		}
+	}
+}
+
+func errChunks(err error) error {
+	return fmt.Errorf("object was imported, but not its chunks: %w", err)
+}
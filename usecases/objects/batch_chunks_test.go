@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_AddObjects_WithChunks(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "Doc",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					ModuleConfig:      map[string]interface{}{"text-chunker": map[string]interface{}{}},
+					Properties: []*models.Property{
+						{Name: "body", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				{
+					Class:             "Chunk",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{Name: "text", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+		logger, _ := test.NewNullLogger()
+		modulesProvider = getFakeModulesProvider()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+		modulesProvider.chunkObject = func(class *models.Class, object *models.Object) ([]*models.Object, error) {
+			body := object.Properties.(map[string]interface{})["body"].(string)
+			if body == "fail" {
+				return nil, errors.New("cannot chunk")
+			}
+			return []*models.Object{
+				{Class: "Chunk", Properties: map[string]interface{}{"text": body + " 1"}},
+				{Class: "Chunk", Properties: map[string]interface{}{"text": body + " 2"}},
+			}, nil
+		}
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, &config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(), nil)
+	}
+	ctx := context.Background()
+
+	t.Run("chunks are imported after their parents", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Twice()
+		objects := []*models.Object{
+			{Class: "Doc", Properties: map[string]interface{}{"body": "first"}},
+			{Class: "Doc", Properties: map[string]interface{}{"body": "second"}},
+		}
+
+		res, err := manager.AddObjects(ctx, nil, objects, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err)
+
+		require.Len(t, vectorRepo.Calls, 2)
+		parents := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+		require.Len(t, parents, 2)
+		chunks := vectorRepo.Calls[1].Arguments[0].(BatchObjects)
+		require.Len(t, chunks, 4)
+		for i, text := range []string{"first 1", "first 2", "second 1", "second 2"} {
+			assert.Nil(t, chunks[i].Err)
+			assert.Equal(t, "Chunk", chunks[i].Object.Class)
+			assert.Equal(t, text, chunks[i].Object.Properties.(map[string]interface{})["text"])
+		}
+	})
+
+	t.Run("failed chunks are reported for their parent", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Twice()
+		objects := []*models.Object{
+			{Class: "Doc", Properties: map[string]interface{}{"body": "fail"}},
+			{Class: "Doc", Properties: map[string]interface{}{"body": "second"}},
+			{Class: "Doc", Properties: map[string]interface{}{"body": 1}},
+		}
+
+		res, err := manager.AddObjects(ctx, nil, objects, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		require.NotNil(t, res[0].Err)
+		assert.Contains(t, res[0].Err.Error(), "object was imported, but not its chunks: cannot chunk")
+		assert.Nil(t, res[1].Err)
+		// invalid objects are not chunked
+		require.NotNil(t, res[2].Err)
+		assert.NotContains(t, res[2].Err.Error(), "chunks")
+
+		require.Len(t, vectorRepo.Calls, 2)
+		assert.Len(t, vectorRepo.Calls[1].Arguments[0].(BatchObjects), 2)
+	})
+
+	t.Run("chunks failing validation", func(t *testing.T) {
+		reset()
+		modulesProvider.chunkObject = func(class *models.Class, object *models.Object) ([]*models.Object, error) {
+			return []*models.Object{{Class: "NotAClass"}}, nil
+		}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Twice()
+		objects := []*models.Object{
+			{Class: "Doc", Properties: map[string]interface{}{"body": "first"}},
+		}
+
+		res, err := manager.AddObjects(ctx, nil, objects, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		require.NotNil(t, res[0].Err)
+		assert.Contains(t, res[0].Err.Error(), "object was imported, but not its chunks: chunk")
+	})
+}
@@ -77,7 +77,13 @@ func (b *BatchManager) DeleteObjectsFromGRPC(ctx context.Context, principal *mod
 	defer b.metrics.BatchDeleteDec()
 
 	deletionTime := time.UnixMilli(b.timeSource.Now())
-	return b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
+	result, err := b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
+	if err != nil {
+		return result, err
+	}
+
+	b.publishDeletes(params.ClassName.String(), tenant, result)
+	return result, nil
 }
 
 func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
@@ -103,9 +109,34 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 		return nil, fmt.Errorf("batch delete objects: %w", err)
 	}
 
+	b.publishDeletes(match.Class, tenant, result)
+	b.deleteBlobs(match.Class, tenant, result)
 	return b.toResponse(match, params.Output, result)
 }
 
+func (b *BatchManager) publishDeletes(class, tenant string, result BatchDeleteResult) {
+	if result.DryRun {
+		return
+	}
+	for _, obj := range result.Objects {
+		if obj.Err == nil {
+			b.Events.Publish(Event{Type: EventDelete, Class: class, Tenant: tenant, ID: obj.UUID})
+		}
+	}
+}
+
+// deleteBlobs removes the files uploaded for the deleted objects
+func (b *BatchManager) deleteBlobs(class, tenant string, result BatchDeleteResult) {
+	if result.DryRun {
+		return
+	}
+	for _, obj := range result.Objects {
+		if obj.Err == nil {
+			deleteObjectBlobs(b.Blobs, b.logger, class, tenant, obj.UUID)
+		}
+	}
+}
+
 func (b *BatchManager) toResponse(match *models.BatchDeleteMatch, output string,
 	result BatchDeleteResult,
 ) (*BatchDeleteResponse, error) {
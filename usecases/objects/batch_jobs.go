@@ -0,0 +1,532 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	// batchJobSize is how many objects of a batch job are imported at once,
+	// which is also how often its progress is updated
+	batchJobSize = 100
+	// batchJobRetention is how long a finished batch job can be queried
+	batchJobRetention = time.Hour
+	// maxRunningBatchJobs is how many batch jobs can run at the same time on
+	// a node, including migration jobs
+	maxRunningBatchJobs = 16
+)
+
+// BatchJob is a batch of objects imported in the background. Objects holds
+// the result of every object processed so far, in the original order. Only
+// the id of an object is kept with its result, not the object itself.
+// VectorsRecomputed and VectorsSkipped count the vectors computed by
+// vectorizer modules and the ones kept because the vectorized properties
+// didn't change.
+type BatchJob struct {
+	ID                string
+	Status            string
+	Err               error
+	Total             int
+	Objects           BatchObjects
+	StartTimeUnix     int64
+	EndTimeUnix       int64
+	VectorsRecomputed int64
+	VectorsSkipped    int64
+
+	classes []string
+}
+
+// batchJobs holds running batch jobs and finished ones until they expire.
+// Jobs only live on the node which started them, so they can only be queried
+// on that node. If dir is set, jobs are written to it when they start and
+// finish, see BatchManager.PersistBatchJobs.
+type batchJobs struct {
+	sync.Mutex
+	jobs       map[string]*BatchJob
+	retention  time.Duration
+	maxRunning int
+	dir        string
+	logger     logrus.FieldLogger
+}
+
+func newBatchJobs(retention time.Duration, maxRunning int, logger logrus.FieldLogger) *batchJobs {
+	return &batchJobs{
+		jobs: map[string]*BatchJob{}, retention: retention, maxRunning: maxRunning, logger: logger,
+	}
+}
+
+// get returns a copy of the job, or nil if there is no such job
+func (j *batchJobs) get(id string, now int64) *BatchJob {
+	j.Lock()
+	defer j.Unlock()
+
+	j.expire(now)
+	job, ok := j.jobs[id]
+	if !ok {
+		return nil
+	}
+	cp := *job
+	cp.Objects = append(BatchObjects(nil), job.Objects...)
+	return &cp
+}
+
+// add fails with ErrTooManyBatchJobs if maxRunning jobs are running already
+func (j *batchJobs) add(job *BatchJob, now int64) error {
+	j.Lock()
+	defer j.Unlock()
+
+	j.expire(now)
+	running := 0
+	for _, other := range j.jobs {
+		if other.EndTimeUnix == 0 {
+			running++
+		}
+	}
+	if running >= j.maxRunning {
+		return ErrTooManyBatchJobs{max: j.maxRunning}
+	}
+	if err := j.persist(job); err != nil {
+		return NewErrInternal("persist batch job: %v", err)
+	}
+	j.jobs[job.ID] = job
+	return nil
+}
+
+// update persists the job once it finished. Progress of running jobs is only
+// kept in memory, writing all results after every sub-batch would be too
+// expensive for large jobs.
+func (j *batchJobs) update(id string, fn func(job *BatchJob)) {
+	j.Lock()
+	defer j.Unlock()
+
+	job, ok := j.jobs[id]
+	if !ok {
+		return
+	}
+	running := job.EndTimeUnix == 0
+	fn(job)
+	if running && job.EndTimeUnix != 0 {
+		if err := j.persist(job); err != nil {
+			j.logger.WithField("action", "batch_job").WithField("id", id).
+				WithError(err).Error("could not persist finished batch job")
+		}
+	}
+}
+
+// appendResults adds the results of objects to the job. The objects are
+// dropped, only their ids are kept.
+func (job *BatchJob) appendResults(objs BatchObjects) {
+	for _, obj := range objs {
+		id := obj.UUID
+		if id == "" && obj.Object != nil {
+			id = obj.Object.ID
+		}
+		job.Objects = append(job.Objects, BatchObject{
+			OriginalIndex: obj.OriginalIndex,
+			Err:           obj.Err,
+			UUID:          id,
+			Object:        &models.Object{ID: id},
+		})
+	}
+}
+
+// expire removes jobs which finished longer than the retention ago
+func (j *batchJobs) expire(now int64) {
+	for id, job := range j.jobs {
+		if job.EndTimeUnix != 0 && now-job.EndTimeUnix > j.retention.Milliseconds() {
+			delete(j.jobs, id)
+			if j.dir != "" {
+				if err := os.Remove(j.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+					j.logger.WithField("action", "batch_job").WithField("id", id).
+						WithError(err).Warn("could not remove expired batch job")
+				}
+			}
+		}
+	}
+}
+
+// persistedBatchJob is how a BatchJob is written to disk
+type persistedBatchJob struct {
+	ID                string                    `json:"id"`
+	Status            string                    `json:"status"`
+	Error             string                    `json:"error,omitempty"`
+	Total             int                       `json:"total"`
+	Objects           []persistedBatchJobObject `json:"objects,omitempty"`
+	StartTimeUnix     int64                     `json:"startTimeUnix"`
+	EndTimeUnix       int64                     `json:"endTimeUnix"`
+	VectorsRecomputed int64                     `json:"vectorsRecomputed"`
+	VectorsSkipped    int64                     `json:"vectorsSkipped"`
+	Classes           []string                  `json:"classes"`
+}
+
+type persistedBatchJobObject struct {
+	Index int         `json:"index"`
+	ID    strfmt.UUID `json:"id,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+func (j *batchJobs) path(id string) string {
+	return filepath.Join(j.dir, id+".json")
+}
+
+// persist writes the job to a temporary file first, so that a crash never
+// leaves a partially written job behind
+func (j *batchJobs) persist(job *BatchJob) error {
+	if j.dir == "" {
+		return nil
+	}
+
+	p := persistedBatchJob{
+		ID:                job.ID,
+		Status:            job.Status,
+		Total:             job.Total,
+		StartTimeUnix:     job.StartTimeUnix,
+		EndTimeUnix:       job.EndTimeUnix,
+		VectorsRecomputed: job.VectorsRecomputed,
+		VectorsSkipped:    job.VectorsSkipped,
+		Classes:           job.classes,
+	}
+	if job.Err != nil {
+		p.Error = job.Err.Error()
+	}
+	for _, obj := range job.Objects {
+		po := persistedBatchJobObject{Index: obj.OriginalIndex, ID: obj.UUID}
+		if obj.Err != nil {
+			po.Error = obj.Err.Error()
+		}
+		p.Objects = append(p.Objects, po)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(j.dir, ".job-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), j.path(job.ID))
+}
+
+// load reads all jobs from dir. Jobs which were still running when the node
+// stopped can't be resumed, so they are failed.
+func (j *batchJobs) load(now int64) error {
+	entries, err := os.ReadDir(j.dir)
+	if err != nil {
+		return err
+	}
+
+	j.Lock()
+	defer j.Unlock()
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(j.dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		var p persistedBatchJob
+		if err := json.Unmarshal(data, &p); err != nil {
+			return fmt.Errorf("parse batch job %s: %w", entry.Name(), err)
+		}
+
+		job := &BatchJob{
+			ID:                p.ID,
+			Status:            p.Status,
+			Total:             p.Total,
+			StartTimeUnix:     p.StartTimeUnix,
+			EndTimeUnix:       p.EndTimeUnix,
+			VectorsRecomputed: p.VectorsRecomputed,
+			VectorsSkipped:    p.VectorsSkipped,
+			classes:           p.Classes,
+		}
+		if p.Error != "" {
+			job.Err = errors.New(p.Error)
+		}
+		for _, po := range p.Objects {
+			obj := BatchObject{OriginalIndex: po.Index, UUID: po.ID, Object: &models.Object{ID: po.ID}}
+			if po.Error != "" {
+				obj.Err = errors.New(po.Error)
+			}
+			job.Objects = append(job.Objects, obj)
+		}
+
+		if job.EndTimeUnix == 0 {
+			job.Status = models.BatchJobStatusFAILED
+			job.Err = errBatchJobInterrupted
+			job.EndTimeUnix = now
+			if err := j.persist(job); err != nil {
+				return err
+			}
+		}
+		j.jobs[job.ID] = job
+	}
+
+	j.expire(now)
+	return nil
+}
+
+var errBatchJobInterrupted = errors.New("the job was interrupted by a restart of the node")
+
+// PersistBatchJobs makes batch jobs survive restarts of the node. Jobs are
+// written to dir when they start and once they finish, and the jobs found in
+// dir are loaded. Jobs which were still running fail, as they can't be
+// resumed. Jobs are kept until batchJobRetention after they finished.
+func (b *BatchManager) PersistBatchJobs(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create batch jobs directory: %w", err)
+	}
+	b.jobs.Lock()
+	b.jobs.dir = dir
+	b.jobs.Unlock()
+
+	if err := b.jobs.load(b.timeSource.Now()); err != nil {
+		return fmt.Errorf("load batch jobs: %w", err)
+	}
+	return nil
+}
+
+// AddObjectsAsync starts a batch job importing the objects in the
+// background and returns it right away. The job imports the objects just
+// like AddObjects, in batches of batchJobSize, and can be queried with
+// GetBatchJob.
+func (b *BatchManager) AddObjectsAsync(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, repl *additional.ReplicationProperties,
+) (*BatchJob, error) {
+	if err := b.authorizeAddObjects(principal, objects); err != nil {
+		return nil, err
+	}
+
+	if len(objects) == 0 {
+		return nil, errEmptyObjects
+	}
+
+	id, err := generateUUID()
+	if err != nil {
+		return nil, NewErrInternal("%v", err)
+	}
+
+	classes := map[string]struct{}{}
+	job := &BatchJob{
+		ID:            id.String(),
+		Status:        models.BatchJobStatusSTARTED,
+		Total:         len(objects),
+		StartTimeUnix: b.timeSource.Now(),
+	}
+	for _, obj := range objects {
+		if _, ok := classes[obj.Class]; !ok {
+			classes[obj.Class] = struct{}{}
+			job.classes = append(job.classes, obj.Class)
+		}
+	}
+	if err := b.jobs.add(job, job.StartTimeUnix); err != nil {
+		return nil, err
+	}
+
+	// imported objects are released while the job runs, without touching
+	// the slice of the caller
+	objects = append([]*models.Object(nil), objects...)
+	enterrors.GoWrapper(func() {
+		b.runBatchJob(job.ID, principal, objects, repl)
+	}, b.logger)
+
+	return b.jobs.get(job.ID, job.StartTimeUnix), nil
+}
+
+func (b *BatchManager) runBatchJob(id string, principal *models.Principal,
+	objects []*models.Object, repl *additional.ReplicationProperties,
+) {
+	// the job outlives the request which started it
+	stats := &moduletools.VectorizationStats{}
+	ctx := moduletools.ContextWithVectorizationStats(context.Background(), stats)
+
+	for start := 0; start < len(objects); start += batchJobSize {
+		end := start + batchJobSize
+		if end > len(objects) {
+			end = len(objects)
+		}
+
+		res, err := b.AddObjects(ctx, principal, objects[start:end], nil, repl)
+		if err != nil {
+			b.logger.WithField("action", "batch_job").WithField("id", id).
+				WithError(err).Error("batch job failed")
+			b.failJob(id, err)
+			return
+		}
+
+		for i := range res {
+			res[i].OriginalIndex += start
+		}
+		// imported objects aren't needed anymore
+		clear(objects[start:end])
+		b.jobs.update(id, func(job *BatchJob) {
+			job.appendResults(res)
+			job.VectorsRecomputed = stats.Recomputed()
+			job.VectorsSkipped = stats.Skipped()
+		})
+	}
+
+	b.jobs.update(id, func(job *BatchJob) {
+		job.Status = models.BatchJobStatusSUCCESS
+		job.EndTimeUnix = b.timeSource.Now()
+	})
+}
+
+// newMigrationJob registers a new job which migrates the objects of the
+// given classes
+func (b *BatchManager) newMigrationJob(classes ...string) (*BatchJob, error) {
+	id, err := generateUUID()
+	if err != nil {
+		return nil, NewErrInternal("%v", err)
+	}
+	job := &BatchJob{
+		ID:            id.String(),
+		Status:        models.BatchJobStatusSTARTED,
+		StartTimeUnix: b.timeSource.Now(),
+		classes:       classes,
+	}
+	if err := b.jobs.add(job, job.StartTimeUnix); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// failJob marks a job as failed with err
+func (b *BatchManager) failJob(id string, err error) {
+	b.jobs.update(id, func(job *BatchJob) {
+		job.Status = models.BatchJobStatusFAILED
+		job.Err = err
+		job.EndTimeUnix = b.timeSource.Now()
+	})
+}
+
+// runMigrationJob pages through all objects of the class, tenant by tenant,
+// and passes every page to migrate. The objects it returns are added to the
+// job. finish is only called if all objects were migrated without an error,
+// otherwise the job fails. The error the job failed with is returned.
+func (b *BatchManager) runMigrationJob(id, action, class string, tenants []string,
+	addProps additional.Properties,
+	migrate func(ctx context.Context, tenant string, page search.Results) BatchObjects,
+	finish func(ctx context.Context) error,
+) error {
+	// the job outlives the request which started it
+	ctx := context.Background()
+
+	fail := func(err error) error {
+		b.logger.WithField("action", action).WithField("id", id).
+			WithError(err).Error("migration job failed")
+		b.failJob(id, err)
+		return err
+	}
+
+	failed := 0
+	for _, tenant := range tenants {
+		cursor := &filters.Cursor{Limit: batchJobSize}
+		for {
+			page, qerr := b.vectorRepo.Query(ctx, &QueryInput{
+				Class:      class,
+				Limit:      cursor.Limit,
+				Cursor:     cursor,
+				Tenant:     tenant,
+				Additional: addProps,
+			})
+			if qerr != nil {
+				return fail(qerr)
+			}
+
+			migrated := migrate(ctx, tenant, page)
+			for _, obj := range migrated {
+				if obj.Err != nil {
+					failed++
+				}
+			}
+			b.jobs.update(id, func(job *BatchJob) {
+				for i := range migrated {
+					migrated[i].OriginalIndex = len(job.Objects) + i
+				}
+				job.appendResults(migrated)
+				job.Total = len(job.Objects)
+			})
+
+			if len(page) < cursor.Limit {
+				break
+			}
+			cursor = &filters.Cursor{After: page[len(page)-1].ID.String(), Limit: batchJobSize}
+		}
+	}
+
+	if finish != nil {
+		if failed > 0 {
+			return fail(fmt.Errorf("%d objects could not be migrated", failed))
+		}
+		if err := finish(ctx); err != nil {
+			return fail(err)
+		}
+	}
+
+	b.jobs.update(id, func(job *BatchJob) {
+		job.Status = models.BatchJobStatusSUCCESS
+		job.EndTimeUnix = b.timeSource.Now()
+	})
+	return nil
+}
+
+// GetBatchJob returns the batch job with the given id. Jobs can be queried
+// while they are running and for batchJobRetention after they finished.
+func (b *BatchManager) GetBatchJob(ctx context.Context, principal *models.Principal,
+	id string,
+) (*BatchJob, error) {
+	job := b.jobs.get(id, b.timeSource.Now())
+
+	// check access before revealing whether the job exists
+	var classes []string
+	if job != nil {
+		classes = job.classes
+	}
+	err := b.authorizer.Authorize(principal, authorization.READ, authorization.Collections(classes...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if job == nil {
+		return nil, NewErrNotFound("batch job %q does not exist", id)
+	}
+	return job, nil
+}
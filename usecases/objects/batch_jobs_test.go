@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_AddObjectsAsync(t *testing.T) {
+	newManager := func(repoErr error) *BatchManager {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(repoErr)
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{{
+				Class:             "Foo",
+				Vectorizer:        config.VectorizerModuleNone,
+				VectorIndexConfig: hnsw.UserConfig{},
+			}}},
+		}}
+		logger, _ := test.NewNullLogger()
+		return NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(), nil)
+	}
+	waitForJob := func(t *testing.T, manager *BatchManager, id string) *BatchJob {
+		var job *BatchJob
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = manager.GetBatchJob(context.Background(), nil, id)
+			require.Nil(t, err)
+			return job.Status != models.BatchJobStatusSTARTED
+		}, 5*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	objects := make([]*models.Object, batchJobSize+50)
+	for i := range objects {
+		objects[i] = &models.Object{Class: "Foo", Vector: []float32{0.1, 0.2}}
+	}
+
+	t.Run("without any objects", func(t *testing.T) {
+		_, err := newManager(nil).AddObjectsAsync(context.Background(), nil, nil, nil)
+		assert.Equal(t, errEmptyObjects, err)
+	})
+
+	t.Run("imports all objects", func(t *testing.T) {
+		manager := newManager(nil)
+		job, err := manager.AddObjectsAsync(context.Background(), nil, objects, nil)
+		require.Nil(t, err)
+		assert.Len(t, job.ID, 36)
+		assert.Equal(t, len(objects), job.Total)
+
+		job = waitForJob(t, manager, job.ID)
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		assert.NotZero(t, job.EndTimeUnix)
+		require.Len(t, job.Objects, len(objects))
+		for i, obj := range job.Objects {
+			assert.Equal(t, i, obj.OriginalIndex)
+			assert.Nil(t, obj.Err)
+			assert.Nil(t, obj.Object.Vector, "only the id of objects is kept")
+		}
+		assert.NotNil(t, objects[0], "objects of the caller are kept")
+	})
+
+	t.Run("fails as a whole", func(t *testing.T) {
+		manager := newManager(errors.New("disk full"))
+		job, err := manager.AddObjectsAsync(context.Background(), nil, objects, nil)
+		require.Nil(t, err)
+
+		job = waitForJob(t, manager, job.ID)
+		assert.Equal(t, models.BatchJobStatusFAILED, job.Status)
+		assert.ErrorContains(t, job.Err, "disk full")
+		assert.Empty(t, job.Objects)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		_, err := newManager(nil).GetBatchJob(context.Background(), nil, "unknown")
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+}
+
+func TestBatchJobsExpire(t *testing.T) {
+	jobs := newBatchJobs(time.Second, 2, nil)
+	require.Nil(t, jobs.add(&BatchJob{ID: "running"}, 0))
+	require.Nil(t, jobs.add(&BatchJob{ID: "finished", EndTimeUnix: 500}, 0))
+
+	assert.NotNil(t, jobs.get("finished", 1500))
+	assert.Nil(t, jobs.get("finished", 1501))
+	assert.NotNil(t, jobs.get("running", 1501))
+}
+
+func TestBatchJobsMaxRunning(t *testing.T) {
+	jobs := newBatchJobs(time.Second, 2, nil)
+	require.Nil(t, jobs.add(&BatchJob{ID: "1"}, 0))
+	require.Nil(t, jobs.add(&BatchJob{ID: "2"}, 0))
+	assert.ErrorAs(t, jobs.add(&BatchJob{ID: "3"}, 0), &ErrTooManyBatchJobs{})
+
+	jobs.update("1", func(job *BatchJob) { job.EndTimeUnix = 1 })
+	assert.Nil(t, jobs.add(&BatchJob{ID: "3"}, 0), "finished jobs don't count")
+}
+
+func TestBatchJobsPersist(t *testing.T) {
+	dir := t.TempDir()
+	jobs := newBatchJobs(time.Second, 2, nil)
+	jobs.dir = dir
+	require.Nil(t, jobs.add(&BatchJob{ID: "running", Status: models.BatchJobStatusSTARTED}, 0))
+	require.Nil(t, jobs.add(&BatchJob{ID: "finished", Status: models.BatchJobStatusSTARTED, classes: []string{"Foo"}}, 0))
+	jobs.update("finished", func(job *BatchJob) {
+		job.appendResults(BatchObjects{
+			{OriginalIndex: 0, UUID: "8d5a3aa2-3c8d-4589-9ae1-3f638f506970"},
+			{OriginalIndex: 1, UUID: "9d5a3aa2-3c8d-4589-9ae1-3f638f506970", Err: errors.New("invalid")},
+		})
+		job.Total = 2
+		job.Status = models.BatchJobStatusSUCCESS
+		job.EndTimeUnix = 500
+	})
+
+	t.Run("finished jobs are loaded", func(t *testing.T) {
+		loaded := newBatchJobs(time.Second, 2, nil)
+		loaded.dir = dir
+		require.Nil(t, loaded.load(1000))
+
+		job := loaded.get("finished", 1000)
+		require.NotNil(t, job)
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		assert.Equal(t, []string{"Foo"}, job.classes)
+		require.Len(t, job.Objects, 2)
+		assert.Nil(t, job.Objects[0].Err)
+		assert.EqualError(t, job.Objects[1].Err, "invalid")
+		assert.Equal(t, job.Objects[1].UUID, job.Objects[1].Object.ID)
+	})
+
+	t.Run("running jobs fail", func(t *testing.T) {
+		loaded := newBatchJobs(time.Second, 2, nil)
+		loaded.dir = dir
+		require.Nil(t, loaded.load(1000))
+
+		job := loaded.get("running", 1000)
+		require.NotNil(t, job)
+		assert.Equal(t, models.BatchJobStatusFAILED, job.Status)
+		assert.EqualError(t, job.Err, errBatchJobInterrupted.Error())
+		assert.Equal(t, int64(1000), job.EndTimeUnix)
+	})
+
+	t.Run("expired jobs are removed", func(t *testing.T) {
+		loaded := newBatchJobs(time.Second, 2, nil)
+		loaded.dir = dir
+		require.Nil(t, loaded.load(1501))
+
+		assert.Nil(t, loaded.get("finished", 1501))
+		assert.NoFileExists(t, loaded.path("finished"))
+		assert.NotNil(t, loaded.get("running", 1501))
+	})
+}
@@ -35,6 +35,14 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	jobs              *batchJobs
+
+	// Events receives all object changes made through the manager
+	Events *Events
+
+	// Blobs stores the files uploaded to blob properties, see
+	// Manager.Blobs
+	Blobs BlobStore
 }
 
 type BatchVectorRepo interface {
@@ -68,5 +76,6 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		authorizer:        authorizer,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           NewMetrics(prom),
+		jobs:              newBatchJobs(batchJobRetention, maxRunningBatchJobs, logger),
 	}
 }
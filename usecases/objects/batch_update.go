@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/classcache"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// UpdateObjects replaces existing objects in batch. Every object needs an
+// id. Objects which don't exist fail individually instead of being created,
+// the others keep their creation time.
+func (b *BatchManager) UpdateObjects(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
+	if err := b.authorizeAddObjects(principal, objects); err != nil {
+		return nil, err
+	}
+
+	if len(objects) == 0 {
+		return nil, errEmptyObjects
+	}
+
+	ctx = classcache.ContextWithClassCache(ctx)
+
+	var (
+		res           = make(BatchObjects, len(objects))
+		existing      = make([]*models.Object, 0, len(objects))
+		indexes       = make([]int, 0, len(objects))
+		creationTimes = make([]int64, 0, len(objects))
+	)
+	for i, obj := range objects {
+		res[i] = BatchObject{OriginalIndex: i, Object: obj, UUID: obj.ID}
+		if obj.Class == "" {
+			res[i].Err = errors.New("object has an empty class")
+			continue
+		}
+		if obj.ID == "" {
+			res[i].Err = errors.New("object has no id")
+			continue
+		}
+
+		prev, err := b.vectorRepo.Object(ctx, obj.Class, obj.ID, search.SelectProperties{},
+			additional.Properties{}, repl, obj.Tenant)
+		if err != nil {
+			res[i].Err = err
+			continue
+		}
+		if prev == nil {
+			res[i].Err = fmt.Errorf("object %s of class %s does not exist", obj.ID, obj.Class)
+			continue
+		}
+
+		existing = append(existing, obj)
+		indexes = append(indexes, i)
+		creationTimes = append(creationTimes, prev.Created)
+	}
+
+	if len(existing) == 0 {
+		return res, nil
+	}
+
+	updated, err := b.putObjects(ctx, principal, existing, creationTimes, repl, EventUpdate)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range updated {
+		i := indexes[obj.OriginalIndex]
+		obj.OriginalIndex = i
+		res[i] = obj
+	}
+
+	return res, nil
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_UpdateObjects(t *testing.T) {
+	const (
+		existingID strfmt.UUID = "6c9fd2e8-1d0e-4b7d-9c38-4a6c4b1f3c3a"
+		missingID  strfmt.UUID = "0b8a6f4e-6d1c-4c52-9f0b-8a1c3e3a8f11"
+	)
+
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("Object", "Foo", existingID, mock.Anything, mock.Anything, "").
+		Return(&search.Result{ID: existingID, ClassName: "Foo", Created: 42}, nil)
+	vectorRepo.On("Object", "Foo", missingID, mock.Anything, mock.Anything, "").
+		Return(nil, nil)
+	vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+	modulesProvider := getFakeModulesProvider()
+	modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{{
+			Class:             "Foo",
+			Vectorizer:        config.VectorizerModuleNone,
+			VectorIndexConfig: hnsw.UserConfig{},
+		}}},
+	}}
+	logger, _ := test.NewNullLogger()
+	manager := NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{}, schemaManager,
+		&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(), nil)
+
+	t.Run("without any objects", func(t *testing.T) {
+		_, err := manager.UpdateObjects(context.Background(), nil, nil, nil, nil)
+		assert.Equal(t, errEmptyObjects, err)
+	})
+
+	t.Run("only existing objects are replaced", func(t *testing.T) {
+		res, err := manager.UpdateObjects(context.Background(), nil, []*models.Object{
+			{Class: "Foo", ID: missingID, Vector: []float32{0.1}},
+			{Class: "Foo", Vector: []float32{0.2}},
+			{Class: "Foo", ID: existingID, Vector: []float32{0.3}},
+		}, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		assert.ErrorContains(t, res[0].Err, "does not exist")
+		assert.ErrorContains(t, res[1].Err, "no id")
+		assert.Nil(t, res[2].Err)
+		assert.Equal(t, 2, res[2].OriginalIndex)
+		assert.Equal(t, existingID, res[2].UUID)
+		assert.Equal(t, int64(42), res[2].Object.CreationTimeUnix, "creation time is kept")
+
+		stored := vectorRepo.Calls[len(vectorRepo.Calls)-1].Arguments[0].(BatchObjects)
+		require.Len(t, stored, 1, "only the existing object is stored")
+		assert.Equal(t, existingID, stored[0].UUID)
+	})
+}
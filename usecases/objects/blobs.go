@@ -0,0 +1,277 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// ErrBlobNotFound is returned by a BlobStore if no blob with the key exists
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores the files uploaded to blob properties. Keys are slash
+// separated paths, which allows backends like object storages to use them
+// as they are.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes all blobs whose keys start with prefix, which
+	// ends with a slash
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
+// AddObjectWithBlobs adds an object like AddObject and stores the contents of
+// blobs in the blob storage first. The keys of blobs are names of blob
+// properties, which are set to a reference to the stored file.
+//
+// Stored files are removed again if the object can't be added. They are
+// also removed once the object is deleted or the property is changed.
+func (m *Manager) AddObjectWithBlobs(ctx context.Context, principal *models.Principal,
+	object *models.Object, blobs map[string]io.Reader, repl *additional.ReplicationProperties,
+) (*models.Object, error) {
+	if object == nil {
+		return nil, NewErrInvalidUserInput("no object given")
+	}
+	err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(object.Class, object.Tenant)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blobs) > 0 && m.Blobs == nil {
+		return nil, NewErrInternal("no blob storage configured")
+	}
+
+	vclasses, err := m.schemaManager.GetCachedClass(ctx, principal, object.Class)
+	if err != nil {
+		return nil, err
+	}
+	class := vclasses[object.Class].Class
+	if class == nil {
+		return nil, NewErrInvalidUserInput("class '%s' does not exist, it must be created before files can be uploaded",
+			object.Class)
+	}
+	for name := range blobs {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil || len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeBlob) {
+			return nil, NewErrInvalidUserInput("class '%s' has no blob property '%s'", class.Class, name)
+		}
+	}
+
+	// the id is part of the keys of the stored files
+	if object.ID == "" {
+		if object.ID, err = generateUUID(); err != nil {
+			return nil, NewErrInternal("could not generate id: %v", err)
+		}
+	} else {
+		object.ID = strfmt.UUID(strings.ToLower(object.ID.String()))
+	}
+
+	props, ok := object.Properties.(map[string]interface{})
+	if !ok || props == nil {
+		props = map[string]interface{}{}
+	}
+	stored := make([]string, 0, len(blobs))
+	for name, r := range blobs {
+		if _, ok := props[name]; ok {
+			m.deleteBlobs(stored)
+			return nil, NewErrInvalidUserInput("property '%s' is set and a file was uploaded for it", name)
+		}
+		key := validation.BlobKeyPrefix(class.Class, object.Tenant, object.ID, name) + uuid.NewString()
+		if err := m.Blobs.Put(ctx, key, r); err != nil {
+			m.deleteBlobs(stored)
+			return nil, NewErrInternal("store file of property '%s': %v", name, err)
+		}
+		stored = append(stored, key)
+		props[name] = validation.BlobRefPrefix + key
+	}
+	object.Properties = props
+
+	res, err := m.AddObject(ctx, principal, object, repl)
+	if err != nil {
+		m.deleteBlobs(stored)
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetObjectBlob opens the file referenced by the blob property of an object.
+// Only files which were uploaded for this very object and property can be
+// opened, so that access to files is always checked against the object.
+func (m *Manager) GetObjectBlob(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, property string,
+	repl *additional.ReplicationProperties, tenant string,
+) (io.ReadCloser, error) {
+	obj, err := m.GetObject(ctx, principal, class, id, additional.Properties{}, repl, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if m.Blobs == nil {
+		return nil, NewErrNotFound("no file for property '%s' of object '%s'", property, id)
+	}
+
+	props, _ := obj.Properties.(map[string]interface{})
+	ref, _ := props[property].(string)
+	prefix := validation.BlobRefPrefix + validation.BlobKeyPrefix(obj.Class, obj.Tenant, obj.ID, property)
+	if !strings.HasPrefix(ref, prefix) {
+		return nil, NewErrNotFound("no file for property '%s' of object '%s'", property, id)
+	}
+
+	r, err := m.Blobs.Get(ctx, strings.TrimPrefix(ref, validation.BlobRefPrefix))
+	if err != nil {
+		if errors.Is(err, ErrBlobNotFound) {
+			return nil, NewErrNotFound("file of property '%s' of object '%s' was removed", property, id)
+		}
+		return nil, NewErrInternal("open file of property '%s': %v", property, err)
+	}
+	return r, nil
+}
+
+// blobExists is nil without a blob storage, so that references to files
+// are rejected
+func blobExists(store BlobStore) func(ctx context.Context, key string) (bool, error) {
+	if store == nil {
+		return nil
+	}
+	return store.Exists
+}
+
+// resolveBlobRefs replaces the references to stored files in the blob
+// properties of obj with the base64 encoded contents of the files, so that
+// vectorizers see the same data as for inline values. The returned function
+// puts the references back. Nothing is read for classes without vectorizer.
+func resolveBlobRefs(ctx context.Context, store BlobStore, class *models.Class,
+	obj *models.Object,
+) (func(), error) {
+	refs := map[string]string{}
+	props, _ := obj.Properties.(map[string]interface{})
+	restore := func() {
+		for name, ref := range refs {
+			props[name] = ref
+		}
+	}
+	if store == nil || props == nil || class == nil || !hasVectorizer(class) {
+		return restore, nil
+	}
+
+	for _, prop := range class.Properties {
+		if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeBlob) {
+			continue
+		}
+		ref, ok := props[prop.Name].(string)
+		if !ok || !strings.HasPrefix(ref, validation.BlobRefPrefix) {
+			continue
+		}
+		data, err := readBlob(ctx, store, strings.TrimPrefix(ref, validation.BlobRefPrefix))
+		if err != nil {
+			restore()
+			return func() {}, fmt.Errorf("read file of property '%s': %w", prop.Name, err)
+		}
+		refs[prop.Name] = ref
+		props[prop.Name] = base64.StdEncoding.EncodeToString(data)
+	}
+	return restore, nil
+}
+
+func readBlob(ctx context.Context, store BlobStore, key string) ([]byte, error) {
+	r, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func hasVectorizer(class *models.Class) bool {
+	if class.Vectorizer != "" && class.Vectorizer != config.VectorizerModuleNone {
+		return true
+	}
+	for _, vectorConfig := range class.VectorConfig {
+		vectorizer, ok := vectorConfig.Vectorizer.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := vectorizer[config.VectorizerModuleNone]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// replacedBlobs returns the keys of the files prev referenced in its blob
+// properties which next doesn't reference any more
+func replacedBlobs(prev, next *models.Object) []string {
+	if prev == nil {
+		return nil
+	}
+	prevProps, _ := prev.Properties.(map[string]interface{})
+	nextProps, _ := next.Properties.(map[string]interface{})
+
+	var keys []string
+	for name, value := range prevProps {
+		ref, ok := value.(string)
+		prefix := validation.BlobRefPrefix + validation.BlobKeyPrefix(prev.Class, prev.Tenant, prev.ID, name)
+		if !ok || !strings.HasPrefix(ref, prefix) {
+			continue
+		}
+		if current, _ := nextProps[name].(string); current == ref {
+			continue
+		}
+		keys = append(keys, strings.TrimPrefix(ref, validation.BlobRefPrefix))
+	}
+	return keys
+}
+
+// deleteObjectBlobs removes all files uploaded for an object. It is best
+// effort like deleteBlobs.
+func deleteObjectBlobs(store BlobStore, logger logrus.FieldLogger,
+	class, tenant string, id strfmt.UUID,
+) {
+	if store == nil {
+		return
+	}
+	prefix := validation.BlobKeyPrefix(class, tenant, id, "")
+	if err := store.DeletePrefix(context.Background(), prefix); err != nil {
+		logger.WithField("action", "delete_blob").WithField("prefix", prefix).
+			WithError(err).Warn("failed to remove files of deleted object")
+	}
+}
+
+// deleteBlobs is best effort, a file which can't be removed only takes up
+// space
+func (m *Manager) deleteBlobs(keys []string) {
+	if m.Blobs == nil {
+		return
+	}
+	for _, key := range keys {
+		if err := m.Blobs.Delete(context.Background(), key); err != nil {
+			m.logger.WithField("action", "delete_blob").WithField("key", key).
+				WithError(err).Warn("failed to remove uploaded file")
+		}
+	}
+}
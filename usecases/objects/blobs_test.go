@@ -0,0 +1,302 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_Blobs(t *testing.T) {
+	var (
+		cls = "Document"
+		id  = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					Properties: []*models.Property{
+						{Name: "file", DataType: schema.DataTypeBlob.PropString()},
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+			},
+		},
+	}
+	newManager := func() (fakeGetManager, *fakeBlobStore) {
+		m := newFakeGetManager(sch)
+		store := &fakeBlobStore{blobs: map[string][]byte{}}
+		m.Blobs = store
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return(nil, nil)
+		return m, store
+	}
+
+	t.Run("upload", func(t *testing.T) {
+		m, store := newManager()
+		m.repo.On("Exists", cls, id).Return(false, nil).Once()
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		obj := &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{"title": "report"}}
+		res, err := m.AddObjectWithBlobs(context.Background(), nil, obj,
+			map[string]io.Reader{"file": strings.NewReader("some pdf")}, nil)
+		require.Nil(t, err)
+
+		ref := res.Properties.(map[string]interface{})["file"].(string)
+		assert.True(t, strings.HasPrefix(ref, "blob://Document//"+id.String()+"/file/"), ref)
+		assert.Equal(t, "report", res.Properties.(map[string]interface{})["title"])
+		require.Len(t, store.blobs, 1)
+		assert.Equal(t, "some pdf", string(store.blobs[strings.TrimPrefix(ref, "blob://")]))
+	})
+
+	t.Run("upload assigns an id", func(t *testing.T) {
+		m, store := newManager()
+		m.repo.On("Exists", cls, mock.Anything).Return(false, nil).Once()
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := m.AddObjectWithBlobs(context.Background(), nil, &models.Object{Class: cls},
+			map[string]io.Reader{"file": strings.NewReader("some pdf")}, nil)
+		require.Nil(t, err)
+		require.Len(t, res.ID, 36)
+		for key := range store.blobs {
+			assert.True(t, strings.HasPrefix(key, "Document//"+res.ID.String()+"/file/"), key)
+		}
+	})
+
+	t.Run("upload to properties which are no blobs", func(t *testing.T) {
+		for _, prop := range []string{"title", "missing"} {
+			m, store := newManager()
+
+			_, err := m.AddObjectWithBlobs(context.Background(), nil, &models.Object{Class: cls, ID: id},
+				map[string]io.Reader{prop: strings.NewReader("some pdf")}, nil)
+			assert.True(t, errors.As(err, &ErrInvalidUserInput{}), prop)
+			assert.Empty(t, store.blobs)
+			m.repo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("upload to a property which is set", func(t *testing.T) {
+		m, store := newManager()
+
+		obj := &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{"file": "c29tZSBwZGY="}}
+		_, err := m.AddObjectWithBlobs(context.Background(), nil, obj,
+			map[string]io.Reader{"file": strings.NewReader("some pdf")}, nil)
+		assert.True(t, errors.As(err, &ErrInvalidUserInput{}))
+		assert.Empty(t, store.blobs)
+	})
+
+	t.Run("files are removed if the object can't be added", func(t *testing.T) {
+		m, store := newManager()
+		m.repo.On("Exists", cls, id).Return(true, nil).Once()
+
+		_, err := m.AddObjectWithBlobs(context.Background(), nil, &models.Object{Class: cls, ID: id},
+			map[string]io.Reader{"file": strings.NewReader("some pdf")}, nil)
+		require.NotNil(t, err)
+		assert.Empty(t, store.blobs)
+	})
+
+	stored := func(ref string) *search.Result {
+		return &search.Result{
+			ID:        id,
+			ClassName: cls,
+			Schema:    map[string]interface{}{"file": ref},
+		}
+	}
+
+	t.Run("download", func(t *testing.T) {
+		m, store := newManager()
+		key := fmt.Sprintf("Document//%s/file/1", id)
+		store.blobs[key] = []byte("some pdf")
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").
+			Return(stored("blob://"+key), nil).Once()
+
+		r, err := m.GetObjectBlob(context.Background(), nil, cls, id, "file", nil, "")
+		require.Nil(t, err)
+		data, err := io.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, "some pdf", string(data))
+	})
+
+	t.Run("download files of other objects", func(t *testing.T) {
+		otherID := "e0b2f6a6-3e4b-4c8e-9d2e-4a0e1f0c3b7d"
+		for _, key := range []string{
+			fmt.Sprintf("Document//%s/file/1", otherID),
+			fmt.Sprintf("Document//%s/other/1", id),
+			fmt.Sprintf("Other//%s/file/1", id),
+		} {
+			m, store := newManager()
+			store.blobs[key] = []byte("some pdf")
+			m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").
+				Return(stored("blob://"+key), nil).Once()
+
+			_, err := m.GetObjectBlob(context.Background(), nil, cls, id, "file", nil, "")
+			assert.True(t, errors.As(err, &ErrNotFound{}), key)
+		}
+	})
+
+	t.Run("download inline blobs and removed files", func(t *testing.T) {
+		for _, ref := range []string{"c29tZSBwZGY=", fmt.Sprintf("blob://Document//%s/file/1", id)} {
+			m, _ := newManager()
+			m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").
+				Return(stored(ref), nil).Once()
+
+			_, err := m.GetObjectBlob(context.Background(), nil, cls, id, "file", nil, "")
+			assert.True(t, errors.As(err, &ErrNotFound{}), ref)
+		}
+	})
+
+	t.Run("references to files which were not uploaded for the object", func(t *testing.T) {
+		otherID := "e0b2f6a6-3e4b-4c8e-9d2e-4a0e1f0c3b7d"
+		for _, key := range []string{
+			fmt.Sprintf("Document//%s/file/1", otherID),
+			fmt.Sprintf("Document//%s/other/1", id),
+			fmt.Sprintf("Document//%s/file/missing", id),
+		} {
+			m, store := newManager()
+			store.blobs[fmt.Sprintf("Document//%s/file/1", otherID)] = []byte("some pdf")
+			store.blobs[fmt.Sprintf("Document//%s/other/1", id)] = []byte("some pdf")
+			m.repo.On("Exists", cls, id).Return(false, nil).Once()
+
+			obj := &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{"file": "blob://" + key}}
+			_, err := m.AddObject(context.Background(), nil, obj, nil)
+			assert.True(t, errors.As(err, &ErrInvalidUserInput{}), key)
+			m.repo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("references to uploaded files", func(t *testing.T) {
+		m, store := newManager()
+		key := fmt.Sprintf("Document//%s/file/1", id)
+		store.blobs[key] = []byte("some pdf")
+		m.repo.On("Exists", cls, id).Return(false, nil).Once()
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		obj := &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{"file": "blob://" + key}}
+		_, err := m.AddObject(context.Background(), nil, obj, nil)
+		require.Nil(t, err)
+	})
+
+	t.Run("files are removed with their object", func(t *testing.T) {
+		m, store := newManager()
+		otherID := "e0b2f6a6-3e4b-4c8e-9d2e-4a0e1f0c3b7d"
+		store.blobs[fmt.Sprintf("Document//%s/file/1", id)] = []byte("some pdf")
+		store.blobs[fmt.Sprintf("Document//%s/file/2", id)] = []byte("some pdf")
+		store.blobs[fmt.Sprintf("Document//%s/file/1", otherID)] = []byte("some pdf")
+		m.repo.On("DeleteObject", cls, id, mock.Anything).Return(nil).Once()
+
+		err := m.DeleteObject(context.Background(), nil, cls, id, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]byte{
+			fmt.Sprintf("Document//%s/file/1", otherID): []byte("some pdf"),
+		}, store.blobs)
+	})
+
+	t.Run("replaced files", func(t *testing.T) {
+		ref := fmt.Sprintf("blob://Document//%s/file/1", id)
+		prev := &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{"file": ref}}
+
+		for _, tc := range []struct {
+			name  string
+			props map[string]interface{}
+			want  []string
+		}{
+			{"unchanged", map[string]interface{}{"file": ref}, nil},
+			{"replaced", map[string]interface{}{"file": ref + "0"}, []string{strings.TrimPrefix(ref, "blob://")}},
+			{"inlined", map[string]interface{}{"file": "c29tZSBwZGY="}, []string{strings.TrimPrefix(ref, "blob://")}},
+			{"removed", nil, []string{strings.TrimPrefix(ref, "blob://")}},
+		} {
+			next := &models.Object{Class: cls, ID: id, Properties: tc.props}
+			assert.Equal(t, tc.want, replacedBlobs(prev, next), tc.name)
+		}
+		assert.Nil(t, replacedBlobs(nil, prev))
+	})
+
+	t.Run("vectorizers see the content of files", func(t *testing.T) {
+		store := &fakeBlobStore{blobs: map[string][]byte{}}
+		key := fmt.Sprintf("Document//%s/file/1", id)
+		store.blobs[key] = []byte("some pdf")
+		class := &models.Class{
+			Class:      cls,
+			Vectorizer: "multi2vec-clip",
+			Properties: sch.Objects.Classes[0].Properties,
+		}
+		obj := &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{
+			"file": "blob://" + key, "title": "report",
+		}}
+
+		restore, err := resolveBlobRefs(context.Background(), store, class, obj)
+		require.Nil(t, err)
+		assert.Equal(t, "c29tZSBwZGY=", obj.Properties.(map[string]interface{})["file"])
+		restore()
+		assert.Equal(t, "blob://"+key, obj.Properties.(map[string]interface{})["file"])
+		assert.Equal(t, "report", obj.Properties.(map[string]interface{})["title"])
+	})
+}
+
+type fakeBlobStore struct {
+	blobs map[string][]byte
+}
+
+func (f *fakeBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	f.blobs[key] = data
+	return nil
+}
+
+func (f *fakeBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := f.blobs[key]
+	if !ok {
+		return nil, ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, ok := f.blobs[key]
+	return ok, nil
+}
+
+func (f *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	delete(f.blobs, key)
+	return nil
+}
+
+func (f *fakeBlobStore) DeletePrefix(ctx context.Context, prefix string) error {
+	for key := range f.blobs {
+		if strings.HasPrefix(key, prefix) {
+			delete(f.blobs, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// EncodeCursor returns the opaque token which continues a listing over all
+// classes right after object id of the given class. Classes are visited in
+// lexical order and the objects of each class in UUID order.
+func EncodeCursor(class string, id strfmt.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(class + "/" + id.String()))
+}
+
+// decodeCursor is the inverse of EncodeCursor. The nil UUID is accepted as
+// the token starting a listing from the very first object.
+func decodeCursor(token string) (class string, id strfmt.UUID, err error) {
+	if token == uuid.Nil.String() {
+		return "", "", nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", "", NewErrInvalidUserInput("list objects: invalid after cursor %q", token)
+	}
+	class, rawID, ok := strings.Cut(string(b), "/")
+	if !ok || class == "" {
+		return "", "", NewErrInvalidUserInput("list objects: invalid after cursor %q", token)
+	}
+	if _, err := uuid.Parse(rawID); err != nil {
+		return "", "", NewErrInvalidUserInput("list objects: invalid after cursor %q: %v", token, err)
+	}
+	return class, strfmt.UUID(rawID), nil
+}
+
+// getObjectsAfter lists up to limit objects across all classes following
+// the position encoded by the after token. Each class is read with the
+// same UUID-ordered cursor used by class-specific listings, so no page
+// requires scanning the objects already returned.
+func (m *Manager) getObjectsAfter(ctx context.Context, principal *models.Principal,
+	after string, limit int, addl additional.Properties, tenant string,
+) (search.Results, error) {
+	afterClass, afterID, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	sch, err := m.schemaManager.GetConsistentSchema(principal, false)
+	if err != nil {
+		return nil, NewErrInternal("list objects: %v", err)
+	}
+	var classes []string
+	if sch.Objects != nil {
+		for _, c := range sch.Objects.Classes {
+			// a tenant can only be served by multi-tenant classes and vice versa
+			if schema.MultiTenancyEnabled(c) != (tenant != "") {
+				continue
+			}
+			if c.Class >= afterClass {
+				classes = append(classes, c.Class)
+			}
+		}
+	}
+	slices.Sort(classes)
+
+	var res search.Results
+	for _, class := range classes {
+		cursor := &filters.Cursor{Limit: limit - len(res)}
+		if class == afterClass {
+			cursor.After = afterID.String()
+		}
+		page, qerr := m.vectorRepo.Query(ctx, &QueryInput{
+			Class:      class,
+			Limit:      cursor.Limit,
+			Cursor:     cursor,
+			Tenant:     tenant,
+			Additional: addl,
+		})
+		if qerr != nil {
+			switch qerr.Code {
+			case StatusNotFound, StatusUnprocessableEntity:
+				// class dropped in the meantime or tenant not part of it
+				continue
+			default:
+				return nil, NewErrInternal("list objects: %v", qerr)
+			}
+		}
+		res = append(res, page...)
+		if len(res) >= limit {
+			break
+		}
+	}
+	return res, nil
+}
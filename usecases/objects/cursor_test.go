@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	id := strfmt.UUID("8a0c9c4e-4d2e-4f0e-9d1c-3a3b3c3d3e3f")
+	class, got, err := decodeCursor(EncodeCursor("Article", id))
+	require.Nil(t, err)
+	assert.Equal(t, "Article", class)
+	assert.Equal(t, id, got)
+
+	class, got, err = decodeCursor(uuid.Nil.String())
+	require.Nil(t, err)
+	assert.Equal(t, "", class)
+	assert.Equal(t, strfmt.UUID(""), got)
+
+	for _, token := range []string{"%%%", EncodeCursor("", id), "QXJ0aWNsZS9ub3QtYS11dWlk"} {
+		_, _, err = decodeCursor(token)
+		assert.IsType(t, ErrInvalidUserInput{}, err, token)
+	}
+}
+
+func TestGetObjectsAfter(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		idA  = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+		idB1 = strfmt.UUID("00000000-0000-0000-0000-0000000000b1")
+		idB2 = strfmt.UUID("00000000-0000-0000-0000-0000000000b2")
+		sch  = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+			{Class: "B"},
+			{Class: "Tenanted", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true}},
+			{Class: "A"},
+		}}}
+	)
+	query := func(class, after string, limit int) *QueryInput {
+		return &QueryInput{
+			Class:  class,
+			Limit:  limit,
+			Cursor: &filters.Cursor{After: after, Limit: limit},
+		}
+	}
+
+	t.Run("first page spans classes", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Query", query("A", "", 2)).
+			Return([]search.Result{{ClassName: "A", ID: idA}}, (*Error)(nil)).Once()
+		m.repo.On("Query", query("B", "", 1)).
+			Return([]search.Result{{ClassName: "B", ID: idB1}}, (*Error)(nil)).Once()
+
+		res, err := m.GetObjects(ctx, nil, nil, ptInt64(2), nil, nil, ptString(uuid.Nil.String()), additional.Properties{}, "")
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, idA, res[0].ID)
+		assert.Equal(t, idB1, res[1].ID)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("next page resumes within class", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Query", query("B", string(idB1), 2)).
+			Return([]search.Result{{ClassName: "B", ID: idB2}}, (*Error)(nil)).Once()
+
+		res, err := m.GetObjects(ctx, nil, nil, ptInt64(2), nil, nil, ptString(EncodeCursor("B", idB1)), additional.Properties{}, "")
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, idB2, res[0].ID)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("tenant only visits multi-tenant classes", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		in := query("Tenanted", "", 2)
+		in.Tenant = "t1"
+		m.repo.On("Query", in).Return([]search.Result{}, (*Error)(nil)).Once()
+
+		res, err := m.GetObjects(ctx, nil, nil, ptInt64(2), nil, nil, ptString(uuid.Nil.String()), additional.Properties{}, "t1")
+		require.Nil(t, err)
+		assert.Empty(t, res)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("offset not allowed", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		_, err := m.GetObjects(ctx, nil, ptInt64(1), ptInt64(2), nil, nil, ptString(uuid.Nil.String()), additional.Properties{}, "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
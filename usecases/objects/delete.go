@@ -79,6 +79,8 @@ func (m *Manager) DeleteObject(ctx context.Context,
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
 
+	deleteObjectBlobs(m.Blobs, m.logger, class, tenant, id)
+	m.Events.Publish(Event{Type: EventDelete, Class: class, Tenant: tenant, ID: id})
 	return nil
 }
 
@@ -109,6 +111,8 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID, dele
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
 		}
+		deleteObjectBlobs(m.Blobs, m.logger, object.Class, object.Tenant, id)
+		m.Events.Publish(Event{Type: EventDelete, Class: object.Class, ID: id})
 		deleteCounter++
 	}
 }
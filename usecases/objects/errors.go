@@ -12,7 +12,10 @@
 package objects
 
 import (
+	"errors"
 	"fmt"
+
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
 // objects status code
@@ -20,6 +23,7 @@ const (
 	StatusForbidden           = 403
 	StatusBadRequest          = 400
 	StatusNotFound            = 404
+	StatusPreconditionFailed  = 412
 	StatusUnprocessableEntity = 422
 	StatusInternalServerError = 500
 )
@@ -56,29 +60,56 @@ func (e *Error) UnprocessableEntity() bool {
 	return e.Code == StatusUnprocessableEntity
 }
 
+func (e *Error) PreconditionFailed() bool {
+	return e.Code == StatusPreconditionFailed
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
+	// err is only set if handlers need to tell the cause apart
+	err error
 }
 
 func (e ErrInvalidUserInput) Error() string {
 	return e.msg
 }
 
+func (e ErrInvalidUserInput) Unwrap() error {
+	return e.err
+}
+
 // NewErrInvalidUserInput with Errorf signature
 func NewErrInvalidUserInput(format string, args ...interface{}) ErrInvalidUserInput {
 	return ErrInvalidUserInput{msg: fmt.Sprintf(format, args...)}
 }
 
+// newErrInvalidObject wraps the error of validating an object. Writes to
+// deprecated properties stay unwrappable, so that handlers can warn about
+// them.
+func newErrInvalidObject(err error) ErrInvalidUserInput {
+	e := NewErrInvalidUserInput("invalid object: %v", err)
+	if errors.As(err, &validation.ErrDeprecatedProperty{}) {
+		e.err = err
+	}
+	return e
+}
+
 // ErrInternal indicates something went wrong during processing
 type ErrInternal struct {
 	msg string
+	// err is only set if handlers need to tell the cause apart
+	err error
 }
 
 func (e ErrInternal) Error() string {
 	return e.msg
 }
 
+func (e ErrInternal) Unwrap() error {
+	return e.err
+}
+
 // NewErrInternal with Errorf signature
 func NewErrInternal(format string, args ...interface{}) ErrInternal {
 	return ErrInternal{msg: fmt.Sprintf(format, args...)}
@@ -98,6 +129,32 @@ func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
 
+// ErrTooManyBatchJobs indicates that a batch job can't be started before one
+// of the running jobs finished
+type ErrTooManyBatchJobs struct {
+	max int
+}
+
+func (e ErrTooManyBatchJobs) Error() string {
+	return fmt.Sprintf("%d batch jobs are running already, retry once one of them finished", e.max)
+}
+
+// ErrObjectChanged indicates that the precondition of a conditional update
+// does not hold, because the object was updated since the version the
+// caller based its update on, see ContextWithIfMatch
+type ErrObjectChanged struct {
+	msg string
+}
+
+func (e ErrObjectChanged) Error() string {
+	return e.msg
+}
+
+// NewErrObjectChanged with Errorf signature
+func NewErrObjectChanged(format string, args ...interface{}) ErrObjectChanged {
+	return ErrObjectChanged{msg: fmt.Sprintf(format, args...)}
+}
+
 type ErrMultiTenancy struct {
 	err error
 }
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// EventCreate is published when an object is added. Batch imports are
+	// always published as EventCreate, as they do not distinguish between
+	// new and replaced objects.
+	EventCreate = "create"
+	// EventUpdate is published when an object is replaced or merged
+	EventUpdate = "update"
+	// EventDelete is published when an object is deleted
+	EventDelete = "delete"
+	// EventExpire is published when an object is deleted because its
+	// expiresAtUnix passed. It is published by every node holding a replica.
+	EventExpire = "expire"
+
+	// EventClassAdd is published when a class is added to the schema
+	EventClassAdd = "class_add"
+	// EventClassDelete is published when a class is removed from the schema
+	EventClassDelete = "class_delete"
+	// EventPropertyAdd is published when a property is added to a class
+	EventPropertyAdd = "property_add"
+)
+
+// Event describes a change of a single object or of the schema. Object is
+// nil for EventDelete, EventExpire and all schema events. Schema events carry the hash
+// of the schema after the change and, for EventPropertyAdd, the property.
+type Event struct {
+	Type       string
+	Class      string
+	Tenant     string
+	ID         strfmt.UUID
+	Object     *models.Object
+	Property   *models.Property
+	SchemaHash string
+}
+
+// IsSchemaEvent is true for changes of the schema rather than of an object
+func (ev Event) IsSchemaEvent() bool {
+	switch ev.Type {
+	case EventClassAdd, EventClassDelete, EventPropertyAdd:
+		return true
+	default:
+		return false
+	}
+}
+
+// Events fans out object changes made through this node to all current
+// subscribers. Publishing never blocks: a subscriber which does not keep up
+// misses the events that do not fit into its buffer. A nil *Events discards
+// all events.
+type Events struct {
+	sync.RWMutex
+	subscribers map[int]chan Event
+	next        int
+}
+
+// NewEvents returns an Events without subscribers
+func NewEvents() *Events {
+	return &Events{subscribers: map[int]chan Event{}}
+}
+
+// Subscribe returns a channel receiving all events published from now on
+// and a function to end the subscription, which closes the channel.
+func (e *Events) Subscribe(buffer int) (<-chan Event, func()) {
+	ch := make(chan Event, buffer)
+
+	e.Lock()
+	id := e.next
+	e.next++
+	e.subscribers[id] = ch
+	e.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			e.Lock()
+			delete(e.subscribers, id)
+			e.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Publish sends ev to all subscribers
+func (e *Events) Publish(ev Event) {
+	if e == nil {
+		return
+	}
+
+	e.RLock()
+	defer e.RUnlock()
+
+	for _, ch := range e.subscribers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (e *Events) publishObject(typ string, obj *models.Object) {
+	if e == nil || obj == nil {
+		return
+	}
+	e.Publish(Event{
+		Type:   typ,
+		Class:  obj.Class,
+		Tenant: obj.Tenant,
+		ID:     obj.ID,
+		Object: obj,
+	})
+}
+
+// SchemaChanges returns a callback to be notified of every schema update.
+// It publishes the classes added or removed and the properties added since
+// the previous call. The first call only records the initial schema.
+func (e *Events) SchemaChanges() func(schema.Schema) {
+	var (
+		mu       sync.Mutex
+		previous map[string]*models.Class
+	)
+	return func(s schema.Schema) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		current := map[string]*models.Class{}
+		if s.Objects != nil {
+			for _, class := range s.Objects.Classes {
+				current[class.Class] = class
+			}
+		}
+		if previous == nil {
+			previous = current
+			return
+		}
+
+		hash := schema.Hash(s.Objects)
+		for name, class := range current {
+			old, ok := previous[name]
+			if !ok {
+				e.Publish(Event{Type: EventClassAdd, Class: name, SchemaHash: hash})
+				continue
+			}
+			for _, prop := range class.Properties {
+				if _, err := schema.GetPropertyByName(old, prop.Name); err != nil {
+					e.Publish(Event{Type: EventPropertyAdd, Class: name, Property: prop, SchemaHash: hash})
+				}
+			}
+		}
+		for name := range previous {
+			if _, ok := current[name]; !ok {
+				e.Publish(Event{Type: EventClassDelete, Class: name, SchemaHash: hash})
+			}
+		}
+		previous = current
+	}
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestEvents(t *testing.T) {
+	events := NewEvents()
+	first, cancelFirst := events.Subscribe(2)
+	second, cancelSecond := events.Subscribe(1)
+	defer cancelSecond()
+
+	events.publishObject(EventCreate, &models.Object{Class: "Article", ID: "1", Tenant: "t1"})
+	events.Publish(Event{Type: EventDelete, Class: "Article", ID: "2"})
+
+	ev := <-first
+	assert.Equal(t, EventCreate, ev.Type)
+	assert.Equal(t, "Article", ev.Class)
+	assert.Equal(t, "t1", ev.Tenant)
+	require.NotNil(t, ev.Object)
+	ev = <-first
+	assert.Equal(t, EventDelete, ev.Type)
+
+	ev = <-second
+	assert.Equal(t, EventCreate, ev.Type)
+	assert.Len(t, second, 0, "events exceeding the buffer are dropped")
+
+	cancelFirst()
+	cancelFirst()
+	_, ok := <-first
+	assert.False(t, ok, "channel is closed after cancel")
+	events.Publish(Event{Type: EventDelete, Class: "Article", ID: "3"})
+}
+
+func TestEventsNil(t *testing.T) {
+	var events *Events
+	events.Publish(Event{Type: EventDelete})
+	events.publishObject(EventCreate, &models.Object{})
+}
+
+func TestEventsSchemaChanges(t *testing.T) {
+	events := NewEvents()
+	ch, cancel := events.Subscribe(10)
+	defer cancel()
+
+	onUpdate := events.SchemaChanges()
+	onUpdate(schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{Class: "Article"},
+		{Class: "Author"},
+	}}})
+	assert.Len(t, ch, 0, "the initial schema is not published")
+
+	updated := &models.Schema{Classes: []*models.Class{
+		{Class: "Article", Properties: []*models.Property{{Name: "title"}}},
+		{Class: "Journal"},
+	}}
+	onUpdate(schema.Schema{Objects: updated})
+	require.Len(t, ch, 3)
+
+	got := map[string]Event{}
+	for i := 0; i < 3; i++ {
+		ev := <-ch
+		assert.True(t, ev.IsSchemaEvent())
+		assert.Equal(t, schema.Hash(updated), ev.SchemaHash)
+		got[ev.Type] = ev
+	}
+	assert.Equal(t, "Article", got[EventPropertyAdd].Class)
+	assert.Equal(t, "title", got[EventPropertyAdd].Property.Name)
+	assert.Equal(t, "Journal", got[EventClassAdd].Class)
+	assert.Equal(t, "Author", got[EventClassDelete].Class)
+
+	onUpdate(schema.Schema{Objects: updated})
+	assert.Len(t, ch, 0, "unchanged schema is not published")
+}
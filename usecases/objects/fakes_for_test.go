@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/dto"
@@ -35,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/entities/versioned"
 )
@@ -51,6 +53,11 @@ type fakeSchemaManager struct {
 	GetSchemaResponse schema.Schema
 	GetschemaErr      error
 	tenantsEnabled    bool
+	tenants           []*models.Tenant
+	shardStatus       map[string]string // by class, every class has a single shard
+	shardStatusLock   sync.Mutex
+	vectorDimensions  map[string]map[string]int // by class
+	vectorDimsLock    sync.Mutex
 }
 
 func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
@@ -171,6 +178,68 @@ func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *mod
 	return class, 0, nil
 }
 
+func (f *fakeSchemaManager) UpdatePropertyLifecycle(ctx context.Context, principal *models.Principal,
+	class, prop, lifecycle string,
+) (*models.Property, uint64, error) {
+	for _, p := range f.ReadOnlyClass(class).Properties {
+		if p.Name == prop {
+			p.Lifecycle = lifecycle
+			return p, 0, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("property %q of class %q does not exist", prop, class)
+}
+
+func (f *fakeSchemaManager) SetVectorDimensions(ctx context.Context, class string,
+	dims map[string]int,
+) (uint64, error) {
+	f.vectorDimsLock.Lock()
+	defer f.vectorDimsLock.Unlock()
+
+	if f.vectorDimensions == nil {
+		f.vectorDimensions = map[string]map[string]int{}
+	}
+	f.vectorDimensions[class] = dims
+	return 0, nil
+}
+
+func (f *fakeSchemaManager) DeleteClass(ctx context.Context, principal *models.Principal,
+	class string,
+) error {
+	classes := f.GetSchemaResponse.Objects.Classes
+	for i, c := range classes {
+		if c.Class == class {
+			f.GetSchemaResponse.Objects.Classes = append(classes[:i:i], classes[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("class %q does not exist", class)
+}
+
+func (f *fakeSchemaManager) ShardsStatus(ctx context.Context, principal *models.Principal,
+	class, tenant string,
+) (models.ShardStatusList, error) {
+	f.shardStatusLock.Lock()
+	defer f.shardStatusLock.Unlock()
+	status := f.shardStatus[class]
+	if status == "" {
+		status = storagestate.StatusReady.String()
+	}
+	return models.ShardStatusList{{Name: "shard0", Status: status}}, nil
+}
+
+func (f *fakeSchemaManager) UpdateShardStatus(ctx context.Context, principal *models.Principal,
+	class, shard, status string,
+) (uint64, error) {
+	f.shardStatusLock.Lock()
+	defer f.shardStatusLock.Unlock()
+	if f.shardStatus == nil {
+		f.shardStatus = map[string]string{}
+	}
+	f.shardStatus[class] = status
+	return 0, nil
+}
+
 func (f *fakeSchemaManager) AddTenants(ctx context.Context,
 	principal *models.Principal, class string, tenants []*models.Tenant,
 ) (uint64, error) {
@@ -182,6 +251,12 @@ func (f *fakeSchemaManager) MultiTenancy(class string) models.MultiTenancyConfig
 	return models.MultiTenancyConfig{Enabled: f.tenantsEnabled}
 }
 
+func (f *fakeSchemaManager) GetTenants(ctx context.Context, principal *models.Principal,
+	class string,
+) ([]*models.Tenant, error) {
+	return f.tenants, nil
+}
+
 func (f *fakeSchemaManager) WaitForUpdate(ctx context.Context, schemaVersion uint64) error {
 	return nil
 }
@@ -246,6 +321,35 @@ func (f *fakeVectorRepo) Query(ctx context.Context, q *QueryInput) (search.Resul
 	return res, err
 }
 
+func (f *fakeVectorRepo) TrashedObjects(ctx context.Context, class, tenant string,
+) ([]*search.Result, error) {
+	args := f.Called(class, tenant)
+	if args.Get(0) != nil {
+		return args.Get(0).([]*search.Result), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (f *fakeVectorRepo) ObjectHistory(ctx context.Context, class string, id strfmt.UUID,
+	tenant string,
+) ([]*search.Result, error) {
+	args := f.Called(class, id, tenant)
+	if args.Get(0) != nil {
+		return args.Get(0).([]*search.Result), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (f *fakeVectorRepo) TrashedObject(ctx context.Context, class string, id strfmt.UUID,
+	tenant string,
+) (*search.Result, error) {
+	args := f.Called(class, id, tenant)
+	if args.Get(0) != nil {
+		return args.Get(0).(*search.Result), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (f *fakeVectorRepo) PutObject(ctx context.Context, concept *models.Object, vector []float32,
 	vectors models.Vectors, repl *additional.ReplicationProperties, schemaVersion uint64,
 ) error {
@@ -360,6 +464,7 @@ type fakeModulesProvider struct {
 	mock.Mock
 	customExtender  *fakeExtender
 	customProjector *fakeProjector
+	chunkObject     func(class *models.Class, object *models.Object) ([]*models.Object, error)
 }
 
 func (p *fakeModulesProvider) GetObjectAdditionalExtend(ctx context.Context,
@@ -423,6 +528,15 @@ func (p *fakeModulesProvider) VectorizerName(className string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+func (p *fakeModulesProvider) ChunkObject(ctx context.Context, class *models.Class,
+	object *models.Object,
+) ([]*models.Object, error) {
+	if p.chunkObject == nil {
+		return nil, nil
+	}
+	return p.chunkObject(class, object)
+}
+
 func (p *fakeModulesProvider) additionalExtend(ctx context.Context,
 	in search.Results, moduleParams map[string]interface{}, capability string,
 ) (search.Results, error) {
@@ -801,7 +915,7 @@ func getFakeModulesProviderWithCustomExtenders(
 	customProjector *fakeProjector,
 	opts ...func(provider *fakeModulesProvider),
 ) *fakeModulesProvider {
-	p := &fakeModulesProvider{mock.Mock{}, customExtender, customProjector}
+	p := &fakeModulesProvider{Mock: mock.Mock{}, customExtender: customExtender, customProjector: customProjector}
 	p.applyOptions(opts...)
 	return p
 }
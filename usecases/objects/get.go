@@ -74,7 +74,7 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
-	return m.getObjectsFromRepo(ctx, offset, limit, sort, order, after, addl, tenant)
+	return m.getObjectsFromRepo(ctx, principal, offset, limit, sort, order, after, addl, tenant)
 }
 
 func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Principal,
@@ -140,7 +140,7 @@ func (m *Manager) getObjectFromRepo(ctx context.Context, class string, id strfmt
 	return res, nil
 }
 
-func (m *Manager) getObjectsFromRepo(ctx context.Context,
+func (m *Manager) getObjectsFromRepo(ctx context.Context, principal *models.Principal,
 	offset, limit *int64, sort, order *string, after *string,
 	additional additional.Properties, tenant string,
 ) ([]*models.Object, error) {
@@ -148,13 +148,21 @@ func (m *Manager) getObjectsFromRepo(ctx context.Context,
 	if err != nil {
 		return nil, NewErrInternal("list objects: %v", err)
 	}
+	var res search.Results
 	if after != nil {
-		return nil, NewErrInternal("list objects: after parameter not allowed, cursor must be specific to one class, set class query param")
-	}
-	res, err := m.vectorRepo.ObjectSearch(ctx, smartOffset, smartLimit,
-		nil, m.getSort(sort, order), additional, tenant)
-	if err != nil {
-		return nil, NewErrInternal("list objects: %v", err)
+		if smartOffset > 0 || sort != nil {
+			return nil, NewErrInvalidUserInput("list objects: offset and sort cannot be set with after parameter")
+		}
+		res, err = m.getObjectsAfter(ctx, principal, *after, smartLimit, additional, tenant)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res, err = m.vectorRepo.ObjectSearch(ctx, smartOffset, smartLimit,
+			nil, m.getSort(sort, order), additional, tenant)
+		if err != nil {
+			return nil, NewErrInternal("list objects: %v", err)
+		}
 	}
 
 	if m.modulesProvider != nil {
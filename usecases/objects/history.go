@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// GetObjectHistory lists the prior versions of an object, latest first. Only
+// the last OBJECT_HISTORY_VERSIONS versions are kept, and they are dropped
+// when the object is deleted.
+func (m *Manager) GetObjectHistory(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, includeVector bool, tenant string,
+) ([]*models.Object, error) {
+	err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, id))
+	if err != nil {
+		return nil, err
+	}
+
+	if m.config.Config.ObjectHistoryVersions <= 0 {
+		return nil, NewErrInvalidUserInput("object history is disabled, " +
+			"set OBJECT_HISTORY_VERSIONS to keep prior versions of objects")
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	res, err := m.vectorRepo.ObjectHistory(ctx, class, id, tenant)
+	if err != nil {
+		var e1 ErrMultiTenancy
+		if errors.As(err, &e1) {
+			return nil, e1
+		}
+		var e2 ErrInvalidUserInput
+		if errors.As(err, &e2) {
+			return nil, e2
+		}
+		return nil, NewErrInternal("could not search history: %v", err)
+	}
+
+	out := make([]*models.Object, len(res))
+	for i := range res {
+		out[i] = res[i].ObjectWithVector(includeVector)
+	}
+	return out, nil
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_ObjectHistory(t *testing.T) {
+	var (
+		cls = "MyClass"
+		id  = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+				},
+			},
+		},
+	}
+	version := func(updated int64) *search.Result {
+		return &search.Result{
+			ID:        id,
+			ClassName: cls,
+			Schema:    map[string]interface{}{"foo": "bar"},
+			Vector:    []float32{1, 2, 3},
+			Created:   100,
+			Updated:   updated,
+		}
+	}
+	newManager := func(versions int) fakeGetManager {
+		m := newFakeGetManager(sch)
+		m.config.Config.ObjectHistoryVersions = versions
+		return m
+	}
+
+	t.Run("history disabled", func(t *testing.T) {
+		m := newManager(0)
+
+		_, err := m.GetObjectHistory(context.Background(), nil, cls, id, false, "")
+		assert.True(t, errors.As(err, &ErrInvalidUserInput{}))
+		m.repo.AssertNotCalled(t, "ObjectHistory", cls, id, "")
+	})
+
+	t.Run("list history", func(t *testing.T) {
+		m := newManager(2)
+		m.repo.On("ObjectHistory", cls, id, "").
+			Return([]*search.Result{version(300), version(200)}, nil).Once()
+
+		res, err := m.GetObjectHistory(context.Background(), nil, cls, id, true, "")
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, int64(300), res[0].LastUpdateTimeUnix)
+		assert.Equal(t, int64(200), res[1].LastUpdateTimeUnix)
+		assert.Equal(t, []float32{1, 2, 3}, []float32(res[0].Vector))
+	})
+
+	t.Run("invalid tenant", func(t *testing.T) {
+		m := newManager(2)
+		m.repo.On("ObjectHistory", cls, id, "foo").
+			Return(nil, NewErrMultiTenancy(errors.New("no tenant"))).Once()
+
+		_, err := m.GetObjectHistory(context.Background(), nil, cls, id, false, "foo")
+		assert.True(t, errors.As(err, &ErrMultiTenancy{}))
+	})
+}
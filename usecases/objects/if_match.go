@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// IfMatch is the precondition of an If-Match header (RFC 9110, section
+// 13.1.1). The entity tag of an object is its quoted lastUpdateTimeUnix,
+// see ETag.
+type IfMatch struct {
+	// Any is set for "*", which matches any existing object
+	Any bool
+	// Tags are the opaque tags of the strong entity tags of the header. Weak
+	// tags are dropped, as If-Match uses the strong comparison.
+	Tags []string
+}
+
+// ETag returns the entity tag of an object last updated at version
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseIfMatch parses the value of an If-Match header. A header which is not
+// a valid list of entity tags can't match any object, so it's returned as an
+// IfMatch without tags.
+func ParseIfMatch(header string) IfMatch {
+	s := strings.TrimSpace(header)
+	if s == "*" {
+		return IfMatch{Any: true}
+	}
+
+	var out IfMatch
+	for {
+		s = strings.TrimLeft(s, " \t,")
+		if s == "" {
+			return out
+		}
+		weak := strings.HasPrefix(s, "W/")
+		if weak {
+			s = s[2:]
+		}
+		tag, rest, ok := cutOpaqueTag(s)
+		if !ok {
+			return IfMatch{}
+		}
+		if !weak {
+			out.Tags = append(out.Tags, tag)
+		}
+		if s = strings.TrimLeft(rest, " \t"); s != "" && s[0] != ',' {
+			return IfMatch{}
+		}
+	}
+}
+
+// cutOpaqueTag cuts the quoted opaque tag s starts with
+func cutOpaqueTag(s string) (tag, rest string, ok bool) {
+	if s == "" || s[0] != '"' {
+		return "", "", false
+	}
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"':
+			return s[1:i], s[i+1:], true
+		case c == 0x21, c >= 0x23 && c <= 0x7e, c >= 0x80:
+			// etagc
+		default:
+			return "", "", false
+		}
+	}
+	return "", "", false
+}
+
+// Matches reports whether an existing object last updated at version meets
+// the precondition
+func (m IfMatch) Matches(version int64) bool {
+	if m.Any {
+		return true
+	}
+	etag := strconv.FormatInt(version, 10)
+	for _, tag := range m.Tags {
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the precondition as header value, so that it can be
+// passed on to the nodes owning the object
+func (m IfMatch) String() string {
+	if m.Any {
+		return "*"
+	}
+	tags := make([]string, len(m.Tags))
+	for i, tag := range m.Tags {
+		tags[i] = `"` + tag + `"`
+	}
+	return strings.Join(tags, ", ")
+}
+
+type ifMatchKey struct{}
+
+// ContextWithIfMatch returns a context for updates of an object which must
+// only be applied if the object meets the precondition. Otherwise the update
+// fails with an ErrObjectChanged, so that the caller can fetch the object
+// again and retry.
+//
+// The precondition is checked by the shard while it holds the lock of the
+// object, so two updates based on the same version can't both succeed, no
+// matter which nodes they are sent to.
+func ContextWithIfMatch(ctx context.Context, m IfMatch) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, m)
+}
+
+// IfMatchFromContext returns the precondition of a conditional update
+func IfMatchFromContext(ctx context.Context) (IfMatch, bool) {
+	m, ok := ctx.Value(ifMatchKey{}).(IfMatch)
+	return m, ok
+}
+
+// CheckIfMatch fails if ctx carries a precondition which the object does not
+// meet. exists is false if the object does not exist (anymore).
+func CheckIfMatch(ctx context.Context, id strfmt.UUID, exists bool, version int64) error {
+	m, ok := IfMatchFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if !exists {
+		return NewErrObjectChanged("object %s changed: it does not exist", id)
+	}
+	if !m.Matches(version) {
+		return NewErrObjectChanged("object %s changed: its version %s does not match %s",
+			id, ETag(version), m)
+	}
+	return nil
+}
+
+// nextUpdateTime returns the time of an update of an object last updated at
+// prev. It's always later than prev, so that every update changes the
+// version of the object even if the clock did not advance.
+func nextUpdateTime(ts timeSource, prev int64) int64 {
+	if now := ts.Now(); now > prev {
+		return now
+	}
+	return prev + 1
+}
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_IfMatch(t *testing.T) {
+	var (
+		cls = "MyClass"
+		id  = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+		// see fakeTimeSource
+		now int64 = 12345
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					Properties: []*models.Property{
+						{
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+							Name:         "foo",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	newManager := func(lastUpdate int64) fakeGetManager {
+		m := newFakeGetManager(sch)
+		m.timeSource = fakeTimeSource{}
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(&search.Result{
+			ID:        id,
+			ClassName: cls,
+			Schema:    map[string]interface{}{"foo": "bar"},
+			Created:   lastUpdate,
+			Updated:   lastUpdate,
+		}, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		return m
+	}
+	payload := func() *models.Object {
+		return &models.Object{
+			Class:      cls,
+			ID:         id,
+			Properties: map[string]interface{}{"foo": "baz"},
+		}
+	}
+
+	t.Run("update without expected version", func(t *testing.T) {
+		m := newManager(100)
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := m.UpdateObject(context.Background(), nil, cls, id, payload(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, now, res.LastUpdateTimeUnix)
+	})
+
+	t.Run("update with current version", func(t *testing.T) {
+		m := newManager(100)
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		ctx := ContextWithIfMatch(context.Background(), ParseIfMatch(`"100"`))
+		res, err := m.UpdateObject(ctx, nil, cls, id, payload(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, now, res.LastUpdateTimeUnix)
+	})
+
+	t.Run("update with outdated version", func(t *testing.T) {
+		m := newManager(100)
+
+		ctx := ContextWithIfMatch(context.Background(), ParseIfMatch(`"99"`))
+		_, err := m.UpdateObject(ctx, nil, cls, id, payload(), nil)
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &ErrObjectChanged{}))
+		m.repo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("version changes although the clock did not advance", func(t *testing.T) {
+		m := newManager(now)
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := m.UpdateObject(context.Background(), nil, cls, id, payload(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, now+1, res.LastUpdateTimeUnix)
+	})
+
+	t.Run("merge with current version", func(t *testing.T) {
+		m := newManager(100)
+		m.repo.On("Merge", mock.Anything).Return(nil).Once()
+
+		ctx := ContextWithIfMatch(context.Background(), ParseIfMatch(`"100"`))
+		err := m.MergeObject(ctx, nil, payload(), nil)
+		require.Nil(t, err)
+	})
+
+	t.Run("merge with outdated version", func(t *testing.T) {
+		m := newManager(100)
+
+		ctx := ContextWithIfMatch(context.Background(), ParseIfMatch(`"99"`))
+		err := m.MergeObject(ctx, nil, payload(), nil)
+		require.NotNil(t, err)
+		assert.True(t, err.PreconditionFailed())
+		assert.True(t, errors.As(err, &ErrObjectChanged{}))
+		m.repo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+}
+
+func Test_ParseIfMatch(t *testing.T) {
+	for _, tc := range []struct {
+		header  string
+		matches map[int64]bool
+	}{
+		{`*`, map[int64]bool{1: true, 100: true}},
+		{`"100"`, map[int64]bool{100: true, 1: false}},
+		{` "1" ,"100", `, map[int64]bool{100: true, 1: true, 10: false}},
+		{`"1,00", "2"`, map[int64]bool{100: false, 2: true}},
+		// weak tags never match with the strong comparison
+		{`W/"100"`, map[int64]bool{100: false}},
+		{`W/"100", "2"`, map[int64]bool{100: false, 2: true}},
+		// invalid headers can't match any object
+		{`100`, map[int64]bool{100: false}},
+		{`"100`, map[int64]bool{100: false}},
+		{`"100" "2"`, map[int64]bool{100: false, 2: false}},
+		{`"1 00"`, map[int64]bool{100: false}},
+		{`*, "100"`, map[int64]bool{100: false}},
+	} {
+		t.Run(tc.header, func(t *testing.T) {
+			m := ParseIfMatch(tc.header)
+			for version, want := range tc.matches {
+				assert.Equal(t, want, m.Matches(version), version)
+			}
+			assert.Equal(t, m, ParseIfMatch(m.String()))
+		})
+	}
+}
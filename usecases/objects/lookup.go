@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// LookupObject returns the object of a class whose property is equal to
+// value. The lookup runs an Equal filter through the inverted index of the
+// property, just like a Get query with a where filter. Text properties need
+// field tokenization, as an Equal filter on any other tokenization matches
+// every object which contains the tokens of value. Properties are not
+// unique, so a value which matches more than one object is rejected.
+func (m *Manager) LookupObject(ctx context.Context, principal *models.Principal,
+	class, property, value string, additional additional.Properties, tenant string,
+) (*models.Object, error) {
+	err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := m.lookupFilter(class, property, value)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	// one more than needed, to tell whether the value is unique
+	res, rerr := m.vectorRepo.Query(ctx, &QueryInput{
+		Class:      class,
+		Limit:      2,
+		Filters:    filter,
+		Tenant:     tenant,
+		Additional: additional,
+	})
+	if rerr != nil {
+		switch rerr.Code {
+		case StatusNotFound:
+			return nil, NewErrNotFound("%s", rerr.Msg)
+		case StatusBadRequest, StatusUnprocessableEntity:
+			return nil, NewErrInvalidUserInput("%v", rerr)
+		default:
+			return nil, NewErrInternal("lookup object: %v", rerr)
+		}
+	}
+
+	switch len(res) {
+	case 0:
+		return nil, NewErrNotFound("no object of class %q with %s %q", class, property, value)
+	case 1:
+	default:
+		return nil, NewErrInvalidUserInput("%s %q matches more than one object of class %q",
+			property, value, class)
+	}
+
+	if additional.Vector {
+		m.trackUsageSingle(&res[0])
+	}
+
+	return res[0].ObjectWithVector(additional.Vector), nil
+}
+
+// lookupFilter parses value according to the data type of the property and
+// builds an Equal filter on it
+func (m *Manager) lookupFilter(className, property, value string) (*filters.LocalFilter, error) {
+	class := m.schemaManager.ReadOnlyClass(className)
+	if class == nil {
+		return nil, NewErrNotFound("class %q not found", className)
+	}
+	prop, err := schema.GetPropertyByName(class, property)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("%v", err)
+	}
+
+	dt, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		return nil, NewErrInvalidUserInput("property %q of type %v does not support lookups",
+			property, prop.DataType)
+	}
+	if baseType, ok := schema.IsArrayType(dt); ok {
+		dt = baseType
+	}
+
+	where := &models.WhereFilter{
+		Operator: models.WhereFilterOperatorEqual,
+		Path:     []string{property},
+	}
+	switch dt {
+	case schema.DataTypeText, schema.DataTypeString:
+		if prop.Tokenization != models.PropertyTokenizationField {
+			return nil, NewErrInvalidUserInput("property %q needs %s tokenization for lookups, has %q",
+				property, models.PropertyTokenizationField, prop.Tokenization)
+		}
+		where.ValueText = &value
+	case schema.DataTypeUUID:
+		where.ValueText = &value
+	case schema.DataTypeDate:
+		where.ValueDate = &value
+	case schema.DataTypeInt:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, NewErrInvalidUserInput("value %q of int property %q: %v", value, property, err)
+		}
+		where.ValueInt = &v
+	case schema.DataTypeNumber:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, NewErrInvalidUserInput("value %q of number property %q: %v", value, property, err)
+		}
+		where.ValueNumber = &v
+	case schema.DataTypeBoolean:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, NewErrInvalidUserInput("value %q of boolean property %q: %v", value, property, err)
+		}
+		where.ValueBoolean = &v
+	default:
+		return nil, NewErrInvalidUserInput("property %q of type %v does not support lookups",
+			property, prop.DataType)
+	}
+
+	filter, err := filterext.Parse(where, class.Class)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid lookup: %v", err)
+	}
+	if err := filters.ValidateFilters(m.schemaManager.ReadOnlyClass, filter); err != nil {
+		return nil, NewErrInvalidUserInput("invalid lookup: %v", err)
+	}
+	return filter, nil
+}
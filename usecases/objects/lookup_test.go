@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func Test_LookupObject(t *testing.T) {
+	cls := "MyClass"
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: cls,
+					Properties: []*models.Property{
+						{
+							Name:         "externalId",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+						{
+							Name:         "title",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWord,
+						},
+						{Name: "number", DataType: schema.DataTypeInt.PropString()},
+						{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+					},
+				},
+			},
+		},
+	}
+
+	equal := func(prop string, value interface{}, dt schema.DataType) interface{} {
+		return mock.MatchedBy(func(q *QueryInput) bool {
+			root := q.Filters.Root
+			return q.Class == cls && q.Limit == 2 &&
+				root.Operator == filters.OperatorEqual &&
+				root.On.Property == schema.PropertyName(prop) &&
+				root.Value.Value == value && root.Value.Type == dt
+		})
+	}
+
+	t.Run("found", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Query", equal("externalId", "a1", schema.DataTypeText)).
+			Return([]search.Result{{ClassName: cls, Schema: map[string]interface{}{"externalId": "a1"}}}, (*Error)(nil)).Once()
+
+		res, err := m.LookupObject(context.Background(), nil, cls, "externalId", "a1", additional.Properties{}, "")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"externalId": "a1"}, res.Properties)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("value parsed by data type", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Query", equal("number", 42, schema.DataTypeInt)).
+			Return([]search.Result{}, (*Error)(nil)).Once()
+
+		_, err := m.LookupObject(context.Background(), nil, cls, "number", "42", additional.Properties{}, "")
+		assert.IsType(t, ErrNotFound{}, err)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("not unique", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Query", mock.Anything).
+			Return([]search.Result{{ClassName: cls}, {ClassName: cls}}, (*Error)(nil)).Once()
+
+		_, err := m.LookupObject(context.Background(), nil, cls, "externalId", "a1", additional.Properties{}, "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+		assert.ErrorContains(t, err, "matches more than one object")
+	})
+
+	t.Run("invalid lookups", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			class    string
+			property string
+			value    string
+			expected error
+		}{
+			{name: "unknown class", class: "Unknown", property: "externalId", value: "a1", expected: ErrNotFound{}},
+			{name: "unknown property", class: cls, property: "unknown", value: "a1", expected: ErrInvalidUserInput{}},
+			{name: "invalid value", class: cls, property: "number", value: "a1", expected: ErrInvalidUserInput{}},
+			{name: "unsupported type", class: cls, property: "location", value: "a1", expected: ErrInvalidUserInput{}},
+			{name: "not field tokenized", class: cls, property: "title", value: "a1", expected: ErrInvalidUserInput{}},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				m := newFakeGetManager(sch)
+				_, err := m.LookupObject(context.Background(), nil, test.class, test.property, test.value,
+					additional.Properties{}, "")
+				assert.IsType(t, test.expected, err)
+				m.repo.AssertNotCalled(t, "Query", mock.Anything)
+			})
+		}
+	})
+}
@@ -45,7 +45,17 @@ type schemaManager interface {
 	// AddClassProperty it is upsert operation. it adds properties to a class and updates
 	// existing properties if the merge bool passed true.
 	AddClassProperty(ctx context.Context, principal *models.Principal, class *models.Class, merge bool, prop ...*models.Property) (*models.Class, uint64, error)
+	// UpdatePropertyLifecycle moves a property one step along its lifecycle
+	UpdatePropertyLifecycle(ctx context.Context, principal *models.Principal, class, prop, lifecycle string) (*models.Property, uint64, error)
+	DeleteClass(ctx context.Context, principal *models.Principal, class string) error
+	// SetVectorDimensions declares the dimensions of the vectors of a class,
+	// keyed by target vector, unless they are declared already
+	SetVectorDimensions(ctx context.Context, class string, dims map[string]int) (uint64, error)
+	// ShardsStatus lists the shards of a class, or the one of a tenant
+	ShardsStatus(ctx context.Context, principal *models.Principal, class, tenant string) (models.ShardStatusList, error)
+	UpdateShardStatus(ctx context.Context, principal *models.Principal, class, shard, status string) (uint64, error)
 	MultiTenancy(class string) models.MultiTenancyConfig
+	GetTenants(ctx context.Context, principal *models.Principal, class string) ([]*models.Tenant, error)
 
 	// Consistent methods with the consistency flag.
 	// This is used to ensure that internal users will not miss-use the flag and it doesn't need to be set to a default
@@ -81,6 +91,12 @@ type Manager struct {
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
 	allocChecker      *memwatch.Monitor
+
+	// Events receives all object changes made through the manager
+	Events *Events
+
+	// Blobs stores the files uploaded to blob properties
+	Blobs BlobStore
 }
 
 type objectsMetrics interface {
@@ -140,6 +156,12 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	// TrashedObjects returns the soft deleted objects of a class
+	TrashedObjects(ctx context.Context, class, tenant string) ([]*search.Result, error)
+	// TrashedObject returns a soft deleted object or nil if it is not in the trash
+	TrashedObject(ctx context.Context, class string, id strfmt.UUID, tenant string) (*search.Result, error)
+	// ObjectHistory returns the prior versions of an object, latest first
+	ObjectHistory(ctx context.Context, class string, id strfmt.UUID, tenant string) ([]*search.Result, error)
 }
 
 type ModulesProvider interface {
@@ -154,6 +176,7 @@ type ModulesProvider interface {
 		findObjectFn modulecapabilities.FindObjectFn,
 		logger logrus.FieldLogger) (map[int]error, error)
 	VectorizerName(className string) (string, error)
+	ChunkObject(ctx context.Context, class *models.Class, object *models.Object) ([]*models.Object, error)
 }
 
 // NewManager creates a new manager
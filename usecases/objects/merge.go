@@ -59,6 +59,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	}
 
 	ctx = classcache.ContextWithClassCache(ctx)
+
 	obj, err := m.vectorRepo.Object(ctx, cls, id, nil, additional.Properties{}, repl, updates.Tenant)
 	if err != nil {
 		switch err.(type) {
@@ -75,6 +76,10 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if obj == nil {
 		return &Error{"not found", StatusNotFound, err}
 	}
+	// fail early, the shard checks the precondition again when it writes
+	if err := CheckIfMatch(ctx, id, true, obj.Updated); err != nil {
+		return &Error{"precondition failed", StatusPreconditionFailed, err}
+	}
 
 	var schemaVersion uint64
 	if schemaVersion, err = m.autoSchemaManager.autoSchema(ctx, principal, false, updates); err != nil {
@@ -122,7 +127,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		References:         refs,
 		Vector:             objWithVec.Vector,
 		Vectors:            objWithVec.Vectors,
-		UpdateTime:         m.timeSource.Now(),
+		UpdateTime:         nextUpdateTime(m.timeSource, prevObj.LastUpdateTimeUnix),
 		PropertiesToDelete: propertiesToDelete,
 	}
 
@@ -140,6 +145,9 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	}
 
 	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl, tenant, schemaVersion); err != nil {
+		if errors.As(err, &ErrObjectChanged{}) {
+			return &Error{"precondition failed", StatusPreconditionFailed, err}
+		}
 		if errors.As(err, &ErrDirtyReadOfDeletedObject{}) || errors.As(err, &ErrDirtyWriteOfDeletedObject{}) {
 			m.logger.WithError(err).Debugf("object %s/%s not found, possibly due to replication consistency races", cls, id)
 			return &Error{"not found", StatusNotFound, err}
@@ -147,6 +155,12 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
+	m.deleteBlobs(replacedBlobs(prevObj, objWithVec))
+
+	objWithVec.Tenant = tenant
+	objWithVec.CreationTimeUnix = prevObj.CreationTimeUnix
+	objWithVec.LastUpdateTimeUnix = mergeDoc.UpdateTime
+	m.Events.publishObject(EventUpdate, objWithVec)
 	return nil
 }
 
@@ -199,7 +213,13 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	// Note: vector could be a nil vector in case a vectorizer is configured,
 	// then the vectorizer will set it
 	obj := &models.Object{Class: className, Properties: mergedProps, Vector: vector, Vectors: vectors, ID: id}
-	if err := m.modulesProvider.UpdateVector(ctx, obj, class, m.findObject, m.logger); err != nil {
+	restoreBlobRefs, err := resolveBlobRefs(ctx, m.Blobs, class, obj)
+	if err != nil {
+		return nil, err
+	}
+	err = m.modulesProvider.UpdateVector(ctx, obj, class, m.findObject, m.logger)
+	restoreBlobRefs()
+	if err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// PurgeProperty starts a batch job removing the values of a tombstoned
+// property from all objects of the class in the background. The property
+// itself stays in the schema. The job can be queried with GetBatchJob. Its
+// objects are the ones the property was removed from, so its total grows
+// while the job is running.
+func (b *BatchManager) PurgeProperty(ctx context.Context, principal *models.Principal,
+	className, propName string, repl *additional.ReplicationProperties,
+) (*BatchJob, error) {
+	err := b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(className)...)
+	if err != nil {
+		return nil, err
+	}
+
+	class := b.schemaManager.ReadOnlyClass(className)
+	if class == nil {
+		return nil, NewErrNotFound("class %q does not exist", className)
+	}
+	prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(propName))
+	if err != nil {
+		return nil, NewErrNotFound("property %q of class %q does not exist", propName, className)
+	}
+	if !schema.PropertyTombstoned(prop) {
+		return nil, NewErrInvalidUserInput("property %q of class %q needs to be tombstoned before it can be purged",
+			prop.Name, class.Class)
+	}
+
+	tenants := []string{""}
+	if schema.MultiTenancyEnabled(class) {
+		if tenants, err = b.activeTenants(ctx, principal, class.Class); err != nil {
+			return nil, err
+		}
+	}
+
+	job, err := b.newMigrationJob(class.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	enterrors.GoWrapper(func() {
+		b.runPurgeJob(job.ID, class.Class, prop.Name, tenants, repl)
+	}, b.logger)
+
+	return b.jobs.get(job.ID, job.StartTimeUnix), nil
+}
+
+// activeTenants returns the names of all tenants of the class. Inactive
+// tenants can't be read, so they need to be activated before the objects of
+// the class can be migrated.
+func (b *BatchManager) activeTenants(ctx context.Context, principal *models.Principal,
+	class string,
+) ([]string, error) {
+	tenants, err := b.schemaManager.GetTenants(ctx, principal, class)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		if schema.ActivityStatus(tenant.ActivityStatus) != models.TenantActivityStatusHOT {
+			return nil, NewErrInvalidUserInput("tenant %q of class %q is not active, activate it first",
+				tenant.Name, class)
+		}
+		names = append(names, tenant.Name)
+	}
+	return names, nil
+}
+
+func (b *BatchManager) runPurgeJob(id, class, prop string, tenants []string,
+	repl *additional.ReplicationProperties,
+) {
+	b.runMigrationJob(id, "purge_property", class, tenants, additional.Properties{},
+		func(ctx context.Context, tenant string, page search.Results) BatchObjects {
+			var purged BatchObjects
+			for _, res := range page {
+				if props, ok := res.Schema.(map[string]interface{}); !ok || props[prop] == nil {
+					continue
+				}
+				err := b.purgeObject(ctx, class, prop, tenant, res.ID, res.Updated, repl)
+				purged = append(purged, BatchObject{
+					Err:    err,
+					Object: &models.Object{Class: class, ID: res.ID, Tenant: tenant},
+					UUID:   res.ID,
+				})
+			}
+			return purged
+		}, nil)
+}
+
+// maxPurgeAttempts bounds how often the purge of an object which keeps
+// changing is tried
+const maxPurgeAttempts = 3
+
+// purgeObject removes the property from an object last updated at version.
+// Like any other update, the purge advances the version of the object and
+// is only applied if the object is still at the version it was read at, so
+// that clients holding an older version can't overwrite the purge without a
+// conflict. An object which changed in the meantime is read again.
+func (b *BatchManager) purgeObject(ctx context.Context, class, prop, tenant string,
+	id strfmt.UUID, version int64, repl *additional.ReplicationProperties,
+) error {
+	for attempt := 1; ; attempt++ {
+		err := b.vectorRepo.Merge(ContextWithIfMatch(ctx, IfMatch{Tags: []string{strconv.FormatInt(version, 10)}}),
+			MergeDocument{
+				Class:              class,
+				ID:                 id,
+				UpdateTime:         nextUpdateTime(b.timeSource, version),
+				PropertiesToDelete: []string{prop},
+			}, repl, tenant, 0)
+		if err == nil || !errors.As(err, &ErrObjectChanged{}) || attempt == maxPurgeAttempts {
+			return err
+		}
+
+		obj, err := b.vectorRepo.Object(ctx, class, id, nil, additional.Properties{}, repl, tenant)
+		if err != nil {
+			return err
+		}
+		if obj == nil {
+			// deleted in the meantime, nothing left to purge
+			return nil
+		}
+		version = obj.Updated
+	}
+}
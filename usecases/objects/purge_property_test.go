@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_PurgeProperty(t *testing.T) {
+	var (
+		ctx = context.Background()
+		idA = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+		idB = strfmt.UUID("00000000-0000-0000-0000-00000000000b")
+		sch = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+			Class: "Foo",
+			Properties: []*models.Property{
+				{Name: "active", DataType: schema.DataTypeText.PropString()},
+				{
+					Name: "deprecated", DataType: schema.DataTypeText.PropString(),
+					Lifecycle: models.PropertyLifecycleDeprecated,
+				},
+				{
+					Name: "tombstoned", DataType: schema.DataTypeText.PropString(),
+					Lifecycle: models.PropertyLifecycleTombstoned,
+				},
+			},
+		}}}}
+	)
+	newManager := func() (*BatchManager, *fakeVectorRepo) {
+		vectorRepo := &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+		logger, _ := test.NewNullLogger()
+		return NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(), nil), vectorRepo
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		manager, _ := newManager()
+		_, err := manager.PurgeProperty(ctx, nil, "Bar", "tombstoned", nil)
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+
+	t.Run("unknown property", func(t *testing.T) {
+		manager, _ := newManager()
+		_, err := manager.PurgeProperty(ctx, nil, "Foo", "unknown", nil)
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+
+	t.Run("property which is not tombstoned", func(t *testing.T) {
+		manager, _ := newManager()
+		for _, prop := range []string{"active", "deprecated"} {
+			_, err := manager.PurgeProperty(ctx, nil, "Foo", prop, nil)
+			assert.ErrorAs(t, err, &ErrInvalidUserInput{}, prop)
+		}
+	})
+
+	t.Run("removes the values of the property", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		manager.timeSource = fakeTimeSource{}
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{ClassName: "Foo", ID: idA, Schema: map[string]interface{}{"tombstoned": "value"}, Updated: 20000},
+			{ClassName: "Foo", ID: idB, Schema: map[string]interface{}{"active": "value"}},
+		}, (*Error)(nil)).Once()
+		// the version advances even though the clock is behind it
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.ID == idA && doc.UpdateTime == 20001 &&
+				assert.ObjectsAreEqual([]string{"tombstoned"}, doc.PropertiesToDelete)
+		})).Return(nil).Once()
+
+		job, err := manager.PurgeProperty(ctx, nil, "Foo", "tombstoned", nil)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			job, err = manager.GetBatchJob(ctx, nil, job.ID)
+			require.Nil(t, err)
+			return job.Status != models.BatchJobStatusSTARTED
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		require.Len(t, job.Objects, 1)
+		assert.Equal(t, idA, job.Objects[0].UUID)
+		assert.Nil(t, job.Objects[0].Err)
+		vectorRepo.AssertExpectations(t)
+	})
+	t.Run("retries objects which changed concurrently", func(t *testing.T) {
+		manager, vectorRepo := newManager()
+		manager.timeSource = fakeTimeSource{}
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{ClassName: "Foo", ID: idA, Schema: map[string]interface{}{"tombstoned": "value"}, Updated: 20000},
+		}, (*Error)(nil)).Once()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.UpdateTime == 20001
+		})).Return(NewErrObjectChanged("object changed")).Once()
+		vectorRepo.On("Object", "Foo", idA, mock.Anything, mock.Anything, "").
+			Return(&search.Result{ClassName: "Foo", ID: idA, Updated: 30000}, nil).Once()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.UpdateTime == 30001
+		})).Return(nil).Once()
+
+		job, err := manager.PurgeProperty(ctx, nil, "Foo", "tombstoned", nil)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			job, err = manager.GetBatchJob(ctx, nil, job.ID)
+			require.Nil(t, err)
+			return job.Status != models.BatchJobStatusSTARTED
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		require.Len(t, job.Objects, 1)
+		assert.Nil(t, job.Objects[0].Err)
+		vectorRepo.AssertExpectations(t)
+	})
+}
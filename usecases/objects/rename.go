@@ -0,0 +1,410 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// RenameProperty renames a property without reimporting the objects of its
+// class. A property with the new name and the same settings is added and the
+// old property is deprecated, so that writes have to use the new name. A
+// batch job then moves the values of all objects to the new property in the
+// background and tombstones the old property once all objects are migrated.
+// Objects are not vectorized again.
+func (b *BatchManager) RenameProperty(ctx context.Context, principal *models.Principal,
+	className, propName, newName string, repl *additional.ReplicationProperties,
+) (*BatchJob, error) {
+	err := b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(className)...)
+	if err != nil {
+		return nil, err
+	}
+
+	class := b.schemaManager.ReadOnlyClass(className)
+	if class == nil {
+		return nil, NewErrNotFound("class %q does not exist", className)
+	}
+	prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(propName))
+	if err != nil {
+		return nil, NewErrNotFound("property %q of class %q does not exist", propName, className)
+	}
+	if schema.PropertyDeprecated(prop) {
+		return nil, NewErrInvalidUserInput("property %q of class %q is %s, only active properties can be renamed",
+			prop.Name, class.Class, prop.Lifecycle)
+	}
+	if newName == "" {
+		return nil, NewErrInvalidUserInput("the new name of property %q must not be empty", prop.Name)
+	}
+	newName = schema.LowercaseFirstLetter(newName)
+	for _, existing := range class.Properties {
+		if strings.EqualFold(existing.Name, newName) {
+			return nil, NewErrInvalidUserInput("class %q already has a property %q", class.Class, existing.Name)
+		}
+	}
+
+	tenants := []string{""}
+	if schema.MultiTenancyEnabled(class) {
+		if tenants, err = b.activeTenants(ctx, principal, class.Class); err != nil {
+			return nil, err
+		}
+	}
+
+	// the job is registered first, as only a limited number of jobs can run
+	job, err := b.newMigrationJob(class.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	renamed := *prop
+	renamed.Name = newName
+	renamed.Lifecycle = ""
+	if _, _, err := b.schemaManager.AddClassProperty(ctx, principal, class, false, &renamed); err != nil {
+		b.failJob(job.ID, err)
+		return nil, schemaChangeErr(err)
+	}
+	_, version, err := b.schemaManager.UpdatePropertyLifecycle(ctx, principal, class.Class,
+		prop.Name, models.PropertyLifecycleDeprecated)
+	if err != nil {
+		b.failJob(job.ID, err)
+		return nil, schemaChangeErr(err)
+	}
+
+	enterrors.GoWrapper(func() {
+		b.runMigrationJob(job.ID, "rename_property", class.Class, tenants, additional.Properties{},
+			func(ctx context.Context, tenant string, page search.Results) BatchObjects {
+				var moved BatchObjects
+				for _, res := range page {
+					props, ok := res.Schema.(map[string]interface{})
+					if !ok || props[prop.Name] == nil {
+						continue
+					}
+					err := b.moveProperty(ctx, class.Class, tenant, res, prop.Name, renamed.Name, repl, version)
+					moved = append(moved, BatchObject{
+						Err:    err,
+						Object: &models.Object{Class: class.Class, ID: res.ID, Tenant: tenant},
+						UUID:   res.ID,
+					})
+				}
+				return moved
+			},
+			func(ctx context.Context) error {
+				_, _, err := b.schemaManager.UpdatePropertyLifecycle(ctx, principal, class.Class,
+					prop.Name, models.PropertyLifecycleTombstoned)
+				return err
+			})
+	}, b.logger)
+
+	return b.jobs.get(job.ID, job.StartTimeUnix), nil
+}
+
+// maxMoveAttempts is how often moving the value of a renamed property is
+// retried for an object which is written to at the same time
+const maxMoveAttempts = 3
+
+// moveProperty moves the value of the property from of the object res to the
+// property to. The merge only applies to the version of the object which was
+// read, so that a value written with the new name in the meantime is never
+// overwritten by the old one. If the object changed, it's read again.
+func (b *BatchManager) moveProperty(ctx context.Context, class, tenant string,
+	res search.Result, from, to string, repl *additional.ReplicationProperties, version uint64,
+) error {
+	for attempt := 1; ; attempt++ {
+		props, ok := res.Schema.(map[string]interface{})
+		if !ok || props[from] == nil || props[to] != nil {
+			// nothing to move, or written with the new name since the
+			// rename, in which case the old value is outdated
+			return nil
+		}
+
+		updateTime := b.timeSource.Now()
+		if updateTime <= res.Updated {
+			updateTime = res.Updated + 1
+		}
+		ifMatch := IfMatch{Tags: []string{strconv.FormatInt(res.Updated, 10)}}
+		err := b.vectorRepo.Merge(ContextWithIfMatch(ctx, ifMatch), MergeDocument{
+			Class:              class,
+			ID:                 res.ID,
+			PrimitiveSchema:    map[string]interface{}{to: props[from]},
+			UpdateTime:         updateTime,
+			PropertiesToDelete: []string{from},
+		}, repl, tenant, version)
+		if !errors.As(err, &ErrObjectChanged{}) || attempt == maxMoveAttempts {
+			return err
+		}
+
+		current, err := b.vectorRepo.Object(ctx, class, res.ID, search.SelectProperties{},
+			additional.Properties{}, repl, tenant)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			// deleted in the meantime
+			return nil
+		}
+		res = *current
+	}
+}
+
+// RenameClass renames a class without reimporting its objects. The shards of
+// the class are made read-only first, so that no write can be missed while
+// the objects are copied. A class with the new name, the same settings and
+// the same tenants is added. A batch job then copies all objects including
+// their vectors to the new class in the background. Once all objects are
+// copied, the old class is deleted if deleteOld is set. Otherwise it's kept,
+// read-only, and has to be deleted by the user after switching to the new
+// class. Until then the objects of the class are stored twice. If the job
+// fails, the old class is made writable again.
+// References of the class to itself are renamed, a class which is referenced
+// by other classes can't be renamed.
+func (b *BatchManager) RenameClass(ctx context.Context, principal *models.Principal,
+	className, newName string, deleteOld bool, repl *additional.ReplicationProperties,
+) (*BatchJob, error) {
+	err := b.authorizer.Authorize(principal, authorization.DELETE, authorization.Collections(className)...)
+	if err != nil {
+		return nil, err
+	}
+	newName = schema.UppercaseClassName(newName)
+	err = b.authorizer.Authorize(principal, authorization.CREATE, authorization.Collections(newName)...)
+	if err != nil {
+		return nil, err
+	}
+
+	class := b.schemaManager.ReadOnlyClass(className)
+	if class == nil {
+		return nil, NewErrNotFound("class %q does not exist", className)
+	}
+	if newName == "" {
+		return nil, NewErrInvalidUserInput("the new name of class %q must not be empty", class.Class)
+	}
+	if existing := b.schemaManager.ReadOnlyClass(newName); existing != nil {
+		return nil, NewErrInvalidUserInput("class %q already exists", existing.Class)
+	}
+
+	sch, err := b.schemaManager.GetConsistentSchema(principal, false)
+	if err != nil {
+		return nil, err
+	}
+	var classes []*models.Class
+	if sch.Objects != nil {
+		classes = sch.Objects.Classes
+	}
+	for _, other := range classes {
+		if other.Class == class.Class {
+			continue
+		}
+		for _, prop := range other.Properties {
+			for _, dataType := range prop.DataType {
+				if dataType == class.Class {
+					return nil, NewErrInvalidUserInput("class %q is referenced by property %q of class %q and can't be renamed",
+						class.Class, prop.Name, other.Class)
+				}
+			}
+		}
+	}
+
+	tenants := []string{""}
+	if schema.MultiTenancyEnabled(class) {
+		if tenants, err = b.activeTenants(ctx, principal, class.Class); err != nil {
+			return nil, err
+		}
+	}
+
+	renamed, err := renamedClass(class, newName)
+	if err != nil {
+		return nil, NewErrInternal("%v", err)
+	}
+
+	// the job is registered first, as only a limited number of jobs can run
+	job, err := b.newMigrationJob(class.Class, renamed.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	shards, err := b.setShardsStatus(ctx, principal, class.Class, nil, storagestate.StatusReadOnly.String())
+	if err != nil {
+		b.failJob(job.ID, err)
+		return nil, err
+	}
+	// makeWritable is called if the rename can't be completed
+	makeWritable := func(ctx context.Context) {
+		if _, err := b.setShardsStatus(ctx, principal, class.Class, shards, storagestate.StatusReady.String()); err != nil {
+			b.logger.WithField("action", "rename_class").WithField("class", class.Class).
+				WithError(err).Error("make shards of class writable again")
+		}
+	}
+
+	_, version, err := b.schemaManager.AddClass(ctx, principal, renamed)
+	if err != nil {
+		makeWritable(ctx)
+		b.failJob(job.ID, err)
+		return nil, schemaChangeErr(err)
+	}
+	if schema.MultiTenancyEnabled(class) && len(tenants) > 0 {
+		newTenants := make([]*models.Tenant, len(tenants))
+		for i, tenant := range tenants {
+			newTenants[i] = &models.Tenant{Name: tenant}
+		}
+		if version, err = b.schemaManager.AddTenants(ctx, principal, renamed.Class, newTenants); err != nil {
+			makeWritable(ctx)
+			b.failJob(job.ID, err)
+			return nil, schemaChangeErr(err)
+		}
+	}
+
+	addProps := additional.Properties{Vector: true}
+	for targetVector := range class.VectorConfig {
+		addProps.Vectors = append(addProps.Vectors, targetVector)
+	}
+
+	enterrors.GoWrapper(func() {
+		err := b.runMigrationJob(job.ID, "rename_class", class.Class, tenants, addProps,
+			func(ctx context.Context, tenant string, page search.Results) BatchObjects {
+				if len(page) == 0 {
+					return nil
+				}
+				batch := make(BatchObjects, len(page))
+				for i, res := range page {
+					obj := res.Object()
+					obj.Class = renamed.Class
+					obj.Tenant = tenant
+					renameReferences(obj.Properties, class.Class, renamed.Class)
+					batch[i] = BatchObject{OriginalIndex: i, Object: obj, UUID: res.ID}
+				}
+				copied, err := b.vectorRepo.BatchPutObjects(ctx, batch, repl, version)
+				if err != nil {
+					for i := range batch {
+						batch[i].Err = err
+					}
+					return batch
+				}
+				return copied
+			},
+			func(ctx context.Context) error {
+				if !deleteOld {
+					// the old class stays read-only until it is deleted by the user
+					return nil
+				}
+				return b.schemaManager.DeleteClass(ctx, principal, class.Class)
+			})
+		if err != nil {
+			makeWritable(context.Background())
+		}
+	}, b.logger)
+
+	return b.jobs.get(job.ID, job.StartTimeUnix), nil
+}
+
+// setShardsStatus sets the status of the given shards of the class, or of all
+// its shards if shards is nil, and returns the names of the shards
+func (b *BatchManager) setShardsStatus(ctx context.Context, principal *models.Principal,
+	class string, shards []string, status string,
+) ([]string, error) {
+	if shards == nil {
+		list, err := b.schemaManager.ShardsStatus(ctx, principal, class, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range list {
+			shards = append(shards, shard.Name)
+		}
+	}
+
+	var version uint64
+	for _, shard := range shards {
+		v, err := b.schemaManager.UpdateShardStatus(ctx, principal, class, shard, status)
+		if err != nil {
+			return nil, fmt.Errorf("set status of shard %q of class %q to %s: %w", shard, class, status, err)
+		}
+		version = max(version, v)
+	}
+	// the status has to be applied on this node before objects are read
+	if err := b.schemaManager.WaitForUpdate(ctx, version); err != nil {
+		return nil, err
+	}
+	return shards, nil
+}
+
+// renamedClass returns a copy of the class with the new name. The copy only
+// contains the settings as they are sent by users, so it can be added just
+// like a new class. References to the class itself point to the copy.
+func renamedClass(class *models.Class, name string) (*models.Class, error) {
+	b, err := json.Marshal(class)
+	if err != nil {
+		return nil, err
+	}
+	var renamed models.Class
+	if err := json.Unmarshal(b, &renamed); err != nil {
+		return nil, err
+	}
+
+	renamed.Class = name
+	for _, prop := range renamed.Properties {
+		for i, dataType := range prop.DataType {
+			if dataType == class.Class {
+				prop.DataType[i] = name
+			}
+		}
+	}
+	return &renamed, nil
+}
+
+// renameReferences changes the beacons pointing to objects of the class
+// from to point to the same objects of the class to
+func renameReferences(props models.PropertySchema, from, to string) {
+	propsMap, ok := props.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, value := range propsMap {
+		refs, ok := value.(models.MultipleRef)
+		if !ok {
+			continue
+		}
+		for _, ref := range refs {
+			parsed, err := crossref.Parse(ref.Beacon.String())
+			if err != nil || parsed.Class != from {
+				continue
+			}
+			ref.Beacon = strfmt.URI(crossref.New(parsed.PeerName, to, parsed.TargetID).String())
+			if string(ref.Class) == from {
+				ref.Class = strfmt.URI(to)
+			}
+		}
+	}
+}
+
+// schemaChangeErr keeps authorization errors of the schema manager as they
+// are. All other errors are caused by the new name or settings of the
+// renamed class or property.
+func schemaChangeErr(err error) error {
+	var forbidden autherrs.Forbidden
+	if errors.As(err, &forbidden) {
+		return forbidden
+	}
+	return NewErrInvalidUserInput("%v", err)
+}
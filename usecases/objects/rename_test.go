@@ -0,0 +1,295 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_RenameProperty(t *testing.T) {
+	var (
+		ctx = context.Background()
+		idA = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+		idB = strfmt.UUID("00000000-0000-0000-0000-00000000000b")
+		idC = strfmt.UUID("00000000-0000-0000-0000-00000000000c")
+	)
+	newManager := func() (*BatchManager, *fakeVectorRepo, *fakeSchemaManager) {
+		vectorRepo := &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{{
+				Class: "Foo",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+					{Name: "other", DataType: schema.DataTypeText.PropString()},
+					{
+						Name: "deprecated", DataType: schema.DataTypeText.PropString(),
+						Lifecycle: models.PropertyLifecycleDeprecated,
+					},
+				},
+			}},
+		}}}
+		logger, _ := test.NewNullLogger()
+		return NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(), nil), vectorRepo, schemaManager
+	}
+	waitForJob := func(t *testing.T, manager *BatchManager, job *BatchJob) *BatchJob {
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = manager.GetBatchJob(ctx, nil, job.ID)
+			require.Nil(t, err)
+			return job.Status != models.BatchJobStatusSTARTED
+		}, 5*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	t.Run("unknown class or property", func(t *testing.T) {
+		manager, _, _ := newManager()
+		_, err := manager.RenameProperty(ctx, nil, "Bar", "title", "headline", nil)
+		assert.ErrorAs(t, err, &ErrNotFound{})
+		_, err = manager.RenameProperty(ctx, nil, "Foo", "unknown", "headline", nil)
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+
+	t.Run("invalid new name or property", func(t *testing.T) {
+		manager, _, _ := newManager()
+		for _, tc := range []struct{ prop, newName string }{
+			{"deprecated", "headline"},
+			{"title", ""},
+			{"title", "Other"},
+		} {
+			_, err := manager.RenameProperty(ctx, nil, "Foo", tc.prop, tc.newName, nil)
+			assert.ErrorAs(t, err, &ErrInvalidUserInput{}, tc)
+		}
+	})
+
+	t.Run("moves the values to the new property", func(t *testing.T) {
+		manager, vectorRepo, schemaManager := newManager()
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{ClassName: "Foo", ID: idA, Schema: map[string]interface{}{"title": "value"}},
+			{ClassName: "Foo", ID: idB, Schema: map[string]interface{}{"other": "value"}},
+			// written with the new name while the job runs
+			{ClassName: "Foo", ID: idC, Schema: map[string]interface{}{"title": "old", "headline": "new"}},
+		}, (*Error)(nil)).Once()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.ID == idA &&
+				assert.ObjectsAreEqual(map[string]interface{}{"headline": "value"}, doc.PrimitiveSchema) &&
+				assert.ObjectsAreEqual([]string{"title"}, doc.PropertiesToDelete)
+		})).Return(nil).Once()
+
+		job, err := manager.RenameProperty(ctx, nil, "Foo", "title", "Headline", nil)
+		require.Nil(t, err)
+		job = waitForJob(t, manager, job)
+
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		require.Len(t, job.Objects, 1)
+		assert.Equal(t, idA, job.Objects[0].UUID)
+		vectorRepo.AssertExpectations(t)
+
+		class := schemaManager.ReadOnlyClass("Foo")
+		old, err := schema.GetPropertyByName(class, "title")
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyLifecycleTombstoned, old.Lifecycle)
+		renamed, err := schema.GetPropertyByName(class, "headline")
+		require.Nil(t, err)
+		assert.Equal(t, old.DataType, renamed.DataType)
+		assert.Empty(t, renamed.Lifecycle)
+	})
+
+	t.Run("objects written to while the value is moved are read again", func(t *testing.T) {
+		manager, vectorRepo, _ := newManager()
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{ClassName: "Foo", ID: idA, Updated: 1, Schema: map[string]interface{}{"title": "old"}},
+			{ClassName: "Foo", ID: idB, Updated: 1, Schema: map[string]interface{}{"title": "old"}},
+		}, (*Error)(nil)).Once()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.UpdateTime > 1
+		})).Return(NewErrObjectChanged("changed")).Twice()
+		// written with the new name in the meantime
+		vectorRepo.On("Object", "Foo", idA, mock.Anything, mock.Anything, "").Return(&search.Result{
+			ClassName: "Foo", ID: idA, Updated: 2, Schema: map[string]interface{}{"title": "old", "headline": "new"},
+		}, nil).Once()
+		// written to without touching the property
+		vectorRepo.On("Object", "Foo", idB, mock.Anything, mock.Anything, "").Return(&search.Result{
+			ClassName: "Foo", ID: idB, Updated: 2, Schema: map[string]interface{}{"title": "old", "other": "new"},
+		}, nil).Once()
+		vectorRepo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.ID == idB && doc.UpdateTime > 2 &&
+				assert.ObjectsAreEqual(map[string]interface{}{"headline": "old"}, doc.PrimitiveSchema)
+		})).Return(nil).Once()
+
+		job, err := manager.RenameProperty(ctx, nil, "Foo", "title", "headline", nil)
+		require.Nil(t, err)
+		job = waitForJob(t, manager, job)
+
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("old property stays deprecated if an object can't be migrated", func(t *testing.T) {
+		manager, vectorRepo, schemaManager := newManager()
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{ClassName: "Foo", ID: idA, Schema: map[string]interface{}{"title": "value"}},
+		}, (*Error)(nil)).Once()
+		vectorRepo.On("Merge", mock.Anything).Return(errors.New("merge failed")).Once()
+
+		job, err := manager.RenameProperty(ctx, nil, "Foo", "title", "headline", nil)
+		require.Nil(t, err)
+		job = waitForJob(t, manager, job)
+
+		assert.Equal(t, models.BatchJobStatusFAILED, job.Status)
+		assert.EqualError(t, job.Err, "1 objects could not be migrated")
+		old, err := schema.GetPropertyByName(schemaManager.ReadOnlyClass("Foo"), "title")
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyLifecycleDeprecated, old.Lifecycle)
+	})
+}
+
+func Test_BatchManager_RenameClass(t *testing.T) {
+	var (
+		ctx = context.Background()
+		idA = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+		idB = strfmt.UUID("00000000-0000-0000-0000-00000000000b")
+	)
+	newManager := func(classes ...*models.Class) (*BatchManager, *fakeVectorRepo, *fakeSchemaManager) {
+		vectorRepo := &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+			Classes: append([]*models.Class{{
+				Class: "Foo",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+					{Name: "related", DataType: []string{"Foo"}},
+				},
+			}}, classes...),
+		}}}
+		logger, _ := test.NewNullLogger()
+		return NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(), nil), vectorRepo, schemaManager
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		manager, _, _ := newManager()
+		_, err := manager.RenameClass(ctx, nil, "Bar", "Baz", false, nil)
+		assert.ErrorAs(t, err, &ErrNotFound{})
+	})
+
+	t.Run("new name already exists", func(t *testing.T) {
+		manager, _, _ := newManager(&models.Class{Class: "Bar"})
+		_, err := manager.RenameClass(ctx, nil, "Foo", "bar", false, nil)
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+	})
+
+	t.Run("class referenced by another class", func(t *testing.T) {
+		manager, _, _ := newManager(&models.Class{
+			Class:      "Bar",
+			Properties: []*models.Property{{Name: "foo", DataType: []string{"Foo"}}},
+		})
+		_, err := manager.RenameClass(ctx, nil, "Foo", "Baz", false, nil)
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+		assert.ErrorContains(t, err, `referenced by property "foo" of class "Bar"`)
+	})
+
+	t.Run("copies the objects and keeps the old class read-only", func(t *testing.T) {
+		manager, vectorRepo, schemaManager := newManager()
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{
+				ClassName: "Foo", ID: idA, Vector: []float32{1, 2},
+				Schema: map[string]interface{}{
+					"title": "a",
+					"related": models.MultipleRef{
+						{Beacon: strfmt.URI("weaviate://localhost/Foo/" + idB)},
+					},
+				},
+			},
+			{ClassName: "Foo", ID: idB, Schema: map[string]interface{}{"title": "b"}},
+		}, (*Error)(nil)).Once()
+		vectorRepo.On("BatchPutObjects", mock.MatchedBy(func(batch BatchObjects) bool {
+			return len(batch) == 2 && batch[0].Object.Class == "Baz" &&
+				assert.ObjectsAreEqual(models.C11yVector{1, 2}, batch[0].Object.Vector) &&
+				assert.ObjectsAreEqual(models.MultipleRef{{Beacon: strfmt.URI("weaviate://localhost/Baz/" + idB)}},
+					batch[0].Object.Properties.(map[string]interface{})["related"])
+		})).Return(nil).Once()
+
+		job, err := manager.RenameClass(ctx, nil, "Foo", "baz", false, nil)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			job, err = manager.GetBatchJob(ctx, nil, job.ID)
+			require.Nil(t, err)
+			return job.Status != models.BatchJobStatusSTARTED
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		assert.Len(t, job.Objects, 2)
+		vectorRepo.AssertExpectations(t)
+
+		assert.NotNil(t, schemaManager.ReadOnlyClass("Foo"))
+		assert.Equal(t, storagestate.StatusReadOnly.String(), schemaManager.shardStatus["Foo"])
+		renamed := schemaManager.ReadOnlyClass("Baz")
+		require.NotNil(t, renamed)
+		related, err := schema.GetPropertyByName(renamed, "related")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Baz"}, related.DataType)
+	})
+	t.Run("deletes the old class if asked to", func(t *testing.T) {
+		manager, vectorRepo, schemaManager := newManager()
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{ClassName: "Foo", ID: idA, Schema: map[string]interface{}{"title": "a"}},
+		}, (*Error)(nil)).Once()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+		job, err := manager.RenameClass(ctx, nil, "Foo", "Baz", true, nil)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			job, err = manager.GetBatchJob(ctx, nil, job.ID)
+			require.Nil(t, err)
+			return job.Status != models.BatchJobStatusSTARTED
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, models.BatchJobStatusSUCCESS, job.Status)
+		assert.Nil(t, schemaManager.ReadOnlyClass("Foo"))
+		assert.NotNil(t, schemaManager.ReadOnlyClass("Baz"))
+	})
+
+	t.Run("old class is writable again if the copy fails", func(t *testing.T) {
+		manager, vectorRepo, schemaManager := newManager()
+		vectorRepo.On("Query", mock.Anything).Return([]search.Result{
+			{ClassName: "Foo", ID: idA, Schema: map[string]interface{}{"title": "a"}},
+		}, (*Error)(nil)).Once()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(errors.New("put failed")).Once()
+
+		job, err := manager.RenameClass(ctx, nil, "Foo", "Baz", false, nil)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			shards, err := schemaManager.ShardsStatus(ctx, nil, "Foo", "")
+			require.Nil(t, err)
+			return shards[0].Status == storagestate.StatusReady.String()
+		}, 5*time.Second, 10*time.Millisecond)
+		job, err = manager.GetBatchJob(ctx, nil, job.ID)
+		require.Nil(t, err)
+		assert.Equal(t, models.BatchJobStatusFAILED, job.Status)
+	})
+}
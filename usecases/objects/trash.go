@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/classcache"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// GetTrashedObjects lists the soft deleted objects of a class which can still
+// be restored. The time of deletion is returned as additional property
+// deletionTimeUnix.
+func (m *Manager) GetTrashedObjects(ctx context.Context, principal *models.Principal,
+	class string, includeVector bool, tenant string,
+) ([]*models.Object, error) {
+	err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkSoftDeleteEnabled(); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	res, err := m.vectorRepo.TrashedObjects(ctx, class, tenant)
+	if err != nil {
+		return nil, trashError(err)
+	}
+
+	out := make([]*models.Object, len(res))
+	for i := range res {
+		out[i] = res[i].ObjectWithVector(includeVector)
+	}
+	return out, nil
+}
+
+// RestoreObject restores a soft deleted object as it was when it was deleted.
+// It fails with ErrNotFound if the object is not in the trash, for example
+// because it was restored or created again in the meantime.
+func (m *Manager) RestoreObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
+) (*models.Object, error) {
+	err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.Objects(class, tenant, id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkSoftDeleteEnabled(); err != nil {
+		return nil, err
+	}
+
+	ctx = classcache.ContextWithClassCache(ctx)
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	vclasses, err := m.schemaManager.GetCachedClass(ctx, principal, class)
+	if err != nil {
+		return nil, fmt.Errorf("could not get class %s: %w", class, err)
+	}
+	if len(vclasses) == 0 || vclasses[class].Class == nil {
+		return nil, NewErrInvalidUserInput("class %q not found in schema", class)
+	}
+
+	res, err := m.vectorRepo.TrashedObject(ctx, class, id, tenant)
+	if err != nil {
+		return nil, trashError(err)
+	}
+	if res == nil {
+		return nil, NewErrNotFound("object %s/%s is not in the trash", class, id)
+	}
+
+	object := res.Object()
+	object.Additional = nil
+	object.LastUpdateTimeUnix = m.timeSource.Now()
+
+	// Ensure that the local schema has caught up to the version we used to validate
+	if err := m.schemaManager.WaitForUpdate(ctx, vclasses[class].Version); err != nil {
+		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", vclasses[class].Version, err)
+	}
+	err = m.vectorRepo.PutObject(ctx, object, object.Vector, object.Vectors, repl, vclasses[class].Version)
+	if err != nil {
+		return nil, NewErrInternal("could not restore object: %v", err)
+	}
+
+	m.Events.publishObject(EventCreate, object)
+	return object, nil
+}
+
+func (m *Manager) checkSoftDeleteEnabled() error {
+	if m.config.Config.SoftDeleteRetention <= 0 {
+		return NewErrInvalidUserInput("soft delete is disabled, " +
+			"set SOFT_DELETE_RETENTION to keep deleted objects in the trash")
+	}
+	return nil
+}
+
+func trashError(err error) error {
+	var e1 ErrMultiTenancy
+	if errors.As(err, &e1) {
+		return e1
+	}
+	var e2 ErrInvalidUserInput
+	if errors.As(err, &e2) {
+		return e2
+	}
+	return NewErrInternal("could not search trash: %v", err)
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_Trash(t *testing.T) {
+	var (
+		cls = "MyClass"
+		id  = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+		// see fakeTimeSource
+		now int64 = 12345
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+				},
+			},
+		},
+	}
+	trashed := func() *search.Result {
+		return &search.Result{
+			ID:                   id,
+			ClassName:            cls,
+			Schema:               map[string]interface{}{"foo": "bar"},
+			Vector:               []float32{1, 2, 3},
+			Created:              100,
+			Updated:              200,
+			AdditionalProperties: models.AdditionalProperties{"deletionTimeUnix": int64(300)},
+		}
+	}
+	newManager := func(retention time.Duration) fakeGetManager {
+		m := newFakeGetManager(sch)
+		m.timeSource = fakeTimeSource{}
+		m.config.Config.SoftDeleteRetention = retention
+		return m
+	}
+
+	t.Run("soft delete disabled", func(t *testing.T) {
+		m := newManager(0)
+
+		_, err := m.GetTrashedObjects(context.Background(), nil, cls, false, "")
+		assert.True(t, errors.As(err, &ErrInvalidUserInput{}))
+
+		_, err = m.RestoreObject(context.Background(), nil, cls, id, nil, "")
+		assert.True(t, errors.As(err, &ErrInvalidUserInput{}))
+	})
+
+	t.Run("list trash", func(t *testing.T) {
+		m := newManager(time.Hour)
+		m.repo.On("TrashedObjects", cls, "").Return([]*search.Result{trashed()}, nil).Once()
+
+		res, err := m.GetTrashedObjects(context.Background(), nil, cls, false, "")
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, id, res[0].ID)
+		assert.Nil(t, res[0].Vector)
+		assert.Equal(t, int64(300), res[0].Additional["deletionTimeUnix"])
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		m := newManager(time.Hour)
+		m.repo.On("TrashedObject", cls, id, "").Return(trashed(), nil).Once()
+		m.repo.On("PutObject", mock.Anything, []float32{1, 2, 3}).Return(nil).Once()
+
+		res, err := m.RestoreObject(context.Background(), nil, cls, id, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, id, res.ID)
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, res.Properties)
+		assert.Equal(t, int64(100), res.CreationTimeUnix)
+		assert.Equal(t, now, res.LastUpdateTimeUnix)
+		assert.Nil(t, res.Additional)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("restore object which is not in the trash", func(t *testing.T) {
+		m := newManager(time.Hour)
+		m.repo.On("TrashedObject", cls, id, "").Return(nil, nil).Once()
+
+		_, err := m.RestoreObject(context.Background(), nil, cls, id, nil, "")
+		assert.True(t, errors.As(err, &ErrNotFound{}))
+		m.repo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+	})
+}
@@ -65,6 +65,10 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	// fail early, the shard checks the precondition again when it writes
+	if err := CheckIfMatch(ctx, id, true, obj.Updated); err != nil {
+		return nil, err
+	}
 
 	var schemaVersion uint64
 	if schemaVersion, err = m.autoSchemaManager.autoSchema(ctx, principal, false, updates); err != nil {
@@ -82,7 +86,7 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	err = m.validateObjectAndNormalizeNames(
 		ctx, principal, repl, updates, prevObj)
 	if err != nil {
-		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+		return nil, newErrInvalidObject(err)
 	}
 
 	// Set the original creation timestamp before call to put,
@@ -90,7 +94,7 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	// directly from the request body, therefore `CreationTimeUnix`
 	// inherits the zero value.
 	updates.CreationTimeUnix = obj.Created
-	updates.LastUpdateTimeUnix = m.timeSource.Now()
+	updates.LastUpdateTimeUnix = nextUpdateTime(m.timeSource, obj.Updated)
 
 	vclasses, err := m.schemaManager.GetCachedClass(ctx, principal, className)
 	if err != nil {
@@ -98,10 +102,18 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	}
 
 	vclass := vclasses[className]
-	err = m.modulesProvider.UpdateVector(ctx, updates, vclass.Class, m.findObject, m.logger)
+	restoreBlobRefs, err := resolveBlobRefs(ctx, m.Blobs, vclass.Class, updates)
 	if err != nil {
 		return nil, NewErrInternal("update object: %v", err)
 	}
+	err = m.modulesProvider.UpdateVector(ctx, updates, vclass.Class, m.findObject, m.logger)
+	restoreBlobRefs()
+	if err != nil {
+		// keep the cause, so that exhausted module budgets can be reported
+		e := NewErrInternal("update object: %v", err)
+		e.err = err
+		return nil, e
+	}
 
 	if err := m.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
@@ -111,6 +123,8 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("put object: %w", err)
 	}
+	m.deleteBlobs(replacedBlobs(prevObj, updates))
 
+	m.Events.publishObject(EventUpdate, updates)
 	return updates, nil
 }
@@ -88,8 +88,8 @@ func Test_UpdateAction(t *testing.T) {
 			ID:        id,
 			ClassName: "ActionClass",
 			Schema:    map[string]interface{}{"foo": "bar"},
-			Created:   beforeUpdate,
-			Updated:   beforeUpdate,
+			Created:   beforeUpdate - 1000,
+			Updated:   beforeUpdate - 1000,
 		}
 		db.On("ObjectByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
 		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
@@ -107,7 +107,7 @@ func Test_UpdateAction(t *testing.T) {
 			Class:            "ActionClass",
 			ID:               id,
 			Properties:       map[string]interface{}{"foo": "baz"},
-			CreationTimeUnix: beforeUpdate,
+			CreationTimeUnix: beforeUpdate - 1000,
 		}
 
 		afterUpdate := time.Now().UnixNano() / int64(time.Millisecond)
@@ -39,7 +39,7 @@ func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principa
 	ctx = classcache.ContextWithClassCache(ctx)
 	err = m.validateObjectAndNormalizeNames(ctx, principal, repl, obj, nil)
 	if err != nil {
-		return NewErrInvalidUserInput("invalid object: %v", err)
+		return newErrInvalidObject(err)
 	}
 
 	return nil
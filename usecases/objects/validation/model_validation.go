@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -26,6 +27,8 @@ import (
 
 type exists func(_ context.Context, class string, _ strfmt.UUID, _ *additional.ReplicationProperties, _ string) (bool, error)
 
+type blobExists func(_ context.Context, key string) (bool, error)
+
 const (
 	// ErrorMissingActionObjects message
 	ErrorMissingActionObjects string = "no objects, object and subject, are added. Add 'objects' by using the 'objects' key in the root of the JSON"
@@ -61,10 +64,13 @@ const (
 	ErrorNotFoundInDatabase string = "%s: no object with id %s found"
 	// ErrorInvalidProperties message
 	ErrorInvalidProperties string = "properties of object %v must be of type map[string]interface"
+	// ErrorExpiryNotInFuture message
+	ErrorExpiryNotInFuture string = "expiresAtUnix %d must be in the future"
 )
 
 type Validator struct {
 	exists           exists
+	blobExists       blobExists
 	config           *config.WeaviateConfig
 	replicationProps *additional.ReplicationProperties
 }
@@ -79,6 +85,14 @@ func New(exists exists, config *config.WeaviateConfig,
 	}
 }
 
+// WithBlobs makes the validator accept references to files in the blob
+// storage, as long as they exist. Without it, blob properties only accept
+// inline values.
+func (v *Validator) WithBlobs(blobExists blobExists) *Validator {
+	v.blobExists = blobExists
+	return v
+}
+
 func (v *Validator) Object(ctx context.Context, class *models.Class,
 	incoming *models.Object, existing *models.Object,
 ) error {
@@ -86,11 +100,19 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return errors.New(ErrorMissingClass)
 	}
 
+	if incoming.ExpiresAtUnix != 0 && incoming.ExpiresAtUnix <= time.Now().UnixMilli() {
+		return fmt.Errorf(ErrorExpiryNotInFuture, incoming.ExpiresAtUnix)
+	}
+
 	if err := v.vector(ctx, class, incoming); err != nil {
 		return err
 	}
 
-	return v.properties(ctx, class, incoming, existing)
+	if err := v.properties(ctx, class, incoming, existing); err != nil {
+		return err
+	}
+
+	return v.blobRefs(ctx, class, incoming)
 }
 
 // ValidateSingleRef validates a single ref based on location URL and existence of the object in the database
@@ -15,6 +15,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 
@@ -62,3 +63,33 @@ func TestValidationReference(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, ref.TargetID.String(), UuidLower)
 }
+
+func TestValidationExpiry(t *testing.T) {
+	validator := New(fakeExists, &config.WeaviateConfig{}, nil)
+	class := &models.Class{Class: "Foo"}
+
+	for _, tc := range []struct {
+		name      string
+		expiresAt int64
+		valid     bool
+	}{
+		{name: "no expiry", expiresAt: 0, valid: true},
+		{name: "future", expiresAt: time.Now().Add(time.Hour).UnixMilli(), valid: true},
+		{name: "past", expiresAt: time.Now().Add(-time.Hour).UnixMilli()},
+		{name: "negative", expiresAt: -1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &models.Object{
+				Class:         "Foo",
+				Properties:    map[string]interface{}{},
+				ExpiresAtUnix: tc.expiresAt,
+			}
+			err := validator.Object(context.Background(), class, obj, nil)
+			if tc.valid {
+				require.Nil(t, err)
+			} else {
+				require.ErrorContains(t, err, "must be in the future")
+			}
+		})
+	}
+}
@@ -15,9 +15,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"regexp"
+	"strings"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -39,6 +42,19 @@ const (
 	ErrorMissingSingleRefType string = "class '%s' with property '%s' requires exactly 3 arguments: 'beacon', 'locationUrl' and 'type'. 'type' is missing, check your input schema"
 )
 
+// ErrDeprecatedProperty is returned for writes to deprecated or tombstoned
+// properties
+type ErrDeprecatedProperty struct {
+	Class     string
+	Property  string
+	Lifecycle string
+}
+
+func (e ErrDeprecatedProperty) Error() string {
+	return fmt.Sprintf("property '%s' of class '%s' is %s and can no longer be written",
+		e.Property, e.Class, e.Lifecycle)
+}
+
 func (v *Validator) properties(ctx context.Context, class *models.Class,
 	incomingObject *models.Object, existingObject *models.Object,
 ) error {
@@ -82,6 +98,9 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 		if err != nil {
 			return err
 		}
+		if schema.PropertyDeprecated(property) {
+			return ErrDeprecatedProperty{Class: className, Property: property.Name, Lifecycle: property.Lifecycle}
+		}
 		dataType, err := schema.GetPropertyDataType(class, propertyKeyLowerCase)
 		if err != nil {
 			return err
@@ -494,6 +513,24 @@ func geoCoordinates(input interface{}) (*models.GeoCoordinates, error) {
 		return nil, fmt.Errorf("invalid latitude: %s", err)
 	}
 
+	// NaN compares false against any bound, so it has to be rejected before
+	// the range checks
+	if math.IsNaN(latFloat) || math.IsInf(latFloat, 0) {
+		return nil, fmt.Errorf("invalid latitude: must be a finite number, got %v", latFloat)
+	}
+	if math.IsNaN(lonFloat) || math.IsInf(lonFloat, 0) {
+		return nil, fmt.Errorf("invalid longitude: must be a finite number, got %v", lonFloat)
+	}
+
+	// withinGeoRange filters compute distances on a sphere, which are
+	// meaningless for coordinates outside of these ranges
+	if latFloat < -90 || latFloat > 90 {
+		return nil, fmt.Errorf("invalid latitude: must be between -90 and 90, got %v", latFloat)
+	}
+	if lonFloat < -180 || lonFloat > 180 {
+		return nil, fmt.Errorf("invalid longitude: must be between -180 and 180, got %v", lonFloat)
+	}
+
 	return &models.GeoCoordinates{
 		Longitude: ptFloat32(float32(lonFloat)),
 		Latitude:  ptFloat32(float32(latFloat)),
@@ -549,12 +586,69 @@ func parseCoordinate(raw interface{}) (float64, error) {
 	}
 }
 
+// BlobRefPrefix marks blob values which reference a file in the blob storage
+// instead of holding the base64 encoded data inline, see
+// objects.Manager.AddObjectWithBlobs
+const BlobRefPrefix = "blob://"
+
+// BlobKeyPrefix is the common prefix of the keys of all files uploaded for a
+// property of an object. Without a property it is the common prefix of all
+// files of the object.
+func BlobKeyPrefix(class, tenant string, id strfmt.UUID, property string) string {
+	prefix := fmt.Sprintf("%s/%s/%s/", class, tenant, strings.ToLower(id.String()))
+	if property == "" {
+		return prefix
+	}
+	return prefix + property + "/"
+}
+
+// blobRefs checks that the references to files in the blob properties of
+// obj point to stored files, which were uploaded for this very object and
+// property
+func (v *Validator) blobRefs(ctx context.Context, class *models.Class, obj *models.Object) error {
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for _, prop := range class.Properties {
+		if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeBlob) {
+			continue
+		}
+		ref, ok := props[prop.Name].(string)
+		if !ok || !strings.HasPrefix(ref, BlobRefPrefix) {
+			continue
+		}
+		key := strings.TrimPrefix(ref, BlobRefPrefix)
+		if !strings.HasPrefix(key, BlobKeyPrefix(obj.Class, obj.Tenant, obj.ID, prop.Name)) {
+			return fmt.Errorf("invalid blob property '%s' on class '%s': "+
+				"reference to a file of another object or property", prop.Name, class.Class)
+		}
+		if v.blobExists == nil {
+			return fmt.Errorf("invalid blob property '%s' on class '%s': "+
+				"no blob storage configured", prop.Name, class.Class)
+		}
+		exists, err := v.blobExists(ctx, key)
+		if err != nil {
+			return fmt.Errorf("check file of blob property '%s': %w", prop.Name, err)
+		}
+		if !exists {
+			return fmt.Errorf("invalid blob property '%s' on class '%s': "+
+				"referenced file does not exist", prop.Name, class.Class)
+		}
+	}
+	return nil
+}
+
 func blobVal(val interface{}) (string, error) {
 	typed, ok := val.(string)
 	if !ok {
 		return "", fmt.Errorf("not a blob base64 string, but %T", val)
 	}
 
+	if strings.HasPrefix(typed, BlobRefPrefix) {
+		return typed, nil
+	}
+
 	base64Regex := regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{4})$`)
 	ok = base64Regex.MatchString(typed)
 	if !ok {
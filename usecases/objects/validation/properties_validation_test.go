@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 	"time"
@@ -229,6 +230,63 @@ func TestProperties(t *testing.T) {
 	}
 }
 
+func TestGeoCoordinates(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  map[string]interface{}
+		expErr bool
+	}{
+		{name: "valid", input: map[string]interface{}{"latitude": 52.366667, "longitude": 4.9}},
+		{name: "bounds", input: map[string]interface{}{"latitude": json.Number("-90"), "longitude": json.Number("180")}},
+		{name: "missing latitude", input: map[string]interface{}{"longitude": 4.9}, expErr: true},
+		{name: "latitude out of range", input: map[string]interface{}{"latitude": 90.5, "longitude": 4.9}, expErr: true},
+		{name: "longitude out of range", input: map[string]interface{}{"latitude": 52.366667, "longitude": json.Number("-180.1")}, expErr: true},
+		{name: "latitude NaN", input: map[string]interface{}{"latitude": math.NaN(), "longitude": 4.9}, expErr: true},
+		{name: "latitude NaN as json.Number", input: map[string]interface{}{"latitude": json.Number("NaN"), "longitude": 4.9}, expErr: true},
+		{name: "longitude NaN", input: map[string]interface{}{"latitude": 52.366667, "longitude": math.NaN()}, expErr: true},
+		{name: "latitude infinite", input: map[string]interface{}{"latitude": math.Inf(1), "longitude": 4.9}, expErr: true},
+		{name: "longitude infinite", input: map[string]interface{}{"latitude": 52.366667, "longitude": json.Number("-Inf")}, expErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := geoCoordinates(test.input)
+			if test.expErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProperties_Deprecated(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{
+				Name: "summary", DataType: schema.DataTypeText.PropString(),
+				Lifecycle: models.PropertyLifecycleDeprecated,
+			},
+		},
+	}
+	validator := &Validator{}
+
+	err := validator.properties(context.Background(), class, &models.Object{
+		Class: "Article", Properties: map[string]any{"title": "foo"},
+	}, nil)
+	require.NoError(t, err)
+
+	err = validator.properties(context.Background(), class, &models.Object{
+		Class: "Article", Properties: map[string]any{"title": "foo", "summary": "bar"},
+	}, nil)
+	var deprecated ErrDeprecatedProperty
+	require.ErrorAs(t, err, &deprecated)
+	assert.Equal(t, ErrDeprecatedProperty{
+		Class: "Article", Property: "summary", Lifecycle: models.PropertyLifecycleDeprecated,
+	}, deprecated)
+}
+
 func extractBeacon(t *testing.T, props models.PropertySchema) strfmt.URI {
 	require.IsType(t, map[string]any{}, props)
 	require.Contains(t, props.(map[string]any), "inJournal")
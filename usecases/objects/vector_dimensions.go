@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+)
+
+// declareVectorDimensions declares the dimensions of the vectors of objects
+// which were imported successfully in the vector index configs of their
+// class, unless the class declares them already. This only changes the
+// schema on the first import of a class. Failures are only logged, as the
+// objects were imported already and the dimensions are declared again on
+// the next import.
+func declareVectorDimensions(ctx context.Context, schemaManager schemaManager,
+	logger logrus.FieldLogger, objects ...*models.Object,
+) {
+	classes := map[string]*models.Class{}
+	byClass := map[string]map[string]int{}
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		class, ok := classes[obj.Class]
+		if !ok {
+			class = schemaManager.ReadOnlyClass(obj.Class)
+			classes[obj.Class] = class
+		}
+		if class == nil {
+			continue
+		}
+
+		missing := func(targetVector string, cfg interface{}, dims int) {
+			vectorIndexConfig, ok := cfg.(schemaConfig.VectorIndexConfig)
+			if !ok || dims == 0 || vectorindex.DeclaredDimensions(vectorIndexConfig) != 0 {
+				return
+			}
+			if byClass[obj.Class] == nil {
+				byClass[obj.Class] = map[string]int{}
+			}
+			if _, ok := byClass[obj.Class][targetVector]; !ok {
+				byClass[obj.Class][targetVector] = dims
+			}
+		}
+
+		missing("", class.VectorIndexConfig, len(obj.Vector))
+		for targetVector, vector := range obj.Vectors {
+			missing(targetVector, class.VectorConfig[targetVector].VectorIndexConfig, len(vector))
+		}
+	}
+
+	for class, dims := range byClass {
+		if _, err := schemaManager.SetVectorDimensions(ctx, class, dims); err != nil {
+			logger.WithField("action", "declare_vector_dimensions").WithField("class", class).
+				WithError(err).Warn("could not declare vector dimensions")
+		}
+	}
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDeclareVectorDimensions(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{
+			{Class: "Legacy", VectorIndexConfig: hnsw.UserConfig{}},
+			{Class: "Declared", VectorIndexConfig: hnsw.UserConfig{Dimensions: 2}},
+			{Class: "Named", VectorConfig: map[string]models.VectorConfig{
+				"a": {VectorIndexConfig: hnsw.UserConfig{}},
+				"b": {VectorIndexConfig: hnsw.UserConfig{Dimensions: 2}},
+			}},
+		}},
+	}}
+
+	declareVectorDimensions(context.Background(), schemaManager, logger,
+		&models.Object{Class: "Legacy", Vector: []float32{1, 2, 3}},
+		&models.Object{Class: "Legacy", Vector: []float32{1, 2, 3}},
+		&models.Object{Class: "Declared", Vector: []float32{1, 2}},
+		&models.Object{Class: "Named", Vectors: models.Vectors{"a": {1, 2, 3, 4}, "b": {1, 2}}},
+		nil,
+	)
+
+	assert.Equal(t, map[string]map[string]int{
+		"Legacy": {"": 3},
+		"Named":  {"a": 4},
+	}, schemaManager.vectorDimensions)
+}
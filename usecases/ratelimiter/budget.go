@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ErrBudgetExhausted is returned by Budget.Wait if a call doesn't fit into
+// the budget in time. If the queue is full the budget can't tell when
+// capacity frees up, otherwise RetryAfter is the time after which the call
+// would have been admitted.
+type ErrBudgetExhausted struct {
+	Name       string
+	RetryAfter time.Duration
+	QueueFull  bool
+}
+
+func (e ErrBudgetExhausted) Error() string {
+	if e.QueueFull {
+		return fmt.Sprintf("budget of %q exhausted: too many calls are waiting, retry later", e.Name)
+	}
+	return fmt.Sprintf("budget of %q exhausted: retry after %ds", e.Name, int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+// Budget limits the requests per second and the tokens per minute of calls
+// to an external service. Calls exceeding the budget wait for up to maxWait,
+// at most maxQueued of them at the same time. A limit of 0 disables the
+// respective limit.
+type Budget struct {
+	name      string
+	requests  *rate.Limiter
+	tokens    *rate.Limiter
+	maxWait   time.Duration
+	maxQueued int64
+	queued    atomic.Int64
+}
+
+func NewBudget(name string, requestsPerSecond, tokensPerMinute int, maxWait time.Duration, maxQueued int) *Budget {
+	b := &Budget{name: name, maxWait: maxWait, maxQueued: int64(maxQueued)}
+	if requestsPerSecond > 0 {
+		b.requests = rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+	}
+	if tokensPerMinute > 0 {
+		// allow a minute's worth of tokens at once, so that large batches
+		// don't have to be split
+		b.tokens = rate.NewLimiter(rate.Limit(float64(tokensPerMinute)/60), tokensPerMinute)
+	}
+	return b
+}
+
+// Wait blocks until the call fits into the budget. It fails with
+// ErrBudgetExhausted without consuming the budget if that takes longer than
+// the max wait or the deadline of ctx.
+func (b *Budget) Wait(ctx context.Context, requests, tokens int) error {
+	if b.maxQueued > 0 {
+		if b.queued.Add(1) > b.maxQueued {
+			b.queued.Add(-1)
+			return ErrBudgetExhausted{Name: b.name, QueueFull: true}
+		}
+		defer b.queued.Add(-1)
+	}
+
+	now := time.Now()
+	var reservations []*rate.Reservation
+	var delay time.Duration
+	reserve := func(limiter *rate.Limiter, n int) {
+		if limiter == nil {
+			return
+		}
+		// a single reservation can't exceed the burst, larger calls are
+		// reserved in chunks which are admitted one after the other
+		for n > 0 {
+			chunk := min(n, limiter.Burst())
+			r := limiter.ReserveN(now, chunk)
+			reservations = append(reservations, r)
+			delay = max(delay, r.DelayFrom(now))
+			n -= chunk
+		}
+	}
+	reserve(b.requests, requests)
+	reserve(b.tokens, tokens)
+
+	cancel := func() {
+		for i := len(reservations) - 1; i >= 0; i-- {
+			reservations[i].CancelAt(now)
+		}
+	}
+
+	maxWait := b.maxWait
+	if deadline, ok := ctx.Deadline(); ok {
+		maxWait = min(maxWait, deadline.Sub(now))
+	}
+	if delay > maxWait {
+		cancel()
+		return ErrBudgetExhausted{Name: b.name, RetryAfter: delay - maxWait}
+	}
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetRequests(t *testing.T) {
+	b := NewBudget("test", 10, 0, 0, 0)
+	ctx := context.Background()
+
+	require.Nil(t, b.Wait(ctx, 10, 0))
+
+	var exhausted ErrBudgetExhausted
+	err := b.Wait(ctx, 5, 0)
+	require.True(t, errors.As(err, &exhausted))
+	assert.False(t, exhausted.QueueFull)
+	assert.InDelta(t, 500*time.Millisecond, exhausted.RetryAfter, float64(50*time.Millisecond))
+	assert.Equal(t, `budget of "test" exhausted: retry after 1s`, err.Error())
+}
+
+func TestBudgetQueues(t *testing.T) {
+	b := NewBudget("test", 20, 0, time.Second, 0)
+	ctx := context.Background()
+
+	// calls larger than the burst are admitted in chunks, the last chunk has
+	// to wait for the budget
+	start := time.Now()
+	require.Nil(t, b.Wait(ctx, 25, 0))
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestBudgetTokens(t *testing.T) {
+	b := NewBudget("test", 0, 600, 0, 0)
+	ctx := context.Background()
+
+	require.Nil(t, b.Wait(ctx, 1000, 600))
+	err := b.Wait(ctx, 1, 10)
+	assert.True(t, errors.As(err, &ErrBudgetExhausted{}))
+
+	t.Run("rejected calls don't consume the budget", func(t *testing.T) {
+		b := NewBudget("test", 0, 600, 0, 0)
+		require.Nil(t, b.Wait(ctx, 1, 500))
+		require.NotNil(t, b.Wait(ctx, 1, 200))
+		require.Nil(t, b.Wait(ctx, 1, 100))
+	})
+}
+
+func TestBudgetDeadline(t *testing.T) {
+	b := NewBudget("test", 1, 0, time.Minute, 0)
+	require.Nil(t, b.Wait(context.Background(), 1, 0))
+
+	// waiting would exceed the deadline of the call
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := b.Wait(ctx, 1, 0)
+	assert.True(t, errors.As(err, &ErrBudgetExhausted{}))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, b.Wait(ctx, 1, 0), context.Canceled)
+}
+
+func TestBudgetMaxQueued(t *testing.T) {
+	b := NewBudget("test", 1, 0, time.Minute, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	require.Nil(t, b.Wait(ctx, 1, 0))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		b.Wait(ctx, 1, 0)
+	}()
+	require.Eventually(t, func() bool { return b.queued.Load() == 1 }, time.Second, time.Millisecond)
+
+	var exhausted ErrBudgetExhausted
+	require.True(t, errors.As(b.Wait(ctx, 1, 0), &exhausted))
+	assert.True(t, exhausted.QueueFull)
+
+	cancel()
+	wg.Wait()
+	assert.Equal(t, int64(0), b.queued.Load())
+}
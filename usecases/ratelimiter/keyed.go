@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// minSweepSize is the number of tracked keys below which idle per-key
+// limiters are never cleaned up
+const minSweepSize = 1024
+
+// Keyed limits the request rate per key (e.g. per API key) and across all
+// keys. A rate of 0 disables the respective limit.
+type Keyed struct {
+	perKey      rate.Limit
+	perKeyBurst int
+	global      *rate.Limiter
+
+	mu      sync.Mutex
+	keys    map[string]*keyedEntry
+	sweepAt int
+}
+
+type keyedEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewKeyed creates a limiter allowing perKey requests per second for every
+// key and global requests per second overall. A burst of 0 defaults to the
+// corresponding rate.
+func NewKeyed(perKey, perKeyBurst, global, globalBurst int) *Keyed {
+	k := &Keyed{
+		perKey:      rate.Limit(perKey),
+		perKeyBurst: burstOrRate(perKeyBurst, perKey),
+		keys:        map[string]*keyedEntry{},
+		sweepAt:     minSweepSize,
+	}
+	if global > 0 {
+		k.global = rate.NewLimiter(rate.Limit(global), burstOrRate(globalBurst, global))
+	}
+	return k
+}
+
+func burstOrRate(burst, r int) int {
+	if burst > 0 {
+		return burst
+	}
+	return r
+}
+
+// Allow reports whether a request for key may proceed at time now. If not,
+// it also returns how long the caller should wait before retrying.
+func (k *Keyed) Allow(key string, now time.Time) (bool, time.Duration) {
+	var perKey *rate.Reservation
+	if k.perKey > 0 {
+		perKey = k.reserve(key, now)
+		if delay := perKey.DelayFrom(now); delay > 0 {
+			perKey.CancelAt(now)
+			return false, delay
+		}
+	}
+
+	if k.global != nil {
+		global := k.global.ReserveN(now, 1)
+		if delay := global.DelayFrom(now); delay > 0 {
+			global.CancelAt(now)
+			// the request is not served, so it must not count against the key
+			if perKey != nil {
+				perKey.CancelAt(now)
+			}
+			return false, delay
+		}
+	}
+
+	return true, 0
+}
+
+// Remaining returns how many requests key can make right away without
+// exceeding the per-key limit, and the size of its burst. ok is false if
+// there is no per-key limit.
+func (k *Keyed) Remaining(key string, now time.Time) (remaining, burst int, ok bool) {
+	if k.perKey <= 0 {
+		return 0, 0, false
+	}
+
+	k.mu.Lock()
+	e, found := k.keys[key]
+	k.mu.Unlock()
+	if !found {
+		return k.perKeyBurst, k.perKeyBurst, true
+	}
+	return max(0, int(e.limiter.TokensAt(now))), k.perKeyBurst, true
+}
+
+func (k *Keyed) reserve(key string, now time.Time) *rate.Reservation {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	e, ok := k.keys[key]
+	if !ok {
+		if len(k.keys) >= k.sweepAt {
+			k.sweep(now)
+			k.sweepAt = max(minSweepSize, 2*len(k.keys))
+		}
+		e = &keyedEntry{limiter: rate.NewLimiter(k.perKey, k.perKeyBurst)}
+		k.keys[key] = e
+	}
+	e.lastSeen = now
+	return e.limiter.ReserveN(now, 1)
+}
+
+// sweep removes limiters which have been idle long enough to refill
+// completely, as they behave exactly like newly created ones.
+func (k *Keyed) sweep(now time.Time) {
+	refill := time.Duration(math.Ceil(float64(k.perKeyBurst) / float64(k.perKey) * float64(time.Second)))
+	for key, e := range k.keys {
+		if now.Sub(e.lastSeen) >= refill {
+			delete(k.keys, key)
+		}
+	}
+}
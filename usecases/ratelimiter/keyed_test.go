@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedPerKey(t *testing.T) {
+	l := NewKeyed(2, 0, 0, 0)
+	now := time.Now()
+
+	ok, _ := l.Allow("a", now)
+	assert.True(t, ok)
+	ok, _ = l.Allow("a", now)
+	assert.True(t, ok)
+
+	ok, retryAfter := l.Allow("a", now)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// other keys are not affected
+	ok, _ = l.Allow("b", now)
+	assert.True(t, ok)
+
+	ok, _ = l.Allow("a", now.Add(500*time.Millisecond))
+	assert.True(t, ok)
+}
+
+func TestKeyedRemaining(t *testing.T) {
+	l := NewKeyed(5, 10, 0, 0)
+	now := time.Now()
+
+	remaining, burst, ok := l.Remaining("a", now)
+	assert.True(t, ok)
+	assert.Equal(t, 10, remaining)
+	assert.Equal(t, 10, burst)
+
+	for i := 0; i < 9; i++ {
+		l.Allow("a", now)
+	}
+	remaining, _, _ = l.Remaining("a", now)
+	assert.Equal(t, 1, remaining)
+	remaining, _, _ = l.Remaining("a", now.Add(time.Second))
+	assert.Equal(t, 6, remaining)
+
+	_, _, ok = NewKeyed(0, 0, 10, 0).Remaining("a", now)
+	assert.False(t, ok, "no per-key limit")
+}
+
+func TestKeyedGlobal(t *testing.T) {
+	l := NewKeyed(10, 0, 1, 2)
+	now := time.Now()
+
+	ok, _ := l.Allow("a", now)
+	assert.True(t, ok)
+	ok, _ = l.Allow("b", now)
+	assert.True(t, ok)
+
+	ok, retryAfter := l.Allow("c", now)
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+}
+
+func TestKeyedGlobalRejectionDoesNotCountPerKey(t *testing.T) {
+	l := NewKeyed(1, 0, 1, 0)
+	now := time.Now()
+
+	ok, _ := l.Allow("a", now)
+	assert.True(t, ok)
+
+	// rejected by the global limit, so b keeps its token
+	ok, _ = l.Allow("b", now)
+	assert.False(t, ok)
+
+	ok, _ = l.Allow("b", now.Add(time.Second))
+	assert.True(t, ok)
+}
+
+func TestKeyedUnlimited(t *testing.T) {
+	l := NewKeyed(0, 0, 0, 0)
+	now := time.Now()
+
+	for i := 0; i < 1000; i++ {
+		ok, _ := l.Allow("a", now)
+		assert.True(t, ok)
+	}
+	assert.Empty(t, l.keys)
+}
+
+func TestKeyedSweep(t *testing.T) {
+	l := NewKeyed(1, 0, 0, 0)
+	now := time.Now()
+
+	for i := 0; i < minSweepSize; i++ {
+		l.Allow(string(rune(i)), now)
+	}
+	assert.Len(t, l.keys, minSweepSize)
+
+	// all previous keys have refilled by now and are dropped
+	l.Allow("new", now.Add(time.Second))
+	assert.Len(t, l.keys, 1)
+}
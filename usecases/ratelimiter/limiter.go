@@ -30,13 +30,11 @@ func New(maxRequests int) *Limiter {
 // there are too many concurrent requests it does not increase the counter and
 // returns false
 func (l *Limiter) TryInc() bool {
-	if l.max <= 0 {
-		return true
-	}
-
+	// requests are counted even without a limit, so that a limit set at
+	// runtime considers the requests which are already running
 	new := atomic.AddInt64(&l.current, 1)
 
-	if new <= l.max {
+	if limit := atomic.LoadInt64(&l.max); limit <= 0 || new <= limit {
 		return true
 	}
 
@@ -46,10 +44,6 @@ func (l *Limiter) TryInc() bool {
 }
 
 func (l *Limiter) Dec() {
-	if l.max <= 0 {
-		return
-	}
-
 	new := atomic.AddInt64(&l.current, -1)
 	if new < 0 {
 		// Should not happen unless some client called Dec multiple times.
@@ -58,3 +52,9 @@ func (l *Limiter) Dec() {
 		atomic.CompareAndSwapInt64(&l.current, new, 0)
 	}
 }
+
+// SetMax changes the maximum concurrent requests. Requests which are already
+// running are not affected, a value <= 0 removes the limit.
+func (l *Limiter) SetMax(maxRequests int) {
+	atomic.StoreInt64(&l.max, int64(maxRequests))
+}
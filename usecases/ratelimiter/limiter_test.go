@@ -103,6 +103,23 @@ func TestLimiterCantGoNegative(t *testing.T) {
 	assert.False(t, l.TryInc())
 }
 
+func TestLimiterSetMax(t *testing.T) {
+	l := New(-1)
+	assert.True(t, l.TryInc())
+	assert.True(t, l.TryInc())
+
+	// the running requests count towards the new limit
+	l.SetMax(3)
+	assert.True(t, l.TryInc())
+	assert.False(t, l.TryInc())
+
+	l.Dec()
+	assert.True(t, l.TryInc())
+
+	l.SetMax(0)
+	assert.True(t, l.TryInc())
+}
+
 func BenchmarkLimiter(b *testing.B) {
 	l := New(-1)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	// ConflictExistOrDeleted means an object exists on some replicas but is
+	// deleted on others, and the deletion strategy leaves it unresolved
+	ConflictExistOrDeleted = "exist_or_deleted"
+	// ConflictPropertyMerge means the property merge kept properties which
+	// last-write-wins would have dropped
+	ConflictPropertyMerge = "property_merge"
+)
+
+// Conflict describes replicas which diverged in a way that is not resolved
+// silently
+type Conflict struct {
+	Time   time.Time   `json:"time"`
+	Class  string      `json:"class"`
+	Shard  string      `json:"shard"`
+	ID     strfmt.UUID `json:"id"`
+	Kind   string      `json:"kind"`
+	Detail string      `json:"detail,omitempty"`
+}
+
+// ConflictReport keeps the most recent conflicts of this node for review by
+// an administrator. A nil report discards all conflicts.
+type ConflictReport struct {
+	mu        sync.Mutex
+	conflicts []Conflict
+	next      int
+}
+
+// NewConflictReport returns a report holding up to size conflicts
+func NewConflictReport(size int) *ConflictReport {
+	return &ConflictReport{conflicts: make([]Conflict, 0, size)}
+}
+
+// Record adds c to the report, replacing the oldest conflict if it is full
+func (r *ConflictReport) Record(c Conflict) {
+	if r == nil || cap(r.conflicts) == 0 {
+		return
+	}
+	if c.Time.IsZero() {
+		c.Time = time.Now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.conflicts) < cap(r.conflicts) {
+		r.conflicts = append(r.conflicts, c)
+		return
+	}
+	r.conflicts[r.next] = c
+	r.next = (r.next + 1) % len(r.conflicts)
+}
+
+// List returns the recorded conflicts, oldest first
+func (r *ConflictReport) List() []Conflict {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := make([]Conflict, 0, len(r.conflicts))
+	list = append(list, r.conflicts[r.next:]...)
+	return append(list, r.conflicts[:r.next]...)
+}
+
+// MergeProperties implements the PropertyMerge conflict resolution: the
+// properties of the latest version win, but properties it lacks are taken
+// from the stale version. It returns the merged properties and the names of
+// those taken from stale in sorted order.
+func MergeProperties(latest, stale map[string]interface{}) (map[string]interface{}, []string) {
+	var taken []string
+	for name := range stale {
+		if _, ok := latest[name]; !ok {
+			taken = append(taken, name)
+		}
+	}
+	if len(taken) == 0 {
+		return latest, nil
+	}
+	sort.Strings(taken)
+
+	merged := make(map[string]interface{}, len(latest)+len(taken))
+	for name, v := range latest {
+		merged[name] = v
+	}
+	for _, name := range taken {
+		merged[name] = stale[name]
+	}
+	return merged, taken
+}
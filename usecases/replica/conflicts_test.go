@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConflictReport(t *testing.T) {
+	r := NewConflictReport(3)
+	assert.Empty(t, r.List())
+
+	for _, id := range []strfmt.UUID{"1", "2", "3", "4", "5"} {
+		r.Record(Conflict{ID: id, Kind: ConflictPropertyMerge})
+	}
+
+	list := r.List()
+	ids := make([]strfmt.UUID, len(list))
+	for i, c := range list {
+		ids[i] = c.ID
+		assert.False(t, c.Time.IsZero())
+	}
+	assert.Equal(t, []strfmt.UUID{"3", "4", "5"}, ids, "only the most recent, oldest first")
+}
+
+func TestConflictReportNil(t *testing.T) {
+	var r *ConflictReport
+	r.Record(Conflict{ID: "1"})
+	assert.Nil(t, r.List())
+}
+
+func TestMergeProperties(t *testing.T) {
+	t.Run("nothing to merge", func(t *testing.T) {
+		latest := map[string]interface{}{"a": "new", "b": 2.0}
+		merged, taken := MergeProperties(latest, map[string]interface{}{"a": "old"})
+		assert.Equal(t, latest, merged)
+		assert.Empty(t, taken)
+	})
+
+	t.Run("missing properties are kept", func(t *testing.T) {
+		latest := map[string]interface{}{"a": "new"}
+		stale := map[string]interface{}{"a": "old", "c": true, "b": 1.0}
+		merged, taken := MergeProperties(latest, stale)
+		assert.Equal(t, map[string]interface{}{"a": "new", "b": 1.0, "c": true}, merged)
+		assert.Equal(t, []string{"b", "c"}, taken)
+		assert.Len(t, latest, 1, "latest must not be modified")
+	})
+
+	t.Run("no latest properties", func(t *testing.T) {
+		merged, taken := MergeProperties(nil, map[string]interface{}{"a": "old"})
+		assert.Equal(t, map[string]interface{}{"a": "old"}, merged)
+		assert.Equal(t, []string{"a"}, taken)
+	})
+}
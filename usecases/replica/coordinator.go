@@ -180,7 +180,7 @@ func (c *coordinator[T]) Push(ctx context.Context,
 	ask readyOp,
 	com commitOp[T],
 ) (<-chan _Result[T], int, error) {
-	state, err := c.Resolver.State(c.Shard, cl, "")
+	state, err := c.Resolver.State(c.Shard, cl, "", ReadRoutingLocalFirst)
 	if err != nil {
 		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
@@ -212,7 +212,8 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 	op readOp[T], directCandidate string,
 	timeout time.Duration,
 ) (<-chan _Result[T], rState, error) {
-	state, err := c.Resolver.State(c.Shard, cl, directCandidate)
+	routing := readRoutingFromContext(ctx, c.Resolver.ReadRouting)
+	state, err := c.Resolver.State(c.Shard, cl, directCandidate, routing)
 	if err != nil {
 		return nil, state, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
@@ -320,3 +320,11 @@ func (r batchReply) UpdateTimeAt(idx int) int64 {
 	}
 	return r.FullData[idx].UpdateTime()
 }
+
+// DeletedAt reports whether the object at idx is deleted on the sender
+func (r batchReply) DeletedAt(idx int) bool {
+	if len(r.DigestData) != 0 {
+		return r.DigestData[idx].Deleted
+	}
+	return r.FullData[idx].Deleted
+}
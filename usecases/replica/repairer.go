@@ -47,6 +47,19 @@ type repairer struct {
 	deletionStrategy string
 	client           finderClient // needed to commit and abort operation
 	logger           logrus.FieldLogger
+	conflicts        *ConflictReport // unresolved conflicts are reported here
+}
+
+// reportExistOrDeleted records a conflict which the deletion strategy leaves
+// unresolved
+func (r *repairer) reportExistOrDeleted(shard string, id strfmt.UUID, deletedOn, existsOn []string) {
+	r.conflicts.Record(Conflict{
+		Class:  r.class,
+		Shard:  shard,
+		ID:     id,
+		Kind:   ConflictExistOrDeleted,
+		Detail: fmt.Sprintf("deleted on %v, exists on %v", deletedOn, existsOn),
+	})
 }
 
 // repairOne repairs a single object (used by Finder::GetOne)
@@ -108,6 +121,15 @@ func (r *repairer) repairOne(ctx context.Context,
 	}
 
 	if deleted && r.deletionStrategy != models.ReplicationConfigDeletionStrategyTimeBasedResolution {
+		var deletedOn, existsOn []string
+		for _, x := range votes {
+			if x.o.Deleted {
+				deletedOn = append(deletedOn, x.sender)
+			} else {
+				existsOn = append(existsOn, x.sender)
+			}
+		}
+		r.reportExistOrDeleted(shard, id, deletedOn, existsOn)
 		return nil, errConflictExistOrDeleted
 	}
 
@@ -241,6 +263,15 @@ func (r *repairer) repairExist(ctx context.Context,
 	}
 
 	if deleted && r.deletionStrategy != models.ReplicationConfigDeletionStrategyTimeBasedResolution {
+		var deletedOn, existsOn []string
+		for _, x := range votes {
+			if x.o.Deleted {
+				deletedOn = append(deletedOn, x.sender)
+			} else {
+				existsOn = append(existsOn, x.sender)
+			}
+		}
+		r.reportExistOrDeleted(shard, id, deletedOn, existsOn)
 		return false, errConflictExistOrDeleted
 	}
 
@@ -410,6 +441,26 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 		}
 	}
 
+	if r.deletionStrategy != models.ReplicationConfigDeletionStrategyDeleteOnConflict &&
+		r.deletionStrategy != models.ReplicationConfigDeletionStrategyTimeBasedResolution {
+		for j, x := range lastTimes {
+			if !x.Deleted {
+				continue
+			}
+			var deletedOn, existsOn []string
+			for _, vote := range votes {
+				if vote.DeletedAt(j) {
+					deletedOn = append(deletedOn, vote.Sender)
+				} else {
+					existsOn = append(existsOn, vote.Sender)
+				}
+			}
+			if len(existsOn) > 0 {
+				r.reportExistOrDeleted(shard, ids[j], deletedOn, existsOn)
+			}
+		}
+	}
+
 	// concurrent repairs
 	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(r.logger, ctx)
 
@@ -241,11 +241,18 @@ func TestRepairerOneWithALL(t *testing.T) {
 		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
 		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
 		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		finder.conflicts = NewConflictReport(10)
 
 		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
 		require.ErrorContains(t, err, msgCLevel)
 		require.Equal(t, nilObject, got)
 		f.assertLogErrorContains(t, errConflictExistOrDeleted.Error())
+
+		conflicts := finder.conflicts.List()
+		require.Len(t, conflicts, 1)
+		require.Equal(t, ConflictExistOrDeleted, conflicts[0].Kind)
+		require.Equal(t, id, conflicts[0].ID)
+		require.Equal(t, shard, conflicts[0].Shard)
 	})
 	t.Run("NoConflictDeletedObject", func(t *testing.T) {
 		var (
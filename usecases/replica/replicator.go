@@ -69,6 +69,8 @@ func NewReplicator(className string,
 	stateGetter shardingState,
 	nodeResolver nodeResolver,
 	deletionStrategy string,
+	readRouting ReadRouting,
+	conflicts *ConflictReport,
 	client Client,
 	l logrus.FieldLogger,
 ) *Replicator {
@@ -77,15 +79,18 @@ func NewReplicator(className string,
 		nodeResolver: nodeResolver,
 		Class:        className,
 		NodeName:     stateGetter.NodeName(),
+		ReadRouting:  readRouting,
 	}
+	finder := NewFinder(className, resolver, client, l,
+		defaultPullBackOffInitialInterval, defaultPullBackOffMaxElapsedTime, deletionStrategy)
+	finder.conflicts = conflicts
 	return &Replicator{
 		class:       className,
 		stateGetter: stateGetter,
 		client:      client,
 		resolver:    resolver,
 		log:         l,
-		Finder: NewFinder(className, resolver, client, l,
-			defaultPullBackOffInitialInterval, defaultPullBackOffMaxElapsedTime, deletionStrategy),
+		Finder:      finder,
 	}
 }
 
@@ -100,8 +105,9 @@ func (r *Replicator) PutObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObject), r.log)
+	withIfMatch := ifMatchOf(ctx)
 	isReady := func(ctx context.Context, host, requestID string) error {
-		resp, err := r.client.PutObject(ctx, host, r.class, shard, requestID, obj, schemaVersion)
+		resp, err := r.client.PutObject(withIfMatch(ctx), host, r.class, shard, requestID, obj, schemaVersion)
 		if err == nil {
 			err = resp.FirstError()
 		}
@@ -121,6 +127,10 @@ func (r *Replicator) PutObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", obj.ID()).Error(err)
+		replicaErr, ok := err.(*Error)
+		if ok && replicaErr != nil && replicaErr.Code == StatusObjectChanged {
+			return objects.NewErrObjectChanged("%s", replicaErr.Msg)
+		}
 	}
 	return err
 }
@@ -132,8 +142,9 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opMergeObject), r.log)
+	withIfMatch := ifMatchOf(ctx)
 	op := func(ctx context.Context, host, requestID string) error {
-		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc, schemaVersion)
+		resp, err := r.client.MergeObject(withIfMatch(ctx), host, r.class, shard, requestID, doc, schemaVersion)
 		if err == nil {
 			err = resp.FirstError()
 		}
@@ -156,6 +167,9 @@ func (r *Replicator) MergeObject(ctx context.Context,
 		if ok && replicaErr != nil && replicaErr.Code == StatusObjectNotFound {
 			return objects.NewErrDirtyWriteOfDeletedObject(replicaErr)
 		}
+		if ok && replicaErr != nil && replicaErr.Code == StatusObjectChanged {
+			return objects.NewErrObjectChanged("%s", replicaErr.Msg)
+		}
 	}
 	return err
 }
@@ -341,3 +355,15 @@ func (r *Replicator) requestID(op opID) string {
 		time.Now().UnixMilli(),
 		r.requestCounter.Add(1))
 }
+
+// ifMatchOf returns a function which adds the precondition of a conditional
+// update in ctx to the contexts the coordinator derives for the replicas
+func ifMatchOf(ctx context.Context) func(context.Context) context.Context {
+	m, ok := objects.IfMatchFromContext(ctx)
+	return func(ctx context.Context) context.Context {
+		if !ok {
+			return ctx
+		}
+		return objects.ContextWithIfMatch(ctx, m)
+	}
+}
@@ -708,6 +708,8 @@ func (f fakeFactory) newReplicator() *Replicator {
 		shardingState,
 		nodeResolver,
 		models.ReplicationConfigDeletionStrategyNoAutomatedResolution,
+		ReadRoutingLocalFirst,
+		nil,
 		struct {
 			rClient
 			wClient
@@ -49,10 +49,12 @@ type resolver struct {
 	nodeResolver
 	Class    string
 	NodeName string
+	// ReadRouting is used for reads unless the request overrides it
+	ReadRouting ReadRouting
 }
 
-// State returns replicas state
-func (r *resolver) State(shardName string, cl ConsistencyLevel, directCandidate string) (res rState, err error) {
+// State returns replicas state, with hosts ordered according to routing
+func (r *resolver) State(shardName string, cl ConsistencyLevel, directCandidate string, routing ReadRouting) (res rState, err error) {
 	res.CLevel = cl
 	m, err := r.Schema.ResolveParentNodes(r.Class, shardName)
 	if err != nil {
@@ -77,11 +79,24 @@ func (r *resolver) State(shardName string, cl ConsistencyLevel, directCandidate
 	if addr := m[directCandidate]; addr != "" {
 		res.Hosts = append(res.Hosts, addr)
 	}
+	// with nearest routing, replicas in other zones come last and are only
+	// asked if the ones in this zone do not suffice
+	var zone string
+	zr, ok := r.nodeResolver.(zoneResolver)
+	if ok && routing == ReadRoutingNearest {
+		zone = zr.NodeZone(r.NodeName)
+	}
+	var otherZones []string
 	for name, addr := range m {
 		if name != "" && addr != "" && name != directCandidate {
+			if zone != "" && zr.NodeZone(name) != zone {
+				otherZones = append(otherZones, addr)
+				continue
+			}
 			res.Hosts = append(res.Hosts, addr)
 		}
 	}
+	res.Hosts = append(res.Hosts, otherZones...)
 
 	if res.Len() == 0 {
 		return res, errNoReplicaFound
@@ -12,9 +12,12 @@
 package replica
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestResolver(t *testing.T) {
@@ -45,7 +48,7 @@ func TestResolver(t *testing.T) {
 		Schema:       newFakeShardingState("A", ss, nr),
 	}
 	t.Run("ShardingState", func(t *testing.T) {
-		_, err := r.State("Sx", One, "")
+		_, err := r.State("Sx", One, "", ReadRoutingLocalFirst)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "sharding state")
 	})
@@ -56,7 +59,7 @@ func TestResolver(t *testing.T) {
 			NodeName:     "B",
 			Schema:       newFakeShardingState("B", ss, nr),
 		}
-		got, err := r.State("S1", All, "")
+		got, err := r.State("S1", All, "", ReadRoutingLocalFirst)
 		assert.Nil(t, err)
 		m := make(map[string]string, len(ss["S1"]))
 		for _, k := range ss["S1"] {
@@ -67,7 +70,7 @@ func TestResolver(t *testing.T) {
 	})
 
 	t.Run("ALLWithDirectCandidate", func(t *testing.T) {
-		got, err := r.State("S1", All, "B")
+		got, err := r.State("S1", All, "B", ReadRoutingLocalFirst)
 		assert.Nil(t, err)
 		m := make(map[string]string, len(ss["S1"]))
 		for _, k := range ss["S1"] {
@@ -77,7 +80,7 @@ func TestResolver(t *testing.T) {
 		assertSameHosts(want, got, "B")
 	})
 	t.Run("Quorum", func(t *testing.T) {
-		got, err := r.State("S3", Quorum, "")
+		got, err := r.State("S3", Quorum, "", ReadRoutingLocalFirst)
 		assert.Nil(t, err)
 
 		m := make(map[string]string, len(ss["S1"]))
@@ -94,7 +97,7 @@ func TestResolver(t *testing.T) {
 		assert.Nil(t, err)
 	})
 	t.Run("NoQuorum", func(t *testing.T) {
-		got, err := r.State("S5", Quorum, "")
+		got, err := r.State("S5", Quorum, "", ReadRoutingLocalFirst)
 		assert.ErrorIs(t, err, errUnresolvedName)
 		m := make(map[string]string, len(ss["S1"]))
 		for _, k := range ss["S5"] {
@@ -111,3 +114,65 @@ func TestResolver(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+type fakeZoneResolver struct {
+	*fakeNodeResolver
+	zones map[string]string
+}
+
+func (r fakeZoneResolver) NodeZone(nodeName string) string {
+	return r.zones[nodeName]
+}
+
+func TestResolverNearest(t *testing.T) {
+	ss := map[string][]string{"S1": {"A", "B", "C", "D", "E"}}
+	nr := newFakeNodeResolver([]string{"A", "B", "C", "D", "E"})
+	r := resolver{
+		nodeResolver: fakeZoneResolver{nr, map[string]string{
+			"A": "z1", "B": "z2", "C": "z1", "D": "z2", "E": "z1",
+		}},
+		Class:    "C",
+		NodeName: "A",
+		Schema:   newFakeShardingState("A", ss, nr),
+	}
+
+	t.Run("same zone first", func(t *testing.T) {
+		got, err := r.State("S1", Quorum, "", ReadRoutingNearest)
+		require.Nil(t, err)
+		require.Len(t, got.Hosts, 5)
+		assert.Equal(t, "A", got.Hosts[0])
+		assert.ElementsMatch(t, []string{"C", "E"}, got.Hosts[1:3])
+		assert.ElementsMatch(t, []string{"B", "D"}, got.Hosts[3:])
+	})
+
+	t.Run("direct candidate stays first", func(t *testing.T) {
+		got, err := r.State("S1", One, "B", ReadRoutingNearest)
+		require.Nil(t, err)
+		assert.Equal(t, "B", got.Hosts[0])
+		assert.ElementsMatch(t, []string{"A", "C", "E"}, got.Hosts[1:4])
+		assert.Equal(t, "D", got.Hosts[4])
+	})
+
+	t.Run("no zone configured", func(t *testing.T) {
+		r := r
+		r.nodeResolver = fakeZoneResolver{nr, map[string]string{}}
+		got, err := r.State("S1", All, "", ReadRoutingNearest)
+		require.Nil(t, err)
+		assert.Equal(t, "A", got.Hosts[0])
+		assert.ElementsMatch(t, ss["S1"], got.Hosts)
+	})
+}
+
+func TestReadRoutingFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ReadRoutingLocalFirst, readRoutingFromContext(ctx, ReadRoutingLocalFirst))
+
+	rest := context.WithValue(ctx, ReadRoutingHeader, []string{"nearest"})
+	assert.Equal(t, ReadRoutingNearest, readRoutingFromContext(rest, ReadRoutingLocalFirst))
+
+	grpc := metadata.NewIncomingContext(ctx, metadata.Pairs(ReadRoutingHeader, "local-first"))
+	assert.Equal(t, ReadRoutingLocalFirst, readRoutingFromContext(grpc, ReadRoutingNearest))
+
+	invalid := context.WithValue(ctx, ReadRoutingHeader, []string{"farthest"})
+	assert.Equal(t, ReadRoutingNearest, readRoutingFromContext(invalid, ReadRoutingNearest))
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ReadRouting decides in which order replicas are asked to serve a read
+type ReadRouting string
+
+const (
+	// ReadRoutingLocalFirst asks the local replica first and falls back to
+	// the others in random order
+	ReadRoutingLocalFirst ReadRouting = "local-first"
+	// ReadRoutingNearest asks the local replica first, followed by replicas in
+	// the same zone. Other zones are only asked when those do not suffice.
+	ReadRoutingNearest ReadRouting = "nearest"
+)
+
+// ReadRoutingHeader overrides the configured read routing for a request
+const ReadRoutingHeader = "X-Weaviate-Read-Routing"
+
+// zoneResolver is implemented by node resolvers aware of availability zones
+type zoneResolver interface {
+	NodeZone(nodeName string) string
+}
+
+// ParseReadRouting validates a read routing policy. An empty value selects
+// the default ReadRoutingLocalFirst.
+func ParseReadRouting(s string) (ReadRouting, error) {
+	switch r := ReadRouting(strings.ToLower(s)); r {
+	case "":
+		return ReadRoutingLocalFirst, nil
+	case ReadRoutingLocalFirst, ReadRoutingNearest:
+		return r, nil
+	default:
+		return "", fmt.Errorf("unknown read routing %q, must be one of %q, %q",
+			s, ReadRoutingLocalFirst, ReadRoutingNearest)
+	}
+}
+
+// readRoutingFromContext returns the routing requested through
+// ReadRoutingHeader, or fallback if none or an invalid one was requested
+func readRoutingFromContext(ctx context.Context, fallback ReadRouting) ReadRouting {
+	var values []string
+	if v, ok := ctx.Value(ReadRoutingHeader).([]string); ok {
+		values = v
+	} else if md, ok := metadata.FromIncomingContext(ctx); ok {
+		values = md[strings.ToLower(ReadRoutingHeader)]
+	}
+	if len(values) > 0 && values[0] != "" {
+		if r, err := ParseReadRouting(values[0]); err == nil {
+			return r
+		}
+	}
+	return fallback
+}
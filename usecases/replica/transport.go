@@ -51,6 +51,7 @@ const (
 	StatusPreconditionFailed
 	StatusReadOnly
 	StatusObjectNotFound
+	StatusObjectChanged
 )
 
 // Error reports error happening during replication
@@ -109,6 +110,8 @@ func statusText(code StatusCode) string {
 		return "read only"
 	case StatusObjectNotFound:
 		return "object not found"
+	case StatusObjectChanged:
+		return "object changed"
 	default:
 		return ""
 	}
@@ -55,6 +55,7 @@ func TestReplicationErrorStatus(t *testing.T) {
 		{StatusConflict, "conflict"},
 		{StatusPreconditionFailed, "precondition failed"},
 		{StatusReadOnly, "read only"},
+		{StatusObjectChanged, "object changed"},
 	}
 	for _, test := range tests {
 		got := statusText(test.code)
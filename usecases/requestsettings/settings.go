@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package requestsettings carries the settings a client can set for a whole
+// request via headers. They are parsed once when the request comes in and
+// are read from the context by the layers which apply them.
+package requestsettings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+const (
+	ConsistencyLevelHeader = "X-Weaviate-Consistency-Level"
+	TenantHeader           = "X-Weaviate-Tenant"
+	TimeoutHeader          = "X-Weaviate-Timeout"
+)
+
+// Settings are defaults for the request, parameters and arguments of an
+// operation take precedence over them
+type Settings struct {
+	ConsistencyLevel string
+	Tenant           string
+	Timeout          time.Duration
+}
+
+// FromHeaders parses the settings, a header which is not set leaves the
+// setting empty
+func FromHeaders(h http.Header) (Settings, error) {
+	s := Settings{
+		ConsistencyLevel: h.Get(ConsistencyLevelHeader),
+		Tenant:           h.Get(TenantHeader),
+	}
+
+	switch replica.ConsistencyLevel(s.ConsistencyLevel) {
+	case "", replica.One, replica.Quorum, replica.All:
+	default:
+		return Settings{}, fmt.Errorf("unrecognized consistency level '%v' in header %s, "+
+			"try one of the following: ['ONE', 'QUORUM', 'ALL']", s.ConsistencyLevel, ConsistencyLevelHeader)
+	}
+
+	if v := h.Get(TimeoutHeader); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			return Settings{}, fmt.Errorf("header %s must be a positive duration like '30s', got '%v'",
+				TimeoutHeader, v)
+		}
+		s.Timeout = timeout
+	}
+
+	return s, nil
+}
+
+type settingsKey struct{}
+
+// WithSettings returns a copy of ctx carrying the settings
+func WithSettings(ctx context.Context, s Settings) context.Context {
+	return context.WithValue(ctx, settingsKey{}, s)
+}
+
+// FromContext returns the settings stored in ctx, or empty settings
+func FromContext(ctx context.Context) Settings {
+	if ctx == nil {
+		return Settings{}
+	}
+	s, _ := ctx.Value(settingsKey{}).(Settings)
+	return s
+}
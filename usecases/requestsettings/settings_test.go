@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package requestsettings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromHeaders(t *testing.T) {
+	headers := func(kv ...string) http.Header {
+		h := http.Header{}
+		for i := 0; i < len(kv); i += 2 {
+			h.Set(kv[i], kv[i+1])
+		}
+		return h
+	}
+
+	s, err := FromHeaders(headers())
+	require.Nil(t, err)
+	assert.Equal(t, Settings{}, s)
+
+	s, err = FromHeaders(headers(ConsistencyLevelHeader, "QUORUM", TenantHeader, "tenant1", TimeoutHeader, "1m30s"))
+	require.Nil(t, err)
+	assert.Equal(t, Settings{ConsistencyLevel: "QUORUM", Tenant: "tenant1", Timeout: 90 * time.Second}, s)
+
+	for _, h := range []http.Header{
+		headers(ConsistencyLevelHeader, "MOST"),
+		headers(TimeoutHeader, "30"),
+		headers(TimeoutHeader, "-1s"),
+	} {
+		_, err := FromHeaders(h)
+		assert.NotNil(t, err, h)
+	}
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, Settings{}, FromContext(context.Background()))
+
+	ctx := WithSettings(context.Background(), Settings{Tenant: "tenant1"})
+	assert.Equal(t, Settings{Tenant: "tenant1"}, FromContext(ctx))
+}
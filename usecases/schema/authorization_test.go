@@ -72,6 +72,18 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.CREATE,
 			expectedResources: authorization.Collections(),
 		},
+		{
+			methodName:        "ImportSchema",
+			additionalArgs:    []interface{}{&models.Schema{Classes: []*models.Class{{Class: "classname"}}}},
+			expectedVerb:      authorization.CREATE,
+			expectedResources: authorization.Collections(),
+		},
+		{
+			methodName:        "ValidateSchema",
+			additionalArgs:    []interface{}{&models.Schema{}},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Collections(),
+		},
 		{
 			methodName:        "UpdateClass",
 			additionalArgs:    []interface{}{"class", &models.Class{Class: "class"}},
@@ -90,6 +102,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.Collections("classname"),
 		},
+		{
+			methodName:        "UpdatePropertyLifecycle",
+			additionalArgs:    []interface{}{"classname", "prop", models.PropertyLifecycleDeprecated},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Collections("classname"),
+		},
 		{
 			methodName:        "DeleteClassProperty",
 			additionalArgs:    []interface{}{"somename", "someprop"},
@@ -159,14 +177,16 @@ func Test_Schema_Authorization(t *testing.T) {
 			case "RegisterSchemaUpdateCallback",
 				// introduced by sync.Mutex in go 1.18
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
-				"TryLock", "RLocker", "TryRLock", "CopyShardingState", "TxManager", "RestoreClass",
+				"TryLock", "RLocker", "TryRLock", "CopyShardingState", "TxManager", "RestoreClass", "ClassesVersion",
 				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas",
 				// internal methods to indicate readiness state
 				"StartServing", "Shutdown", "Statistics",
 				// Cluster/nodes related endpoint
 				"JoinNode", "RemoveNode", "Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
 				// revert to schema v0 (non raft)
-				"StoreSchemaV1":
+				"StoreSchemaV1",
+				// startup maintenance
+				"SyncTemplates":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
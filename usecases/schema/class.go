@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 
 	entcfg "github.com/weaviate/weaviate/entities/config"
@@ -26,14 +27,17 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/classcache"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/logging"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -82,7 +86,7 @@ func (h *Handler) GetCachedClass(ctxWithClassCache context.Context,
 		for _, vclass := range vclasses {
 			if err := h.parser.ParseClass(vclass.Class); err != nil {
 				// remove invalid classes
-				h.logger.WithFields(logrus.Fields{
+				logging.WithRequest(ctxWithClassCache, h.logger).WithFields(logrus.Fields{
 					"Class": vclass.Class.Class,
 					"Error": err,
 				}).Warn("parsing class error")
@@ -104,10 +108,34 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 		return nil, 0, err
 	}
 
+	shardState, err := h.prepareNewClass(ctx, cls, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	version, err := h.applyIfUnchanged(ctx, func() (uint64, error) {
+		return h.schemaManager.AddClass(ctx, cls, shardState)
+	})
+	if errors.Is(err, clusterSchema.ErrClassExists) {
+		// another class was added concurrently since the check above
+		return nil, 0, NewErrClassExists("%v", err)
+	} else if err != nil {
+		return nil, 0, err
+	}
+	return cls, version, err
+}
+
+// prepareNewClass normalizes and validates a class which is about to be added
+// and initializes its sharding state
+func (h *Handler) prepareNewClass(ctx context.Context, cls *models.Class,
+	relaxCrossRefValidation bool,
+) (*sharding.State, error) {
 	cls.Class = schema.UppercaseClassName(cls.Class)
+	if err := h.expandTemplates(cls); err != nil {
+		return nil, err
+	}
 	cls.Properties = schema.LowercaseAllPropertyNames(cls.Properties)
 	if cls.ShardingConfig != nil && schema.MultiTenancyEnabled(cls) {
-		return nil, 0, fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
+		return nil, fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
 	} else if cls.MultiTenancyConfig == nil {
 		cls.MultiTenancyConfig = &models.MultiTenancyConfig{}
 	} else if cls.MultiTenancyConfig.Enabled {
@@ -115,21 +143,25 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 	}
 
 	if err := h.setNewClassDefaults(cls, h.config.Replication); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	if err := h.validateCanAddClass(ctx, cls, false); err != nil {
-		return nil, 0, err
+	if err := h.validateCanAddClass(ctx, cls, relaxCrossRefValidation); err != nil {
+		return nil, err
+	}
+	if other := h.schemaReader.ClassEqual(cls.Class); other == cls.Class {
+		return nil, NewErrClassExists("class name %s already exists", cls.Class)
+	} else if other != "" {
+		return nil, NewErrClassExists("%v: found similar class %q", clusterSchema.ErrClassExists, other)
 	}
 	// migrate only after validation in completed
 	h.migrateClassSettings(cls)
 	if err := h.parser.ParseClass(cls); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	err = h.invertedConfigValidator(cls.InvertedIndexConfig)
-	if err != nil {
-		return nil, 0, err
+	if err := h.invertedConfigValidator(cls.InvertedIndexConfig); err != nil {
+		return nil, err
 	}
 
 	shardState, err := sharding.InitState(cls.Class,
@@ -137,13 +169,9 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 		h.clusterState.LocalName(), h.schemaManager.StorageCandidates(), cls.ReplicationConfig.Factor,
 		schema.MultiTenancyEnabled(cls))
 	if err != nil {
-		return nil, 0, fmt.Errorf("init sharding state: %w", err)
+		return nil, fmt.Errorf("init sharding state: %w", err)
 	}
-	version, err := h.schemaManager.AddClass(ctx, cls, shardState)
-	if err != nil {
-		return nil, 0, err
-	}
-	return cls, version, err
+	return shardState, nil
 }
 
 func (h *Handler) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, m map[string]string) error {
@@ -202,7 +230,9 @@ func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	_, err = h.schemaManager.DeleteClass(ctx, class)
+	_, err = h.applyIfUnchanged(ctx, func() (uint64, error) {
+		return h.schemaManager.DeleteClass(ctx, class)
+	})
 	return err
 }
 
@@ -240,39 +270,115 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	initial := h.schemaReader.ReadOnlyClass(className)
-	var shardingState *sharding.State
+	// the local schema hash is compared before scaling, so that stale updates
+	// fail before any replicas are changed. Changes made while scaling are
+	// detected when the update is applied.
+	_, err = h.applyIfUnchanged(ctx, func() (uint64, error) {
+		initial := h.schemaReader.ReadOnlyClass(className)
+		var shardingState *sharding.State
 
-	// first layer of defense is basic validation if class already exists
-	if initial != nil {
-		_, err := validateUpdatingMT(initial, updated)
-		if err != nil {
-			return err
-		}
+		// first layer of defense is basic validation if class already exists
+		if initial != nil {
+			_, err := validateUpdatingMT(initial, updated)
+			if err != nil {
+				return 0, err
+			}
 
-		initialRF := initial.ReplicationConfig.Factor
-		updatedRF := updated.ReplicationConfig.Factor
+			initialRF := initial.ReplicationConfig.Factor
+			updatedRF := updated.ReplicationConfig.Factor
 
-		if initialRF != updatedRF {
-			ss, _, err := h.schemaManager.QueryShardingState(className)
-			if err != nil {
-				return fmt.Errorf("query sharding state for %q: %w", className, err)
+			if initialRF != updatedRF {
+				ss, _, err := h.schemaManager.QueryShardingState(className)
+				if err != nil {
+					return 0, fmt.Errorf("query sharding state for %q: %w", className, err)
+				}
+				shardingState, err = h.scaleOut.Scale(ctx, className, ss.Config, initialRF, updatedRF)
+				if err != nil {
+					return 0, fmt.Errorf(
+						"scale %q from %d replicas to %d: %w",
+						className, initialRF, updatedRF, err)
+				}
 			}
-			shardingState, err = h.scaleOut.Scale(ctx, className, ss.Config, initialRF, updatedRF)
-			if err != nil {
-				return fmt.Errorf(
-					"scale %q from %d replicas to %d: %w",
-					className, initialRF, updatedRF, err)
+
+			if err := validateImmutableFields(initial, updated); err != nil {
+				return 0, err
 			}
+			keepVectorDimensions(initial, updated)
 		}
 
-		if err := validateImmutableFields(initial, updated); err != nil {
-			return err
+		return h.schemaManager.UpdateClass(ctx, updated, shardingState)
+	})
+	return err
+}
+
+// SetVectorDimensions declares the dimensions of the vectors of a class in
+// its vector index configs, so that they are kept across restarts and known
+// on all nodes. dims is keyed by target vector, "" stands for the vector of
+// classes without target vectors. Dimensions which are declared already are
+// kept.
+//
+// It is called once the first vectors were imported. The import was
+// authorized already, so the principal is not authorized again.
+func (h *Handler) SetVectorDimensions(ctx context.Context, className string,
+	dims map[string]int,
+) (uint64, error) {
+	class := h.schemaReader.ReadOnlyClass(className)
+	if class == nil {
+		return 0, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	changed := false
+	declare := func(targetVector string, cfg interface{}) interface{} {
+		vectorIndexConfig, ok := cfg.(schemaConfig.VectorIndexConfig)
+		if !ok || dims[targetVector] == 0 || vectorindex.DeclaredDimensions(vectorIndexConfig) != 0 {
+			return cfg
 		}
+		updated := vectorindex.WithDimensions(vectorIndexConfig, dims[targetVector])
+		changed = changed || vectorindex.DeclaredDimensions(updated) != 0
+		return updated
 	}
 
-	_, err = h.schemaManager.UpdateClass(ctx, updated, shardingState)
-	return err
+	updated := *class
+	if !hasTargetVectors(class) {
+		updated.VectorIndexConfig = declare("", class.VectorIndexConfig)
+	} else {
+		updated.VectorConfig = make(map[string]models.VectorConfig, len(class.VectorConfig))
+		for targetVector, vectorConfig := range class.VectorConfig {
+			vectorConfig.VectorIndexConfig = declare(targetVector, vectorConfig.VectorIndexConfig)
+			updated.VectorConfig[targetVector] = vectorConfig
+		}
+	}
+	if !changed {
+		return 0, nil
+	}
+
+	return h.schemaManager.UpdateClass(ctx, &updated, nil)
+}
+
+// keepVectorDimensions copies the declared dimensions of the vector index
+// configs of initial to updated where updated doesn't declare any. Clients
+// which update a class without knowing about the dimensions declared on the
+// first import would otherwise fail to update it.
+func keepVectorDimensions(initial, updated *models.Class) {
+	keep := func(initialCfg, updatedCfg interface{}) interface{} {
+		initialVIC, ok1 := initialCfg.(schemaConfig.VectorIndexConfig)
+		updatedVIC, ok2 := updatedCfg.(schemaConfig.VectorIndexConfig)
+		if !ok1 || !ok2 || vectorindex.DeclaredDimensions(updatedVIC) != 0 {
+			return updatedCfg
+		}
+		if dims := vectorindex.DeclaredDimensions(initialVIC); dims != 0 {
+			return vectorindex.WithDimensions(updatedVIC, dims)
+		}
+		return updatedCfg
+	}
+
+	updated.VectorIndexConfig = keep(initial.VectorIndexConfig, updated.VectorIndexConfig)
+	for targetVector, vectorConfig := range updated.VectorConfig {
+		if initialConfig, ok := initial.VectorConfig[targetVector]; ok {
+			vectorConfig.VectorIndexConfig = keep(initialConfig.VectorIndexConfig, vectorConfig.VectorIndexConfig)
+			updated.VectorConfig[targetVector] = vectorConfig
+		}
+	}
 }
 
 func (m *Handler) setNewClassDefaults(class *models.Class, globalCfg replication.GlobalConfig) error {
@@ -535,11 +641,11 @@ func (h *Handler) validateProperty(
 		}
 
 		if err := schema.ValidateReservedPropertyName(property.Name); err != nil {
-			return err
+			return NewErrReservedName("%s", err)
 		}
 
 		if existingPropertyNames[strings.ToLower(property.Name)] {
-			return fmt.Errorf("class %q: conflict for property %q: already in use or provided multiple times", class.Class, property.Name)
+			return NewErrPropertyExists("class %q: conflict for property %q: already in use or provided multiple times", class.Class, property.Name)
 		}
 
 		// Validate data type of property.
@@ -631,6 +737,12 @@ func (h *Handler) validateCanAddClass(
 		return err
 	}
 
+	if class.EncryptionConfig != nil && class.EncryptionConfig.Enabled &&
+		h.config.DataEncryption.MasterKey == "" {
+		return fmt.Errorf("class %q can not be encrypted: no master key is configured, "+
+			"set DATA_ENCRYPTION_MASTER_KEY", class.Class)
+	}
+
 	// all is fine!
 	return nil
 }
@@ -777,7 +889,8 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC,
+		vectorindex.VectorIndexTypeDISKANN:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
@@ -826,6 +939,11 @@ func validateImmutableFields(initial, updated *models.Class) error {
 		return err
 	}
 
+	if !slices.Equal(initial.Templates, updated.Templates) {
+		return fmt.Errorf("templates are immutable: attempted change from %v to %v",
+			initial.Templates, updated.Templates)
+	}
+
 	for k, v := range updated.VectorConfig {
 		if _, ok := initial.VectorConfig[k]; !ok {
 			return fmt.Errorf("vector config is immutable")
@@ -835,6 +953,25 @@ func validateImmutableFields(initial, updated *models.Class) error {
 		}
 	}
 
+	return validateEncryptionConfigUpdate(initial.EncryptionConfig, updated.EncryptionConfig)
+}
+
+// validateEncryptionConfigUpdate only allows to rotate the key of an
+// encrypted class. Existing data is not encrypted or decrypted on update.
+func validateEncryptionConfigUpdate(initial, updated *models.EncryptionConfig) error {
+	wasEnabled := initial != nil && initial.Enabled
+	isEnabled := updated != nil && updated.Enabled
+	if wasEnabled != isEnabled {
+		return fmt.Errorf("encryptionConfig.enabled is immutable: attempted change from %v to %v",
+			wasEnabled, isEnabled)
+	}
+	if !isEnabled {
+		return nil
+	}
+	if updated.KeyVersion < initial.KeyVersion {
+		return fmt.Errorf("encryptionConfig.keyVersion can not be decreased: "+
+			"attempted change from %d to %d", initial.KeyVersion, updated.KeyVersion)
+	}
 	return nil
 }
 
@@ -96,6 +96,25 @@ func Test_AddClass(t *testing.T) {
 		class = models.Class{Class: "RAFT"}
 		_, _, err = handler.AddClass(ctx, nil, &class)
 		assert.EqualError(t, err, fmt.Sprintf("parse class name: class name `%s` is reserved", config.DefaultRaftDir))
+		assert.ErrorAs(t, err, &ErrReservedName{})
+	})
+
+	t.Run("with existing class name", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.countClassEqual = true
+		fakeSchemaManager.On("ClassEqual", "NewClass").Return("NewClass")
+		fakeSchemaManager.On("ClassEqual", "Newclass").Return("NewClass")
+
+		class := models.Class{Class: "NewClass", Vectorizer: "none"}
+		_, _, err := handler.AddClass(ctx, nil, &class)
+		assert.EqualError(t, err, "class name NewClass already exists")
+		assert.ErrorAs(t, err, &ErrClassExists{})
+
+		class = models.Class{Class: "Newclass", Vectorizer: "none"}
+		_, _, err = handler.AddClass(ctx, nil, &class)
+		assert.EqualError(t, err, `class already exists: found similar class "NewClass"`)
+		assert.ErrorAs(t, err, &ErrClassExists{})
+		fakeSchemaManager.AssertNotCalled(t, "AddClass", mock.Anything, mock.Anything)
 	})
 
 	t.Run("with default params", func(t *testing.T) {
@@ -1365,6 +1384,48 @@ func Test_UpdateClass(t *testing.T) {
 	})
 }
 
+func Test_SetVectorDimensions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("declares missing dimensions", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Foo").Return(&models.Class{
+			Class: "Foo",
+			VectorConfig: map[string]models.VectorConfig{
+				"declared": {VectorIndexConfig: hnsw.UserConfig{Dimensions: 3}},
+				"missing":  {VectorIndexConfig: hnsw.UserConfig{}},
+			},
+		})
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(class *models.Class) bool {
+			return class.VectorConfig["declared"].VectorIndexConfig.(hnsw.UserConfig).Dimensions == 3 &&
+				class.VectorConfig["missing"].VectorIndexConfig.(hnsw.UserConfig).Dimensions == 4
+		}), mock.Anything).Return(nil)
+
+		_, err := handler.SetVectorDimensions(ctx, "Foo", map[string]int{"declared": 5, "missing": 4})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("keeps declared dimensions", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Foo").Return(&models.Class{
+			Class:             "Foo",
+			VectorIndexConfig: hnsw.UserConfig{Dimensions: 3},
+		})
+
+		_, err := handler.SetVectorDimensions(ctx, "Foo", map[string]int{"": 4})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("updates keep declared dimensions", func(t *testing.T) {
+		initial := &models.Class{Class: "Foo", VectorIndexConfig: hnsw.UserConfig{Dimensions: 3}}
+		updated := &models.Class{Class: "Foo", VectorIndexConfig: hnsw.UserConfig{EF: 100}}
+		keepVectorDimensions(initial, updated)
+		assert.Equal(t, hnsw.UserConfig{EF: 100, Dimensions: 3}, updated.VectorIndexConfig)
+	})
+}
+
 func TestRestoreClass_WithCircularRefs(t *testing.T) {
 	// When restoring a class, there could be circular refs between the classes,
 	// thus any validation that checks if linked classes exist would fail on the
@@ -1660,3 +1721,64 @@ func Test_SetClassDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEncryptionConfig(t *testing.T) {
+	encrypted := func(version int64) *models.Class {
+		return &models.Class{
+			Class:             "Secret",
+			Vectorizer:        "none",
+			VectorIndexType:   "hnsw",
+			VectorIndexConfig: hnsw.UserConfig{},
+			EncryptionConfig:  &models.EncryptionConfig{Enabled: true, KeyVersion: version},
+		}
+	}
+
+	t.Run("master key is required", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		err := handler.validateCanAddClass(context.Background(), encrypted(0), false)
+		assert.ErrorContains(t, err, "no master key is configured")
+
+		handler.config.DataEncryption.MasterKey = "configured"
+		assert.Nil(t, handler.validateCanAddClass(context.Background(), encrypted(0), false))
+	})
+
+	tests := []struct {
+		name             string
+		initial, updated *models.Class
+		expectedErr      string
+	}{
+		{
+			name:    "key is rotated",
+			initial: encrypted(0), updated: encrypted(1),
+		},
+		{
+			name:    "unencrypted class stays unencrypted",
+			initial: &models.Class{Class: "Secret"}, updated: &models.Class{Class: "Secret"},
+		},
+		{
+			name:    "key version is decreased",
+			initial: encrypted(2), updated: encrypted(1),
+			expectedErr: "keyVersion can not be decreased",
+		},
+		{
+			name:    "encryption is enabled",
+			initial: &models.Class{Class: "Secret"}, updated: encrypted(0),
+			expectedErr: "encryptionConfig.enabled is immutable",
+		},
+		{
+			name:    "encryption is disabled",
+			initial: encrypted(0), updated: &models.Class{Class: "Secret"},
+			expectedErr: "encryptionConfig.enabled is immutable",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateImmutableFields(test.initial, test.updated)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
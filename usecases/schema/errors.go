@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "fmt"
+
+// ErrClassExists indicates that a class with the same name, or a name which
+// only differs in case, already exists
+type ErrClassExists struct {
+	msg string
+}
+
+func (e ErrClassExists) Error() string {
+	return e.msg
+}
+
+// NewErrClassExists with Errorf signature
+func NewErrClassExists(format string, args ...interface{}) ErrClassExists {
+	return ErrClassExists{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrPropertyExists indicates that a property name is already in use by the
+// class, or was provided multiple times
+type ErrPropertyExists struct {
+	msg string
+}
+
+func (e ErrPropertyExists) Error() string {
+	return e.msg
+}
+
+// NewErrPropertyExists with Errorf signature
+func NewErrPropertyExists(format string, args ...interface{}) ErrPropertyExists {
+	return ErrPropertyExists{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrPropertyTypeConflict indicates that a property exists with a different
+// data type than the one provided
+type ErrPropertyTypeConflict struct {
+	msg string
+}
+
+func (e ErrPropertyTypeConflict) Error() string {
+	return e.msg
+}
+
+// NewErrPropertyTypeConflict with Errorf signature
+func NewErrPropertyTypeConflict(format string, args ...interface{}) ErrPropertyTypeConflict {
+	return ErrPropertyTypeConflict{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrReservedName indicates that a class or property name is reserved for
+// internal use
+type ErrReservedName struct {
+	msg string
+}
+
+func (e ErrReservedName) Error() string {
+	return e.msg
+}
+
+// NewErrReservedName with Errorf signature
+func NewErrReservedName(format string, args ...interface{}) ErrReservedName {
+	return ErrReservedName{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrSchemaChanged indicates that the schema changed since the hash the
+// caller based its change on, see ContextWithExpectedHash
+type ErrSchemaChanged struct {
+	msg string
+}
+
+func (e ErrSchemaChanged) Error() string {
+	return e.msg
+}
+
+// NewErrSchemaChanged with Errorf signature
+func NewErrSchemaChanged(format string, args ...interface{}) ErrSchemaChanged {
+	return ErrSchemaChanged{msg: fmt.Sprintf(format, args...)}
+}
@@ -109,6 +109,10 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 		return fmt.Errorf("update replication config: %w", err)
 	}
 
+	if err := e.migrator.UpdateEncryptionConfig(ctx, className, req.Class.EncryptionConfig); err != nil {
+		return fmt.Errorf("update encryption config: %w", err)
+	}
+
 	return nil
 }
 
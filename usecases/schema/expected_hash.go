@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ContextWithExpectedHash returns a context for schema changes which must
+// only be applied if the hash of the schema, see schema.Hash, still equals
+// hash. Otherwise the change fails with an ErrSchemaChanged, so that the
+// caller can fetch the schema again and retry.
+//
+// The hash is part of the RAFT command and compared when the command is
+// applied, so concurrent changes through other nodes are detected as well.
+func ContextWithExpectedHash(ctx context.Context, hash string) context.Context {
+	return types.ContextWithExpectedSchemaHash(ctx, hash)
+}
+
+// applyIfUnchanged applies a schema change through apply. If ctx carries an
+// expected schema hash, it fails early if the local schema has another hash
+// already. Such changes only return once the local schema includes them, so
+// that the next comparison takes them into account.
+func (h *Handler) applyIfUnchanged(ctx context.Context, apply func() (uint64, error)) (uint64, error) {
+	expected, ok := types.ExpectedSchemaHash(ctx)
+	if !ok {
+		return apply()
+	}
+
+	current := h.schemaReader.ReadOnlySchema()
+	if hash := schema.Hash(&current); hash != expected {
+		return 0, NewErrSchemaChanged("schema changed: expected schema hash %s, got %s", expected, hash)
+	}
+
+	version, err := apply()
+	if errors.Is(err, types.ErrSchemaChanged) {
+		return 0, NewErrSchemaChanged("%v", err)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return version, h.schemaReader.WaitForUpdate(ctx, version)
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestHandler_ExpectedHash(t *testing.T) {
+	ctx := context.Background()
+	current := models.Schema{Classes: []*models.Class{{Class: "C1"}}}
+	currentHash := schema.Hash(&current)
+
+	t.Run("without expected hash", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteClass", "C1").Return(nil)
+
+		require.Nil(t, handler.DeleteClass(ctx, nil, "C1"))
+		fakeSchemaManager.AssertNotCalled(t, "ReadOnlySchema")
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("with current hash", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(current)
+		fakeSchemaManager.On("DeleteClass", "C1").Return(nil)
+
+		err := handler.DeleteClass(ContextWithExpectedHash(ctx, currentHash), nil, "C1")
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("with outdated hash", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(current)
+
+		class := &models.Class{Class: "C1"}
+		prop := &models.Property{Name: "p", DataType: schema.DataTypeText.PropString()}
+		_, _, err := handler.AddClassProperty(ContextWithExpectedHash(ctx, "outdated"), nil, class, false, prop)
+		require.ErrorAs(t, err, &ErrSchemaChanged{})
+		assert.Contains(t, err.Error(), currentHash)
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejected when applied", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(current)
+		fakeSchemaManager.On("DeleteClass", "C1").Return(types.ErrSchemaChanged)
+
+		err := handler.DeleteClass(ContextWithExpectedHash(ctx, currentHash), nil, "C1")
+		require.ErrorAs(t, err, &ErrSchemaChanged{})
+	})
+}
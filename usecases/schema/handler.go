@@ -128,6 +128,7 @@ type Handler struct {
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
 	parser                  Parser
+	templates               map[string]*Template
 }
 
 // NewHandler creates a new handler
@@ -142,6 +143,11 @@ func NewHandler(
 	scaleoutManager scaleOut,
 	cloud modulecapabilities.OffloadCloud,
 ) (Handler, error) {
+	templates, err := LoadTemplates(config.SchemaTemplatesPath)
+	if err != nil {
+		return Handler{}, err
+	}
+
 	handler := Handler{
 		config:                  config,
 		schemaReader:            schemaReader,
@@ -157,6 +163,7 @@ func NewHandler(
 		clusterState:            clusterState,
 		scaleOut:                scaleoutManager,
 		cloud:                   cloud,
+		templates:               templates,
 	}
 
 	handler.scaleOut.SetSchemaReader(schemaReader)
@@ -213,6 +220,17 @@ func (h *Handler) NodeName() string {
 	return h.clusterState.LocalName()
 }
 
+// ClassesVersion returns the highest schema version of the given classes. It
+// identifies a point in the schema log that all of them have reached.
+func (h *Handler) ClassesVersion(classes []string) uint64 {
+	var version uint64
+	for _, class := range classes {
+		info := h.schemaReader.ClassInfo(class)
+		version = max(version, info.Version())
+	}
+	return version
+}
+
 func (h *Handler) UpdateShardStatus(ctx context.Context,
 	principal *models.Principal, class, shard, status string,
 ) (uint64, error) {
@@ -168,7 +168,9 @@ func (fakeValidator) ValidateVectorIndexConfigsUpdate(old, updated map[string]sc
 	return nil
 }
 
-type fakeModuleConfig struct{}
+type fakeModuleConfig struct {
+	validateErr error
+}
 
 func (f *fakeModuleConfig) SetClassDefaults(class *models.Class) {
 	defaultConfig := map[string]interface{}{
@@ -208,7 +210,7 @@ func (f *fakeModuleConfig) SetSinglePropertyDefaults(class *models.Class,
 }
 
 func (f *fakeModuleConfig) ValidateClass(ctx context.Context, class *models.Class) error {
-	return nil
+	return f.validateErr
 }
 
 func (f *fakeModuleConfig) GetByName(name string) modulecapabilities.Module {
@@ -330,6 +332,10 @@ func (f *fakeMigrator) UpdateReplicationConfig(ctx context.Context, className st
 	return nil
 }
 
+func (f *fakeMigrator) UpdateEncryptionConfig(ctx context.Context, className string, cfg *models.EncryptionConfig) error {
+	return nil
+}
+
 func (f *fakeMigrator) WaitForStartup(ctx context.Context) error {
 	args := f.Called(ctx)
 	return args.Error(0)
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ImportSchema adds all classes of a previously dumped schema, e.g. to
+// migrate a schema from another instance. All classes are validated before
+// the first one is added. If adding a class fails, the classes added before
+// are deleted again, so that either all or none of them end up in the schema.
+func (h *Handler) ImportSchema(ctx context.Context, principal *models.Principal,
+	sch *models.Schema,
+) ([]*models.Class, uint64, error) {
+	err := h.Authorizer.Authorize(principal, authorization.CREATE, authorization.Collections()...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if sch == nil || len(sch.Classes) == 0 {
+		return nil, 0, fmt.Errorf("schema to import has no classes")
+	}
+
+	imported := make(map[string]*models.Class, len(sch.Classes))
+	states := make([]*sharding.State, len(sch.Classes))
+	for i, cls := range sch.Classes {
+		if cls == nil {
+			return nil, 0, fmt.Errorf("class at position %d is empty", i)
+		}
+		// cross-references may point to other imported classes, they are
+		// validated once all classes are known
+		state, err := h.prepareNewClass(ctx, cls, true)
+		if err != nil {
+			return nil, 0, fmt.Errorf("class %q: %w", cls.Class, err)
+		}
+		if _, ok := imported[strings.ToLower(cls.Class)]; ok {
+			return nil, 0, NewErrClassExists("class %q is imported multiple times", cls.Class)
+		}
+		imported[strings.ToLower(cls.Class)] = cls
+		states[i] = state
+	}
+
+	findClass := func(name string) *models.Class {
+		if cls, ok := imported[strings.ToLower(name)]; ok && cls.Class == name {
+			return cls
+		}
+		return h.schemaReader.ReadOnlyClass(name)
+	}
+	for _, cls := range sch.Classes {
+		for _, prop := range cls.Properties {
+			if _, err := schema.FindPropertyDataTypeWithRefs(findClass, prop.DataType,
+				false, schema.ClassName(cls.Class)); err != nil {
+				return nil, 0, fmt.Errorf("class %q: property '%s': invalid dataType: %w", cls.Class, prop.Name, err)
+			}
+		}
+	}
+
+	version, err := h.applyIfUnchanged(ctx, func() (uint64, error) {
+		var version uint64
+		for i, cls := range sch.Classes {
+			classCtx := ctx
+			if i > 0 {
+				// only the first class is based on the expected schema
+				classCtx = ContextWithExpectedHash(ctx, "")
+			}
+			v, err := h.schemaManager.AddClass(classCtx, cls, states[i])
+			if err != nil {
+				h.rollbackImport(ctx, sch.Classes[:i])
+				return 0, fmt.Errorf("class %q: %w", cls.Class, err)
+			}
+			version = v
+		}
+		return version, nil
+	})
+	if errors.Is(err, clusterSchema.ErrClassExists) {
+		// another class was added concurrently since the check above
+		return nil, 0, NewErrClassExists("%v", err)
+	} else if err != nil {
+		return nil, 0, err
+	}
+	return sch.Classes, version, nil
+}
+
+// rollbackImport deletes the classes of a failed import in reverse order
+func (h *Handler) rollbackImport(ctx context.Context, added []*models.Class) {
+	for i := len(added) - 1; i >= 0; i-- {
+		if _, err := h.schemaManager.DeleteClass(ctx, added[i].Class); err != nil {
+			h.logger.WithField("action", "schema_import").
+				WithField("class", added[i].Class).
+				WithError(err).Error("delete class after failed import")
+		}
+	}
+}
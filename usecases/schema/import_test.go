@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_ImportSchema(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newSchema := func() *models.Schema {
+		return &models.Schema{Classes: []*models.Class{
+			{
+				Class:      "Article",
+				Vectorizer: "none",
+				Properties: []*models.Property{
+					{DataType: []string{"text"}, Name: "title"},
+					{DataType: []string{"Author"}, Name: "writtenBy"},
+				},
+			},
+			{
+				Class:      "Author",
+				Vectorizer: "none",
+				Properties: []*models.Property{
+					{DataType: []string{"text"}, Name: "name"},
+					{DataType: []string{"Article"}, Name: "wrote"},
+				},
+			},
+		}}
+	}
+
+	t.Run("cross-references between imported classes", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil).Twice()
+
+		classes, _, err := handler.ImportSchema(ctx, nil, newSchema())
+		require.Nil(t, err)
+		require.Len(t, classes, 2)
+		assert.Equal(t, "Article", classes[0].Class)
+		assert.Equal(t, "Author", classes[1].Class)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("reference to an unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Author").Return(nil)
+
+		sch := newSchema()
+		sch.Classes = sch.Classes[:1]
+		_, _, err := handler.ImportSchema(ctx, nil, sch)
+		assert.ErrorContains(t, err, `class "Article": property 'writtenBy': invalid dataType`)
+		fakeSchemaManager.AssertNotCalled(t, "AddClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("class imported multiple times", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+		sch := newSchema()
+		sch.Classes = append(sch.Classes, &models.Class{Class: "article", Vectorizer: "none"})
+		_, _, err := handler.ImportSchema(ctx, nil, sch)
+		assert.ErrorAs(t, err, &ErrClassExists{})
+		fakeSchemaManager.AssertNotCalled(t, "AddClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+		sch := newSchema()
+		sch.Classes[1].Properties[0].DataType = []string{"unknown"}
+		_, _, err := handler.ImportSchema(ctx, nil, sch)
+		assert.ErrorContains(t, err, `class "Author"`)
+		fakeSchemaManager.AssertNotCalled(t, "AddClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("classes are deleted again if adding one fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("AddClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.Class == "Article"
+		}), mock.Anything).Return(nil).Once()
+		fakeSchemaManager.On("AddClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.Class == "Author"
+		}), mock.Anything).Return(errors.New("apply failed")).Once()
+		fakeSchemaManager.On("DeleteClass", "Article").Return(nil).Once()
+
+		_, _, err := handler.ImportSchema(ctx, nil, newSchema())
+		assert.ErrorContains(t, err, "apply failed")
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("empty schema", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+
+		_, _, err := handler.ImportSchema(ctx, nil, &models.Schema{})
+		assert.EqualError(t, err, "schema to import has no classes")
+	})
+}
@@ -61,6 +61,8 @@ type Migrator interface {
 		updated *models.InvertedIndexConfig) error
 	UpdateReplicationConfig(ctx context.Context, className string,
 		updated *models.ReplicationConfig) error
+	UpdateEncryptionConfig(ctx context.Context, className string,
+		updated *models.EncryptionConfig) error
 	WaitForStartup(context.Context) error
 	Shutdown(context.Context) error
 }
@@ -53,7 +53,7 @@ func (p *Parser) ParseClass(class *models.Class) error {
 	}
 
 	if strings.EqualFold(class.Class, config.DefaultRaftDir) {
-		return fmt.Errorf("parse class name: %w", fmt.Errorf("class name `raft` is reserved"))
+		return fmt.Errorf("parse class name: %w", NewErrReservedName("class name `%s` is reserved", config.DefaultRaftDir))
 	}
 
 	if err := p.parseShardingConfig(class); err != nil {
@@ -194,7 +194,8 @@ func (p *Parser) parseTargetVectorsVectorIndexConfig(class *models.Class) error
 func (p *Parser) parseGivenVectorIndexConfig(vectorIndexType string,
 	vectorIndexConfig interface{},
 ) (schemaConfig.VectorIndexConfig, error) {
-	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC {
+	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC &&
+		vectorIndexType != vectorindex.VectorIndexTypeDISKANN {
 		return nil, errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			vectorIndexType)
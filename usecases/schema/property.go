@@ -14,6 +14,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
@@ -32,6 +33,12 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 		return nil, 0, err
 	}
 
+	return h.addClassProperty(ctx, class, merge, newProps...)
+}
+
+func (h *Handler) addClassProperty(ctx context.Context, class *models.Class, merge bool,
+	newProps ...*models.Property,
+) (*models.Class, uint64, error) {
 	if class == nil {
 		return nil, 0, fmt.Errorf("class is nil: %w", ErrNotFound)
 	}
@@ -49,6 +56,12 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 		if prop.DataType == nil {
 			return nil, 0, fmt.Errorf("property must contain dataType")
 		}
+		for _, existing := range class.Properties {
+			if strings.EqualFold(existing.Name, prop.Name) && !slices.Equal(existing.DataType, prop.DataType) {
+				return nil, 0, NewErrPropertyTypeConflict("class %q: property %q already exists with dataType %v, got %v",
+					class.Class, prop.Name, existing.DataType, prop.DataType)
+			}
+		}
 	}
 
 	if err := h.setNewPropDefaults(class, newProps...); err != nil {
@@ -75,8 +88,18 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 
 	migratePropertySettings(props...)
 
-	class.Properties = clusterSchema.MergeProps(class.Properties, props)
-	version, err := h.schemaManager.AddProperty(ctx, class.Class, props...)
+	// the modules own the moduleConfig of the new properties, let them validate
+	// it in the context of the class the same way they do when a class is added
+	merged := *class
+	merged.Properties = clusterSchema.MergeProps(class.Properties, props)
+	if err := h.moduleConfig.ValidateClass(ctx, &merged); err != nil {
+		return nil, 0, err
+	}
+
+	class.Properties = merged.Properties
+	version, err := h.applyIfUnchanged(ctx, func() (uint64, error) {
+		return h.schemaManager.AddProperty(ctx, class.Class, props...)
+	})
 	if err != nil {
 		return nil, 0, err
 	}
@@ -97,6 +120,61 @@ func (h *Handler) DeleteClassProperty(ctx context.Context, principal *models.Pri
 	// return h.deleteClassProperty(ctx, class, property, kind.Action)
 }
 
+// propertyLifecycles in the order a property moves through them when it is
+// removed
+var propertyLifecycles = []string{
+	models.PropertyLifecycleActive,
+	models.PropertyLifecycleDeprecated,
+	models.PropertyLifecycleTombstoned,
+}
+
+// UpdatePropertyLifecycle moves a property one step along its lifecycle, from
+// active to deprecated to tombstoned, or back. Skipping a step is rejected, so
+// that writes are always rejected before a property disappears from GraphQL.
+func (h *Handler) UpdatePropertyLifecycle(ctx context.Context, principal *models.Principal,
+	className, propName, lifecycle string,
+) (*models.Property, uint64, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(className)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	class := h.schemaReader.ReadOnlyClass(className)
+	if class == nil {
+		return nil, 0, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	prop := findProperty(class.Properties, propName)
+	if prop == nil {
+		return nil, 0, fmt.Errorf("property %q of class %q: %w", propName, className, ErrNotFound)
+	}
+
+	current := prop.Lifecycle
+	if current == "" {
+		current = models.PropertyLifecycleActive
+	}
+	from, to := slices.Index(propertyLifecycles, current), slices.Index(propertyLifecycles, lifecycle)
+	if to < 0 {
+		return nil, 0, fmt.Errorf("invalid property lifecycle %q, must be one of %v", lifecycle, propertyLifecycles)
+	}
+	if from == to {
+		return prop, 0, nil
+	}
+	if to-from != 1 && from-to != 1 {
+		return nil, 0, fmt.Errorf("property %q of class %q is %s and can't be %s right away",
+			prop.Name, className, current, lifecycle)
+	}
+
+	updated := *prop
+	updated.Lifecycle = lifecycle
+	version, err := h.applyIfUnchanged(ctx, func() (uint64, error) {
+		return h.schemaManager.AddProperty(ctx, class.Class, &updated)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return &updated, version, nil
+}
+
 func (h *Handler) setNewPropDefaults(class *models.Class, props ...*models.Property) error {
 	setPropertyDefaults(props...)
 	h.moduleConfig.SetSinglePropertyDefaults(class, props...)
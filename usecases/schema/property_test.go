@@ -112,11 +112,54 @@ func TestHandler_AddProperty(t *testing.T) {
 					_, _, err := handler.AddClassProperty(ctx, nil, &class, false, prop)
 					require.ErrorContains(t, err, "conflict for property")
 					require.ErrorContains(t, err, "already in use or provided multiple times")
+					require.ErrorAs(t, err, &ErrPropertyExists{})
+				})
+			}
+			fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+		})
+
+		t.Run("adding properties with other data type", func(t *testing.T) {
+			for _, propName := range existingNames {
+				t.Run(propName, func(t *testing.T) {
+					prop := &models.Property{
+						Name:     propName,
+						DataType: schema.DataTypeInt.PropString(),
+					}
+					_, _, err := handler.AddClassProperty(ctx, nil, &class, true, prop)
+					require.ErrorContains(t, err, "already exists with dataType [text], got [int]")
+					require.ErrorAs(t, err, &ErrPropertyTypeConflict{})
 				})
 			}
 			fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
 		})
 	})
+
+	t.Run("fails adding property of reserved name", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+
+		class := models.Class{Class: "NewClass", Vectorizer: "none"}
+		prop := &models.Property{Name: "_id", DataType: schema.DataTypeText.PropString()}
+		_, _, err := handler.AddClassProperty(ctx, nil, &class, false, prop)
+		require.ErrorAs(t, err, &ErrReservedName{})
+	})
+
+	t.Run("fails adding property with moduleConfig rejected by the module", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.moduleConfig = &fakeModuleConfig{validateErr: fmt.Errorf("module 'my-module1': invalid setting")}
+
+		class := models.Class{Class: "NewClass", Vectorizer: "none"}
+		prop := &models.Property{
+			Name:     "prop",
+			DataType: schema.DataTypeText.PropString(),
+			ModuleConfig: map[string]interface{}{
+				"my-module1": map[string]interface{}{"setting": "invalid"},
+			},
+		}
+		_, _, err := handler.AddClassProperty(ctx, nil, &class, false, prop)
+		require.ErrorContains(t, err, "invalid setting")
+		assert.Empty(t, class.Properties)
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+	})
 }
 
 // TestHandler_AddProperty_Object verifies that we can add properties on class with the Object and ObjectArray type.
@@ -770,3 +813,51 @@ func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 func (pdt *fakePropertyDataType) ContainsClass(name schema.ClassName) bool {
 	return false
 }
+
+func TestHandler_UpdatePropertyLifecycle(t *testing.T) {
+	ctx := context.Background()
+	class := func(lifecycle string) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString(), Lifecycle: lifecycle},
+			},
+		}
+	}
+
+	t.Run("moves one step", func(t *testing.T) {
+		for _, tc := range []struct{ from, to string }{
+			{"", models.PropertyLifecycleDeprecated},
+			{models.PropertyLifecycleDeprecated, models.PropertyLifecycleTombstoned},
+			{models.PropertyLifecycleTombstoned, models.PropertyLifecycleDeprecated},
+			{models.PropertyLifecycleDeprecated, models.PropertyLifecycleActive},
+		} {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class(tc.from))
+			fakeSchemaManager.On("AddProperty", "Article", mock.MatchedBy(func(props []*models.Property) bool {
+				return len(props) == 1 && props[0].Lifecycle == tc.to
+			})).Return(nil)
+
+			prop, _, err := handler.UpdatePropertyLifecycle(ctx, nil, "Article", "title", tc.to)
+			require.NoError(t, err, tc.to)
+			assert.Equal(t, tc.to, prop.Lifecycle)
+			fakeSchemaManager.AssertExpectations(t)
+		}
+	})
+
+	t.Run("skips a step", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class(""))
+
+		_, _, err := handler.UpdatePropertyLifecycle(ctx, nil, "Article", "title", models.PropertyLifecycleTombstoned)
+		assert.ErrorContains(t, err, "can't be tombstoned right away")
+	})
+
+	t.Run("unknown property", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class(""))
+
+		_, _, err := handler.UpdatePropertyLifecycle(ctx, nil, "Article", "body", models.PropertyLifecycleDeprecated)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
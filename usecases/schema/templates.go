@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Template is a reusable set of properties, which classes include by listing
+// its name in models.Class.Templates
+type Template struct {
+	Name       string             `json:"name"`
+	Properties []*models.Property `json:"properties"`
+}
+
+// LoadTemplates reads the templates from a JSON file containing an array of
+// templates. An empty path means there are no templates.
+func LoadTemplates(path string) (map[string]*Template, error) {
+	templates := map[string]*Template{}
+	if path == "" {
+		return templates, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read schema templates: %w", err)
+	}
+	var list []*Template
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("parse schema templates %s: %w", path, err)
+	}
+
+	for _, t := range list {
+		if t.Name == "" {
+			return nil, fmt.Errorf("schema templates %s: template without name", path)
+		}
+		if _, ok := templates[t.Name]; ok {
+			return nil, fmt.Errorf("schema templates %s: duplicate template %q", path, t.Name)
+		}
+		for _, prop := range t.Properties {
+			if prop.Name == "" || len(prop.DataType) == 0 {
+				return nil, fmt.Errorf("schema template %q: properties need a name and dataType", t.Name)
+			}
+		}
+		templates[t.Name] = t
+	}
+	return templates, nil
+}
+
+// expandTemplates adds the properties of the templates included by the class.
+// Properties defined by the class itself take precedence, but need to have
+// the same data type as the template property.
+func (h *Handler) expandTemplates(class *models.Class) error {
+	props, err := h.missingTemplateProperties(class)
+	if err != nil {
+		return err
+	}
+	class.Properties = append(class.Properties, props...)
+	return nil
+}
+
+// missingTemplateProperties returns copies of the template properties which
+// the class does not have yet
+func (h *Handler) missingTemplateProperties(class *models.Class) ([]*models.Property, error) {
+	var missing []*models.Property
+	for _, name := range class.Templates {
+		t, ok := h.templates[name]
+		if !ok {
+			return nil, fmt.Errorf("class %q: unknown schema template %q", class.Class, name)
+		}
+
+		for _, prop := range t.Properties {
+			if existing := findProperty(class.Properties, prop.Name); existing != nil {
+				if !slices.Equal(existing.DataType, prop.DataType) {
+					return nil, NewErrPropertyTypeConflict("class %q: property %q of template %q has dataType %v, got %v",
+						class.Class, prop.Name, name, prop.DataType, existing.DataType)
+				}
+				continue
+			}
+			if findProperty(missing, prop.Name) != nil {
+				// included by multiple templates
+				continue
+			}
+
+			cp, err := cloneProperty(prop)
+			if err != nil {
+				return nil, fmt.Errorf("schema template %q: %w", name, err)
+			}
+			missing = append(missing, cp)
+		}
+	}
+	return missing, nil
+}
+
+// SyncTemplates adds properties which were added to a template since to all
+// classes including it. Properties can't be removed from a class, so
+// removing a property from a template only affects new classes.
+func (h *Handler) SyncTemplates(ctx context.Context) error {
+	if len(h.templates) == 0 {
+		return nil
+	}
+
+	for _, class := range h.schemaReader.ReadOnlySchema().Classes {
+		if len(class.Templates) == 0 {
+			continue
+		}
+		props, err := h.missingTemplateProperties(class)
+		if err != nil {
+			return err
+		}
+		if len(props) == 0 {
+			continue
+		}
+
+		if _, _, err := h.addClassProperty(ctx, class, false, props...); err != nil {
+			return fmt.Errorf("sync schema templates of class %q: %w", class.Class, err)
+		}
+		h.logger.WithField("action", "schema_templates_sync").
+			WithField("class", class.Class).
+			Infof("added %d template properties", len(props))
+	}
+	return nil
+}
+
+func findProperty(props []*models.Property, name string) *models.Property {
+	for _, prop := range props {
+		if strings.EqualFold(prop.Name, name) {
+			return prop
+		}
+	}
+	return nil
+}
+
+// cloneProperty so that defaults set on class properties never change the
+// template itself
+func cloneProperty(prop *models.Property) (*models.Property, error) {
+	data, err := json.Marshal(prop)
+	if err != nil {
+		return nil, err
+	}
+	var cp models.Property
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, err
+	}
+	cp.Name = schema.LowercaseFirstLetter(cp.Name)
+	return &cp, nil
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func auditTemplate() *Template {
+	return &Template{
+		Name: "audit",
+		Properties: []*models.Property{
+			{Name: "CreatedBy", DataType: []string{"text"}},
+			{Name: "createdAt", DataType: []string{"date"}},
+		},
+	}
+}
+
+func propertyNames(props []*models.Property) []string {
+	names := make([]string, len(props))
+	for i, prop := range props {
+		names[i] = prop.Name
+	}
+	return names
+}
+
+func TestLoadTemplates(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "templates.json")
+		require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("without path", func(t *testing.T) {
+		templates, err := LoadTemplates("")
+		require.Nil(t, err)
+		assert.Empty(t, templates)
+	})
+
+	t.Run("valid templates", func(t *testing.T) {
+		templates, err := LoadTemplates(write(t,
+			`[{"name":"audit","properties":[{"name":"createdBy","dataType":["text"]}]}]`))
+		require.Nil(t, err)
+		require.Contains(t, templates, "audit")
+		assert.Equal(t, []string{"createdBy"}, propertyNames(templates["audit"].Properties))
+	})
+
+	t.Run("duplicate templates", func(t *testing.T) {
+		_, err := LoadTemplates(write(t, `[{"name":"audit"},{"name":"audit"}]`))
+		assert.ErrorContains(t, err, `duplicate template "audit"`)
+	})
+
+	t.Run("property without data type", func(t *testing.T) {
+		_, err := LoadTemplates(write(t, `[{"name":"audit","properties":[{"name":"createdBy"}]}]`))
+		assert.ErrorContains(t, err, "need a name and dataType")
+	})
+}
+
+func TestHandler_Templates(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("add class including a template", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.templates = map[string]*Template{"audit": auditTemplate()}
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+		class := &models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			Templates:  []string{"audit"},
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "createdAt", DataType: []string{"date"}, Description: "own definition"},
+			},
+		}
+		_, _, err := handler.AddClass(ctx, nil, class)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"title", "createdAt", "createdBy"}, propertyNames(class.Properties))
+		assert.Equal(t, "own definition", class.Properties[1].Description)
+		assert.Equal(t, "CreatedBy", handler.templates["audit"].Properties[0].Name,
+			"template must not be changed")
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("add class with conflicting property", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		handler.templates = map[string]*Template{"audit": auditTemplate()}
+
+		class := &models.Class{
+			Class:      "Article",
+			Templates:  []string{"audit"},
+			Properties: []*models.Property{{Name: "createdAt", DataType: []string{"text"}}},
+		}
+		_, _, err := handler.AddClass(ctx, nil, class)
+		require.ErrorAs(t, err, &ErrPropertyTypeConflict{})
+	})
+
+	t.Run("add class with unknown template", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+
+		_, _, err := handler.AddClass(ctx, nil, &models.Class{Class: "Article", Templates: []string{"audit"}})
+		assert.ErrorContains(t, err, `unknown schema template "audit"`)
+	})
+
+	t.Run("sync adds new template properties", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.templates = map[string]*Template{"audit": auditTemplate()}
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: []*models.Class{
+			{Class: "Plain", Properties: []*models.Property{{Name: "title", DataType: []string{"text"}}}},
+			{
+				Class:      "Article",
+				Templates:  []string{"audit"},
+				Properties: []*models.Property{{Name: "createdBy", DataType: []string{"text"}}},
+			},
+		}})
+		fakeSchemaManager.On("AddProperty", "Article", mock.MatchedBy(func(props []*models.Property) bool {
+			return assert.ObjectsAreEqual([]string{"createdAt"}, propertyNames(props))
+		})).Return(nil)
+
+		require.Nil(t, handler.SyncTemplates(ctx))
+		fakeSchemaManager.AssertExpectations(t)
+	})
+}
+
+func Test_UpdateClass_TemplatesImmutable(t *testing.T) {
+	err := validateImmutableFields(
+		&models.Class{Class: "Article", Templates: []string{"audit"}},
+		&models.Class{Class: "Article"},
+	)
+	assert.ErrorContains(t, err, "templates are immutable")
+}
@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ValidateSchema compares a proposed schema with the current one without
+// applying anything. Classes which would be added are validated the same way
+// as by AddClass, changed classes the same way as by UpdateClass and new
+// properties the same way as by AddClassProperty. All errors are collected,
+// so that they can be fixed at once.
+func (h *Handler) ValidateSchema(ctx context.Context, principal *models.Principal,
+	proposed *models.Schema,
+) (*models.SchemaDiff, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Collections()...)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposed == nil {
+		proposed = &models.Schema{}
+	}
+
+	diff := &models.SchemaDiff{
+		AddedClasses:   []string{},
+		RemovedClasses: []string{},
+		ChangedClasses: []*models.SchemaClassDiff{},
+		Errors:         []*models.SchemaValidationError{},
+	}
+	addErr := func(class, property string, err error) {
+		diff.Errors = append(diff.Errors, &models.SchemaValidationError{
+			Class:    class,
+			Property: property,
+			Message:  err.Error(),
+		})
+	}
+
+	proposedClasses := make(map[string]*models.Class, len(proposed.Classes))
+	classes := make([]*models.Class, 0, len(proposed.Classes))
+	for i, cls := range proposed.Classes {
+		if cls == nil || cls.Class == "" {
+			return nil, fmt.Errorf("class at position %d has no name", i)
+		}
+		cls.Class = schema.UppercaseClassName(cls.Class)
+		cls.Properties = schema.LowercaseAllPropertyNames(cls.Properties)
+		if _, ok := proposedClasses[strings.ToLower(cls.Class)]; ok {
+			addErr(cls.Class, "", fmt.Errorf("class is contained multiple times"))
+			continue
+		}
+		proposedClasses[strings.ToLower(cls.Class)] = cls
+		classes = append(classes, cls)
+	}
+
+	current := h.schemaReader.ReadOnlySchema()
+	currentClasses := make(map[string]*models.Class, len(current.Classes))
+	for _, cls := range current.Classes {
+		currentClasses[cls.Class] = cls
+		if proposedClasses[strings.ToLower(cls.Class)] == nil ||
+			proposedClasses[strings.ToLower(cls.Class)].Class != cls.Class {
+			diff.RemovedClasses = append(diff.RemovedClasses, cls.Class)
+		}
+	}
+	sort.Strings(diff.RemovedClasses)
+
+	// cross-references may point to other proposed classes
+	findClass := func(name string) *models.Class {
+		if cls, ok := proposedClasses[strings.ToLower(name)]; ok && cls.Class == name {
+			return cls
+		}
+		return h.schemaReader.ReadOnlyClass(name)
+	}
+
+	for _, cls := range classes {
+		existing, ok := currentClasses[cls.Class]
+		if !ok {
+			diff.AddedClasses = append(diff.AddedClasses, cls.Class)
+			h.validateAddedClass(ctx, cls, findClass, addErr)
+			continue
+		}
+		if classDiff := h.validateChangedClass(ctx, existing, cls, findClass, addErr); classDiff != nil {
+			diff.ChangedClasses = append(diff.ChangedClasses, classDiff)
+		}
+	}
+
+	diff.Valid = len(diff.Errors) == 0
+	return diff, nil
+}
+
+func (h *Handler) validateAddedClass(ctx context.Context, cls *models.Class,
+	findClass func(string) *models.Class, addErr func(class, property string, err error),
+) {
+	if _, err := h.prepareNewClass(ctx, cls, true); err != nil {
+		addErr(cls.Class, "", err)
+		return
+	}
+	h.validateReferences(cls, cls.Properties, findClass, addErr)
+}
+
+// validateChangedClass returns the differences between the current and the
+// proposed version of a class, or nil if there are none
+func (h *Handler) validateChangedClass(ctx context.Context, current, proposed *models.Class,
+	findClass func(string) *models.Class, addErr func(class, property string, err error),
+) *models.SchemaClassDiff {
+	// set the same defaults the current class got when it was added, so that
+	// omitted optionals don't show up as changes
+	err := h.setNewClassDefaults(proposed, h.config.Replication)
+	if err == nil {
+		if proposed.MultiTenancyConfig == nil {
+			proposed.MultiTenancyConfig = &models.MultiTenancyConfig{}
+		}
+		h.migrateClassSettings(proposed)
+		err = h.parser.ParseClass(proposed)
+	}
+
+	diff := diffClass(current, proposed)
+	if len(diff.AddedProperties) == 0 && len(diff.RemovedProperties) == 0 &&
+		len(diff.ChangedProperties) == 0 && len(diff.ChangedSettings) == 0 {
+		return nil
+	}
+	if err != nil {
+		addErr(proposed.Class, "", err)
+		return diff
+	}
+
+	for _, name := range diff.RemovedProperties {
+		addErr(current.Class, name, fmt.Errorf("properties cannot be removed"))
+	}
+	for _, name := range diff.ChangedProperties {
+		addErr(current.Class, name, fmt.Errorf("properties cannot be changed, only added"))
+	}
+	if len(diff.AddedProperties) > 0 {
+		h.validateAddedProperties(ctx, current, proposed, diff.AddedProperties, findClass, addErr)
+	}
+	if len(diff.ChangedSettings) > 0 {
+		if err := h.validateClassUpdate(current, proposed); err != nil {
+			addErr(current.Class, "", err)
+		}
+	}
+	return diff
+}
+
+func (h *Handler) validateAddedProperties(ctx context.Context, current, proposed *models.Class,
+	names []string, findClass func(string) *models.Class, addErr func(class, property string, err error),
+) {
+	existingNames := make(map[string]bool, len(current.Properties))
+	for _, prop := range current.Properties {
+		existingNames[strings.ToLower(prop.Name)] = true
+	}
+
+	newProps := make([]*models.Property, 0, len(names))
+	for _, name := range names {
+		prop := classProperty(proposed, name)
+		if err := h.validateProperty(current, existingNames, true, prop); err != nil {
+			addErr(current.Class, prop.Name, err)
+			continue
+		}
+		newProps = append(newProps, prop)
+	}
+	h.validateReferences(current, newProps, findClass, addErr)
+
+	merged := *current
+	merged.Properties = clusterSchema.MergeProps(current.Properties, newProps)
+	if err := h.moduleConfig.ValidateClass(ctx, &merged); err != nil {
+		addErr(current.Class, "", err)
+	}
+}
+
+// validateClassUpdate runs the same checks as UpdateClass. Properties are
+// compared separately, so the current ones are kept.
+func (h *Handler) validateClassUpdate(current, proposed *models.Class) error {
+	// the update is parsed again, so it needs the unparsed configs just like
+	// an update which reaches the schema manager
+	var update models.Class
+	b, err := json.Marshal(proposed)
+	if err != nil {
+		return fmt.Errorf("marshal class: %w", err)
+	}
+	if err := json.Unmarshal(b, &update); err != nil {
+		return fmt.Errorf("unmarshal class: %w", err)
+	}
+	update.Properties = current.Properties
+	if err := h.parser.parseModuleConfig(&update); err != nil {
+		return fmt.Errorf("parse module config: %w", err)
+	}
+	if err := h.parser.parseVectorConfig(&update); err != nil {
+		return fmt.Errorf("parse vector config: %w", err)
+	}
+	if err := h.validateVectorSettings(&update); err != nil {
+		return err
+	}
+	_, err = h.parser.ParseClassUpdate(current, &update)
+	return err
+}
+
+func (h *Handler) validateReferences(cls *models.Class, props []*models.Property,
+	findClass func(string) *models.Class, addErr func(class, property string, err error),
+) {
+	for _, prop := range props {
+		if _, err := schema.FindPropertyDataTypeWithRefs(findClass, prop.DataType,
+			false, schema.ClassName(cls.Class)); err != nil {
+			addErr(cls.Class, prop.Name, fmt.Errorf("invalid dataType: %w", err))
+		}
+	}
+}
+
+// diffClass compares two versions of a class by their JSON representation, so
+// that parsed and unparsed configs with the same content are equal
+func diffClass(current, proposed *models.Class) *models.SchemaClassDiff {
+	diff := &models.SchemaClassDiff{
+		Class:             current.Class,
+		AddedProperties:   []string{},
+		RemovedProperties: []string{},
+		ChangedProperties: []string{},
+		ChangedSettings:   []string{},
+	}
+
+	currentSettings, proposedSettings := asJSONMap(current), asJSONMap(proposed)
+	keys := map[string]struct{}{}
+	for key := range currentSettings {
+		keys[key] = struct{}{}
+	}
+	for key := range proposedSettings {
+		keys[key] = struct{}{}
+	}
+	for key := range keys {
+		if key == "class" || key == "properties" {
+			continue
+		}
+		if !reflect.DeepEqual(currentSettings[key], proposedSettings[key]) {
+			diff.ChangedSettings = append(diff.ChangedSettings, key)
+		}
+	}
+	sort.Strings(diff.ChangedSettings)
+
+	for _, prop := range proposed.Properties {
+		existing := classProperty(current, prop.Name)
+		if existing == nil {
+			diff.AddedProperties = append(diff.AddedProperties, prop.Name)
+		} else if !reflect.DeepEqual(asJSONMap(existing), asJSONMap(prop)) {
+			diff.ChangedProperties = append(diff.ChangedProperties, prop.Name)
+		}
+	}
+	for _, prop := range current.Properties {
+		if classProperty(proposed, prop.Name) == nil {
+			diff.RemovedProperties = append(diff.RemovedProperties, prop.Name)
+		}
+	}
+	return diff
+}
+
+func asJSONMap(v interface{}) map[string]interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+func classProperty(cls *models.Class, name string) *models.Property {
+	for _, prop := range cls.Properties {
+		if strings.EqualFold(prop.Name, name) {
+			return prop
+		}
+	}
+	return nil
+}
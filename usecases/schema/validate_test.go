@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_ValidateSchema(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	article := func() *models.Class {
+		return &models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			Properties: []*models.Property{
+				{DataType: []string{"text"}, Name: "title"},
+			},
+		}
+	}
+
+	// newHandler returns a handler whose current schema contains the given
+	// classes, with all defaults set as if they had been added
+	newHandler := func(t *testing.T, classes ...*models.Class) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		for _, cls := range classes {
+			_, err := handler.prepareNewClass(ctx, cls, false)
+			require.Nil(t, err)
+		}
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: classes})
+		return handler, fakeSchemaManager
+	}
+
+	t.Run("unchanged schema", func(t *testing.T) {
+		handler, _ := newHandler(t, article())
+
+		diff, err := handler.ValidateSchema(ctx, nil, &models.Schema{Classes: []*models.Class{article()}})
+		require.Nil(t, err)
+		assert.True(t, diff.Valid)
+		assert.Empty(t, diff.AddedClasses)
+		assert.Empty(t, diff.RemovedClasses)
+		assert.Empty(t, diff.ChangedClasses)
+		assert.Empty(t, diff.Errors)
+	})
+
+	t.Run("added, removed and changed classes", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t, article(), &models.Class{Class: "Comment", Vectorizer: "none"})
+		fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(nil)
+
+		changed := article()
+		changed.Description = "news articles"
+		changed.Properties = append(changed.Properties,
+			&models.Property{DataType: []string{"Author"}, Name: "writtenBy"})
+		proposed := &models.Schema{Classes: []*models.Class{
+			changed,
+			{
+				Class:      "Author",
+				Vectorizer: "none",
+				Properties: []*models.Property{
+					{DataType: []string{"Article"}, Name: "wrote"},
+				},
+			},
+		}}
+
+		diff, err := handler.ValidateSchema(ctx, nil, proposed)
+		require.Nil(t, err)
+		assert.True(t, diff.Valid)
+		assert.Empty(t, diff.Errors)
+		assert.Equal(t, []string{"Author"}, diff.AddedClasses)
+		assert.Equal(t, []string{"Comment"}, diff.RemovedClasses)
+		require.Len(t, diff.ChangedClasses, 1)
+		assert.Equal(t, &models.SchemaClassDiff{
+			Class:             "Article",
+			AddedProperties:   []string{"writtenBy"},
+			RemovedProperties: []string{},
+			ChangedProperties: []string{},
+			ChangedSettings:   []string{"description"},
+		}, diff.ChangedClasses[0])
+		fakeSchemaManager.AssertNotCalled(t, "AddClass", mock.Anything, mock.Anything)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+	})
+
+	t.Run("all errors are collected", func(t *testing.T) {
+		current := article()
+		current.Properties = append(current.Properties,
+			&models.Property{DataType: []string{"int"}, Name: "wordCount"})
+		handler, fakeSchemaManager := newHandler(t, current)
+		fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(nil)
+
+		changed := article()
+		changed.Properties[0].DataType = []string{"int"}
+		changed.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+		proposed := &models.Schema{Classes: []*models.Class{
+			changed,
+			{
+				Class:      "Author",
+				Vectorizer: "none",
+				Properties: []*models.Property{
+					{DataType: []string{"Unknown"}, Name: "wrote"},
+				},
+			},
+		}}
+
+		diff, err := handler.ValidateSchema(ctx, nil, proposed)
+		require.Nil(t, err)
+		assert.False(t, diff.Valid)
+		assert.Equal(t, []string{"Author"}, diff.AddedClasses)
+		require.Len(t, diff.ChangedClasses, 1)
+		assert.Equal(t, []string{"title"}, diff.ChangedClasses[0].ChangedProperties)
+		assert.Equal(t, []string{"wordCount"}, diff.ChangedClasses[0].RemovedProperties)
+		assert.Contains(t, diff.ChangedClasses[0].ChangedSettings, "multiTenancyConfig")
+
+		require.Len(t, diff.Errors, 4)
+		assert.Equal(t, "Article", diff.Errors[0].Class)
+		assert.Equal(t, "wordCount", diff.Errors[0].Property)
+		assert.Equal(t, "properties cannot be removed", diff.Errors[0].Message)
+		assert.Equal(t, "title", diff.Errors[1].Property)
+		assert.Equal(t, "properties cannot be changed, only added", diff.Errors[1].Message)
+		assert.Equal(t, "Article", diff.Errors[2].Class)
+		assert.Empty(t, diff.Errors[2].Property)
+		assert.Contains(t, diff.Errors[2].Message, "enabling multi-tenancy for an existing class is not supported")
+		assert.Equal(t, "Author", diff.Errors[3].Class)
+		assert.Equal(t, "wrote", diff.Errors[3].Property)
+		assert.Contains(t, diff.Errors[3].Message, "invalid dataType")
+	})
+
+	t.Run("class without a name", func(t *testing.T) {
+		handler, _ := newHandler(t)
+
+		_, err := handler.ValidateSchema(ctx, nil, &models.Schema{Classes: []*models.Class{{}}})
+		assert.EqualError(t, err, "class at position 0 has no name")
+	})
+}
@@ -97,6 +97,8 @@ type RemoteIndexClient interface {
 	GetShardQueueSize(ctx context.Context, hostName, indexName, shardName string) (int64, error)
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName, targetStatus string, schemaVersion uint64) error
+	TrashEntries(ctx context.Context, hostName, indexName, shardName string, id strfmt.UUID) ([][]byte, error)
+	ObjectHistory(ctx context.Context, hostName, indexName, shardName string, id strfmt.UUID) ([][]byte, error)
 
 	PutFile(ctx context.Context, hostName, indexName, shardName, fileName string,
 		payload io.ReadSeekCloser) error
@@ -408,6 +410,38 @@ func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetS
 	return ri.client.UpdateShardStatus(ctx, host, ri.class, shardName, targetStatus, schemaVersion)
 }
 
+// TrashEntries returns the entries of the trash of a remote shard which did
+// not expire yet, or only the entry of the given object if id is set
+func (ri *RemoteIndex) TrashEntries(ctx context.Context, shardName string, id strfmt.UUID) ([][]byte, error) {
+	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
+	if err != nil {
+		return nil, fmt.Errorf("class %s has no physical shard %q: %w", ri.class, shardName, err)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(owner)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", owner)
+	}
+
+	return ri.client.TrashEntries(ctx, host, ri.class, shardName, id)
+}
+
+// ObjectHistory returns the prior versions of an object of a remote shard,
+// latest first
+func (ri *RemoteIndex) ObjectHistory(ctx context.Context, shardName string, id strfmt.UUID) ([][]byte, error) {
+	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
+	if err != nil {
+		return nil, fmt.Errorf("class %s has no physical shard %q: %w", ri.class, shardName, err)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(owner)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", owner)
+	}
+
+	return ri.client.ObjectHistory(ctx, host, ri.class, shardName, id)
+}
+
 func (ri *RemoteIndex) queryAllReplicas(
 	ctx context.Context,
 	log logrus.FieldLogger,
@@ -77,6 +77,8 @@ type RemoteIndexIncomingRepo interface {
 	IncomingGetShardQueueSize(ctx context.Context, shardName string) (int64, error)
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
 	IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error
+	IncomingTrashEntries(ctx context.Context, shardName string, id strfmt.UUID) ([][]byte, error)
+	IncomingObjectHistory(ctx context.Context, shardName string, id strfmt.UUID) ([][]byte, error)
 	IncomingOverwriteObjects(ctx context.Context, shard string,
 		vobjects []*objects.VObject) ([]replica.RepairResponse, error)
 	IncomingDigestObjects(ctx context.Context, shardName string,
@@ -277,6 +279,28 @@ func (rii *RemoteIndexIncoming) UpdateShardStatus(ctx context.Context,
 	return index.IncomingUpdateShardStatus(ctx, shardName, targetStatus, schemaVersion)
 }
 
+func (rii *RemoteIndexIncoming) TrashEntries(ctx context.Context,
+	indexName, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingTrashEntries(ctx, shardName, id)
+}
+
+func (rii *RemoteIndexIncoming) ObjectHistory(ctx context.Context,
+	indexName, shardName string, id strfmt.UUID,
+) ([][]byte, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingObjectHistory(ctx, shardName, id)
+}
+
 func (rii *RemoteIndexIncoming) FilePutter(ctx context.Context,
 	indexName, shardName, filePath string,
 ) (io.WriteCloser, error) {
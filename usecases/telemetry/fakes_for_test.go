@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -46,3 +47,24 @@ func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
 	}
 	return schema.Schema{}
 }
+
+type fakeModule struct {
+	modulecapabilities.Module
+	name string
+}
+
+func (m *fakeModule) Name() string {
+	return m.name
+}
+
+type fakeModulesProvider struct {
+	names []string
+}
+
+func (p *fakeModulesProvider) GetAll() []modulecapabilities.Module {
+	mods := make([]modulecapabilities.Module, len(p.names))
+	for i, name := range p.names {
+		mods[i] = &fakeModule{name: name}
+	}
+	return mods
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type modulesProvider interface {
+	GetAll() []modulecapabilities.Module
+}
+
+// UsageReport is the anonymized payload sent by the UsageReporter. In
+// contrast to the regular telemetry Payload it contains no machine id and the
+// object count is only reported as an order of magnitude.
+type UsageReport struct {
+	Version          string   `json:"version"`
+	OS               string   `json:"os"`
+	Arch             string   `json:"arch"`
+	ObjectsMagnitude string   `json:"objectsMagnitude"`
+	EnabledModules   []string `json:"enabledModules,omitempty"`
+}
+
+// UsageReporter periodically sends an anonymized UsageReport to a user
+// configured endpoint. It is opt-in and does nothing unless enabled in the
+// config.
+type UsageReporter struct {
+	config            config.UsageReporting
+	nodesStatusGetter nodesStatusGetter
+	modules           modulesProvider
+	logger            logrus.FieldLogger
+	client            *http.Client
+	shutdown          chan struct{}
+	started           bool
+}
+
+// NewUsageReporter creates a new UsageReporter instance
+func NewUsageReporter(cfg config.UsageReporting, nodesStatusGetter nodesStatusGetter,
+	modules modulesProvider, logger logrus.FieldLogger,
+) *UsageReporter {
+	if cfg.Interval <= 0 {
+		cfg.Interval = config.DefaultUsageReportingInterval
+	}
+	return &UsageReporter{
+		config:            cfg,
+		nodesStatusGetter: nodesStatusGetter,
+		modules:           modules,
+		logger:            logger.WithField("action", "usage_reporting"),
+		client:            &http.Client{Timeout: 30 * time.Second},
+		shutdown:          make(chan struct{}),
+	}
+}
+
+// Start begins sending reports in the configured interval. It is a no-op if
+// usage reporting is not enabled.
+func (r *UsageReporter) Start() {
+	if !r.config.Enabled {
+		return
+	}
+	r.started = true
+
+	f := func() {
+		t := time.NewTicker(r.config.Interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-r.shutdown:
+				return
+			case <-t.C:
+				ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+				report, err := r.send(ctx)
+				cancel()
+				if err != nil {
+					r.logger.WithError(err).Error("failed to send usage report")
+					continue
+				}
+				r.logger.WithField("report", fmt.Sprintf("%+v", report)).
+					Debug("usage report sent")
+			}
+		}
+	}
+	enterrors.GoWrapper(f, r.logger)
+
+	r.logger.WithField("endpoint", r.config.Endpoint).
+		WithField("interval", r.config.Interval.String()).
+		Info("usage reporting enabled")
+}
+
+// Stop ends the reporting loop
+func (r *UsageReporter) Stop(ctx context.Context) error {
+	if !r.started {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown usage reporting: %w", ctx.Err())
+	case r.shutdown <- struct{}{}:
+		return nil
+	}
+}
+
+// Preview builds the report exactly as it would be sent, without sending it.
+// This works regardless of whether usage reporting is enabled, so operators
+// can inspect the payload before opting in.
+func (r *UsageReporter) Preview(ctx context.Context) (*UsageReport, error) {
+	return r.buildReport(ctx)
+}
+
+func (r *UsageReporter) send(ctx context.Context) (*UsageReport, error) {
+	report, err := r.buildReport(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("build report: %w", err)
+	}
+
+	b, err := json.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("marshal report: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.config.Endpoint, bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("request unsuccessful, status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+	return report, nil
+}
+
+func (r *UsageReporter) buildReport(ctx context.Context) (*UsageReport, error) {
+	status := r.nodesStatusGetter.LocalNodeStatus(ctx, "", verbosity.OutputVerbose)
+	if status == nil || status.Stats == nil {
+		return nil, fmt.Errorf("received nil node stats")
+	}
+
+	var mods []string
+	for _, mod := range r.modules.GetAll() {
+		mods = append(mods, mod.Name())
+	}
+	sort.Strings(mods)
+
+	return &UsageReport{
+		Version:          config.ServerVersion,
+		OS:               runtime.GOOS,
+		Arch:             runtime.GOARCH,
+		ObjectsMagnitude: orderOfMagnitude(status.Stats.ObjectCount),
+		EnabledModules:   mods,
+	}, nil
+}
+
+// orderOfMagnitude rounds the count down to the nearest power of ten, so that
+// the exact size of a dataset is never revealed, e.g. 4321 becomes "1e3".
+// The digits are counted on the integer, as math.Log10 rounds up counts just
+// below a power of ten, e.g. 999999999999999999.
+func orderOfMagnitude(count int64) string {
+	if count <= 0 {
+		return "0"
+	}
+	exp := 0
+	for count >= 10 {
+		count /= 10
+		exp++
+	}
+	return fmt.Sprintf("1e%d", exp)
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestUsageReporter_Preview(t *testing.T) {
+	sg := &fakeNodesStatusGetter{}
+	sg.On("LocalNodeStatus", context.Background(), "", verbosity.OutputVerbose).Return(
+		&models.NodeStatus{Stats: &models.NodeStats{ObjectCount: 43210}})
+	mods := &fakeModulesProvider{names: []string{"text2vec-openai", "backup-s3"}}
+	logger, _ := test.NewNullLogger()
+
+	// preview works even if usage reporting is disabled
+	r := NewUsageReporter(config.UsageReporting{}, sg, mods, logger)
+	report, err := r.Preview(context.Background())
+	require.Nil(t, err)
+
+	assert.Equal(t, &UsageReport{
+		Version:          config.ServerVersion,
+		OS:               runtime.GOOS,
+		Arch:             runtime.GOARCH,
+		ObjectsMagnitude: "1e4",
+		EnabledModules:   []string{"backup-s3", "text2vec-openai"},
+	}, report)
+}
+
+func TestUsageReporter_Send(t *testing.T) {
+	received := make(chan UsageReport, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var report UsageReport
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&report))
+		received <- report
+	}))
+	defer server.Close()
+
+	sg := &fakeNodesStatusGetter{}
+	sg.On("LocalNodeStatus", context.Background(), "", verbosity.OutputVerbose).Return(
+		&models.NodeStatus{Stats: &models.NodeStats{ObjectCount: 7}})
+	logger, _ := test.NewNullLogger()
+
+	r := NewUsageReporter(config.UsageReporting{
+		Enabled:  true,
+		Endpoint: server.URL,
+		Interval: 10 * time.Millisecond,
+	}, sg, &fakeModulesProvider{}, logger)
+
+	_, err := r.send(context.Background())
+	require.Nil(t, err)
+	report := <-received
+	assert.Equal(t, "1e0", report.ObjectsMagnitude)
+	assert.Empty(t, report.EnabledModules)
+}
+
+func TestOrderOfMagnitude(t *testing.T) {
+	tests := map[int64]string{
+		0:          "0",
+		1:          "1e0",
+		9:          "1e0",
+		10:         "1e1",
+		999:        "1e2",
+		1000:       "1e3",
+		1234567890: "1e9",
+
+		999999999999999999:  "1e17",
+		1000000000000000000: "1e18",
+		math.MaxInt64:       "1e18",
+		-1:                  "0",
+	}
+	for count, expected := range tests {
+		assert.Equal(t, expected, orderOfMagnitude(count), "count %d", count)
+	}
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tracing sets up OpenTelemetry tracing. Spans are started with
+// Start throughout the request path; unless Init installed an exporting
+// provider they are no-ops, so instrumented code doesn't need to check
+// whether tracing is enabled.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/usecases/build"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/weaviate/weaviate"
+	serviceName         = "weaviate"
+
+	DefaultSampleRate = 0.1
+)
+
+// Config controls the export of traces via OTLP/HTTP. Besides Endpoint, the
+// exporter honours the standard OTEL_EXPORTER_OTLP_* environment variables,
+// e.g. for headers or TLS settings.
+type Config struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Endpoint is the collector URL, e.g. http://otel-collector:4318. If
+	// empty, the exporter's default (or OTEL_EXPORTER_OTLP_ENDPOINT) is used.
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// SampleRate is the fraction of root spans that are recorded. Sampling
+	// decisions of incoming trace parents are always respected.
+	SampleRate float64 `json:"sample_rate" yaml:"sample_rate"`
+}
+
+func (c Config) Validate() error {
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("tracing sample rate must be between 0 and 1, got %v", c.SampleRate)
+	}
+	return nil
+}
+
+// Init installs the global tracer provider and W3C trace context
+// propagation. The returned function flushes pending spans and stops the
+// exporter; it is a no-op if tracing is disabled.
+func Init(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []otlptracehttp.Option
+	if cfg.Endpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create otlp exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", build.Version),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRate))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as child of the span carried by ctx, if any.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End marks span as failed if err is set and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -39,7 +39,9 @@ import (
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/generictypes"
 	uc "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"github.com/weaviate/weaviate/usecases/traverser/grouper"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var _NUMCPU = runtime.GOMAXPROCS(0)
@@ -129,7 +131,10 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 // GetClass from search and connector repo
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
-) ([]interface{}, error) {
+) (_ []interface{}, err error) {
+	ctx, span := tracing.Start(ctx, "explorer.GetClass", attribute.String("class", params.ClassName))
+	defer func() { tracing.End(span, err) }()
+
 	if params.Pagination == nil {
 		params.Pagination = &filters.Pagination{
 			Offset: 0,
@@ -137,20 +142,14 @@ func (e *Explorer) GetClass(ctx context.Context,
 		}
 	}
 
-	if err := e.validateFilters(params.Filters); err != nil {
-		return nil, errors.Wrap(err, "invalid 'where' filter")
-	}
-
-	if err := e.validateSort(params.ClassName, params.Sort); err != nil {
-		return nil, errors.Wrap(err, "invalid 'sort' parameter")
-	}
-
-	if err := e.validateCursor(params); err != nil {
-		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
+	if err := e.validateGetParams(ctx, params); err != nil {
+		return nil, err
 	}
 
 	if params.KeywordRanking != nil {
-		res, err := e.getClassKeywordBased(ctx, params)
+		searchCtx, searchSpan := tracing.Start(ctx, "explorer.search", attribute.String("search_type", "keyword"))
+		res, err := e.getClassKeywordBased(searchCtx, params)
+		tracing.End(searchSpan, err)
 		if err != nil {
 			return nil, err
 		}
@@ -158,20 +157,43 @@ func (e *Explorer) GetClass(ctx context.Context,
 	}
 
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
-		res, searchVector, err := e.getClassVectorSearch(ctx, params)
+		searchCtx, searchSpan := tracing.Start(ctx, "explorer.search", attribute.String("search_type", "vector"))
+		res, searchVector, err := e.getClassVectorSearch(searchCtx, params)
+		tracing.End(searchSpan, err)
 		if err != nil {
 			return nil, err
 		}
 		return e.searchResultsToGetResponse(ctx, res, searchVector, params)
 	}
 
-	res, err := e.getClassList(ctx, params)
+	searchCtx, searchSpan := tracing.Start(ctx, "explorer.search", attribute.String("search_type", "list"))
+	res, err := e.getClassList(searchCtx, params)
+	tracing.End(searchSpan, err)
 	if err != nil {
 		return nil, err
 	}
 	return e.searchResultsToGetResponse(ctx, res, nil, params)
 }
 
+func (e *Explorer) validateGetParams(ctx context.Context, params dto.GetParams) (err error) {
+	_, span := tracing.Start(ctx, "explorer.validate")
+	defer func() { tracing.End(span, err) }()
+
+	if err := e.validateFilters(params.Filters); err != nil {
+		return errors.Wrap(err, "invalid 'where' filter")
+	}
+
+	if err := e.validateSort(params.ClassName, params.Sort); err != nil {
+		return errors.Wrap(err, "invalid 'sort' parameter")
+	}
+
+	if err := e.validateCursor(params); err != nil {
+		return errors.Wrap(err, "cursor api: invalid 'after' parameter")
+	}
+
+	return nil
+}
+
 func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
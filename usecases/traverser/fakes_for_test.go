@@ -193,6 +193,7 @@ func (f *fakeExplorer) CrossClassVectorSearch(ctx context.Context, p ExplorePara
 
 type fakeSchemaGetter struct {
 	schema schema.Schema
+	nodes  []string
 }
 
 func newFakeSchemaGetter(className string) *fakeSchemaGetter {
@@ -250,7 +251,7 @@ func (f *fakeSchemaGetter) OptimisticTenantStatus(_ context.Context, class strin
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return string(uuid) }
 
 func (f *fakeSchemaGetter) Nodes() []string {
-	panic("not implemented")
+	return f.nodes
 }
 
 func (f *fakeSchemaGetter) NodeName() string {
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
 )
@@ -45,6 +46,9 @@ type Traverser struct {
 	targetVectorParamHelper *TargetVectorParamHelper
 	metrics                 *Metrics
 	ratelimiter             *ratelimiter.Limiter
+
+	// ObjectEvents are the object changes streamed by Subscribe
+	ObjectEvents *objects.Events
 }
 
 type VectorSearcher interface {
@@ -84,6 +88,12 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 	}
 }
 
+// SetMaxConcurrentGetRequests changes the limit of concurrent Get requests,
+// a value <= 0 removes the limit
+func (t *Traverser) SetMaxConcurrentGetRequests(maxGetRequests int) {
+	t.ratelimiter.SetMax(maxGetRequests)
+}
+
 // TraverserRepo describes the dependencies of the Traverser UC to the
 // connected database
 type TraverserRepo interface {
@@ -110,6 +110,9 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		if err := filters.ValidateFilters(t.schemaGetter.ReadOnlyClass, params.Filters); err != nil {
 			return nil, errors.Wrap(err, "invalid 'where' filter")
 		}
+		if err := t.probeForRefFilterDepthLimit(params.Filters); err != nil {
+			return nil, err
+		}
 	}
 	var mp *modules.Provider
 
@@ -18,16 +18,22 @@ import (
 
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params dto.GetParams,
-) ([]interface{}, error) {
+) (_ []interface{}, err error) {
 	before := time.Now()
 
+	ctx, span := tracing.Start(ctx, "traverser.GetClass", attribute.String("class", params.ClassName))
+	defer func() { tracing.End(span, err) }()
+
 	ok := t.ratelimiter.TryInc()
 	if !ok {
 		// we currently have no concept of error status code or typed errors in
@@ -42,7 +48,7 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
 
-	err := t.authorizer.Authorize(principal, authorization.READ, authorization.Collections(params.ClassName)...)
+	err = t.authorizer.Authorize(principal, authorization.READ, authorization.Collections(params.ClassName)...)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +57,13 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := t.probeForRefFilterDepthLimit(params.Filters); err != nil {
+		return nil, err
+	}
+
+	_, lockSpan := tracing.Start(ctx, "traverser.LockConnector")
 	unlock, err := t.locks.LockConnector()
+	tracing.End(lockSpan, err)
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
 	}
@@ -100,3 +112,34 @@ func (t *Traverser) probeForRefDepthLimit(props search.SelectProperties) error {
 	}
 	return nil
 }
+
+// probeForRefFilterDepthLimit checks that the reference hops of a where filter
+// don't exceed the limit provided by QUERY_CROSS_REFERENCE_DEPTH_LIMIT. Every
+// hop is resolved with a nested search, so deep paths are expensive.
+func (t *Traverser) probeForRefFilterDepthLimit(filter *filters.LocalFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	depthLimit := t.config.Config.QueryCrossReferenceDepthLimit
+	var probe func(clause *filters.Clause) error
+	probe = func(clause *filters.Clause) error {
+		depth := 0
+		for path := clause.On; path != nil && path.Child != nil; path = path.Child {
+			depth++
+		}
+		if depth > depthLimit {
+			return fmt.Errorf("where filter on %v exceeds QUERY_CROSS_REFERENCE_DEPTH_LIMIT (%d)",
+				clause.On.Slice(), depthLimit)
+		}
+
+		for i := range clause.Operands {
+			if err := probe(&clause.Operands[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return probe(filter.Root)
+}
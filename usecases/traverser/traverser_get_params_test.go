@@ -17,6 +17,7 @@ import (
 	logrus "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -209,3 +210,33 @@ func TestGet_NestedRefDepthLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestGet_RefFilterDepthLimit(t *testing.T) {
+	makePath := func(hops int) *filters.Path {
+		path := &filters.Path{Class: "LinkedListNode", Property: "name"}
+		for i := 0; i < hops; i++ {
+			path = &filters.Path{Class: "LinkedListNode", Property: "nextNode", Child: path}
+		}
+		return path
+	}
+	makeFilter := func(hops int) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				{Operator: filters.OperatorEqual, On: makePath(0)},
+				{Operator: filters.OperatorEqual, On: makePath(hops)},
+			},
+		}}
+	}
+
+	logger, _ := logrus.NewNullLogger()
+	cfg := config.WeaviateConfig{Config: config.Config{QueryCrossReferenceDepthLimit: 3}}
+	traverser := NewTraverser(&cfg, &fakeLocks{}, logger, mocks.NewMockAuthorizer(),
+		&fakeVectorRepo{}, &fakeExplorer{}, &fakeSchemaGetter{aggregateTestSchema}, nil, nil, -1)
+
+	assert.Nil(t, traverser.probeForRefFilterDepthLimit(nil))
+	assert.Nil(t, traverser.probeForRefFilterDepthLimit(makeFilter(3)))
+	err := traverser.probeForRefFilterDepthLimit(makeFilter(4))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "exceeds QUERY_CROSS_REFERENCE_DEPTH_LIMIT (3)")
+}
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// subscriptionBuffer is the number of events a subscriber may fall behind
+// before it misses events
+const subscriptionBuffer = 100
+
+// Subscribe streams the changes of objects of the given classes and of
+// their schema until ctx is done. Classes with multi-tenancy enabled
+// require a tenant, only the object changes of this tenant are streamed.
+//
+// Object changes are only published by the node they are made through, so
+// subscriptions are rejected if the cluster has more than one node, as they
+// would miss most changes.
+func (t *Traverser) Subscribe(ctx context.Context, principal *models.Principal,
+	classes []string, tenant string,
+) (<-chan objects.Event, error) {
+	err := t.authorizer.Authorize(principal, authorization.READ, authorization.Collections(classes...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(classes) == 0 {
+		return nil, fmt.Errorf("no class selected")
+	}
+	if t.ObjectEvents == nil {
+		return nil, fmt.Errorf("subscriptions are not available")
+	}
+	if nodes := t.schemaGetter.Nodes(); len(nodes) > 1 {
+		return nil, fmt.Errorf("subscriptions are not available in a cluster of %d nodes", len(nodes))
+	}
+
+	var resources []string
+	for _, name := range classes {
+		class := t.schemaGetter.ReadOnlyClass(name)
+		if class == nil {
+			return nil, fmt.Errorf("class %s not found", name)
+		}
+		multiTenant := schema.MultiTenancyEnabled(class)
+		if multiTenant && tenant == "" {
+			return nil, fmt.Errorf("class %s has multi-tenancy enabled, but request was without tenant", name)
+		}
+		if !multiTenant && tenant != "" {
+			return nil, fmt.Errorf("class %s has multi-tenancy disabled, but request was with tenant", name)
+		}
+		if multiTenant {
+			resources = append(resources, authorization.Shards(name, tenant)...)
+		}
+		resources = append(resources, authorization.Objects(name, tenant, ""))
+	}
+	if err := t.authorizer.Authorize(principal, authorization.READ, resources...); err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]struct{}, len(classes))
+	for _, class := range classes {
+		selected[class] = struct{}{}
+	}
+
+	events, cancel := t.ObjectEvents.Subscribe(subscriptionBuffer)
+	out := make(chan objects.Event)
+	enterrors.GoWrapper(func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev := <-events:
+				if _, ok := selected[ev.Class]; !ok {
+					continue
+				}
+				if tenant != "" && ev.Tenant != tenant && !ev.IsSchemaEvent() {
+					continue
+				}
+				select {
+				case out <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}, t.logger)
+
+	return out, nil
+}
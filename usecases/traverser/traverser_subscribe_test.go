@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestTraverserSubscribe(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{Class: "Article", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true}},
+			{Class: "Author"},
+		},
+	}}}
+	authorizer := mocks.NewMockAuthorizer()
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+		&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1)
+
+	t.Run("without events", func(t *testing.T) {
+		_, err := traverser.Subscribe(context.Background(), nil, []string{"Article"}, "t1")
+		require.NotNil(t, err)
+	})
+
+	traverser.ObjectEvents = objects.NewEvents()
+
+	t.Run("multi-tenant classes require a tenant", func(t *testing.T) {
+		_, err := traverser.Subscribe(context.Background(), nil, []string{"Article"}, "")
+		require.NotNil(t, err)
+		_, err = traverser.Subscribe(context.Background(), nil, []string{"Author"}, "t1")
+		require.NotNil(t, err)
+	})
+
+	t.Run("the tenant is authorized", func(t *testing.T) {
+		principal := &models.Principal{Username: "john"}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := traverser.Subscribe(ctx, principal, []string{"Article"}, "t1")
+		require.Nil(t, err)
+		calls := authorizer.Calls()
+		assert.Equal(t, mocks.AuthZReq{
+			Principal: principal, Verb: authorization.READ,
+			Resources: append(authorization.Shards("Article", "t1"), authorization.Objects("Article", "t1", "")),
+		}, calls[len(calls)-1])
+	})
+
+	t.Run("rejected in a cluster", func(t *testing.T) {
+		schemaGetter.nodes = []string{"node1", "node2"}
+		defer func() { schemaGetter.nodes = nil }()
+
+		_, err := traverser.Subscribe(context.Background(), nil, []string{"Author"}, "")
+		require.NotNil(t, err)
+	})
+
+	t.Run("filters by class and tenant", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		events, err := traverser.Subscribe(ctx, nil, []string{"Article"}, "t1")
+		require.Nil(t, err)
+
+		traverser.ObjectEvents.Publish(objects.Event{Type: objects.EventCreate, Class: "Author", Tenant: "t1", ID: "1"})
+		traverser.ObjectEvents.Publish(objects.Event{Type: objects.EventCreate, Class: "Article", Tenant: "t2", ID: "2"})
+		traverser.ObjectEvents.Publish(objects.Event{Type: objects.EventUpdate, Class: "Article", Tenant: "t1", ID: "3"})
+		traverser.ObjectEvents.Publish(objects.Event{Type: objects.EventClassDelete, Class: "Article", SchemaHash: "abc"})
+
+		ev := <-events
+		assert.Equal(t, objects.EventUpdate, ev.Type)
+		assert.Equal(t, "3", ev.ID.String())
+
+		ev = <-events
+		assert.Equal(t, objects.EventClassDelete, ev.Type, "schema events are not filtered by tenant")
+
+		cancel()
+		_, ok := <-events
+		assert.False(t, ok, "events are closed once the context is done")
+	})
+}